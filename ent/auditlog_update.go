@@ -0,0 +1,524 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/auditlog"
+	"github.com/zibbp/ganymede/ent/predicate"
+)
+
+// AuditLogUpdate is the builder for updating AuditLog entities.
+type AuditLogUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AuditLogMutation
+}
+
+// Where appends a list predicates to the AuditLogUpdate builder.
+func (_u *AuditLogUpdate) Where(ps ...predicate.AuditLog) *AuditLogUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetAction sets the "action" field.
+func (_u *AuditLogUpdate) SetAction(v string) *AuditLogUpdate {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *AuditLogUpdate) SetNillableAction(v *string) *AuditLogUpdate {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// SetResourceType sets the "resource_type" field.
+func (_u *AuditLogUpdate) SetResourceType(v string) *AuditLogUpdate {
+	_u.mutation.SetResourceType(v)
+	return _u
+}
+
+// SetNillableResourceType sets the "resource_type" field if the given value is not nil.
+func (_u *AuditLogUpdate) SetNillableResourceType(v *string) *AuditLogUpdate {
+	if v != nil {
+		_u.SetResourceType(*v)
+	}
+	return _u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *AuditLogUpdate) SetResourceID(v string) *AuditLogUpdate {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *AuditLogUpdate) SetNillableResourceID(v *string) *AuditLogUpdate {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// ClearResourceID clears the value of the "resource_id" field.
+func (_u *AuditLogUpdate) ClearResourceID() *AuditLogUpdate {
+	_u.mutation.ClearResourceID()
+	return _u
+}
+
+// SetActorID sets the "actor_id" field.
+func (_u *AuditLogUpdate) SetActorID(v uuid.UUID) *AuditLogUpdate {
+	_u.mutation.SetActorID(v)
+	return _u
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (_u *AuditLogUpdate) SetNillableActorID(v *uuid.UUID) *AuditLogUpdate {
+	if v != nil {
+		_u.SetActorID(*v)
+	}
+	return _u
+}
+
+// ClearActorID clears the value of the "actor_id" field.
+func (_u *AuditLogUpdate) ClearActorID() *AuditLogUpdate {
+	_u.mutation.ClearActorID()
+	return _u
+}
+
+// SetActorUsername sets the "actor_username" field.
+func (_u *AuditLogUpdate) SetActorUsername(v string) *AuditLogUpdate {
+	_u.mutation.SetActorUsername(v)
+	return _u
+}
+
+// SetNillableActorUsername sets the "actor_username" field if the given value is not nil.
+func (_u *AuditLogUpdate) SetNillableActorUsername(v *string) *AuditLogUpdate {
+	if v != nil {
+		_u.SetActorUsername(*v)
+	}
+	return _u
+}
+
+// ClearActorUsername clears the value of the "actor_username" field.
+func (_u *AuditLogUpdate) ClearActorUsername() *AuditLogUpdate {
+	_u.mutation.ClearActorUsername()
+	return _u
+}
+
+// SetIP sets the "ip" field.
+func (_u *AuditLogUpdate) SetIP(v string) *AuditLogUpdate {
+	_u.mutation.SetIP(v)
+	return _u
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (_u *AuditLogUpdate) SetNillableIP(v *string) *AuditLogUpdate {
+	if v != nil {
+		_u.SetIP(*v)
+	}
+	return _u
+}
+
+// ClearIP clears the value of the "ip" field.
+func (_u *AuditLogUpdate) ClearIP() *AuditLogUpdate {
+	_u.mutation.ClearIP()
+	return _u
+}
+
+// SetBefore sets the "before" field.
+func (_u *AuditLogUpdate) SetBefore(v map[string]interface{}) *AuditLogUpdate {
+	_u.mutation.SetBefore(v)
+	return _u
+}
+
+// ClearBefore clears the value of the "before" field.
+func (_u *AuditLogUpdate) ClearBefore() *AuditLogUpdate {
+	_u.mutation.ClearBefore()
+	return _u
+}
+
+// SetAfter sets the "after" field.
+func (_u *AuditLogUpdate) SetAfter(v map[string]interface{}) *AuditLogUpdate {
+	_u.mutation.SetAfter(v)
+	return _u
+}
+
+// ClearAfter clears the value of the "after" field.
+func (_u *AuditLogUpdate) ClearAfter() *AuditLogUpdate {
+	_u.mutation.ClearAfter()
+	return _u
+}
+
+// Mutation returns the AuditLogMutation object of the builder.
+func (_u *AuditLogUpdate) Mutation() *AuditLogMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AuditLogUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AuditLogUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AuditLogUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AuditLogUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *AuditLogUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(auditlog.Table, auditlog.Columns, sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(auditlog.FieldAction, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ResourceType(); ok {
+		_spec.SetField(auditlog.FieldResourceType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(auditlog.FieldResourceID, field.TypeString, value)
+	}
+	if _u.mutation.ResourceIDCleared() {
+		_spec.ClearField(auditlog.FieldResourceID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ActorID(); ok {
+		_spec.SetField(auditlog.FieldActorID, field.TypeUUID, value)
+	}
+	if _u.mutation.ActorIDCleared() {
+		_spec.ClearField(auditlog.FieldActorID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.ActorUsername(); ok {
+		_spec.SetField(auditlog.FieldActorUsername, field.TypeString, value)
+	}
+	if _u.mutation.ActorUsernameCleared() {
+		_spec.ClearField(auditlog.FieldActorUsername, field.TypeString)
+	}
+	if value, ok := _u.mutation.IP(); ok {
+		_spec.SetField(auditlog.FieldIP, field.TypeString, value)
+	}
+	if _u.mutation.IPCleared() {
+		_spec.ClearField(auditlog.FieldIP, field.TypeString)
+	}
+	if value, ok := _u.mutation.Before(); ok {
+		_spec.SetField(auditlog.FieldBefore, field.TypeJSON, value)
+	}
+	if _u.mutation.BeforeCleared() {
+		_spec.ClearField(auditlog.FieldBefore, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.After(); ok {
+		_spec.SetField(auditlog.FieldAfter, field.TypeJSON, value)
+	}
+	if _u.mutation.AfterCleared() {
+		_spec.ClearField(auditlog.FieldAfter, field.TypeJSON)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditlog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AuditLogUpdateOne is the builder for updating a single AuditLog entity.
+type AuditLogUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AuditLogMutation
+}
+
+// SetAction sets the "action" field.
+func (_u *AuditLogUpdateOne) SetAction(v string) *AuditLogUpdateOne {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *AuditLogUpdateOne) SetNillableAction(v *string) *AuditLogUpdateOne {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// SetResourceType sets the "resource_type" field.
+func (_u *AuditLogUpdateOne) SetResourceType(v string) *AuditLogUpdateOne {
+	_u.mutation.SetResourceType(v)
+	return _u
+}
+
+// SetNillableResourceType sets the "resource_type" field if the given value is not nil.
+func (_u *AuditLogUpdateOne) SetNillableResourceType(v *string) *AuditLogUpdateOne {
+	if v != nil {
+		_u.SetResourceType(*v)
+	}
+	return _u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *AuditLogUpdateOne) SetResourceID(v string) *AuditLogUpdateOne {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *AuditLogUpdateOne) SetNillableResourceID(v *string) *AuditLogUpdateOne {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// ClearResourceID clears the value of the "resource_id" field.
+func (_u *AuditLogUpdateOne) ClearResourceID() *AuditLogUpdateOne {
+	_u.mutation.ClearResourceID()
+	return _u
+}
+
+// SetActorID sets the "actor_id" field.
+func (_u *AuditLogUpdateOne) SetActorID(v uuid.UUID) *AuditLogUpdateOne {
+	_u.mutation.SetActorID(v)
+	return _u
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (_u *AuditLogUpdateOne) SetNillableActorID(v *uuid.UUID) *AuditLogUpdateOne {
+	if v != nil {
+		_u.SetActorID(*v)
+	}
+	return _u
+}
+
+// ClearActorID clears the value of the "actor_id" field.
+func (_u *AuditLogUpdateOne) ClearActorID() *AuditLogUpdateOne {
+	_u.mutation.ClearActorID()
+	return _u
+}
+
+// SetActorUsername sets the "actor_username" field.
+func (_u *AuditLogUpdateOne) SetActorUsername(v string) *AuditLogUpdateOne {
+	_u.mutation.SetActorUsername(v)
+	return _u
+}
+
+// SetNillableActorUsername sets the "actor_username" field if the given value is not nil.
+func (_u *AuditLogUpdateOne) SetNillableActorUsername(v *string) *AuditLogUpdateOne {
+	if v != nil {
+		_u.SetActorUsername(*v)
+	}
+	return _u
+}
+
+// ClearActorUsername clears the value of the "actor_username" field.
+func (_u *AuditLogUpdateOne) ClearActorUsername() *AuditLogUpdateOne {
+	_u.mutation.ClearActorUsername()
+	return _u
+}
+
+// SetIP sets the "ip" field.
+func (_u *AuditLogUpdateOne) SetIP(v string) *AuditLogUpdateOne {
+	_u.mutation.SetIP(v)
+	return _u
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (_u *AuditLogUpdateOne) SetNillableIP(v *string) *AuditLogUpdateOne {
+	if v != nil {
+		_u.SetIP(*v)
+	}
+	return _u
+}
+
+// ClearIP clears the value of the "ip" field.
+func (_u *AuditLogUpdateOne) ClearIP() *AuditLogUpdateOne {
+	_u.mutation.ClearIP()
+	return _u
+}
+
+// SetBefore sets the "before" field.
+func (_u *AuditLogUpdateOne) SetBefore(v map[string]interface{}) *AuditLogUpdateOne {
+	_u.mutation.SetBefore(v)
+	return _u
+}
+
+// ClearBefore clears the value of the "before" field.
+func (_u *AuditLogUpdateOne) ClearBefore() *AuditLogUpdateOne {
+	_u.mutation.ClearBefore()
+	return _u
+}
+
+// SetAfter sets the "after" field.
+func (_u *AuditLogUpdateOne) SetAfter(v map[string]interface{}) *AuditLogUpdateOne {
+	_u.mutation.SetAfter(v)
+	return _u
+}
+
+// ClearAfter clears the value of the "after" field.
+func (_u *AuditLogUpdateOne) ClearAfter() *AuditLogUpdateOne {
+	_u.mutation.ClearAfter()
+	return _u
+}
+
+// Mutation returns the AuditLogMutation object of the builder.
+func (_u *AuditLogUpdateOne) Mutation() *AuditLogMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the AuditLogUpdate builder.
+func (_u *AuditLogUpdateOne) Where(ps ...predicate.AuditLog) *AuditLogUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AuditLogUpdateOne) Select(field string, fields ...string) *AuditLogUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AuditLog entity.
+func (_u *AuditLogUpdateOne) Save(ctx context.Context) (*AuditLog, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AuditLogUpdateOne) SaveX(ctx context.Context) *AuditLog {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AuditLogUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AuditLogUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *AuditLogUpdateOne) sqlSave(ctx context.Context) (_node *AuditLog, err error) {
+	_spec := sqlgraph.NewUpdateSpec(auditlog.Table, auditlog.Columns, sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AuditLog.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, auditlog.FieldID)
+		for _, f := range fields {
+			if !auditlog.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != auditlog.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(auditlog.FieldAction, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ResourceType(); ok {
+		_spec.SetField(auditlog.FieldResourceType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(auditlog.FieldResourceID, field.TypeString, value)
+	}
+	if _u.mutation.ResourceIDCleared() {
+		_spec.ClearField(auditlog.FieldResourceID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ActorID(); ok {
+		_spec.SetField(auditlog.FieldActorID, field.TypeUUID, value)
+	}
+	if _u.mutation.ActorIDCleared() {
+		_spec.ClearField(auditlog.FieldActorID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.ActorUsername(); ok {
+		_spec.SetField(auditlog.FieldActorUsername, field.TypeString, value)
+	}
+	if _u.mutation.ActorUsernameCleared() {
+		_spec.ClearField(auditlog.FieldActorUsername, field.TypeString)
+	}
+	if value, ok := _u.mutation.IP(); ok {
+		_spec.SetField(auditlog.FieldIP, field.TypeString, value)
+	}
+	if _u.mutation.IPCleared() {
+		_spec.ClearField(auditlog.FieldIP, field.TypeString)
+	}
+	if value, ok := _u.mutation.Before(); ok {
+		_spec.SetField(auditlog.FieldBefore, field.TypeJSON, value)
+	}
+	if _u.mutation.BeforeCleared() {
+		_spec.ClearField(auditlog.FieldBefore, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.After(); ok {
+		_spec.SetField(auditlog.FieldAfter, field.TypeJSON, value)
+	}
+	if _u.mutation.AfterCleared() {
+		_spec.ClearField(auditlog.FieldAfter, field.TypeJSON)
+	}
+	_node = &AuditLog{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditlog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
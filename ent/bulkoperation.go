@@ -0,0 +1,309 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/bulkoperation"
+	"github.com/zibbp/ganymede/ent/user"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// BulkOperation is the model entity for the BulkOperation schema.
+type BulkOperation struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Action holds the value of the "action" field.
+	Action utils.BulkAction `json:"action,omitempty"`
+	// Status holds the value of the "status" field.
+	Status utils.TaskStatus `json:"status,omitempty"`
+	// Filter selecting the vods, used if no vod IDs are set.
+	Filter utils.BulkVodFilter `json:"filter,omitempty"`
+	// Vods the action is applied to.
+	VodIds []uuid.UUID `json:"vod_ids,omitempty"`
+	// Playlist vods are added to or removed from.
+	PlaylistID *uuid.UUID `json:"playlist_id,omitempty"`
+	// Total holds the value of the "total" field.
+	Total int `json:"total,omitempty"`
+	// Succeeded holds the value of the "succeeded" field.
+	Succeeded int `json:"succeeded,omitempty"`
+	// Failed holds the value of the "failed" field.
+	Failed int `json:"failed,omitempty"`
+	// Results holds the value of the "results" field.
+	Results []utils.BulkItemResult `json:"results,omitempty"`
+	// Error that stopped the operation.
+	Error string `json:"error,omitempty"`
+	// JobID holds the value of the "job_id" field.
+	JobID int64 `json:"job_id,omitempty"`
+	// FinishedAt holds the value of the "finished_at" field.
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BulkOperationQuery when eager-loading is set.
+	Edges                BulkOperationEdges `json:"edges"`
+	user_bulk_operations *uuid.UUID
+	selectValues         sql.SelectValues
+}
+
+// BulkOperationEdges holds the relations/edges for other nodes in the graph.
+type BulkOperationEdges struct {
+	// CreatedBy holds the value of the created_by edge.
+	CreatedBy *User `json:"created_by,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// CreatedByOrErr returns the CreatedBy value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BulkOperationEdges) CreatedByOrErr() (*User, error) {
+	if e.CreatedBy != nil {
+		return e.CreatedBy, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "created_by"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BulkOperation) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bulkoperation.FieldPlaylistID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case bulkoperation.FieldFilter, bulkoperation.FieldVodIds, bulkoperation.FieldResults:
+			values[i] = new([]byte)
+		case bulkoperation.FieldTotal, bulkoperation.FieldSucceeded, bulkoperation.FieldFailed, bulkoperation.FieldJobID:
+			values[i] = new(sql.NullInt64)
+		case bulkoperation.FieldAction, bulkoperation.FieldStatus, bulkoperation.FieldError:
+			values[i] = new(sql.NullString)
+		case bulkoperation.FieldFinishedAt, bulkoperation.FieldUpdatedAt, bulkoperation.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case bulkoperation.FieldID:
+			values[i] = new(uuid.UUID)
+		case bulkoperation.ForeignKeys[0]: // user_bulk_operations
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BulkOperation fields.
+func (_m *BulkOperation) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bulkoperation.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case bulkoperation.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				_m.Action = utils.BulkAction(value.String)
+			}
+		case bulkoperation.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = utils.TaskStatus(value.String)
+			}
+		case bulkoperation.FieldFilter:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field filter", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Filter); err != nil {
+					return fmt.Errorf("unmarshal field filter: %w", err)
+				}
+			}
+		case bulkoperation.FieldVodIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field vod_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.VodIds); err != nil {
+					return fmt.Errorf("unmarshal field vod_ids: %w", err)
+				}
+			}
+		case bulkoperation.FieldPlaylistID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field playlist_id", values[i])
+			} else if value.Valid {
+				_m.PlaylistID = new(uuid.UUID)
+				*_m.PlaylistID = *value.S.(*uuid.UUID)
+			}
+		case bulkoperation.FieldTotal:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field total", values[i])
+			} else if value.Valid {
+				_m.Total = int(value.Int64)
+			}
+		case bulkoperation.FieldSucceeded:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field succeeded", values[i])
+			} else if value.Valid {
+				_m.Succeeded = int(value.Int64)
+			}
+		case bulkoperation.FieldFailed:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field failed", values[i])
+			} else if value.Valid {
+				_m.Failed = int(value.Int64)
+			}
+		case bulkoperation.FieldResults:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field results", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Results); err != nil {
+					return fmt.Errorf("unmarshal field results: %w", err)
+				}
+			}
+		case bulkoperation.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				_m.Error = value.String
+			}
+		case bulkoperation.FieldJobID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field job_id", values[i])
+			} else if value.Valid {
+				_m.JobID = value.Int64
+			}
+		case bulkoperation.FieldFinishedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field finished_at", values[i])
+			} else if value.Valid {
+				_m.FinishedAt = new(time.Time)
+				*_m.FinishedAt = value.Time
+			}
+		case bulkoperation.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case bulkoperation.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case bulkoperation.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_bulk_operations", values[i])
+			} else if value.Valid {
+				_m.user_bulk_operations = new(uuid.UUID)
+				*_m.user_bulk_operations = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BulkOperation.
+// This includes values selected through modifiers, order, etc.
+func (_m *BulkOperation) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryCreatedBy queries the "created_by" edge of the BulkOperation entity.
+func (_m *BulkOperation) QueryCreatedBy() *UserQuery {
+	return NewBulkOperationClient(_m.config).QueryCreatedBy(_m)
+}
+
+// Update returns a builder for updating this BulkOperation.
+// Note that you need to call BulkOperation.Unwrap() before calling this method if this BulkOperation
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BulkOperation) Update() *BulkOperationUpdateOne {
+	return NewBulkOperationClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BulkOperation entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BulkOperation) Unwrap() *BulkOperation {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: BulkOperation is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BulkOperation) String() string {
+	var builder strings.Builder
+	builder.WriteString("BulkOperation(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("action=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Action))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("filter=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Filter))
+	builder.WriteString(", ")
+	builder.WriteString("vod_ids=")
+	builder.WriteString(fmt.Sprintf("%v", _m.VodIds))
+	builder.WriteString(", ")
+	if v := _m.PlaylistID; v != nil {
+		builder.WriteString("playlist_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("total=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Total))
+	builder.WriteString(", ")
+	builder.WriteString("succeeded=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Succeeded))
+	builder.WriteString(", ")
+	builder.WriteString("failed=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Failed))
+	builder.WriteString(", ")
+	builder.WriteString("results=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Results))
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(_m.Error)
+	builder.WriteString(", ")
+	builder.WriteString("job_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.JobID))
+	builder.WriteString(", ")
+	if v := _m.FinishedAt; v != nil {
+		builder.WriteString("finished_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BulkOperations is a parsable slice of BulkOperation.
+type BulkOperations []*BulkOperation
@@ -0,0 +1,656 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bulkoperation
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/predicate"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldLTE(FieldID, id))
+}
+
+// PlaylistID applies equality check predicate on the "playlist_id" field. It's identical to PlaylistIDEQ.
+func PlaylistID(v uuid.UUID) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldEQ(FieldPlaylistID, v))
+}
+
+// Total applies equality check predicate on the "total" field. It's identical to TotalEQ.
+func Total(v int) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldEQ(FieldTotal, v))
+}
+
+// Succeeded applies equality check predicate on the "succeeded" field. It's identical to SucceededEQ.
+func Succeeded(v int) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldEQ(FieldSucceeded, v))
+}
+
+// Failed applies equality check predicate on the "failed" field. It's identical to FailedEQ.
+func Failed(v int) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldEQ(FieldFailed, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldEQ(FieldError, v))
+}
+
+// JobID applies equality check predicate on the "job_id" field. It's identical to JobIDEQ.
+func JobID(v int64) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldEQ(FieldJobID, v))
+}
+
+// FinishedAt applies equality check predicate on the "finished_at" field. It's identical to FinishedAtEQ.
+func FinishedAt(v time.Time) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ActionEQ applies the EQ predicate on the "action" field.
+func ActionEQ(v utils.BulkAction) predicate.BulkOperation {
+	vc := v
+	return predicate.BulkOperation(sql.FieldEQ(FieldAction, vc))
+}
+
+// ActionNEQ applies the NEQ predicate on the "action" field.
+func ActionNEQ(v utils.BulkAction) predicate.BulkOperation {
+	vc := v
+	return predicate.BulkOperation(sql.FieldNEQ(FieldAction, vc))
+}
+
+// ActionIn applies the In predicate on the "action" field.
+func ActionIn(vs ...utils.BulkAction) predicate.BulkOperation {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BulkOperation(sql.FieldIn(FieldAction, v...))
+}
+
+// ActionNotIn applies the NotIn predicate on the "action" field.
+func ActionNotIn(vs ...utils.BulkAction) predicate.BulkOperation {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BulkOperation(sql.FieldNotIn(FieldAction, v...))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v utils.TaskStatus) predicate.BulkOperation {
+	vc := v
+	return predicate.BulkOperation(sql.FieldEQ(FieldStatus, vc))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v utils.TaskStatus) predicate.BulkOperation {
+	vc := v
+	return predicate.BulkOperation(sql.FieldNEQ(FieldStatus, vc))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...utils.TaskStatus) predicate.BulkOperation {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BulkOperation(sql.FieldIn(FieldStatus, v...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...utils.TaskStatus) predicate.BulkOperation {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BulkOperation(sql.FieldNotIn(FieldStatus, v...))
+}
+
+// FilterIsNil applies the IsNil predicate on the "filter" field.
+func FilterIsNil() predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldIsNull(FieldFilter))
+}
+
+// FilterNotNil applies the NotNil predicate on the "filter" field.
+func FilterNotNil() predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldNotNull(FieldFilter))
+}
+
+// VodIdsIsNil applies the IsNil predicate on the "vod_ids" field.
+func VodIdsIsNil() predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldIsNull(FieldVodIds))
+}
+
+// VodIdsNotNil applies the NotNil predicate on the "vod_ids" field.
+func VodIdsNotNil() predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldNotNull(FieldVodIds))
+}
+
+// PlaylistIDEQ applies the EQ predicate on the "playlist_id" field.
+func PlaylistIDEQ(v uuid.UUID) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldEQ(FieldPlaylistID, v))
+}
+
+// PlaylistIDNEQ applies the NEQ predicate on the "playlist_id" field.
+func PlaylistIDNEQ(v uuid.UUID) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldNEQ(FieldPlaylistID, v))
+}
+
+// PlaylistIDIn applies the In predicate on the "playlist_id" field.
+func PlaylistIDIn(vs ...uuid.UUID) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldIn(FieldPlaylistID, vs...))
+}
+
+// PlaylistIDNotIn applies the NotIn predicate on the "playlist_id" field.
+func PlaylistIDNotIn(vs ...uuid.UUID) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldNotIn(FieldPlaylistID, vs...))
+}
+
+// PlaylistIDGT applies the GT predicate on the "playlist_id" field.
+func PlaylistIDGT(v uuid.UUID) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldGT(FieldPlaylistID, v))
+}
+
+// PlaylistIDGTE applies the GTE predicate on the "playlist_id" field.
+func PlaylistIDGTE(v uuid.UUID) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldGTE(FieldPlaylistID, v))
+}
+
+// PlaylistIDLT applies the LT predicate on the "playlist_id" field.
+func PlaylistIDLT(v uuid.UUID) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldLT(FieldPlaylistID, v))
+}
+
+// PlaylistIDLTE applies the LTE predicate on the "playlist_id" field.
+func PlaylistIDLTE(v uuid.UUID) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldLTE(FieldPlaylistID, v))
+}
+
+// PlaylistIDIsNil applies the IsNil predicate on the "playlist_id" field.
+func PlaylistIDIsNil() predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldIsNull(FieldPlaylistID))
+}
+
+// PlaylistIDNotNil applies the NotNil predicate on the "playlist_id" field.
+func PlaylistIDNotNil() predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldNotNull(FieldPlaylistID))
+}
+
+// TotalEQ applies the EQ predicate on the "total" field.
+func TotalEQ(v int) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldEQ(FieldTotal, v))
+}
+
+// TotalNEQ applies the NEQ predicate on the "total" field.
+func TotalNEQ(v int) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldNEQ(FieldTotal, v))
+}
+
+// TotalIn applies the In predicate on the "total" field.
+func TotalIn(vs ...int) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldIn(FieldTotal, vs...))
+}
+
+// TotalNotIn applies the NotIn predicate on the "total" field.
+func TotalNotIn(vs ...int) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldNotIn(FieldTotal, vs...))
+}
+
+// TotalGT applies the GT predicate on the "total" field.
+func TotalGT(v int) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldGT(FieldTotal, v))
+}
+
+// TotalGTE applies the GTE predicate on the "total" field.
+func TotalGTE(v int) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldGTE(FieldTotal, v))
+}
+
+// TotalLT applies the LT predicate on the "total" field.
+func TotalLT(v int) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldLT(FieldTotal, v))
+}
+
+// TotalLTE applies the LTE predicate on the "total" field.
+func TotalLTE(v int) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldLTE(FieldTotal, v))
+}
+
+// SucceededEQ applies the EQ predicate on the "succeeded" field.
+func SucceededEQ(v int) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldEQ(FieldSucceeded, v))
+}
+
+// SucceededNEQ applies the NEQ predicate on the "succeeded" field.
+func SucceededNEQ(v int) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldNEQ(FieldSucceeded, v))
+}
+
+// SucceededIn applies the In predicate on the "succeeded" field.
+func SucceededIn(vs ...int) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldIn(FieldSucceeded, vs...))
+}
+
+// SucceededNotIn applies the NotIn predicate on the "succeeded" field.
+func SucceededNotIn(vs ...int) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldNotIn(FieldSucceeded, vs...))
+}
+
+// SucceededGT applies the GT predicate on the "succeeded" field.
+func SucceededGT(v int) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldGT(FieldSucceeded, v))
+}
+
+// SucceededGTE applies the GTE predicate on the "succeeded" field.
+func SucceededGTE(v int) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldGTE(FieldSucceeded, v))
+}
+
+// SucceededLT applies the LT predicate on the "succeeded" field.
+func SucceededLT(v int) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldLT(FieldSucceeded, v))
+}
+
+// SucceededLTE applies the LTE predicate on the "succeeded" field.
+func SucceededLTE(v int) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldLTE(FieldSucceeded, v))
+}
+
+// FailedEQ applies the EQ predicate on the "failed" field.
+func FailedEQ(v int) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldEQ(FieldFailed, v))
+}
+
+// FailedNEQ applies the NEQ predicate on the "failed" field.
+func FailedNEQ(v int) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldNEQ(FieldFailed, v))
+}
+
+// FailedIn applies the In predicate on the "failed" field.
+func FailedIn(vs ...int) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldIn(FieldFailed, vs...))
+}
+
+// FailedNotIn applies the NotIn predicate on the "failed" field.
+func FailedNotIn(vs ...int) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldNotIn(FieldFailed, vs...))
+}
+
+// FailedGT applies the GT predicate on the "failed" field.
+func FailedGT(v int) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldGT(FieldFailed, v))
+}
+
+// FailedGTE applies the GTE predicate on the "failed" field.
+func FailedGTE(v int) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldGTE(FieldFailed, v))
+}
+
+// FailedLT applies the LT predicate on the "failed" field.
+func FailedLT(v int) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldLT(FieldFailed, v))
+}
+
+// FailedLTE applies the LTE predicate on the "failed" field.
+func FailedLTE(v int) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldLTE(FieldFailed, v))
+}
+
+// ResultsIsNil applies the IsNil predicate on the "results" field.
+func ResultsIsNil() predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldIsNull(FieldResults))
+}
+
+// ResultsNotNil applies the NotNil predicate on the "results" field.
+func ResultsNotNil() predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldNotNull(FieldResults))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorIsNil applies the IsNil predicate on the "error" field.
+func ErrorIsNil() predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldIsNull(FieldError))
+}
+
+// ErrorNotNil applies the NotNil predicate on the "error" field.
+func ErrorNotNil() predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldNotNull(FieldError))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldContainsFold(FieldError, v))
+}
+
+// JobIDEQ applies the EQ predicate on the "job_id" field.
+func JobIDEQ(v int64) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldEQ(FieldJobID, v))
+}
+
+// JobIDNEQ applies the NEQ predicate on the "job_id" field.
+func JobIDNEQ(v int64) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldNEQ(FieldJobID, v))
+}
+
+// JobIDIn applies the In predicate on the "job_id" field.
+func JobIDIn(vs ...int64) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldIn(FieldJobID, vs...))
+}
+
+// JobIDNotIn applies the NotIn predicate on the "job_id" field.
+func JobIDNotIn(vs ...int64) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldNotIn(FieldJobID, vs...))
+}
+
+// JobIDGT applies the GT predicate on the "job_id" field.
+func JobIDGT(v int64) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldGT(FieldJobID, v))
+}
+
+// JobIDGTE applies the GTE predicate on the "job_id" field.
+func JobIDGTE(v int64) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldGTE(FieldJobID, v))
+}
+
+// JobIDLT applies the LT predicate on the "job_id" field.
+func JobIDLT(v int64) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldLT(FieldJobID, v))
+}
+
+// JobIDLTE applies the LTE predicate on the "job_id" field.
+func JobIDLTE(v int64) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldLTE(FieldJobID, v))
+}
+
+// JobIDIsNil applies the IsNil predicate on the "job_id" field.
+func JobIDIsNil() predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldIsNull(FieldJobID))
+}
+
+// JobIDNotNil applies the NotNil predicate on the "job_id" field.
+func JobIDNotNil() predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldNotNull(FieldJobID))
+}
+
+// FinishedAtEQ applies the EQ predicate on the "finished_at" field.
+func FinishedAtEQ(v time.Time) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtNEQ applies the NEQ predicate on the "finished_at" field.
+func FinishedAtNEQ(v time.Time) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldNEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtIn applies the In predicate on the "finished_at" field.
+func FinishedAtIn(vs ...time.Time) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtNotIn applies the NotIn predicate on the "finished_at" field.
+func FinishedAtNotIn(vs ...time.Time) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldNotIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtGT applies the GT predicate on the "finished_at" field.
+func FinishedAtGT(v time.Time) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldGT(FieldFinishedAt, v))
+}
+
+// FinishedAtGTE applies the GTE predicate on the "finished_at" field.
+func FinishedAtGTE(v time.Time) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldGTE(FieldFinishedAt, v))
+}
+
+// FinishedAtLT applies the LT predicate on the "finished_at" field.
+func FinishedAtLT(v time.Time) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldLT(FieldFinishedAt, v))
+}
+
+// FinishedAtLTE applies the LTE predicate on the "finished_at" field.
+func FinishedAtLTE(v time.Time) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldLTE(FieldFinishedAt, v))
+}
+
+// FinishedAtIsNil applies the IsNil predicate on the "finished_at" field.
+func FinishedAtIsNil() predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldIsNull(FieldFinishedAt))
+}
+
+// FinishedAtNotNil applies the NotNil predicate on the "finished_at" field.
+func FinishedAtNotNil() predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldNotNull(FieldFinishedAt))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasCreatedBy applies the HasEdge predicate on the "created_by" edge.
+func HasCreatedBy() predicate.BulkOperation {
+	return predicate.BulkOperation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CreatedByTable, CreatedByColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCreatedByWith applies the HasEdge predicate on the "created_by" edge with a given conditions (other predicates).
+func HasCreatedByWith(preds ...predicate.User) predicate.BulkOperation {
+	return predicate.BulkOperation(func(s *sql.Selector) {
+		step := newCreatedByStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BulkOperation) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BulkOperation) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BulkOperation) predicate.BulkOperation {
+	return predicate.BulkOperation(sql.NotPredicates(p))
+}
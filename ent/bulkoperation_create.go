@@ -0,0 +1,522 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/bulkoperation"
+	"github.com/zibbp/ganymede/ent/user"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// BulkOperationCreate is the builder for creating a BulkOperation entity.
+type BulkOperationCreate struct {
+	config
+	mutation *BulkOperationMutation
+	hooks    []Hook
+}
+
+// SetAction sets the "action" field.
+func (_c *BulkOperationCreate) SetAction(v utils.BulkAction) *BulkOperationCreate {
+	_c.mutation.SetAction(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *BulkOperationCreate) SetStatus(v utils.TaskStatus) *BulkOperationCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *BulkOperationCreate) SetNillableStatus(v *utils.TaskStatus) *BulkOperationCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetFilter sets the "filter" field.
+func (_c *BulkOperationCreate) SetFilter(v utils.BulkVodFilter) *BulkOperationCreate {
+	_c.mutation.SetFilter(v)
+	return _c
+}
+
+// SetNillableFilter sets the "filter" field if the given value is not nil.
+func (_c *BulkOperationCreate) SetNillableFilter(v *utils.BulkVodFilter) *BulkOperationCreate {
+	if v != nil {
+		_c.SetFilter(*v)
+	}
+	return _c
+}
+
+// SetVodIds sets the "vod_ids" field.
+func (_c *BulkOperationCreate) SetVodIds(v []uuid.UUID) *BulkOperationCreate {
+	_c.mutation.SetVodIds(v)
+	return _c
+}
+
+// SetPlaylistID sets the "playlist_id" field.
+func (_c *BulkOperationCreate) SetPlaylistID(v uuid.UUID) *BulkOperationCreate {
+	_c.mutation.SetPlaylistID(v)
+	return _c
+}
+
+// SetNillablePlaylistID sets the "playlist_id" field if the given value is not nil.
+func (_c *BulkOperationCreate) SetNillablePlaylistID(v *uuid.UUID) *BulkOperationCreate {
+	if v != nil {
+		_c.SetPlaylistID(*v)
+	}
+	return _c
+}
+
+// SetTotal sets the "total" field.
+func (_c *BulkOperationCreate) SetTotal(v int) *BulkOperationCreate {
+	_c.mutation.SetTotal(v)
+	return _c
+}
+
+// SetNillableTotal sets the "total" field if the given value is not nil.
+func (_c *BulkOperationCreate) SetNillableTotal(v *int) *BulkOperationCreate {
+	if v != nil {
+		_c.SetTotal(*v)
+	}
+	return _c
+}
+
+// SetSucceeded sets the "succeeded" field.
+func (_c *BulkOperationCreate) SetSucceeded(v int) *BulkOperationCreate {
+	_c.mutation.SetSucceeded(v)
+	return _c
+}
+
+// SetNillableSucceeded sets the "succeeded" field if the given value is not nil.
+func (_c *BulkOperationCreate) SetNillableSucceeded(v *int) *BulkOperationCreate {
+	if v != nil {
+		_c.SetSucceeded(*v)
+	}
+	return _c
+}
+
+// SetFailed sets the "failed" field.
+func (_c *BulkOperationCreate) SetFailed(v int) *BulkOperationCreate {
+	_c.mutation.SetFailed(v)
+	return _c
+}
+
+// SetNillableFailed sets the "failed" field if the given value is not nil.
+func (_c *BulkOperationCreate) SetNillableFailed(v *int) *BulkOperationCreate {
+	if v != nil {
+		_c.SetFailed(*v)
+	}
+	return _c
+}
+
+// SetResults sets the "results" field.
+func (_c *BulkOperationCreate) SetResults(v []utils.BulkItemResult) *BulkOperationCreate {
+	_c.mutation.SetResults(v)
+	return _c
+}
+
+// SetError sets the "error" field.
+func (_c *BulkOperationCreate) SetError(v string) *BulkOperationCreate {
+	_c.mutation.SetError(v)
+	return _c
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_c *BulkOperationCreate) SetNillableError(v *string) *BulkOperationCreate {
+	if v != nil {
+		_c.SetError(*v)
+	}
+	return _c
+}
+
+// SetJobID sets the "job_id" field.
+func (_c *BulkOperationCreate) SetJobID(v int64) *BulkOperationCreate {
+	_c.mutation.SetJobID(v)
+	return _c
+}
+
+// SetNillableJobID sets the "job_id" field if the given value is not nil.
+func (_c *BulkOperationCreate) SetNillableJobID(v *int64) *BulkOperationCreate {
+	if v != nil {
+		_c.SetJobID(*v)
+	}
+	return _c
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_c *BulkOperationCreate) SetFinishedAt(v time.Time) *BulkOperationCreate {
+	_c.mutation.SetFinishedAt(v)
+	return _c
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_c *BulkOperationCreate) SetNillableFinishedAt(v *time.Time) *BulkOperationCreate {
+	if v != nil {
+		_c.SetFinishedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *BulkOperationCreate) SetUpdatedAt(v time.Time) *BulkOperationCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *BulkOperationCreate) SetNillableUpdatedAt(v *time.Time) *BulkOperationCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *BulkOperationCreate) SetCreatedAt(v time.Time) *BulkOperationCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *BulkOperationCreate) SetNillableCreatedAt(v *time.Time) *BulkOperationCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BulkOperationCreate) SetID(v uuid.UUID) *BulkOperationCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *BulkOperationCreate) SetNillableID(v *uuid.UUID) *BulkOperationCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetCreatedByID sets the "created_by" edge to the User entity by ID.
+func (_c *BulkOperationCreate) SetCreatedByID(id uuid.UUID) *BulkOperationCreate {
+	_c.mutation.SetCreatedByID(id)
+	return _c
+}
+
+// SetNillableCreatedByID sets the "created_by" edge to the User entity by ID if the given value is not nil.
+func (_c *BulkOperationCreate) SetNillableCreatedByID(id *uuid.UUID) *BulkOperationCreate {
+	if id != nil {
+		_c = _c.SetCreatedByID(*id)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" edge to the User entity.
+func (_c *BulkOperationCreate) SetCreatedBy(v *User) *BulkOperationCreate {
+	return _c.SetCreatedByID(v.ID)
+}
+
+// Mutation returns the BulkOperationMutation object of the builder.
+func (_c *BulkOperationCreate) Mutation() *BulkOperationMutation {
+	return _c.mutation
+}
+
+// Save creates the BulkOperation in the database.
+func (_c *BulkOperationCreate) Save(ctx context.Context) (*BulkOperation, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BulkOperationCreate) SaveX(ctx context.Context) *BulkOperation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BulkOperationCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BulkOperationCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BulkOperationCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := bulkoperation.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.Total(); !ok {
+		v := bulkoperation.DefaultTotal
+		_c.mutation.SetTotal(v)
+	}
+	if _, ok := _c.mutation.Succeeded(); !ok {
+		v := bulkoperation.DefaultSucceeded
+		_c.mutation.SetSucceeded(v)
+	}
+	if _, ok := _c.mutation.Failed(); !ok {
+		v := bulkoperation.DefaultFailed
+		_c.mutation.SetFailed(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := bulkoperation.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := bulkoperation.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := bulkoperation.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BulkOperationCreate) check() error {
+	if _, ok := _c.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "BulkOperation.action"`)}
+	}
+	if v, ok := _c.mutation.Action(); ok {
+		if err := bulkoperation.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "BulkOperation.action": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "BulkOperation.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := bulkoperation.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "BulkOperation.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Total(); !ok {
+		return &ValidationError{Name: "total", err: errors.New(`ent: missing required field "BulkOperation.total"`)}
+	}
+	if _, ok := _c.mutation.Succeeded(); !ok {
+		return &ValidationError{Name: "succeeded", err: errors.New(`ent: missing required field "BulkOperation.succeeded"`)}
+	}
+	if _, ok := _c.mutation.Failed(); !ok {
+		return &ValidationError{Name: "failed", err: errors.New(`ent: missing required field "BulkOperation.failed"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "BulkOperation.updated_at"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "BulkOperation.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *BulkOperationCreate) sqlSave(ctx context.Context) (*BulkOperation, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BulkOperationCreate) createSpec() (*BulkOperation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BulkOperation{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bulkoperation.Table, sqlgraph.NewFieldSpec(bulkoperation.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Action(); ok {
+		_spec.SetField(bulkoperation.FieldAction, field.TypeEnum, value)
+		_node.Action = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(bulkoperation.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Filter(); ok {
+		_spec.SetField(bulkoperation.FieldFilter, field.TypeJSON, value)
+		_node.Filter = value
+	}
+	if value, ok := _c.mutation.VodIds(); ok {
+		_spec.SetField(bulkoperation.FieldVodIds, field.TypeJSON, value)
+		_node.VodIds = value
+	}
+	if value, ok := _c.mutation.PlaylistID(); ok {
+		_spec.SetField(bulkoperation.FieldPlaylistID, field.TypeUUID, value)
+		_node.PlaylistID = &value
+	}
+	if value, ok := _c.mutation.Total(); ok {
+		_spec.SetField(bulkoperation.FieldTotal, field.TypeInt, value)
+		_node.Total = value
+	}
+	if value, ok := _c.mutation.Succeeded(); ok {
+		_spec.SetField(bulkoperation.FieldSucceeded, field.TypeInt, value)
+		_node.Succeeded = value
+	}
+	if value, ok := _c.mutation.Failed(); ok {
+		_spec.SetField(bulkoperation.FieldFailed, field.TypeInt, value)
+		_node.Failed = value
+	}
+	if value, ok := _c.mutation.Results(); ok {
+		_spec.SetField(bulkoperation.FieldResults, field.TypeJSON, value)
+		_node.Results = value
+	}
+	if value, ok := _c.mutation.Error(); ok {
+		_spec.SetField(bulkoperation.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := _c.mutation.JobID(); ok {
+		_spec.SetField(bulkoperation.FieldJobID, field.TypeInt64, value)
+		_node.JobID = value
+	}
+	if value, ok := _c.mutation.FinishedAt(); ok {
+		_spec.SetField(bulkoperation.FieldFinishedAt, field.TypeTime, value)
+		_node.FinishedAt = &value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(bulkoperation.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(bulkoperation.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.CreatedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bulkoperation.CreatedByTable,
+			Columns: []string{bulkoperation.CreatedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_bulk_operations = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// BulkOperationCreateBulk is the builder for creating many BulkOperation entities in bulk.
+type BulkOperationCreateBulk struct {
+	config
+	err      error
+	builders []*BulkOperationCreate
+}
+
+// Save creates the BulkOperation entities in the database.
+func (_c *BulkOperationCreateBulk) Save(ctx context.Context) ([]*BulkOperation, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BulkOperation, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BulkOperationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BulkOperationCreateBulk) SaveX(ctx context.Context) []*BulkOperation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BulkOperationCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BulkOperationCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,986 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/bulkoperation"
+	"github.com/zibbp/ganymede/ent/predicate"
+	"github.com/zibbp/ganymede/ent/user"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// BulkOperationUpdate is the builder for updating BulkOperation entities.
+type BulkOperationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BulkOperationMutation
+}
+
+// Where appends a list predicates to the BulkOperationUpdate builder.
+func (_u *BulkOperationUpdate) Where(ps ...predicate.BulkOperation) *BulkOperationUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetAction sets the "action" field.
+func (_u *BulkOperationUpdate) SetAction(v utils.BulkAction) *BulkOperationUpdate {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *BulkOperationUpdate) SetNillableAction(v *utils.BulkAction) *BulkOperationUpdate {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *BulkOperationUpdate) SetStatus(v utils.TaskStatus) *BulkOperationUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *BulkOperationUpdate) SetNillableStatus(v *utils.TaskStatus) *BulkOperationUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetFilter sets the "filter" field.
+func (_u *BulkOperationUpdate) SetFilter(v utils.BulkVodFilter) *BulkOperationUpdate {
+	_u.mutation.SetFilter(v)
+	return _u
+}
+
+// SetNillableFilter sets the "filter" field if the given value is not nil.
+func (_u *BulkOperationUpdate) SetNillableFilter(v *utils.BulkVodFilter) *BulkOperationUpdate {
+	if v != nil {
+		_u.SetFilter(*v)
+	}
+	return _u
+}
+
+// ClearFilter clears the value of the "filter" field.
+func (_u *BulkOperationUpdate) ClearFilter() *BulkOperationUpdate {
+	_u.mutation.ClearFilter()
+	return _u
+}
+
+// SetVodIds sets the "vod_ids" field.
+func (_u *BulkOperationUpdate) SetVodIds(v []uuid.UUID) *BulkOperationUpdate {
+	_u.mutation.SetVodIds(v)
+	return _u
+}
+
+// AppendVodIds appends value to the "vod_ids" field.
+func (_u *BulkOperationUpdate) AppendVodIds(v []uuid.UUID) *BulkOperationUpdate {
+	_u.mutation.AppendVodIds(v)
+	return _u
+}
+
+// ClearVodIds clears the value of the "vod_ids" field.
+func (_u *BulkOperationUpdate) ClearVodIds() *BulkOperationUpdate {
+	_u.mutation.ClearVodIds()
+	return _u
+}
+
+// SetPlaylistID sets the "playlist_id" field.
+func (_u *BulkOperationUpdate) SetPlaylistID(v uuid.UUID) *BulkOperationUpdate {
+	_u.mutation.SetPlaylistID(v)
+	return _u
+}
+
+// SetNillablePlaylistID sets the "playlist_id" field if the given value is not nil.
+func (_u *BulkOperationUpdate) SetNillablePlaylistID(v *uuid.UUID) *BulkOperationUpdate {
+	if v != nil {
+		_u.SetPlaylistID(*v)
+	}
+	return _u
+}
+
+// ClearPlaylistID clears the value of the "playlist_id" field.
+func (_u *BulkOperationUpdate) ClearPlaylistID() *BulkOperationUpdate {
+	_u.mutation.ClearPlaylistID()
+	return _u
+}
+
+// SetTotal sets the "total" field.
+func (_u *BulkOperationUpdate) SetTotal(v int) *BulkOperationUpdate {
+	_u.mutation.ResetTotal()
+	_u.mutation.SetTotal(v)
+	return _u
+}
+
+// SetNillableTotal sets the "total" field if the given value is not nil.
+func (_u *BulkOperationUpdate) SetNillableTotal(v *int) *BulkOperationUpdate {
+	if v != nil {
+		_u.SetTotal(*v)
+	}
+	return _u
+}
+
+// AddTotal adds value to the "total" field.
+func (_u *BulkOperationUpdate) AddTotal(v int) *BulkOperationUpdate {
+	_u.mutation.AddTotal(v)
+	return _u
+}
+
+// SetSucceeded sets the "succeeded" field.
+func (_u *BulkOperationUpdate) SetSucceeded(v int) *BulkOperationUpdate {
+	_u.mutation.ResetSucceeded()
+	_u.mutation.SetSucceeded(v)
+	return _u
+}
+
+// SetNillableSucceeded sets the "succeeded" field if the given value is not nil.
+func (_u *BulkOperationUpdate) SetNillableSucceeded(v *int) *BulkOperationUpdate {
+	if v != nil {
+		_u.SetSucceeded(*v)
+	}
+	return _u
+}
+
+// AddSucceeded adds value to the "succeeded" field.
+func (_u *BulkOperationUpdate) AddSucceeded(v int) *BulkOperationUpdate {
+	_u.mutation.AddSucceeded(v)
+	return _u
+}
+
+// SetFailed sets the "failed" field.
+func (_u *BulkOperationUpdate) SetFailed(v int) *BulkOperationUpdate {
+	_u.mutation.ResetFailed()
+	_u.mutation.SetFailed(v)
+	return _u
+}
+
+// SetNillableFailed sets the "failed" field if the given value is not nil.
+func (_u *BulkOperationUpdate) SetNillableFailed(v *int) *BulkOperationUpdate {
+	if v != nil {
+		_u.SetFailed(*v)
+	}
+	return _u
+}
+
+// AddFailed adds value to the "failed" field.
+func (_u *BulkOperationUpdate) AddFailed(v int) *BulkOperationUpdate {
+	_u.mutation.AddFailed(v)
+	return _u
+}
+
+// SetResults sets the "results" field.
+func (_u *BulkOperationUpdate) SetResults(v []utils.BulkItemResult) *BulkOperationUpdate {
+	_u.mutation.SetResults(v)
+	return _u
+}
+
+// AppendResults appends value to the "results" field.
+func (_u *BulkOperationUpdate) AppendResults(v []utils.BulkItemResult) *BulkOperationUpdate {
+	_u.mutation.AppendResults(v)
+	return _u
+}
+
+// ClearResults clears the value of the "results" field.
+func (_u *BulkOperationUpdate) ClearResults() *BulkOperationUpdate {
+	_u.mutation.ClearResults()
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *BulkOperationUpdate) SetError(v string) *BulkOperationUpdate {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *BulkOperationUpdate) SetNillableError(v *string) *BulkOperationUpdate {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *BulkOperationUpdate) ClearError() *BulkOperationUpdate {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetJobID sets the "job_id" field.
+func (_u *BulkOperationUpdate) SetJobID(v int64) *BulkOperationUpdate {
+	_u.mutation.ResetJobID()
+	_u.mutation.SetJobID(v)
+	return _u
+}
+
+// SetNillableJobID sets the "job_id" field if the given value is not nil.
+func (_u *BulkOperationUpdate) SetNillableJobID(v *int64) *BulkOperationUpdate {
+	if v != nil {
+		_u.SetJobID(*v)
+	}
+	return _u
+}
+
+// AddJobID adds value to the "job_id" field.
+func (_u *BulkOperationUpdate) AddJobID(v int64) *BulkOperationUpdate {
+	_u.mutation.AddJobID(v)
+	return _u
+}
+
+// ClearJobID clears the value of the "job_id" field.
+func (_u *BulkOperationUpdate) ClearJobID() *BulkOperationUpdate {
+	_u.mutation.ClearJobID()
+	return _u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_u *BulkOperationUpdate) SetFinishedAt(v time.Time) *BulkOperationUpdate {
+	_u.mutation.SetFinishedAt(v)
+	return _u
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_u *BulkOperationUpdate) SetNillableFinishedAt(v *time.Time) *BulkOperationUpdate {
+	if v != nil {
+		_u.SetFinishedAt(*v)
+	}
+	return _u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (_u *BulkOperationUpdate) ClearFinishedAt() *BulkOperationUpdate {
+	_u.mutation.ClearFinishedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *BulkOperationUpdate) SetUpdatedAt(v time.Time) *BulkOperationUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetCreatedByID sets the "created_by" edge to the User entity by ID.
+func (_u *BulkOperationUpdate) SetCreatedByID(id uuid.UUID) *BulkOperationUpdate {
+	_u.mutation.SetCreatedByID(id)
+	return _u
+}
+
+// SetNillableCreatedByID sets the "created_by" edge to the User entity by ID if the given value is not nil.
+func (_u *BulkOperationUpdate) SetNillableCreatedByID(id *uuid.UUID) *BulkOperationUpdate {
+	if id != nil {
+		_u = _u.SetCreatedByID(*id)
+	}
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" edge to the User entity.
+func (_u *BulkOperationUpdate) SetCreatedBy(v *User) *BulkOperationUpdate {
+	return _u.SetCreatedByID(v.ID)
+}
+
+// Mutation returns the BulkOperationMutation object of the builder.
+func (_u *BulkOperationUpdate) Mutation() *BulkOperationMutation {
+	return _u.mutation
+}
+
+// ClearCreatedBy clears the "created_by" edge to the User entity.
+func (_u *BulkOperationUpdate) ClearCreatedBy() *BulkOperationUpdate {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BulkOperationUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BulkOperationUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BulkOperationUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BulkOperationUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *BulkOperationUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := bulkoperation.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BulkOperationUpdate) check() error {
+	if v, ok := _u.mutation.Action(); ok {
+		if err := bulkoperation.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "BulkOperation.action": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := bulkoperation.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "BulkOperation.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BulkOperationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bulkoperation.Table, bulkoperation.Columns, sqlgraph.NewFieldSpec(bulkoperation.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(bulkoperation.FieldAction, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(bulkoperation.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Filter(); ok {
+		_spec.SetField(bulkoperation.FieldFilter, field.TypeJSON, value)
+	}
+	if _u.mutation.FilterCleared() {
+		_spec.ClearField(bulkoperation.FieldFilter, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.VodIds(); ok {
+		_spec.SetField(bulkoperation.FieldVodIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedVodIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bulkoperation.FieldVodIds, value)
+		})
+	}
+	if _u.mutation.VodIdsCleared() {
+		_spec.ClearField(bulkoperation.FieldVodIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.PlaylistID(); ok {
+		_spec.SetField(bulkoperation.FieldPlaylistID, field.TypeUUID, value)
+	}
+	if _u.mutation.PlaylistIDCleared() {
+		_spec.ClearField(bulkoperation.FieldPlaylistID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.Total(); ok {
+		_spec.SetField(bulkoperation.FieldTotal, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTotal(); ok {
+		_spec.AddField(bulkoperation.FieldTotal, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Succeeded(); ok {
+		_spec.SetField(bulkoperation.FieldSucceeded, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSucceeded(); ok {
+		_spec.AddField(bulkoperation.FieldSucceeded, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Failed(); ok {
+		_spec.SetField(bulkoperation.FieldFailed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFailed(); ok {
+		_spec.AddField(bulkoperation.FieldFailed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Results(); ok {
+		_spec.SetField(bulkoperation.FieldResults, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedResults(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bulkoperation.FieldResults, value)
+		})
+	}
+	if _u.mutation.ResultsCleared() {
+		_spec.ClearField(bulkoperation.FieldResults, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(bulkoperation.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(bulkoperation.FieldError, field.TypeString)
+	}
+	if value, ok := _u.mutation.JobID(); ok {
+		_spec.SetField(bulkoperation.FieldJobID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedJobID(); ok {
+		_spec.AddField(bulkoperation.FieldJobID, field.TypeInt64, value)
+	}
+	if _u.mutation.JobIDCleared() {
+		_spec.ClearField(bulkoperation.FieldJobID, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.FinishedAt(); ok {
+		_spec.SetField(bulkoperation.FieldFinishedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FinishedAtCleared() {
+		_spec.ClearField(bulkoperation.FieldFinishedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(bulkoperation.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bulkoperation.CreatedByTable,
+			Columns: []string{bulkoperation.CreatedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CreatedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bulkoperation.CreatedByTable,
+			Columns: []string{bulkoperation.CreatedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bulkoperation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BulkOperationUpdateOne is the builder for updating a single BulkOperation entity.
+type BulkOperationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BulkOperationMutation
+}
+
+// SetAction sets the "action" field.
+func (_u *BulkOperationUpdateOne) SetAction(v utils.BulkAction) *BulkOperationUpdateOne {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *BulkOperationUpdateOne) SetNillableAction(v *utils.BulkAction) *BulkOperationUpdateOne {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *BulkOperationUpdateOne) SetStatus(v utils.TaskStatus) *BulkOperationUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *BulkOperationUpdateOne) SetNillableStatus(v *utils.TaskStatus) *BulkOperationUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetFilter sets the "filter" field.
+func (_u *BulkOperationUpdateOne) SetFilter(v utils.BulkVodFilter) *BulkOperationUpdateOne {
+	_u.mutation.SetFilter(v)
+	return _u
+}
+
+// SetNillableFilter sets the "filter" field if the given value is not nil.
+func (_u *BulkOperationUpdateOne) SetNillableFilter(v *utils.BulkVodFilter) *BulkOperationUpdateOne {
+	if v != nil {
+		_u.SetFilter(*v)
+	}
+	return _u
+}
+
+// ClearFilter clears the value of the "filter" field.
+func (_u *BulkOperationUpdateOne) ClearFilter() *BulkOperationUpdateOne {
+	_u.mutation.ClearFilter()
+	return _u
+}
+
+// SetVodIds sets the "vod_ids" field.
+func (_u *BulkOperationUpdateOne) SetVodIds(v []uuid.UUID) *BulkOperationUpdateOne {
+	_u.mutation.SetVodIds(v)
+	return _u
+}
+
+// AppendVodIds appends value to the "vod_ids" field.
+func (_u *BulkOperationUpdateOne) AppendVodIds(v []uuid.UUID) *BulkOperationUpdateOne {
+	_u.mutation.AppendVodIds(v)
+	return _u
+}
+
+// ClearVodIds clears the value of the "vod_ids" field.
+func (_u *BulkOperationUpdateOne) ClearVodIds() *BulkOperationUpdateOne {
+	_u.mutation.ClearVodIds()
+	return _u
+}
+
+// SetPlaylistID sets the "playlist_id" field.
+func (_u *BulkOperationUpdateOne) SetPlaylistID(v uuid.UUID) *BulkOperationUpdateOne {
+	_u.mutation.SetPlaylistID(v)
+	return _u
+}
+
+// SetNillablePlaylistID sets the "playlist_id" field if the given value is not nil.
+func (_u *BulkOperationUpdateOne) SetNillablePlaylistID(v *uuid.UUID) *BulkOperationUpdateOne {
+	if v != nil {
+		_u.SetPlaylistID(*v)
+	}
+	return _u
+}
+
+// ClearPlaylistID clears the value of the "playlist_id" field.
+func (_u *BulkOperationUpdateOne) ClearPlaylistID() *BulkOperationUpdateOne {
+	_u.mutation.ClearPlaylistID()
+	return _u
+}
+
+// SetTotal sets the "total" field.
+func (_u *BulkOperationUpdateOne) SetTotal(v int) *BulkOperationUpdateOne {
+	_u.mutation.ResetTotal()
+	_u.mutation.SetTotal(v)
+	return _u
+}
+
+// SetNillableTotal sets the "total" field if the given value is not nil.
+func (_u *BulkOperationUpdateOne) SetNillableTotal(v *int) *BulkOperationUpdateOne {
+	if v != nil {
+		_u.SetTotal(*v)
+	}
+	return _u
+}
+
+// AddTotal adds value to the "total" field.
+func (_u *BulkOperationUpdateOne) AddTotal(v int) *BulkOperationUpdateOne {
+	_u.mutation.AddTotal(v)
+	return _u
+}
+
+// SetSucceeded sets the "succeeded" field.
+func (_u *BulkOperationUpdateOne) SetSucceeded(v int) *BulkOperationUpdateOne {
+	_u.mutation.ResetSucceeded()
+	_u.mutation.SetSucceeded(v)
+	return _u
+}
+
+// SetNillableSucceeded sets the "succeeded" field if the given value is not nil.
+func (_u *BulkOperationUpdateOne) SetNillableSucceeded(v *int) *BulkOperationUpdateOne {
+	if v != nil {
+		_u.SetSucceeded(*v)
+	}
+	return _u
+}
+
+// AddSucceeded adds value to the "succeeded" field.
+func (_u *BulkOperationUpdateOne) AddSucceeded(v int) *BulkOperationUpdateOne {
+	_u.mutation.AddSucceeded(v)
+	return _u
+}
+
+// SetFailed sets the "failed" field.
+func (_u *BulkOperationUpdateOne) SetFailed(v int) *BulkOperationUpdateOne {
+	_u.mutation.ResetFailed()
+	_u.mutation.SetFailed(v)
+	return _u
+}
+
+// SetNillableFailed sets the "failed" field if the given value is not nil.
+func (_u *BulkOperationUpdateOne) SetNillableFailed(v *int) *BulkOperationUpdateOne {
+	if v != nil {
+		_u.SetFailed(*v)
+	}
+	return _u
+}
+
+// AddFailed adds value to the "failed" field.
+func (_u *BulkOperationUpdateOne) AddFailed(v int) *BulkOperationUpdateOne {
+	_u.mutation.AddFailed(v)
+	return _u
+}
+
+// SetResults sets the "results" field.
+func (_u *BulkOperationUpdateOne) SetResults(v []utils.BulkItemResult) *BulkOperationUpdateOne {
+	_u.mutation.SetResults(v)
+	return _u
+}
+
+// AppendResults appends value to the "results" field.
+func (_u *BulkOperationUpdateOne) AppendResults(v []utils.BulkItemResult) *BulkOperationUpdateOne {
+	_u.mutation.AppendResults(v)
+	return _u
+}
+
+// ClearResults clears the value of the "results" field.
+func (_u *BulkOperationUpdateOne) ClearResults() *BulkOperationUpdateOne {
+	_u.mutation.ClearResults()
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *BulkOperationUpdateOne) SetError(v string) *BulkOperationUpdateOne {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *BulkOperationUpdateOne) SetNillableError(v *string) *BulkOperationUpdateOne {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *BulkOperationUpdateOne) ClearError() *BulkOperationUpdateOne {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetJobID sets the "job_id" field.
+func (_u *BulkOperationUpdateOne) SetJobID(v int64) *BulkOperationUpdateOne {
+	_u.mutation.ResetJobID()
+	_u.mutation.SetJobID(v)
+	return _u
+}
+
+// SetNillableJobID sets the "job_id" field if the given value is not nil.
+func (_u *BulkOperationUpdateOne) SetNillableJobID(v *int64) *BulkOperationUpdateOne {
+	if v != nil {
+		_u.SetJobID(*v)
+	}
+	return _u
+}
+
+// AddJobID adds value to the "job_id" field.
+func (_u *BulkOperationUpdateOne) AddJobID(v int64) *BulkOperationUpdateOne {
+	_u.mutation.AddJobID(v)
+	return _u
+}
+
+// ClearJobID clears the value of the "job_id" field.
+func (_u *BulkOperationUpdateOne) ClearJobID() *BulkOperationUpdateOne {
+	_u.mutation.ClearJobID()
+	return _u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_u *BulkOperationUpdateOne) SetFinishedAt(v time.Time) *BulkOperationUpdateOne {
+	_u.mutation.SetFinishedAt(v)
+	return _u
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_u *BulkOperationUpdateOne) SetNillableFinishedAt(v *time.Time) *BulkOperationUpdateOne {
+	if v != nil {
+		_u.SetFinishedAt(*v)
+	}
+	return _u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (_u *BulkOperationUpdateOne) ClearFinishedAt() *BulkOperationUpdateOne {
+	_u.mutation.ClearFinishedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *BulkOperationUpdateOne) SetUpdatedAt(v time.Time) *BulkOperationUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetCreatedByID sets the "created_by" edge to the User entity by ID.
+func (_u *BulkOperationUpdateOne) SetCreatedByID(id uuid.UUID) *BulkOperationUpdateOne {
+	_u.mutation.SetCreatedByID(id)
+	return _u
+}
+
+// SetNillableCreatedByID sets the "created_by" edge to the User entity by ID if the given value is not nil.
+func (_u *BulkOperationUpdateOne) SetNillableCreatedByID(id *uuid.UUID) *BulkOperationUpdateOne {
+	if id != nil {
+		_u = _u.SetCreatedByID(*id)
+	}
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" edge to the User entity.
+func (_u *BulkOperationUpdateOne) SetCreatedBy(v *User) *BulkOperationUpdateOne {
+	return _u.SetCreatedByID(v.ID)
+}
+
+// Mutation returns the BulkOperationMutation object of the builder.
+func (_u *BulkOperationUpdateOne) Mutation() *BulkOperationMutation {
+	return _u.mutation
+}
+
+// ClearCreatedBy clears the "created_by" edge to the User entity.
+func (_u *BulkOperationUpdateOne) ClearCreatedBy() *BulkOperationUpdateOne {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// Where appends a list predicates to the BulkOperationUpdate builder.
+func (_u *BulkOperationUpdateOne) Where(ps ...predicate.BulkOperation) *BulkOperationUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BulkOperationUpdateOne) Select(field string, fields ...string) *BulkOperationUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BulkOperation entity.
+func (_u *BulkOperationUpdateOne) Save(ctx context.Context) (*BulkOperation, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BulkOperationUpdateOne) SaveX(ctx context.Context) *BulkOperation {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BulkOperationUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BulkOperationUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *BulkOperationUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := bulkoperation.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BulkOperationUpdateOne) check() error {
+	if v, ok := _u.mutation.Action(); ok {
+		if err := bulkoperation.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "BulkOperation.action": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := bulkoperation.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "BulkOperation.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BulkOperationUpdateOne) sqlSave(ctx context.Context) (_node *BulkOperation, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bulkoperation.Table, bulkoperation.Columns, sqlgraph.NewFieldSpec(bulkoperation.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "BulkOperation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bulkoperation.FieldID)
+		for _, f := range fields {
+			if !bulkoperation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != bulkoperation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(bulkoperation.FieldAction, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(bulkoperation.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Filter(); ok {
+		_spec.SetField(bulkoperation.FieldFilter, field.TypeJSON, value)
+	}
+	if _u.mutation.FilterCleared() {
+		_spec.ClearField(bulkoperation.FieldFilter, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.VodIds(); ok {
+		_spec.SetField(bulkoperation.FieldVodIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedVodIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bulkoperation.FieldVodIds, value)
+		})
+	}
+	if _u.mutation.VodIdsCleared() {
+		_spec.ClearField(bulkoperation.FieldVodIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.PlaylistID(); ok {
+		_spec.SetField(bulkoperation.FieldPlaylistID, field.TypeUUID, value)
+	}
+	if _u.mutation.PlaylistIDCleared() {
+		_spec.ClearField(bulkoperation.FieldPlaylistID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.Total(); ok {
+		_spec.SetField(bulkoperation.FieldTotal, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTotal(); ok {
+		_spec.AddField(bulkoperation.FieldTotal, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Succeeded(); ok {
+		_spec.SetField(bulkoperation.FieldSucceeded, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSucceeded(); ok {
+		_spec.AddField(bulkoperation.FieldSucceeded, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Failed(); ok {
+		_spec.SetField(bulkoperation.FieldFailed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFailed(); ok {
+		_spec.AddField(bulkoperation.FieldFailed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Results(); ok {
+		_spec.SetField(bulkoperation.FieldResults, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedResults(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bulkoperation.FieldResults, value)
+		})
+	}
+	if _u.mutation.ResultsCleared() {
+		_spec.ClearField(bulkoperation.FieldResults, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(bulkoperation.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(bulkoperation.FieldError, field.TypeString)
+	}
+	if value, ok := _u.mutation.JobID(); ok {
+		_spec.SetField(bulkoperation.FieldJobID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedJobID(); ok {
+		_spec.AddField(bulkoperation.FieldJobID, field.TypeInt64, value)
+	}
+	if _u.mutation.JobIDCleared() {
+		_spec.ClearField(bulkoperation.FieldJobID, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.FinishedAt(); ok {
+		_spec.SetField(bulkoperation.FieldFinishedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FinishedAtCleared() {
+		_spec.ClearField(bulkoperation.FieldFinishedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(bulkoperation.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bulkoperation.CreatedByTable,
+			Columns: []string{bulkoperation.CreatedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CreatedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bulkoperation.CreatedByTable,
+			Columns: []string{bulkoperation.CreatedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &BulkOperation{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bulkoperation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
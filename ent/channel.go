@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -12,6 +13,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/zibbp/ganymede/ent/channel"
 	"github.com/zibbp/ganymede/ent/youtubeconfig"
+	"github.com/zibbp/ganymede/internal/utils"
 )
 
 // Channel is the model entity for the Channel schema.
@@ -31,8 +33,14 @@ type Channel struct {
 	Retention bool `json:"retention,omitempty"`
 	// RetentionDays holds the value of the "retention_days" field.
 	RetentionDays int64 `json:"retention_days,omitempty"`
+	// Visibility holds the value of the "visibility" field.
+	Visibility utils.Visibility `json:"visibility,omitempty"`
+	// User groups that can view the channel if the visibility is restricted.
+	AllowedGroups []string `json:"allowed_groups,omitempty"`
 	// Total storage size in bytes for the channel's videos.
 	StorageSizeBytes int64 `json:"storage_size_bytes,omitempty"`
+	// The time the channel was moved to the trash with its VODs.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
@@ -51,9 +59,13 @@ type ChannelEdges struct {
 	Live []*Live `json:"live,omitempty"`
 	// YoutubeConfig holds the value of the youtube_config edge.
 	YoutubeConfig *YoutubeConfig `json:"youtube_config,omitempty"`
+	// NotificationSubscriptions holds the value of the notification_subscriptions edge.
+	NotificationSubscriptions []*NotificationSubscription `json:"notification_subscriptions,omitempty"`
+	// Users that can view the channel if the visibility is restricted.
+	AllowedUsers []*User `json:"allowed_users,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [5]bool
 }
 
 // VodsOrErr returns the Vods value or an error if the edge
@@ -85,18 +97,38 @@ func (e ChannelEdges) YoutubeConfigOrErr() (*YoutubeConfig, error) {
 	return nil, &NotLoadedError{edge: "youtube_config"}
 }
 
+// NotificationSubscriptionsOrErr returns the NotificationSubscriptions value or an error if the edge
+// was not loaded in eager-loading.
+func (e ChannelEdges) NotificationSubscriptionsOrErr() ([]*NotificationSubscription, error) {
+	if e.loadedTypes[3] {
+		return e.NotificationSubscriptions, nil
+	}
+	return nil, &NotLoadedError{edge: "notification_subscriptions"}
+}
+
+// AllowedUsersOrErr returns the AllowedUsers value or an error if the edge
+// was not loaded in eager-loading.
+func (e ChannelEdges) AllowedUsersOrErr() ([]*User, error) {
+	if e.loadedTypes[4] {
+		return e.AllowedUsers, nil
+	}
+	return nil, &NotLoadedError{edge: "allowed_users"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Channel) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case channel.FieldAllowedGroups:
+			values[i] = new([]byte)
 		case channel.FieldRetention:
 			values[i] = new(sql.NullBool)
 		case channel.FieldRetentionDays, channel.FieldStorageSizeBytes:
 			values[i] = new(sql.NullInt64)
-		case channel.FieldExtID, channel.FieldName, channel.FieldDisplayName, channel.FieldImagePath:
+		case channel.FieldExtID, channel.FieldName, channel.FieldDisplayName, channel.FieldImagePath, channel.FieldVisibility:
 			values[i] = new(sql.NullString)
-		case channel.FieldUpdatedAt, channel.FieldCreatedAt:
+		case channel.FieldDeletedAt, channel.FieldUpdatedAt, channel.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
 		case channel.FieldID:
 			values[i] = new(uuid.UUID)
@@ -157,12 +189,33 @@ func (_m *Channel) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.RetentionDays = value.Int64
 			}
+		case channel.FieldVisibility:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field visibility", values[i])
+			} else if value.Valid {
+				_m.Visibility = utils.Visibility(value.String)
+			}
+		case channel.FieldAllowedGroups:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field allowed_groups", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.AllowedGroups); err != nil {
+					return fmt.Errorf("unmarshal field allowed_groups: %w", err)
+				}
+			}
 		case channel.FieldStorageSizeBytes:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field storage_size_bytes", values[i])
 			} else if value.Valid {
 				_m.StorageSizeBytes = value.Int64
 			}
+		case channel.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
 		case channel.FieldUpdatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
@@ -203,6 +256,16 @@ func (_m *Channel) QueryYoutubeConfig() *YoutubeConfigQuery {
 	return NewChannelClient(_m.config).QueryYoutubeConfig(_m)
 }
 
+// QueryNotificationSubscriptions queries the "notification_subscriptions" edge of the Channel entity.
+func (_m *Channel) QueryNotificationSubscriptions() *NotificationSubscriptionQuery {
+	return NewChannelClient(_m.config).QueryNotificationSubscriptions(_m)
+}
+
+// QueryAllowedUsers queries the "allowed_users" edge of the Channel entity.
+func (_m *Channel) QueryAllowedUsers() *UserQuery {
+	return NewChannelClient(_m.config).QueryAllowedUsers(_m)
+}
+
 // Update returns a builder for updating this Channel.
 // Note that you need to call Channel.Unwrap() before calling this method if this Channel
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -244,9 +307,20 @@ func (_m *Channel) String() string {
 	builder.WriteString("retention_days=")
 	builder.WriteString(fmt.Sprintf("%v", _m.RetentionDays))
 	builder.WriteString(", ")
+	builder.WriteString("visibility=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Visibility))
+	builder.WriteString(", ")
+	builder.WriteString("allowed_groups=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AllowedGroups))
+	builder.WriteString(", ")
 	builder.WriteString("storage_size_bytes=")
 	builder.WriteString(fmt.Sprintf("%v", _m.StorageSizeBytes))
 	builder.WriteString(", ")
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("updated_at=")
 	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -3,11 +3,13 @@
 package channel
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/internal/utils"
 )
 
 const (
@@ -27,8 +29,14 @@ const (
 	FieldRetention = "retention"
 	// FieldRetentionDays holds the string denoting the retention_days field in the database.
 	FieldRetentionDays = "retention_days"
+	// FieldVisibility holds the string denoting the visibility field in the database.
+	FieldVisibility = "visibility"
+	// FieldAllowedGroups holds the string denoting the allowed_groups field in the database.
+	FieldAllowedGroups = "allowed_groups"
 	// FieldStorageSizeBytes holds the string denoting the storage_size_bytes field in the database.
 	FieldStorageSizeBytes = "storage_size_bytes"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
@@ -39,6 +47,10 @@ const (
 	EdgeLive = "live"
 	// EdgeYoutubeConfig holds the string denoting the youtube_config edge name in mutations.
 	EdgeYoutubeConfig = "youtube_config"
+	// EdgeNotificationSubscriptions holds the string denoting the notification_subscriptions edge name in mutations.
+	EdgeNotificationSubscriptions = "notification_subscriptions"
+	// EdgeAllowedUsers holds the string denoting the allowed_users edge name in mutations.
+	EdgeAllowedUsers = "allowed_users"
 	// Table holds the table name of the channel in the database.
 	Table = "channels"
 	// VodsTable is the table that holds the vods relation/edge.
@@ -62,6 +74,18 @@ const (
 	YoutubeConfigInverseTable = "youtube_configs"
 	// YoutubeConfigColumn is the table column denoting the youtube_config relation/edge.
 	YoutubeConfigColumn = "channel_youtube_config"
+	// NotificationSubscriptionsTable is the table that holds the notification_subscriptions relation/edge.
+	NotificationSubscriptionsTable = "notification_subscriptions"
+	// NotificationSubscriptionsInverseTable is the table name for the NotificationSubscription entity.
+	// It exists in this package in order to avoid circular dependency with the "notificationsubscription" package.
+	NotificationSubscriptionsInverseTable = "notification_subscriptions"
+	// NotificationSubscriptionsColumn is the table column denoting the notification_subscriptions relation/edge.
+	NotificationSubscriptionsColumn = "channel_notification_subscriptions"
+	// AllowedUsersTable is the table that holds the allowed_users relation/edge. The primary key declared below.
+	AllowedUsersTable = "channel_allowed_users"
+	// AllowedUsersInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	AllowedUsersInverseTable = "users"
 )
 
 // Columns holds all SQL columns for channel fields.
@@ -73,11 +97,20 @@ var Columns = []string{
 	FieldImagePath,
 	FieldRetention,
 	FieldRetentionDays,
+	FieldVisibility,
+	FieldAllowedGroups,
 	FieldStorageSizeBytes,
+	FieldDeletedAt,
 	FieldUpdatedAt,
 	FieldCreatedAt,
 }
 
+var (
+	// AllowedUsersPrimaryKey and AllowedUsersColumn2 are the table columns denoting the
+	// primary key for the allowed_users relation (M2M).
+	AllowedUsersPrimaryKey = []string{"channel_id", "user_id"}
+)
+
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -103,6 +136,18 @@ var (
 	DefaultID func() uuid.UUID
 )
 
+const DefaultVisibility utils.Visibility = "public"
+
+// VisibilityValidator is a validator for the "visibility" field enum values. It is called by the builders before save.
+func VisibilityValidator(v utils.Visibility) error {
+	switch v {
+	case "public", "authenticated", "restricted":
+		return nil
+	default:
+		return fmt.Errorf("channel: invalid enum value for visibility field: %q", v)
+	}
+}
+
 // OrderOption defines the ordering options for the Channel queries.
 type OrderOption func(*sql.Selector)
 
@@ -141,11 +186,21 @@ func ByRetentionDays(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldRetentionDays, opts...).ToFunc()
 }
 
+// ByVisibility orders the results by the visibility field.
+func ByVisibility(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVisibility, opts...).ToFunc()
+}
+
 // ByStorageSizeBytes orders the results by the storage_size_bytes field.
 func ByStorageSizeBytes(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStorageSizeBytes, opts...).ToFunc()
 }
 
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
 // ByUpdatedAt orders the results by the updated_at field.
 func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
@@ -190,6 +245,34 @@ func ByYoutubeConfigField(field string, opts ...sql.OrderTermOption) OrderOption
 		sqlgraph.OrderByNeighborTerms(s, newYoutubeConfigStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByNotificationSubscriptionsCount orders the results by notification_subscriptions count.
+func ByNotificationSubscriptionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newNotificationSubscriptionsStep(), opts...)
+	}
+}
+
+// ByNotificationSubscriptions orders the results by notification_subscriptions terms.
+func ByNotificationSubscriptions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newNotificationSubscriptionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAllowedUsersCount orders the results by allowed_users count.
+func ByAllowedUsersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAllowedUsersStep(), opts...)
+	}
+}
+
+// ByAllowedUsers orders the results by allowed_users terms.
+func ByAllowedUsers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAllowedUsersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newVodsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -211,3 +294,17 @@ func newYoutubeConfigStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2O, false, YoutubeConfigTable, YoutubeConfigColumn),
 	)
 }
+func newNotificationSubscriptionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(NotificationSubscriptionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, NotificationSubscriptionsTable, NotificationSubscriptionsColumn),
+	)
+}
+func newAllowedUsersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AllowedUsersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, AllowedUsersTable, AllowedUsersPrimaryKey...),
+	)
+}
@@ -9,6 +9,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/google/uuid"
 	"github.com/zibbp/ganymede/ent/predicate"
+	"github.com/zibbp/ganymede/internal/utils"
 )
 
 // ID filters vertices based on their ID field.
@@ -91,6 +92,11 @@ func StorageSizeBytes(v int64) predicate.Channel {
 	return predicate.Channel(sql.FieldEQ(FieldStorageSizeBytes, v))
 }
 
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.Channel {
+	return predicate.Channel(sql.FieldEQ(FieldDeletedAt, v))
+}
+
 // UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
 func UpdatedAt(v time.Time) predicate.Channel {
 	return predicate.Channel(sql.FieldEQ(FieldUpdatedAt, v))
@@ -431,6 +437,46 @@ func RetentionDaysNotNil() predicate.Channel {
 	return predicate.Channel(sql.FieldNotNull(FieldRetentionDays))
 }
 
+// VisibilityEQ applies the EQ predicate on the "visibility" field.
+func VisibilityEQ(v utils.Visibility) predicate.Channel {
+	vc := v
+	return predicate.Channel(sql.FieldEQ(FieldVisibility, vc))
+}
+
+// VisibilityNEQ applies the NEQ predicate on the "visibility" field.
+func VisibilityNEQ(v utils.Visibility) predicate.Channel {
+	vc := v
+	return predicate.Channel(sql.FieldNEQ(FieldVisibility, vc))
+}
+
+// VisibilityIn applies the In predicate on the "visibility" field.
+func VisibilityIn(vs ...utils.Visibility) predicate.Channel {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Channel(sql.FieldIn(FieldVisibility, v...))
+}
+
+// VisibilityNotIn applies the NotIn predicate on the "visibility" field.
+func VisibilityNotIn(vs ...utils.Visibility) predicate.Channel {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Channel(sql.FieldNotIn(FieldVisibility, v...))
+}
+
+// AllowedGroupsIsNil applies the IsNil predicate on the "allowed_groups" field.
+func AllowedGroupsIsNil() predicate.Channel {
+	return predicate.Channel(sql.FieldIsNull(FieldAllowedGroups))
+}
+
+// AllowedGroupsNotNil applies the NotNil predicate on the "allowed_groups" field.
+func AllowedGroupsNotNil() predicate.Channel {
+	return predicate.Channel(sql.FieldNotNull(FieldAllowedGroups))
+}
+
 // StorageSizeBytesEQ applies the EQ predicate on the "storage_size_bytes" field.
 func StorageSizeBytesEQ(v int64) predicate.Channel {
 	return predicate.Channel(sql.FieldEQ(FieldStorageSizeBytes, v))
@@ -471,6 +517,56 @@ func StorageSizeBytesLTE(v int64) predicate.Channel {
 	return predicate.Channel(sql.FieldLTE(FieldStorageSizeBytes, v))
 }
 
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.Channel {
+	return predicate.Channel(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.Channel {
+	return predicate.Channel(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.Channel {
+	return predicate.Channel(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.Channel {
+	return predicate.Channel(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.Channel {
+	return predicate.Channel(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.Channel {
+	return predicate.Channel(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.Channel {
+	return predicate.Channel(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.Channel {
+	return predicate.Channel(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Channel {
+	return predicate.Channel(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Channel {
+	return predicate.Channel(sql.FieldNotNull(FieldDeletedAt))
+}
+
 // UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
 func UpdatedAtEQ(v time.Time) predicate.Channel {
 	return predicate.Channel(sql.FieldEQ(FieldUpdatedAt, v))
@@ -620,6 +716,52 @@ func HasYoutubeConfigWith(preds ...predicate.YoutubeConfig) predicate.Channel {
 	})
 }
 
+// HasNotificationSubscriptions applies the HasEdge predicate on the "notification_subscriptions" edge.
+func HasNotificationSubscriptions() predicate.Channel {
+	return predicate.Channel(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, NotificationSubscriptionsTable, NotificationSubscriptionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasNotificationSubscriptionsWith applies the HasEdge predicate on the "notification_subscriptions" edge with a given conditions (other predicates).
+func HasNotificationSubscriptionsWith(preds ...predicate.NotificationSubscription) predicate.Channel {
+	return predicate.Channel(func(s *sql.Selector) {
+		step := newNotificationSubscriptionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAllowedUsers applies the HasEdge predicate on the "allowed_users" edge.
+func HasAllowedUsers() predicate.Channel {
+	return predicate.Channel(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, AllowedUsersTable, AllowedUsersPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAllowedUsersWith applies the HasEdge predicate on the "allowed_users" edge with a given conditions (other predicates).
+func HasAllowedUsersWith(preds ...predicate.User) predicate.Channel {
+	return predicate.Channel(func(s *sql.Selector) {
+		step := newAllowedUsersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Channel) predicate.Channel {
 	return predicate.Channel(sql.AndPredicates(predicates...))
@@ -13,8 +13,11 @@ import (
 	"github.com/google/uuid"
 	"github.com/zibbp/ganymede/ent/channel"
 	"github.com/zibbp/ganymede/ent/live"
+	"github.com/zibbp/ganymede/ent/notificationsubscription"
+	"github.com/zibbp/ganymede/ent/user"
 	"github.com/zibbp/ganymede/ent/vod"
 	"github.com/zibbp/ganymede/ent/youtubeconfig"
+	"github.com/zibbp/ganymede/internal/utils"
 )
 
 // ChannelCreate is the builder for creating a Channel entity.
@@ -84,6 +87,26 @@ func (_c *ChannelCreate) SetNillableRetentionDays(v *int64) *ChannelCreate {
 	return _c
 }
 
+// SetVisibility sets the "visibility" field.
+func (_c *ChannelCreate) SetVisibility(v utils.Visibility) *ChannelCreate {
+	_c.mutation.SetVisibility(v)
+	return _c
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (_c *ChannelCreate) SetNillableVisibility(v *utils.Visibility) *ChannelCreate {
+	if v != nil {
+		_c.SetVisibility(*v)
+	}
+	return _c
+}
+
+// SetAllowedGroups sets the "allowed_groups" field.
+func (_c *ChannelCreate) SetAllowedGroups(v []string) *ChannelCreate {
+	_c.mutation.SetAllowedGroups(v)
+	return _c
+}
+
 // SetStorageSizeBytes sets the "storage_size_bytes" field.
 func (_c *ChannelCreate) SetStorageSizeBytes(v int64) *ChannelCreate {
 	_c.mutation.SetStorageSizeBytes(v)
@@ -98,6 +121,20 @@ func (_c *ChannelCreate) SetNillableStorageSizeBytes(v *int64) *ChannelCreate {
 	return _c
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *ChannelCreate) SetDeletedAt(v time.Time) *ChannelCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *ChannelCreate) SetNillableDeletedAt(v *time.Time) *ChannelCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_c *ChannelCreate) SetUpdatedAt(v time.Time) *ChannelCreate {
 	_c.mutation.SetUpdatedAt(v)
@@ -189,6 +226,36 @@ func (_c *ChannelCreate) SetYoutubeConfig(v *YoutubeConfig) *ChannelCreate {
 	return _c.SetYoutubeConfigID(v.ID)
 }
 
+// AddNotificationSubscriptionIDs adds the "notification_subscriptions" edge to the NotificationSubscription entity by IDs.
+func (_c *ChannelCreate) AddNotificationSubscriptionIDs(ids ...uuid.UUID) *ChannelCreate {
+	_c.mutation.AddNotificationSubscriptionIDs(ids...)
+	return _c
+}
+
+// AddNotificationSubscriptions adds the "notification_subscriptions" edges to the NotificationSubscription entity.
+func (_c *ChannelCreate) AddNotificationSubscriptions(v ...*NotificationSubscription) *ChannelCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddNotificationSubscriptionIDs(ids...)
+}
+
+// AddAllowedUserIDs adds the "allowed_users" edge to the User entity by IDs.
+func (_c *ChannelCreate) AddAllowedUserIDs(ids ...uuid.UUID) *ChannelCreate {
+	_c.mutation.AddAllowedUserIDs(ids...)
+	return _c
+}
+
+// AddAllowedUsers adds the "allowed_users" edges to the User entity.
+func (_c *ChannelCreate) AddAllowedUsers(v ...*User) *ChannelCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAllowedUserIDs(ids...)
+}
+
 // Mutation returns the ChannelMutation object of the builder.
 func (_c *ChannelCreate) Mutation() *ChannelMutation {
 	return _c.mutation
@@ -228,6 +295,10 @@ func (_c *ChannelCreate) defaults() {
 		v := channel.DefaultRetention
 		_c.mutation.SetRetention(v)
 	}
+	if _, ok := _c.mutation.Visibility(); !ok {
+		v := channel.DefaultVisibility
+		_c.mutation.SetVisibility(v)
+	}
 	if _, ok := _c.mutation.StorageSizeBytes(); !ok {
 		v := channel.DefaultStorageSizeBytes
 		_c.mutation.SetStorageSizeBytes(v)
@@ -260,6 +331,14 @@ func (_c *ChannelCreate) check() error {
 	if _, ok := _c.mutation.Retention(); !ok {
 		return &ValidationError{Name: "retention", err: errors.New(`ent: missing required field "Channel.retention"`)}
 	}
+	if _, ok := _c.mutation.Visibility(); !ok {
+		return &ValidationError{Name: "visibility", err: errors.New(`ent: missing required field "Channel.visibility"`)}
+	}
+	if v, ok := _c.mutation.Visibility(); ok {
+		if err := channel.VisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "Channel.visibility": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.StorageSizeBytes(); !ok {
 		return &ValidationError{Name: "storage_size_bytes", err: errors.New(`ent: missing required field "Channel.storage_size_bytes"`)}
 	}
@@ -328,10 +407,22 @@ func (_c *ChannelCreate) createSpec() (*Channel, *sqlgraph.CreateSpec) {
 		_spec.SetField(channel.FieldRetentionDays, field.TypeInt64, value)
 		_node.RetentionDays = value
 	}
+	if value, ok := _c.mutation.Visibility(); ok {
+		_spec.SetField(channel.FieldVisibility, field.TypeEnum, value)
+		_node.Visibility = value
+	}
+	if value, ok := _c.mutation.AllowedGroups(); ok {
+		_spec.SetField(channel.FieldAllowedGroups, field.TypeJSON, value)
+		_node.AllowedGroups = value
+	}
 	if value, ok := _c.mutation.StorageSizeBytes(); ok {
 		_spec.SetField(channel.FieldStorageSizeBytes, field.TypeInt64, value)
 		_node.StorageSizeBytes = value
 	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(channel.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
 	if value, ok := _c.mutation.UpdatedAt(); ok {
 		_spec.SetField(channel.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
@@ -388,6 +479,38 @@ func (_c *ChannelCreate) createSpec() (*Channel, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.NotificationSubscriptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   channel.NotificationSubscriptionsTable,
+			Columns: []string{channel.NotificationSubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationsubscription.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AllowedUsersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   channel.AllowedUsersTable,
+			Columns: channel.AllowedUsersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
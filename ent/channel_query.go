@@ -15,7 +15,9 @@ import (
 	"github.com/google/uuid"
 	"github.com/zibbp/ganymede/ent/channel"
 	"github.com/zibbp/ganymede/ent/live"
+	"github.com/zibbp/ganymede/ent/notificationsubscription"
 	"github.com/zibbp/ganymede/ent/predicate"
+	"github.com/zibbp/ganymede/ent/user"
 	"github.com/zibbp/ganymede/ent/vod"
 	"github.com/zibbp/ganymede/ent/youtubeconfig"
 )
@@ -23,13 +25,15 @@ import (
 // ChannelQuery is the builder for querying Channel entities.
 type ChannelQuery struct {
 	config
-	ctx               *QueryContext
-	order             []channel.OrderOption
-	inters            []Interceptor
-	predicates        []predicate.Channel
-	withVods          *VodQuery
-	withLive          *LiveQuery
-	withYoutubeConfig *YoutubeConfigQuery
+	ctx                           *QueryContext
+	order                         []channel.OrderOption
+	inters                        []Interceptor
+	predicates                    []predicate.Channel
+	withVods                      *VodQuery
+	withLive                      *LiveQuery
+	withYoutubeConfig             *YoutubeConfigQuery
+	withNotificationSubscriptions *NotificationSubscriptionQuery
+	withAllowedUsers              *UserQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -132,6 +136,50 @@ func (_q *ChannelQuery) QueryYoutubeConfig() *YoutubeConfigQuery {
 	return query
 }
 
+// QueryNotificationSubscriptions chains the current query on the "notification_subscriptions" edge.
+func (_q *ChannelQuery) QueryNotificationSubscriptions() *NotificationSubscriptionQuery {
+	query := (&NotificationSubscriptionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(channel.Table, channel.FieldID, selector),
+			sqlgraph.To(notificationsubscription.Table, notificationsubscription.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, channel.NotificationSubscriptionsTable, channel.NotificationSubscriptionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAllowedUsers chains the current query on the "allowed_users" edge.
+func (_q *ChannelQuery) QueryAllowedUsers() *UserQuery {
+	query := (&UserClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(channel.Table, channel.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, channel.AllowedUsersTable, channel.AllowedUsersPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Channel entity from the query.
 // Returns a *NotFoundError when no Channel was found.
 func (_q *ChannelQuery) First(ctx context.Context) (*Channel, error) {
@@ -319,14 +367,16 @@ func (_q *ChannelQuery) Clone() *ChannelQuery {
 		return nil
 	}
 	return &ChannelQuery{
-		config:            _q.config,
-		ctx:               _q.ctx.Clone(),
-		order:             append([]channel.OrderOption{}, _q.order...),
-		inters:            append([]Interceptor{}, _q.inters...),
-		predicates:        append([]predicate.Channel{}, _q.predicates...),
-		withVods:          _q.withVods.Clone(),
-		withLive:          _q.withLive.Clone(),
-		withYoutubeConfig: _q.withYoutubeConfig.Clone(),
+		config:                        _q.config,
+		ctx:                           _q.ctx.Clone(),
+		order:                         append([]channel.OrderOption{}, _q.order...),
+		inters:                        append([]Interceptor{}, _q.inters...),
+		predicates:                    append([]predicate.Channel{}, _q.predicates...),
+		withVods:                      _q.withVods.Clone(),
+		withLive:                      _q.withLive.Clone(),
+		withYoutubeConfig:             _q.withYoutubeConfig.Clone(),
+		withNotificationSubscriptions: _q.withNotificationSubscriptions.Clone(),
+		withAllowedUsers:              _q.withAllowedUsers.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -366,6 +416,28 @@ func (_q *ChannelQuery) WithYoutubeConfig(opts ...func(*YoutubeConfigQuery)) *Ch
 	return _q
 }
 
+// WithNotificationSubscriptions tells the query-builder to eager-load the nodes that are connected to
+// the "notification_subscriptions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ChannelQuery) WithNotificationSubscriptions(opts ...func(*NotificationSubscriptionQuery)) *ChannelQuery {
+	query := (&NotificationSubscriptionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withNotificationSubscriptions = query
+	return _q
+}
+
+// WithAllowedUsers tells the query-builder to eager-load the nodes that are connected to
+// the "allowed_users" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ChannelQuery) WithAllowedUsers(opts ...func(*UserQuery)) *ChannelQuery {
+	query := (&UserClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withAllowedUsers = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -444,10 +516,12 @@ func (_q *ChannelQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Chan
 	var (
 		nodes       = []*Channel{}
 		_spec       = _q.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [5]bool{
 			_q.withVods != nil,
 			_q.withLive != nil,
 			_q.withYoutubeConfig != nil,
+			_q.withNotificationSubscriptions != nil,
+			_q.withAllowedUsers != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -488,6 +562,22 @@ func (_q *ChannelQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Chan
 			return nil, err
 		}
 	}
+	if query := _q.withNotificationSubscriptions; query != nil {
+		if err := _q.loadNotificationSubscriptions(ctx, query, nodes,
+			func(n *Channel) { n.Edges.NotificationSubscriptions = []*NotificationSubscription{} },
+			func(n *Channel, e *NotificationSubscription) {
+				n.Edges.NotificationSubscriptions = append(n.Edges.NotificationSubscriptions, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withAllowedUsers; query != nil {
+		if err := _q.loadAllowedUsers(ctx, query, nodes,
+			func(n *Channel) { n.Edges.AllowedUsers = []*User{} },
+			func(n *Channel, e *User) { n.Edges.AllowedUsers = append(n.Edges.AllowedUsers, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -581,6 +671,98 @@ func (_q *ChannelQuery) loadYoutubeConfig(ctx context.Context, query *YoutubeCon
 	}
 	return nil
 }
+func (_q *ChannelQuery) loadNotificationSubscriptions(ctx context.Context, query *NotificationSubscriptionQuery, nodes []*Channel, init func(*Channel), assign func(*Channel, *NotificationSubscription)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Channel)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.NotificationSubscription(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(channel.NotificationSubscriptionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.channel_notification_subscriptions
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "channel_notification_subscriptions" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "channel_notification_subscriptions" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *ChannelQuery) loadAllowedUsers(ctx context.Context, query *UserQuery, nodes []*Channel, init func(*Channel), assign func(*Channel, *User)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[uuid.UUID]*Channel)
+	nids := make(map[uuid.UUID]map[*Channel]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(channel.AllowedUsersTable)
+		s.Join(joinT).On(s.C(user.FieldID), joinT.C(channel.AllowedUsersPrimaryKey[1]))
+		s.Where(sql.InValues(joinT.C(channel.AllowedUsersPrimaryKey[0]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(channel.AllowedUsersPrimaryKey[0]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(uuid.UUID)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := *values[0].(*uuid.UUID)
+				inValue := *values[1].(*uuid.UUID)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Channel]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*User](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "allowed_users" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
 
 func (_q *ChannelQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -10,13 +10,17 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/zibbp/ganymede/ent/channel"
 	"github.com/zibbp/ganymede/ent/live"
+	"github.com/zibbp/ganymede/ent/notificationsubscription"
 	"github.com/zibbp/ganymede/ent/predicate"
+	"github.com/zibbp/ganymede/ent/user"
 	"github.com/zibbp/ganymede/ent/vod"
 	"github.com/zibbp/ganymede/ent/youtubeconfig"
+	"github.com/zibbp/ganymede/internal/utils"
 )
 
 // ChannelUpdate is the builder for updating Channel entities.
@@ -135,6 +139,38 @@ func (_u *ChannelUpdate) ClearRetentionDays() *ChannelUpdate {
 	return _u
 }
 
+// SetVisibility sets the "visibility" field.
+func (_u *ChannelUpdate) SetVisibility(v utils.Visibility) *ChannelUpdate {
+	_u.mutation.SetVisibility(v)
+	return _u
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (_u *ChannelUpdate) SetNillableVisibility(v *utils.Visibility) *ChannelUpdate {
+	if v != nil {
+		_u.SetVisibility(*v)
+	}
+	return _u
+}
+
+// SetAllowedGroups sets the "allowed_groups" field.
+func (_u *ChannelUpdate) SetAllowedGroups(v []string) *ChannelUpdate {
+	_u.mutation.SetAllowedGroups(v)
+	return _u
+}
+
+// AppendAllowedGroups appends value to the "allowed_groups" field.
+func (_u *ChannelUpdate) AppendAllowedGroups(v []string) *ChannelUpdate {
+	_u.mutation.AppendAllowedGroups(v)
+	return _u
+}
+
+// ClearAllowedGroups clears the value of the "allowed_groups" field.
+func (_u *ChannelUpdate) ClearAllowedGroups() *ChannelUpdate {
+	_u.mutation.ClearAllowedGroups()
+	return _u
+}
+
 // SetStorageSizeBytes sets the "storage_size_bytes" field.
 func (_u *ChannelUpdate) SetStorageSizeBytes(v int64) *ChannelUpdate {
 	_u.mutation.ResetStorageSizeBytes()
@@ -156,6 +192,26 @@ func (_u *ChannelUpdate) AddStorageSizeBytes(v int64) *ChannelUpdate {
 	return _u
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *ChannelUpdate) SetDeletedAt(v time.Time) *ChannelUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *ChannelUpdate) SetNillableDeletedAt(v *time.Time) *ChannelUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *ChannelUpdate) ClearDeletedAt() *ChannelUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *ChannelUpdate) SetUpdatedAt(v time.Time) *ChannelUpdate {
 	_u.mutation.SetUpdatedAt(v)
@@ -211,6 +267,36 @@ func (_u *ChannelUpdate) SetYoutubeConfig(v *YoutubeConfig) *ChannelUpdate {
 	return _u.SetYoutubeConfigID(v.ID)
 }
 
+// AddNotificationSubscriptionIDs adds the "notification_subscriptions" edge to the NotificationSubscription entity by IDs.
+func (_u *ChannelUpdate) AddNotificationSubscriptionIDs(ids ...uuid.UUID) *ChannelUpdate {
+	_u.mutation.AddNotificationSubscriptionIDs(ids...)
+	return _u
+}
+
+// AddNotificationSubscriptions adds the "notification_subscriptions" edges to the NotificationSubscription entity.
+func (_u *ChannelUpdate) AddNotificationSubscriptions(v ...*NotificationSubscription) *ChannelUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddNotificationSubscriptionIDs(ids...)
+}
+
+// AddAllowedUserIDs adds the "allowed_users" edge to the User entity by IDs.
+func (_u *ChannelUpdate) AddAllowedUserIDs(ids ...uuid.UUID) *ChannelUpdate {
+	_u.mutation.AddAllowedUserIDs(ids...)
+	return _u
+}
+
+// AddAllowedUsers adds the "allowed_users" edges to the User entity.
+func (_u *ChannelUpdate) AddAllowedUsers(v ...*User) *ChannelUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAllowedUserIDs(ids...)
+}
+
 // Mutation returns the ChannelMutation object of the builder.
 func (_u *ChannelUpdate) Mutation() *ChannelMutation {
 	return _u.mutation
@@ -264,6 +350,48 @@ func (_u *ChannelUpdate) ClearYoutubeConfig() *ChannelUpdate {
 	return _u
 }
 
+// ClearNotificationSubscriptions clears all "notification_subscriptions" edges to the NotificationSubscription entity.
+func (_u *ChannelUpdate) ClearNotificationSubscriptions() *ChannelUpdate {
+	_u.mutation.ClearNotificationSubscriptions()
+	return _u
+}
+
+// RemoveNotificationSubscriptionIDs removes the "notification_subscriptions" edge to NotificationSubscription entities by IDs.
+func (_u *ChannelUpdate) RemoveNotificationSubscriptionIDs(ids ...uuid.UUID) *ChannelUpdate {
+	_u.mutation.RemoveNotificationSubscriptionIDs(ids...)
+	return _u
+}
+
+// RemoveNotificationSubscriptions removes "notification_subscriptions" edges to NotificationSubscription entities.
+func (_u *ChannelUpdate) RemoveNotificationSubscriptions(v ...*NotificationSubscription) *ChannelUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveNotificationSubscriptionIDs(ids...)
+}
+
+// ClearAllowedUsers clears all "allowed_users" edges to the User entity.
+func (_u *ChannelUpdate) ClearAllowedUsers() *ChannelUpdate {
+	_u.mutation.ClearAllowedUsers()
+	return _u
+}
+
+// RemoveAllowedUserIDs removes the "allowed_users" edge to User entities by IDs.
+func (_u *ChannelUpdate) RemoveAllowedUserIDs(ids ...uuid.UUID) *ChannelUpdate {
+	_u.mutation.RemoveAllowedUserIDs(ids...)
+	return _u
+}
+
+// RemoveAllowedUsers removes "allowed_users" edges to User entities.
+func (_u *ChannelUpdate) RemoveAllowedUsers(v ...*User) *ChannelUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAllowedUserIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *ChannelUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -300,7 +428,20 @@ func (_u *ChannelUpdate) defaults() {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (_u *ChannelUpdate) check() error {
+	if v, ok := _u.mutation.Visibility(); ok {
+		if err := channel.VisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "Channel.visibility": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (_u *ChannelUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(channel.Table, channel.Columns, sqlgraph.NewFieldSpec(channel.FieldID, field.TypeUUID))
 	if ps := _u.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -336,12 +477,32 @@ func (_u *ChannelUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.RetentionDaysCleared() {
 		_spec.ClearField(channel.FieldRetentionDays, field.TypeInt64)
 	}
+	if value, ok := _u.mutation.Visibility(); ok {
+		_spec.SetField(channel.FieldVisibility, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.AllowedGroups(); ok {
+		_spec.SetField(channel.FieldAllowedGroups, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAllowedGroups(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, channel.FieldAllowedGroups, value)
+		})
+	}
+	if _u.mutation.AllowedGroupsCleared() {
+		_spec.ClearField(channel.FieldAllowedGroups, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.StorageSizeBytes(); ok {
 		_spec.SetField(channel.FieldStorageSizeBytes, field.TypeInt64, value)
 	}
 	if value, ok := _u.mutation.AddedStorageSizeBytes(); ok {
 		_spec.AddField(channel.FieldStorageSizeBytes, field.TypeInt64, value)
 	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(channel.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(channel.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(channel.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -464,6 +625,96 @@ func (_u *ChannelUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.NotificationSubscriptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   channel.NotificationSubscriptionsTable,
+			Columns: []string{channel.NotificationSubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationsubscription.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedNotificationSubscriptionsIDs(); len(nodes) > 0 && !_u.mutation.NotificationSubscriptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   channel.NotificationSubscriptionsTable,
+			Columns: []string{channel.NotificationSubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationsubscription.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.NotificationSubscriptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   channel.NotificationSubscriptionsTable,
+			Columns: []string{channel.NotificationSubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationsubscription.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AllowedUsersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   channel.AllowedUsersTable,
+			Columns: channel.AllowedUsersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAllowedUsersIDs(); len(nodes) > 0 && !_u.mutation.AllowedUsersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   channel.AllowedUsersTable,
+			Columns: channel.AllowedUsersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AllowedUsersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   channel.AllowedUsersTable,
+			Columns: channel.AllowedUsersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{channel.Label}
@@ -587,6 +838,38 @@ func (_u *ChannelUpdateOne) ClearRetentionDays() *ChannelUpdateOne {
 	return _u
 }
 
+// SetVisibility sets the "visibility" field.
+func (_u *ChannelUpdateOne) SetVisibility(v utils.Visibility) *ChannelUpdateOne {
+	_u.mutation.SetVisibility(v)
+	return _u
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (_u *ChannelUpdateOne) SetNillableVisibility(v *utils.Visibility) *ChannelUpdateOne {
+	if v != nil {
+		_u.SetVisibility(*v)
+	}
+	return _u
+}
+
+// SetAllowedGroups sets the "allowed_groups" field.
+func (_u *ChannelUpdateOne) SetAllowedGroups(v []string) *ChannelUpdateOne {
+	_u.mutation.SetAllowedGroups(v)
+	return _u
+}
+
+// AppendAllowedGroups appends value to the "allowed_groups" field.
+func (_u *ChannelUpdateOne) AppendAllowedGroups(v []string) *ChannelUpdateOne {
+	_u.mutation.AppendAllowedGroups(v)
+	return _u
+}
+
+// ClearAllowedGroups clears the value of the "allowed_groups" field.
+func (_u *ChannelUpdateOne) ClearAllowedGroups() *ChannelUpdateOne {
+	_u.mutation.ClearAllowedGroups()
+	return _u
+}
+
 // SetStorageSizeBytes sets the "storage_size_bytes" field.
 func (_u *ChannelUpdateOne) SetStorageSizeBytes(v int64) *ChannelUpdateOne {
 	_u.mutation.ResetStorageSizeBytes()
@@ -608,6 +891,26 @@ func (_u *ChannelUpdateOne) AddStorageSizeBytes(v int64) *ChannelUpdateOne {
 	return _u
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *ChannelUpdateOne) SetDeletedAt(v time.Time) *ChannelUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *ChannelUpdateOne) SetNillableDeletedAt(v *time.Time) *ChannelUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *ChannelUpdateOne) ClearDeletedAt() *ChannelUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *ChannelUpdateOne) SetUpdatedAt(v time.Time) *ChannelUpdateOne {
 	_u.mutation.SetUpdatedAt(v)
@@ -663,6 +966,36 @@ func (_u *ChannelUpdateOne) SetYoutubeConfig(v *YoutubeConfig) *ChannelUpdateOne
 	return _u.SetYoutubeConfigID(v.ID)
 }
 
+// AddNotificationSubscriptionIDs adds the "notification_subscriptions" edge to the NotificationSubscription entity by IDs.
+func (_u *ChannelUpdateOne) AddNotificationSubscriptionIDs(ids ...uuid.UUID) *ChannelUpdateOne {
+	_u.mutation.AddNotificationSubscriptionIDs(ids...)
+	return _u
+}
+
+// AddNotificationSubscriptions adds the "notification_subscriptions" edges to the NotificationSubscription entity.
+func (_u *ChannelUpdateOne) AddNotificationSubscriptions(v ...*NotificationSubscription) *ChannelUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddNotificationSubscriptionIDs(ids...)
+}
+
+// AddAllowedUserIDs adds the "allowed_users" edge to the User entity by IDs.
+func (_u *ChannelUpdateOne) AddAllowedUserIDs(ids ...uuid.UUID) *ChannelUpdateOne {
+	_u.mutation.AddAllowedUserIDs(ids...)
+	return _u
+}
+
+// AddAllowedUsers adds the "allowed_users" edges to the User entity.
+func (_u *ChannelUpdateOne) AddAllowedUsers(v ...*User) *ChannelUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAllowedUserIDs(ids...)
+}
+
 // Mutation returns the ChannelMutation object of the builder.
 func (_u *ChannelUpdateOne) Mutation() *ChannelMutation {
 	return _u.mutation
@@ -716,6 +1049,48 @@ func (_u *ChannelUpdateOne) ClearYoutubeConfig() *ChannelUpdateOne {
 	return _u
 }
 
+// ClearNotificationSubscriptions clears all "notification_subscriptions" edges to the NotificationSubscription entity.
+func (_u *ChannelUpdateOne) ClearNotificationSubscriptions() *ChannelUpdateOne {
+	_u.mutation.ClearNotificationSubscriptions()
+	return _u
+}
+
+// RemoveNotificationSubscriptionIDs removes the "notification_subscriptions" edge to NotificationSubscription entities by IDs.
+func (_u *ChannelUpdateOne) RemoveNotificationSubscriptionIDs(ids ...uuid.UUID) *ChannelUpdateOne {
+	_u.mutation.RemoveNotificationSubscriptionIDs(ids...)
+	return _u
+}
+
+// RemoveNotificationSubscriptions removes "notification_subscriptions" edges to NotificationSubscription entities.
+func (_u *ChannelUpdateOne) RemoveNotificationSubscriptions(v ...*NotificationSubscription) *ChannelUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveNotificationSubscriptionIDs(ids...)
+}
+
+// ClearAllowedUsers clears all "allowed_users" edges to the User entity.
+func (_u *ChannelUpdateOne) ClearAllowedUsers() *ChannelUpdateOne {
+	_u.mutation.ClearAllowedUsers()
+	return _u
+}
+
+// RemoveAllowedUserIDs removes the "allowed_users" edge to User entities by IDs.
+func (_u *ChannelUpdateOne) RemoveAllowedUserIDs(ids ...uuid.UUID) *ChannelUpdateOne {
+	_u.mutation.RemoveAllowedUserIDs(ids...)
+	return _u
+}
+
+// RemoveAllowedUsers removes "allowed_users" edges to User entities.
+func (_u *ChannelUpdateOne) RemoveAllowedUsers(v ...*User) *ChannelUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAllowedUserIDs(ids...)
+}
+
 // Where appends a list predicates to the ChannelUpdate builder.
 func (_u *ChannelUpdateOne) Where(ps ...predicate.Channel) *ChannelUpdateOne {
 	_u.mutation.Where(ps...)
@@ -765,7 +1140,20 @@ func (_u *ChannelUpdateOne) defaults() {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (_u *ChannelUpdateOne) check() error {
+	if v, ok := _u.mutation.Visibility(); ok {
+		if err := channel.VisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "Channel.visibility": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (_u *ChannelUpdateOne) sqlSave(ctx context.Context) (_node *Channel, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(channel.Table, channel.Columns, sqlgraph.NewFieldSpec(channel.FieldID, field.TypeUUID))
 	id, ok := _u.mutation.ID()
 	if !ok {
@@ -818,12 +1206,32 @@ func (_u *ChannelUpdateOne) sqlSave(ctx context.Context) (_node *Channel, err er
 	if _u.mutation.RetentionDaysCleared() {
 		_spec.ClearField(channel.FieldRetentionDays, field.TypeInt64)
 	}
+	if value, ok := _u.mutation.Visibility(); ok {
+		_spec.SetField(channel.FieldVisibility, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.AllowedGroups(); ok {
+		_spec.SetField(channel.FieldAllowedGroups, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAllowedGroups(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, channel.FieldAllowedGroups, value)
+		})
+	}
+	if _u.mutation.AllowedGroupsCleared() {
+		_spec.ClearField(channel.FieldAllowedGroups, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.StorageSizeBytes(); ok {
 		_spec.SetField(channel.FieldStorageSizeBytes, field.TypeInt64, value)
 	}
 	if value, ok := _u.mutation.AddedStorageSizeBytes(); ok {
 		_spec.AddField(channel.FieldStorageSizeBytes, field.TypeInt64, value)
 	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(channel.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(channel.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(channel.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -946,6 +1354,96 @@ func (_u *ChannelUpdateOne) sqlSave(ctx context.Context) (_node *Channel, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.NotificationSubscriptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   channel.NotificationSubscriptionsTable,
+			Columns: []string{channel.NotificationSubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationsubscription.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedNotificationSubscriptionsIDs(); len(nodes) > 0 && !_u.mutation.NotificationSubscriptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   channel.NotificationSubscriptionsTable,
+			Columns: []string{channel.NotificationSubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationsubscription.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.NotificationSubscriptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   channel.NotificationSubscriptionsTable,
+			Columns: []string{channel.NotificationSubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationsubscription.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AllowedUsersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   channel.AllowedUsersTable,
+			Columns: channel.AllowedUsersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAllowedUsersIDs(); len(nodes) > 0 && !_u.mutation.AllowedUsersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   channel.AllowedUsersTable,
+			Columns: channel.AllowedUsersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AllowedUsersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   channel.AllowedUsersTable,
+			Columns: channel.AllowedUsersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Channel{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
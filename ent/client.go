@@ -16,20 +16,30 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/zibbp/ganymede/ent/apitoken"
+	"github.com/zibbp/ganymede/ent/auditlog"
 	"github.com/zibbp/ganymede/ent/blockedvideos"
+	"github.com/zibbp/ganymede/ent/bulkoperation"
 	"github.com/zibbp/ganymede/ent/channel"
 	"github.com/zibbp/ganymede/ent/chapter"
 	"github.com/zibbp/ganymede/ent/live"
 	"github.com/zibbp/ganymede/ent/livecategory"
 	"github.com/zibbp/ganymede/ent/livetitleregex"
+	"github.com/zibbp/ganymede/ent/loginthrottle"
 	"github.com/zibbp/ganymede/ent/multistreaminfo"
 	"github.com/zibbp/ganymede/ent/mutedsegment"
+	"github.com/zibbp/ganymede/ent/notificationfailure"
+	"github.com/zibbp/ganymede/ent/notificationsubscription"
 	"github.com/zibbp/ganymede/ent/playback"
 	"github.com/zibbp/ganymede/ent/playlist"
 	"github.com/zibbp/ganymede/ent/playlistrule"
 	"github.com/zibbp/ganymede/ent/playlistrulegroup"
+	"github.com/zibbp/ganymede/ent/playlistsection"
+	"github.com/zibbp/ganymede/ent/playlistvod"
 	"github.com/zibbp/ganymede/ent/queue"
 	"github.com/zibbp/ganymede/ent/sessions"
+	"github.com/zibbp/ganymede/ent/sharelink"
+	"github.com/zibbp/ganymede/ent/tag"
 	"github.com/zibbp/ganymede/ent/twitchcategory"
 	"github.com/zibbp/ganymede/ent/user"
 	"github.com/zibbp/ganymede/ent/vod"
@@ -44,8 +54,14 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// ApiToken is the client for interacting with the ApiToken builders.
+	ApiToken *ApiTokenClient
+	// AuditLog is the client for interacting with the AuditLog builders.
+	AuditLog *AuditLogClient
 	// BlockedVideos is the client for interacting with the BlockedVideos builders.
 	BlockedVideos *BlockedVideosClient
+	// BulkOperation is the client for interacting with the BulkOperation builders.
+	BulkOperation *BulkOperationClient
 	// Channel is the client for interacting with the Channel builders.
 	Channel *ChannelClient
 	// Chapter is the client for interacting with the Chapter builders.
@@ -56,10 +72,16 @@ type Client struct {
 	LiveCategory *LiveCategoryClient
 	// LiveTitleRegex is the client for interacting with the LiveTitleRegex builders.
 	LiveTitleRegex *LiveTitleRegexClient
+	// LoginThrottle is the client for interacting with the LoginThrottle builders.
+	LoginThrottle *LoginThrottleClient
 	// MultistreamInfo is the client for interacting with the MultistreamInfo builders.
 	MultistreamInfo *MultistreamInfoClient
 	// MutedSegment is the client for interacting with the MutedSegment builders.
 	MutedSegment *MutedSegmentClient
+	// NotificationFailure is the client for interacting with the NotificationFailure builders.
+	NotificationFailure *NotificationFailureClient
+	// NotificationSubscription is the client for interacting with the NotificationSubscription builders.
+	NotificationSubscription *NotificationSubscriptionClient
 	// Playback is the client for interacting with the Playback builders.
 	Playback *PlaybackClient
 	// Playlist is the client for interacting with the Playlist builders.
@@ -68,10 +90,18 @@ type Client struct {
 	PlaylistRule *PlaylistRuleClient
 	// PlaylistRuleGroup is the client for interacting with the PlaylistRuleGroup builders.
 	PlaylistRuleGroup *PlaylistRuleGroupClient
+	// PlaylistSection is the client for interacting with the PlaylistSection builders.
+	PlaylistSection *PlaylistSectionClient
+	// PlaylistVod is the client for interacting with the PlaylistVod builders.
+	PlaylistVod *PlaylistVodClient
 	// Queue is the client for interacting with the Queue builders.
 	Queue *QueueClient
 	// Sessions is the client for interacting with the Sessions builders.
 	Sessions *SessionsClient
+	// ShareLink is the client for interacting with the ShareLink builders.
+	ShareLink *ShareLinkClient
+	// Tag is the client for interacting with the Tag builders.
+	Tag *TagClient
 	// TwitchCategory is the client for interacting with the TwitchCategory builders.
 	TwitchCategory *TwitchCategoryClient
 	// User is the client for interacting with the User builders.
@@ -97,20 +127,30 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.ApiToken = NewApiTokenClient(c.config)
+	c.AuditLog = NewAuditLogClient(c.config)
 	c.BlockedVideos = NewBlockedVideosClient(c.config)
+	c.BulkOperation = NewBulkOperationClient(c.config)
 	c.Channel = NewChannelClient(c.config)
 	c.Chapter = NewChapterClient(c.config)
 	c.Live = NewLiveClient(c.config)
 	c.LiveCategory = NewLiveCategoryClient(c.config)
 	c.LiveTitleRegex = NewLiveTitleRegexClient(c.config)
+	c.LoginThrottle = NewLoginThrottleClient(c.config)
 	c.MultistreamInfo = NewMultistreamInfoClient(c.config)
 	c.MutedSegment = NewMutedSegmentClient(c.config)
+	c.NotificationFailure = NewNotificationFailureClient(c.config)
+	c.NotificationSubscription = NewNotificationSubscriptionClient(c.config)
 	c.Playback = NewPlaybackClient(c.config)
 	c.Playlist = NewPlaylistClient(c.config)
 	c.PlaylistRule = NewPlaylistRuleClient(c.config)
 	c.PlaylistRuleGroup = NewPlaylistRuleGroupClient(c.config)
+	c.PlaylistSection = NewPlaylistSectionClient(c.config)
+	c.PlaylistVod = NewPlaylistVodClient(c.config)
 	c.Queue = NewQueueClient(c.config)
 	c.Sessions = NewSessionsClient(c.config)
+	c.ShareLink = NewShareLinkClient(c.config)
+	c.Tag = NewTagClient(c.config)
 	c.TwitchCategory = NewTwitchCategoryClient(c.config)
 	c.User = NewUserClient(c.config)
 	c.Vod = NewVodClient(c.config)
@@ -208,29 +248,39 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:                    ctx,
-		config:                 cfg,
-		BlockedVideos:          NewBlockedVideosClient(cfg),
-		Channel:                NewChannelClient(cfg),
-		Chapter:                NewChapterClient(cfg),
-		Live:                   NewLiveClient(cfg),
-		LiveCategory:           NewLiveCategoryClient(cfg),
-		LiveTitleRegex:         NewLiveTitleRegexClient(cfg),
-		MultistreamInfo:        NewMultistreamInfoClient(cfg),
-		MutedSegment:           NewMutedSegmentClient(cfg),
-		Playback:               NewPlaybackClient(cfg),
-		Playlist:               NewPlaylistClient(cfg),
-		PlaylistRule:           NewPlaylistRuleClient(cfg),
-		PlaylistRuleGroup:      NewPlaylistRuleGroupClient(cfg),
-		Queue:                  NewQueueClient(cfg),
-		Sessions:               NewSessionsClient(cfg),
-		TwitchCategory:         NewTwitchCategoryClient(cfg),
-		User:                   NewUserClient(cfg),
-		Vod:                    NewVodClient(cfg),
-		YoutubeConfig:          NewYoutubeConfigClient(cfg),
-		YoutubeCredential:      NewYoutubeCredentialClient(cfg),
-		YoutubePlaylistMapping: NewYoutubePlaylistMappingClient(cfg),
-		YoutubeUpload:          NewYoutubeUploadClient(cfg),
+		ctx:                      ctx,
+		config:                   cfg,
+		ApiToken:                 NewApiTokenClient(cfg),
+		AuditLog:                 NewAuditLogClient(cfg),
+		BlockedVideos:            NewBlockedVideosClient(cfg),
+		BulkOperation:            NewBulkOperationClient(cfg),
+		Channel:                  NewChannelClient(cfg),
+		Chapter:                  NewChapterClient(cfg),
+		Live:                     NewLiveClient(cfg),
+		LiveCategory:             NewLiveCategoryClient(cfg),
+		LiveTitleRegex:           NewLiveTitleRegexClient(cfg),
+		LoginThrottle:            NewLoginThrottleClient(cfg),
+		MultistreamInfo:          NewMultistreamInfoClient(cfg),
+		MutedSegment:             NewMutedSegmentClient(cfg),
+		NotificationFailure:      NewNotificationFailureClient(cfg),
+		NotificationSubscription: NewNotificationSubscriptionClient(cfg),
+		Playback:                 NewPlaybackClient(cfg),
+		Playlist:                 NewPlaylistClient(cfg),
+		PlaylistRule:             NewPlaylistRuleClient(cfg),
+		PlaylistRuleGroup:        NewPlaylistRuleGroupClient(cfg),
+		PlaylistSection:          NewPlaylistSectionClient(cfg),
+		PlaylistVod:              NewPlaylistVodClient(cfg),
+		Queue:                    NewQueueClient(cfg),
+		Sessions:                 NewSessionsClient(cfg),
+		ShareLink:                NewShareLinkClient(cfg),
+		Tag:                      NewTagClient(cfg),
+		TwitchCategory:           NewTwitchCategoryClient(cfg),
+		User:                     NewUserClient(cfg),
+		Vod:                      NewVodClient(cfg),
+		YoutubeConfig:            NewYoutubeConfigClient(cfg),
+		YoutubeCredential:        NewYoutubeCredentialClient(cfg),
+		YoutubePlaylistMapping:   NewYoutubePlaylistMappingClient(cfg),
+		YoutubeUpload:            NewYoutubeUploadClient(cfg),
 	}, nil
 }
 
@@ -248,36 +298,46 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:                    ctx,
-		config:                 cfg,
-		BlockedVideos:          NewBlockedVideosClient(cfg),
-		Channel:                NewChannelClient(cfg),
-		Chapter:                NewChapterClient(cfg),
-		Live:                   NewLiveClient(cfg),
-		LiveCategory:           NewLiveCategoryClient(cfg),
-		LiveTitleRegex:         NewLiveTitleRegexClient(cfg),
-		MultistreamInfo:        NewMultistreamInfoClient(cfg),
-		MutedSegment:           NewMutedSegmentClient(cfg),
-		Playback:               NewPlaybackClient(cfg),
-		Playlist:               NewPlaylistClient(cfg),
-		PlaylistRule:           NewPlaylistRuleClient(cfg),
-		PlaylistRuleGroup:      NewPlaylistRuleGroupClient(cfg),
-		Queue:                  NewQueueClient(cfg),
-		Sessions:               NewSessionsClient(cfg),
-		TwitchCategory:         NewTwitchCategoryClient(cfg),
-		User:                   NewUserClient(cfg),
-		Vod:                    NewVodClient(cfg),
-		YoutubeConfig:          NewYoutubeConfigClient(cfg),
-		YoutubeCredential:      NewYoutubeCredentialClient(cfg),
-		YoutubePlaylistMapping: NewYoutubePlaylistMappingClient(cfg),
-		YoutubeUpload:          NewYoutubeUploadClient(cfg),
+		ctx:                      ctx,
+		config:                   cfg,
+		ApiToken:                 NewApiTokenClient(cfg),
+		AuditLog:                 NewAuditLogClient(cfg),
+		BlockedVideos:            NewBlockedVideosClient(cfg),
+		BulkOperation:            NewBulkOperationClient(cfg),
+		Channel:                  NewChannelClient(cfg),
+		Chapter:                  NewChapterClient(cfg),
+		Live:                     NewLiveClient(cfg),
+		LiveCategory:             NewLiveCategoryClient(cfg),
+		LiveTitleRegex:           NewLiveTitleRegexClient(cfg),
+		LoginThrottle:            NewLoginThrottleClient(cfg),
+		MultistreamInfo:          NewMultistreamInfoClient(cfg),
+		MutedSegment:             NewMutedSegmentClient(cfg),
+		NotificationFailure:      NewNotificationFailureClient(cfg),
+		NotificationSubscription: NewNotificationSubscriptionClient(cfg),
+		Playback:                 NewPlaybackClient(cfg),
+		Playlist:                 NewPlaylistClient(cfg),
+		PlaylistRule:             NewPlaylistRuleClient(cfg),
+		PlaylistRuleGroup:        NewPlaylistRuleGroupClient(cfg),
+		PlaylistSection:          NewPlaylistSectionClient(cfg),
+		PlaylistVod:              NewPlaylistVodClient(cfg),
+		Queue:                    NewQueueClient(cfg),
+		Sessions:                 NewSessionsClient(cfg),
+		ShareLink:                NewShareLinkClient(cfg),
+		Tag:                      NewTagClient(cfg),
+		TwitchCategory:           NewTwitchCategoryClient(cfg),
+		User:                     NewUserClient(cfg),
+		Vod:                      NewVodClient(cfg),
+		YoutubeConfig:            NewYoutubeConfigClient(cfg),
+		YoutubeCredential:        NewYoutubeCredentialClient(cfg),
+		YoutubePlaylistMapping:   NewYoutubePlaylistMappingClient(cfg),
+		YoutubeUpload:            NewYoutubeUploadClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		BlockedVideos.
+//		ApiToken.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -300,10 +360,12 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.BlockedVideos, c.Channel, c.Chapter, c.Live, c.LiveCategory, c.LiveTitleRegex,
-		c.MultistreamInfo, c.MutedSegment, c.Playback, c.Playlist, c.PlaylistRule,
-		c.PlaylistRuleGroup, c.Queue, c.Sessions, c.TwitchCategory, c.User, c.Vod,
-		c.YoutubeConfig, c.YoutubeCredential, c.YoutubePlaylistMapping,
+		c.ApiToken, c.AuditLog, c.BlockedVideos, c.BulkOperation, c.Channel, c.Chapter,
+		c.Live, c.LiveCategory, c.LiveTitleRegex, c.LoginThrottle, c.MultistreamInfo,
+		c.MutedSegment, c.NotificationFailure, c.NotificationSubscription, c.Playback,
+		c.Playlist, c.PlaylistRule, c.PlaylistRuleGroup, c.PlaylistSection,
+		c.PlaylistVod, c.Queue, c.Sessions, c.ShareLink, c.Tag, c.TwitchCategory,
+		c.User, c.Vod, c.YoutubeConfig, c.YoutubeCredential, c.YoutubePlaylistMapping,
 		c.YoutubeUpload,
 	} {
 		n.Use(hooks...)
@@ -314,10 +376,12 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.BlockedVideos, c.Channel, c.Chapter, c.Live, c.LiveCategory, c.LiveTitleRegex,
-		c.MultistreamInfo, c.MutedSegment, c.Playback, c.Playlist, c.PlaylistRule,
-		c.PlaylistRuleGroup, c.Queue, c.Sessions, c.TwitchCategory, c.User, c.Vod,
-		c.YoutubeConfig, c.YoutubeCredential, c.YoutubePlaylistMapping,
+		c.ApiToken, c.AuditLog, c.BlockedVideos, c.BulkOperation, c.Channel, c.Chapter,
+		c.Live, c.LiveCategory, c.LiveTitleRegex, c.LoginThrottle, c.MultistreamInfo,
+		c.MutedSegment, c.NotificationFailure, c.NotificationSubscription, c.Playback,
+		c.Playlist, c.PlaylistRule, c.PlaylistRuleGroup, c.PlaylistSection,
+		c.PlaylistVod, c.Queue, c.Sessions, c.ShareLink, c.Tag, c.TwitchCategory,
+		c.User, c.Vod, c.YoutubeConfig, c.YoutubeCredential, c.YoutubePlaylistMapping,
 		c.YoutubeUpload,
 	} {
 		n.Intercept(interceptors...)
@@ -327,8 +391,14 @@ func (c *Client) Intercept(interceptors ...Interceptor) {
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *ApiTokenMutation:
+		return c.ApiToken.mutate(ctx, m)
+	case *AuditLogMutation:
+		return c.AuditLog.mutate(ctx, m)
 	case *BlockedVideosMutation:
 		return c.BlockedVideos.mutate(ctx, m)
+	case *BulkOperationMutation:
+		return c.BulkOperation.mutate(ctx, m)
 	case *ChannelMutation:
 		return c.Channel.mutate(ctx, m)
 	case *ChapterMutation:
@@ -339,10 +409,16 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.LiveCategory.mutate(ctx, m)
 	case *LiveTitleRegexMutation:
 		return c.LiveTitleRegex.mutate(ctx, m)
+	case *LoginThrottleMutation:
+		return c.LoginThrottle.mutate(ctx, m)
 	case *MultistreamInfoMutation:
 		return c.MultistreamInfo.mutate(ctx, m)
 	case *MutedSegmentMutation:
 		return c.MutedSegment.mutate(ctx, m)
+	case *NotificationFailureMutation:
+		return c.NotificationFailure.mutate(ctx, m)
+	case *NotificationSubscriptionMutation:
+		return c.NotificationSubscription.mutate(ctx, m)
 	case *PlaybackMutation:
 		return c.Playback.mutate(ctx, m)
 	case *PlaylistMutation:
@@ -351,10 +427,18 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.PlaylistRule.mutate(ctx, m)
 	case *PlaylistRuleGroupMutation:
 		return c.PlaylistRuleGroup.mutate(ctx, m)
+	case *PlaylistSectionMutation:
+		return c.PlaylistSection.mutate(ctx, m)
+	case *PlaylistVodMutation:
+		return c.PlaylistVod.mutate(ctx, m)
 	case *QueueMutation:
 		return c.Queue.mutate(ctx, m)
 	case *SessionsMutation:
 		return c.Sessions.mutate(ctx, m)
+	case *ShareLinkMutation:
+		return c.ShareLink.mutate(ctx, m)
+	case *TagMutation:
+		return c.Tag.mutate(ctx, m)
 	case *TwitchCategoryMutation:
 		return c.TwitchCategory.mutate(ctx, m)
 	case *UserMutation:
@@ -374,6 +458,288 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	}
 }
 
+// ApiTokenClient is a client for the ApiToken schema.
+type ApiTokenClient struct {
+	config
+}
+
+// NewApiTokenClient returns a client for the ApiToken from the given config.
+func NewApiTokenClient(c config) *ApiTokenClient {
+	return &ApiTokenClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `apitoken.Hooks(f(g(h())))`.
+func (c *ApiTokenClient) Use(hooks ...Hook) {
+	c.hooks.ApiToken = append(c.hooks.ApiToken, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `apitoken.Intercept(f(g(h())))`.
+func (c *ApiTokenClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ApiToken = append(c.inters.ApiToken, interceptors...)
+}
+
+// Create returns a builder for creating a ApiToken entity.
+func (c *ApiTokenClient) Create() *ApiTokenCreate {
+	mutation := newApiTokenMutation(c.config, OpCreate)
+	return &ApiTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ApiToken entities.
+func (c *ApiTokenClient) CreateBulk(builders ...*ApiTokenCreate) *ApiTokenCreateBulk {
+	return &ApiTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ApiTokenClient) MapCreateBulk(slice any, setFunc func(*ApiTokenCreate, int)) *ApiTokenCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ApiTokenCreateBulk{err: fmt.Errorf("calling to ApiTokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ApiTokenCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ApiTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ApiToken.
+func (c *ApiTokenClient) Update() *ApiTokenUpdate {
+	mutation := newApiTokenMutation(c.config, OpUpdate)
+	return &ApiTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ApiTokenClient) UpdateOne(_m *ApiToken) *ApiTokenUpdateOne {
+	mutation := newApiTokenMutation(c.config, OpUpdateOne, withApiToken(_m))
+	return &ApiTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ApiTokenClient) UpdateOneID(id uuid.UUID) *ApiTokenUpdateOne {
+	mutation := newApiTokenMutation(c.config, OpUpdateOne, withApiTokenID(id))
+	return &ApiTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ApiToken.
+func (c *ApiTokenClient) Delete() *ApiTokenDelete {
+	mutation := newApiTokenMutation(c.config, OpDelete)
+	return &ApiTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ApiTokenClient) DeleteOne(_m *ApiToken) *ApiTokenDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ApiTokenClient) DeleteOneID(id uuid.UUID) *ApiTokenDeleteOne {
+	builder := c.Delete().Where(apitoken.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ApiTokenDeleteOne{builder}
+}
+
+// Query returns a query builder for ApiToken.
+func (c *ApiTokenClient) Query() *ApiTokenQuery {
+	return &ApiTokenQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeApiToken},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ApiToken entity by its id.
+func (c *ApiTokenClient) Get(ctx context.Context, id uuid.UUID) (*ApiToken, error) {
+	return c.Query().Where(apitoken.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ApiTokenClient) GetX(ctx context.Context, id uuid.UUID) *ApiToken {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a ApiToken.
+func (c *ApiTokenClient) QueryUser(_m *ApiToken) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(apitoken.Table, apitoken.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, apitoken.UserTable, apitoken.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ApiTokenClient) Hooks() []Hook {
+	return c.hooks.ApiToken
+}
+
+// Interceptors returns the client interceptors.
+func (c *ApiTokenClient) Interceptors() []Interceptor {
+	return c.inters.ApiToken
+}
+
+func (c *ApiTokenClient) mutate(ctx context.Context, m *ApiTokenMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ApiTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ApiTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ApiTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ApiTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ApiToken mutation op: %q", m.Op())
+	}
+}
+
+// AuditLogClient is a client for the AuditLog schema.
+type AuditLogClient struct {
+	config
+}
+
+// NewAuditLogClient returns a client for the AuditLog from the given config.
+func NewAuditLogClient(c config) *AuditLogClient {
+	return &AuditLogClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `auditlog.Hooks(f(g(h())))`.
+func (c *AuditLogClient) Use(hooks ...Hook) {
+	c.hooks.AuditLog = append(c.hooks.AuditLog, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `auditlog.Intercept(f(g(h())))`.
+func (c *AuditLogClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AuditLog = append(c.inters.AuditLog, interceptors...)
+}
+
+// Create returns a builder for creating a AuditLog entity.
+func (c *AuditLogClient) Create() *AuditLogCreate {
+	mutation := newAuditLogMutation(c.config, OpCreate)
+	return &AuditLogCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AuditLog entities.
+func (c *AuditLogClient) CreateBulk(builders ...*AuditLogCreate) *AuditLogCreateBulk {
+	return &AuditLogCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AuditLogClient) MapCreateBulk(slice any, setFunc func(*AuditLogCreate, int)) *AuditLogCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AuditLogCreateBulk{err: fmt.Errorf("calling to AuditLogClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AuditLogCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AuditLogCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AuditLog.
+func (c *AuditLogClient) Update() *AuditLogUpdate {
+	mutation := newAuditLogMutation(c.config, OpUpdate)
+	return &AuditLogUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AuditLogClient) UpdateOne(_m *AuditLog) *AuditLogUpdateOne {
+	mutation := newAuditLogMutation(c.config, OpUpdateOne, withAuditLog(_m))
+	return &AuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AuditLogClient) UpdateOneID(id uuid.UUID) *AuditLogUpdateOne {
+	mutation := newAuditLogMutation(c.config, OpUpdateOne, withAuditLogID(id))
+	return &AuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AuditLog.
+func (c *AuditLogClient) Delete() *AuditLogDelete {
+	mutation := newAuditLogMutation(c.config, OpDelete)
+	return &AuditLogDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AuditLogClient) DeleteOne(_m *AuditLog) *AuditLogDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AuditLogClient) DeleteOneID(id uuid.UUID) *AuditLogDeleteOne {
+	builder := c.Delete().Where(auditlog.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AuditLogDeleteOne{builder}
+}
+
+// Query returns a query builder for AuditLog.
+func (c *AuditLogClient) Query() *AuditLogQuery {
+	return &AuditLogQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAuditLog},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AuditLog entity by its id.
+func (c *AuditLogClient) Get(ctx context.Context, id uuid.UUID) (*AuditLog, error) {
+	return c.Query().Where(auditlog.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AuditLogClient) GetX(ctx context.Context, id uuid.UUID) *AuditLog {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AuditLogClient) Hooks() []Hook {
+	return c.hooks.AuditLog
+}
+
+// Interceptors returns the client interceptors.
+func (c *AuditLogClient) Interceptors() []Interceptor {
+	return c.inters.AuditLog
+}
+
+func (c *AuditLogClient) mutate(ctx context.Context, m *AuditLogMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AuditLogCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AuditLogUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AuditLogDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AuditLog mutation op: %q", m.Op())
+	}
+}
+
 // BlockedVideosClient is a client for the BlockedVideos schema.
 type BlockedVideosClient struct {
 	config
@@ -507,6 +873,155 @@ func (c *BlockedVideosClient) mutate(ctx context.Context, m *BlockedVideosMutati
 	}
 }
 
+// BulkOperationClient is a client for the BulkOperation schema.
+type BulkOperationClient struct {
+	config
+}
+
+// NewBulkOperationClient returns a client for the BulkOperation from the given config.
+func NewBulkOperationClient(c config) *BulkOperationClient {
+	return &BulkOperationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bulkoperation.Hooks(f(g(h())))`.
+func (c *BulkOperationClient) Use(hooks ...Hook) {
+	c.hooks.BulkOperation = append(c.hooks.BulkOperation, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bulkoperation.Intercept(f(g(h())))`.
+func (c *BulkOperationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BulkOperation = append(c.inters.BulkOperation, interceptors...)
+}
+
+// Create returns a builder for creating a BulkOperation entity.
+func (c *BulkOperationClient) Create() *BulkOperationCreate {
+	mutation := newBulkOperationMutation(c.config, OpCreate)
+	return &BulkOperationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BulkOperation entities.
+func (c *BulkOperationClient) CreateBulk(builders ...*BulkOperationCreate) *BulkOperationCreateBulk {
+	return &BulkOperationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BulkOperationClient) MapCreateBulk(slice any, setFunc func(*BulkOperationCreate, int)) *BulkOperationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BulkOperationCreateBulk{err: fmt.Errorf("calling to BulkOperationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BulkOperationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BulkOperationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BulkOperation.
+func (c *BulkOperationClient) Update() *BulkOperationUpdate {
+	mutation := newBulkOperationMutation(c.config, OpUpdate)
+	return &BulkOperationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BulkOperationClient) UpdateOne(_m *BulkOperation) *BulkOperationUpdateOne {
+	mutation := newBulkOperationMutation(c.config, OpUpdateOne, withBulkOperation(_m))
+	return &BulkOperationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BulkOperationClient) UpdateOneID(id uuid.UUID) *BulkOperationUpdateOne {
+	mutation := newBulkOperationMutation(c.config, OpUpdateOne, withBulkOperationID(id))
+	return &BulkOperationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BulkOperation.
+func (c *BulkOperationClient) Delete() *BulkOperationDelete {
+	mutation := newBulkOperationMutation(c.config, OpDelete)
+	return &BulkOperationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BulkOperationClient) DeleteOne(_m *BulkOperation) *BulkOperationDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BulkOperationClient) DeleteOneID(id uuid.UUID) *BulkOperationDeleteOne {
+	builder := c.Delete().Where(bulkoperation.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BulkOperationDeleteOne{builder}
+}
+
+// Query returns a query builder for BulkOperation.
+func (c *BulkOperationClient) Query() *BulkOperationQuery {
+	return &BulkOperationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBulkOperation},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BulkOperation entity by its id.
+func (c *BulkOperationClient) Get(ctx context.Context, id uuid.UUID) (*BulkOperation, error) {
+	return c.Query().Where(bulkoperation.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BulkOperationClient) GetX(ctx context.Context, id uuid.UUID) *BulkOperation {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCreatedBy queries the created_by edge of a BulkOperation.
+func (c *BulkOperationClient) QueryCreatedBy(_m *BulkOperation) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(bulkoperation.Table, bulkoperation.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, bulkoperation.CreatedByTable, bulkoperation.CreatedByColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *BulkOperationClient) Hooks() []Hook {
+	return c.hooks.BulkOperation
+}
+
+// Interceptors returns the client interceptors.
+func (c *BulkOperationClient) Interceptors() []Interceptor {
+	return c.inters.BulkOperation
+}
+
+func (c *BulkOperationClient) mutate(ctx context.Context, m *BulkOperationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BulkOperationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BulkOperationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BulkOperationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BulkOperationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown BulkOperation mutation op: %q", m.Op())
+	}
+}
+
 // ChannelClient is a client for the Channel schema.
 type ChannelClient struct {
 	config
@@ -663,17 +1178,49 @@ func (c *ChannelClient) QueryYoutubeConfig(_m *Channel) *YoutubeConfigQuery {
 	return query
 }
 
-// Hooks returns the client hooks.
-func (c *ChannelClient) Hooks() []Hook {
-	return c.hooks.Channel
-}
-
-// Interceptors returns the client interceptors.
-func (c *ChannelClient) Interceptors() []Interceptor {
-	return c.inters.Channel
-}
-
-func (c *ChannelClient) mutate(ctx context.Context, m *ChannelMutation) (Value, error) {
+// QueryNotificationSubscriptions queries the notification_subscriptions edge of a Channel.
+func (c *ChannelClient) QueryNotificationSubscriptions(_m *Channel) *NotificationSubscriptionQuery {
+	query := (&NotificationSubscriptionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(channel.Table, channel.FieldID, id),
+			sqlgraph.To(notificationsubscription.Table, notificationsubscription.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, channel.NotificationSubscriptionsTable, channel.NotificationSubscriptionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAllowedUsers queries the allowed_users edge of a Channel.
+func (c *ChannelClient) QueryAllowedUsers(_m *Channel) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(channel.Table, channel.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, channel.AllowedUsersTable, channel.AllowedUsersPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ChannelClient) Hooks() []Hook {
+	return c.hooks.Channel
+}
+
+// Interceptors returns the client interceptors.
+func (c *ChannelClient) Interceptors() []Interceptor {
+	return c.inters.Channel
+}
+
+func (c *ChannelClient) mutate(ctx context.Context, m *ChannelMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
 		return (&ChannelCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
@@ -1316,6 +1863,139 @@ func (c *LiveTitleRegexClient) mutate(ctx context.Context, m *LiveTitleRegexMuta
 	}
 }
 
+// LoginThrottleClient is a client for the LoginThrottle schema.
+type LoginThrottleClient struct {
+	config
+}
+
+// NewLoginThrottleClient returns a client for the LoginThrottle from the given config.
+func NewLoginThrottleClient(c config) *LoginThrottleClient {
+	return &LoginThrottleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `loginthrottle.Hooks(f(g(h())))`.
+func (c *LoginThrottleClient) Use(hooks ...Hook) {
+	c.hooks.LoginThrottle = append(c.hooks.LoginThrottle, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `loginthrottle.Intercept(f(g(h())))`.
+func (c *LoginThrottleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LoginThrottle = append(c.inters.LoginThrottle, interceptors...)
+}
+
+// Create returns a builder for creating a LoginThrottle entity.
+func (c *LoginThrottleClient) Create() *LoginThrottleCreate {
+	mutation := newLoginThrottleMutation(c.config, OpCreate)
+	return &LoginThrottleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LoginThrottle entities.
+func (c *LoginThrottleClient) CreateBulk(builders ...*LoginThrottleCreate) *LoginThrottleCreateBulk {
+	return &LoginThrottleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LoginThrottleClient) MapCreateBulk(slice any, setFunc func(*LoginThrottleCreate, int)) *LoginThrottleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LoginThrottleCreateBulk{err: fmt.Errorf("calling to LoginThrottleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LoginThrottleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LoginThrottleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LoginThrottle.
+func (c *LoginThrottleClient) Update() *LoginThrottleUpdate {
+	mutation := newLoginThrottleMutation(c.config, OpUpdate)
+	return &LoginThrottleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LoginThrottleClient) UpdateOne(_m *LoginThrottle) *LoginThrottleUpdateOne {
+	mutation := newLoginThrottleMutation(c.config, OpUpdateOne, withLoginThrottle(_m))
+	return &LoginThrottleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LoginThrottleClient) UpdateOneID(id uuid.UUID) *LoginThrottleUpdateOne {
+	mutation := newLoginThrottleMutation(c.config, OpUpdateOne, withLoginThrottleID(id))
+	return &LoginThrottleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LoginThrottle.
+func (c *LoginThrottleClient) Delete() *LoginThrottleDelete {
+	mutation := newLoginThrottleMutation(c.config, OpDelete)
+	return &LoginThrottleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LoginThrottleClient) DeleteOne(_m *LoginThrottle) *LoginThrottleDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LoginThrottleClient) DeleteOneID(id uuid.UUID) *LoginThrottleDeleteOne {
+	builder := c.Delete().Where(loginthrottle.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LoginThrottleDeleteOne{builder}
+}
+
+// Query returns a query builder for LoginThrottle.
+func (c *LoginThrottleClient) Query() *LoginThrottleQuery {
+	return &LoginThrottleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLoginThrottle},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LoginThrottle entity by its id.
+func (c *LoginThrottleClient) Get(ctx context.Context, id uuid.UUID) (*LoginThrottle, error) {
+	return c.Query().Where(loginthrottle.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LoginThrottleClient) GetX(ctx context.Context, id uuid.UUID) *LoginThrottle {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *LoginThrottleClient) Hooks() []Hook {
+	return c.hooks.LoginThrottle
+}
+
+// Interceptors returns the client interceptors.
+func (c *LoginThrottleClient) Interceptors() []Interceptor {
+	return c.inters.LoginThrottle
+}
+
+func (c *LoginThrottleClient) mutate(ctx context.Context, m *LoginThrottleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LoginThrottleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LoginThrottleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LoginThrottleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LoginThrottleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LoginThrottle mutation op: %q", m.Op())
+	}
+}
+
 // MultistreamInfoClient is a client for the MultistreamInfo schema.
 type MultistreamInfoClient struct {
 	config
@@ -1630,107 +2310,107 @@ func (c *MutedSegmentClient) mutate(ctx context.Context, m *MutedSegmentMutation
 	}
 }
 
-// PlaybackClient is a client for the Playback schema.
-type PlaybackClient struct {
+// NotificationFailureClient is a client for the NotificationFailure schema.
+type NotificationFailureClient struct {
 	config
 }
 
-// NewPlaybackClient returns a client for the Playback from the given config.
-func NewPlaybackClient(c config) *PlaybackClient {
-	return &PlaybackClient{config: c}
+// NewNotificationFailureClient returns a client for the NotificationFailure from the given config.
+func NewNotificationFailureClient(c config) *NotificationFailureClient {
+	return &NotificationFailureClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `playback.Hooks(f(g(h())))`.
-func (c *PlaybackClient) Use(hooks ...Hook) {
-	c.hooks.Playback = append(c.hooks.Playback, hooks...)
+// A call to `Use(f, g, h)` equals to `notificationfailure.Hooks(f(g(h())))`.
+func (c *NotificationFailureClient) Use(hooks ...Hook) {
+	c.hooks.NotificationFailure = append(c.hooks.NotificationFailure, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `playback.Intercept(f(g(h())))`.
-func (c *PlaybackClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Playback = append(c.inters.Playback, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `notificationfailure.Intercept(f(g(h())))`.
+func (c *NotificationFailureClient) Intercept(interceptors ...Interceptor) {
+	c.inters.NotificationFailure = append(c.inters.NotificationFailure, interceptors...)
 }
 
-// Create returns a builder for creating a Playback entity.
-func (c *PlaybackClient) Create() *PlaybackCreate {
-	mutation := newPlaybackMutation(c.config, OpCreate)
-	return &PlaybackCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a NotificationFailure entity.
+func (c *NotificationFailureClient) Create() *NotificationFailureCreate {
+	mutation := newNotificationFailureMutation(c.config, OpCreate)
+	return &NotificationFailureCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Playback entities.
-func (c *PlaybackClient) CreateBulk(builders ...*PlaybackCreate) *PlaybackCreateBulk {
-	return &PlaybackCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of NotificationFailure entities.
+func (c *NotificationFailureClient) CreateBulk(builders ...*NotificationFailureCreate) *NotificationFailureCreateBulk {
+	return &NotificationFailureCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PlaybackClient) MapCreateBulk(slice any, setFunc func(*PlaybackCreate, int)) *PlaybackCreateBulk {
+func (c *NotificationFailureClient) MapCreateBulk(slice any, setFunc func(*NotificationFailureCreate, int)) *NotificationFailureCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PlaybackCreateBulk{err: fmt.Errorf("calling to PlaybackClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &NotificationFailureCreateBulk{err: fmt.Errorf("calling to NotificationFailureClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PlaybackCreate, rv.Len())
+	builders := make([]*NotificationFailureCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PlaybackCreateBulk{config: c.config, builders: builders}
+	return &NotificationFailureCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Playback.
-func (c *PlaybackClient) Update() *PlaybackUpdate {
-	mutation := newPlaybackMutation(c.config, OpUpdate)
-	return &PlaybackUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for NotificationFailure.
+func (c *NotificationFailureClient) Update() *NotificationFailureUpdate {
+	mutation := newNotificationFailureMutation(c.config, OpUpdate)
+	return &NotificationFailureUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PlaybackClient) UpdateOne(_m *Playback) *PlaybackUpdateOne {
-	mutation := newPlaybackMutation(c.config, OpUpdateOne, withPlayback(_m))
-	return &PlaybackUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *NotificationFailureClient) UpdateOne(_m *NotificationFailure) *NotificationFailureUpdateOne {
+	mutation := newNotificationFailureMutation(c.config, OpUpdateOne, withNotificationFailure(_m))
+	return &NotificationFailureUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PlaybackClient) UpdateOneID(id uuid.UUID) *PlaybackUpdateOne {
-	mutation := newPlaybackMutation(c.config, OpUpdateOne, withPlaybackID(id))
-	return &PlaybackUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *NotificationFailureClient) UpdateOneID(id uuid.UUID) *NotificationFailureUpdateOne {
+	mutation := newNotificationFailureMutation(c.config, OpUpdateOne, withNotificationFailureID(id))
+	return &NotificationFailureUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Playback.
-func (c *PlaybackClient) Delete() *PlaybackDelete {
-	mutation := newPlaybackMutation(c.config, OpDelete)
-	return &PlaybackDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for NotificationFailure.
+func (c *NotificationFailureClient) Delete() *NotificationFailureDelete {
+	mutation := newNotificationFailureMutation(c.config, OpDelete)
+	return &NotificationFailureDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PlaybackClient) DeleteOne(_m *Playback) *PlaybackDeleteOne {
+func (c *NotificationFailureClient) DeleteOne(_m *NotificationFailure) *NotificationFailureDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PlaybackClient) DeleteOneID(id uuid.UUID) *PlaybackDeleteOne {
-	builder := c.Delete().Where(playback.ID(id))
+func (c *NotificationFailureClient) DeleteOneID(id uuid.UUID) *NotificationFailureDeleteOne {
+	builder := c.Delete().Where(notificationfailure.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PlaybackDeleteOne{builder}
+	return &NotificationFailureDeleteOne{builder}
 }
 
-// Query returns a query builder for Playback.
-func (c *PlaybackClient) Query() *PlaybackQuery {
-	return &PlaybackQuery{
+// Query returns a query builder for NotificationFailure.
+func (c *NotificationFailureClient) Query() *NotificationFailureQuery {
+	return &NotificationFailureQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePlayback},
+		ctx:    &QueryContext{Type: TypeNotificationFailure},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Playback entity by its id.
-func (c *PlaybackClient) Get(ctx context.Context, id uuid.UUID) (*Playback, error) {
-	return c.Query().Where(playback.ID(id)).Only(ctx)
+// Get returns a NotificationFailure entity by its id.
+func (c *NotificationFailureClient) Get(ctx context.Context, id uuid.UUID) (*NotificationFailure, error) {
+	return c.Query().Where(notificationfailure.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PlaybackClient) GetX(ctx context.Context, id uuid.UUID) *Playback {
+func (c *NotificationFailureClient) GetX(ctx context.Context, id uuid.UUID) *NotificationFailure {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1739,131 +2419,131 @@ func (c *PlaybackClient) GetX(ctx context.Context, id uuid.UUID) *Playback {
 }
 
 // Hooks returns the client hooks.
-func (c *PlaybackClient) Hooks() []Hook {
-	return c.hooks.Playback
+func (c *NotificationFailureClient) Hooks() []Hook {
+	return c.hooks.NotificationFailure
 }
 
 // Interceptors returns the client interceptors.
-func (c *PlaybackClient) Interceptors() []Interceptor {
-	return c.inters.Playback
+func (c *NotificationFailureClient) Interceptors() []Interceptor {
+	return c.inters.NotificationFailure
 }
 
-func (c *PlaybackClient) mutate(ctx context.Context, m *PlaybackMutation) (Value, error) {
+func (c *NotificationFailureClient) mutate(ctx context.Context, m *NotificationFailureMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PlaybackCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&NotificationFailureCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PlaybackUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&NotificationFailureUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PlaybackUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&NotificationFailureUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PlaybackDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&NotificationFailureDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Playback mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown NotificationFailure mutation op: %q", m.Op())
 	}
 }
 
-// PlaylistClient is a client for the Playlist schema.
-type PlaylistClient struct {
+// NotificationSubscriptionClient is a client for the NotificationSubscription schema.
+type NotificationSubscriptionClient struct {
 	config
 }
 
-// NewPlaylistClient returns a client for the Playlist from the given config.
-func NewPlaylistClient(c config) *PlaylistClient {
-	return &PlaylistClient{config: c}
+// NewNotificationSubscriptionClient returns a client for the NotificationSubscription from the given config.
+func NewNotificationSubscriptionClient(c config) *NotificationSubscriptionClient {
+	return &NotificationSubscriptionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `playlist.Hooks(f(g(h())))`.
-func (c *PlaylistClient) Use(hooks ...Hook) {
-	c.hooks.Playlist = append(c.hooks.Playlist, hooks...)
+// A call to `Use(f, g, h)` equals to `notificationsubscription.Hooks(f(g(h())))`.
+func (c *NotificationSubscriptionClient) Use(hooks ...Hook) {
+	c.hooks.NotificationSubscription = append(c.hooks.NotificationSubscription, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `playlist.Intercept(f(g(h())))`.
-func (c *PlaylistClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Playlist = append(c.inters.Playlist, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `notificationsubscription.Intercept(f(g(h())))`.
+func (c *NotificationSubscriptionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.NotificationSubscription = append(c.inters.NotificationSubscription, interceptors...)
 }
 
-// Create returns a builder for creating a Playlist entity.
-func (c *PlaylistClient) Create() *PlaylistCreate {
-	mutation := newPlaylistMutation(c.config, OpCreate)
-	return &PlaylistCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a NotificationSubscription entity.
+func (c *NotificationSubscriptionClient) Create() *NotificationSubscriptionCreate {
+	mutation := newNotificationSubscriptionMutation(c.config, OpCreate)
+	return &NotificationSubscriptionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Playlist entities.
-func (c *PlaylistClient) CreateBulk(builders ...*PlaylistCreate) *PlaylistCreateBulk {
-	return &PlaylistCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of NotificationSubscription entities.
+func (c *NotificationSubscriptionClient) CreateBulk(builders ...*NotificationSubscriptionCreate) *NotificationSubscriptionCreateBulk {
+	return &NotificationSubscriptionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PlaylistClient) MapCreateBulk(slice any, setFunc func(*PlaylistCreate, int)) *PlaylistCreateBulk {
+func (c *NotificationSubscriptionClient) MapCreateBulk(slice any, setFunc func(*NotificationSubscriptionCreate, int)) *NotificationSubscriptionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PlaylistCreateBulk{err: fmt.Errorf("calling to PlaylistClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &NotificationSubscriptionCreateBulk{err: fmt.Errorf("calling to NotificationSubscriptionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PlaylistCreate, rv.Len())
+	builders := make([]*NotificationSubscriptionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PlaylistCreateBulk{config: c.config, builders: builders}
+	return &NotificationSubscriptionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Playlist.
-func (c *PlaylistClient) Update() *PlaylistUpdate {
-	mutation := newPlaylistMutation(c.config, OpUpdate)
-	return &PlaylistUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for NotificationSubscription.
+func (c *NotificationSubscriptionClient) Update() *NotificationSubscriptionUpdate {
+	mutation := newNotificationSubscriptionMutation(c.config, OpUpdate)
+	return &NotificationSubscriptionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PlaylistClient) UpdateOne(_m *Playlist) *PlaylistUpdateOne {
-	mutation := newPlaylistMutation(c.config, OpUpdateOne, withPlaylist(_m))
-	return &PlaylistUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *NotificationSubscriptionClient) UpdateOne(_m *NotificationSubscription) *NotificationSubscriptionUpdateOne {
+	mutation := newNotificationSubscriptionMutation(c.config, OpUpdateOne, withNotificationSubscription(_m))
+	return &NotificationSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PlaylistClient) UpdateOneID(id uuid.UUID) *PlaylistUpdateOne {
-	mutation := newPlaylistMutation(c.config, OpUpdateOne, withPlaylistID(id))
-	return &PlaylistUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *NotificationSubscriptionClient) UpdateOneID(id uuid.UUID) *NotificationSubscriptionUpdateOne {
+	mutation := newNotificationSubscriptionMutation(c.config, OpUpdateOne, withNotificationSubscriptionID(id))
+	return &NotificationSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Playlist.
-func (c *PlaylistClient) Delete() *PlaylistDelete {
-	mutation := newPlaylistMutation(c.config, OpDelete)
-	return &PlaylistDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for NotificationSubscription.
+func (c *NotificationSubscriptionClient) Delete() *NotificationSubscriptionDelete {
+	mutation := newNotificationSubscriptionMutation(c.config, OpDelete)
+	return &NotificationSubscriptionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PlaylistClient) DeleteOne(_m *Playlist) *PlaylistDeleteOne {
+func (c *NotificationSubscriptionClient) DeleteOne(_m *NotificationSubscription) *NotificationSubscriptionDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PlaylistClient) DeleteOneID(id uuid.UUID) *PlaylistDeleteOne {
-	builder := c.Delete().Where(playlist.ID(id))
+func (c *NotificationSubscriptionClient) DeleteOneID(id uuid.UUID) *NotificationSubscriptionDeleteOne {
+	builder := c.Delete().Where(notificationsubscription.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PlaylistDeleteOne{builder}
+	return &NotificationSubscriptionDeleteOne{builder}
 }
 
-// Query returns a query builder for Playlist.
-func (c *PlaylistClient) Query() *PlaylistQuery {
-	return &PlaylistQuery{
+// Query returns a query builder for NotificationSubscription.
+func (c *NotificationSubscriptionClient) Query() *NotificationSubscriptionQuery {
+	return &NotificationSubscriptionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePlaylist},
+		ctx:    &QueryContext{Type: TypeNotificationSubscription},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Playlist entity by its id.
-func (c *PlaylistClient) Get(ctx context.Context, id uuid.UUID) (*Playlist, error) {
-	return c.Query().Where(playlist.ID(id)).Only(ctx)
+// Get returns a NotificationSubscription entity by its id.
+func (c *NotificationSubscriptionClient) Get(ctx context.Context, id uuid.UUID) (*NotificationSubscription, error) {
+	return c.Query().Where(notificationsubscription.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PlaylistClient) GetX(ctx context.Context, id uuid.UUID) *Playlist {
+func (c *NotificationSubscriptionClient) GetX(ctx context.Context, id uuid.UUID) *NotificationSubscription {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1871,15 +2551,15 @@ func (c *PlaylistClient) GetX(ctx context.Context, id uuid.UUID) *Playlist {
 	return obj
 }
 
-// QueryVods queries the vods edge of a Playlist.
-func (c *PlaylistClient) QueryVods(_m *Playlist) *VodQuery {
-	query := (&VodClient{config: c.config}).Query()
+// QueryUser queries the user edge of a NotificationSubscription.
+func (c *NotificationSubscriptionClient) QueryUser(_m *NotificationSubscription) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(playlist.Table, playlist.FieldID, id),
-			sqlgraph.To(vod.Table, vod.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, false, playlist.VodsTable, playlist.VodsPrimaryKey...),
+			sqlgraph.From(notificationsubscription.Table, notificationsubscription.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, notificationsubscription.UserTable, notificationsubscription.UserColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1887,15 +2567,15 @@ func (c *PlaylistClient) QueryVods(_m *Playlist) *VodQuery {
 	return query
 }
 
-// QueryMultistreamInfo queries the multistream_info edge of a Playlist.
-func (c *PlaylistClient) QueryMultistreamInfo(_m *Playlist) *MultistreamInfoQuery {
-	query := (&MultistreamInfoClient{config: c.config}).Query()
+// QueryChannel queries the channel edge of a NotificationSubscription.
+func (c *NotificationSubscriptionClient) QueryChannel(_m *NotificationSubscription) *ChannelQuery {
+	query := (&ChannelClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(playlist.Table, playlist.FieldID, id),
-			sqlgraph.To(multistreaminfo.Table, multistreaminfo.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, playlist.MultistreamInfoTable, playlist.MultistreamInfoColumn),
+			sqlgraph.From(notificationsubscription.Table, notificationsubscription.FieldID, id),
+			sqlgraph.To(channel.Table, channel.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, notificationsubscription.ChannelTable, notificationsubscription.ChannelColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1903,15 +2583,15 @@ func (c *PlaylistClient) QueryMultistreamInfo(_m *Playlist) *MultistreamInfoQuer
 	return query
 }
 
-// QueryRuleGroups queries the rule_groups edge of a Playlist.
-func (c *PlaylistClient) QueryRuleGroups(_m *Playlist) *PlaylistRuleGroupQuery {
-	query := (&PlaylistRuleGroupClient{config: c.config}).Query()
+// QueryPlaylist queries the playlist edge of a NotificationSubscription.
+func (c *NotificationSubscriptionClient) QueryPlaylist(_m *NotificationSubscription) *PlaylistQuery {
+	query := (&PlaylistClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(playlist.Table, playlist.FieldID, id),
-			sqlgraph.To(playlistrulegroup.Table, playlistrulegroup.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, playlist.RuleGroupsTable, playlist.RuleGroupsColumn),
+			sqlgraph.From(notificationsubscription.Table, notificationsubscription.FieldID, id),
+			sqlgraph.To(playlist.Table, playlist.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, notificationsubscription.PlaylistTable, notificationsubscription.PlaylistColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1920,131 +2600,131 @@ func (c *PlaylistClient) QueryRuleGroups(_m *Playlist) *PlaylistRuleGroupQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *PlaylistClient) Hooks() []Hook {
-	return c.hooks.Playlist
+func (c *NotificationSubscriptionClient) Hooks() []Hook {
+	return c.hooks.NotificationSubscription
 }
 
 // Interceptors returns the client interceptors.
-func (c *PlaylistClient) Interceptors() []Interceptor {
-	return c.inters.Playlist
+func (c *NotificationSubscriptionClient) Interceptors() []Interceptor {
+	return c.inters.NotificationSubscription
 }
 
-func (c *PlaylistClient) mutate(ctx context.Context, m *PlaylistMutation) (Value, error) {
+func (c *NotificationSubscriptionClient) mutate(ctx context.Context, m *NotificationSubscriptionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PlaylistCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&NotificationSubscriptionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PlaylistUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&NotificationSubscriptionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PlaylistUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&NotificationSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PlaylistDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&NotificationSubscriptionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Playlist mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown NotificationSubscription mutation op: %q", m.Op())
 	}
 }
 
-// PlaylistRuleClient is a client for the PlaylistRule schema.
-type PlaylistRuleClient struct {
+// PlaybackClient is a client for the Playback schema.
+type PlaybackClient struct {
 	config
 }
 
-// NewPlaylistRuleClient returns a client for the PlaylistRule from the given config.
-func NewPlaylistRuleClient(c config) *PlaylistRuleClient {
-	return &PlaylistRuleClient{config: c}
+// NewPlaybackClient returns a client for the Playback from the given config.
+func NewPlaybackClient(c config) *PlaybackClient {
+	return &PlaybackClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `playlistrule.Hooks(f(g(h())))`.
-func (c *PlaylistRuleClient) Use(hooks ...Hook) {
-	c.hooks.PlaylistRule = append(c.hooks.PlaylistRule, hooks...)
+// A call to `Use(f, g, h)` equals to `playback.Hooks(f(g(h())))`.
+func (c *PlaybackClient) Use(hooks ...Hook) {
+	c.hooks.Playback = append(c.hooks.Playback, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `playlistrule.Intercept(f(g(h())))`.
-func (c *PlaylistRuleClient) Intercept(interceptors ...Interceptor) {
-	c.inters.PlaylistRule = append(c.inters.PlaylistRule, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `playback.Intercept(f(g(h())))`.
+func (c *PlaybackClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Playback = append(c.inters.Playback, interceptors...)
 }
 
-// Create returns a builder for creating a PlaylistRule entity.
-func (c *PlaylistRuleClient) Create() *PlaylistRuleCreate {
-	mutation := newPlaylistRuleMutation(c.config, OpCreate)
-	return &PlaylistRuleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Playback entity.
+func (c *PlaybackClient) Create() *PlaybackCreate {
+	mutation := newPlaybackMutation(c.config, OpCreate)
+	return &PlaybackCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of PlaylistRule entities.
-func (c *PlaylistRuleClient) CreateBulk(builders ...*PlaylistRuleCreate) *PlaylistRuleCreateBulk {
-	return &PlaylistRuleCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Playback entities.
+func (c *PlaybackClient) CreateBulk(builders ...*PlaybackCreate) *PlaybackCreateBulk {
+	return &PlaybackCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PlaylistRuleClient) MapCreateBulk(slice any, setFunc func(*PlaylistRuleCreate, int)) *PlaylistRuleCreateBulk {
+func (c *PlaybackClient) MapCreateBulk(slice any, setFunc func(*PlaybackCreate, int)) *PlaybackCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PlaylistRuleCreateBulk{err: fmt.Errorf("calling to PlaylistRuleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &PlaybackCreateBulk{err: fmt.Errorf("calling to PlaybackClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PlaylistRuleCreate, rv.Len())
+	builders := make([]*PlaybackCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PlaylistRuleCreateBulk{config: c.config, builders: builders}
+	return &PlaybackCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for PlaylistRule.
-func (c *PlaylistRuleClient) Update() *PlaylistRuleUpdate {
-	mutation := newPlaylistRuleMutation(c.config, OpUpdate)
-	return &PlaylistRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Playback.
+func (c *PlaybackClient) Update() *PlaybackUpdate {
+	mutation := newPlaybackMutation(c.config, OpUpdate)
+	return &PlaybackUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PlaylistRuleClient) UpdateOne(_m *PlaylistRule) *PlaylistRuleUpdateOne {
-	mutation := newPlaylistRuleMutation(c.config, OpUpdateOne, withPlaylistRule(_m))
-	return &PlaylistRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *PlaybackClient) UpdateOne(_m *Playback) *PlaybackUpdateOne {
+	mutation := newPlaybackMutation(c.config, OpUpdateOne, withPlayback(_m))
+	return &PlaybackUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PlaylistRuleClient) UpdateOneID(id uuid.UUID) *PlaylistRuleUpdateOne {
-	mutation := newPlaylistRuleMutation(c.config, OpUpdateOne, withPlaylistRuleID(id))
-	return &PlaylistRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *PlaybackClient) UpdateOneID(id uuid.UUID) *PlaybackUpdateOne {
+	mutation := newPlaybackMutation(c.config, OpUpdateOne, withPlaybackID(id))
+	return &PlaybackUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for PlaylistRule.
-func (c *PlaylistRuleClient) Delete() *PlaylistRuleDelete {
-	mutation := newPlaylistRuleMutation(c.config, OpDelete)
-	return &PlaylistRuleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Playback.
+func (c *PlaybackClient) Delete() *PlaybackDelete {
+	mutation := newPlaybackMutation(c.config, OpDelete)
+	return &PlaybackDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PlaylistRuleClient) DeleteOne(_m *PlaylistRule) *PlaylistRuleDeleteOne {
+func (c *PlaybackClient) DeleteOne(_m *Playback) *PlaybackDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PlaylistRuleClient) DeleteOneID(id uuid.UUID) *PlaylistRuleDeleteOne {
-	builder := c.Delete().Where(playlistrule.ID(id))
+func (c *PlaybackClient) DeleteOneID(id uuid.UUID) *PlaybackDeleteOne {
+	builder := c.Delete().Where(playback.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PlaylistRuleDeleteOne{builder}
+	return &PlaybackDeleteOne{builder}
 }
 
-// Query returns a query builder for PlaylistRule.
-func (c *PlaylistRuleClient) Query() *PlaylistRuleQuery {
-	return &PlaylistRuleQuery{
+// Query returns a query builder for Playback.
+func (c *PlaybackClient) Query() *PlaybackQuery {
+	return &PlaybackQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePlaylistRule},
+		ctx:    &QueryContext{Type: TypePlayback},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a PlaylistRule entity by its id.
-func (c *PlaylistRuleClient) Get(ctx context.Context, id uuid.UUID) (*PlaylistRule, error) {
-	return c.Query().Where(playlistrule.ID(id)).Only(ctx)
+// Get returns a Playback entity by its id.
+func (c *PlaybackClient) Get(ctx context.Context, id uuid.UUID) (*Playback, error) {
+	return c.Query().Where(playback.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PlaylistRuleClient) GetX(ctx context.Context, id uuid.UUID) *PlaylistRule {
+func (c *PlaybackClient) GetX(ctx context.Context, id uuid.UUID) *Playback {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2052,148 +2732,132 @@ func (c *PlaylistRuleClient) GetX(ctx context.Context, id uuid.UUID) *PlaylistRu
 	return obj
 }
 
-// QueryGroup queries the group edge of a PlaylistRule.
-func (c *PlaylistRuleClient) QueryGroup(_m *PlaylistRule) *PlaylistRuleGroupQuery {
-	query := (&PlaylistRuleGroupClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(playlistrule.Table, playlistrule.FieldID, id),
-			sqlgraph.To(playlistrulegroup.Table, playlistrulegroup.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, playlistrule.GroupTable, playlistrule.GroupColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *PlaylistRuleClient) Hooks() []Hook {
-	return c.hooks.PlaylistRule
+func (c *PlaybackClient) Hooks() []Hook {
+	return c.hooks.Playback
 }
 
 // Interceptors returns the client interceptors.
-func (c *PlaylistRuleClient) Interceptors() []Interceptor {
-	return c.inters.PlaylistRule
+func (c *PlaybackClient) Interceptors() []Interceptor {
+	return c.inters.Playback
 }
 
-func (c *PlaylistRuleClient) mutate(ctx context.Context, m *PlaylistRuleMutation) (Value, error) {
+func (c *PlaybackClient) mutate(ctx context.Context, m *PlaybackMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PlaylistRuleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PlaybackCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PlaylistRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PlaybackUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PlaylistRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PlaybackUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PlaylistRuleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&PlaybackDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown PlaylistRule mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Playback mutation op: %q", m.Op())
 	}
 }
 
-// PlaylistRuleGroupClient is a client for the PlaylistRuleGroup schema.
-type PlaylistRuleGroupClient struct {
+// PlaylistClient is a client for the Playlist schema.
+type PlaylistClient struct {
 	config
 }
 
-// NewPlaylistRuleGroupClient returns a client for the PlaylistRuleGroup from the given config.
-func NewPlaylistRuleGroupClient(c config) *PlaylistRuleGroupClient {
-	return &PlaylistRuleGroupClient{config: c}
+// NewPlaylistClient returns a client for the Playlist from the given config.
+func NewPlaylistClient(c config) *PlaylistClient {
+	return &PlaylistClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `playlistrulegroup.Hooks(f(g(h())))`.
-func (c *PlaylistRuleGroupClient) Use(hooks ...Hook) {
-	c.hooks.PlaylistRuleGroup = append(c.hooks.PlaylistRuleGroup, hooks...)
+// A call to `Use(f, g, h)` equals to `playlist.Hooks(f(g(h())))`.
+func (c *PlaylistClient) Use(hooks ...Hook) {
+	c.hooks.Playlist = append(c.hooks.Playlist, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `playlistrulegroup.Intercept(f(g(h())))`.
-func (c *PlaylistRuleGroupClient) Intercept(interceptors ...Interceptor) {
-	c.inters.PlaylistRuleGroup = append(c.inters.PlaylistRuleGroup, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `playlist.Intercept(f(g(h())))`.
+func (c *PlaylistClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Playlist = append(c.inters.Playlist, interceptors...)
 }
 
-// Create returns a builder for creating a PlaylistRuleGroup entity.
-func (c *PlaylistRuleGroupClient) Create() *PlaylistRuleGroupCreate {
-	mutation := newPlaylistRuleGroupMutation(c.config, OpCreate)
-	return &PlaylistRuleGroupCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Playlist entity.
+func (c *PlaylistClient) Create() *PlaylistCreate {
+	mutation := newPlaylistMutation(c.config, OpCreate)
+	return &PlaylistCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of PlaylistRuleGroup entities.
-func (c *PlaylistRuleGroupClient) CreateBulk(builders ...*PlaylistRuleGroupCreate) *PlaylistRuleGroupCreateBulk {
-	return &PlaylistRuleGroupCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Playlist entities.
+func (c *PlaylistClient) CreateBulk(builders ...*PlaylistCreate) *PlaylistCreateBulk {
+	return &PlaylistCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PlaylistRuleGroupClient) MapCreateBulk(slice any, setFunc func(*PlaylistRuleGroupCreate, int)) *PlaylistRuleGroupCreateBulk {
+func (c *PlaylistClient) MapCreateBulk(slice any, setFunc func(*PlaylistCreate, int)) *PlaylistCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PlaylistRuleGroupCreateBulk{err: fmt.Errorf("calling to PlaylistRuleGroupClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &PlaylistCreateBulk{err: fmt.Errorf("calling to PlaylistClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PlaylistRuleGroupCreate, rv.Len())
+	builders := make([]*PlaylistCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PlaylistRuleGroupCreateBulk{config: c.config, builders: builders}
+	return &PlaylistCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for PlaylistRuleGroup.
-func (c *PlaylistRuleGroupClient) Update() *PlaylistRuleGroupUpdate {
-	mutation := newPlaylistRuleGroupMutation(c.config, OpUpdate)
-	return &PlaylistRuleGroupUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Playlist.
+func (c *PlaylistClient) Update() *PlaylistUpdate {
+	mutation := newPlaylistMutation(c.config, OpUpdate)
+	return &PlaylistUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PlaylistRuleGroupClient) UpdateOne(_m *PlaylistRuleGroup) *PlaylistRuleGroupUpdateOne {
-	mutation := newPlaylistRuleGroupMutation(c.config, OpUpdateOne, withPlaylistRuleGroup(_m))
-	return &PlaylistRuleGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *PlaylistClient) UpdateOne(_m *Playlist) *PlaylistUpdateOne {
+	mutation := newPlaylistMutation(c.config, OpUpdateOne, withPlaylist(_m))
+	return &PlaylistUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PlaylistRuleGroupClient) UpdateOneID(id uuid.UUID) *PlaylistRuleGroupUpdateOne {
-	mutation := newPlaylistRuleGroupMutation(c.config, OpUpdateOne, withPlaylistRuleGroupID(id))
-	return &PlaylistRuleGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *PlaylistClient) UpdateOneID(id uuid.UUID) *PlaylistUpdateOne {
+	mutation := newPlaylistMutation(c.config, OpUpdateOne, withPlaylistID(id))
+	return &PlaylistUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for PlaylistRuleGroup.
-func (c *PlaylistRuleGroupClient) Delete() *PlaylistRuleGroupDelete {
-	mutation := newPlaylistRuleGroupMutation(c.config, OpDelete)
-	return &PlaylistRuleGroupDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Playlist.
+func (c *PlaylistClient) Delete() *PlaylistDelete {
+	mutation := newPlaylistMutation(c.config, OpDelete)
+	return &PlaylistDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PlaylistRuleGroupClient) DeleteOne(_m *PlaylistRuleGroup) *PlaylistRuleGroupDeleteOne {
+func (c *PlaylistClient) DeleteOne(_m *Playlist) *PlaylistDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PlaylistRuleGroupClient) DeleteOneID(id uuid.UUID) *PlaylistRuleGroupDeleteOne {
-	builder := c.Delete().Where(playlistrulegroup.ID(id))
+func (c *PlaylistClient) DeleteOneID(id uuid.UUID) *PlaylistDeleteOne {
+	builder := c.Delete().Where(playlist.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PlaylistRuleGroupDeleteOne{builder}
+	return &PlaylistDeleteOne{builder}
 }
 
-// Query returns a query builder for PlaylistRuleGroup.
-func (c *PlaylistRuleGroupClient) Query() *PlaylistRuleGroupQuery {
-	return &PlaylistRuleGroupQuery{
+// Query returns a query builder for Playlist.
+func (c *PlaylistClient) Query() *PlaylistQuery {
+	return &PlaylistQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePlaylistRuleGroup},
+		ctx:    &QueryContext{Type: TypePlaylist},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a PlaylistRuleGroup entity by its id.
-func (c *PlaylistRuleGroupClient) Get(ctx context.Context, id uuid.UUID) (*PlaylistRuleGroup, error) {
-	return c.Query().Where(playlistrulegroup.ID(id)).Only(ctx)
+// Get returns a Playlist entity by its id.
+func (c *PlaylistClient) Get(ctx context.Context, id uuid.UUID) (*Playlist, error) {
+	return c.Query().Where(playlist.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PlaylistRuleGroupClient) GetX(ctx context.Context, id uuid.UUID) *PlaylistRuleGroup {
+func (c *PlaylistClient) GetX(ctx context.Context, id uuid.UUID) *Playlist {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2201,15 +2865,15 @@ func (c *PlaylistRuleGroupClient) GetX(ctx context.Context, id uuid.UUID) *Playl
 	return obj
 }
 
-// QueryPlaylist queries the playlist edge of a PlaylistRuleGroup.
-func (c *PlaylistRuleGroupClient) QueryPlaylist(_m *PlaylistRuleGroup) *PlaylistQuery {
-	query := (&PlaylistClient{config: c.config}).Query()
+// QueryVods queries the vods edge of a Playlist.
+func (c *PlaylistClient) QueryVods(_m *Playlist) *VodQuery {
+	query := (&VodClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(playlistrulegroup.Table, playlistrulegroup.FieldID, id),
-			sqlgraph.To(playlist.Table, playlist.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, playlistrulegroup.PlaylistTable, playlistrulegroup.PlaylistColumn),
+			sqlgraph.From(playlist.Table, playlist.FieldID, id),
+			sqlgraph.To(vod.Table, vod.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, playlist.VodsTable, playlist.VodsPrimaryKey...),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -2217,15 +2881,15 @@ func (c *PlaylistRuleGroupClient) QueryPlaylist(_m *PlaylistRuleGroup) *Playlist
 	return query
 }
 
-// QueryRules queries the rules edge of a PlaylistRuleGroup.
-func (c *PlaylistRuleGroupClient) QueryRules(_m *PlaylistRuleGroup) *PlaylistRuleQuery {
-	query := (&PlaylistRuleClient{config: c.config}).Query()
+// QuerySections queries the sections edge of a Playlist.
+func (c *PlaylistClient) QuerySections(_m *Playlist) *PlaylistSectionQuery {
+	query := (&PlaylistSectionClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(playlistrulegroup.Table, playlistrulegroup.FieldID, id),
-			sqlgraph.To(playlistrule.Table, playlistrule.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, playlistrulegroup.RulesTable, playlistrulegroup.RulesColumn),
+			sqlgraph.From(playlist.Table, playlist.FieldID, id),
+			sqlgraph.To(playlistsection.Table, playlistsection.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, playlist.SectionsTable, playlist.SectionsColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -2233,132 +2897,1121 @@ func (c *PlaylistRuleGroupClient) QueryRules(_m *PlaylistRuleGroup) *PlaylistRul
 	return query
 }
 
-// Hooks returns the client hooks.
-func (c *PlaylistRuleGroupClient) Hooks() []Hook {
-	return c.hooks.PlaylistRuleGroup
+// QueryMultistreamInfo queries the multistream_info edge of a Playlist.
+func (c *PlaylistClient) QueryMultistreamInfo(_m *Playlist) *MultistreamInfoQuery {
+	query := (&MultistreamInfoClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(playlist.Table, playlist.FieldID, id),
+			sqlgraph.To(multistreaminfo.Table, multistreaminfo.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, playlist.MultistreamInfoTable, playlist.MultistreamInfoColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
 }
 
-// Interceptors returns the client interceptors.
-func (c *PlaylistRuleGroupClient) Interceptors() []Interceptor {
+// QueryRuleGroups queries the rule_groups edge of a Playlist.
+func (c *PlaylistClient) QueryRuleGroups(_m *Playlist) *PlaylistRuleGroupQuery {
+	query := (&PlaylistRuleGroupClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(playlist.Table, playlist.FieldID, id),
+			sqlgraph.To(playlistrulegroup.Table, playlistrulegroup.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, playlist.RuleGroupsTable, playlist.RuleGroupsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryNotificationSubscriptions queries the notification_subscriptions edge of a Playlist.
+func (c *PlaylistClient) QueryNotificationSubscriptions(_m *Playlist) *NotificationSubscriptionQuery {
+	query := (&NotificationSubscriptionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(playlist.Table, playlist.FieldID, id),
+			sqlgraph.To(notificationsubscription.Table, notificationsubscription.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, playlist.NotificationSubscriptionsTable, playlist.NotificationSubscriptionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAllowedUsers queries the allowed_users edge of a Playlist.
+func (c *PlaylistClient) QueryAllowedUsers(_m *Playlist) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(playlist.Table, playlist.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, playlist.AllowedUsersTable, playlist.AllowedUsersPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryShareLinks queries the share_links edge of a Playlist.
+func (c *PlaylistClient) QueryShareLinks(_m *Playlist) *ShareLinkQuery {
+	query := (&ShareLinkClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(playlist.Table, playlist.FieldID, id),
+			sqlgraph.To(sharelink.Table, sharelink.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, playlist.ShareLinksTable, playlist.ShareLinksColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryEntries queries the entries edge of a Playlist.
+func (c *PlaylistClient) QueryEntries(_m *Playlist) *PlaylistVodQuery {
+	query := (&PlaylistVodClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(playlist.Table, playlist.FieldID, id),
+			sqlgraph.To(playlistvod.Table, playlistvod.PlaylistColumn),
+			sqlgraph.Edge(sqlgraph.O2M, true, playlist.EntriesTable, playlist.EntriesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PlaylistClient) Hooks() []Hook {
+	return c.hooks.Playlist
+}
+
+// Interceptors returns the client interceptors.
+func (c *PlaylistClient) Interceptors() []Interceptor {
+	return c.inters.Playlist
+}
+
+func (c *PlaylistClient) mutate(ctx context.Context, m *PlaylistMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PlaylistCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PlaylistUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PlaylistUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PlaylistDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Playlist mutation op: %q", m.Op())
+	}
+}
+
+// PlaylistRuleClient is a client for the PlaylistRule schema.
+type PlaylistRuleClient struct {
+	config
+}
+
+// NewPlaylistRuleClient returns a client for the PlaylistRule from the given config.
+func NewPlaylistRuleClient(c config) *PlaylistRuleClient {
+	return &PlaylistRuleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `playlistrule.Hooks(f(g(h())))`.
+func (c *PlaylistRuleClient) Use(hooks ...Hook) {
+	c.hooks.PlaylistRule = append(c.hooks.PlaylistRule, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `playlistrule.Intercept(f(g(h())))`.
+func (c *PlaylistRuleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PlaylistRule = append(c.inters.PlaylistRule, interceptors...)
+}
+
+// Create returns a builder for creating a PlaylistRule entity.
+func (c *PlaylistRuleClient) Create() *PlaylistRuleCreate {
+	mutation := newPlaylistRuleMutation(c.config, OpCreate)
+	return &PlaylistRuleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PlaylistRule entities.
+func (c *PlaylistRuleClient) CreateBulk(builders ...*PlaylistRuleCreate) *PlaylistRuleCreateBulk {
+	return &PlaylistRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PlaylistRuleClient) MapCreateBulk(slice any, setFunc func(*PlaylistRuleCreate, int)) *PlaylistRuleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PlaylistRuleCreateBulk{err: fmt.Errorf("calling to PlaylistRuleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PlaylistRuleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PlaylistRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PlaylistRule.
+func (c *PlaylistRuleClient) Update() *PlaylistRuleUpdate {
+	mutation := newPlaylistRuleMutation(c.config, OpUpdate)
+	return &PlaylistRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PlaylistRuleClient) UpdateOne(_m *PlaylistRule) *PlaylistRuleUpdateOne {
+	mutation := newPlaylistRuleMutation(c.config, OpUpdateOne, withPlaylistRule(_m))
+	return &PlaylistRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PlaylistRuleClient) UpdateOneID(id uuid.UUID) *PlaylistRuleUpdateOne {
+	mutation := newPlaylistRuleMutation(c.config, OpUpdateOne, withPlaylistRuleID(id))
+	return &PlaylistRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PlaylistRule.
+func (c *PlaylistRuleClient) Delete() *PlaylistRuleDelete {
+	mutation := newPlaylistRuleMutation(c.config, OpDelete)
+	return &PlaylistRuleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PlaylistRuleClient) DeleteOne(_m *PlaylistRule) *PlaylistRuleDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PlaylistRuleClient) DeleteOneID(id uuid.UUID) *PlaylistRuleDeleteOne {
+	builder := c.Delete().Where(playlistrule.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PlaylistRuleDeleteOne{builder}
+}
+
+// Query returns a query builder for PlaylistRule.
+func (c *PlaylistRuleClient) Query() *PlaylistRuleQuery {
+	return &PlaylistRuleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePlaylistRule},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PlaylistRule entity by its id.
+func (c *PlaylistRuleClient) Get(ctx context.Context, id uuid.UUID) (*PlaylistRule, error) {
+	return c.Query().Where(playlistrule.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PlaylistRuleClient) GetX(ctx context.Context, id uuid.UUID) *PlaylistRule {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryGroup queries the group edge of a PlaylistRule.
+func (c *PlaylistRuleClient) QueryGroup(_m *PlaylistRule) *PlaylistRuleGroupQuery {
+	query := (&PlaylistRuleGroupClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(playlistrule.Table, playlistrule.FieldID, id),
+			sqlgraph.To(playlistrulegroup.Table, playlistrulegroup.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, playlistrule.GroupTable, playlistrule.GroupColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PlaylistRuleClient) Hooks() []Hook {
+	return c.hooks.PlaylistRule
+}
+
+// Interceptors returns the client interceptors.
+func (c *PlaylistRuleClient) Interceptors() []Interceptor {
+	return c.inters.PlaylistRule
+}
+
+func (c *PlaylistRuleClient) mutate(ctx context.Context, m *PlaylistRuleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PlaylistRuleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PlaylistRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PlaylistRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PlaylistRuleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PlaylistRule mutation op: %q", m.Op())
+	}
+}
+
+// PlaylistRuleGroupClient is a client for the PlaylistRuleGroup schema.
+type PlaylistRuleGroupClient struct {
+	config
+}
+
+// NewPlaylistRuleGroupClient returns a client for the PlaylistRuleGroup from the given config.
+func NewPlaylistRuleGroupClient(c config) *PlaylistRuleGroupClient {
+	return &PlaylistRuleGroupClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `playlistrulegroup.Hooks(f(g(h())))`.
+func (c *PlaylistRuleGroupClient) Use(hooks ...Hook) {
+	c.hooks.PlaylistRuleGroup = append(c.hooks.PlaylistRuleGroup, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `playlistrulegroup.Intercept(f(g(h())))`.
+func (c *PlaylistRuleGroupClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PlaylistRuleGroup = append(c.inters.PlaylistRuleGroup, interceptors...)
+}
+
+// Create returns a builder for creating a PlaylistRuleGroup entity.
+func (c *PlaylistRuleGroupClient) Create() *PlaylistRuleGroupCreate {
+	mutation := newPlaylistRuleGroupMutation(c.config, OpCreate)
+	return &PlaylistRuleGroupCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PlaylistRuleGroup entities.
+func (c *PlaylistRuleGroupClient) CreateBulk(builders ...*PlaylistRuleGroupCreate) *PlaylistRuleGroupCreateBulk {
+	return &PlaylistRuleGroupCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PlaylistRuleGroupClient) MapCreateBulk(slice any, setFunc func(*PlaylistRuleGroupCreate, int)) *PlaylistRuleGroupCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PlaylistRuleGroupCreateBulk{err: fmt.Errorf("calling to PlaylistRuleGroupClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PlaylistRuleGroupCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PlaylistRuleGroupCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PlaylistRuleGroup.
+func (c *PlaylistRuleGroupClient) Update() *PlaylistRuleGroupUpdate {
+	mutation := newPlaylistRuleGroupMutation(c.config, OpUpdate)
+	return &PlaylistRuleGroupUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PlaylistRuleGroupClient) UpdateOne(_m *PlaylistRuleGroup) *PlaylistRuleGroupUpdateOne {
+	mutation := newPlaylistRuleGroupMutation(c.config, OpUpdateOne, withPlaylistRuleGroup(_m))
+	return &PlaylistRuleGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PlaylistRuleGroupClient) UpdateOneID(id uuid.UUID) *PlaylistRuleGroupUpdateOne {
+	mutation := newPlaylistRuleGroupMutation(c.config, OpUpdateOne, withPlaylistRuleGroupID(id))
+	return &PlaylistRuleGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PlaylistRuleGroup.
+func (c *PlaylistRuleGroupClient) Delete() *PlaylistRuleGroupDelete {
+	mutation := newPlaylistRuleGroupMutation(c.config, OpDelete)
+	return &PlaylistRuleGroupDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PlaylistRuleGroupClient) DeleteOne(_m *PlaylistRuleGroup) *PlaylistRuleGroupDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PlaylistRuleGroupClient) DeleteOneID(id uuid.UUID) *PlaylistRuleGroupDeleteOne {
+	builder := c.Delete().Where(playlistrulegroup.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PlaylistRuleGroupDeleteOne{builder}
+}
+
+// Query returns a query builder for PlaylistRuleGroup.
+func (c *PlaylistRuleGroupClient) Query() *PlaylistRuleGroupQuery {
+	return &PlaylistRuleGroupQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePlaylistRuleGroup},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PlaylistRuleGroup entity by its id.
+func (c *PlaylistRuleGroupClient) Get(ctx context.Context, id uuid.UUID) (*PlaylistRuleGroup, error) {
+	return c.Query().Where(playlistrulegroup.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PlaylistRuleGroupClient) GetX(ctx context.Context, id uuid.UUID) *PlaylistRuleGroup {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPlaylist queries the playlist edge of a PlaylistRuleGroup.
+func (c *PlaylistRuleGroupClient) QueryPlaylist(_m *PlaylistRuleGroup) *PlaylistQuery {
+	query := (&PlaylistClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(playlistrulegroup.Table, playlistrulegroup.FieldID, id),
+			sqlgraph.To(playlist.Table, playlist.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, playlistrulegroup.PlaylistTable, playlistrulegroup.PlaylistColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRules queries the rules edge of a PlaylistRuleGroup.
+func (c *PlaylistRuleGroupClient) QueryRules(_m *PlaylistRuleGroup) *PlaylistRuleQuery {
+	query := (&PlaylistRuleClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(playlistrulegroup.Table, playlistrulegroup.FieldID, id),
+			sqlgraph.To(playlistrule.Table, playlistrule.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, playlistrulegroup.RulesTable, playlistrulegroup.RulesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryParent queries the parent edge of a PlaylistRuleGroup.
+func (c *PlaylistRuleGroupClient) QueryParent(_m *PlaylistRuleGroup) *PlaylistRuleGroupQuery {
+	query := (&PlaylistRuleGroupClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(playlistrulegroup.Table, playlistrulegroup.FieldID, id),
+			sqlgraph.To(playlistrulegroup.Table, playlistrulegroup.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, playlistrulegroup.ParentTable, playlistrulegroup.ParentColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChildren queries the children edge of a PlaylistRuleGroup.
+func (c *PlaylistRuleGroupClient) QueryChildren(_m *PlaylistRuleGroup) *PlaylistRuleGroupQuery {
+	query := (&PlaylistRuleGroupClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(playlistrulegroup.Table, playlistrulegroup.FieldID, id),
+			sqlgraph.To(playlistrulegroup.Table, playlistrulegroup.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, playlistrulegroup.ChildrenTable, playlistrulegroup.ChildrenColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PlaylistRuleGroupClient) Hooks() []Hook {
+	return c.hooks.PlaylistRuleGroup
+}
+
+// Interceptors returns the client interceptors.
+func (c *PlaylistRuleGroupClient) Interceptors() []Interceptor {
 	return c.inters.PlaylistRuleGroup
 }
 
-func (c *PlaylistRuleGroupClient) mutate(ctx context.Context, m *PlaylistRuleGroupMutation) (Value, error) {
+func (c *PlaylistRuleGroupClient) mutate(ctx context.Context, m *PlaylistRuleGroupMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PlaylistRuleGroupCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PlaylistRuleGroupUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PlaylistRuleGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PlaylistRuleGroupDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PlaylistRuleGroup mutation op: %q", m.Op())
+	}
+}
+
+// PlaylistSectionClient is a client for the PlaylistSection schema.
+type PlaylistSectionClient struct {
+	config
+}
+
+// NewPlaylistSectionClient returns a client for the PlaylistSection from the given config.
+func NewPlaylistSectionClient(c config) *PlaylistSectionClient {
+	return &PlaylistSectionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `playlistsection.Hooks(f(g(h())))`.
+func (c *PlaylistSectionClient) Use(hooks ...Hook) {
+	c.hooks.PlaylistSection = append(c.hooks.PlaylistSection, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `playlistsection.Intercept(f(g(h())))`.
+func (c *PlaylistSectionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PlaylistSection = append(c.inters.PlaylistSection, interceptors...)
+}
+
+// Create returns a builder for creating a PlaylistSection entity.
+func (c *PlaylistSectionClient) Create() *PlaylistSectionCreate {
+	mutation := newPlaylistSectionMutation(c.config, OpCreate)
+	return &PlaylistSectionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PlaylistSection entities.
+func (c *PlaylistSectionClient) CreateBulk(builders ...*PlaylistSectionCreate) *PlaylistSectionCreateBulk {
+	return &PlaylistSectionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PlaylistSectionClient) MapCreateBulk(slice any, setFunc func(*PlaylistSectionCreate, int)) *PlaylistSectionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PlaylistSectionCreateBulk{err: fmt.Errorf("calling to PlaylistSectionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PlaylistSectionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PlaylistSectionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PlaylistSection.
+func (c *PlaylistSectionClient) Update() *PlaylistSectionUpdate {
+	mutation := newPlaylistSectionMutation(c.config, OpUpdate)
+	return &PlaylistSectionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PlaylistSectionClient) UpdateOne(_m *PlaylistSection) *PlaylistSectionUpdateOne {
+	mutation := newPlaylistSectionMutation(c.config, OpUpdateOne, withPlaylistSection(_m))
+	return &PlaylistSectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PlaylistSectionClient) UpdateOneID(id uuid.UUID) *PlaylistSectionUpdateOne {
+	mutation := newPlaylistSectionMutation(c.config, OpUpdateOne, withPlaylistSectionID(id))
+	return &PlaylistSectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PlaylistSection.
+func (c *PlaylistSectionClient) Delete() *PlaylistSectionDelete {
+	mutation := newPlaylistSectionMutation(c.config, OpDelete)
+	return &PlaylistSectionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PlaylistSectionClient) DeleteOne(_m *PlaylistSection) *PlaylistSectionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PlaylistSectionClient) DeleteOneID(id uuid.UUID) *PlaylistSectionDeleteOne {
+	builder := c.Delete().Where(playlistsection.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PlaylistSectionDeleteOne{builder}
+}
+
+// Query returns a query builder for PlaylistSection.
+func (c *PlaylistSectionClient) Query() *PlaylistSectionQuery {
+	return &PlaylistSectionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePlaylistSection},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PlaylistSection entity by its id.
+func (c *PlaylistSectionClient) Get(ctx context.Context, id uuid.UUID) (*PlaylistSection, error) {
+	return c.Query().Where(playlistsection.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PlaylistSectionClient) GetX(ctx context.Context, id uuid.UUID) *PlaylistSection {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPlaylist queries the playlist edge of a PlaylistSection.
+func (c *PlaylistSectionClient) QueryPlaylist(_m *PlaylistSection) *PlaylistQuery {
+	query := (&PlaylistClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(playlistsection.Table, playlistsection.FieldID, id),
+			sqlgraph.To(playlist.Table, playlist.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, playlistsection.PlaylistTable, playlistsection.PlaylistColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PlaylistSectionClient) Hooks() []Hook {
+	return c.hooks.PlaylistSection
+}
+
+// Interceptors returns the client interceptors.
+func (c *PlaylistSectionClient) Interceptors() []Interceptor {
+	return c.inters.PlaylistSection
+}
+
+func (c *PlaylistSectionClient) mutate(ctx context.Context, m *PlaylistSectionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PlaylistSectionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PlaylistSectionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PlaylistSectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PlaylistSectionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PlaylistSection mutation op: %q", m.Op())
+	}
+}
+
+// PlaylistVodClient is a client for the PlaylistVod schema.
+type PlaylistVodClient struct {
+	config
+}
+
+// NewPlaylistVodClient returns a client for the PlaylistVod from the given config.
+func NewPlaylistVodClient(c config) *PlaylistVodClient {
+	return &PlaylistVodClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `playlistvod.Hooks(f(g(h())))`.
+func (c *PlaylistVodClient) Use(hooks ...Hook) {
+	c.hooks.PlaylistVod = append(c.hooks.PlaylistVod, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `playlistvod.Intercept(f(g(h())))`.
+func (c *PlaylistVodClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PlaylistVod = append(c.inters.PlaylistVod, interceptors...)
+}
+
+// Create returns a builder for creating a PlaylistVod entity.
+func (c *PlaylistVodClient) Create() *PlaylistVodCreate {
+	mutation := newPlaylistVodMutation(c.config, OpCreate)
+	return &PlaylistVodCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PlaylistVod entities.
+func (c *PlaylistVodClient) CreateBulk(builders ...*PlaylistVodCreate) *PlaylistVodCreateBulk {
+	return &PlaylistVodCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PlaylistVodClient) MapCreateBulk(slice any, setFunc func(*PlaylistVodCreate, int)) *PlaylistVodCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PlaylistVodCreateBulk{err: fmt.Errorf("calling to PlaylistVodClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PlaylistVodCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PlaylistVodCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PlaylistVod.
+func (c *PlaylistVodClient) Update() *PlaylistVodUpdate {
+	mutation := newPlaylistVodMutation(c.config, OpUpdate)
+	return &PlaylistVodUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PlaylistVodClient) UpdateOne(_m *PlaylistVod) *PlaylistVodUpdateOne {
+	mutation := newPlaylistVodMutation(c.config, OpUpdateOne)
+	mutation.playlist = &_m.PlaylistID
+	mutation.vod = &_m.VodID
+	return &PlaylistVodUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PlaylistVod.
+func (c *PlaylistVodClient) Delete() *PlaylistVodDelete {
+	mutation := newPlaylistVodMutation(c.config, OpDelete)
+	return &PlaylistVodDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Query returns a query builder for PlaylistVod.
+func (c *PlaylistVodClient) Query() *PlaylistVodQuery {
+	return &PlaylistVodQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePlaylistVod},
+		inters: c.Interceptors(),
+	}
+}
+
+// QueryPlaylist queries the playlist edge of a PlaylistVod.
+func (c *PlaylistVodClient) QueryPlaylist(_m *PlaylistVod) *PlaylistQuery {
+	return c.Query().
+		Where(playlistvod.PlaylistID(_m.PlaylistID), playlistvod.VodID(_m.VodID)).
+		QueryPlaylist()
+}
+
+// QueryVod queries the vod edge of a PlaylistVod.
+func (c *PlaylistVodClient) QueryVod(_m *PlaylistVod) *VodQuery {
+	return c.Query().
+		Where(playlistvod.PlaylistID(_m.PlaylistID), playlistvod.VodID(_m.VodID)).
+		QueryVod()
+}
+
+// Hooks returns the client hooks.
+func (c *PlaylistVodClient) Hooks() []Hook {
+	return c.hooks.PlaylistVod
+}
+
+// Interceptors returns the client interceptors.
+func (c *PlaylistVodClient) Interceptors() []Interceptor {
+	return c.inters.PlaylistVod
+}
+
+func (c *PlaylistVodClient) mutate(ctx context.Context, m *PlaylistVodMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PlaylistVodCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PlaylistVodUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PlaylistVodUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PlaylistVodDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PlaylistVod mutation op: %q", m.Op())
+	}
+}
+
+// QueueClient is a client for the Queue schema.
+type QueueClient struct {
+	config
+}
+
+// NewQueueClient returns a client for the Queue from the given config.
+func NewQueueClient(c config) *QueueClient {
+	return &QueueClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `queue.Hooks(f(g(h())))`.
+func (c *QueueClient) Use(hooks ...Hook) {
+	c.hooks.Queue = append(c.hooks.Queue, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `queue.Intercept(f(g(h())))`.
+func (c *QueueClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Queue = append(c.inters.Queue, interceptors...)
+}
+
+// Create returns a builder for creating a Queue entity.
+func (c *QueueClient) Create() *QueueCreate {
+	mutation := newQueueMutation(c.config, OpCreate)
+	return &QueueCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Queue entities.
+func (c *QueueClient) CreateBulk(builders ...*QueueCreate) *QueueCreateBulk {
+	return &QueueCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *QueueClient) MapCreateBulk(slice any, setFunc func(*QueueCreate, int)) *QueueCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &QueueCreateBulk{err: fmt.Errorf("calling to QueueClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*QueueCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &QueueCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Queue.
+func (c *QueueClient) Update() *QueueUpdate {
+	mutation := newQueueMutation(c.config, OpUpdate)
+	return &QueueUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *QueueClient) UpdateOne(_m *Queue) *QueueUpdateOne {
+	mutation := newQueueMutation(c.config, OpUpdateOne, withQueue(_m))
+	return &QueueUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *QueueClient) UpdateOneID(id uuid.UUID) *QueueUpdateOne {
+	mutation := newQueueMutation(c.config, OpUpdateOne, withQueueID(id))
+	return &QueueUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Queue.
+func (c *QueueClient) Delete() *QueueDelete {
+	mutation := newQueueMutation(c.config, OpDelete)
+	return &QueueDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *QueueClient) DeleteOne(_m *Queue) *QueueDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *QueueClient) DeleteOneID(id uuid.UUID) *QueueDeleteOne {
+	builder := c.Delete().Where(queue.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &QueueDeleteOne{builder}
+}
+
+// Query returns a query builder for Queue.
+func (c *QueueClient) Query() *QueueQuery {
+	return &QueueQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeQueue},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Queue entity by its id.
+func (c *QueueClient) Get(ctx context.Context, id uuid.UUID) (*Queue, error) {
+	return c.Query().Where(queue.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *QueueClient) GetX(ctx context.Context, id uuid.UUID) *Queue {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryVod queries the vod edge of a Queue.
+func (c *QueueClient) QueryVod(_m *Queue) *VodQuery {
+	query := (&VodClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(queue.Table, queue.FieldID, id),
+			sqlgraph.To(vod.Table, vod.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, queue.VodTable, queue.VodColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *QueueClient) Hooks() []Hook {
+	return c.hooks.Queue
+}
+
+// Interceptors returns the client interceptors.
+func (c *QueueClient) Interceptors() []Interceptor {
+	return c.inters.Queue
+}
+
+func (c *QueueClient) mutate(ctx context.Context, m *QueueMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PlaylistRuleGroupCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&QueueCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PlaylistRuleGroupUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&QueueUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PlaylistRuleGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&QueueUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&QueueDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Queue mutation op: %q", m.Op())
+	}
+}
+
+// SessionsClient is a client for the Sessions schema.
+type SessionsClient struct {
+	config
+}
+
+// NewSessionsClient returns a client for the Sessions from the given config.
+func NewSessionsClient(c config) *SessionsClient {
+	return &SessionsClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `sessions.Hooks(f(g(h())))`.
+func (c *SessionsClient) Use(hooks ...Hook) {
+	c.hooks.Sessions = append(c.hooks.Sessions, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `sessions.Intercept(f(g(h())))`.
+func (c *SessionsClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Sessions = append(c.inters.Sessions, interceptors...)
+}
+
+// Create returns a builder for creating a Sessions entity.
+func (c *SessionsClient) Create() *SessionsCreate {
+	mutation := newSessionsMutation(c.config, OpCreate)
+	return &SessionsCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Sessions entities.
+func (c *SessionsClient) CreateBulk(builders ...*SessionsCreate) *SessionsCreateBulk {
+	return &SessionsCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SessionsClient) MapCreateBulk(slice any, setFunc func(*SessionsCreate, int)) *SessionsCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SessionsCreateBulk{err: fmt.Errorf("calling to SessionsClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SessionsCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SessionsCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Sessions.
+func (c *SessionsClient) Update() *SessionsUpdate {
+	mutation := newSessionsMutation(c.config, OpUpdate)
+	return &SessionsUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SessionsClient) UpdateOne(_m *Sessions) *SessionsUpdateOne {
+	mutation := newSessionsMutation(c.config, OpUpdateOne, withSessions(_m))
+	return &SessionsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SessionsClient) UpdateOneID(id int) *SessionsUpdateOne {
+	mutation := newSessionsMutation(c.config, OpUpdateOne, withSessionsID(id))
+	return &SessionsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Sessions.
+func (c *SessionsClient) Delete() *SessionsDelete {
+	mutation := newSessionsMutation(c.config, OpDelete)
+	return &SessionsDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SessionsClient) DeleteOne(_m *Sessions) *SessionsDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SessionsClient) DeleteOneID(id int) *SessionsDeleteOne {
+	builder := c.Delete().Where(sessions.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SessionsDeleteOne{builder}
+}
+
+// Query returns a query builder for Sessions.
+func (c *SessionsClient) Query() *SessionsQuery {
+	return &SessionsQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSessions},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Sessions entity by its id.
+func (c *SessionsClient) Get(ctx context.Context, id int) (*Sessions, error) {
+	return c.Query().Where(sessions.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SessionsClient) GetX(ctx context.Context, id int) *Sessions {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SessionsClient) Hooks() []Hook {
+	return c.hooks.Sessions
+}
+
+// Interceptors returns the client interceptors.
+func (c *SessionsClient) Interceptors() []Interceptor {
+	return c.inters.Sessions
+}
+
+func (c *SessionsClient) mutate(ctx context.Context, m *SessionsMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SessionsCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SessionsUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SessionsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PlaylistRuleGroupDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&SessionsDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown PlaylistRuleGroup mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Sessions mutation op: %q", m.Op())
 	}
 }
 
-// QueueClient is a client for the Queue schema.
-type QueueClient struct {
+// ShareLinkClient is a client for the ShareLink schema.
+type ShareLinkClient struct {
 	config
 }
 
-// NewQueueClient returns a client for the Queue from the given config.
-func NewQueueClient(c config) *QueueClient {
-	return &QueueClient{config: c}
+// NewShareLinkClient returns a client for the ShareLink from the given config.
+func NewShareLinkClient(c config) *ShareLinkClient {
+	return &ShareLinkClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `queue.Hooks(f(g(h())))`.
-func (c *QueueClient) Use(hooks ...Hook) {
-	c.hooks.Queue = append(c.hooks.Queue, hooks...)
+// A call to `Use(f, g, h)` equals to `sharelink.Hooks(f(g(h())))`.
+func (c *ShareLinkClient) Use(hooks ...Hook) {
+	c.hooks.ShareLink = append(c.hooks.ShareLink, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `queue.Intercept(f(g(h())))`.
-func (c *QueueClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Queue = append(c.inters.Queue, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `sharelink.Intercept(f(g(h())))`.
+func (c *ShareLinkClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ShareLink = append(c.inters.ShareLink, interceptors...)
 }
 
-// Create returns a builder for creating a Queue entity.
-func (c *QueueClient) Create() *QueueCreate {
-	mutation := newQueueMutation(c.config, OpCreate)
-	return &QueueCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ShareLink entity.
+func (c *ShareLinkClient) Create() *ShareLinkCreate {
+	mutation := newShareLinkMutation(c.config, OpCreate)
+	return &ShareLinkCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Queue entities.
-func (c *QueueClient) CreateBulk(builders ...*QueueCreate) *QueueCreateBulk {
-	return &QueueCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ShareLink entities.
+func (c *ShareLinkClient) CreateBulk(builders ...*ShareLinkCreate) *ShareLinkCreateBulk {
+	return &ShareLinkCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *QueueClient) MapCreateBulk(slice any, setFunc func(*QueueCreate, int)) *QueueCreateBulk {
+func (c *ShareLinkClient) MapCreateBulk(slice any, setFunc func(*ShareLinkCreate, int)) *ShareLinkCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &QueueCreateBulk{err: fmt.Errorf("calling to QueueClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ShareLinkCreateBulk{err: fmt.Errorf("calling to ShareLinkClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*QueueCreate, rv.Len())
+	builders := make([]*ShareLinkCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &QueueCreateBulk{config: c.config, builders: builders}
+	return &ShareLinkCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Queue.
-func (c *QueueClient) Update() *QueueUpdate {
-	mutation := newQueueMutation(c.config, OpUpdate)
-	return &QueueUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ShareLink.
+func (c *ShareLinkClient) Update() *ShareLinkUpdate {
+	mutation := newShareLinkMutation(c.config, OpUpdate)
+	return &ShareLinkUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *QueueClient) UpdateOne(_m *Queue) *QueueUpdateOne {
-	mutation := newQueueMutation(c.config, OpUpdateOne, withQueue(_m))
-	return &QueueUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ShareLinkClient) UpdateOne(_m *ShareLink) *ShareLinkUpdateOne {
+	mutation := newShareLinkMutation(c.config, OpUpdateOne, withShareLink(_m))
+	return &ShareLinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *QueueClient) UpdateOneID(id uuid.UUID) *QueueUpdateOne {
-	mutation := newQueueMutation(c.config, OpUpdateOne, withQueueID(id))
-	return &QueueUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ShareLinkClient) UpdateOneID(id uuid.UUID) *ShareLinkUpdateOne {
+	mutation := newShareLinkMutation(c.config, OpUpdateOne, withShareLinkID(id))
+	return &ShareLinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Queue.
-func (c *QueueClient) Delete() *QueueDelete {
-	mutation := newQueueMutation(c.config, OpDelete)
-	return &QueueDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ShareLink.
+func (c *ShareLinkClient) Delete() *ShareLinkDelete {
+	mutation := newShareLinkMutation(c.config, OpDelete)
+	return &ShareLinkDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *QueueClient) DeleteOne(_m *Queue) *QueueDeleteOne {
+func (c *ShareLinkClient) DeleteOne(_m *ShareLink) *ShareLinkDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *QueueClient) DeleteOneID(id uuid.UUID) *QueueDeleteOne {
-	builder := c.Delete().Where(queue.ID(id))
+func (c *ShareLinkClient) DeleteOneID(id uuid.UUID) *ShareLinkDeleteOne {
+	builder := c.Delete().Where(sharelink.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &QueueDeleteOne{builder}
+	return &ShareLinkDeleteOne{builder}
 }
 
-// Query returns a query builder for Queue.
-func (c *QueueClient) Query() *QueueQuery {
-	return &QueueQuery{
+// Query returns a query builder for ShareLink.
+func (c *ShareLinkClient) Query() *ShareLinkQuery {
+	return &ShareLinkQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeQueue},
+		ctx:    &QueryContext{Type: TypeShareLink},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Queue entity by its id.
-func (c *QueueClient) Get(ctx context.Context, id uuid.UUID) (*Queue, error) {
-	return c.Query().Where(queue.ID(id)).Only(ctx)
+// Get returns a ShareLink entity by its id.
+func (c *ShareLinkClient) Get(ctx context.Context, id uuid.UUID) (*ShareLink, error) {
+	return c.Query().Where(sharelink.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *QueueClient) GetX(ctx context.Context, id uuid.UUID) *Queue {
+func (c *ShareLinkClient) GetX(ctx context.Context, id uuid.UUID) *ShareLink {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2366,15 +4019,47 @@ func (c *QueueClient) GetX(ctx context.Context, id uuid.UUID) *Queue {
 	return obj
 }
 
-// QueryVod queries the vod edge of a Queue.
-func (c *QueueClient) QueryVod(_m *Queue) *VodQuery {
+// QueryVod queries the vod edge of a ShareLink.
+func (c *ShareLinkClient) QueryVod(_m *ShareLink) *VodQuery {
 	query := (&VodClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(queue.Table, queue.FieldID, id),
+			sqlgraph.From(sharelink.Table, sharelink.FieldID, id),
 			sqlgraph.To(vod.Table, vod.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, true, queue.VodTable, queue.VodColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, sharelink.VodTable, sharelink.VodColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPlaylist queries the playlist edge of a ShareLink.
+func (c *ShareLinkClient) QueryPlaylist(_m *ShareLink) *PlaylistQuery {
+	query := (&PlaylistClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(sharelink.Table, sharelink.FieldID, id),
+			sqlgraph.To(playlist.Table, playlist.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, sharelink.PlaylistTable, sharelink.PlaylistColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCreatedBy queries the created_by edge of a ShareLink.
+func (c *ShareLinkClient) QueryCreatedBy(_m *ShareLink) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(sharelink.Table, sharelink.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, sharelink.CreatedByTable, sharelink.CreatedByColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -2383,131 +4068,131 @@ func (c *QueueClient) QueryVod(_m *Queue) *VodQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *QueueClient) Hooks() []Hook {
-	return c.hooks.Queue
+func (c *ShareLinkClient) Hooks() []Hook {
+	return c.hooks.ShareLink
 }
 
 // Interceptors returns the client interceptors.
-func (c *QueueClient) Interceptors() []Interceptor {
-	return c.inters.Queue
+func (c *ShareLinkClient) Interceptors() []Interceptor {
+	return c.inters.ShareLink
 }
 
-func (c *QueueClient) mutate(ctx context.Context, m *QueueMutation) (Value, error) {
+func (c *ShareLinkClient) mutate(ctx context.Context, m *ShareLinkMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&QueueCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ShareLinkCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&QueueUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ShareLinkUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&QueueUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ShareLinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&QueueDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ShareLinkDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Queue mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ShareLink mutation op: %q", m.Op())
 	}
 }
 
-// SessionsClient is a client for the Sessions schema.
-type SessionsClient struct {
+// TagClient is a client for the Tag schema.
+type TagClient struct {
 	config
 }
 
-// NewSessionsClient returns a client for the Sessions from the given config.
-func NewSessionsClient(c config) *SessionsClient {
-	return &SessionsClient{config: c}
+// NewTagClient returns a client for the Tag from the given config.
+func NewTagClient(c config) *TagClient {
+	return &TagClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `sessions.Hooks(f(g(h())))`.
-func (c *SessionsClient) Use(hooks ...Hook) {
-	c.hooks.Sessions = append(c.hooks.Sessions, hooks...)
+// A call to `Use(f, g, h)` equals to `tag.Hooks(f(g(h())))`.
+func (c *TagClient) Use(hooks ...Hook) {
+	c.hooks.Tag = append(c.hooks.Tag, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `sessions.Intercept(f(g(h())))`.
-func (c *SessionsClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Sessions = append(c.inters.Sessions, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `tag.Intercept(f(g(h())))`.
+func (c *TagClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Tag = append(c.inters.Tag, interceptors...)
 }
 
-// Create returns a builder for creating a Sessions entity.
-func (c *SessionsClient) Create() *SessionsCreate {
-	mutation := newSessionsMutation(c.config, OpCreate)
-	return &SessionsCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Tag entity.
+func (c *TagClient) Create() *TagCreate {
+	mutation := newTagMutation(c.config, OpCreate)
+	return &TagCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Sessions entities.
-func (c *SessionsClient) CreateBulk(builders ...*SessionsCreate) *SessionsCreateBulk {
-	return &SessionsCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Tag entities.
+func (c *TagClient) CreateBulk(builders ...*TagCreate) *TagCreateBulk {
+	return &TagCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *SessionsClient) MapCreateBulk(slice any, setFunc func(*SessionsCreate, int)) *SessionsCreateBulk {
+func (c *TagClient) MapCreateBulk(slice any, setFunc func(*TagCreate, int)) *TagCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &SessionsCreateBulk{err: fmt.Errorf("calling to SessionsClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &TagCreateBulk{err: fmt.Errorf("calling to TagClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*SessionsCreate, rv.Len())
+	builders := make([]*TagCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &SessionsCreateBulk{config: c.config, builders: builders}
+	return &TagCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Sessions.
-func (c *SessionsClient) Update() *SessionsUpdate {
-	mutation := newSessionsMutation(c.config, OpUpdate)
-	return &SessionsUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Tag.
+func (c *TagClient) Update() *TagUpdate {
+	mutation := newTagMutation(c.config, OpUpdate)
+	return &TagUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *SessionsClient) UpdateOne(_m *Sessions) *SessionsUpdateOne {
-	mutation := newSessionsMutation(c.config, OpUpdateOne, withSessions(_m))
-	return &SessionsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *TagClient) UpdateOne(_m *Tag) *TagUpdateOne {
+	mutation := newTagMutation(c.config, OpUpdateOne, withTag(_m))
+	return &TagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *SessionsClient) UpdateOneID(id int) *SessionsUpdateOne {
-	mutation := newSessionsMutation(c.config, OpUpdateOne, withSessionsID(id))
-	return &SessionsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *TagClient) UpdateOneID(id uuid.UUID) *TagUpdateOne {
+	mutation := newTagMutation(c.config, OpUpdateOne, withTagID(id))
+	return &TagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Sessions.
-func (c *SessionsClient) Delete() *SessionsDelete {
-	mutation := newSessionsMutation(c.config, OpDelete)
-	return &SessionsDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Tag.
+func (c *TagClient) Delete() *TagDelete {
+	mutation := newTagMutation(c.config, OpDelete)
+	return &TagDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *SessionsClient) DeleteOne(_m *Sessions) *SessionsDeleteOne {
+func (c *TagClient) DeleteOne(_m *Tag) *TagDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *SessionsClient) DeleteOneID(id int) *SessionsDeleteOne {
-	builder := c.Delete().Where(sessions.ID(id))
+func (c *TagClient) DeleteOneID(id uuid.UUID) *TagDeleteOne {
+	builder := c.Delete().Where(tag.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &SessionsDeleteOne{builder}
+	return &TagDeleteOne{builder}
 }
 
-// Query returns a query builder for Sessions.
-func (c *SessionsClient) Query() *SessionsQuery {
-	return &SessionsQuery{
+// Query returns a query builder for Tag.
+func (c *TagClient) Query() *TagQuery {
+	return &TagQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeSessions},
+		ctx:    &QueryContext{Type: TypeTag},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Sessions entity by its id.
-func (c *SessionsClient) Get(ctx context.Context, id int) (*Sessions, error) {
-	return c.Query().Where(sessions.ID(id)).Only(ctx)
+// Get returns a Tag entity by its id.
+func (c *TagClient) Get(ctx context.Context, id uuid.UUID) (*Tag, error) {
+	return c.Query().Where(tag.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *SessionsClient) GetX(ctx context.Context, id int) *Sessions {
+func (c *TagClient) GetX(ctx context.Context, id uuid.UUID) *Tag {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2515,28 +4200,44 @@ func (c *SessionsClient) GetX(ctx context.Context, id int) *Sessions {
 	return obj
 }
 
+// QueryVods queries the vods edge of a Tag.
+func (c *TagClient) QueryVods(_m *Tag) *VodQuery {
+	query := (&VodClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tag.Table, tag.FieldID, id),
+			sqlgraph.To(vod.Table, vod.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, tag.VodsTable, tag.VodsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
-func (c *SessionsClient) Hooks() []Hook {
-	return c.hooks.Sessions
+func (c *TagClient) Hooks() []Hook {
+	return c.hooks.Tag
 }
 
 // Interceptors returns the client interceptors.
-func (c *SessionsClient) Interceptors() []Interceptor {
-	return c.inters.Sessions
+func (c *TagClient) Interceptors() []Interceptor {
+	return c.inters.Tag
 }
 
-func (c *SessionsClient) mutate(ctx context.Context, m *SessionsMutation) (Value, error) {
+func (c *TagClient) mutate(ctx context.Context, m *TagMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&SessionsCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TagCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&SessionsUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TagUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&SessionsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&SessionsDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&TagDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Sessions mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Tag mutation op: %q", m.Op())
 	}
 }
 
@@ -2781,6 +4482,102 @@ func (c *UserClient) GetX(ctx context.Context, id uuid.UUID) *User {
 	return obj
 }
 
+// QueryNotificationSubscriptions queries the notification_subscriptions edge of a User.
+func (c *UserClient) QueryNotificationSubscriptions(_m *User) *NotificationSubscriptionQuery {
+	query := (&NotificationSubscriptionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(notificationsubscription.Table, notificationsubscription.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.NotificationSubscriptionsTable, user.NotificationSubscriptionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAPITokens queries the api_tokens edge of a User.
+func (c *UserClient) QueryAPITokens(_m *User) *ApiTokenQuery {
+	query := (&ApiTokenClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(apitoken.Table, apitoken.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.APITokensTable, user.APITokensColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAllowedChannels queries the allowed_channels edge of a User.
+func (c *UserClient) QueryAllowedChannels(_m *User) *ChannelQuery {
+	query := (&ChannelClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(channel.Table, channel.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, user.AllowedChannelsTable, user.AllowedChannelsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAllowedPlaylists queries the allowed_playlists edge of a User.
+func (c *UserClient) QueryAllowedPlaylists(_m *User) *PlaylistQuery {
+	query := (&PlaylistClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(playlist.Table, playlist.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, user.AllowedPlaylistsTable, user.AllowedPlaylistsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryShareLinks queries the share_links edge of a User.
+func (c *UserClient) QueryShareLinks(_m *User) *ShareLinkQuery {
+	query := (&ShareLinkClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(sharelink.Table, sharelink.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ShareLinksTable, user.ShareLinksColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBulkOperations queries the bulk_operations edge of a User.
+func (c *UserClient) QueryBulkOperations(_m *User) *BulkOperationQuery {
+	query := (&BulkOperationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(bulkoperation.Table, bulkoperation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.BulkOperationsTable, user.BulkOperationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *UserClient) Hooks() []Hook {
 	return c.hooks.User
@@ -3026,6 +4823,54 @@ func (c *VodClient) QueryYoutubeUpload(_m *Vod) *YoutubeUploadQuery {
 	return query
 }
 
+// QueryShareLinks queries the share_links edge of a Vod.
+func (c *VodClient) QueryShareLinks(_m *Vod) *ShareLinkQuery {
+	query := (&ShareLinkClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(vod.Table, vod.FieldID, id),
+			sqlgraph.To(sharelink.Table, sharelink.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, vod.ShareLinksTable, vod.ShareLinksColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTags queries the tags edge of a Vod.
+func (c *VodClient) QueryTags(_m *Vod) *TagQuery {
+	query := (&TagClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(vod.Table, vod.FieldID, id),
+			sqlgraph.To(tag.Table, tag.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, vod.TagsTable, vod.TagsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPlaylistEntries queries the playlist_entries edge of a Vod.
+func (c *VodClient) QueryPlaylistEntries(_m *Vod) *PlaylistVodQuery {
+	query := (&PlaylistVodClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(vod.Table, vod.FieldID, id),
+			sqlgraph.To(playlistvod.Table, playlistvod.VodColumn),
+			sqlgraph.Edge(sqlgraph.O2M, true, vod.PlaylistEntriesTable, vod.PlaylistEntriesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *VodClient) Hooks() []Hook {
 	return c.hooks.Vod
@@ -3650,15 +5495,19 @@ func (c *YoutubeUploadClient) mutate(ctx context.Context, m *YoutubeUploadMutati
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		BlockedVideos, Channel, Chapter, Live, LiveCategory, LiveTitleRegex,
-		MultistreamInfo, MutedSegment, Playback, Playlist, PlaylistRule,
-		PlaylistRuleGroup, Queue, Sessions, TwitchCategory, User, Vod, YoutubeConfig,
-		YoutubeCredential, YoutubePlaylistMapping, YoutubeUpload []ent.Hook
+		ApiToken, AuditLog, BlockedVideos, BulkOperation, Channel, Chapter, Live,
+		LiveCategory, LiveTitleRegex, LoginThrottle, MultistreamInfo, MutedSegment,
+		NotificationFailure, NotificationSubscription, Playback, Playlist,
+		PlaylistRule, PlaylistRuleGroup, PlaylistSection, PlaylistVod, Queue, Sessions,
+		ShareLink, Tag, TwitchCategory, User, Vod, YoutubeConfig, YoutubeCredential,
+		YoutubePlaylistMapping, YoutubeUpload []ent.Hook
 	}
 	inters struct {
-		BlockedVideos, Channel, Chapter, Live, LiveCategory, LiveTitleRegex,
-		MultistreamInfo, MutedSegment, Playback, Playlist, PlaylistRule,
-		PlaylistRuleGroup, Queue, Sessions, TwitchCategory, User, Vod, YoutubeConfig,
-		YoutubeCredential, YoutubePlaylistMapping, YoutubeUpload []ent.Interceptor
+		ApiToken, AuditLog, BlockedVideos, BulkOperation, Channel, Chapter, Live,
+		LiveCategory, LiveTitleRegex, LoginThrottle, MultistreamInfo, MutedSegment,
+		NotificationFailure, NotificationSubscription, Playback, Playlist,
+		PlaylistRule, PlaylistRuleGroup, PlaylistSection, PlaylistVod, Queue, Sessions,
+		ShareLink, Tag, TwitchCategory, User, Vod, YoutubeConfig, YoutubeCredential,
+		YoutubePlaylistMapping, YoutubeUpload []ent.Interceptor
 	}
 )
@@ -12,20 +12,30 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/zibbp/ganymede/ent/apitoken"
+	"github.com/zibbp/ganymede/ent/auditlog"
 	"github.com/zibbp/ganymede/ent/blockedvideos"
+	"github.com/zibbp/ganymede/ent/bulkoperation"
 	"github.com/zibbp/ganymede/ent/channel"
 	"github.com/zibbp/ganymede/ent/chapter"
 	"github.com/zibbp/ganymede/ent/live"
 	"github.com/zibbp/ganymede/ent/livecategory"
 	"github.com/zibbp/ganymede/ent/livetitleregex"
+	"github.com/zibbp/ganymede/ent/loginthrottle"
 	"github.com/zibbp/ganymede/ent/multistreaminfo"
 	"github.com/zibbp/ganymede/ent/mutedsegment"
+	"github.com/zibbp/ganymede/ent/notificationfailure"
+	"github.com/zibbp/ganymede/ent/notificationsubscription"
 	"github.com/zibbp/ganymede/ent/playback"
 	"github.com/zibbp/ganymede/ent/playlist"
 	"github.com/zibbp/ganymede/ent/playlistrule"
 	"github.com/zibbp/ganymede/ent/playlistrulegroup"
+	"github.com/zibbp/ganymede/ent/playlistsection"
+	"github.com/zibbp/ganymede/ent/playlistvod"
 	"github.com/zibbp/ganymede/ent/queue"
 	"github.com/zibbp/ganymede/ent/sessions"
+	"github.com/zibbp/ganymede/ent/sharelink"
+	"github.com/zibbp/ganymede/ent/tag"
 	"github.com/zibbp/ganymede/ent/twitchcategory"
 	"github.com/zibbp/ganymede/ent/user"
 	"github.com/zibbp/ganymede/ent/vod"
@@ -93,27 +103,37 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			blockedvideos.Table:          blockedvideos.ValidColumn,
-			channel.Table:                channel.ValidColumn,
-			chapter.Table:                chapter.ValidColumn,
-			live.Table:                   live.ValidColumn,
-			livecategory.Table:           livecategory.ValidColumn,
-			livetitleregex.Table:         livetitleregex.ValidColumn,
-			multistreaminfo.Table:        multistreaminfo.ValidColumn,
-			mutedsegment.Table:           mutedsegment.ValidColumn,
-			playback.Table:               playback.ValidColumn,
-			playlist.Table:               playlist.ValidColumn,
-			playlistrule.Table:           playlistrule.ValidColumn,
-			playlistrulegroup.Table:      playlistrulegroup.ValidColumn,
-			queue.Table:                  queue.ValidColumn,
-			sessions.Table:               sessions.ValidColumn,
-			twitchcategory.Table:         twitchcategory.ValidColumn,
-			user.Table:                   user.ValidColumn,
-			vod.Table:                    vod.ValidColumn,
-			youtubeconfig.Table:          youtubeconfig.ValidColumn,
-			youtubecredential.Table:      youtubecredential.ValidColumn,
-			youtubeplaylistmapping.Table: youtubeplaylistmapping.ValidColumn,
-			youtubeupload.Table:          youtubeupload.ValidColumn,
+			apitoken.Table:                 apitoken.ValidColumn,
+			auditlog.Table:                 auditlog.ValidColumn,
+			blockedvideos.Table:            blockedvideos.ValidColumn,
+			bulkoperation.Table:            bulkoperation.ValidColumn,
+			channel.Table:                  channel.ValidColumn,
+			chapter.Table:                  chapter.ValidColumn,
+			live.Table:                     live.ValidColumn,
+			livecategory.Table:             livecategory.ValidColumn,
+			livetitleregex.Table:           livetitleregex.ValidColumn,
+			loginthrottle.Table:            loginthrottle.ValidColumn,
+			multistreaminfo.Table:          multistreaminfo.ValidColumn,
+			mutedsegment.Table:             mutedsegment.ValidColumn,
+			notificationfailure.Table:      notificationfailure.ValidColumn,
+			notificationsubscription.Table: notificationsubscription.ValidColumn,
+			playback.Table:                 playback.ValidColumn,
+			playlist.Table:                 playlist.ValidColumn,
+			playlistrule.Table:             playlistrule.ValidColumn,
+			playlistrulegroup.Table:        playlistrulegroup.ValidColumn,
+			playlistsection.Table:          playlistsection.ValidColumn,
+			playlistvod.Table:              playlistvod.ValidColumn,
+			queue.Table:                    queue.ValidColumn,
+			sessions.Table:                 sessions.ValidColumn,
+			sharelink.Table:                sharelink.ValidColumn,
+			tag.Table:                      tag.ValidColumn,
+			twitchcategory.Table:           twitchcategory.ValidColumn,
+			user.Table:                     user.ValidColumn,
+			vod.Table:                      vod.ValidColumn,
+			youtubeconfig.Table:            youtubeconfig.ValidColumn,
+			youtubecredential.Table:        youtubecredential.ValidColumn,
+			youtubeplaylistmapping.Table:   youtubeplaylistmapping.ValidColumn,
+			youtubeupload.Table:            youtubeupload.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
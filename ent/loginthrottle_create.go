@@ -0,0 +1,326 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/loginthrottle"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// LoginThrottleCreate is the builder for creating a LoginThrottle entity.
+type LoginThrottleCreate struct {
+	config
+	mutation *LoginThrottleMutation
+	hooks    []Hook
+}
+
+// SetKind sets the "kind" field.
+func (_c *LoginThrottleCreate) SetKind(v utils.LoginThrottleKind) *LoginThrottleCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetValue sets the "value" field.
+func (_c *LoginThrottleCreate) SetValue(v string) *LoginThrottleCreate {
+	_c.mutation.SetValue(v)
+	return _c
+}
+
+// SetAttempts sets the "attempts" field.
+func (_c *LoginThrottleCreate) SetAttempts(v int) *LoginThrottleCreate {
+	_c.mutation.SetAttempts(v)
+	return _c
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_c *LoginThrottleCreate) SetNillableAttempts(v *int) *LoginThrottleCreate {
+	if v != nil {
+		_c.SetAttempts(*v)
+	}
+	return _c
+}
+
+// SetLastAttemptAt sets the "last_attempt_at" field.
+func (_c *LoginThrottleCreate) SetLastAttemptAt(v time.Time) *LoginThrottleCreate {
+	_c.mutation.SetLastAttemptAt(v)
+	return _c
+}
+
+// SetNillableLastAttemptAt sets the "last_attempt_at" field if the given value is not nil.
+func (_c *LoginThrottleCreate) SetNillableLastAttemptAt(v *time.Time) *LoginThrottleCreate {
+	if v != nil {
+		_c.SetLastAttemptAt(*v)
+	}
+	return _c
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (_c *LoginThrottleCreate) SetLockedUntil(v time.Time) *LoginThrottleCreate {
+	_c.mutation.SetLockedUntil(v)
+	return _c
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (_c *LoginThrottleCreate) SetNillableLockedUntil(v *time.Time) *LoginThrottleCreate {
+	if v != nil {
+		_c.SetLockedUntil(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *LoginThrottleCreate) SetCreatedAt(v time.Time) *LoginThrottleCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *LoginThrottleCreate) SetNillableCreatedAt(v *time.Time) *LoginThrottleCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *LoginThrottleCreate) SetID(v uuid.UUID) *LoginThrottleCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *LoginThrottleCreate) SetNillableID(v *uuid.UUID) *LoginThrottleCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the LoginThrottleMutation object of the builder.
+func (_c *LoginThrottleCreate) Mutation() *LoginThrottleMutation {
+	return _c.mutation
+}
+
+// Save creates the LoginThrottle in the database.
+func (_c *LoginThrottleCreate) Save(ctx context.Context) (*LoginThrottle, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *LoginThrottleCreate) SaveX(ctx context.Context) *LoginThrottle {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LoginThrottleCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LoginThrottleCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *LoginThrottleCreate) defaults() {
+	if _, ok := _c.mutation.Attempts(); !ok {
+		v := loginthrottle.DefaultAttempts
+		_c.mutation.SetAttempts(v)
+	}
+	if _, ok := _c.mutation.LastAttemptAt(); !ok {
+		v := loginthrottle.DefaultLastAttemptAt()
+		_c.mutation.SetLastAttemptAt(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := loginthrottle.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := loginthrottle.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *LoginThrottleCreate) check() error {
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "LoginThrottle.kind"`)}
+	}
+	if v, ok := _c.mutation.Kind(); ok {
+		if err := loginthrottle.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "LoginThrottle.kind": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Value(); !ok {
+		return &ValidationError{Name: "value", err: errors.New(`ent: missing required field "LoginThrottle.value"`)}
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "LoginThrottle.attempts"`)}
+	}
+	if _, ok := _c.mutation.LastAttemptAt(); !ok {
+		return &ValidationError{Name: "last_attempt_at", err: errors.New(`ent: missing required field "LoginThrottle.last_attempt_at"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "LoginThrottle.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *LoginThrottleCreate) sqlSave(ctx context.Context) (*LoginThrottle, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *LoginThrottleCreate) createSpec() (*LoginThrottle, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LoginThrottle{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(loginthrottle.Table, sqlgraph.NewFieldSpec(loginthrottle.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(loginthrottle.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.Value(); ok {
+		_spec.SetField(loginthrottle.FieldValue, field.TypeString, value)
+		_node.Value = value
+	}
+	if value, ok := _c.mutation.Attempts(); ok {
+		_spec.SetField(loginthrottle.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := _c.mutation.LastAttemptAt(); ok {
+		_spec.SetField(loginthrottle.FieldLastAttemptAt, field.TypeTime, value)
+		_node.LastAttemptAt = value
+	}
+	if value, ok := _c.mutation.LockedUntil(); ok {
+		_spec.SetField(loginthrottle.FieldLockedUntil, field.TypeTime, value)
+		_node.LockedUntil = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(loginthrottle.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// LoginThrottleCreateBulk is the builder for creating many LoginThrottle entities in bulk.
+type LoginThrottleCreateBulk struct {
+	config
+	err      error
+	builders []*LoginThrottleCreate
+}
+
+// Save creates the LoginThrottle entities in the database.
+func (_c *LoginThrottleCreateBulk) Save(ctx context.Context) ([]*LoginThrottle, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*LoginThrottle, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LoginThrottleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *LoginThrottleCreateBulk) SaveX(ctx context.Context) []*LoginThrottle {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LoginThrottleCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LoginThrottleCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
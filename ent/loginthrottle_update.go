@@ -0,0 +1,411 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/zibbp/ganymede/ent/loginthrottle"
+	"github.com/zibbp/ganymede/ent/predicate"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// LoginThrottleUpdate is the builder for updating LoginThrottle entities.
+type LoginThrottleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LoginThrottleMutation
+}
+
+// Where appends a list predicates to the LoginThrottleUpdate builder.
+func (_u *LoginThrottleUpdate) Where(ps ...predicate.LoginThrottle) *LoginThrottleUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *LoginThrottleUpdate) SetKind(v utils.LoginThrottleKind) *LoginThrottleUpdate {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *LoginThrottleUpdate) SetNillableKind(v *utils.LoginThrottleKind) *LoginThrottleUpdate {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetValue sets the "value" field.
+func (_u *LoginThrottleUpdate) SetValue(v string) *LoginThrottleUpdate {
+	_u.mutation.SetValue(v)
+	return _u
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (_u *LoginThrottleUpdate) SetNillableValue(v *string) *LoginThrottleUpdate {
+	if v != nil {
+		_u.SetValue(*v)
+	}
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *LoginThrottleUpdate) SetAttempts(v int) *LoginThrottleUpdate {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *LoginThrottleUpdate) SetNillableAttempts(v *int) *LoginThrottleUpdate {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *LoginThrottleUpdate) AddAttempts(v int) *LoginThrottleUpdate {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetLastAttemptAt sets the "last_attempt_at" field.
+func (_u *LoginThrottleUpdate) SetLastAttemptAt(v time.Time) *LoginThrottleUpdate {
+	_u.mutation.SetLastAttemptAt(v)
+	return _u
+}
+
+// SetNillableLastAttemptAt sets the "last_attempt_at" field if the given value is not nil.
+func (_u *LoginThrottleUpdate) SetNillableLastAttemptAt(v *time.Time) *LoginThrottleUpdate {
+	if v != nil {
+		_u.SetLastAttemptAt(*v)
+	}
+	return _u
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (_u *LoginThrottleUpdate) SetLockedUntil(v time.Time) *LoginThrottleUpdate {
+	_u.mutation.SetLockedUntil(v)
+	return _u
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (_u *LoginThrottleUpdate) SetNillableLockedUntil(v *time.Time) *LoginThrottleUpdate {
+	if v != nil {
+		_u.SetLockedUntil(*v)
+	}
+	return _u
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (_u *LoginThrottleUpdate) ClearLockedUntil() *LoginThrottleUpdate {
+	_u.mutation.ClearLockedUntil()
+	return _u
+}
+
+// Mutation returns the LoginThrottleMutation object of the builder.
+func (_u *LoginThrottleUpdate) Mutation() *LoginThrottleMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *LoginThrottleUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LoginThrottleUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *LoginThrottleUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LoginThrottleUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LoginThrottleUpdate) check() error {
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := loginthrottle.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "LoginThrottle.kind": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *LoginThrottleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(loginthrottle.Table, loginthrottle.Columns, sqlgraph.NewFieldSpec(loginthrottle.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(loginthrottle.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Value(); ok {
+		_spec.SetField(loginthrottle.FieldValue, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(loginthrottle.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(loginthrottle.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastAttemptAt(); ok {
+		_spec.SetField(loginthrottle.FieldLastAttemptAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.LockedUntil(); ok {
+		_spec.SetField(loginthrottle.FieldLockedUntil, field.TypeTime, value)
+	}
+	if _u.mutation.LockedUntilCleared() {
+		_spec.ClearField(loginthrottle.FieldLockedUntil, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{loginthrottle.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// LoginThrottleUpdateOne is the builder for updating a single LoginThrottle entity.
+type LoginThrottleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LoginThrottleMutation
+}
+
+// SetKind sets the "kind" field.
+func (_u *LoginThrottleUpdateOne) SetKind(v utils.LoginThrottleKind) *LoginThrottleUpdateOne {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *LoginThrottleUpdateOne) SetNillableKind(v *utils.LoginThrottleKind) *LoginThrottleUpdateOne {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetValue sets the "value" field.
+func (_u *LoginThrottleUpdateOne) SetValue(v string) *LoginThrottleUpdateOne {
+	_u.mutation.SetValue(v)
+	return _u
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (_u *LoginThrottleUpdateOne) SetNillableValue(v *string) *LoginThrottleUpdateOne {
+	if v != nil {
+		_u.SetValue(*v)
+	}
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *LoginThrottleUpdateOne) SetAttempts(v int) *LoginThrottleUpdateOne {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *LoginThrottleUpdateOne) SetNillableAttempts(v *int) *LoginThrottleUpdateOne {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *LoginThrottleUpdateOne) AddAttempts(v int) *LoginThrottleUpdateOne {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetLastAttemptAt sets the "last_attempt_at" field.
+func (_u *LoginThrottleUpdateOne) SetLastAttemptAt(v time.Time) *LoginThrottleUpdateOne {
+	_u.mutation.SetLastAttemptAt(v)
+	return _u
+}
+
+// SetNillableLastAttemptAt sets the "last_attempt_at" field if the given value is not nil.
+func (_u *LoginThrottleUpdateOne) SetNillableLastAttemptAt(v *time.Time) *LoginThrottleUpdateOne {
+	if v != nil {
+		_u.SetLastAttemptAt(*v)
+	}
+	return _u
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (_u *LoginThrottleUpdateOne) SetLockedUntil(v time.Time) *LoginThrottleUpdateOne {
+	_u.mutation.SetLockedUntil(v)
+	return _u
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (_u *LoginThrottleUpdateOne) SetNillableLockedUntil(v *time.Time) *LoginThrottleUpdateOne {
+	if v != nil {
+		_u.SetLockedUntil(*v)
+	}
+	return _u
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (_u *LoginThrottleUpdateOne) ClearLockedUntil() *LoginThrottleUpdateOne {
+	_u.mutation.ClearLockedUntil()
+	return _u
+}
+
+// Mutation returns the LoginThrottleMutation object of the builder.
+func (_u *LoginThrottleUpdateOne) Mutation() *LoginThrottleMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the LoginThrottleUpdate builder.
+func (_u *LoginThrottleUpdateOne) Where(ps ...predicate.LoginThrottle) *LoginThrottleUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *LoginThrottleUpdateOne) Select(field string, fields ...string) *LoginThrottleUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated LoginThrottle entity.
+func (_u *LoginThrottleUpdateOne) Save(ctx context.Context) (*LoginThrottle, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LoginThrottleUpdateOne) SaveX(ctx context.Context) *LoginThrottle {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *LoginThrottleUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LoginThrottleUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LoginThrottleUpdateOne) check() error {
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := loginthrottle.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "LoginThrottle.kind": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *LoginThrottleUpdateOne) sqlSave(ctx context.Context) (_node *LoginThrottle, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(loginthrottle.Table, loginthrottle.Columns, sqlgraph.NewFieldSpec(loginthrottle.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LoginThrottle.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, loginthrottle.FieldID)
+		for _, f := range fields {
+			if !loginthrottle.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != loginthrottle.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(loginthrottle.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Value(); ok {
+		_spec.SetField(loginthrottle.FieldValue, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(loginthrottle.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(loginthrottle.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastAttemptAt(); ok {
+		_spec.SetField(loginthrottle.FieldLastAttemptAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.LockedUntil(); ok {
+		_spec.SetField(loginthrottle.FieldLockedUntil, field.TypeTime, value)
+	}
+	if _u.mutation.LockedUntilCleared() {
+		_spec.ClearField(loginthrottle.FieldLockedUntil, field.TypeTime)
+	}
+	_node = &LoginThrottle{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{loginthrottle.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
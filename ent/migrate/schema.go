@@ -8,6 +8,73 @@ import (
 )
 
 var (
+	// APITokensColumns holds the columns for the "api_tokens" table.
+	APITokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString},
+		{Name: "token_hash", Type: field.TypeString, Unique: true},
+		{Name: "token_prefix", Type: field.TypeString},
+		{Name: "role", Type: field.TypeEnum, Nullable: true, Enums: []string{"admin", "editor", "archiver", "user"}},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_api_tokens", Type: field.TypeUUID},
+	}
+	// APITokensTable holds the schema information for the "api_tokens" table.
+	APITokensTable = &schema.Table{
+		Name:       "api_tokens",
+		Columns:    APITokensColumns,
+		PrimaryKey: []*schema.Column{APITokensColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "api_tokens_users_api_tokens",
+				Columns:    []*schema.Column{APITokensColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// AuditLogsColumns holds the columns for the "audit_logs" table.
+	AuditLogsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "action", Type: field.TypeString},
+		{Name: "resource_type", Type: field.TypeString},
+		{Name: "resource_id", Type: field.TypeString, Nullable: true},
+		{Name: "actor_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "actor_username", Type: field.TypeString, Nullable: true},
+		{Name: "ip", Type: field.TypeString, Nullable: true},
+		{Name: "before", Type: field.TypeJSON, Nullable: true},
+		{Name: "after", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// AuditLogsTable holds the schema information for the "audit_logs" table.
+	AuditLogsTable = &schema.Table{
+		Name:       "audit_logs",
+		Columns:    AuditLogsColumns,
+		PrimaryKey: []*schema.Column{AuditLogsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditlog_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[9]},
+			},
+			{
+				Name:    "auditlog_action",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[1]},
+			},
+			{
+				Name:    "auditlog_actor_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[4]},
+			},
+			{
+				Name:    "auditlog_resource_type_resource_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[2], AuditLogsColumns[3]},
+			},
+		},
+	}
 	// BlockedVideosColumns holds the columns for the "blocked_videos" table.
 	BlockedVideosColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString},
@@ -19,6 +86,46 @@ var (
 		Columns:    BlockedVideosColumns,
 		PrimaryKey: []*schema.Column{BlockedVideosColumns[0]},
 	}
+	// BulkOperationsColumns holds the columns for the "bulk_operations" table.
+	BulkOperationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "action", Type: field.TypeEnum, Enums: []string{"delete", "lock", "unlock", "add_to_playlist", "remove_from_playlist", "generate_static_thumbnail", "generate_sprite_thumbnails", "render_chat", "convert_to_hls", "refresh_metadata"}},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"success", "running", "pending", "failed"}, Default: "pending"},
+		{Name: "filter", Type: field.TypeJSON, Nullable: true},
+		{Name: "vod_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "playlist_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "total", Type: field.TypeInt, Default: 0},
+		{Name: "succeeded", Type: field.TypeInt, Default: 0},
+		{Name: "failed", Type: field.TypeInt, Default: 0},
+		{Name: "results", Type: field.TypeJSON, Nullable: true},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "job_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "finished_at", Type: field.TypeTime, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_bulk_operations", Type: field.TypeUUID, Nullable: true},
+	}
+	// BulkOperationsTable holds the schema information for the "bulk_operations" table.
+	BulkOperationsTable = &schema.Table{
+		Name:       "bulk_operations",
+		Columns:    BulkOperationsColumns,
+		PrimaryKey: []*schema.Column{BulkOperationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "bulk_operations_users_bulk_operations",
+				Columns:    []*schema.Column{BulkOperationsColumns[15]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bulkoperation_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{BulkOperationsColumns[14]},
+			},
+		},
+	}
 	// ChannelsColumns holds the columns for the "channels" table.
 	ChannelsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -28,7 +135,10 @@ var (
 		{Name: "image_path", Type: field.TypeString},
 		{Name: "retention", Type: field.TypeBool, Default: false},
 		{Name: "retention_days", Type: field.TypeInt64, Nullable: true},
+		{Name: "visibility", Type: field.TypeEnum, Enums: []string{"public", "authenticated", "restricted"}, Default: "public"},
+		{Name: "allowed_groups", Type: field.TypeJSON, Nullable: true},
 		{Name: "storage_size_bytes", Type: field.TypeInt64, Default: 0},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "created_at", Type: field.TypeTime},
 	}
@@ -145,6 +255,34 @@ var (
 			},
 		},
 	}
+	// LoginThrottlesColumns holds the columns for the "login_throttles" table.
+	LoginThrottlesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"ip", "username", "registration"}},
+		{Name: "value", Type: field.TypeString},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "last_attempt_at", Type: field.TypeTime},
+		{Name: "locked_until", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// LoginThrottlesTable holds the schema information for the "login_throttles" table.
+	LoginThrottlesTable = &schema.Table{
+		Name:       "login_throttles",
+		Columns:    LoginThrottlesColumns,
+		PrimaryKey: []*schema.Column{LoginThrottlesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "loginthrottle_kind_value",
+				Unique:  true,
+				Columns: []*schema.Column{LoginThrottlesColumns[1], LoginThrottlesColumns[2]},
+			},
+			{
+				Name:    "loginthrottle_last_attempt_at",
+				Unique:  false,
+				Columns: []*schema.Column{LoginThrottlesColumns[4]},
+			},
+		},
+	}
 	// MultistreamInfosColumns holds the columns for the "multistream_infos" table.
 	MultistreamInfosColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -193,6 +331,67 @@ var (
 			},
 		},
 	}
+	// NotificationFailuresColumns holds the columns for the "notification_failures" table.
+	NotificationFailuresColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "provider_name", Type: field.TypeString},
+		{Name: "provider_type", Type: field.TypeEnum, Enums: []string{"webhook", "discord", "slack", "ntfy", "gotify", "apprise", "smtp"}},
+		{Name: "event", Type: field.TypeString},
+		{Name: "error", Type: field.TypeString, Size: 2147483647},
+		{Name: "attempts", Type: field.TypeInt},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// NotificationFailuresTable holds the schema information for the "notification_failures" table.
+	NotificationFailuresTable = &schema.Table{
+		Name:       "notification_failures",
+		Columns:    NotificationFailuresColumns,
+		PrimaryKey: []*schema.Column{NotificationFailuresColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "notificationfailure_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{NotificationFailuresColumns[6]},
+			},
+		},
+	}
+	// NotificationSubscriptionsColumns holds the columns for the "notification_subscriptions" table.
+	NotificationSubscriptionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "event", Type: field.TypeEnum, Enums: []string{"channel_live", "archive_completed", "playlist_video_added"}},
+		{Name: "provider_type", Type: field.TypeEnum, Enums: []string{"webhook", "discord", "slack", "ntfy", "gotify", "apprise", "smtp"}, Default: "webhook"},
+		{Name: "url", Type: field.TypeString, Nullable: true},
+		{Name: "token", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "channel_notification_subscriptions", Type: field.TypeUUID, Nullable: true},
+		{Name: "playlist_notification_subscriptions", Type: field.TypeUUID, Nullable: true},
+		{Name: "user_notification_subscriptions", Type: field.TypeUUID},
+	}
+	// NotificationSubscriptionsTable holds the schema information for the "notification_subscriptions" table.
+	NotificationSubscriptionsTable = &schema.Table{
+		Name:       "notification_subscriptions",
+		Columns:    NotificationSubscriptionsColumns,
+		PrimaryKey: []*schema.Column{NotificationSubscriptionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "notification_subscriptions_channels_notification_subscriptions",
+				Columns:    []*schema.Column{NotificationSubscriptionsColumns[6]},
+				RefColumns: []*schema.Column{ChannelsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "notification_subscriptions_playlists_notification_subscriptions",
+				Columns:    []*schema.Column{NotificationSubscriptionsColumns[7]},
+				RefColumns: []*schema.Column{PlaylistsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "notification_subscriptions_users_notification_subscriptions",
+				Columns:    []*schema.Column{NotificationSubscriptionsColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// PlaybacksColumns holds the columns for the "playbacks" table.
 	PlaybacksColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -215,6 +414,9 @@ var (
 		{Name: "name", Type: field.TypeString, Unique: true},
 		{Name: "description", Type: field.TypeString, Nullable: true},
 		{Name: "thumbnail_path", Type: field.TypeString, Nullable: true},
+		{Name: "visibility", Type: field.TypeEnum, Enums: []string{"public", "authenticated", "restricted"}, Default: "public"},
+		{Name: "allowed_groups", Type: field.TypeJSON, Nullable: true},
+		{Name: "manual_order", Type: field.TypeBool, Default: false},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "created_at", Type: field.TypeTime},
 	}
@@ -228,11 +430,12 @@ var (
 	PlaylistRulesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
 		{Name: "name", Type: field.TypeString, Nullable: true},
-		{Name: "field", Type: field.TypeEnum, Enums: []string{"title", "category", "type", "platform", "channel_name"}, Default: "title"},
-		{Name: "operator", Type: field.TypeEnum, Enums: []string{"equals", "contains", "regex"}, Default: "contains"},
+		{Name: "field", Type: field.TypeEnum, Enums: []string{"title", "category", "type", "platform", "channel_name", "tag", "duration", "streamed_at", "views", "local_views", "storage_size_bytes", "age_days"}, Default: "title"},
+		{Name: "operator", Type: field.TypeEnum, Enums: []string{"equals", "contains", "regex", "greater_than", "greater_than_or_equal", "less_than", "less_than_or_equal"}, Default: "contains"},
 		{Name: "value", Type: field.TypeString},
 		{Name: "position", Type: field.TypeInt, Default: 0},
 		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "negate", Type: field.TypeBool, Default: false},
 		{Name: "playlist_rule_group_rules", Type: field.TypeUUID},
 	}
 	// PlaylistRulesTable holds the schema information for the "playlist_rules" table.
@@ -243,7 +446,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "playlist_rules_playlist_rule_groups_rules",
-				Columns:    []*schema.Column{PlaylistRulesColumns[7]},
+				Columns:    []*schema.Column{PlaylistRulesColumns[8]},
 				RefColumns: []*schema.Column{PlaylistRuleGroupsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -254,7 +457,9 @@ var (
 		{Name: "id", Type: field.TypeUUID},
 		{Name: "operator", Type: field.TypeEnum, Enums: []string{"AND", "OR"}, Default: "AND"},
 		{Name: "position", Type: field.TypeInt, Default: 0},
+		{Name: "negate", Type: field.TypeBool, Default: false},
 		{Name: "playlist_rule_groups", Type: field.TypeUUID},
+		{Name: "parent_id", Type: field.TypeUUID, Nullable: true},
 	}
 	// PlaylistRuleGroupsTable holds the schema information for the "playlist_rule_groups" table.
 	PlaylistRuleGroupsTable = &schema.Table{
@@ -264,10 +469,66 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "playlist_rule_groups_playlists_rule_groups",
-				Columns:    []*schema.Column{PlaylistRuleGroupsColumns[3]},
+				Columns:    []*schema.Column{PlaylistRuleGroupsColumns[4]},
 				RefColumns: []*schema.Column{PlaylistsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
+			{
+				Symbol:     "playlist_rule_groups_playlist_rule_groups_children",
+				Columns:    []*schema.Column{PlaylistRuleGroupsColumns[5]},
+				RefColumns: []*schema.Column{PlaylistRuleGroupsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// PlaylistSectionsColumns holds the columns for the "playlist_sections" table.
+	PlaylistSectionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString, Size: 100},
+		{Name: "position", Type: field.TypeInt, Default: 0},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "playlist_sections", Type: field.TypeUUID},
+	}
+	// PlaylistSectionsTable holds the schema information for the "playlist_sections" table.
+	PlaylistSectionsTable = &schema.Table{
+		Name:       "playlist_sections",
+		Columns:    PlaylistSectionsColumns,
+		PrimaryKey: []*schema.Column{PlaylistSectionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "playlist_sections_playlists_sections",
+				Columns:    []*schema.Column{PlaylistSectionsColumns[5]},
+				RefColumns: []*schema.Column{PlaylistsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// PlaylistVodsColumns holds the columns for the "playlist_vods" table.
+	PlaylistVodsColumns = []*schema.Column{
+		{Name: "position", Type: field.TypeInt, Default: 0},
+		{Name: "section_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "playlist_id", Type: field.TypeUUID},
+		{Name: "vod_id", Type: field.TypeUUID},
+	}
+	// PlaylistVodsTable holds the schema information for the "playlist_vods" table.
+	PlaylistVodsTable = &schema.Table{
+		Name:       "playlist_vods",
+		Columns:    PlaylistVodsColumns,
+		PrimaryKey: []*schema.Column{PlaylistVodsColumns[2], PlaylistVodsColumns[3]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "playlist_vods_playlists_playlist",
+				Columns:    []*schema.Column{PlaylistVodsColumns[2]},
+				RefColumns: []*schema.Column{PlaylistsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "playlist_vods_vods_vod",
+				Columns:    []*schema.Column{PlaylistVodsColumns[3]},
+				RefColumns: []*schema.Column{VodsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
 		},
 	}
 	// QueuesColumns holds the columns for the "queues" table.
@@ -283,6 +544,7 @@ var (
 		{Name: "task_vod_save_info", Type: field.TypeEnum, Nullable: true, Enums: []string{"success", "running", "pending", "failed"}, Default: "pending"},
 		{Name: "task_video_download", Type: field.TypeEnum, Nullable: true, Enums: []string{"success", "running", "pending", "failed"}, Default: "pending"},
 		{Name: "task_video_convert", Type: field.TypeEnum, Nullable: true, Enums: []string{"success", "running", "pending", "failed"}, Default: "pending"},
+		{Name: "task_video_validate", Type: field.TypeEnum, Nullable: true, Enums: []string{"success", "running", "pending", "failed"}, Default: "pending"},
 		{Name: "task_video_move", Type: field.TypeEnum, Nullable: true, Enums: []string{"success", "running", "pending", "failed"}, Default: "pending"},
 		{Name: "task_chat_download", Type: field.TypeEnum, Nullable: true, Enums: []string{"success", "running", "pending", "failed"}, Default: "pending"},
 		{Name: "task_chat_convert", Type: field.TypeEnum, Nullable: true, Enums: []string{"success", "running", "pending", "failed"}, Default: "pending"},
@@ -291,6 +553,19 @@ var (
 		{Name: "chat_start", Type: field.TypeTime, Nullable: true},
 		{Name: "archive_chat", Type: field.TypeBool, Nullable: true, Default: true},
 		{Name: "render_chat", Type: field.TypeBool, Nullable: true, Default: true},
+		{Name: "video_download_attempts", Type: field.TypeInt, Nullable: true, Default: 0},
+		{Name: "video_download_resume_fragment", Type: field.TypeInt, Nullable: true},
+		{Name: "video_download_resume_bytes", Type: field.TypeInt64, Nullable: true},
+		{Name: "video_download_progress_at", Type: field.TypeTime, Nullable: true},
+		{Name: "video_validation_verdict", Type: field.TypeEnum, Nullable: true, Enums: []string{"passed", "failed"}},
+		{Name: "video_validation_message", Type: field.TypeString, Nullable: true},
+		{Name: "video_validation_expected_duration", Type: field.TypeFloat64, Nullable: true},
+		{Name: "video_validation_measured_duration", Type: field.TypeFloat64, Nullable: true},
+		{Name: "video_validation_has_video", Type: field.TypeBool, Nullable: true},
+		{Name: "video_validation_has_audio", Type: field.TypeBool, Nullable: true},
+		{Name: "video_validation_retries", Type: field.TypeInt, Nullable: true, Default: 0},
+		{Name: "video_validated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "task_progress", Type: field.TypeJSON, Nullable: true},
 		{Name: "workflow_id", Type: field.TypeString, Nullable: true},
 		{Name: "workflow_run_id", Type: field.TypeString, Nullable: true},
 		{Name: "updated_at", Type: field.TypeTime},
@@ -305,7 +580,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "queues_vods_queue",
-				Columns:    []*schema.Column{QueuesColumns[23]},
+				Columns:    []*schema.Column{QueuesColumns[37]},
 				RefColumns: []*schema.Column{VodsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -331,6 +606,60 @@ var (
 			},
 		},
 	}
+	// ShareLinksColumns holds the columns for the "share_links" table.
+	ShareLinksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "token_hash", Type: field.TypeString, Unique: true},
+		{Name: "token_prefix", Type: field.TypeString},
+		{Name: "start_seconds", Type: field.TypeInt, Nullable: true},
+		{Name: "end_seconds", Type: field.TypeInt, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "views", Type: field.TypeInt, Default: 0},
+		{Name: "last_viewed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "playlist_share_links", Type: field.TypeUUID, Nullable: true},
+		{Name: "user_share_links", Type: field.TypeUUID, Nullable: true},
+		{Name: "vod_share_links", Type: field.TypeUUID, Nullable: true},
+	}
+	// ShareLinksTable holds the schema information for the "share_links" table.
+	ShareLinksTable = &schema.Table{
+		Name:       "share_links",
+		Columns:    ShareLinksColumns,
+		PrimaryKey: []*schema.Column{ShareLinksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "share_links_playlists_share_links",
+				Columns:    []*schema.Column{ShareLinksColumns[9]},
+				RefColumns: []*schema.Column{PlaylistsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "share_links_users_share_links",
+				Columns:    []*schema.Column{ShareLinksColumns[10]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "share_links_vods_share_links",
+				Columns:    []*schema.Column{ShareLinksColumns[11]},
+				RefColumns: []*schema.Column{VodsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// TagsColumns holds the columns for the "tags" table.
+	TagsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString, Unique: true, Size: 50},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// TagsTable holds the schema information for the "tags" table.
+	TagsTable = &schema.Table{
+		Name:       "tags",
+		Columns:    TagsColumns,
+		PrimaryKey: []*schema.Column{TagsColumns[0]},
+	}
 	// TwitchCategoriesColumns holds the columns for the "twitch_categories" table.
 	TwitchCategoriesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString},
@@ -353,8 +682,14 @@ var (
 		{Name: "username", Type: field.TypeString, Unique: true},
 		{Name: "password", Type: field.TypeString, Nullable: true},
 		{Name: "oauth", Type: field.TypeBool, Default: false},
+		{Name: "ldap", Type: field.TypeBool, Default: false},
 		{Name: "role", Type: field.TypeEnum, Enums: []string{"admin", "editor", "archiver", "user"}, Default: "user"},
 		{Name: "webhook", Type: field.TypeString, Nullable: true},
+		{Name: "groups", Type: field.TypeJSON, Nullable: true},
+		{Name: "totp_enabled", Type: field.TypeBool, Default: false},
+		{Name: "totp_secret", Type: field.TypeString, Nullable: true},
+		{Name: "totp_last_counter", Type: field.TypeInt64, Nullable: true},
+		{Name: "totp_recovery_codes", Type: field.TypeJSON, Nullable: true},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "created_at", Type: field.TypeTime},
 	}
@@ -408,6 +743,8 @@ var (
 		{Name: "sprite_thumbnails_columns", Type: field.TypeInt, Nullable: true},
 		{Name: "storage_size_bytes", Type: field.TypeInt64, Default: 0},
 		{Name: "streamed_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "trash_path", Type: field.TypeString, Nullable: true},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "channel_vods", Type: field.TypeUUID},
@@ -420,7 +757,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "vods_channels_vods",
-				Columns:    []*schema.Column{VodsColumns[44]},
+				Columns:    []*schema.Column{VodsColumns[46]},
 				RefColumns: []*schema.Column{ChannelsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -524,26 +861,76 @@ var (
 			},
 		},
 	}
-	// PlaylistVodsColumns holds the columns for the "playlist_vods" table.
-	PlaylistVodsColumns = []*schema.Column{
+	// ChannelAllowedUsersColumns holds the columns for the "channel_allowed_users" table.
+	ChannelAllowedUsersColumns = []*schema.Column{
+		{Name: "channel_id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// ChannelAllowedUsersTable holds the schema information for the "channel_allowed_users" table.
+	ChannelAllowedUsersTable = &schema.Table{
+		Name:       "channel_allowed_users",
+		Columns:    ChannelAllowedUsersColumns,
+		PrimaryKey: []*schema.Column{ChannelAllowedUsersColumns[0], ChannelAllowedUsersColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "channel_allowed_users_channel_id",
+				Columns:    []*schema.Column{ChannelAllowedUsersColumns[0]},
+				RefColumns: []*schema.Column{ChannelsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "channel_allowed_users_user_id",
+				Columns:    []*schema.Column{ChannelAllowedUsersColumns[1]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// PlaylistAllowedUsersColumns holds the columns for the "playlist_allowed_users" table.
+	PlaylistAllowedUsersColumns = []*schema.Column{
 		{Name: "playlist_id", Type: field.TypeUUID},
-		{Name: "vod_id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeUUID},
 	}
-	// PlaylistVodsTable holds the schema information for the "playlist_vods" table.
-	PlaylistVodsTable = &schema.Table{
-		Name:       "playlist_vods",
-		Columns:    PlaylistVodsColumns,
-		PrimaryKey: []*schema.Column{PlaylistVodsColumns[0], PlaylistVodsColumns[1]},
+	// PlaylistAllowedUsersTable holds the schema information for the "playlist_allowed_users" table.
+	PlaylistAllowedUsersTable = &schema.Table{
+		Name:       "playlist_allowed_users",
+		Columns:    PlaylistAllowedUsersColumns,
+		PrimaryKey: []*schema.Column{PlaylistAllowedUsersColumns[0], PlaylistAllowedUsersColumns[1]},
 		ForeignKeys: []*schema.ForeignKey{
 			{
-				Symbol:     "playlist_vods_playlist_id",
-				Columns:    []*schema.Column{PlaylistVodsColumns[0]},
+				Symbol:     "playlist_allowed_users_playlist_id",
+				Columns:    []*schema.Column{PlaylistAllowedUsersColumns[0]},
 				RefColumns: []*schema.Column{PlaylistsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
-				Symbol:     "playlist_vods_vod_id",
-				Columns:    []*schema.Column{PlaylistVodsColumns[1]},
+				Symbol:     "playlist_allowed_users_user_id",
+				Columns:    []*schema.Column{PlaylistAllowedUsersColumns[1]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// TagVodsColumns holds the columns for the "tag_vods" table.
+	TagVodsColumns = []*schema.Column{
+		{Name: "tag_id", Type: field.TypeUUID},
+		{Name: "vod_id", Type: field.TypeUUID},
+	}
+	// TagVodsTable holds the schema information for the "tag_vods" table.
+	TagVodsTable = &schema.Table{
+		Name:       "tag_vods",
+		Columns:    TagVodsColumns,
+		PrimaryKey: []*schema.Column{TagVodsColumns[0], TagVodsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "tag_vods_tag_id",
+				Columns:    []*schema.Column{TagVodsColumns[0]},
+				RefColumns: []*schema.Column{TagsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "tag_vods_vod_id",
+				Columns:    []*schema.Column{TagVodsColumns[1]},
 				RefColumns: []*schema.Column{VodsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -551,20 +938,30 @@ var (
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		APITokensTable,
+		AuditLogsTable,
 		BlockedVideosTable,
+		BulkOperationsTable,
 		ChannelsTable,
 		ChaptersTable,
 		LivesTable,
 		LiveCategoriesTable,
 		LiveTitleRegexesTable,
+		LoginThrottlesTable,
 		MultistreamInfosTable,
 		MutedSegmentsTable,
+		NotificationFailuresTable,
+		NotificationSubscriptionsTable,
 		PlaybacksTable,
 		PlaylistsTable,
 		PlaylistRulesTable,
 		PlaylistRuleGroupsTable,
+		PlaylistSectionsTable,
+		PlaylistVodsTable,
 		QueuesTable,
 		SessionsTable,
+		ShareLinksTable,
+		TagsTable,
 		TwitchCategoriesTable,
 		UsersTable,
 		VodsTable,
@@ -572,11 +969,15 @@ var (
 		YoutubeCredentialsTable,
 		YoutubePlaylistMappingsTable,
 		YoutubeUploadsTable,
-		PlaylistVodsTable,
+		ChannelAllowedUsersTable,
+		PlaylistAllowedUsersTable,
+		TagVodsTable,
 	}
 )
 
 func init() {
+	APITokensTable.ForeignKeys[0].RefTable = UsersTable
+	BulkOperationsTable.ForeignKeys[0].RefTable = UsersTable
 	ChaptersTable.ForeignKeys[0].RefTable = VodsTable
 	LivesTable.ForeignKeys[0].RefTable = ChannelsTable
 	LiveCategoriesTable.ForeignKeys[0].RefTable = LivesTable
@@ -584,13 +985,27 @@ func init() {
 	MultistreamInfosTable.ForeignKeys[0].RefTable = VodsTable
 	MultistreamInfosTable.ForeignKeys[1].RefTable = PlaylistsTable
 	MutedSegmentsTable.ForeignKeys[0].RefTable = VodsTable
+	NotificationSubscriptionsTable.ForeignKeys[0].RefTable = ChannelsTable
+	NotificationSubscriptionsTable.ForeignKeys[1].RefTable = PlaylistsTable
+	NotificationSubscriptionsTable.ForeignKeys[2].RefTable = UsersTable
 	PlaylistRulesTable.ForeignKeys[0].RefTable = PlaylistRuleGroupsTable
 	PlaylistRuleGroupsTable.ForeignKeys[0].RefTable = PlaylistsTable
+	PlaylistRuleGroupsTable.ForeignKeys[1].RefTable = PlaylistRuleGroupsTable
+	PlaylistSectionsTable.ForeignKeys[0].RefTable = PlaylistsTable
+	PlaylistVodsTable.ForeignKeys[0].RefTable = PlaylistsTable
+	PlaylistVodsTable.ForeignKeys[1].RefTable = VodsTable
 	QueuesTable.ForeignKeys[0].RefTable = VodsTable
+	ShareLinksTable.ForeignKeys[0].RefTable = PlaylistsTable
+	ShareLinksTable.ForeignKeys[1].RefTable = UsersTable
+	ShareLinksTable.ForeignKeys[2].RefTable = VodsTable
 	VodsTable.ForeignKeys[0].RefTable = ChannelsTable
 	YoutubeConfigsTable.ForeignKeys[0].RefTable = ChannelsTable
 	YoutubePlaylistMappingsTable.ForeignKeys[0].RefTable = YoutubeConfigsTable
 	YoutubeUploadsTable.ForeignKeys[0].RefTable = VodsTable
-	PlaylistVodsTable.ForeignKeys[0].RefTable = PlaylistsTable
-	PlaylistVodsTable.ForeignKeys[1].RefTable = VodsTable
+	ChannelAllowedUsersTable.ForeignKeys[0].RefTable = ChannelsTable
+	ChannelAllowedUsersTable.ForeignKeys[1].RefTable = UsersTable
+	PlaylistAllowedUsersTable.ForeignKeys[0].RefTable = PlaylistsTable
+	PlaylistAllowedUsersTable.ForeignKeys[1].RefTable = UsersTable
+	TagVodsTable.ForeignKeys[0].RefTable = TagsTable
+	TagVodsTable.ForeignKeys[1].RefTable = VodsTable
 }
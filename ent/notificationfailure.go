@@ -0,0 +1,165 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/notificationfailure"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// NotificationFailure is the model entity for the NotificationFailure schema.
+type NotificationFailure struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Name of the provider the notification was sent to.
+	ProviderName string `json:"provider_name,omitempty"`
+	// ProviderType holds the value of the "provider_type" field.
+	ProviderType utils.NotificationProviderType `json:"provider_type,omitempty"`
+	// Notification event that was sent.
+	Event string `json:"event,omitempty"`
+	// Error of the last delivery attempt.
+	Error string `json:"error,omitempty"`
+	// Number of delivery attempts.
+	Attempts int `json:"attempts,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*NotificationFailure) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case notificationfailure.FieldAttempts:
+			values[i] = new(sql.NullInt64)
+		case notificationfailure.FieldProviderName, notificationfailure.FieldProviderType, notificationfailure.FieldEvent, notificationfailure.FieldError:
+			values[i] = new(sql.NullString)
+		case notificationfailure.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case notificationfailure.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the NotificationFailure fields.
+func (_m *NotificationFailure) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case notificationfailure.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case notificationfailure.FieldProviderName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider_name", values[i])
+			} else if value.Valid {
+				_m.ProviderName = value.String
+			}
+		case notificationfailure.FieldProviderType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider_type", values[i])
+			} else if value.Valid {
+				_m.ProviderType = utils.NotificationProviderType(value.String)
+			}
+		case notificationfailure.FieldEvent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field event", values[i])
+			} else if value.Valid {
+				_m.Event = value.String
+			}
+		case notificationfailure.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				_m.Error = value.String
+			}
+		case notificationfailure.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				_m.Attempts = int(value.Int64)
+			}
+		case notificationfailure.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the NotificationFailure.
+// This includes values selected through modifiers, order, etc.
+func (_m *NotificationFailure) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this NotificationFailure.
+// Note that you need to call NotificationFailure.Unwrap() before calling this method if this NotificationFailure
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *NotificationFailure) Update() *NotificationFailureUpdateOne {
+	return NewNotificationFailureClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the NotificationFailure entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *NotificationFailure) Unwrap() *NotificationFailure {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: NotificationFailure is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *NotificationFailure) String() string {
+	var builder strings.Builder
+	builder.WriteString("NotificationFailure(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("provider_name=")
+	builder.WriteString(_m.ProviderName)
+	builder.WriteString(", ")
+	builder.WriteString("provider_type=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ProviderType))
+	builder.WriteString(", ")
+	builder.WriteString("event=")
+	builder.WriteString(_m.Event)
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(_m.Error)
+	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Attempts))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// NotificationFailures is a parsable slice of NotificationFailure.
+type NotificationFailures []*NotificationFailure
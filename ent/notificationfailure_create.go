@@ -0,0 +1,297 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/notificationfailure"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// NotificationFailureCreate is the builder for creating a NotificationFailure entity.
+type NotificationFailureCreate struct {
+	config
+	mutation *NotificationFailureMutation
+	hooks    []Hook
+}
+
+// SetProviderName sets the "provider_name" field.
+func (_c *NotificationFailureCreate) SetProviderName(v string) *NotificationFailureCreate {
+	_c.mutation.SetProviderName(v)
+	return _c
+}
+
+// SetProviderType sets the "provider_type" field.
+func (_c *NotificationFailureCreate) SetProviderType(v utils.NotificationProviderType) *NotificationFailureCreate {
+	_c.mutation.SetProviderType(v)
+	return _c
+}
+
+// SetEvent sets the "event" field.
+func (_c *NotificationFailureCreate) SetEvent(v string) *NotificationFailureCreate {
+	_c.mutation.SetEvent(v)
+	return _c
+}
+
+// SetError sets the "error" field.
+func (_c *NotificationFailureCreate) SetError(v string) *NotificationFailureCreate {
+	_c.mutation.SetError(v)
+	return _c
+}
+
+// SetAttempts sets the "attempts" field.
+func (_c *NotificationFailureCreate) SetAttempts(v int) *NotificationFailureCreate {
+	_c.mutation.SetAttempts(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *NotificationFailureCreate) SetCreatedAt(v time.Time) *NotificationFailureCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *NotificationFailureCreate) SetNillableCreatedAt(v *time.Time) *NotificationFailureCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *NotificationFailureCreate) SetID(v uuid.UUID) *NotificationFailureCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *NotificationFailureCreate) SetNillableID(v *uuid.UUID) *NotificationFailureCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the NotificationFailureMutation object of the builder.
+func (_c *NotificationFailureCreate) Mutation() *NotificationFailureMutation {
+	return _c.mutation
+}
+
+// Save creates the NotificationFailure in the database.
+func (_c *NotificationFailureCreate) Save(ctx context.Context) (*NotificationFailure, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *NotificationFailureCreate) SaveX(ctx context.Context) *NotificationFailure {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *NotificationFailureCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *NotificationFailureCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *NotificationFailureCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := notificationfailure.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := notificationfailure.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *NotificationFailureCreate) check() error {
+	if _, ok := _c.mutation.ProviderName(); !ok {
+		return &ValidationError{Name: "provider_name", err: errors.New(`ent: missing required field "NotificationFailure.provider_name"`)}
+	}
+	if _, ok := _c.mutation.ProviderType(); !ok {
+		return &ValidationError{Name: "provider_type", err: errors.New(`ent: missing required field "NotificationFailure.provider_type"`)}
+	}
+	if v, ok := _c.mutation.ProviderType(); ok {
+		if err := notificationfailure.ProviderTypeValidator(v); err != nil {
+			return &ValidationError{Name: "provider_type", err: fmt.Errorf(`ent: validator failed for field "NotificationFailure.provider_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Event(); !ok {
+		return &ValidationError{Name: "event", err: errors.New(`ent: missing required field "NotificationFailure.event"`)}
+	}
+	if _, ok := _c.mutation.Error(); !ok {
+		return &ValidationError{Name: "error", err: errors.New(`ent: missing required field "NotificationFailure.error"`)}
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "NotificationFailure.attempts"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "NotificationFailure.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *NotificationFailureCreate) sqlSave(ctx context.Context) (*NotificationFailure, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *NotificationFailureCreate) createSpec() (*NotificationFailure, *sqlgraph.CreateSpec) {
+	var (
+		_node = &NotificationFailure{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(notificationfailure.Table, sqlgraph.NewFieldSpec(notificationfailure.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.ProviderName(); ok {
+		_spec.SetField(notificationfailure.FieldProviderName, field.TypeString, value)
+		_node.ProviderName = value
+	}
+	if value, ok := _c.mutation.ProviderType(); ok {
+		_spec.SetField(notificationfailure.FieldProviderType, field.TypeEnum, value)
+		_node.ProviderType = value
+	}
+	if value, ok := _c.mutation.Event(); ok {
+		_spec.SetField(notificationfailure.FieldEvent, field.TypeString, value)
+		_node.Event = value
+	}
+	if value, ok := _c.mutation.Error(); ok {
+		_spec.SetField(notificationfailure.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := _c.mutation.Attempts(); ok {
+		_spec.SetField(notificationfailure.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(notificationfailure.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// NotificationFailureCreateBulk is the builder for creating many NotificationFailure entities in bulk.
+type NotificationFailureCreateBulk struct {
+	config
+	err      error
+	builders []*NotificationFailureCreate
+}
+
+// Save creates the NotificationFailure entities in the database.
+func (_c *NotificationFailureCreateBulk) Save(ctx context.Context) ([]*NotificationFailure, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*NotificationFailure, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*NotificationFailureMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *NotificationFailureCreateBulk) SaveX(ctx context.Context) []*NotificationFailure {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *NotificationFailureCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *NotificationFailureCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
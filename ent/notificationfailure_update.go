@@ -0,0 +1,392 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/zibbp/ganymede/ent/notificationfailure"
+	"github.com/zibbp/ganymede/ent/predicate"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// NotificationFailureUpdate is the builder for updating NotificationFailure entities.
+type NotificationFailureUpdate struct {
+	config
+	hooks    []Hook
+	mutation *NotificationFailureMutation
+}
+
+// Where appends a list predicates to the NotificationFailureUpdate builder.
+func (_u *NotificationFailureUpdate) Where(ps ...predicate.NotificationFailure) *NotificationFailureUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetProviderName sets the "provider_name" field.
+func (_u *NotificationFailureUpdate) SetProviderName(v string) *NotificationFailureUpdate {
+	_u.mutation.SetProviderName(v)
+	return _u
+}
+
+// SetNillableProviderName sets the "provider_name" field if the given value is not nil.
+func (_u *NotificationFailureUpdate) SetNillableProviderName(v *string) *NotificationFailureUpdate {
+	if v != nil {
+		_u.SetProviderName(*v)
+	}
+	return _u
+}
+
+// SetProviderType sets the "provider_type" field.
+func (_u *NotificationFailureUpdate) SetProviderType(v utils.NotificationProviderType) *NotificationFailureUpdate {
+	_u.mutation.SetProviderType(v)
+	return _u
+}
+
+// SetNillableProviderType sets the "provider_type" field if the given value is not nil.
+func (_u *NotificationFailureUpdate) SetNillableProviderType(v *utils.NotificationProviderType) *NotificationFailureUpdate {
+	if v != nil {
+		_u.SetProviderType(*v)
+	}
+	return _u
+}
+
+// SetEvent sets the "event" field.
+func (_u *NotificationFailureUpdate) SetEvent(v string) *NotificationFailureUpdate {
+	_u.mutation.SetEvent(v)
+	return _u
+}
+
+// SetNillableEvent sets the "event" field if the given value is not nil.
+func (_u *NotificationFailureUpdate) SetNillableEvent(v *string) *NotificationFailureUpdate {
+	if v != nil {
+		_u.SetEvent(*v)
+	}
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *NotificationFailureUpdate) SetError(v string) *NotificationFailureUpdate {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *NotificationFailureUpdate) SetNillableError(v *string) *NotificationFailureUpdate {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *NotificationFailureUpdate) SetAttempts(v int) *NotificationFailureUpdate {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *NotificationFailureUpdate) SetNillableAttempts(v *int) *NotificationFailureUpdate {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *NotificationFailureUpdate) AddAttempts(v int) *NotificationFailureUpdate {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// Mutation returns the NotificationFailureMutation object of the builder.
+func (_u *NotificationFailureUpdate) Mutation() *NotificationFailureMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *NotificationFailureUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *NotificationFailureUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *NotificationFailureUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *NotificationFailureUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *NotificationFailureUpdate) check() error {
+	if v, ok := _u.mutation.ProviderType(); ok {
+		if err := notificationfailure.ProviderTypeValidator(v); err != nil {
+			return &ValidationError{Name: "provider_type", err: fmt.Errorf(`ent: validator failed for field "NotificationFailure.provider_type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *NotificationFailureUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(notificationfailure.Table, notificationfailure.Columns, sqlgraph.NewFieldSpec(notificationfailure.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ProviderName(); ok {
+		_spec.SetField(notificationfailure.FieldProviderName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ProviderType(); ok {
+		_spec.SetField(notificationfailure.FieldProviderType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Event(); ok {
+		_spec.SetField(notificationfailure.FieldEvent, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(notificationfailure.FieldError, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(notificationfailure.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(notificationfailure.FieldAttempts, field.TypeInt, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notificationfailure.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// NotificationFailureUpdateOne is the builder for updating a single NotificationFailure entity.
+type NotificationFailureUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *NotificationFailureMutation
+}
+
+// SetProviderName sets the "provider_name" field.
+func (_u *NotificationFailureUpdateOne) SetProviderName(v string) *NotificationFailureUpdateOne {
+	_u.mutation.SetProviderName(v)
+	return _u
+}
+
+// SetNillableProviderName sets the "provider_name" field if the given value is not nil.
+func (_u *NotificationFailureUpdateOne) SetNillableProviderName(v *string) *NotificationFailureUpdateOne {
+	if v != nil {
+		_u.SetProviderName(*v)
+	}
+	return _u
+}
+
+// SetProviderType sets the "provider_type" field.
+func (_u *NotificationFailureUpdateOne) SetProviderType(v utils.NotificationProviderType) *NotificationFailureUpdateOne {
+	_u.mutation.SetProviderType(v)
+	return _u
+}
+
+// SetNillableProviderType sets the "provider_type" field if the given value is not nil.
+func (_u *NotificationFailureUpdateOne) SetNillableProviderType(v *utils.NotificationProviderType) *NotificationFailureUpdateOne {
+	if v != nil {
+		_u.SetProviderType(*v)
+	}
+	return _u
+}
+
+// SetEvent sets the "event" field.
+func (_u *NotificationFailureUpdateOne) SetEvent(v string) *NotificationFailureUpdateOne {
+	_u.mutation.SetEvent(v)
+	return _u
+}
+
+// SetNillableEvent sets the "event" field if the given value is not nil.
+func (_u *NotificationFailureUpdateOne) SetNillableEvent(v *string) *NotificationFailureUpdateOne {
+	if v != nil {
+		_u.SetEvent(*v)
+	}
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *NotificationFailureUpdateOne) SetError(v string) *NotificationFailureUpdateOne {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *NotificationFailureUpdateOne) SetNillableError(v *string) *NotificationFailureUpdateOne {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *NotificationFailureUpdateOne) SetAttempts(v int) *NotificationFailureUpdateOne {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *NotificationFailureUpdateOne) SetNillableAttempts(v *int) *NotificationFailureUpdateOne {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *NotificationFailureUpdateOne) AddAttempts(v int) *NotificationFailureUpdateOne {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// Mutation returns the NotificationFailureMutation object of the builder.
+func (_u *NotificationFailureUpdateOne) Mutation() *NotificationFailureMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the NotificationFailureUpdate builder.
+func (_u *NotificationFailureUpdateOne) Where(ps ...predicate.NotificationFailure) *NotificationFailureUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *NotificationFailureUpdateOne) Select(field string, fields ...string) *NotificationFailureUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated NotificationFailure entity.
+func (_u *NotificationFailureUpdateOne) Save(ctx context.Context) (*NotificationFailure, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *NotificationFailureUpdateOne) SaveX(ctx context.Context) *NotificationFailure {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *NotificationFailureUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *NotificationFailureUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *NotificationFailureUpdateOne) check() error {
+	if v, ok := _u.mutation.ProviderType(); ok {
+		if err := notificationfailure.ProviderTypeValidator(v); err != nil {
+			return &ValidationError{Name: "provider_type", err: fmt.Errorf(`ent: validator failed for field "NotificationFailure.provider_type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *NotificationFailureUpdateOne) sqlSave(ctx context.Context) (_node *NotificationFailure, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(notificationfailure.Table, notificationfailure.Columns, sqlgraph.NewFieldSpec(notificationfailure.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "NotificationFailure.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, notificationfailure.FieldID)
+		for _, f := range fields {
+			if !notificationfailure.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != notificationfailure.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ProviderName(); ok {
+		_spec.SetField(notificationfailure.FieldProviderName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ProviderType(); ok {
+		_spec.SetField(notificationfailure.FieldProviderType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Event(); ok {
+		_spec.SetField(notificationfailure.FieldEvent, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(notificationfailure.FieldError, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(notificationfailure.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(notificationfailure.FieldAttempts, field.TypeInt, value)
+	}
+	_node = &NotificationFailure{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notificationfailure.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,248 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/channel"
+	"github.com/zibbp/ganymede/ent/notificationsubscription"
+	"github.com/zibbp/ganymede/ent/playlist"
+	"github.com/zibbp/ganymede/ent/user"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// NotificationSubscription is the model entity for the NotificationSubscription schema.
+type NotificationSubscription struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Event holds the value of the "event" field.
+	Event utils.SubscriptionEvent `json:"event,omitempty"`
+	// ProviderType holds the value of the "provider_type" field.
+	ProviderType utils.NotificationProviderType `json:"provider_type,omitempty"`
+	// Destination of the notification, the user's webhook is used if empty.
+	URL string `json:"url,omitempty"`
+	// ntfy access token or Gotify application token.
+	Token string `json:"-"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the NotificationSubscriptionQuery when eager-loading is set.
+	Edges                               NotificationSubscriptionEdges `json:"edges"`
+	channel_notification_subscriptions  *uuid.UUID
+	playlist_notification_subscriptions *uuid.UUID
+	user_notification_subscriptions     *uuid.UUID
+	selectValues                        sql.SelectValues
+}
+
+// NotificationSubscriptionEdges holds the relations/edges for other nodes in the graph.
+type NotificationSubscriptionEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// Channel of channel_live and archive_completed subscriptions.
+	Channel *Channel `json:"channel,omitempty"`
+	// Playlist of playlist_video_added subscriptions.
+	Playlist *Playlist `json:"playlist,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e NotificationSubscriptionEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// ChannelOrErr returns the Channel value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e NotificationSubscriptionEdges) ChannelOrErr() (*Channel, error) {
+	if e.Channel != nil {
+		return e.Channel, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: channel.Label}
+	}
+	return nil, &NotLoadedError{edge: "channel"}
+}
+
+// PlaylistOrErr returns the Playlist value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e NotificationSubscriptionEdges) PlaylistOrErr() (*Playlist, error) {
+	if e.Playlist != nil {
+		return e.Playlist, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: playlist.Label}
+	}
+	return nil, &NotLoadedError{edge: "playlist"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*NotificationSubscription) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case notificationsubscription.FieldEvent, notificationsubscription.FieldProviderType, notificationsubscription.FieldURL, notificationsubscription.FieldToken:
+			values[i] = new(sql.NullString)
+		case notificationsubscription.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case notificationsubscription.FieldID:
+			values[i] = new(uuid.UUID)
+		case notificationsubscription.ForeignKeys[0]: // channel_notification_subscriptions
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case notificationsubscription.ForeignKeys[1]: // playlist_notification_subscriptions
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case notificationsubscription.ForeignKeys[2]: // user_notification_subscriptions
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the NotificationSubscription fields.
+func (_m *NotificationSubscription) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case notificationsubscription.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case notificationsubscription.FieldEvent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field event", values[i])
+			} else if value.Valid {
+				_m.Event = utils.SubscriptionEvent(value.String)
+			}
+		case notificationsubscription.FieldProviderType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider_type", values[i])
+			} else if value.Valid {
+				_m.ProviderType = utils.NotificationProviderType(value.String)
+			}
+		case notificationsubscription.FieldURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field url", values[i])
+			} else if value.Valid {
+				_m.URL = value.String
+			}
+		case notificationsubscription.FieldToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token", values[i])
+			} else if value.Valid {
+				_m.Token = value.String
+			}
+		case notificationsubscription.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case notificationsubscription.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field channel_notification_subscriptions", values[i])
+			} else if value.Valid {
+				_m.channel_notification_subscriptions = new(uuid.UUID)
+				*_m.channel_notification_subscriptions = *value.S.(*uuid.UUID)
+			}
+		case notificationsubscription.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field playlist_notification_subscriptions", values[i])
+			} else if value.Valid {
+				_m.playlist_notification_subscriptions = new(uuid.UUID)
+				*_m.playlist_notification_subscriptions = *value.S.(*uuid.UUID)
+			}
+		case notificationsubscription.ForeignKeys[2]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_notification_subscriptions", values[i])
+			} else if value.Valid {
+				_m.user_notification_subscriptions = new(uuid.UUID)
+				*_m.user_notification_subscriptions = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the NotificationSubscription.
+// This includes values selected through modifiers, order, etc.
+func (_m *NotificationSubscription) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the NotificationSubscription entity.
+func (_m *NotificationSubscription) QueryUser() *UserQuery {
+	return NewNotificationSubscriptionClient(_m.config).QueryUser(_m)
+}
+
+// QueryChannel queries the "channel" edge of the NotificationSubscription entity.
+func (_m *NotificationSubscription) QueryChannel() *ChannelQuery {
+	return NewNotificationSubscriptionClient(_m.config).QueryChannel(_m)
+}
+
+// QueryPlaylist queries the "playlist" edge of the NotificationSubscription entity.
+func (_m *NotificationSubscription) QueryPlaylist() *PlaylistQuery {
+	return NewNotificationSubscriptionClient(_m.config).QueryPlaylist(_m)
+}
+
+// Update returns a builder for updating this NotificationSubscription.
+// Note that you need to call NotificationSubscription.Unwrap() before calling this method if this NotificationSubscription
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *NotificationSubscription) Update() *NotificationSubscriptionUpdateOne {
+	return NewNotificationSubscriptionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the NotificationSubscription entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *NotificationSubscription) Unwrap() *NotificationSubscription {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: NotificationSubscription is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *NotificationSubscription) String() string {
+	var builder strings.Builder
+	builder.WriteString("NotificationSubscription(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("event=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Event))
+	builder.WriteString(", ")
+	builder.WriteString("provider_type=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ProviderType))
+	builder.WriteString(", ")
+	builder.WriteString("url=")
+	builder.WriteString(_m.URL)
+	builder.WriteString(", ")
+	builder.WriteString("token=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// NotificationSubscriptions is a parsable slice of NotificationSubscription.
+type NotificationSubscriptions []*NotificationSubscription
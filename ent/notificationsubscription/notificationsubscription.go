@@ -0,0 +1,196 @@
+// Code generated by ent, DO NOT EDIT.
+
+package notificationsubscription
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+const (
+	// Label holds the string label denoting the notificationsubscription type in the database.
+	Label = "notification_subscription"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldEvent holds the string denoting the event field in the database.
+	FieldEvent = "event"
+	// FieldProviderType holds the string denoting the provider_type field in the database.
+	FieldProviderType = "provider_type"
+	// FieldURL holds the string denoting the url field in the database.
+	FieldURL = "url"
+	// FieldToken holds the string denoting the token field in the database.
+	FieldToken = "token"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeUser holds the string denoting the user edge name in mutations.
+	EdgeUser = "user"
+	// EdgeChannel holds the string denoting the channel edge name in mutations.
+	EdgeChannel = "channel"
+	// EdgePlaylist holds the string denoting the playlist edge name in mutations.
+	EdgePlaylist = "playlist"
+	// Table holds the table name of the notificationsubscription in the database.
+	Table = "notification_subscriptions"
+	// UserTable is the table that holds the user relation/edge.
+	UserTable = "notification_subscriptions"
+	// UserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	UserInverseTable = "users"
+	// UserColumn is the table column denoting the user relation/edge.
+	UserColumn = "user_notification_subscriptions"
+	// ChannelTable is the table that holds the channel relation/edge.
+	ChannelTable = "notification_subscriptions"
+	// ChannelInverseTable is the table name for the Channel entity.
+	// It exists in this package in order to avoid circular dependency with the "channel" package.
+	ChannelInverseTable = "channels"
+	// ChannelColumn is the table column denoting the channel relation/edge.
+	ChannelColumn = "channel_notification_subscriptions"
+	// PlaylistTable is the table that holds the playlist relation/edge.
+	PlaylistTable = "notification_subscriptions"
+	// PlaylistInverseTable is the table name for the Playlist entity.
+	// It exists in this package in order to avoid circular dependency with the "playlist" package.
+	PlaylistInverseTable = "playlists"
+	// PlaylistColumn is the table column denoting the playlist relation/edge.
+	PlaylistColumn = "playlist_notification_subscriptions"
+)
+
+// Columns holds all SQL columns for notificationsubscription fields.
+var Columns = []string{
+	FieldID,
+	FieldEvent,
+	FieldProviderType,
+	FieldURL,
+	FieldToken,
+	FieldCreatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "notification_subscriptions"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"channel_notification_subscriptions",
+	"playlist_notification_subscriptions",
+	"user_notification_subscriptions",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// EventValidator is a validator for the "event" field enum values. It is called by the builders before save.
+func EventValidator(e utils.SubscriptionEvent) error {
+	switch e {
+	case "channel_live", "archive_completed", "playlist_video_added":
+		return nil
+	default:
+		return fmt.Errorf("notificationsubscription: invalid enum value for event field: %q", e)
+	}
+}
+
+const DefaultProviderType utils.NotificationProviderType = "webhook"
+
+// ProviderTypeValidator is a validator for the "provider_type" field enum values. It is called by the builders before save.
+func ProviderTypeValidator(pt utils.NotificationProviderType) error {
+	switch pt {
+	case "webhook", "discord", "slack", "ntfy", "gotify", "apprise", "smtp":
+		return nil
+	default:
+		return fmt.Errorf("notificationsubscription: invalid enum value for provider_type field: %q", pt)
+	}
+}
+
+// OrderOption defines the ordering options for the NotificationSubscription queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByEvent orders the results by the event field.
+func ByEvent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEvent, opts...).ToFunc()
+}
+
+// ByProviderType orders the results by the provider_type field.
+func ByProviderType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProviderType, opts...).ToFunc()
+}
+
+// ByURL orders the results by the url field.
+func ByURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldURL, opts...).ToFunc()
+}
+
+// ByToken orders the results by the token field.
+func ByToken(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldToken, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUserField orders the results by user field.
+func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByChannelField orders the results by channel field.
+func ByChannelField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newChannelStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByPlaylistField orders the results by playlist field.
+func ByPlaylistField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPlaylistStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newUserStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UserInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+	)
+}
+func newChannelStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ChannelInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ChannelTable, ChannelColumn),
+	)
+}
+func newPlaylistStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PlaylistInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, PlaylistTable, PlaylistColumn),
+	)
+}
@@ -0,0 +1,417 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/channel"
+	"github.com/zibbp/ganymede/ent/notificationsubscription"
+	"github.com/zibbp/ganymede/ent/playlist"
+	"github.com/zibbp/ganymede/ent/user"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// NotificationSubscriptionCreate is the builder for creating a NotificationSubscription entity.
+type NotificationSubscriptionCreate struct {
+	config
+	mutation *NotificationSubscriptionMutation
+	hooks    []Hook
+}
+
+// SetEvent sets the "event" field.
+func (_c *NotificationSubscriptionCreate) SetEvent(v utils.SubscriptionEvent) *NotificationSubscriptionCreate {
+	_c.mutation.SetEvent(v)
+	return _c
+}
+
+// SetProviderType sets the "provider_type" field.
+func (_c *NotificationSubscriptionCreate) SetProviderType(v utils.NotificationProviderType) *NotificationSubscriptionCreate {
+	_c.mutation.SetProviderType(v)
+	return _c
+}
+
+// SetNillableProviderType sets the "provider_type" field if the given value is not nil.
+func (_c *NotificationSubscriptionCreate) SetNillableProviderType(v *utils.NotificationProviderType) *NotificationSubscriptionCreate {
+	if v != nil {
+		_c.SetProviderType(*v)
+	}
+	return _c
+}
+
+// SetURL sets the "url" field.
+func (_c *NotificationSubscriptionCreate) SetURL(v string) *NotificationSubscriptionCreate {
+	_c.mutation.SetURL(v)
+	return _c
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (_c *NotificationSubscriptionCreate) SetNillableURL(v *string) *NotificationSubscriptionCreate {
+	if v != nil {
+		_c.SetURL(*v)
+	}
+	return _c
+}
+
+// SetToken sets the "token" field.
+func (_c *NotificationSubscriptionCreate) SetToken(v string) *NotificationSubscriptionCreate {
+	_c.mutation.SetToken(v)
+	return _c
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (_c *NotificationSubscriptionCreate) SetNillableToken(v *string) *NotificationSubscriptionCreate {
+	if v != nil {
+		_c.SetToken(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *NotificationSubscriptionCreate) SetCreatedAt(v time.Time) *NotificationSubscriptionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *NotificationSubscriptionCreate) SetNillableCreatedAt(v *time.Time) *NotificationSubscriptionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *NotificationSubscriptionCreate) SetID(v uuid.UUID) *NotificationSubscriptionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *NotificationSubscriptionCreate) SetNillableID(v *uuid.UUID) *NotificationSubscriptionCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_c *NotificationSubscriptionCreate) SetUserID(id uuid.UUID) *NotificationSubscriptionCreate {
+	_c.mutation.SetUserID(id)
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *NotificationSubscriptionCreate) SetUser(v *User) *NotificationSubscriptionCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// SetChannelID sets the "channel" edge to the Channel entity by ID.
+func (_c *NotificationSubscriptionCreate) SetChannelID(id uuid.UUID) *NotificationSubscriptionCreate {
+	_c.mutation.SetChannelID(id)
+	return _c
+}
+
+// SetNillableChannelID sets the "channel" edge to the Channel entity by ID if the given value is not nil.
+func (_c *NotificationSubscriptionCreate) SetNillableChannelID(id *uuid.UUID) *NotificationSubscriptionCreate {
+	if id != nil {
+		_c = _c.SetChannelID(*id)
+	}
+	return _c
+}
+
+// SetChannel sets the "channel" edge to the Channel entity.
+func (_c *NotificationSubscriptionCreate) SetChannel(v *Channel) *NotificationSubscriptionCreate {
+	return _c.SetChannelID(v.ID)
+}
+
+// SetPlaylistID sets the "playlist" edge to the Playlist entity by ID.
+func (_c *NotificationSubscriptionCreate) SetPlaylistID(id uuid.UUID) *NotificationSubscriptionCreate {
+	_c.mutation.SetPlaylistID(id)
+	return _c
+}
+
+// SetNillablePlaylistID sets the "playlist" edge to the Playlist entity by ID if the given value is not nil.
+func (_c *NotificationSubscriptionCreate) SetNillablePlaylistID(id *uuid.UUID) *NotificationSubscriptionCreate {
+	if id != nil {
+		_c = _c.SetPlaylistID(*id)
+	}
+	return _c
+}
+
+// SetPlaylist sets the "playlist" edge to the Playlist entity.
+func (_c *NotificationSubscriptionCreate) SetPlaylist(v *Playlist) *NotificationSubscriptionCreate {
+	return _c.SetPlaylistID(v.ID)
+}
+
+// Mutation returns the NotificationSubscriptionMutation object of the builder.
+func (_c *NotificationSubscriptionCreate) Mutation() *NotificationSubscriptionMutation {
+	return _c.mutation
+}
+
+// Save creates the NotificationSubscription in the database.
+func (_c *NotificationSubscriptionCreate) Save(ctx context.Context) (*NotificationSubscription, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *NotificationSubscriptionCreate) SaveX(ctx context.Context) *NotificationSubscription {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *NotificationSubscriptionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *NotificationSubscriptionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *NotificationSubscriptionCreate) defaults() {
+	if _, ok := _c.mutation.ProviderType(); !ok {
+		v := notificationsubscription.DefaultProviderType
+		_c.mutation.SetProviderType(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := notificationsubscription.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := notificationsubscription.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *NotificationSubscriptionCreate) check() error {
+	if _, ok := _c.mutation.Event(); !ok {
+		return &ValidationError{Name: "event", err: errors.New(`ent: missing required field "NotificationSubscription.event"`)}
+	}
+	if v, ok := _c.mutation.Event(); ok {
+		if err := notificationsubscription.EventValidator(v); err != nil {
+			return &ValidationError{Name: "event", err: fmt.Errorf(`ent: validator failed for field "NotificationSubscription.event": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ProviderType(); !ok {
+		return &ValidationError{Name: "provider_type", err: errors.New(`ent: missing required field "NotificationSubscription.provider_type"`)}
+	}
+	if v, ok := _c.mutation.ProviderType(); ok {
+		if err := notificationsubscription.ProviderTypeValidator(v); err != nil {
+			return &ValidationError{Name: "provider_type", err: fmt.Errorf(`ent: validator failed for field "NotificationSubscription.provider_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "NotificationSubscription.created_at"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "NotificationSubscription.user"`)}
+	}
+	return nil
+}
+
+func (_c *NotificationSubscriptionCreate) sqlSave(ctx context.Context) (*NotificationSubscription, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *NotificationSubscriptionCreate) createSpec() (*NotificationSubscription, *sqlgraph.CreateSpec) {
+	var (
+		_node = &NotificationSubscription{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(notificationsubscription.Table, sqlgraph.NewFieldSpec(notificationsubscription.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Event(); ok {
+		_spec.SetField(notificationsubscription.FieldEvent, field.TypeEnum, value)
+		_node.Event = value
+	}
+	if value, ok := _c.mutation.ProviderType(); ok {
+		_spec.SetField(notificationsubscription.FieldProviderType, field.TypeEnum, value)
+		_node.ProviderType = value
+	}
+	if value, ok := _c.mutation.URL(); ok {
+		_spec.SetField(notificationsubscription.FieldURL, field.TypeString, value)
+		_node.URL = value
+	}
+	if value, ok := _c.mutation.Token(); ok {
+		_spec.SetField(notificationsubscription.FieldToken, field.TypeString, value)
+		_node.Token = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(notificationsubscription.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notificationsubscription.UserTable,
+			Columns: []string{notificationsubscription.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_notification_subscriptions = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ChannelIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notificationsubscription.ChannelTable,
+			Columns: []string{notificationsubscription.ChannelColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(channel.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.channel_notification_subscriptions = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PlaylistIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notificationsubscription.PlaylistTable,
+			Columns: []string{notificationsubscription.PlaylistColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlist.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.playlist_notification_subscriptions = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// NotificationSubscriptionCreateBulk is the builder for creating many NotificationSubscription entities in bulk.
+type NotificationSubscriptionCreateBulk struct {
+	config
+	err      error
+	builders []*NotificationSubscriptionCreate
+}
+
+// Save creates the NotificationSubscription entities in the database.
+func (_c *NotificationSubscriptionCreateBulk) Save(ctx context.Context) ([]*NotificationSubscription, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*NotificationSubscription, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*NotificationSubscriptionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *NotificationSubscriptionCreateBulk) SaveX(ctx context.Context) []*NotificationSubscription {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *NotificationSubscriptionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *NotificationSubscriptionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
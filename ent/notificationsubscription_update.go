@@ -0,0 +1,702 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/channel"
+	"github.com/zibbp/ganymede/ent/notificationsubscription"
+	"github.com/zibbp/ganymede/ent/playlist"
+	"github.com/zibbp/ganymede/ent/predicate"
+	"github.com/zibbp/ganymede/ent/user"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// NotificationSubscriptionUpdate is the builder for updating NotificationSubscription entities.
+type NotificationSubscriptionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *NotificationSubscriptionMutation
+}
+
+// Where appends a list predicates to the NotificationSubscriptionUpdate builder.
+func (_u *NotificationSubscriptionUpdate) Where(ps ...predicate.NotificationSubscription) *NotificationSubscriptionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetEvent sets the "event" field.
+func (_u *NotificationSubscriptionUpdate) SetEvent(v utils.SubscriptionEvent) *NotificationSubscriptionUpdate {
+	_u.mutation.SetEvent(v)
+	return _u
+}
+
+// SetNillableEvent sets the "event" field if the given value is not nil.
+func (_u *NotificationSubscriptionUpdate) SetNillableEvent(v *utils.SubscriptionEvent) *NotificationSubscriptionUpdate {
+	if v != nil {
+		_u.SetEvent(*v)
+	}
+	return _u
+}
+
+// SetProviderType sets the "provider_type" field.
+func (_u *NotificationSubscriptionUpdate) SetProviderType(v utils.NotificationProviderType) *NotificationSubscriptionUpdate {
+	_u.mutation.SetProviderType(v)
+	return _u
+}
+
+// SetNillableProviderType sets the "provider_type" field if the given value is not nil.
+func (_u *NotificationSubscriptionUpdate) SetNillableProviderType(v *utils.NotificationProviderType) *NotificationSubscriptionUpdate {
+	if v != nil {
+		_u.SetProviderType(*v)
+	}
+	return _u
+}
+
+// SetURL sets the "url" field.
+func (_u *NotificationSubscriptionUpdate) SetURL(v string) *NotificationSubscriptionUpdate {
+	_u.mutation.SetURL(v)
+	return _u
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (_u *NotificationSubscriptionUpdate) SetNillableURL(v *string) *NotificationSubscriptionUpdate {
+	if v != nil {
+		_u.SetURL(*v)
+	}
+	return _u
+}
+
+// ClearURL clears the value of the "url" field.
+func (_u *NotificationSubscriptionUpdate) ClearURL() *NotificationSubscriptionUpdate {
+	_u.mutation.ClearURL()
+	return _u
+}
+
+// SetToken sets the "token" field.
+func (_u *NotificationSubscriptionUpdate) SetToken(v string) *NotificationSubscriptionUpdate {
+	_u.mutation.SetToken(v)
+	return _u
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (_u *NotificationSubscriptionUpdate) SetNillableToken(v *string) *NotificationSubscriptionUpdate {
+	if v != nil {
+		_u.SetToken(*v)
+	}
+	return _u
+}
+
+// ClearToken clears the value of the "token" field.
+func (_u *NotificationSubscriptionUpdate) ClearToken() *NotificationSubscriptionUpdate {
+	_u.mutation.ClearToken()
+	return _u
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_u *NotificationSubscriptionUpdate) SetUserID(id uuid.UUID) *NotificationSubscriptionUpdate {
+	_u.mutation.SetUserID(id)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *NotificationSubscriptionUpdate) SetUser(v *User) *NotificationSubscriptionUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// SetChannelID sets the "channel" edge to the Channel entity by ID.
+func (_u *NotificationSubscriptionUpdate) SetChannelID(id uuid.UUID) *NotificationSubscriptionUpdate {
+	_u.mutation.SetChannelID(id)
+	return _u
+}
+
+// SetNillableChannelID sets the "channel" edge to the Channel entity by ID if the given value is not nil.
+func (_u *NotificationSubscriptionUpdate) SetNillableChannelID(id *uuid.UUID) *NotificationSubscriptionUpdate {
+	if id != nil {
+		_u = _u.SetChannelID(*id)
+	}
+	return _u
+}
+
+// SetChannel sets the "channel" edge to the Channel entity.
+func (_u *NotificationSubscriptionUpdate) SetChannel(v *Channel) *NotificationSubscriptionUpdate {
+	return _u.SetChannelID(v.ID)
+}
+
+// SetPlaylistID sets the "playlist" edge to the Playlist entity by ID.
+func (_u *NotificationSubscriptionUpdate) SetPlaylistID(id uuid.UUID) *NotificationSubscriptionUpdate {
+	_u.mutation.SetPlaylistID(id)
+	return _u
+}
+
+// SetNillablePlaylistID sets the "playlist" edge to the Playlist entity by ID if the given value is not nil.
+func (_u *NotificationSubscriptionUpdate) SetNillablePlaylistID(id *uuid.UUID) *NotificationSubscriptionUpdate {
+	if id != nil {
+		_u = _u.SetPlaylistID(*id)
+	}
+	return _u
+}
+
+// SetPlaylist sets the "playlist" edge to the Playlist entity.
+func (_u *NotificationSubscriptionUpdate) SetPlaylist(v *Playlist) *NotificationSubscriptionUpdate {
+	return _u.SetPlaylistID(v.ID)
+}
+
+// Mutation returns the NotificationSubscriptionMutation object of the builder.
+func (_u *NotificationSubscriptionUpdate) Mutation() *NotificationSubscriptionMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *NotificationSubscriptionUpdate) ClearUser() *NotificationSubscriptionUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// ClearChannel clears the "channel" edge to the Channel entity.
+func (_u *NotificationSubscriptionUpdate) ClearChannel() *NotificationSubscriptionUpdate {
+	_u.mutation.ClearChannel()
+	return _u
+}
+
+// ClearPlaylist clears the "playlist" edge to the Playlist entity.
+func (_u *NotificationSubscriptionUpdate) ClearPlaylist() *NotificationSubscriptionUpdate {
+	_u.mutation.ClearPlaylist()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *NotificationSubscriptionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *NotificationSubscriptionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *NotificationSubscriptionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *NotificationSubscriptionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *NotificationSubscriptionUpdate) check() error {
+	if v, ok := _u.mutation.Event(); ok {
+		if err := notificationsubscription.EventValidator(v); err != nil {
+			return &ValidationError{Name: "event", err: fmt.Errorf(`ent: validator failed for field "NotificationSubscription.event": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ProviderType(); ok {
+		if err := notificationsubscription.ProviderTypeValidator(v); err != nil {
+			return &ValidationError{Name: "provider_type", err: fmt.Errorf(`ent: validator failed for field "NotificationSubscription.provider_type": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "NotificationSubscription.user"`)
+	}
+	return nil
+}
+
+func (_u *NotificationSubscriptionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(notificationsubscription.Table, notificationsubscription.Columns, sqlgraph.NewFieldSpec(notificationsubscription.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Event(); ok {
+		_spec.SetField(notificationsubscription.FieldEvent, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ProviderType(); ok {
+		_spec.SetField(notificationsubscription.FieldProviderType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.URL(); ok {
+		_spec.SetField(notificationsubscription.FieldURL, field.TypeString, value)
+	}
+	if _u.mutation.URLCleared() {
+		_spec.ClearField(notificationsubscription.FieldURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.Token(); ok {
+		_spec.SetField(notificationsubscription.FieldToken, field.TypeString, value)
+	}
+	if _u.mutation.TokenCleared() {
+		_spec.ClearField(notificationsubscription.FieldToken, field.TypeString)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notificationsubscription.UserTable,
+			Columns: []string{notificationsubscription.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notificationsubscription.UserTable,
+			Columns: []string{notificationsubscription.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ChannelCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notificationsubscription.ChannelTable,
+			Columns: []string{notificationsubscription.ChannelColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(channel.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ChannelIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notificationsubscription.ChannelTable,
+			Columns: []string{notificationsubscription.ChannelColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(channel.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PlaylistCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notificationsubscription.PlaylistTable,
+			Columns: []string{notificationsubscription.PlaylistColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlist.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PlaylistIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notificationsubscription.PlaylistTable,
+			Columns: []string{notificationsubscription.PlaylistColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlist.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notificationsubscription.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// NotificationSubscriptionUpdateOne is the builder for updating a single NotificationSubscription entity.
+type NotificationSubscriptionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *NotificationSubscriptionMutation
+}
+
+// SetEvent sets the "event" field.
+func (_u *NotificationSubscriptionUpdateOne) SetEvent(v utils.SubscriptionEvent) *NotificationSubscriptionUpdateOne {
+	_u.mutation.SetEvent(v)
+	return _u
+}
+
+// SetNillableEvent sets the "event" field if the given value is not nil.
+func (_u *NotificationSubscriptionUpdateOne) SetNillableEvent(v *utils.SubscriptionEvent) *NotificationSubscriptionUpdateOne {
+	if v != nil {
+		_u.SetEvent(*v)
+	}
+	return _u
+}
+
+// SetProviderType sets the "provider_type" field.
+func (_u *NotificationSubscriptionUpdateOne) SetProviderType(v utils.NotificationProviderType) *NotificationSubscriptionUpdateOne {
+	_u.mutation.SetProviderType(v)
+	return _u
+}
+
+// SetNillableProviderType sets the "provider_type" field if the given value is not nil.
+func (_u *NotificationSubscriptionUpdateOne) SetNillableProviderType(v *utils.NotificationProviderType) *NotificationSubscriptionUpdateOne {
+	if v != nil {
+		_u.SetProviderType(*v)
+	}
+	return _u
+}
+
+// SetURL sets the "url" field.
+func (_u *NotificationSubscriptionUpdateOne) SetURL(v string) *NotificationSubscriptionUpdateOne {
+	_u.mutation.SetURL(v)
+	return _u
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (_u *NotificationSubscriptionUpdateOne) SetNillableURL(v *string) *NotificationSubscriptionUpdateOne {
+	if v != nil {
+		_u.SetURL(*v)
+	}
+	return _u
+}
+
+// ClearURL clears the value of the "url" field.
+func (_u *NotificationSubscriptionUpdateOne) ClearURL() *NotificationSubscriptionUpdateOne {
+	_u.mutation.ClearURL()
+	return _u
+}
+
+// SetToken sets the "token" field.
+func (_u *NotificationSubscriptionUpdateOne) SetToken(v string) *NotificationSubscriptionUpdateOne {
+	_u.mutation.SetToken(v)
+	return _u
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (_u *NotificationSubscriptionUpdateOne) SetNillableToken(v *string) *NotificationSubscriptionUpdateOne {
+	if v != nil {
+		_u.SetToken(*v)
+	}
+	return _u
+}
+
+// ClearToken clears the value of the "token" field.
+func (_u *NotificationSubscriptionUpdateOne) ClearToken() *NotificationSubscriptionUpdateOne {
+	_u.mutation.ClearToken()
+	return _u
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_u *NotificationSubscriptionUpdateOne) SetUserID(id uuid.UUID) *NotificationSubscriptionUpdateOne {
+	_u.mutation.SetUserID(id)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *NotificationSubscriptionUpdateOne) SetUser(v *User) *NotificationSubscriptionUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// SetChannelID sets the "channel" edge to the Channel entity by ID.
+func (_u *NotificationSubscriptionUpdateOne) SetChannelID(id uuid.UUID) *NotificationSubscriptionUpdateOne {
+	_u.mutation.SetChannelID(id)
+	return _u
+}
+
+// SetNillableChannelID sets the "channel" edge to the Channel entity by ID if the given value is not nil.
+func (_u *NotificationSubscriptionUpdateOne) SetNillableChannelID(id *uuid.UUID) *NotificationSubscriptionUpdateOne {
+	if id != nil {
+		_u = _u.SetChannelID(*id)
+	}
+	return _u
+}
+
+// SetChannel sets the "channel" edge to the Channel entity.
+func (_u *NotificationSubscriptionUpdateOne) SetChannel(v *Channel) *NotificationSubscriptionUpdateOne {
+	return _u.SetChannelID(v.ID)
+}
+
+// SetPlaylistID sets the "playlist" edge to the Playlist entity by ID.
+func (_u *NotificationSubscriptionUpdateOne) SetPlaylistID(id uuid.UUID) *NotificationSubscriptionUpdateOne {
+	_u.mutation.SetPlaylistID(id)
+	return _u
+}
+
+// SetNillablePlaylistID sets the "playlist" edge to the Playlist entity by ID if the given value is not nil.
+func (_u *NotificationSubscriptionUpdateOne) SetNillablePlaylistID(id *uuid.UUID) *NotificationSubscriptionUpdateOne {
+	if id != nil {
+		_u = _u.SetPlaylistID(*id)
+	}
+	return _u
+}
+
+// SetPlaylist sets the "playlist" edge to the Playlist entity.
+func (_u *NotificationSubscriptionUpdateOne) SetPlaylist(v *Playlist) *NotificationSubscriptionUpdateOne {
+	return _u.SetPlaylistID(v.ID)
+}
+
+// Mutation returns the NotificationSubscriptionMutation object of the builder.
+func (_u *NotificationSubscriptionUpdateOne) Mutation() *NotificationSubscriptionMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *NotificationSubscriptionUpdateOne) ClearUser() *NotificationSubscriptionUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// ClearChannel clears the "channel" edge to the Channel entity.
+func (_u *NotificationSubscriptionUpdateOne) ClearChannel() *NotificationSubscriptionUpdateOne {
+	_u.mutation.ClearChannel()
+	return _u
+}
+
+// ClearPlaylist clears the "playlist" edge to the Playlist entity.
+func (_u *NotificationSubscriptionUpdateOne) ClearPlaylist() *NotificationSubscriptionUpdateOne {
+	_u.mutation.ClearPlaylist()
+	return _u
+}
+
+// Where appends a list predicates to the NotificationSubscriptionUpdate builder.
+func (_u *NotificationSubscriptionUpdateOne) Where(ps ...predicate.NotificationSubscription) *NotificationSubscriptionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *NotificationSubscriptionUpdateOne) Select(field string, fields ...string) *NotificationSubscriptionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated NotificationSubscription entity.
+func (_u *NotificationSubscriptionUpdateOne) Save(ctx context.Context) (*NotificationSubscription, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *NotificationSubscriptionUpdateOne) SaveX(ctx context.Context) *NotificationSubscription {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *NotificationSubscriptionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *NotificationSubscriptionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *NotificationSubscriptionUpdateOne) check() error {
+	if v, ok := _u.mutation.Event(); ok {
+		if err := notificationsubscription.EventValidator(v); err != nil {
+			return &ValidationError{Name: "event", err: fmt.Errorf(`ent: validator failed for field "NotificationSubscription.event": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ProviderType(); ok {
+		if err := notificationsubscription.ProviderTypeValidator(v); err != nil {
+			return &ValidationError{Name: "provider_type", err: fmt.Errorf(`ent: validator failed for field "NotificationSubscription.provider_type": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "NotificationSubscription.user"`)
+	}
+	return nil
+}
+
+func (_u *NotificationSubscriptionUpdateOne) sqlSave(ctx context.Context) (_node *NotificationSubscription, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(notificationsubscription.Table, notificationsubscription.Columns, sqlgraph.NewFieldSpec(notificationsubscription.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "NotificationSubscription.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, notificationsubscription.FieldID)
+		for _, f := range fields {
+			if !notificationsubscription.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != notificationsubscription.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Event(); ok {
+		_spec.SetField(notificationsubscription.FieldEvent, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ProviderType(); ok {
+		_spec.SetField(notificationsubscription.FieldProviderType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.URL(); ok {
+		_spec.SetField(notificationsubscription.FieldURL, field.TypeString, value)
+	}
+	if _u.mutation.URLCleared() {
+		_spec.ClearField(notificationsubscription.FieldURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.Token(); ok {
+		_spec.SetField(notificationsubscription.FieldToken, field.TypeString, value)
+	}
+	if _u.mutation.TokenCleared() {
+		_spec.ClearField(notificationsubscription.FieldToken, field.TypeString)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notificationsubscription.UserTable,
+			Columns: []string{notificationsubscription.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notificationsubscription.UserTable,
+			Columns: []string{notificationsubscription.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ChannelCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notificationsubscription.ChannelTable,
+			Columns: []string{notificationsubscription.ChannelColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(channel.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ChannelIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notificationsubscription.ChannelTable,
+			Columns: []string{notificationsubscription.ChannelColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(channel.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PlaylistCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notificationsubscription.PlaylistTable,
+			Columns: []string{notificationsubscription.PlaylistColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlist.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PlaylistIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notificationsubscription.PlaylistTable,
+			Columns: []string{notificationsubscription.PlaylistColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlist.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &NotificationSubscription{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notificationsubscription.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -11,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 	"github.com/zibbp/ganymede/ent/playlist"
+	"github.com/zibbp/ganymede/internal/utils"
 )
 
 // Playlist is the model entity for the Playlist schema.
@@ -24,6 +26,12 @@ type Playlist struct {
 	Description string `json:"description,omitempty"`
 	// ThumbnailPath holds the value of the "thumbnail_path" field.
 	ThumbnailPath string `json:"thumbnail_path,omitempty"`
+	// Visibility holds the value of the "visibility" field.
+	Visibility utils.Visibility `json:"visibility,omitempty"`
+	// User groups that can view the playlist if the visibility is restricted.
+	AllowedGroups []string `json:"allowed_groups,omitempty"`
+	// Order vods by their position in the playlist instead of the date they were streamed.
+	ManualOrder bool `json:"manual_order,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
@@ -38,13 +46,23 @@ type Playlist struct {
 type PlaylistEdges struct {
 	// Vods holds the value of the vods edge.
 	Vods []*Vod `json:"vods,omitempty"`
+	// Sections holds the value of the sections edge.
+	Sections []*PlaylistSection `json:"sections,omitempty"`
 	// MultistreamInfo holds the value of the multistream_info edge.
 	MultistreamInfo []*MultistreamInfo `json:"multistream_info,omitempty"`
 	// RuleGroups holds the value of the rule_groups edge.
 	RuleGroups []*PlaylistRuleGroup `json:"rule_groups,omitempty"`
+	// NotificationSubscriptions holds the value of the notification_subscriptions edge.
+	NotificationSubscriptions []*NotificationSubscription `json:"notification_subscriptions,omitempty"`
+	// Users that can view the playlist if the visibility is restricted.
+	AllowedUsers []*User `json:"allowed_users,omitempty"`
+	// ShareLinks holds the value of the share_links edge.
+	ShareLinks []*ShareLink `json:"share_links,omitempty"`
+	// Entries holds the value of the entries edge.
+	Entries []*PlaylistVod `json:"entries,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [8]bool
 }
 
 // VodsOrErr returns the Vods value or an error if the edge
@@ -56,10 +74,19 @@ func (e PlaylistEdges) VodsOrErr() ([]*Vod, error) {
 	return nil, &NotLoadedError{edge: "vods"}
 }
 
+// SectionsOrErr returns the Sections value or an error if the edge
+// was not loaded in eager-loading.
+func (e PlaylistEdges) SectionsOrErr() ([]*PlaylistSection, error) {
+	if e.loadedTypes[1] {
+		return e.Sections, nil
+	}
+	return nil, &NotLoadedError{edge: "sections"}
+}
+
 // MultistreamInfoOrErr returns the MultistreamInfo value or an error if the edge
 // was not loaded in eager-loading.
 func (e PlaylistEdges) MultistreamInfoOrErr() ([]*MultistreamInfo, error) {
-	if e.loadedTypes[1] {
+	if e.loadedTypes[2] {
 		return e.MultistreamInfo, nil
 	}
 	return nil, &NotLoadedError{edge: "multistream_info"}
@@ -68,18 +95,58 @@ func (e PlaylistEdges) MultistreamInfoOrErr() ([]*MultistreamInfo, error) {
 // RuleGroupsOrErr returns the RuleGroups value or an error if the edge
 // was not loaded in eager-loading.
 func (e PlaylistEdges) RuleGroupsOrErr() ([]*PlaylistRuleGroup, error) {
-	if e.loadedTypes[2] {
+	if e.loadedTypes[3] {
 		return e.RuleGroups, nil
 	}
 	return nil, &NotLoadedError{edge: "rule_groups"}
 }
 
+// NotificationSubscriptionsOrErr returns the NotificationSubscriptions value or an error if the edge
+// was not loaded in eager-loading.
+func (e PlaylistEdges) NotificationSubscriptionsOrErr() ([]*NotificationSubscription, error) {
+	if e.loadedTypes[4] {
+		return e.NotificationSubscriptions, nil
+	}
+	return nil, &NotLoadedError{edge: "notification_subscriptions"}
+}
+
+// AllowedUsersOrErr returns the AllowedUsers value or an error if the edge
+// was not loaded in eager-loading.
+func (e PlaylistEdges) AllowedUsersOrErr() ([]*User, error) {
+	if e.loadedTypes[5] {
+		return e.AllowedUsers, nil
+	}
+	return nil, &NotLoadedError{edge: "allowed_users"}
+}
+
+// ShareLinksOrErr returns the ShareLinks value or an error if the edge
+// was not loaded in eager-loading.
+func (e PlaylistEdges) ShareLinksOrErr() ([]*ShareLink, error) {
+	if e.loadedTypes[6] {
+		return e.ShareLinks, nil
+	}
+	return nil, &NotLoadedError{edge: "share_links"}
+}
+
+// EntriesOrErr returns the Entries value or an error if the edge
+// was not loaded in eager-loading.
+func (e PlaylistEdges) EntriesOrErr() ([]*PlaylistVod, error) {
+	if e.loadedTypes[7] {
+		return e.Entries, nil
+	}
+	return nil, &NotLoadedError{edge: "entries"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Playlist) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case playlist.FieldName, playlist.FieldDescription, playlist.FieldThumbnailPath:
+		case playlist.FieldAllowedGroups:
+			values[i] = new([]byte)
+		case playlist.FieldManualOrder:
+			values[i] = new(sql.NullBool)
+		case playlist.FieldName, playlist.FieldDescription, playlist.FieldThumbnailPath, playlist.FieldVisibility:
 			values[i] = new(sql.NullString)
 		case playlist.FieldUpdatedAt, playlist.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
@@ -124,6 +191,26 @@ func (_m *Playlist) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.ThumbnailPath = value.String
 			}
+		case playlist.FieldVisibility:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field visibility", values[i])
+			} else if value.Valid {
+				_m.Visibility = utils.Visibility(value.String)
+			}
+		case playlist.FieldAllowedGroups:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field allowed_groups", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.AllowedGroups); err != nil {
+					return fmt.Errorf("unmarshal field allowed_groups: %w", err)
+				}
+			}
+		case playlist.FieldManualOrder:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field manual_order", values[i])
+			} else if value.Valid {
+				_m.ManualOrder = value.Bool
+			}
 		case playlist.FieldUpdatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
@@ -154,6 +241,11 @@ func (_m *Playlist) QueryVods() *VodQuery {
 	return NewPlaylistClient(_m.config).QueryVods(_m)
 }
 
+// QuerySections queries the "sections" edge of the Playlist entity.
+func (_m *Playlist) QuerySections() *PlaylistSectionQuery {
+	return NewPlaylistClient(_m.config).QuerySections(_m)
+}
+
 // QueryMultistreamInfo queries the "multistream_info" edge of the Playlist entity.
 func (_m *Playlist) QueryMultistreamInfo() *MultistreamInfoQuery {
 	return NewPlaylistClient(_m.config).QueryMultistreamInfo(_m)
@@ -164,6 +256,26 @@ func (_m *Playlist) QueryRuleGroups() *PlaylistRuleGroupQuery {
 	return NewPlaylistClient(_m.config).QueryRuleGroups(_m)
 }
 
+// QueryNotificationSubscriptions queries the "notification_subscriptions" edge of the Playlist entity.
+func (_m *Playlist) QueryNotificationSubscriptions() *NotificationSubscriptionQuery {
+	return NewPlaylistClient(_m.config).QueryNotificationSubscriptions(_m)
+}
+
+// QueryAllowedUsers queries the "allowed_users" edge of the Playlist entity.
+func (_m *Playlist) QueryAllowedUsers() *UserQuery {
+	return NewPlaylistClient(_m.config).QueryAllowedUsers(_m)
+}
+
+// QueryShareLinks queries the "share_links" edge of the Playlist entity.
+func (_m *Playlist) QueryShareLinks() *ShareLinkQuery {
+	return NewPlaylistClient(_m.config).QueryShareLinks(_m)
+}
+
+// QueryEntries queries the "entries" edge of the Playlist entity.
+func (_m *Playlist) QueryEntries() *PlaylistVodQuery {
+	return NewPlaylistClient(_m.config).QueryEntries(_m)
+}
+
 // Update returns a builder for updating this Playlist.
 // Note that you need to call Playlist.Unwrap() before calling this method if this Playlist
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -196,6 +308,15 @@ func (_m *Playlist) String() string {
 	builder.WriteString("thumbnail_path=")
 	builder.WriteString(_m.ThumbnailPath)
 	builder.WriteString(", ")
+	builder.WriteString("visibility=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Visibility))
+	builder.WriteString(", ")
+	builder.WriteString("allowed_groups=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AllowedGroups))
+	builder.WriteString(", ")
+	builder.WriteString("manual_order=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ManualOrder))
+	builder.WriteString(", ")
 	builder.WriteString("updated_at=")
 	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -3,11 +3,13 @@
 package playlist
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/internal/utils"
 )
 
 const (
@@ -21,16 +23,32 @@ const (
 	FieldDescription = "description"
 	// FieldThumbnailPath holds the string denoting the thumbnail_path field in the database.
 	FieldThumbnailPath = "thumbnail_path"
+	// FieldVisibility holds the string denoting the visibility field in the database.
+	FieldVisibility = "visibility"
+	// FieldAllowedGroups holds the string denoting the allowed_groups field in the database.
+	FieldAllowedGroups = "allowed_groups"
+	// FieldManualOrder holds the string denoting the manual_order field in the database.
+	FieldManualOrder = "manual_order"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// EdgeVods holds the string denoting the vods edge name in mutations.
 	EdgeVods = "vods"
+	// EdgeSections holds the string denoting the sections edge name in mutations.
+	EdgeSections = "sections"
 	// EdgeMultistreamInfo holds the string denoting the multistream_info edge name in mutations.
 	EdgeMultistreamInfo = "multistream_info"
 	// EdgeRuleGroups holds the string denoting the rule_groups edge name in mutations.
 	EdgeRuleGroups = "rule_groups"
+	// EdgeNotificationSubscriptions holds the string denoting the notification_subscriptions edge name in mutations.
+	EdgeNotificationSubscriptions = "notification_subscriptions"
+	// EdgeAllowedUsers holds the string denoting the allowed_users edge name in mutations.
+	EdgeAllowedUsers = "allowed_users"
+	// EdgeShareLinks holds the string denoting the share_links edge name in mutations.
+	EdgeShareLinks = "share_links"
+	// EdgeEntries holds the string denoting the entries edge name in mutations.
+	EdgeEntries = "entries"
 	// Table holds the table name of the playlist in the database.
 	Table = "playlists"
 	// VodsTable is the table that holds the vods relation/edge. The primary key declared below.
@@ -38,6 +56,13 @@ const (
 	// VodsInverseTable is the table name for the Vod entity.
 	// It exists in this package in order to avoid circular dependency with the "vod" package.
 	VodsInverseTable = "vods"
+	// SectionsTable is the table that holds the sections relation/edge.
+	SectionsTable = "playlist_sections"
+	// SectionsInverseTable is the table name for the PlaylistSection entity.
+	// It exists in this package in order to avoid circular dependency with the "playlistsection" package.
+	SectionsInverseTable = "playlist_sections"
+	// SectionsColumn is the table column denoting the sections relation/edge.
+	SectionsColumn = "playlist_sections"
 	// MultistreamInfoTable is the table that holds the multistream_info relation/edge.
 	MultistreamInfoTable = "multistream_infos"
 	// MultistreamInfoInverseTable is the table name for the MultistreamInfo entity.
@@ -52,6 +77,32 @@ const (
 	RuleGroupsInverseTable = "playlist_rule_groups"
 	// RuleGroupsColumn is the table column denoting the rule_groups relation/edge.
 	RuleGroupsColumn = "playlist_rule_groups"
+	// NotificationSubscriptionsTable is the table that holds the notification_subscriptions relation/edge.
+	NotificationSubscriptionsTable = "notification_subscriptions"
+	// NotificationSubscriptionsInverseTable is the table name for the NotificationSubscription entity.
+	// It exists in this package in order to avoid circular dependency with the "notificationsubscription" package.
+	NotificationSubscriptionsInverseTable = "notification_subscriptions"
+	// NotificationSubscriptionsColumn is the table column denoting the notification_subscriptions relation/edge.
+	NotificationSubscriptionsColumn = "playlist_notification_subscriptions"
+	// AllowedUsersTable is the table that holds the allowed_users relation/edge. The primary key declared below.
+	AllowedUsersTable = "playlist_allowed_users"
+	// AllowedUsersInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	AllowedUsersInverseTable = "users"
+	// ShareLinksTable is the table that holds the share_links relation/edge.
+	ShareLinksTable = "share_links"
+	// ShareLinksInverseTable is the table name for the ShareLink entity.
+	// It exists in this package in order to avoid circular dependency with the "sharelink" package.
+	ShareLinksInverseTable = "share_links"
+	// ShareLinksColumn is the table column denoting the share_links relation/edge.
+	ShareLinksColumn = "playlist_share_links"
+	// EntriesTable is the table that holds the entries relation/edge.
+	EntriesTable = "playlist_vods"
+	// EntriesInverseTable is the table name for the PlaylistVod entity.
+	// It exists in this package in order to avoid circular dependency with the "playlistvod" package.
+	EntriesInverseTable = "playlist_vods"
+	// EntriesColumn is the table column denoting the entries relation/edge.
+	EntriesColumn = "playlist_id"
 )
 
 // Columns holds all SQL columns for playlist fields.
@@ -60,6 +111,9 @@ var Columns = []string{
 	FieldName,
 	FieldDescription,
 	FieldThumbnailPath,
+	FieldVisibility,
+	FieldAllowedGroups,
+	FieldManualOrder,
 	FieldUpdatedAt,
 	FieldCreatedAt,
 }
@@ -68,6 +122,9 @@ var (
 	// VodsPrimaryKey and VodsColumn2 are the table columns denoting the
 	// primary key for the vods relation (M2M).
 	VodsPrimaryKey = []string{"playlist_id", "vod_id"}
+	// AllowedUsersPrimaryKey and AllowedUsersColumn2 are the table columns denoting the
+	// primary key for the allowed_users relation (M2M).
+	AllowedUsersPrimaryKey = []string{"playlist_id", "user_id"}
 )
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -81,6 +138,8 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultManualOrder holds the default value on creation for the "manual_order" field.
+	DefaultManualOrder bool
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
 	DefaultUpdatedAt func() time.Time
 	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
@@ -91,6 +150,18 @@ var (
 	DefaultID func() uuid.UUID
 )
 
+const DefaultVisibility utils.Visibility = "public"
+
+// VisibilityValidator is a validator for the "visibility" field enum values. It is called by the builders before save.
+func VisibilityValidator(v utils.Visibility) error {
+	switch v {
+	case "public", "authenticated", "restricted":
+		return nil
+	default:
+		return fmt.Errorf("playlist: invalid enum value for visibility field: %q", v)
+	}
+}
+
 // OrderOption defines the ordering options for the Playlist queries.
 type OrderOption func(*sql.Selector)
 
@@ -114,6 +185,16 @@ func ByThumbnailPath(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldThumbnailPath, opts...).ToFunc()
 }
 
+// ByVisibility orders the results by the visibility field.
+func ByVisibility(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVisibility, opts...).ToFunc()
+}
+
+// ByManualOrder orders the results by the manual_order field.
+func ByManualOrder(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldManualOrder, opts...).ToFunc()
+}
+
 // ByUpdatedAt orders the results by the updated_at field.
 func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
@@ -138,6 +219,20 @@ func ByVods(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	}
 }
 
+// BySectionsCount orders the results by sections count.
+func BySectionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSectionsStep(), opts...)
+	}
+}
+
+// BySections orders the results by sections terms.
+func BySections(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSectionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByMultistreamInfoCount orders the results by multistream_info count.
 func ByMultistreamInfoCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -165,6 +260,62 @@ func ByRuleGroups(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newRuleGroupsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByNotificationSubscriptionsCount orders the results by notification_subscriptions count.
+func ByNotificationSubscriptionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newNotificationSubscriptionsStep(), opts...)
+	}
+}
+
+// ByNotificationSubscriptions orders the results by notification_subscriptions terms.
+func ByNotificationSubscriptions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newNotificationSubscriptionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAllowedUsersCount orders the results by allowed_users count.
+func ByAllowedUsersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAllowedUsersStep(), opts...)
+	}
+}
+
+// ByAllowedUsers orders the results by allowed_users terms.
+func ByAllowedUsers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAllowedUsersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByShareLinksCount orders the results by share_links count.
+func ByShareLinksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newShareLinksStep(), opts...)
+	}
+}
+
+// ByShareLinks orders the results by share_links terms.
+func ByShareLinks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newShareLinksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByEntriesCount orders the results by entries count.
+func ByEntriesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newEntriesStep(), opts...)
+	}
+}
+
+// ByEntries orders the results by entries terms.
+func ByEntries(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEntriesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newVodsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -172,6 +323,13 @@ func newVodsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2M, false, VodsTable, VodsPrimaryKey...),
 	)
 }
+func newSectionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SectionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SectionsTable, SectionsColumn),
+	)
+}
 func newMultistreamInfoStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -186,3 +344,31 @@ func newRuleGroupsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, RuleGroupsTable, RuleGroupsColumn),
 	)
 }
+func newNotificationSubscriptionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(NotificationSubscriptionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, NotificationSubscriptionsTable, NotificationSubscriptionsColumn),
+	)
+}
+func newAllowedUsersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AllowedUsersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, AllowedUsersTable, AllowedUsersPrimaryKey...),
+	)
+}
+func newShareLinksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ShareLinksInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ShareLinksTable, ShareLinksColumn),
+	)
+}
+func newEntriesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EntriesInverseTable, EntriesColumn),
+		sqlgraph.Edge(sqlgraph.O2M, true, EntriesTable, EntriesColumn),
+	)
+}
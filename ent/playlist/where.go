@@ -9,6 +9,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/google/uuid"
 	"github.com/zibbp/ganymede/ent/predicate"
+	"github.com/zibbp/ganymede/internal/utils"
 )
 
 // ID filters vertices based on their ID field.
@@ -71,6 +72,11 @@ func ThumbnailPath(v string) predicate.Playlist {
 	return predicate.Playlist(sql.FieldEQ(FieldThumbnailPath, v))
 }
 
+// ManualOrder applies equality check predicate on the "manual_order" field. It's identical to ManualOrderEQ.
+func ManualOrder(v bool) predicate.Playlist {
+	return predicate.Playlist(sql.FieldEQ(FieldManualOrder, v))
+}
+
 // UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
 func UpdatedAt(v time.Time) predicate.Playlist {
 	return predicate.Playlist(sql.FieldEQ(FieldUpdatedAt, v))
@@ -296,6 +302,56 @@ func ThumbnailPathContainsFold(v string) predicate.Playlist {
 	return predicate.Playlist(sql.FieldContainsFold(FieldThumbnailPath, v))
 }
 
+// VisibilityEQ applies the EQ predicate on the "visibility" field.
+func VisibilityEQ(v utils.Visibility) predicate.Playlist {
+	vc := v
+	return predicate.Playlist(sql.FieldEQ(FieldVisibility, vc))
+}
+
+// VisibilityNEQ applies the NEQ predicate on the "visibility" field.
+func VisibilityNEQ(v utils.Visibility) predicate.Playlist {
+	vc := v
+	return predicate.Playlist(sql.FieldNEQ(FieldVisibility, vc))
+}
+
+// VisibilityIn applies the In predicate on the "visibility" field.
+func VisibilityIn(vs ...utils.Visibility) predicate.Playlist {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Playlist(sql.FieldIn(FieldVisibility, v...))
+}
+
+// VisibilityNotIn applies the NotIn predicate on the "visibility" field.
+func VisibilityNotIn(vs ...utils.Visibility) predicate.Playlist {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Playlist(sql.FieldNotIn(FieldVisibility, v...))
+}
+
+// AllowedGroupsIsNil applies the IsNil predicate on the "allowed_groups" field.
+func AllowedGroupsIsNil() predicate.Playlist {
+	return predicate.Playlist(sql.FieldIsNull(FieldAllowedGroups))
+}
+
+// AllowedGroupsNotNil applies the NotNil predicate on the "allowed_groups" field.
+func AllowedGroupsNotNil() predicate.Playlist {
+	return predicate.Playlist(sql.FieldNotNull(FieldAllowedGroups))
+}
+
+// ManualOrderEQ applies the EQ predicate on the "manual_order" field.
+func ManualOrderEQ(v bool) predicate.Playlist {
+	return predicate.Playlist(sql.FieldEQ(FieldManualOrder, v))
+}
+
+// ManualOrderNEQ applies the NEQ predicate on the "manual_order" field.
+func ManualOrderNEQ(v bool) predicate.Playlist {
+	return predicate.Playlist(sql.FieldNEQ(FieldManualOrder, v))
+}
+
 // UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
 func UpdatedAtEQ(v time.Time) predicate.Playlist {
 	return predicate.Playlist(sql.FieldEQ(FieldUpdatedAt, v))
@@ -399,6 +455,29 @@ func HasVodsWith(preds ...predicate.Vod) predicate.Playlist {
 	})
 }
 
+// HasSections applies the HasEdge predicate on the "sections" edge.
+func HasSections() predicate.Playlist {
+	return predicate.Playlist(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SectionsTable, SectionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSectionsWith applies the HasEdge predicate on the "sections" edge with a given conditions (other predicates).
+func HasSectionsWith(preds ...predicate.PlaylistSection) predicate.Playlist {
+	return predicate.Playlist(func(s *sql.Selector) {
+		step := newSectionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasMultistreamInfo applies the HasEdge predicate on the "multistream_info" edge.
 func HasMultistreamInfo() predicate.Playlist {
 	return predicate.Playlist(func(s *sql.Selector) {
@@ -445,6 +524,98 @@ func HasRuleGroupsWith(preds ...predicate.PlaylistRuleGroup) predicate.Playlist
 	})
 }
 
+// HasNotificationSubscriptions applies the HasEdge predicate on the "notification_subscriptions" edge.
+func HasNotificationSubscriptions() predicate.Playlist {
+	return predicate.Playlist(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, NotificationSubscriptionsTable, NotificationSubscriptionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasNotificationSubscriptionsWith applies the HasEdge predicate on the "notification_subscriptions" edge with a given conditions (other predicates).
+func HasNotificationSubscriptionsWith(preds ...predicate.NotificationSubscription) predicate.Playlist {
+	return predicate.Playlist(func(s *sql.Selector) {
+		step := newNotificationSubscriptionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAllowedUsers applies the HasEdge predicate on the "allowed_users" edge.
+func HasAllowedUsers() predicate.Playlist {
+	return predicate.Playlist(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, AllowedUsersTable, AllowedUsersPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAllowedUsersWith applies the HasEdge predicate on the "allowed_users" edge with a given conditions (other predicates).
+func HasAllowedUsersWith(preds ...predicate.User) predicate.Playlist {
+	return predicate.Playlist(func(s *sql.Selector) {
+		step := newAllowedUsersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasShareLinks applies the HasEdge predicate on the "share_links" edge.
+func HasShareLinks() predicate.Playlist {
+	return predicate.Playlist(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ShareLinksTable, ShareLinksColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasShareLinksWith applies the HasEdge predicate on the "share_links" edge with a given conditions (other predicates).
+func HasShareLinksWith(preds ...predicate.ShareLink) predicate.Playlist {
+	return predicate.Playlist(func(s *sql.Selector) {
+		step := newShareLinksStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasEntries applies the HasEdge predicate on the "entries" edge.
+func HasEntries() predicate.Playlist {
+	return predicate.Playlist(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, EntriesTable, EntriesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEntriesWith applies the HasEdge predicate on the "entries" edge with a given conditions (other predicates).
+func HasEntriesWith(preds ...predicate.PlaylistVod) predicate.Playlist {
+	return predicate.Playlist(func(s *sql.Selector) {
+		step := newEntriesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Playlist) predicate.Playlist {
 	return predicate.Playlist(sql.AndPredicates(predicates...))
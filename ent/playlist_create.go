@@ -12,9 +12,14 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/zibbp/ganymede/ent/multistreaminfo"
+	"github.com/zibbp/ganymede/ent/notificationsubscription"
 	"github.com/zibbp/ganymede/ent/playlist"
 	"github.com/zibbp/ganymede/ent/playlistrulegroup"
+	"github.com/zibbp/ganymede/ent/playlistsection"
+	"github.com/zibbp/ganymede/ent/sharelink"
+	"github.com/zibbp/ganymede/ent/user"
 	"github.com/zibbp/ganymede/ent/vod"
+	"github.com/zibbp/ganymede/internal/utils"
 )
 
 // PlaylistCreate is the builder for creating a Playlist entity.
@@ -58,6 +63,40 @@ func (_c *PlaylistCreate) SetNillableThumbnailPath(v *string) *PlaylistCreate {
 	return _c
 }
 
+// SetVisibility sets the "visibility" field.
+func (_c *PlaylistCreate) SetVisibility(v utils.Visibility) *PlaylistCreate {
+	_c.mutation.SetVisibility(v)
+	return _c
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (_c *PlaylistCreate) SetNillableVisibility(v *utils.Visibility) *PlaylistCreate {
+	if v != nil {
+		_c.SetVisibility(*v)
+	}
+	return _c
+}
+
+// SetAllowedGroups sets the "allowed_groups" field.
+func (_c *PlaylistCreate) SetAllowedGroups(v []string) *PlaylistCreate {
+	_c.mutation.SetAllowedGroups(v)
+	return _c
+}
+
+// SetManualOrder sets the "manual_order" field.
+func (_c *PlaylistCreate) SetManualOrder(v bool) *PlaylistCreate {
+	_c.mutation.SetManualOrder(v)
+	return _c
+}
+
+// SetNillableManualOrder sets the "manual_order" field if the given value is not nil.
+func (_c *PlaylistCreate) SetNillableManualOrder(v *bool) *PlaylistCreate {
+	if v != nil {
+		_c.SetManualOrder(*v)
+	}
+	return _c
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_c *PlaylistCreate) SetUpdatedAt(v time.Time) *PlaylistCreate {
 	_c.mutation.SetUpdatedAt(v)
@@ -115,6 +154,21 @@ func (_c *PlaylistCreate) AddVods(v ...*Vod) *PlaylistCreate {
 	return _c.AddVodIDs(ids...)
 }
 
+// AddSectionIDs adds the "sections" edge to the PlaylistSection entity by IDs.
+func (_c *PlaylistCreate) AddSectionIDs(ids ...uuid.UUID) *PlaylistCreate {
+	_c.mutation.AddSectionIDs(ids...)
+	return _c
+}
+
+// AddSections adds the "sections" edges to the PlaylistSection entity.
+func (_c *PlaylistCreate) AddSections(v ...*PlaylistSection) *PlaylistCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddSectionIDs(ids...)
+}
+
 // AddMultistreamInfoIDs adds the "multistream_info" edge to the MultistreamInfo entity by IDs.
 func (_c *PlaylistCreate) AddMultistreamInfoIDs(ids ...int) *PlaylistCreate {
 	_c.mutation.AddMultistreamInfoIDs(ids...)
@@ -145,6 +199,51 @@ func (_c *PlaylistCreate) AddRuleGroups(v ...*PlaylistRuleGroup) *PlaylistCreate
 	return _c.AddRuleGroupIDs(ids...)
 }
 
+// AddNotificationSubscriptionIDs adds the "notification_subscriptions" edge to the NotificationSubscription entity by IDs.
+func (_c *PlaylistCreate) AddNotificationSubscriptionIDs(ids ...uuid.UUID) *PlaylistCreate {
+	_c.mutation.AddNotificationSubscriptionIDs(ids...)
+	return _c
+}
+
+// AddNotificationSubscriptions adds the "notification_subscriptions" edges to the NotificationSubscription entity.
+func (_c *PlaylistCreate) AddNotificationSubscriptions(v ...*NotificationSubscription) *PlaylistCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddNotificationSubscriptionIDs(ids...)
+}
+
+// AddAllowedUserIDs adds the "allowed_users" edge to the User entity by IDs.
+func (_c *PlaylistCreate) AddAllowedUserIDs(ids ...uuid.UUID) *PlaylistCreate {
+	_c.mutation.AddAllowedUserIDs(ids...)
+	return _c
+}
+
+// AddAllowedUsers adds the "allowed_users" edges to the User entity.
+func (_c *PlaylistCreate) AddAllowedUsers(v ...*User) *PlaylistCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAllowedUserIDs(ids...)
+}
+
+// AddShareLinkIDs adds the "share_links" edge to the ShareLink entity by IDs.
+func (_c *PlaylistCreate) AddShareLinkIDs(ids ...uuid.UUID) *PlaylistCreate {
+	_c.mutation.AddShareLinkIDs(ids...)
+	return _c
+}
+
+// AddShareLinks adds the "share_links" edges to the ShareLink entity.
+func (_c *PlaylistCreate) AddShareLinks(v ...*ShareLink) *PlaylistCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddShareLinkIDs(ids...)
+}
+
 // Mutation returns the PlaylistMutation object of the builder.
 func (_c *PlaylistCreate) Mutation() *PlaylistMutation {
 	return _c.mutation
@@ -180,6 +279,14 @@ func (_c *PlaylistCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (_c *PlaylistCreate) defaults() {
+	if _, ok := _c.mutation.Visibility(); !ok {
+		v := playlist.DefaultVisibility
+		_c.mutation.SetVisibility(v)
+	}
+	if _, ok := _c.mutation.ManualOrder(); !ok {
+		v := playlist.DefaultManualOrder
+		_c.mutation.SetManualOrder(v)
+	}
 	if _, ok := _c.mutation.UpdatedAt(); !ok {
 		v := playlist.DefaultUpdatedAt()
 		_c.mutation.SetUpdatedAt(v)
@@ -199,6 +306,17 @@ func (_c *PlaylistCreate) check() error {
 	if _, ok := _c.mutation.Name(); !ok {
 		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Playlist.name"`)}
 	}
+	if _, ok := _c.mutation.Visibility(); !ok {
+		return &ValidationError{Name: "visibility", err: errors.New(`ent: missing required field "Playlist.visibility"`)}
+	}
+	if v, ok := _c.mutation.Visibility(); ok {
+		if err := playlist.VisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "Playlist.visibility": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ManualOrder(); !ok {
+		return &ValidationError{Name: "manual_order", err: errors.New(`ent: missing required field "Playlist.manual_order"`)}
+	}
 	if _, ok := _c.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Playlist.updated_at"`)}
 	}
@@ -252,6 +370,18 @@ func (_c *PlaylistCreate) createSpec() (*Playlist, *sqlgraph.CreateSpec) {
 		_spec.SetField(playlist.FieldThumbnailPath, field.TypeString, value)
 		_node.ThumbnailPath = value
 	}
+	if value, ok := _c.mutation.Visibility(); ok {
+		_spec.SetField(playlist.FieldVisibility, field.TypeEnum, value)
+		_node.Visibility = value
+	}
+	if value, ok := _c.mutation.AllowedGroups(); ok {
+		_spec.SetField(playlist.FieldAllowedGroups, field.TypeJSON, value)
+		_node.AllowedGroups = value
+	}
+	if value, ok := _c.mutation.ManualOrder(); ok {
+		_spec.SetField(playlist.FieldManualOrder, field.TypeBool, value)
+		_node.ManualOrder = value
+	}
 	if value, ok := _c.mutation.UpdatedAt(); ok {
 		_spec.SetField(playlist.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
@@ -274,6 +404,26 @@ func (_c *PlaylistCreate) createSpec() (*Playlist, *sqlgraph.CreateSpec) {
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		createE := &PlaylistVodCreate{config: _c.config, mutation: newPlaylistVodMutation(_c.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.SectionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   playlist.SectionsTable,
+			Columns: []string{playlist.SectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlistsection.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
 	if nodes := _c.mutation.MultistreamInfoIDs(); len(nodes) > 0 {
@@ -308,6 +458,54 @@ func (_c *PlaylistCreate) createSpec() (*Playlist, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.NotificationSubscriptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   playlist.NotificationSubscriptionsTable,
+			Columns: []string{playlist.NotificationSubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationsubscription.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AllowedUsersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   playlist.AllowedUsersTable,
+			Columns: playlist.AllowedUsersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ShareLinksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   playlist.ShareLinksTable,
+			Columns: []string{playlist.ShareLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sharelink.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
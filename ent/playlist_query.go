@@ -14,22 +14,32 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/zibbp/ganymede/ent/multistreaminfo"
+	"github.com/zibbp/ganymede/ent/notificationsubscription"
 	"github.com/zibbp/ganymede/ent/playlist"
 	"github.com/zibbp/ganymede/ent/playlistrulegroup"
+	"github.com/zibbp/ganymede/ent/playlistsection"
+	"github.com/zibbp/ganymede/ent/playlistvod"
 	"github.com/zibbp/ganymede/ent/predicate"
+	"github.com/zibbp/ganymede/ent/sharelink"
+	"github.com/zibbp/ganymede/ent/user"
 	"github.com/zibbp/ganymede/ent/vod"
 )
 
 // PlaylistQuery is the builder for querying Playlist entities.
 type PlaylistQuery struct {
 	config
-	ctx                 *QueryContext
-	order               []playlist.OrderOption
-	inters              []Interceptor
-	predicates          []predicate.Playlist
-	withVods            *VodQuery
-	withMultistreamInfo *MultistreamInfoQuery
-	withRuleGroups      *PlaylistRuleGroupQuery
+	ctx                           *QueryContext
+	order                         []playlist.OrderOption
+	inters                        []Interceptor
+	predicates                    []predicate.Playlist
+	withVods                      *VodQuery
+	withSections                  *PlaylistSectionQuery
+	withMultistreamInfo           *MultistreamInfoQuery
+	withRuleGroups                *PlaylistRuleGroupQuery
+	withNotificationSubscriptions *NotificationSubscriptionQuery
+	withAllowedUsers              *UserQuery
+	withShareLinks                *ShareLinkQuery
+	withEntries                   *PlaylistVodQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -88,6 +98,28 @@ func (_q *PlaylistQuery) QueryVods() *VodQuery {
 	return query
 }
 
+// QuerySections chains the current query on the "sections" edge.
+func (_q *PlaylistQuery) QuerySections() *PlaylistSectionQuery {
+	query := (&PlaylistSectionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(playlist.Table, playlist.FieldID, selector),
+			sqlgraph.To(playlistsection.Table, playlistsection.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, playlist.SectionsTable, playlist.SectionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryMultistreamInfo chains the current query on the "multistream_info" edge.
 func (_q *PlaylistQuery) QueryMultistreamInfo() *MultistreamInfoQuery {
 	query := (&MultistreamInfoClient{config: _q.config}).Query()
@@ -132,6 +164,94 @@ func (_q *PlaylistQuery) QueryRuleGroups() *PlaylistRuleGroupQuery {
 	return query
 }
 
+// QueryNotificationSubscriptions chains the current query on the "notification_subscriptions" edge.
+func (_q *PlaylistQuery) QueryNotificationSubscriptions() *NotificationSubscriptionQuery {
+	query := (&NotificationSubscriptionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(playlist.Table, playlist.FieldID, selector),
+			sqlgraph.To(notificationsubscription.Table, notificationsubscription.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, playlist.NotificationSubscriptionsTable, playlist.NotificationSubscriptionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAllowedUsers chains the current query on the "allowed_users" edge.
+func (_q *PlaylistQuery) QueryAllowedUsers() *UserQuery {
+	query := (&UserClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(playlist.Table, playlist.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, playlist.AllowedUsersTable, playlist.AllowedUsersPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryShareLinks chains the current query on the "share_links" edge.
+func (_q *PlaylistQuery) QueryShareLinks() *ShareLinkQuery {
+	query := (&ShareLinkClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(playlist.Table, playlist.FieldID, selector),
+			sqlgraph.To(sharelink.Table, sharelink.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, playlist.ShareLinksTable, playlist.ShareLinksColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryEntries chains the current query on the "entries" edge.
+func (_q *PlaylistQuery) QueryEntries() *PlaylistVodQuery {
+	query := (&PlaylistVodClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(playlist.Table, playlist.FieldID, selector),
+			sqlgraph.To(playlistvod.Table, playlistvod.PlaylistColumn),
+			sqlgraph.Edge(sqlgraph.O2M, true, playlist.EntriesTable, playlist.EntriesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Playlist entity from the query.
 // Returns a *NotFoundError when no Playlist was found.
 func (_q *PlaylistQuery) First(ctx context.Context) (*Playlist, error) {
@@ -319,14 +439,19 @@ func (_q *PlaylistQuery) Clone() *PlaylistQuery {
 		return nil
 	}
 	return &PlaylistQuery{
-		config:              _q.config,
-		ctx:                 _q.ctx.Clone(),
-		order:               append([]playlist.OrderOption{}, _q.order...),
-		inters:              append([]Interceptor{}, _q.inters...),
-		predicates:          append([]predicate.Playlist{}, _q.predicates...),
-		withVods:            _q.withVods.Clone(),
-		withMultistreamInfo: _q.withMultistreamInfo.Clone(),
-		withRuleGroups:      _q.withRuleGroups.Clone(),
+		config:                        _q.config,
+		ctx:                           _q.ctx.Clone(),
+		order:                         append([]playlist.OrderOption{}, _q.order...),
+		inters:                        append([]Interceptor{}, _q.inters...),
+		predicates:                    append([]predicate.Playlist{}, _q.predicates...),
+		withVods:                      _q.withVods.Clone(),
+		withSections:                  _q.withSections.Clone(),
+		withMultistreamInfo:           _q.withMultistreamInfo.Clone(),
+		withRuleGroups:                _q.withRuleGroups.Clone(),
+		withNotificationSubscriptions: _q.withNotificationSubscriptions.Clone(),
+		withAllowedUsers:              _q.withAllowedUsers.Clone(),
+		withShareLinks:                _q.withShareLinks.Clone(),
+		withEntries:                   _q.withEntries.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -344,6 +469,17 @@ func (_q *PlaylistQuery) WithVods(opts ...func(*VodQuery)) *PlaylistQuery {
 	return _q
 }
 
+// WithSections tells the query-builder to eager-load the nodes that are connected to
+// the "sections" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *PlaylistQuery) WithSections(opts ...func(*PlaylistSectionQuery)) *PlaylistQuery {
+	query := (&PlaylistSectionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withSections = query
+	return _q
+}
+
 // WithMultistreamInfo tells the query-builder to eager-load the nodes that are connected to
 // the "multistream_info" edge. The optional arguments are used to configure the query builder of the edge.
 func (_q *PlaylistQuery) WithMultistreamInfo(opts ...func(*MultistreamInfoQuery)) *PlaylistQuery {
@@ -366,6 +502,50 @@ func (_q *PlaylistQuery) WithRuleGroups(opts ...func(*PlaylistRuleGroupQuery)) *
 	return _q
 }
 
+// WithNotificationSubscriptions tells the query-builder to eager-load the nodes that are connected to
+// the "notification_subscriptions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *PlaylistQuery) WithNotificationSubscriptions(opts ...func(*NotificationSubscriptionQuery)) *PlaylistQuery {
+	query := (&NotificationSubscriptionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withNotificationSubscriptions = query
+	return _q
+}
+
+// WithAllowedUsers tells the query-builder to eager-load the nodes that are connected to
+// the "allowed_users" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *PlaylistQuery) WithAllowedUsers(opts ...func(*UserQuery)) *PlaylistQuery {
+	query := (&UserClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withAllowedUsers = query
+	return _q
+}
+
+// WithShareLinks tells the query-builder to eager-load the nodes that are connected to
+// the "share_links" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *PlaylistQuery) WithShareLinks(opts ...func(*ShareLinkQuery)) *PlaylistQuery {
+	query := (&ShareLinkClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withShareLinks = query
+	return _q
+}
+
+// WithEntries tells the query-builder to eager-load the nodes that are connected to
+// the "entries" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *PlaylistQuery) WithEntries(opts ...func(*PlaylistVodQuery)) *PlaylistQuery {
+	query := (&PlaylistVodClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withEntries = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -444,10 +624,15 @@ func (_q *PlaylistQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Pla
 	var (
 		nodes       = []*Playlist{}
 		_spec       = _q.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [8]bool{
 			_q.withVods != nil,
+			_q.withSections != nil,
 			_q.withMultistreamInfo != nil,
 			_q.withRuleGroups != nil,
+			_q.withNotificationSubscriptions != nil,
+			_q.withAllowedUsers != nil,
+			_q.withShareLinks != nil,
+			_q.withEntries != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -475,6 +660,13 @@ func (_q *PlaylistQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Pla
 			return nil, err
 		}
 	}
+	if query := _q.withSections; query != nil {
+		if err := _q.loadSections(ctx, query, nodes,
+			func(n *Playlist) { n.Edges.Sections = []*PlaylistSection{} },
+			func(n *Playlist, e *PlaylistSection) { n.Edges.Sections = append(n.Edges.Sections, e) }); err != nil {
+			return nil, err
+		}
+	}
 	if query := _q.withMultistreamInfo; query != nil {
 		if err := _q.loadMultistreamInfo(ctx, query, nodes,
 			func(n *Playlist) { n.Edges.MultistreamInfo = []*MultistreamInfo{} },
@@ -489,6 +681,36 @@ func (_q *PlaylistQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Pla
 			return nil, err
 		}
 	}
+	if query := _q.withNotificationSubscriptions; query != nil {
+		if err := _q.loadNotificationSubscriptions(ctx, query, nodes,
+			func(n *Playlist) { n.Edges.NotificationSubscriptions = []*NotificationSubscription{} },
+			func(n *Playlist, e *NotificationSubscription) {
+				n.Edges.NotificationSubscriptions = append(n.Edges.NotificationSubscriptions, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withAllowedUsers; query != nil {
+		if err := _q.loadAllowedUsers(ctx, query, nodes,
+			func(n *Playlist) { n.Edges.AllowedUsers = []*User{} },
+			func(n *Playlist, e *User) { n.Edges.AllowedUsers = append(n.Edges.AllowedUsers, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withShareLinks; query != nil {
+		if err := _q.loadShareLinks(ctx, query, nodes,
+			func(n *Playlist) { n.Edges.ShareLinks = []*ShareLink{} },
+			func(n *Playlist, e *ShareLink) { n.Edges.ShareLinks = append(n.Edges.ShareLinks, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withEntries; query != nil {
+		if err := _q.loadEntries(ctx, query, nodes,
+			func(n *Playlist) { n.Edges.Entries = []*PlaylistVod{} },
+			func(n *Playlist, e *PlaylistVod) { n.Edges.Entries = append(n.Edges.Entries, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -553,6 +775,37 @@ func (_q *PlaylistQuery) loadVods(ctx context.Context, query *VodQuery, nodes []
 	}
 	return nil
 }
+func (_q *PlaylistQuery) loadSections(ctx context.Context, query *PlaylistSectionQuery, nodes []*Playlist, init func(*Playlist), assign func(*Playlist, *PlaylistSection)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Playlist)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.PlaylistSection(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(playlist.SectionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.playlist_sections
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "playlist_sections" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "playlist_sections" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 func (_q *PlaylistQuery) loadMultistreamInfo(ctx context.Context, query *MultistreamInfoQuery, nodes []*Playlist, init func(*Playlist), assign func(*Playlist, *MultistreamInfo)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uuid.UUID]*Playlist)
@@ -615,6 +868,159 @@ func (_q *PlaylistQuery) loadRuleGroups(ctx context.Context, query *PlaylistRule
 	}
 	return nil
 }
+func (_q *PlaylistQuery) loadNotificationSubscriptions(ctx context.Context, query *NotificationSubscriptionQuery, nodes []*Playlist, init func(*Playlist), assign func(*Playlist, *NotificationSubscription)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Playlist)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.NotificationSubscription(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(playlist.NotificationSubscriptionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.playlist_notification_subscriptions
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "playlist_notification_subscriptions" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "playlist_notification_subscriptions" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *PlaylistQuery) loadAllowedUsers(ctx context.Context, query *UserQuery, nodes []*Playlist, init func(*Playlist), assign func(*Playlist, *User)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[uuid.UUID]*Playlist)
+	nids := make(map[uuid.UUID]map[*Playlist]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(playlist.AllowedUsersTable)
+		s.Join(joinT).On(s.C(user.FieldID), joinT.C(playlist.AllowedUsersPrimaryKey[1]))
+		s.Where(sql.InValues(joinT.C(playlist.AllowedUsersPrimaryKey[0]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(playlist.AllowedUsersPrimaryKey[0]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(uuid.UUID)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := *values[0].(*uuid.UUID)
+				inValue := *values[1].(*uuid.UUID)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Playlist]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*User](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "allowed_users" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (_q *PlaylistQuery) loadShareLinks(ctx context.Context, query *ShareLinkQuery, nodes []*Playlist, init func(*Playlist), assign func(*Playlist, *ShareLink)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Playlist)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.ShareLink(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(playlist.ShareLinksColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.playlist_share_links
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "playlist_share_links" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "playlist_share_links" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *PlaylistQuery) loadEntries(ctx context.Context, query *PlaylistVodQuery, nodes []*Playlist, init func(*Playlist), assign func(*Playlist, *PlaylistVod)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Playlist)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(playlistvod.FieldPlaylistID)
+	}
+	query.Where(predicate.PlaylistVod(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(playlist.EntriesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.PlaylistID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "playlist_id" returned %v for node %v`, fk, n)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *PlaylistQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -10,13 +10,19 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/zibbp/ganymede/ent/multistreaminfo"
+	"github.com/zibbp/ganymede/ent/notificationsubscription"
 	"github.com/zibbp/ganymede/ent/playlist"
 	"github.com/zibbp/ganymede/ent/playlistrulegroup"
+	"github.com/zibbp/ganymede/ent/playlistsection"
 	"github.com/zibbp/ganymede/ent/predicate"
+	"github.com/zibbp/ganymede/ent/sharelink"
+	"github.com/zibbp/ganymede/ent/user"
 	"github.com/zibbp/ganymede/ent/vod"
+	"github.com/zibbp/ganymede/internal/utils"
 )
 
 // PlaylistUpdate is the builder for updating Playlist entities.
@@ -86,6 +92,52 @@ func (_u *PlaylistUpdate) ClearThumbnailPath() *PlaylistUpdate {
 	return _u
 }
 
+// SetVisibility sets the "visibility" field.
+func (_u *PlaylistUpdate) SetVisibility(v utils.Visibility) *PlaylistUpdate {
+	_u.mutation.SetVisibility(v)
+	return _u
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (_u *PlaylistUpdate) SetNillableVisibility(v *utils.Visibility) *PlaylistUpdate {
+	if v != nil {
+		_u.SetVisibility(*v)
+	}
+	return _u
+}
+
+// SetAllowedGroups sets the "allowed_groups" field.
+func (_u *PlaylistUpdate) SetAllowedGroups(v []string) *PlaylistUpdate {
+	_u.mutation.SetAllowedGroups(v)
+	return _u
+}
+
+// AppendAllowedGroups appends value to the "allowed_groups" field.
+func (_u *PlaylistUpdate) AppendAllowedGroups(v []string) *PlaylistUpdate {
+	_u.mutation.AppendAllowedGroups(v)
+	return _u
+}
+
+// ClearAllowedGroups clears the value of the "allowed_groups" field.
+func (_u *PlaylistUpdate) ClearAllowedGroups() *PlaylistUpdate {
+	_u.mutation.ClearAllowedGroups()
+	return _u
+}
+
+// SetManualOrder sets the "manual_order" field.
+func (_u *PlaylistUpdate) SetManualOrder(v bool) *PlaylistUpdate {
+	_u.mutation.SetManualOrder(v)
+	return _u
+}
+
+// SetNillableManualOrder sets the "manual_order" field if the given value is not nil.
+func (_u *PlaylistUpdate) SetNillableManualOrder(v *bool) *PlaylistUpdate {
+	if v != nil {
+		_u.SetManualOrder(*v)
+	}
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *PlaylistUpdate) SetUpdatedAt(v time.Time) *PlaylistUpdate {
 	_u.mutation.SetUpdatedAt(v)
@@ -107,6 +159,21 @@ func (_u *PlaylistUpdate) AddVods(v ...*Vod) *PlaylistUpdate {
 	return _u.AddVodIDs(ids...)
 }
 
+// AddSectionIDs adds the "sections" edge to the PlaylistSection entity by IDs.
+func (_u *PlaylistUpdate) AddSectionIDs(ids ...uuid.UUID) *PlaylistUpdate {
+	_u.mutation.AddSectionIDs(ids...)
+	return _u
+}
+
+// AddSections adds the "sections" edges to the PlaylistSection entity.
+func (_u *PlaylistUpdate) AddSections(v ...*PlaylistSection) *PlaylistUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSectionIDs(ids...)
+}
+
 // AddMultistreamInfoIDs adds the "multistream_info" edge to the MultistreamInfo entity by IDs.
 func (_u *PlaylistUpdate) AddMultistreamInfoIDs(ids ...int) *PlaylistUpdate {
 	_u.mutation.AddMultistreamInfoIDs(ids...)
@@ -137,6 +204,51 @@ func (_u *PlaylistUpdate) AddRuleGroups(v ...*PlaylistRuleGroup) *PlaylistUpdate
 	return _u.AddRuleGroupIDs(ids...)
 }
 
+// AddNotificationSubscriptionIDs adds the "notification_subscriptions" edge to the NotificationSubscription entity by IDs.
+func (_u *PlaylistUpdate) AddNotificationSubscriptionIDs(ids ...uuid.UUID) *PlaylistUpdate {
+	_u.mutation.AddNotificationSubscriptionIDs(ids...)
+	return _u
+}
+
+// AddNotificationSubscriptions adds the "notification_subscriptions" edges to the NotificationSubscription entity.
+func (_u *PlaylistUpdate) AddNotificationSubscriptions(v ...*NotificationSubscription) *PlaylistUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddNotificationSubscriptionIDs(ids...)
+}
+
+// AddAllowedUserIDs adds the "allowed_users" edge to the User entity by IDs.
+func (_u *PlaylistUpdate) AddAllowedUserIDs(ids ...uuid.UUID) *PlaylistUpdate {
+	_u.mutation.AddAllowedUserIDs(ids...)
+	return _u
+}
+
+// AddAllowedUsers adds the "allowed_users" edges to the User entity.
+func (_u *PlaylistUpdate) AddAllowedUsers(v ...*User) *PlaylistUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAllowedUserIDs(ids...)
+}
+
+// AddShareLinkIDs adds the "share_links" edge to the ShareLink entity by IDs.
+func (_u *PlaylistUpdate) AddShareLinkIDs(ids ...uuid.UUID) *PlaylistUpdate {
+	_u.mutation.AddShareLinkIDs(ids...)
+	return _u
+}
+
+// AddShareLinks adds the "share_links" edges to the ShareLink entity.
+func (_u *PlaylistUpdate) AddShareLinks(v ...*ShareLink) *PlaylistUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddShareLinkIDs(ids...)
+}
+
 // Mutation returns the PlaylistMutation object of the builder.
 func (_u *PlaylistUpdate) Mutation() *PlaylistMutation {
 	return _u.mutation
@@ -163,6 +275,27 @@ func (_u *PlaylistUpdate) RemoveVods(v ...*Vod) *PlaylistUpdate {
 	return _u.RemoveVodIDs(ids...)
 }
 
+// ClearSections clears all "sections" edges to the PlaylistSection entity.
+func (_u *PlaylistUpdate) ClearSections() *PlaylistUpdate {
+	_u.mutation.ClearSections()
+	return _u
+}
+
+// RemoveSectionIDs removes the "sections" edge to PlaylistSection entities by IDs.
+func (_u *PlaylistUpdate) RemoveSectionIDs(ids ...uuid.UUID) *PlaylistUpdate {
+	_u.mutation.RemoveSectionIDs(ids...)
+	return _u
+}
+
+// RemoveSections removes "sections" edges to PlaylistSection entities.
+func (_u *PlaylistUpdate) RemoveSections(v ...*PlaylistSection) *PlaylistUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSectionIDs(ids...)
+}
+
 // ClearMultistreamInfo clears all "multistream_info" edges to the MultistreamInfo entity.
 func (_u *PlaylistUpdate) ClearMultistreamInfo() *PlaylistUpdate {
 	_u.mutation.ClearMultistreamInfo()
@@ -205,6 +338,69 @@ func (_u *PlaylistUpdate) RemoveRuleGroups(v ...*PlaylistRuleGroup) *PlaylistUpd
 	return _u.RemoveRuleGroupIDs(ids...)
 }
 
+// ClearNotificationSubscriptions clears all "notification_subscriptions" edges to the NotificationSubscription entity.
+func (_u *PlaylistUpdate) ClearNotificationSubscriptions() *PlaylistUpdate {
+	_u.mutation.ClearNotificationSubscriptions()
+	return _u
+}
+
+// RemoveNotificationSubscriptionIDs removes the "notification_subscriptions" edge to NotificationSubscription entities by IDs.
+func (_u *PlaylistUpdate) RemoveNotificationSubscriptionIDs(ids ...uuid.UUID) *PlaylistUpdate {
+	_u.mutation.RemoveNotificationSubscriptionIDs(ids...)
+	return _u
+}
+
+// RemoveNotificationSubscriptions removes "notification_subscriptions" edges to NotificationSubscription entities.
+func (_u *PlaylistUpdate) RemoveNotificationSubscriptions(v ...*NotificationSubscription) *PlaylistUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveNotificationSubscriptionIDs(ids...)
+}
+
+// ClearAllowedUsers clears all "allowed_users" edges to the User entity.
+func (_u *PlaylistUpdate) ClearAllowedUsers() *PlaylistUpdate {
+	_u.mutation.ClearAllowedUsers()
+	return _u
+}
+
+// RemoveAllowedUserIDs removes the "allowed_users" edge to User entities by IDs.
+func (_u *PlaylistUpdate) RemoveAllowedUserIDs(ids ...uuid.UUID) *PlaylistUpdate {
+	_u.mutation.RemoveAllowedUserIDs(ids...)
+	return _u
+}
+
+// RemoveAllowedUsers removes "allowed_users" edges to User entities.
+func (_u *PlaylistUpdate) RemoveAllowedUsers(v ...*User) *PlaylistUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAllowedUserIDs(ids...)
+}
+
+// ClearShareLinks clears all "share_links" edges to the ShareLink entity.
+func (_u *PlaylistUpdate) ClearShareLinks() *PlaylistUpdate {
+	_u.mutation.ClearShareLinks()
+	return _u
+}
+
+// RemoveShareLinkIDs removes the "share_links" edge to ShareLink entities by IDs.
+func (_u *PlaylistUpdate) RemoveShareLinkIDs(ids ...uuid.UUID) *PlaylistUpdate {
+	_u.mutation.RemoveShareLinkIDs(ids...)
+	return _u
+}
+
+// RemoveShareLinks removes "share_links" edges to ShareLink entities.
+func (_u *PlaylistUpdate) RemoveShareLinks(v ...*ShareLink) *PlaylistUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveShareLinkIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *PlaylistUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -241,7 +437,20 @@ func (_u *PlaylistUpdate) defaults() {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (_u *PlaylistUpdate) check() error {
+	if v, ok := _u.mutation.Visibility(); ok {
+		if err := playlist.VisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "Playlist.visibility": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (_u *PlaylistUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(playlist.Table, playlist.Columns, sqlgraph.NewFieldSpec(playlist.FieldID, field.TypeUUID))
 	if ps := _u.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -265,6 +474,23 @@ func (_u *PlaylistUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.ThumbnailPathCleared() {
 		_spec.ClearField(playlist.FieldThumbnailPath, field.TypeString)
 	}
+	if value, ok := _u.mutation.Visibility(); ok {
+		_spec.SetField(playlist.FieldVisibility, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.AllowedGroups(); ok {
+		_spec.SetField(playlist.FieldAllowedGroups, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAllowedGroups(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, playlist.FieldAllowedGroups, value)
+		})
+	}
+	if _u.mutation.AllowedGroupsCleared() {
+		_spec.ClearField(playlist.FieldAllowedGroups, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ManualOrder(); ok {
+		_spec.SetField(playlist.FieldManualOrder, field.TypeBool, value)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(playlist.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -279,6 +505,10 @@ func (_u *PlaylistUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 				IDSpec: sqlgraph.NewFieldSpec(vod.FieldID, field.TypeUUID),
 			},
 		}
+		createE := &PlaylistVodCreate{config: _u.config, mutation: newPlaylistVodMutation(_u.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := _u.mutation.RemovedVodsIDs(); len(nodes) > 0 && !_u.mutation.VodsCleared() {
@@ -295,6 +525,10 @@ func (_u *PlaylistUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		createE := &PlaylistVodCreate{config: _u.config, mutation: newPlaylistVodMutation(_u.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := _u.mutation.VodsIDs(); len(nodes) > 0 {
@@ -311,6 +545,55 @@ func (_u *PlaylistUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		createE := &PlaylistVodCreate{config: _u.config, mutation: newPlaylistVodMutation(_u.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   playlist.SectionsTable,
+			Columns: []string{playlist.SectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlistsection.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSectionsIDs(); len(nodes) > 0 && !_u.mutation.SectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   playlist.SectionsTable,
+			Columns: []string{playlist.SectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlistsection.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SectionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   playlist.SectionsTable,
+			Columns: []string{playlist.SectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlistsection.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
 	if _u.mutation.MultistreamInfoCleared() {
@@ -403,6 +686,141 @@ func (_u *PlaylistUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.NotificationSubscriptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   playlist.NotificationSubscriptionsTable,
+			Columns: []string{playlist.NotificationSubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationsubscription.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedNotificationSubscriptionsIDs(); len(nodes) > 0 && !_u.mutation.NotificationSubscriptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   playlist.NotificationSubscriptionsTable,
+			Columns: []string{playlist.NotificationSubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationsubscription.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.NotificationSubscriptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   playlist.NotificationSubscriptionsTable,
+			Columns: []string{playlist.NotificationSubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationsubscription.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AllowedUsersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   playlist.AllowedUsersTable,
+			Columns: playlist.AllowedUsersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAllowedUsersIDs(); len(nodes) > 0 && !_u.mutation.AllowedUsersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   playlist.AllowedUsersTable,
+			Columns: playlist.AllowedUsersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AllowedUsersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   playlist.AllowedUsersTable,
+			Columns: playlist.AllowedUsersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ShareLinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   playlist.ShareLinksTable,
+			Columns: []string{playlist.ShareLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sharelink.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedShareLinksIDs(); len(nodes) > 0 && !_u.mutation.ShareLinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   playlist.ShareLinksTable,
+			Columns: []string{playlist.ShareLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sharelink.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ShareLinksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   playlist.ShareLinksTable,
+			Columns: []string{playlist.ShareLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sharelink.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{playlist.Label}
@@ -477,6 +895,52 @@ func (_u *PlaylistUpdateOne) ClearThumbnailPath() *PlaylistUpdateOne {
 	return _u
 }
 
+// SetVisibility sets the "visibility" field.
+func (_u *PlaylistUpdateOne) SetVisibility(v utils.Visibility) *PlaylistUpdateOne {
+	_u.mutation.SetVisibility(v)
+	return _u
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (_u *PlaylistUpdateOne) SetNillableVisibility(v *utils.Visibility) *PlaylistUpdateOne {
+	if v != nil {
+		_u.SetVisibility(*v)
+	}
+	return _u
+}
+
+// SetAllowedGroups sets the "allowed_groups" field.
+func (_u *PlaylistUpdateOne) SetAllowedGroups(v []string) *PlaylistUpdateOne {
+	_u.mutation.SetAllowedGroups(v)
+	return _u
+}
+
+// AppendAllowedGroups appends value to the "allowed_groups" field.
+func (_u *PlaylistUpdateOne) AppendAllowedGroups(v []string) *PlaylistUpdateOne {
+	_u.mutation.AppendAllowedGroups(v)
+	return _u
+}
+
+// ClearAllowedGroups clears the value of the "allowed_groups" field.
+func (_u *PlaylistUpdateOne) ClearAllowedGroups() *PlaylistUpdateOne {
+	_u.mutation.ClearAllowedGroups()
+	return _u
+}
+
+// SetManualOrder sets the "manual_order" field.
+func (_u *PlaylistUpdateOne) SetManualOrder(v bool) *PlaylistUpdateOne {
+	_u.mutation.SetManualOrder(v)
+	return _u
+}
+
+// SetNillableManualOrder sets the "manual_order" field if the given value is not nil.
+func (_u *PlaylistUpdateOne) SetNillableManualOrder(v *bool) *PlaylistUpdateOne {
+	if v != nil {
+		_u.SetManualOrder(*v)
+	}
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *PlaylistUpdateOne) SetUpdatedAt(v time.Time) *PlaylistUpdateOne {
 	_u.mutation.SetUpdatedAt(v)
@@ -498,6 +962,21 @@ func (_u *PlaylistUpdateOne) AddVods(v ...*Vod) *PlaylistUpdateOne {
 	return _u.AddVodIDs(ids...)
 }
 
+// AddSectionIDs adds the "sections" edge to the PlaylistSection entity by IDs.
+func (_u *PlaylistUpdateOne) AddSectionIDs(ids ...uuid.UUID) *PlaylistUpdateOne {
+	_u.mutation.AddSectionIDs(ids...)
+	return _u
+}
+
+// AddSections adds the "sections" edges to the PlaylistSection entity.
+func (_u *PlaylistUpdateOne) AddSections(v ...*PlaylistSection) *PlaylistUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSectionIDs(ids...)
+}
+
 // AddMultistreamInfoIDs adds the "multistream_info" edge to the MultistreamInfo entity by IDs.
 func (_u *PlaylistUpdateOne) AddMultistreamInfoIDs(ids ...int) *PlaylistUpdateOne {
 	_u.mutation.AddMultistreamInfoIDs(ids...)
@@ -528,6 +1007,51 @@ func (_u *PlaylistUpdateOne) AddRuleGroups(v ...*PlaylistRuleGroup) *PlaylistUpd
 	return _u.AddRuleGroupIDs(ids...)
 }
 
+// AddNotificationSubscriptionIDs adds the "notification_subscriptions" edge to the NotificationSubscription entity by IDs.
+func (_u *PlaylistUpdateOne) AddNotificationSubscriptionIDs(ids ...uuid.UUID) *PlaylistUpdateOne {
+	_u.mutation.AddNotificationSubscriptionIDs(ids...)
+	return _u
+}
+
+// AddNotificationSubscriptions adds the "notification_subscriptions" edges to the NotificationSubscription entity.
+func (_u *PlaylistUpdateOne) AddNotificationSubscriptions(v ...*NotificationSubscription) *PlaylistUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddNotificationSubscriptionIDs(ids...)
+}
+
+// AddAllowedUserIDs adds the "allowed_users" edge to the User entity by IDs.
+func (_u *PlaylistUpdateOne) AddAllowedUserIDs(ids ...uuid.UUID) *PlaylistUpdateOne {
+	_u.mutation.AddAllowedUserIDs(ids...)
+	return _u
+}
+
+// AddAllowedUsers adds the "allowed_users" edges to the User entity.
+func (_u *PlaylistUpdateOne) AddAllowedUsers(v ...*User) *PlaylistUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAllowedUserIDs(ids...)
+}
+
+// AddShareLinkIDs adds the "share_links" edge to the ShareLink entity by IDs.
+func (_u *PlaylistUpdateOne) AddShareLinkIDs(ids ...uuid.UUID) *PlaylistUpdateOne {
+	_u.mutation.AddShareLinkIDs(ids...)
+	return _u
+}
+
+// AddShareLinks adds the "share_links" edges to the ShareLink entity.
+func (_u *PlaylistUpdateOne) AddShareLinks(v ...*ShareLink) *PlaylistUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddShareLinkIDs(ids...)
+}
+
 // Mutation returns the PlaylistMutation object of the builder.
 func (_u *PlaylistUpdateOne) Mutation() *PlaylistMutation {
 	return _u.mutation
@@ -554,6 +1078,27 @@ func (_u *PlaylistUpdateOne) RemoveVods(v ...*Vod) *PlaylistUpdateOne {
 	return _u.RemoveVodIDs(ids...)
 }
 
+// ClearSections clears all "sections" edges to the PlaylistSection entity.
+func (_u *PlaylistUpdateOne) ClearSections() *PlaylistUpdateOne {
+	_u.mutation.ClearSections()
+	return _u
+}
+
+// RemoveSectionIDs removes the "sections" edge to PlaylistSection entities by IDs.
+func (_u *PlaylistUpdateOne) RemoveSectionIDs(ids ...uuid.UUID) *PlaylistUpdateOne {
+	_u.mutation.RemoveSectionIDs(ids...)
+	return _u
+}
+
+// RemoveSections removes "sections" edges to PlaylistSection entities.
+func (_u *PlaylistUpdateOne) RemoveSections(v ...*PlaylistSection) *PlaylistUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSectionIDs(ids...)
+}
+
 // ClearMultistreamInfo clears all "multistream_info" edges to the MultistreamInfo entity.
 func (_u *PlaylistUpdateOne) ClearMultistreamInfo() *PlaylistUpdateOne {
 	_u.mutation.ClearMultistreamInfo()
@@ -596,6 +1141,69 @@ func (_u *PlaylistUpdateOne) RemoveRuleGroups(v ...*PlaylistRuleGroup) *Playlist
 	return _u.RemoveRuleGroupIDs(ids...)
 }
 
+// ClearNotificationSubscriptions clears all "notification_subscriptions" edges to the NotificationSubscription entity.
+func (_u *PlaylistUpdateOne) ClearNotificationSubscriptions() *PlaylistUpdateOne {
+	_u.mutation.ClearNotificationSubscriptions()
+	return _u
+}
+
+// RemoveNotificationSubscriptionIDs removes the "notification_subscriptions" edge to NotificationSubscription entities by IDs.
+func (_u *PlaylistUpdateOne) RemoveNotificationSubscriptionIDs(ids ...uuid.UUID) *PlaylistUpdateOne {
+	_u.mutation.RemoveNotificationSubscriptionIDs(ids...)
+	return _u
+}
+
+// RemoveNotificationSubscriptions removes "notification_subscriptions" edges to NotificationSubscription entities.
+func (_u *PlaylistUpdateOne) RemoveNotificationSubscriptions(v ...*NotificationSubscription) *PlaylistUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveNotificationSubscriptionIDs(ids...)
+}
+
+// ClearAllowedUsers clears all "allowed_users" edges to the User entity.
+func (_u *PlaylistUpdateOne) ClearAllowedUsers() *PlaylistUpdateOne {
+	_u.mutation.ClearAllowedUsers()
+	return _u
+}
+
+// RemoveAllowedUserIDs removes the "allowed_users" edge to User entities by IDs.
+func (_u *PlaylistUpdateOne) RemoveAllowedUserIDs(ids ...uuid.UUID) *PlaylistUpdateOne {
+	_u.mutation.RemoveAllowedUserIDs(ids...)
+	return _u
+}
+
+// RemoveAllowedUsers removes "allowed_users" edges to User entities.
+func (_u *PlaylistUpdateOne) RemoveAllowedUsers(v ...*User) *PlaylistUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAllowedUserIDs(ids...)
+}
+
+// ClearShareLinks clears all "share_links" edges to the ShareLink entity.
+func (_u *PlaylistUpdateOne) ClearShareLinks() *PlaylistUpdateOne {
+	_u.mutation.ClearShareLinks()
+	return _u
+}
+
+// RemoveShareLinkIDs removes the "share_links" edge to ShareLink entities by IDs.
+func (_u *PlaylistUpdateOne) RemoveShareLinkIDs(ids ...uuid.UUID) *PlaylistUpdateOne {
+	_u.mutation.RemoveShareLinkIDs(ids...)
+	return _u
+}
+
+// RemoveShareLinks removes "share_links" edges to ShareLink entities.
+func (_u *PlaylistUpdateOne) RemoveShareLinks(v ...*ShareLink) *PlaylistUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveShareLinkIDs(ids...)
+}
+
 // Where appends a list predicates to the PlaylistUpdate builder.
 func (_u *PlaylistUpdateOne) Where(ps ...predicate.Playlist) *PlaylistUpdateOne {
 	_u.mutation.Where(ps...)
@@ -645,7 +1253,20 @@ func (_u *PlaylistUpdateOne) defaults() {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (_u *PlaylistUpdateOne) check() error {
+	if v, ok := _u.mutation.Visibility(); ok {
+		if err := playlist.VisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "Playlist.visibility": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (_u *PlaylistUpdateOne) sqlSave(ctx context.Context) (_node *Playlist, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(playlist.Table, playlist.Columns, sqlgraph.NewFieldSpec(playlist.FieldID, field.TypeUUID))
 	id, ok := _u.mutation.ID()
 	if !ok {
@@ -686,6 +1307,23 @@ func (_u *PlaylistUpdateOne) sqlSave(ctx context.Context) (_node *Playlist, err
 	if _u.mutation.ThumbnailPathCleared() {
 		_spec.ClearField(playlist.FieldThumbnailPath, field.TypeString)
 	}
+	if value, ok := _u.mutation.Visibility(); ok {
+		_spec.SetField(playlist.FieldVisibility, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.AllowedGroups(); ok {
+		_spec.SetField(playlist.FieldAllowedGroups, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAllowedGroups(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, playlist.FieldAllowedGroups, value)
+		})
+	}
+	if _u.mutation.AllowedGroupsCleared() {
+		_spec.ClearField(playlist.FieldAllowedGroups, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ManualOrder(); ok {
+		_spec.SetField(playlist.FieldManualOrder, field.TypeBool, value)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(playlist.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -700,6 +1338,10 @@ func (_u *PlaylistUpdateOne) sqlSave(ctx context.Context) (_node *Playlist, err
 				IDSpec: sqlgraph.NewFieldSpec(vod.FieldID, field.TypeUUID),
 			},
 		}
+		createE := &PlaylistVodCreate{config: _u.config, mutation: newPlaylistVodMutation(_u.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := _u.mutation.RemovedVodsIDs(); len(nodes) > 0 && !_u.mutation.VodsCleared() {
@@ -716,6 +1358,10 @@ func (_u *PlaylistUpdateOne) sqlSave(ctx context.Context) (_node *Playlist, err
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		createE := &PlaylistVodCreate{config: _u.config, mutation: newPlaylistVodMutation(_u.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := _u.mutation.VodsIDs(); len(nodes) > 0 {
@@ -732,6 +1378,55 @@ func (_u *PlaylistUpdateOne) sqlSave(ctx context.Context) (_node *Playlist, err
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		createE := &PlaylistVodCreate{config: _u.config, mutation: newPlaylistVodMutation(_u.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   playlist.SectionsTable,
+			Columns: []string{playlist.SectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlistsection.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSectionsIDs(); len(nodes) > 0 && !_u.mutation.SectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   playlist.SectionsTable,
+			Columns: []string{playlist.SectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlistsection.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SectionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   playlist.SectionsTable,
+			Columns: []string{playlist.SectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlistsection.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
 	if _u.mutation.MultistreamInfoCleared() {
@@ -824,6 +1519,141 @@ func (_u *PlaylistUpdateOne) sqlSave(ctx context.Context) (_node *Playlist, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.NotificationSubscriptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   playlist.NotificationSubscriptionsTable,
+			Columns: []string{playlist.NotificationSubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationsubscription.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedNotificationSubscriptionsIDs(); len(nodes) > 0 && !_u.mutation.NotificationSubscriptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   playlist.NotificationSubscriptionsTable,
+			Columns: []string{playlist.NotificationSubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationsubscription.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.NotificationSubscriptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   playlist.NotificationSubscriptionsTable,
+			Columns: []string{playlist.NotificationSubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationsubscription.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AllowedUsersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   playlist.AllowedUsersTable,
+			Columns: playlist.AllowedUsersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAllowedUsersIDs(); len(nodes) > 0 && !_u.mutation.AllowedUsersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   playlist.AllowedUsersTable,
+			Columns: playlist.AllowedUsersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AllowedUsersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   playlist.AllowedUsersTable,
+			Columns: playlist.AllowedUsersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ShareLinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   playlist.ShareLinksTable,
+			Columns: []string{playlist.ShareLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sharelink.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedShareLinksIDs(); len(nodes) > 0 && !_u.mutation.ShareLinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   playlist.ShareLinksTable,
+			Columns: []string{playlist.ShareLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sharelink.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ShareLinksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   playlist.ShareLinksTable,
+			Columns: []string{playlist.ShareLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sharelink.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Playlist{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -31,6 +31,8 @@ type PlaylistRule struct {
 	Position int `json:"position,omitempty"`
 	// Is the rule active?
 	Enabled bool `json:"enabled,omitempty"`
+	// Invert the result of the rule.
+	Negate bool `json:"negate,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the PlaylistRuleQuery when eager-loading is set.
 	Edges                     PlaylistRuleEdges `json:"edges"`
@@ -63,7 +65,7 @@ func (*PlaylistRule) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case playlistrule.FieldEnabled:
+		case playlistrule.FieldEnabled, playlistrule.FieldNegate:
 			values[i] = new(sql.NullBool)
 		case playlistrule.FieldPosition:
 			values[i] = new(sql.NullInt64)
@@ -130,6 +132,12 @@ func (_m *PlaylistRule) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Enabled = value.Bool
 			}
+		case playlistrule.FieldNegate:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field negate", values[i])
+			} else if value.Valid {
+				_m.Negate = value.Bool
+			}
 		case playlistrule.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field playlist_rule_group_rules", values[i])
@@ -195,6 +203,9 @@ func (_m *PlaylistRule) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("enabled=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Enabled))
+	builder.WriteString(", ")
+	builder.WriteString("negate=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Negate))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -28,6 +28,8 @@ const (
 	FieldPosition = "position"
 	// FieldEnabled holds the string denoting the enabled field in the database.
 	FieldEnabled = "enabled"
+	// FieldNegate holds the string denoting the negate field in the database.
+	FieldNegate = "negate"
 	// EdgeGroup holds the string denoting the group edge name in mutations.
 	EdgeGroup = "group"
 	// Table holds the table name of the playlistrule in the database.
@@ -50,6 +52,7 @@ var Columns = []string{
 	FieldValue,
 	FieldPosition,
 	FieldEnabled,
+	FieldNegate,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "playlist_rules"
@@ -78,6 +81,8 @@ var (
 	DefaultPosition int
 	// DefaultEnabled holds the default value on creation for the "enabled" field.
 	DefaultEnabled bool
+	// DefaultNegate holds the default value on creation for the "negate" field.
+	DefaultNegate bool
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -87,7 +92,7 @@ const DefaultField utils.PlaylistRuleField = "title"
 // FieldValidator is a validator for the "field" field enum values. It is called by the builders before save.
 func FieldValidator(f utils.PlaylistRuleField) error {
 	switch f {
-	case "title", "category", "type", "platform", "channel_name":
+	case "title", "category", "type", "platform", "channel_name", "tag", "duration", "streamed_at", "views", "local_views", "storage_size_bytes", "age_days":
 		return nil
 	default:
 		return fmt.Errorf("playlistrule: invalid enum value for field field: %q", f)
@@ -99,7 +104,7 @@ const DefaultOperator utils.PlaylistRuleOperator = "contains"
 // OperatorValidator is a validator for the "operator" field enum values. It is called by the builders before save.
 func OperatorValidator(o utils.PlaylistRuleOperator) error {
 	switch o {
-	case "equals", "contains", "regex":
+	case "equals", "contains", "regex", "greater_than", "greater_than_or_equal", "less_than", "less_than_or_equal":
 		return nil
 	default:
 		return fmt.Errorf("playlistrule: invalid enum value for operator field: %q", o)
@@ -144,6 +149,11 @@ func ByEnabled(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEnabled, opts...).ToFunc()
 }
 
+// ByNegate orders the results by the negate field.
+func ByNegate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNegate, opts...).ToFunc()
+}
+
 // ByGroupField orders the results by group field.
 func ByGroupField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -75,6 +75,11 @@ func Enabled(v bool) predicate.PlaylistRule {
 	return predicate.PlaylistRule(sql.FieldEQ(FieldEnabled, v))
 }
 
+// Negate applies equality check predicate on the "negate" field. It's identical to NegateEQ.
+func Negate(v bool) predicate.PlaylistRule {
+	return predicate.PlaylistRule(sql.FieldEQ(FieldNegate, v))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.PlaylistRule {
 	return predicate.PlaylistRule(sql.FieldEQ(FieldName, v))
@@ -325,6 +330,16 @@ func EnabledNEQ(v bool) predicate.PlaylistRule {
 	return predicate.PlaylistRule(sql.FieldNEQ(FieldEnabled, v))
 }
 
+// NegateEQ applies the EQ predicate on the "negate" field.
+func NegateEQ(v bool) predicate.PlaylistRule {
+	return predicate.PlaylistRule(sql.FieldEQ(FieldNegate, v))
+}
+
+// NegateNEQ applies the NEQ predicate on the "negate" field.
+func NegateNEQ(v bool) predicate.PlaylistRule {
+	return predicate.PlaylistRule(sql.FieldNEQ(FieldNegate, v))
+}
+
 // HasGroup applies the HasEdge predicate on the "group" edge.
 func HasGroup() predicate.PlaylistRule {
 	return predicate.PlaylistRule(func(s *sql.Selector) {
@@ -98,6 +98,20 @@ func (_c *PlaylistRuleCreate) SetNillableEnabled(v *bool) *PlaylistRuleCreate {
 	return _c
 }
 
+// SetNegate sets the "negate" field.
+func (_c *PlaylistRuleCreate) SetNegate(v bool) *PlaylistRuleCreate {
+	_c.mutation.SetNegate(v)
+	return _c
+}
+
+// SetNillableNegate sets the "negate" field if the given value is not nil.
+func (_c *PlaylistRuleCreate) SetNillableNegate(v *bool) *PlaylistRuleCreate {
+	if v != nil {
+		_c.SetNegate(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *PlaylistRuleCreate) SetID(v uuid.UUID) *PlaylistRuleCreate {
 	_c.mutation.SetID(v)
@@ -174,6 +188,10 @@ func (_c *PlaylistRuleCreate) defaults() {
 		v := playlistrule.DefaultEnabled
 		_c.mutation.SetEnabled(v)
 	}
+	if _, ok := _c.mutation.Negate(); !ok {
+		v := playlistrule.DefaultNegate
+		_c.mutation.SetNegate(v)
+	}
 	if _, ok := _c.mutation.ID(); !ok {
 		v := playlistrule.DefaultID()
 		_c.mutation.SetID(v)
@@ -207,6 +225,9 @@ func (_c *PlaylistRuleCreate) check() error {
 	if _, ok := _c.mutation.Enabled(); !ok {
 		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "PlaylistRule.enabled"`)}
 	}
+	if _, ok := _c.mutation.Negate(); !ok {
+		return &ValidationError{Name: "negate", err: errors.New(`ent: missing required field "PlaylistRule.negate"`)}
+	}
 	if len(_c.mutation.GroupIDs()) == 0 {
 		return &ValidationError{Name: "group", err: errors.New(`ent: missing required edge "PlaylistRule.group"`)}
 	}
@@ -269,6 +290,10 @@ func (_c *PlaylistRuleCreate) createSpec() (*PlaylistRule, *sqlgraph.CreateSpec)
 		_spec.SetField(playlistrule.FieldEnabled, field.TypeBool, value)
 		_node.Enabled = value
 	}
+	if value, ok := _c.mutation.Negate(); ok {
+		_spec.SetField(playlistrule.FieldNegate, field.TypeBool, value)
+		_node.Negate = value
+	}
 	if nodes := _c.mutation.GroupIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -127,6 +127,20 @@ func (_u *PlaylistRuleUpdate) SetNillableEnabled(v *bool) *PlaylistRuleUpdate {
 	return _u
 }
 
+// SetNegate sets the "negate" field.
+func (_u *PlaylistRuleUpdate) SetNegate(v bool) *PlaylistRuleUpdate {
+	_u.mutation.SetNegate(v)
+	return _u
+}
+
+// SetNillableNegate sets the "negate" field if the given value is not nil.
+func (_u *PlaylistRuleUpdate) SetNillableNegate(v *bool) *PlaylistRuleUpdate {
+	if v != nil {
+		_u.SetNegate(*v)
+	}
+	return _u
+}
+
 // SetGroupID sets the "group" edge to the PlaylistRuleGroup entity by ID.
 func (_u *PlaylistRuleUpdate) SetGroupID(id uuid.UUID) *PlaylistRuleUpdate {
 	_u.mutation.SetGroupID(id)
@@ -230,6 +244,9 @@ func (_u *PlaylistRuleUpdate) sqlSave(ctx context.Context) (_node int, err error
 	if value, ok := _u.mutation.Enabled(); ok {
 		_spec.SetField(playlistrule.FieldEnabled, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.Negate(); ok {
+		_spec.SetField(playlistrule.FieldNegate, field.TypeBool, value)
+	}
 	if _u.mutation.GroupCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -376,6 +393,20 @@ func (_u *PlaylistRuleUpdateOne) SetNillableEnabled(v *bool) *PlaylistRuleUpdate
 	return _u
 }
 
+// SetNegate sets the "negate" field.
+func (_u *PlaylistRuleUpdateOne) SetNegate(v bool) *PlaylistRuleUpdateOne {
+	_u.mutation.SetNegate(v)
+	return _u
+}
+
+// SetNillableNegate sets the "negate" field if the given value is not nil.
+func (_u *PlaylistRuleUpdateOne) SetNillableNegate(v *bool) *PlaylistRuleUpdateOne {
+	if v != nil {
+		_u.SetNegate(*v)
+	}
+	return _u
+}
+
 // SetGroupID sets the "group" edge to the PlaylistRuleGroup entity by ID.
 func (_u *PlaylistRuleUpdateOne) SetGroupID(id uuid.UUID) *PlaylistRuleUpdateOne {
 	_u.mutation.SetGroupID(id)
@@ -509,6 +540,9 @@ func (_u *PlaylistRuleUpdateOne) sqlSave(ctx context.Context) (_node *PlaylistRu
 	if value, ok := _u.mutation.Enabled(); ok {
 		_spec.SetField(playlistrule.FieldEnabled, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.Negate(); ok {
+		_spec.SetField(playlistrule.FieldNegate, field.TypeBool, value)
+	}
 	if _u.mutation.GroupCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -22,6 +22,10 @@ type PlaylistRuleGroup struct {
 	Operator playlistrulegroup.Operator `json:"operator,omitempty"`
 	// Used to order groups within the playlist
 	Position int `json:"position,omitempty"`
+	// Invert the result of the group
+	Negate bool `json:"negate,omitempty"`
+	// Group this group is nested in, top level groups have none
+	ParentID *uuid.UUID `json:"parent_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the PlaylistRuleGroupQuery when eager-loading is set.
 	Edges                PlaylistRuleGroupEdges `json:"edges"`
@@ -35,9 +39,13 @@ type PlaylistRuleGroupEdges struct {
 	Playlist *Playlist `json:"playlist,omitempty"`
 	// Rules holds the value of the rules edge.
 	Rules []*PlaylistRule `json:"rules,omitempty"`
+	// Parent holds the value of the parent edge.
+	Parent *PlaylistRuleGroup `json:"parent,omitempty"`
+	// Children holds the value of the children edge.
+	Children []*PlaylistRuleGroup `json:"children,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [4]bool
 }
 
 // PlaylistOrErr returns the Playlist value or an error if the edge
@@ -60,11 +68,35 @@ func (e PlaylistRuleGroupEdges) RulesOrErr() ([]*PlaylistRule, error) {
 	return nil, &NotLoadedError{edge: "rules"}
 }
 
+// ParentOrErr returns the Parent value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PlaylistRuleGroupEdges) ParentOrErr() (*PlaylistRuleGroup, error) {
+	if e.Parent != nil {
+		return e.Parent, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: playlistrulegroup.Label}
+	}
+	return nil, &NotLoadedError{edge: "parent"}
+}
+
+// ChildrenOrErr returns the Children value or an error if the edge
+// was not loaded in eager-loading.
+func (e PlaylistRuleGroupEdges) ChildrenOrErr() ([]*PlaylistRuleGroup, error) {
+	if e.loadedTypes[3] {
+		return e.Children, nil
+	}
+	return nil, &NotLoadedError{edge: "children"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*PlaylistRuleGroup) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case playlistrulegroup.FieldParentID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case playlistrulegroup.FieldNegate:
+			values[i] = new(sql.NullBool)
 		case playlistrulegroup.FieldPosition:
 			values[i] = new(sql.NullInt64)
 		case playlistrulegroup.FieldOperator:
@@ -106,6 +138,19 @@ func (_m *PlaylistRuleGroup) assignValues(columns []string, values []any) error
 			} else if value.Valid {
 				_m.Position = int(value.Int64)
 			}
+		case playlistrulegroup.FieldNegate:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field negate", values[i])
+			} else if value.Valid {
+				_m.Negate = value.Bool
+			}
+		case playlistrulegroup.FieldParentID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field parent_id", values[i])
+			} else if value.Valid {
+				_m.ParentID = new(uuid.UUID)
+				*_m.ParentID = *value.S.(*uuid.UUID)
+			}
 		case playlistrulegroup.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field playlist_rule_groups", values[i])
@@ -136,6 +181,16 @@ func (_m *PlaylistRuleGroup) QueryRules() *PlaylistRuleQuery {
 	return NewPlaylistRuleGroupClient(_m.config).QueryRules(_m)
 }
 
+// QueryParent queries the "parent" edge of the PlaylistRuleGroup entity.
+func (_m *PlaylistRuleGroup) QueryParent() *PlaylistRuleGroupQuery {
+	return NewPlaylistRuleGroupClient(_m.config).QueryParent(_m)
+}
+
+// QueryChildren queries the "children" edge of the PlaylistRuleGroup entity.
+func (_m *PlaylistRuleGroup) QueryChildren() *PlaylistRuleGroupQuery {
+	return NewPlaylistRuleGroupClient(_m.config).QueryChildren(_m)
+}
+
 // Update returns a builder for updating this PlaylistRuleGroup.
 // Note that you need to call PlaylistRuleGroup.Unwrap() before calling this method if this PlaylistRuleGroup
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -164,6 +219,14 @@ func (_m *PlaylistRuleGroup) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("position=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Position))
+	builder.WriteString(", ")
+	builder.WriteString("negate=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Negate))
+	builder.WriteString(", ")
+	if v := _m.ParentID; v != nil {
+		builder.WriteString("parent_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -59,6 +59,16 @@ func Position(v int) predicate.PlaylistRuleGroup {
 	return predicate.PlaylistRuleGroup(sql.FieldEQ(FieldPosition, v))
 }
 
+// Negate applies equality check predicate on the "negate" field. It's identical to NegateEQ.
+func Negate(v bool) predicate.PlaylistRuleGroup {
+	return predicate.PlaylistRuleGroup(sql.FieldEQ(FieldNegate, v))
+}
+
+// ParentID applies equality check predicate on the "parent_id" field. It's identical to ParentIDEQ.
+func ParentID(v uuid.UUID) predicate.PlaylistRuleGroup {
+	return predicate.PlaylistRuleGroup(sql.FieldEQ(FieldParentID, v))
+}
+
 // OperatorEQ applies the EQ predicate on the "operator" field.
 func OperatorEQ(v Operator) predicate.PlaylistRuleGroup {
 	return predicate.PlaylistRuleGroup(sql.FieldEQ(FieldOperator, v))
@@ -119,6 +129,46 @@ func PositionLTE(v int) predicate.PlaylistRuleGroup {
 	return predicate.PlaylistRuleGroup(sql.FieldLTE(FieldPosition, v))
 }
 
+// NegateEQ applies the EQ predicate on the "negate" field.
+func NegateEQ(v bool) predicate.PlaylistRuleGroup {
+	return predicate.PlaylistRuleGroup(sql.FieldEQ(FieldNegate, v))
+}
+
+// NegateNEQ applies the NEQ predicate on the "negate" field.
+func NegateNEQ(v bool) predicate.PlaylistRuleGroup {
+	return predicate.PlaylistRuleGroup(sql.FieldNEQ(FieldNegate, v))
+}
+
+// ParentIDEQ applies the EQ predicate on the "parent_id" field.
+func ParentIDEQ(v uuid.UUID) predicate.PlaylistRuleGroup {
+	return predicate.PlaylistRuleGroup(sql.FieldEQ(FieldParentID, v))
+}
+
+// ParentIDNEQ applies the NEQ predicate on the "parent_id" field.
+func ParentIDNEQ(v uuid.UUID) predicate.PlaylistRuleGroup {
+	return predicate.PlaylistRuleGroup(sql.FieldNEQ(FieldParentID, v))
+}
+
+// ParentIDIn applies the In predicate on the "parent_id" field.
+func ParentIDIn(vs ...uuid.UUID) predicate.PlaylistRuleGroup {
+	return predicate.PlaylistRuleGroup(sql.FieldIn(FieldParentID, vs...))
+}
+
+// ParentIDNotIn applies the NotIn predicate on the "parent_id" field.
+func ParentIDNotIn(vs ...uuid.UUID) predicate.PlaylistRuleGroup {
+	return predicate.PlaylistRuleGroup(sql.FieldNotIn(FieldParentID, vs...))
+}
+
+// ParentIDIsNil applies the IsNil predicate on the "parent_id" field.
+func ParentIDIsNil() predicate.PlaylistRuleGroup {
+	return predicate.PlaylistRuleGroup(sql.FieldIsNull(FieldParentID))
+}
+
+// ParentIDNotNil applies the NotNil predicate on the "parent_id" field.
+func ParentIDNotNil() predicate.PlaylistRuleGroup {
+	return predicate.PlaylistRuleGroup(sql.FieldNotNull(FieldParentID))
+}
+
 // HasPlaylist applies the HasEdge predicate on the "playlist" edge.
 func HasPlaylist() predicate.PlaylistRuleGroup {
 	return predicate.PlaylistRuleGroup(func(s *sql.Selector) {
@@ -165,6 +215,52 @@ func HasRulesWith(preds ...predicate.PlaylistRule) predicate.PlaylistRuleGroup {
 	})
 }
 
+// HasParent applies the HasEdge predicate on the "parent" edge.
+func HasParent() predicate.PlaylistRuleGroup {
+	return predicate.PlaylistRuleGroup(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ParentTable, ParentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasParentWith applies the HasEdge predicate on the "parent" edge with a given conditions (other predicates).
+func HasParentWith(preds ...predicate.PlaylistRuleGroup) predicate.PlaylistRuleGroup {
+	return predicate.PlaylistRuleGroup(func(s *sql.Selector) {
+		step := newParentStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasChildren applies the HasEdge predicate on the "children" edge.
+func HasChildren() predicate.PlaylistRuleGroup {
+	return predicate.PlaylistRuleGroup(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ChildrenTable, ChildrenColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasChildrenWith applies the HasEdge predicate on the "children" edge with a given conditions (other predicates).
+func HasChildrenWith(preds ...predicate.PlaylistRuleGroup) predicate.PlaylistRuleGroup {
+	return predicate.PlaylistRuleGroup(func(s *sql.Selector) {
+		step := newChildrenStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.PlaylistRuleGroup) predicate.PlaylistRuleGroup {
 	return predicate.PlaylistRuleGroup(sql.AndPredicates(predicates...))
@@ -50,6 +50,34 @@ func (_c *PlaylistRuleGroupCreate) SetNillablePosition(v *int) *PlaylistRuleGrou
 	return _c
 }
 
+// SetNegate sets the "negate" field.
+func (_c *PlaylistRuleGroupCreate) SetNegate(v bool) *PlaylistRuleGroupCreate {
+	_c.mutation.SetNegate(v)
+	return _c
+}
+
+// SetNillableNegate sets the "negate" field if the given value is not nil.
+func (_c *PlaylistRuleGroupCreate) SetNillableNegate(v *bool) *PlaylistRuleGroupCreate {
+	if v != nil {
+		_c.SetNegate(*v)
+	}
+	return _c
+}
+
+// SetParentID sets the "parent_id" field.
+func (_c *PlaylistRuleGroupCreate) SetParentID(v uuid.UUID) *PlaylistRuleGroupCreate {
+	_c.mutation.SetParentID(v)
+	return _c
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (_c *PlaylistRuleGroupCreate) SetNillableParentID(v *uuid.UUID) *PlaylistRuleGroupCreate {
+	if v != nil {
+		_c.SetParentID(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *PlaylistRuleGroupCreate) SetID(v uuid.UUID) *PlaylistRuleGroupCreate {
 	_c.mutation.SetID(v)
@@ -90,6 +118,26 @@ func (_c *PlaylistRuleGroupCreate) AddRules(v ...*PlaylistRule) *PlaylistRuleGro
 	return _c.AddRuleIDs(ids...)
 }
 
+// SetParent sets the "parent" edge to the PlaylistRuleGroup entity.
+func (_c *PlaylistRuleGroupCreate) SetParent(v *PlaylistRuleGroup) *PlaylistRuleGroupCreate {
+	return _c.SetParentID(v.ID)
+}
+
+// AddChildIDs adds the "children" edge to the PlaylistRuleGroup entity by IDs.
+func (_c *PlaylistRuleGroupCreate) AddChildIDs(ids ...uuid.UUID) *PlaylistRuleGroupCreate {
+	_c.mutation.AddChildIDs(ids...)
+	return _c
+}
+
+// AddChildren adds the "children" edges to the PlaylistRuleGroup entity.
+func (_c *PlaylistRuleGroupCreate) AddChildren(v ...*PlaylistRuleGroup) *PlaylistRuleGroupCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddChildIDs(ids...)
+}
+
 // Mutation returns the PlaylistRuleGroupMutation object of the builder.
 func (_c *PlaylistRuleGroupCreate) Mutation() *PlaylistRuleGroupMutation {
 	return _c.mutation
@@ -133,6 +181,10 @@ func (_c *PlaylistRuleGroupCreate) defaults() {
 		v := playlistrulegroup.DefaultPosition
 		_c.mutation.SetPosition(v)
 	}
+	if _, ok := _c.mutation.Negate(); !ok {
+		v := playlistrulegroup.DefaultNegate
+		_c.mutation.SetNegate(v)
+	}
 	if _, ok := _c.mutation.ID(); !ok {
 		v := playlistrulegroup.DefaultID()
 		_c.mutation.SetID(v)
@@ -152,6 +204,9 @@ func (_c *PlaylistRuleGroupCreate) check() error {
 	if _, ok := _c.mutation.Position(); !ok {
 		return &ValidationError{Name: "position", err: errors.New(`ent: missing required field "PlaylistRuleGroup.position"`)}
 	}
+	if _, ok := _c.mutation.Negate(); !ok {
+		return &ValidationError{Name: "negate", err: errors.New(`ent: missing required field "PlaylistRuleGroup.negate"`)}
+	}
 	if len(_c.mutation.PlaylistIDs()) == 0 {
 		return &ValidationError{Name: "playlist", err: errors.New(`ent: missing required edge "PlaylistRuleGroup.playlist"`)}
 	}
@@ -198,6 +253,10 @@ func (_c *PlaylistRuleGroupCreate) createSpec() (*PlaylistRuleGroup, *sqlgraph.C
 		_spec.SetField(playlistrulegroup.FieldPosition, field.TypeInt, value)
 		_node.Position = value
 	}
+	if value, ok := _c.mutation.Negate(); ok {
+		_spec.SetField(playlistrulegroup.FieldNegate, field.TypeBool, value)
+		_node.Negate = value
+	}
 	if nodes := _c.mutation.PlaylistIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -231,6 +290,39 @@ func (_c *PlaylistRuleGroupCreate) createSpec() (*PlaylistRuleGroup, *sqlgraph.C
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   playlistrulegroup.ParentTable,
+			Columns: []string{playlistrulegroup.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlistrulegroup.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ParentID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   playlistrulegroup.ChildrenTable,
+			Columns: []string{playlistrulegroup.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlistrulegroup.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -28,6 +28,8 @@ type PlaylistRuleGroupQuery struct {
 	predicates   []predicate.PlaylistRuleGroup
 	withPlaylist *PlaylistQuery
 	withRules    *PlaylistRuleQuery
+	withParent   *PlaylistRuleGroupQuery
+	withChildren *PlaylistRuleGroupQuery
 	withFKs      bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -109,6 +111,50 @@ func (_q *PlaylistRuleGroupQuery) QueryRules() *PlaylistRuleQuery {
 	return query
 }
 
+// QueryParent chains the current query on the "parent" edge.
+func (_q *PlaylistRuleGroupQuery) QueryParent() *PlaylistRuleGroupQuery {
+	query := (&PlaylistRuleGroupClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(playlistrulegroup.Table, playlistrulegroup.FieldID, selector),
+			sqlgraph.To(playlistrulegroup.Table, playlistrulegroup.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, playlistrulegroup.ParentTable, playlistrulegroup.ParentColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryChildren chains the current query on the "children" edge.
+func (_q *PlaylistRuleGroupQuery) QueryChildren() *PlaylistRuleGroupQuery {
+	query := (&PlaylistRuleGroupClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(playlistrulegroup.Table, playlistrulegroup.FieldID, selector),
+			sqlgraph.To(playlistrulegroup.Table, playlistrulegroup.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, playlistrulegroup.ChildrenTable, playlistrulegroup.ChildrenColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first PlaylistRuleGroup entity from the query.
 // Returns a *NotFoundError when no PlaylistRuleGroup was found.
 func (_q *PlaylistRuleGroupQuery) First(ctx context.Context) (*PlaylistRuleGroup, error) {
@@ -303,6 +349,8 @@ func (_q *PlaylistRuleGroupQuery) Clone() *PlaylistRuleGroupQuery {
 		predicates:   append([]predicate.PlaylistRuleGroup{}, _q.predicates...),
 		withPlaylist: _q.withPlaylist.Clone(),
 		withRules:    _q.withRules.Clone(),
+		withParent:   _q.withParent.Clone(),
+		withChildren: _q.withChildren.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -331,6 +379,28 @@ func (_q *PlaylistRuleGroupQuery) WithRules(opts ...func(*PlaylistRuleQuery)) *P
 	return _q
 }
 
+// WithParent tells the query-builder to eager-load the nodes that are connected to
+// the "parent" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *PlaylistRuleGroupQuery) WithParent(opts ...func(*PlaylistRuleGroupQuery)) *PlaylistRuleGroupQuery {
+	query := (&PlaylistRuleGroupClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withParent = query
+	return _q
+}
+
+// WithChildren tells the query-builder to eager-load the nodes that are connected to
+// the "children" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *PlaylistRuleGroupQuery) WithChildren(opts ...func(*PlaylistRuleGroupQuery)) *PlaylistRuleGroupQuery {
+	query := (&PlaylistRuleGroupClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withChildren = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -410,9 +480,11 @@ func (_q *PlaylistRuleGroupQuery) sqlAll(ctx context.Context, hooks ...queryHook
 		nodes       = []*PlaylistRuleGroup{}
 		withFKs     = _q.withFKs
 		_spec       = _q.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [4]bool{
 			_q.withPlaylist != nil,
 			_q.withRules != nil,
+			_q.withParent != nil,
+			_q.withChildren != nil,
 		}
 	)
 	if _q.withPlaylist != nil {
@@ -452,6 +524,19 @@ func (_q *PlaylistRuleGroupQuery) sqlAll(ctx context.Context, hooks ...queryHook
 			return nil, err
 		}
 	}
+	if query := _q.withParent; query != nil {
+		if err := _q.loadParent(ctx, query, nodes, nil,
+			func(n *PlaylistRuleGroup, e *PlaylistRuleGroup) { n.Edges.Parent = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withChildren; query != nil {
+		if err := _q.loadChildren(ctx, query, nodes,
+			func(n *PlaylistRuleGroup) { n.Edges.Children = []*PlaylistRuleGroup{} },
+			func(n *PlaylistRuleGroup, e *PlaylistRuleGroup) { n.Edges.Children = append(n.Edges.Children, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -518,6 +603,72 @@ func (_q *PlaylistRuleGroupQuery) loadRules(ctx context.Context, query *Playlist
 	}
 	return nil
 }
+func (_q *PlaylistRuleGroupQuery) loadParent(ctx context.Context, query *PlaylistRuleGroupQuery, nodes []*PlaylistRuleGroup, init func(*PlaylistRuleGroup), assign func(*PlaylistRuleGroup, *PlaylistRuleGroup)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*PlaylistRuleGroup)
+	for i := range nodes {
+		if nodes[i].ParentID == nil {
+			continue
+		}
+		fk := *nodes[i].ParentID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(playlistrulegroup.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "parent_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *PlaylistRuleGroupQuery) loadChildren(ctx context.Context, query *PlaylistRuleGroupQuery, nodes []*PlaylistRuleGroup, init func(*PlaylistRuleGroup), assign func(*PlaylistRuleGroup, *PlaylistRuleGroup)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*PlaylistRuleGroup)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(playlistrulegroup.FieldParentID)
+	}
+	query.Where(predicate.PlaylistRuleGroup(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(playlistrulegroup.ChildrenColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ParentID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "parent_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "parent_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *PlaylistRuleGroupQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -544,6 +695,9 @@ func (_q *PlaylistRuleGroupQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if _q.withParent != nil {
+			_spec.Node.AddColumnOnce(playlistrulegroup.FieldParentID)
+		}
 	}
 	if ps := _q.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -65,6 +65,40 @@ func (_u *PlaylistRuleGroupUpdate) AddPosition(v int) *PlaylistRuleGroupUpdate {
 	return _u
 }
 
+// SetNegate sets the "negate" field.
+func (_u *PlaylistRuleGroupUpdate) SetNegate(v bool) *PlaylistRuleGroupUpdate {
+	_u.mutation.SetNegate(v)
+	return _u
+}
+
+// SetNillableNegate sets the "negate" field if the given value is not nil.
+func (_u *PlaylistRuleGroupUpdate) SetNillableNegate(v *bool) *PlaylistRuleGroupUpdate {
+	if v != nil {
+		_u.SetNegate(*v)
+	}
+	return _u
+}
+
+// SetParentID sets the "parent_id" field.
+func (_u *PlaylistRuleGroupUpdate) SetParentID(v uuid.UUID) *PlaylistRuleGroupUpdate {
+	_u.mutation.SetParentID(v)
+	return _u
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (_u *PlaylistRuleGroupUpdate) SetNillableParentID(v *uuid.UUID) *PlaylistRuleGroupUpdate {
+	if v != nil {
+		_u.SetParentID(*v)
+	}
+	return _u
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (_u *PlaylistRuleGroupUpdate) ClearParentID() *PlaylistRuleGroupUpdate {
+	_u.mutation.ClearParentID()
+	return _u
+}
+
 // SetPlaylistID sets the "playlist" edge to the Playlist entity by ID.
 func (_u *PlaylistRuleGroupUpdate) SetPlaylistID(id uuid.UUID) *PlaylistRuleGroupUpdate {
 	_u.mutation.SetPlaylistID(id)
@@ -91,6 +125,26 @@ func (_u *PlaylistRuleGroupUpdate) AddRules(v ...*PlaylistRule) *PlaylistRuleGro
 	return _u.AddRuleIDs(ids...)
 }
 
+// SetParent sets the "parent" edge to the PlaylistRuleGroup entity.
+func (_u *PlaylistRuleGroupUpdate) SetParent(v *PlaylistRuleGroup) *PlaylistRuleGroupUpdate {
+	return _u.SetParentID(v.ID)
+}
+
+// AddChildIDs adds the "children" edge to the PlaylistRuleGroup entity by IDs.
+func (_u *PlaylistRuleGroupUpdate) AddChildIDs(ids ...uuid.UUID) *PlaylistRuleGroupUpdate {
+	_u.mutation.AddChildIDs(ids...)
+	return _u
+}
+
+// AddChildren adds the "children" edges to the PlaylistRuleGroup entity.
+func (_u *PlaylistRuleGroupUpdate) AddChildren(v ...*PlaylistRuleGroup) *PlaylistRuleGroupUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddChildIDs(ids...)
+}
+
 // Mutation returns the PlaylistRuleGroupMutation object of the builder.
 func (_u *PlaylistRuleGroupUpdate) Mutation() *PlaylistRuleGroupMutation {
 	return _u.mutation
@@ -123,6 +177,33 @@ func (_u *PlaylistRuleGroupUpdate) RemoveRules(v ...*PlaylistRule) *PlaylistRule
 	return _u.RemoveRuleIDs(ids...)
 }
 
+// ClearParent clears the "parent" edge to the PlaylistRuleGroup entity.
+func (_u *PlaylistRuleGroupUpdate) ClearParent() *PlaylistRuleGroupUpdate {
+	_u.mutation.ClearParent()
+	return _u
+}
+
+// ClearChildren clears all "children" edges to the PlaylistRuleGroup entity.
+func (_u *PlaylistRuleGroupUpdate) ClearChildren() *PlaylistRuleGroupUpdate {
+	_u.mutation.ClearChildren()
+	return _u
+}
+
+// RemoveChildIDs removes the "children" edge to PlaylistRuleGroup entities by IDs.
+func (_u *PlaylistRuleGroupUpdate) RemoveChildIDs(ids ...uuid.UUID) *PlaylistRuleGroupUpdate {
+	_u.mutation.RemoveChildIDs(ids...)
+	return _u
+}
+
+// RemoveChildren removes "children" edges to PlaylistRuleGroup entities.
+func (_u *PlaylistRuleGroupUpdate) RemoveChildren(v ...*PlaylistRuleGroup) *PlaylistRuleGroupUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveChildIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *PlaylistRuleGroupUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -184,6 +265,9 @@ func (_u *PlaylistRuleGroupUpdate) sqlSave(ctx context.Context) (_node int, err
 	if value, ok := _u.mutation.AddedPosition(); ok {
 		_spec.AddField(playlistrulegroup.FieldPosition, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.Negate(); ok {
+		_spec.SetField(playlistrulegroup.FieldNegate, field.TypeBool, value)
+	}
 	if _u.mutation.PlaylistCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -258,6 +342,80 @@ func (_u *PlaylistRuleGroupUpdate) sqlSave(ctx context.Context) (_node int, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   playlistrulegroup.ParentTable,
+			Columns: []string{playlistrulegroup.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlistrulegroup.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   playlistrulegroup.ParentTable,
+			Columns: []string{playlistrulegroup.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlistrulegroup.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   playlistrulegroup.ChildrenTable,
+			Columns: []string{playlistrulegroup.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlistrulegroup.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedChildrenIDs(); len(nodes) > 0 && !_u.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   playlistrulegroup.ChildrenTable,
+			Columns: []string{playlistrulegroup.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlistrulegroup.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   playlistrulegroup.ChildrenTable,
+			Columns: []string{playlistrulegroup.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlistrulegroup.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{playlistrulegroup.Label}
@@ -313,6 +471,40 @@ func (_u *PlaylistRuleGroupUpdateOne) AddPosition(v int) *PlaylistRuleGroupUpdat
 	return _u
 }
 
+// SetNegate sets the "negate" field.
+func (_u *PlaylistRuleGroupUpdateOne) SetNegate(v bool) *PlaylistRuleGroupUpdateOne {
+	_u.mutation.SetNegate(v)
+	return _u
+}
+
+// SetNillableNegate sets the "negate" field if the given value is not nil.
+func (_u *PlaylistRuleGroupUpdateOne) SetNillableNegate(v *bool) *PlaylistRuleGroupUpdateOne {
+	if v != nil {
+		_u.SetNegate(*v)
+	}
+	return _u
+}
+
+// SetParentID sets the "parent_id" field.
+func (_u *PlaylistRuleGroupUpdateOne) SetParentID(v uuid.UUID) *PlaylistRuleGroupUpdateOne {
+	_u.mutation.SetParentID(v)
+	return _u
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (_u *PlaylistRuleGroupUpdateOne) SetNillableParentID(v *uuid.UUID) *PlaylistRuleGroupUpdateOne {
+	if v != nil {
+		_u.SetParentID(*v)
+	}
+	return _u
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (_u *PlaylistRuleGroupUpdateOne) ClearParentID() *PlaylistRuleGroupUpdateOne {
+	_u.mutation.ClearParentID()
+	return _u
+}
+
 // SetPlaylistID sets the "playlist" edge to the Playlist entity by ID.
 func (_u *PlaylistRuleGroupUpdateOne) SetPlaylistID(id uuid.UUID) *PlaylistRuleGroupUpdateOne {
 	_u.mutation.SetPlaylistID(id)
@@ -339,6 +531,26 @@ func (_u *PlaylistRuleGroupUpdateOne) AddRules(v ...*PlaylistRule) *PlaylistRule
 	return _u.AddRuleIDs(ids...)
 }
 
+// SetParent sets the "parent" edge to the PlaylistRuleGroup entity.
+func (_u *PlaylistRuleGroupUpdateOne) SetParent(v *PlaylistRuleGroup) *PlaylistRuleGroupUpdateOne {
+	return _u.SetParentID(v.ID)
+}
+
+// AddChildIDs adds the "children" edge to the PlaylistRuleGroup entity by IDs.
+func (_u *PlaylistRuleGroupUpdateOne) AddChildIDs(ids ...uuid.UUID) *PlaylistRuleGroupUpdateOne {
+	_u.mutation.AddChildIDs(ids...)
+	return _u
+}
+
+// AddChildren adds the "children" edges to the PlaylistRuleGroup entity.
+func (_u *PlaylistRuleGroupUpdateOne) AddChildren(v ...*PlaylistRuleGroup) *PlaylistRuleGroupUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddChildIDs(ids...)
+}
+
 // Mutation returns the PlaylistRuleGroupMutation object of the builder.
 func (_u *PlaylistRuleGroupUpdateOne) Mutation() *PlaylistRuleGroupMutation {
 	return _u.mutation
@@ -371,6 +583,33 @@ func (_u *PlaylistRuleGroupUpdateOne) RemoveRules(v ...*PlaylistRule) *PlaylistR
 	return _u.RemoveRuleIDs(ids...)
 }
 
+// ClearParent clears the "parent" edge to the PlaylistRuleGroup entity.
+func (_u *PlaylistRuleGroupUpdateOne) ClearParent() *PlaylistRuleGroupUpdateOne {
+	_u.mutation.ClearParent()
+	return _u
+}
+
+// ClearChildren clears all "children" edges to the PlaylistRuleGroup entity.
+func (_u *PlaylistRuleGroupUpdateOne) ClearChildren() *PlaylistRuleGroupUpdateOne {
+	_u.mutation.ClearChildren()
+	return _u
+}
+
+// RemoveChildIDs removes the "children" edge to PlaylistRuleGroup entities by IDs.
+func (_u *PlaylistRuleGroupUpdateOne) RemoveChildIDs(ids ...uuid.UUID) *PlaylistRuleGroupUpdateOne {
+	_u.mutation.RemoveChildIDs(ids...)
+	return _u
+}
+
+// RemoveChildren removes "children" edges to PlaylistRuleGroup entities.
+func (_u *PlaylistRuleGroupUpdateOne) RemoveChildren(v ...*PlaylistRuleGroup) *PlaylistRuleGroupUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveChildIDs(ids...)
+}
+
 // Where appends a list predicates to the PlaylistRuleGroupUpdate builder.
 func (_u *PlaylistRuleGroupUpdateOne) Where(ps ...predicate.PlaylistRuleGroup) *PlaylistRuleGroupUpdateOne {
 	_u.mutation.Where(ps...)
@@ -462,6 +701,9 @@ func (_u *PlaylistRuleGroupUpdateOne) sqlSave(ctx context.Context) (_node *Playl
 	if value, ok := _u.mutation.AddedPosition(); ok {
 		_spec.AddField(playlistrulegroup.FieldPosition, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.Negate(); ok {
+		_spec.SetField(playlistrulegroup.FieldNegate, field.TypeBool, value)
+	}
 	if _u.mutation.PlaylistCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -536,6 +778,80 @@ func (_u *PlaylistRuleGroupUpdateOne) sqlSave(ctx context.Context) (_node *Playl
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   playlistrulegroup.ParentTable,
+			Columns: []string{playlistrulegroup.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlistrulegroup.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   playlistrulegroup.ParentTable,
+			Columns: []string{playlistrulegroup.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlistrulegroup.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   playlistrulegroup.ChildrenTable,
+			Columns: []string{playlistrulegroup.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlistrulegroup.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedChildrenIDs(); len(nodes) > 0 && !_u.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   playlistrulegroup.ChildrenTable,
+			Columns: []string{playlistrulegroup.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlistrulegroup.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   playlistrulegroup.ChildrenTable,
+			Columns: []string{playlistrulegroup.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlistrulegroup.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &PlaylistRuleGroup{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,181 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/playlist"
+	"github.com/zibbp/ganymede/ent/playlistsection"
+)
+
+// PlaylistSection is the model entity for the PlaylistSection schema.
+type PlaylistSection struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Order of the section in the playlist.
+	Position int `json:"position,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PlaylistSectionQuery when eager-loading is set.
+	Edges             PlaylistSectionEdges `json:"edges"`
+	playlist_sections *uuid.UUID
+	selectValues      sql.SelectValues
+}
+
+// PlaylistSectionEdges holds the relations/edges for other nodes in the graph.
+type PlaylistSectionEdges struct {
+	// Playlist holds the value of the playlist edge.
+	Playlist *Playlist `json:"playlist,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// PlaylistOrErr returns the Playlist value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PlaylistSectionEdges) PlaylistOrErr() (*Playlist, error) {
+	if e.Playlist != nil {
+		return e.Playlist, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: playlist.Label}
+	}
+	return nil, &NotLoadedError{edge: "playlist"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PlaylistSection) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case playlistsection.FieldPosition:
+			values[i] = new(sql.NullInt64)
+		case playlistsection.FieldName:
+			values[i] = new(sql.NullString)
+		case playlistsection.FieldUpdatedAt, playlistsection.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case playlistsection.FieldID:
+			values[i] = new(uuid.UUID)
+		case playlistsection.ForeignKeys[0]: // playlist_sections
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PlaylistSection fields.
+func (_m *PlaylistSection) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case playlistsection.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case playlistsection.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case playlistsection.FieldPosition:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field position", values[i])
+			} else if value.Valid {
+				_m.Position = int(value.Int64)
+			}
+		case playlistsection.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case playlistsection.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case playlistsection.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field playlist_sections", values[i])
+			} else if value.Valid {
+				_m.playlist_sections = new(uuid.UUID)
+				*_m.playlist_sections = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PlaylistSection.
+// This includes values selected through modifiers, order, etc.
+func (_m *PlaylistSection) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryPlaylist queries the "playlist" edge of the PlaylistSection entity.
+func (_m *PlaylistSection) QueryPlaylist() *PlaylistQuery {
+	return NewPlaylistSectionClient(_m.config).QueryPlaylist(_m)
+}
+
+// Update returns a builder for updating this PlaylistSection.
+// Note that you need to call PlaylistSection.Unwrap() before calling this method if this PlaylistSection
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *PlaylistSection) Update() *PlaylistSectionUpdateOne {
+	return NewPlaylistSectionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the PlaylistSection entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *PlaylistSection) Unwrap() *PlaylistSection {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PlaylistSection is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *PlaylistSection) String() string {
+	var builder strings.Builder
+	builder.WriteString("PlaylistSection(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("position=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Position))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PlaylistSections is a parsable slice of PlaylistSection.
+type PlaylistSections []*PlaylistSection
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/zibbp/ganymede/ent/playlistsection"
+	"github.com/zibbp/ganymede/ent/predicate"
+)
+
+// PlaylistSectionDelete is the builder for deleting a PlaylistSection entity.
+type PlaylistSectionDelete struct {
+	config
+	hooks    []Hook
+	mutation *PlaylistSectionMutation
+}
+
+// Where appends a list predicates to the PlaylistSectionDelete builder.
+func (_d *PlaylistSectionDelete) Where(ps ...predicate.PlaylistSection) *PlaylistSectionDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *PlaylistSectionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *PlaylistSectionDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *PlaylistSectionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(playlistsection.Table, sqlgraph.NewFieldSpec(playlistsection.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// PlaylistSectionDeleteOne is the builder for deleting a single PlaylistSection entity.
+type PlaylistSectionDeleteOne struct {
+	_d *PlaylistSectionDelete
+}
+
+// Where appends a list predicates to the PlaylistSectionDelete builder.
+func (_d *PlaylistSectionDeleteOne) Where(ps ...predicate.PlaylistSection) *PlaylistSectionDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *PlaylistSectionDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{playlistsection.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *PlaylistSectionDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
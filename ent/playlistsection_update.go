@@ -0,0 +1,426 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/playlist"
+	"github.com/zibbp/ganymede/ent/playlistsection"
+	"github.com/zibbp/ganymede/ent/predicate"
+)
+
+// PlaylistSectionUpdate is the builder for updating PlaylistSection entities.
+type PlaylistSectionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PlaylistSectionMutation
+}
+
+// Where appends a list predicates to the PlaylistSectionUpdate builder.
+func (_u *PlaylistSectionUpdate) Where(ps ...predicate.PlaylistSection) *PlaylistSectionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *PlaylistSectionUpdate) SetName(v string) *PlaylistSectionUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *PlaylistSectionUpdate) SetNillableName(v *string) *PlaylistSectionUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetPosition sets the "position" field.
+func (_u *PlaylistSectionUpdate) SetPosition(v int) *PlaylistSectionUpdate {
+	_u.mutation.ResetPosition()
+	_u.mutation.SetPosition(v)
+	return _u
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_u *PlaylistSectionUpdate) SetNillablePosition(v *int) *PlaylistSectionUpdate {
+	if v != nil {
+		_u.SetPosition(*v)
+	}
+	return _u
+}
+
+// AddPosition adds value to the "position" field.
+func (_u *PlaylistSectionUpdate) AddPosition(v int) *PlaylistSectionUpdate {
+	_u.mutation.AddPosition(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PlaylistSectionUpdate) SetUpdatedAt(v time.Time) *PlaylistSectionUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetPlaylistID sets the "playlist" edge to the Playlist entity by ID.
+func (_u *PlaylistSectionUpdate) SetPlaylistID(id uuid.UUID) *PlaylistSectionUpdate {
+	_u.mutation.SetPlaylistID(id)
+	return _u
+}
+
+// SetPlaylist sets the "playlist" edge to the Playlist entity.
+func (_u *PlaylistSectionUpdate) SetPlaylist(v *Playlist) *PlaylistSectionUpdate {
+	return _u.SetPlaylistID(v.ID)
+}
+
+// Mutation returns the PlaylistSectionMutation object of the builder.
+func (_u *PlaylistSectionUpdate) Mutation() *PlaylistSectionMutation {
+	return _u.mutation
+}
+
+// ClearPlaylist clears the "playlist" edge to the Playlist entity.
+func (_u *PlaylistSectionUpdate) ClearPlaylist() *PlaylistSectionUpdate {
+	_u.mutation.ClearPlaylist()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PlaylistSectionUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PlaylistSectionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PlaylistSectionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PlaylistSectionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PlaylistSectionUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := playlistsection.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PlaylistSectionUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := playlistsection.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "PlaylistSection.name": %w`, err)}
+		}
+	}
+	if _u.mutation.PlaylistCleared() && len(_u.mutation.PlaylistIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PlaylistSection.playlist"`)
+	}
+	return nil
+}
+
+func (_u *PlaylistSectionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(playlistsection.Table, playlistsection.Columns, sqlgraph.NewFieldSpec(playlistsection.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(playlistsection.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Position(); ok {
+		_spec.SetField(playlistsection.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPosition(); ok {
+		_spec.AddField(playlistsection.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(playlistsection.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.PlaylistCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   playlistsection.PlaylistTable,
+			Columns: []string{playlistsection.PlaylistColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlist.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PlaylistIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   playlistsection.PlaylistTable,
+			Columns: []string{playlistsection.PlaylistColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlist.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{playlistsection.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PlaylistSectionUpdateOne is the builder for updating a single PlaylistSection entity.
+type PlaylistSectionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PlaylistSectionMutation
+}
+
+// SetName sets the "name" field.
+func (_u *PlaylistSectionUpdateOne) SetName(v string) *PlaylistSectionUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *PlaylistSectionUpdateOne) SetNillableName(v *string) *PlaylistSectionUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetPosition sets the "position" field.
+func (_u *PlaylistSectionUpdateOne) SetPosition(v int) *PlaylistSectionUpdateOne {
+	_u.mutation.ResetPosition()
+	_u.mutation.SetPosition(v)
+	return _u
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_u *PlaylistSectionUpdateOne) SetNillablePosition(v *int) *PlaylistSectionUpdateOne {
+	if v != nil {
+		_u.SetPosition(*v)
+	}
+	return _u
+}
+
+// AddPosition adds value to the "position" field.
+func (_u *PlaylistSectionUpdateOne) AddPosition(v int) *PlaylistSectionUpdateOne {
+	_u.mutation.AddPosition(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PlaylistSectionUpdateOne) SetUpdatedAt(v time.Time) *PlaylistSectionUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetPlaylistID sets the "playlist" edge to the Playlist entity by ID.
+func (_u *PlaylistSectionUpdateOne) SetPlaylistID(id uuid.UUID) *PlaylistSectionUpdateOne {
+	_u.mutation.SetPlaylistID(id)
+	return _u
+}
+
+// SetPlaylist sets the "playlist" edge to the Playlist entity.
+func (_u *PlaylistSectionUpdateOne) SetPlaylist(v *Playlist) *PlaylistSectionUpdateOne {
+	return _u.SetPlaylistID(v.ID)
+}
+
+// Mutation returns the PlaylistSectionMutation object of the builder.
+func (_u *PlaylistSectionUpdateOne) Mutation() *PlaylistSectionMutation {
+	return _u.mutation
+}
+
+// ClearPlaylist clears the "playlist" edge to the Playlist entity.
+func (_u *PlaylistSectionUpdateOne) ClearPlaylist() *PlaylistSectionUpdateOne {
+	_u.mutation.ClearPlaylist()
+	return _u
+}
+
+// Where appends a list predicates to the PlaylistSectionUpdate builder.
+func (_u *PlaylistSectionUpdateOne) Where(ps ...predicate.PlaylistSection) *PlaylistSectionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PlaylistSectionUpdateOne) Select(field string, fields ...string) *PlaylistSectionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PlaylistSection entity.
+func (_u *PlaylistSectionUpdateOne) Save(ctx context.Context) (*PlaylistSection, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PlaylistSectionUpdateOne) SaveX(ctx context.Context) *PlaylistSection {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PlaylistSectionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PlaylistSectionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PlaylistSectionUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := playlistsection.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PlaylistSectionUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := playlistsection.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "PlaylistSection.name": %w`, err)}
+		}
+	}
+	if _u.mutation.PlaylistCleared() && len(_u.mutation.PlaylistIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PlaylistSection.playlist"`)
+	}
+	return nil
+}
+
+func (_u *PlaylistSectionUpdateOne) sqlSave(ctx context.Context) (_node *PlaylistSection, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(playlistsection.Table, playlistsection.Columns, sqlgraph.NewFieldSpec(playlistsection.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PlaylistSection.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, playlistsection.FieldID)
+		for _, f := range fields {
+			if !playlistsection.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != playlistsection.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(playlistsection.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Position(); ok {
+		_spec.SetField(playlistsection.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPosition(); ok {
+		_spec.AddField(playlistsection.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(playlistsection.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.PlaylistCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   playlistsection.PlaylistTable,
+			Columns: []string{playlistsection.PlaylistColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlist.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PlaylistIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   playlistsection.PlaylistTable,
+			Columns: []string{playlistsection.PlaylistColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlist.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &PlaylistSection{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{playlistsection.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,181 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/playlist"
+	"github.com/zibbp/ganymede/ent/playlistvod"
+	"github.com/zibbp/ganymede/ent/vod"
+)
+
+// PlaylistVod is the model entity for the PlaylistVod schema.
+type PlaylistVod struct {
+	config `json:"-"`
+	// PlaylistID holds the value of the "playlist_id" field.
+	PlaylistID uuid.UUID `json:"playlist_id,omitempty"`
+	// VodID holds the value of the "vod_id" field.
+	VodID uuid.UUID `json:"vod_id,omitempty"`
+	// Order of the vod in the playlist.
+	Position int `json:"position,omitempty"`
+	// Section of the playlist the vod is in, cleared when the section is deleted.
+	SectionID *uuid.UUID `json:"section_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PlaylistVodQuery when eager-loading is set.
+	Edges        PlaylistVodEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PlaylistVodEdges holds the relations/edges for other nodes in the graph.
+type PlaylistVodEdges struct {
+	// Playlist holds the value of the playlist edge.
+	Playlist *Playlist `json:"playlist,omitempty"`
+	// Vod holds the value of the vod edge.
+	Vod *Vod `json:"vod,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// PlaylistOrErr returns the Playlist value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PlaylistVodEdges) PlaylistOrErr() (*Playlist, error) {
+	if e.Playlist != nil {
+		return e.Playlist, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: playlist.Label}
+	}
+	return nil, &NotLoadedError{edge: "playlist"}
+}
+
+// VodOrErr returns the Vod value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PlaylistVodEdges) VodOrErr() (*Vod, error) {
+	if e.Vod != nil {
+		return e.Vod, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: vod.Label}
+	}
+	return nil, &NotLoadedError{edge: "vod"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PlaylistVod) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case playlistvod.FieldSectionID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case playlistvod.FieldPosition:
+			values[i] = new(sql.NullInt64)
+		case playlistvod.FieldPlaylistID, playlistvod.FieldVodID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PlaylistVod fields.
+func (_m *PlaylistVod) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case playlistvod.FieldPlaylistID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field playlist_id", values[i])
+			} else if value != nil {
+				_m.PlaylistID = *value
+			}
+		case playlistvod.FieldVodID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field vod_id", values[i])
+			} else if value != nil {
+				_m.VodID = *value
+			}
+		case playlistvod.FieldPosition:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field position", values[i])
+			} else if value.Valid {
+				_m.Position = int(value.Int64)
+			}
+		case playlistvod.FieldSectionID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field section_id", values[i])
+			} else if value.Valid {
+				_m.SectionID = new(uuid.UUID)
+				*_m.SectionID = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PlaylistVod.
+// This includes values selected through modifiers, order, etc.
+func (_m *PlaylistVod) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryPlaylist queries the "playlist" edge of the PlaylistVod entity.
+func (_m *PlaylistVod) QueryPlaylist() *PlaylistQuery {
+	return NewPlaylistVodClient(_m.config).QueryPlaylist(_m)
+}
+
+// QueryVod queries the "vod" edge of the PlaylistVod entity.
+func (_m *PlaylistVod) QueryVod() *VodQuery {
+	return NewPlaylistVodClient(_m.config).QueryVod(_m)
+}
+
+// Update returns a builder for updating this PlaylistVod.
+// Note that you need to call PlaylistVod.Unwrap() before calling this method if this PlaylistVod
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *PlaylistVod) Update() *PlaylistVodUpdateOne {
+	return NewPlaylistVodClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the PlaylistVod entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *PlaylistVod) Unwrap() *PlaylistVod {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PlaylistVod is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *PlaylistVod) String() string {
+	var builder strings.Builder
+	builder.WriteString("PlaylistVod(")
+	builder.WriteString("playlist_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PlaylistID))
+	builder.WriteString(", ")
+	builder.WriteString("vod_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.VodID))
+	builder.WriteString(", ")
+	builder.WriteString("position=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Position))
+	builder.WriteString(", ")
+	if v := _m.SectionID; v != nil {
+		builder.WriteString("section_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PlaylistVods is a parsable slice of PlaylistVod.
+type PlaylistVods []*PlaylistVod
@@ -0,0 +1,119 @@
+// Code generated by ent, DO NOT EDIT.
+
+package playlistvod
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the playlistvod type in the database.
+	Label = "playlist_vod"
+	// FieldPlaylistID holds the string denoting the playlist_id field in the database.
+	FieldPlaylistID = "playlist_id"
+	// FieldVodID holds the string denoting the vod_id field in the database.
+	FieldVodID = "vod_id"
+	// FieldPosition holds the string denoting the position field in the database.
+	FieldPosition = "position"
+	// FieldSectionID holds the string denoting the section_id field in the database.
+	FieldSectionID = "section_id"
+	// EdgePlaylist holds the string denoting the playlist edge name in mutations.
+	EdgePlaylist = "playlist"
+	// EdgeVod holds the string denoting the vod edge name in mutations.
+	EdgeVod = "vod"
+	// PlaylistFieldID holds the string denoting the ID field of the Playlist.
+	PlaylistFieldID = "id"
+	// VodFieldID holds the string denoting the ID field of the Vod.
+	VodFieldID = "id"
+	// Table holds the table name of the playlistvod in the database.
+	Table = "playlist_vods"
+	// PlaylistTable is the table that holds the playlist relation/edge.
+	PlaylistTable = "playlist_vods"
+	// PlaylistInverseTable is the table name for the Playlist entity.
+	// It exists in this package in order to avoid circular dependency with the "playlist" package.
+	PlaylistInverseTable = "playlists"
+	// PlaylistColumn is the table column denoting the playlist relation/edge.
+	PlaylistColumn = "playlist_id"
+	// VodTable is the table that holds the vod relation/edge.
+	VodTable = "playlist_vods"
+	// VodInverseTable is the table name for the Vod entity.
+	// It exists in this package in order to avoid circular dependency with the "vod" package.
+	VodInverseTable = "vods"
+	// VodColumn is the table column denoting the vod relation/edge.
+	VodColumn = "vod_id"
+)
+
+// Columns holds all SQL columns for playlistvod fields.
+var Columns = []string{
+	FieldPlaylistID,
+	FieldVodID,
+	FieldPosition,
+	FieldSectionID,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultPosition holds the default value on creation for the "position" field.
+	DefaultPosition int
+)
+
+// OrderOption defines the ordering options for the PlaylistVod queries.
+type OrderOption func(*sql.Selector)
+
+// ByPlaylistID orders the results by the playlist_id field.
+func ByPlaylistID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPlaylistID, opts...).ToFunc()
+}
+
+// ByVodID orders the results by the vod_id field.
+func ByVodID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVodID, opts...).ToFunc()
+}
+
+// ByPosition orders the results by the position field.
+func ByPosition(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPosition, opts...).ToFunc()
+}
+
+// BySectionID orders the results by the section_id field.
+func BySectionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSectionID, opts...).ToFunc()
+}
+
+// ByPlaylistField orders the results by playlist field.
+func ByPlaylistField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPlaylistStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByVodField orders the results by vod field.
+func ByVodField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newVodStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newPlaylistStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, PlaylistColumn),
+		sqlgraph.To(PlaylistInverseTable, PlaylistFieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, PlaylistTable, PlaylistColumn),
+	)
+}
+func newVodStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, VodColumn),
+		sqlgraph.To(VodInverseTable, VodFieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, VodTable, VodColumn),
+	)
+}
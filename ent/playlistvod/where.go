@@ -0,0 +1,221 @@
+// Code generated by ent, DO NOT EDIT.
+
+package playlistvod
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/predicate"
+)
+
+// PlaylistID applies equality check predicate on the "playlist_id" field. It's identical to PlaylistIDEQ.
+func PlaylistID(v uuid.UUID) predicate.PlaylistVod {
+	return predicate.PlaylistVod(sql.FieldEQ(FieldPlaylistID, v))
+}
+
+// VodID applies equality check predicate on the "vod_id" field. It's identical to VodIDEQ.
+func VodID(v uuid.UUID) predicate.PlaylistVod {
+	return predicate.PlaylistVod(sql.FieldEQ(FieldVodID, v))
+}
+
+// Position applies equality check predicate on the "position" field. It's identical to PositionEQ.
+func Position(v int) predicate.PlaylistVod {
+	return predicate.PlaylistVod(sql.FieldEQ(FieldPosition, v))
+}
+
+// SectionID applies equality check predicate on the "section_id" field. It's identical to SectionIDEQ.
+func SectionID(v uuid.UUID) predicate.PlaylistVod {
+	return predicate.PlaylistVod(sql.FieldEQ(FieldSectionID, v))
+}
+
+// PlaylistIDEQ applies the EQ predicate on the "playlist_id" field.
+func PlaylistIDEQ(v uuid.UUID) predicate.PlaylistVod {
+	return predicate.PlaylistVod(sql.FieldEQ(FieldPlaylistID, v))
+}
+
+// PlaylistIDNEQ applies the NEQ predicate on the "playlist_id" field.
+func PlaylistIDNEQ(v uuid.UUID) predicate.PlaylistVod {
+	return predicate.PlaylistVod(sql.FieldNEQ(FieldPlaylistID, v))
+}
+
+// PlaylistIDIn applies the In predicate on the "playlist_id" field.
+func PlaylistIDIn(vs ...uuid.UUID) predicate.PlaylistVod {
+	return predicate.PlaylistVod(sql.FieldIn(FieldPlaylistID, vs...))
+}
+
+// PlaylistIDNotIn applies the NotIn predicate on the "playlist_id" field.
+func PlaylistIDNotIn(vs ...uuid.UUID) predicate.PlaylistVod {
+	return predicate.PlaylistVod(sql.FieldNotIn(FieldPlaylistID, vs...))
+}
+
+// VodIDEQ applies the EQ predicate on the "vod_id" field.
+func VodIDEQ(v uuid.UUID) predicate.PlaylistVod {
+	return predicate.PlaylistVod(sql.FieldEQ(FieldVodID, v))
+}
+
+// VodIDNEQ applies the NEQ predicate on the "vod_id" field.
+func VodIDNEQ(v uuid.UUID) predicate.PlaylistVod {
+	return predicate.PlaylistVod(sql.FieldNEQ(FieldVodID, v))
+}
+
+// VodIDIn applies the In predicate on the "vod_id" field.
+func VodIDIn(vs ...uuid.UUID) predicate.PlaylistVod {
+	return predicate.PlaylistVod(sql.FieldIn(FieldVodID, vs...))
+}
+
+// VodIDNotIn applies the NotIn predicate on the "vod_id" field.
+func VodIDNotIn(vs ...uuid.UUID) predicate.PlaylistVod {
+	return predicate.PlaylistVod(sql.FieldNotIn(FieldVodID, vs...))
+}
+
+// PositionEQ applies the EQ predicate on the "position" field.
+func PositionEQ(v int) predicate.PlaylistVod {
+	return predicate.PlaylistVod(sql.FieldEQ(FieldPosition, v))
+}
+
+// PositionNEQ applies the NEQ predicate on the "position" field.
+func PositionNEQ(v int) predicate.PlaylistVod {
+	return predicate.PlaylistVod(sql.FieldNEQ(FieldPosition, v))
+}
+
+// PositionIn applies the In predicate on the "position" field.
+func PositionIn(vs ...int) predicate.PlaylistVod {
+	return predicate.PlaylistVod(sql.FieldIn(FieldPosition, vs...))
+}
+
+// PositionNotIn applies the NotIn predicate on the "position" field.
+func PositionNotIn(vs ...int) predicate.PlaylistVod {
+	return predicate.PlaylistVod(sql.FieldNotIn(FieldPosition, vs...))
+}
+
+// PositionGT applies the GT predicate on the "position" field.
+func PositionGT(v int) predicate.PlaylistVod {
+	return predicate.PlaylistVod(sql.FieldGT(FieldPosition, v))
+}
+
+// PositionGTE applies the GTE predicate on the "position" field.
+func PositionGTE(v int) predicate.PlaylistVod {
+	return predicate.PlaylistVod(sql.FieldGTE(FieldPosition, v))
+}
+
+// PositionLT applies the LT predicate on the "position" field.
+func PositionLT(v int) predicate.PlaylistVod {
+	return predicate.PlaylistVod(sql.FieldLT(FieldPosition, v))
+}
+
+// PositionLTE applies the LTE predicate on the "position" field.
+func PositionLTE(v int) predicate.PlaylistVod {
+	return predicate.PlaylistVod(sql.FieldLTE(FieldPosition, v))
+}
+
+// SectionIDEQ applies the EQ predicate on the "section_id" field.
+func SectionIDEQ(v uuid.UUID) predicate.PlaylistVod {
+	return predicate.PlaylistVod(sql.FieldEQ(FieldSectionID, v))
+}
+
+// SectionIDNEQ applies the NEQ predicate on the "section_id" field.
+func SectionIDNEQ(v uuid.UUID) predicate.PlaylistVod {
+	return predicate.PlaylistVod(sql.FieldNEQ(FieldSectionID, v))
+}
+
+// SectionIDIn applies the In predicate on the "section_id" field.
+func SectionIDIn(vs ...uuid.UUID) predicate.PlaylistVod {
+	return predicate.PlaylistVod(sql.FieldIn(FieldSectionID, vs...))
+}
+
+// SectionIDNotIn applies the NotIn predicate on the "section_id" field.
+func SectionIDNotIn(vs ...uuid.UUID) predicate.PlaylistVod {
+	return predicate.PlaylistVod(sql.FieldNotIn(FieldSectionID, vs...))
+}
+
+// SectionIDGT applies the GT predicate on the "section_id" field.
+func SectionIDGT(v uuid.UUID) predicate.PlaylistVod {
+	return predicate.PlaylistVod(sql.FieldGT(FieldSectionID, v))
+}
+
+// SectionIDGTE applies the GTE predicate on the "section_id" field.
+func SectionIDGTE(v uuid.UUID) predicate.PlaylistVod {
+	return predicate.PlaylistVod(sql.FieldGTE(FieldSectionID, v))
+}
+
+// SectionIDLT applies the LT predicate on the "section_id" field.
+func SectionIDLT(v uuid.UUID) predicate.PlaylistVod {
+	return predicate.PlaylistVod(sql.FieldLT(FieldSectionID, v))
+}
+
+// SectionIDLTE applies the LTE predicate on the "section_id" field.
+func SectionIDLTE(v uuid.UUID) predicate.PlaylistVod {
+	return predicate.PlaylistVod(sql.FieldLTE(FieldSectionID, v))
+}
+
+// SectionIDIsNil applies the IsNil predicate on the "section_id" field.
+func SectionIDIsNil() predicate.PlaylistVod {
+	return predicate.PlaylistVod(sql.FieldIsNull(FieldSectionID))
+}
+
+// SectionIDNotNil applies the NotNil predicate on the "section_id" field.
+func SectionIDNotNil() predicate.PlaylistVod {
+	return predicate.PlaylistVod(sql.FieldNotNull(FieldSectionID))
+}
+
+// HasPlaylist applies the HasEdge predicate on the "playlist" edge.
+func HasPlaylist() predicate.PlaylistVod {
+	return predicate.PlaylistVod(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, PlaylistColumn),
+			sqlgraph.Edge(sqlgraph.M2O, false, PlaylistTable, PlaylistColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPlaylistWith applies the HasEdge predicate on the "playlist" edge with a given conditions (other predicates).
+func HasPlaylistWith(preds ...predicate.Playlist) predicate.PlaylistVod {
+	return predicate.PlaylistVod(func(s *sql.Selector) {
+		step := newPlaylistStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasVod applies the HasEdge predicate on the "vod" edge.
+func HasVod() predicate.PlaylistVod {
+	return predicate.PlaylistVod(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, VodColumn),
+			sqlgraph.Edge(sqlgraph.M2O, false, VodTable, VodColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasVodWith applies the HasEdge predicate on the "vod" edge with a given conditions (other predicates).
+func HasVodWith(preds ...predicate.Vod) predicate.PlaylistVod {
+	return predicate.PlaylistVod(func(s *sql.Selector) {
+		step := newVodStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PlaylistVod) predicate.PlaylistVod {
+	return predicate.PlaylistVod(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PlaylistVod) predicate.PlaylistVod {
+	return predicate.PlaylistVod(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PlaylistVod) predicate.PlaylistVod {
+	return predicate.PlaylistVod(sql.NotPredicates(p))
+}
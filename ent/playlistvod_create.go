@@ -0,0 +1,281 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/playlist"
+	"github.com/zibbp/ganymede/ent/playlistvod"
+	"github.com/zibbp/ganymede/ent/vod"
+)
+
+// PlaylistVodCreate is the builder for creating a PlaylistVod entity.
+type PlaylistVodCreate struct {
+	config
+	mutation *PlaylistVodMutation
+	hooks    []Hook
+}
+
+// SetPlaylistID sets the "playlist_id" field.
+func (_c *PlaylistVodCreate) SetPlaylistID(v uuid.UUID) *PlaylistVodCreate {
+	_c.mutation.SetPlaylistID(v)
+	return _c
+}
+
+// SetVodID sets the "vod_id" field.
+func (_c *PlaylistVodCreate) SetVodID(v uuid.UUID) *PlaylistVodCreate {
+	_c.mutation.SetVodID(v)
+	return _c
+}
+
+// SetPosition sets the "position" field.
+func (_c *PlaylistVodCreate) SetPosition(v int) *PlaylistVodCreate {
+	_c.mutation.SetPosition(v)
+	return _c
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_c *PlaylistVodCreate) SetNillablePosition(v *int) *PlaylistVodCreate {
+	if v != nil {
+		_c.SetPosition(*v)
+	}
+	return _c
+}
+
+// SetSectionID sets the "section_id" field.
+func (_c *PlaylistVodCreate) SetSectionID(v uuid.UUID) *PlaylistVodCreate {
+	_c.mutation.SetSectionID(v)
+	return _c
+}
+
+// SetNillableSectionID sets the "section_id" field if the given value is not nil.
+func (_c *PlaylistVodCreate) SetNillableSectionID(v *uuid.UUID) *PlaylistVodCreate {
+	if v != nil {
+		_c.SetSectionID(*v)
+	}
+	return _c
+}
+
+// SetPlaylist sets the "playlist" edge to the Playlist entity.
+func (_c *PlaylistVodCreate) SetPlaylist(v *Playlist) *PlaylistVodCreate {
+	return _c.SetPlaylistID(v.ID)
+}
+
+// SetVod sets the "vod" edge to the Vod entity.
+func (_c *PlaylistVodCreate) SetVod(v *Vod) *PlaylistVodCreate {
+	return _c.SetVodID(v.ID)
+}
+
+// Mutation returns the PlaylistVodMutation object of the builder.
+func (_c *PlaylistVodCreate) Mutation() *PlaylistVodMutation {
+	return _c.mutation
+}
+
+// Save creates the PlaylistVod in the database.
+func (_c *PlaylistVodCreate) Save(ctx context.Context) (*PlaylistVod, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PlaylistVodCreate) SaveX(ctx context.Context) *PlaylistVod {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PlaylistVodCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PlaylistVodCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PlaylistVodCreate) defaults() {
+	if _, ok := _c.mutation.Position(); !ok {
+		v := playlistvod.DefaultPosition
+		_c.mutation.SetPosition(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PlaylistVodCreate) check() error {
+	if _, ok := _c.mutation.PlaylistID(); !ok {
+		return &ValidationError{Name: "playlist_id", err: errors.New(`ent: missing required field "PlaylistVod.playlist_id"`)}
+	}
+	if _, ok := _c.mutation.VodID(); !ok {
+		return &ValidationError{Name: "vod_id", err: errors.New(`ent: missing required field "PlaylistVod.vod_id"`)}
+	}
+	if _, ok := _c.mutation.Position(); !ok {
+		return &ValidationError{Name: "position", err: errors.New(`ent: missing required field "PlaylistVod.position"`)}
+	}
+	if len(_c.mutation.PlaylistIDs()) == 0 {
+		return &ValidationError{Name: "playlist", err: errors.New(`ent: missing required edge "PlaylistVod.playlist"`)}
+	}
+	if len(_c.mutation.VodIDs()) == 0 {
+		return &ValidationError{Name: "vod", err: errors.New(`ent: missing required edge "PlaylistVod.vod"`)}
+	}
+	return nil
+}
+
+func (_c *PlaylistVodCreate) sqlSave(ctx context.Context) (*PlaylistVod, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
+
+func (_c *PlaylistVodCreate) createSpec() (*PlaylistVod, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PlaylistVod{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(playlistvod.Table, nil)
+	)
+	if value, ok := _c.mutation.Position(); ok {
+		_spec.SetField(playlistvod.FieldPosition, field.TypeInt, value)
+		_node.Position = value
+	}
+	if value, ok := _c.mutation.SectionID(); ok {
+		_spec.SetField(playlistvod.FieldSectionID, field.TypeUUID, value)
+		_node.SectionID = &value
+	}
+	if nodes := _c.mutation.PlaylistIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   playlistvod.PlaylistTable,
+			Columns: []string{playlistvod.PlaylistColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlist.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PlaylistID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.VodIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   playlistvod.VodTable,
+			Columns: []string{playlistvod.VodColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vod.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.VodID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// PlaylistVodCreateBulk is the builder for creating many PlaylistVod entities in bulk.
+type PlaylistVodCreateBulk struct {
+	config
+	err      error
+	builders []*PlaylistVodCreate
+}
+
+// Save creates the PlaylistVod entities in the database.
+func (_c *PlaylistVodCreateBulk) Save(ctx context.Context) ([]*PlaylistVod, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PlaylistVod, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PlaylistVodMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PlaylistVodCreateBulk) SaveX(ctx context.Context) []*PlaylistVod {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PlaylistVodCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PlaylistVodCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,604 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/playlist"
+	"github.com/zibbp/ganymede/ent/playlistvod"
+	"github.com/zibbp/ganymede/ent/predicate"
+	"github.com/zibbp/ganymede/ent/vod"
+)
+
+// PlaylistVodQuery is the builder for querying PlaylistVod entities.
+type PlaylistVodQuery struct {
+	config
+	ctx          *QueryContext
+	order        []playlistvod.OrderOption
+	inters       []Interceptor
+	predicates   []predicate.PlaylistVod
+	withPlaylist *PlaylistQuery
+	withVod      *VodQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the PlaylistVodQuery builder.
+func (_q *PlaylistVodQuery) Where(ps ...predicate.PlaylistVod) *PlaylistVodQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
+}
+
+// Limit the number of records to be returned by this query.
+func (_q *PlaylistVodQuery) Limit(limit int) *PlaylistVodQuery {
+	_q.ctx.Limit = &limit
+	return _q
+}
+
+// Offset to start from.
+func (_q *PlaylistVodQuery) Offset(offset int) *PlaylistVodQuery {
+	_q.ctx.Offset = &offset
+	return _q
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (_q *PlaylistVodQuery) Unique(unique bool) *PlaylistVodQuery {
+	_q.ctx.Unique = &unique
+	return _q
+}
+
+// Order specifies how the records should be ordered.
+func (_q *PlaylistVodQuery) Order(o ...playlistvod.OrderOption) *PlaylistVodQuery {
+	_q.order = append(_q.order, o...)
+	return _q
+}
+
+// QueryPlaylist chains the current query on the "playlist" edge.
+func (_q *PlaylistVodQuery) QueryPlaylist() *PlaylistQuery {
+	query := (&PlaylistClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(playlistvod.Table, playlistvod.PlaylistColumn, selector),
+			sqlgraph.To(playlist.Table, playlist.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, playlistvod.PlaylistTable, playlistvod.PlaylistColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryVod chains the current query on the "vod" edge.
+func (_q *PlaylistVodQuery) QueryVod() *VodQuery {
+	query := (&VodClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(playlistvod.Table, playlistvod.VodColumn, selector),
+			sqlgraph.To(vod.Table, vod.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, playlistvod.VodTable, playlistvod.VodColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first PlaylistVod entity from the query.
+// Returns a *NotFoundError when no PlaylistVod was found.
+func (_q *PlaylistVodQuery) First(ctx context.Context) (*PlaylistVod, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{playlistvod.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *PlaylistVodQuery) FirstX(ctx context.Context) *PlaylistVod {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// Only returns a single PlaylistVod entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one PlaylistVod entity is found.
+// Returns a *NotFoundError when no PlaylistVod entities are found.
+func (_q *PlaylistVodQuery) Only(ctx context.Context) (*PlaylistVod, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{playlistvod.Label}
+	default:
+		return nil, &NotSingularError{playlistvod.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *PlaylistVodQuery) OnlyX(ctx context.Context) *PlaylistVod {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// All executes the query and returns a list of PlaylistVods.
+func (_q *PlaylistVodQuery) All(ctx context.Context) ([]*PlaylistVod, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*PlaylistVod, *PlaylistVodQuery]()
+	return withInterceptors[[]*PlaylistVod](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *PlaylistVodQuery) AllX(ctx context.Context) []*PlaylistVod {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// Count returns the count of the given query.
+func (_q *PlaylistVodQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*PlaylistVodQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *PlaylistVodQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *PlaylistVodQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.First(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *PlaylistVodQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the PlaylistVodQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *PlaylistVodQuery) Clone() *PlaylistVodQuery {
+	if _q == nil {
+		return nil
+	}
+	return &PlaylistVodQuery{
+		config:       _q.config,
+		ctx:          _q.ctx.Clone(),
+		order:        append([]playlistvod.OrderOption{}, _q.order...),
+		inters:       append([]Interceptor{}, _q.inters...),
+		predicates:   append([]predicate.PlaylistVod{}, _q.predicates...),
+		withPlaylist: _q.withPlaylist.Clone(),
+		withVod:      _q.withVod.Clone(),
+		// clone intermediate query.
+		sql:  _q.sql.Clone(),
+		path: _q.path,
+	}
+}
+
+// WithPlaylist tells the query-builder to eager-load the nodes that are connected to
+// the "playlist" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *PlaylistVodQuery) WithPlaylist(opts ...func(*PlaylistQuery)) *PlaylistVodQuery {
+	query := (&PlaylistClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPlaylist = query
+	return _q
+}
+
+// WithVod tells the query-builder to eager-load the nodes that are connected to
+// the "vod" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *PlaylistVodQuery) WithVod(opts ...func(*VodQuery)) *PlaylistVodQuery {
+	query := (&VodClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withVod = query
+	return _q
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		PlaylistID uuid.UUID `json:"playlist_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.PlaylistVod.Query().
+//		GroupBy(playlistvod.FieldPlaylistID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (_q *PlaylistVodQuery) GroupBy(field string, fields ...string) *PlaylistVodGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &PlaylistVodGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = playlistvod.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		PlaylistID uuid.UUID `json:"playlist_id,omitempty"`
+//	}
+//
+//	client.PlaylistVod.Query().
+//		Select(playlistvod.FieldPlaylistID).
+//		Scan(ctx, &v)
+func (_q *PlaylistVodQuery) Select(fields ...string) *PlaylistVodSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &PlaylistVodSelect{PlaylistVodQuery: _q}
+	sbuild.label = playlistvod.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a PlaylistVodSelect configured with the given aggregations.
+func (_q *PlaylistVodQuery) Aggregate(fns ...AggregateFunc) *PlaylistVodSelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *PlaylistVodQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !playlistvod.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	return nil
+}
+
+func (_q *PlaylistVodQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*PlaylistVod, error) {
+	var (
+		nodes       = []*PlaylistVod{}
+		_spec       = _q.querySpec()
+		loadedTypes = [2]bool{
+			_q.withPlaylist != nil,
+			_q.withVod != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*PlaylistVod).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &PlaylistVod{config: _q.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := _q.withPlaylist; query != nil {
+		if err := _q.loadPlaylist(ctx, query, nodes, nil,
+			func(n *PlaylistVod, e *Playlist) { n.Edges.Playlist = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withVod; query != nil {
+		if err := _q.loadVod(ctx, query, nodes, nil,
+			func(n *PlaylistVod, e *Vod) { n.Edges.Vod = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (_q *PlaylistVodQuery) loadPlaylist(ctx context.Context, query *PlaylistQuery, nodes []*PlaylistVod, init func(*PlaylistVod), assign func(*PlaylistVod, *Playlist)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*PlaylistVod)
+	for i := range nodes {
+		fk := nodes[i].PlaylistID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(playlist.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "playlist_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *PlaylistVodQuery) loadVod(ctx context.Context, query *VodQuery, nodes []*PlaylistVod, init func(*PlaylistVod), assign func(*PlaylistVod, *Vod)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*PlaylistVod)
+	for i := range nodes {
+		fk := nodes[i].VodID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(vod.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "vod_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (_q *PlaylistVodQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	_spec.Unique = false
+	_spec.Node.Columns = nil
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
+}
+
+func (_q *PlaylistVodQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(playlistvod.Table, playlistvod.Columns, nil)
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if _q.path != nil {
+		_spec.Unique = true
+	}
+	if fields := _q.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		for i := range fields {
+			_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+		}
+		if _q.withPlaylist != nil {
+			_spec.Node.AddColumnOnce(playlistvod.FieldPlaylistID)
+		}
+		if _q.withVod != nil {
+			_spec.Node.AddColumnOnce(playlistvod.FieldVodID)
+		}
+	}
+	if ps := _q.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := _q.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (_q *PlaylistVodQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
+	t1 := builder.Table(playlistvod.Table)
+	columns := _q.ctx.Fields
+	if len(columns) == 0 {
+		columns = playlistvod.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if _q.sql != nil {
+		selector = _q.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range _q.predicates {
+		p(selector)
+	}
+	for _, p := range _q.order {
+		p(selector)
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// PlaylistVodGroupBy is the group-by builder for PlaylistVod entities.
+type PlaylistVodGroupBy struct {
+	selector
+	build *PlaylistVodQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (_g *PlaylistVodGroupBy) Aggregate(fns ...AggregateFunc) *PlaylistVodGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_g *PlaylistVodGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PlaylistVodQuery, *PlaylistVodGroupBy](ctx, _g.build, _g, _g.build.inters, v)
+}
+
+func (_g *PlaylistVodGroupBy) sqlScan(ctx context.Context, root *PlaylistVodQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// PlaylistVodSelect is the builder for selecting fields of PlaylistVod entities.
+type PlaylistVodSelect struct {
+	*PlaylistVodQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (_s *PlaylistVodSelect) Aggregate(fns ...AggregateFunc) *PlaylistVodSelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_s *PlaylistVodSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PlaylistVodQuery, *PlaylistVodSelect](ctx, _s.PlaylistVodQuery, _s, _s.inters, v)
+}
+
+func (_s *PlaylistVodSelect) sqlScan(ctx context.Context, root *PlaylistVodQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*_s.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,530 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/playlist"
+	"github.com/zibbp/ganymede/ent/playlistvod"
+	"github.com/zibbp/ganymede/ent/predicate"
+	"github.com/zibbp/ganymede/ent/vod"
+)
+
+// PlaylistVodUpdate is the builder for updating PlaylistVod entities.
+type PlaylistVodUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PlaylistVodMutation
+}
+
+// Where appends a list predicates to the PlaylistVodUpdate builder.
+func (_u *PlaylistVodUpdate) Where(ps ...predicate.PlaylistVod) *PlaylistVodUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetPlaylistID sets the "playlist_id" field.
+func (_u *PlaylistVodUpdate) SetPlaylistID(v uuid.UUID) *PlaylistVodUpdate {
+	_u.mutation.SetPlaylistID(v)
+	return _u
+}
+
+// SetNillablePlaylistID sets the "playlist_id" field if the given value is not nil.
+func (_u *PlaylistVodUpdate) SetNillablePlaylistID(v *uuid.UUID) *PlaylistVodUpdate {
+	if v != nil {
+		_u.SetPlaylistID(*v)
+	}
+	return _u
+}
+
+// SetVodID sets the "vod_id" field.
+func (_u *PlaylistVodUpdate) SetVodID(v uuid.UUID) *PlaylistVodUpdate {
+	_u.mutation.SetVodID(v)
+	return _u
+}
+
+// SetNillableVodID sets the "vod_id" field if the given value is not nil.
+func (_u *PlaylistVodUpdate) SetNillableVodID(v *uuid.UUID) *PlaylistVodUpdate {
+	if v != nil {
+		_u.SetVodID(*v)
+	}
+	return _u
+}
+
+// SetPosition sets the "position" field.
+func (_u *PlaylistVodUpdate) SetPosition(v int) *PlaylistVodUpdate {
+	_u.mutation.ResetPosition()
+	_u.mutation.SetPosition(v)
+	return _u
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_u *PlaylistVodUpdate) SetNillablePosition(v *int) *PlaylistVodUpdate {
+	if v != nil {
+		_u.SetPosition(*v)
+	}
+	return _u
+}
+
+// AddPosition adds value to the "position" field.
+func (_u *PlaylistVodUpdate) AddPosition(v int) *PlaylistVodUpdate {
+	_u.mutation.AddPosition(v)
+	return _u
+}
+
+// SetSectionID sets the "section_id" field.
+func (_u *PlaylistVodUpdate) SetSectionID(v uuid.UUID) *PlaylistVodUpdate {
+	_u.mutation.SetSectionID(v)
+	return _u
+}
+
+// SetNillableSectionID sets the "section_id" field if the given value is not nil.
+func (_u *PlaylistVodUpdate) SetNillableSectionID(v *uuid.UUID) *PlaylistVodUpdate {
+	if v != nil {
+		_u.SetSectionID(*v)
+	}
+	return _u
+}
+
+// ClearSectionID clears the value of the "section_id" field.
+func (_u *PlaylistVodUpdate) ClearSectionID() *PlaylistVodUpdate {
+	_u.mutation.ClearSectionID()
+	return _u
+}
+
+// SetPlaylist sets the "playlist" edge to the Playlist entity.
+func (_u *PlaylistVodUpdate) SetPlaylist(v *Playlist) *PlaylistVodUpdate {
+	return _u.SetPlaylistID(v.ID)
+}
+
+// SetVod sets the "vod" edge to the Vod entity.
+func (_u *PlaylistVodUpdate) SetVod(v *Vod) *PlaylistVodUpdate {
+	return _u.SetVodID(v.ID)
+}
+
+// Mutation returns the PlaylistVodMutation object of the builder.
+func (_u *PlaylistVodUpdate) Mutation() *PlaylistVodMutation {
+	return _u.mutation
+}
+
+// ClearPlaylist clears the "playlist" edge to the Playlist entity.
+func (_u *PlaylistVodUpdate) ClearPlaylist() *PlaylistVodUpdate {
+	_u.mutation.ClearPlaylist()
+	return _u
+}
+
+// ClearVod clears the "vod" edge to the Vod entity.
+func (_u *PlaylistVodUpdate) ClearVod() *PlaylistVodUpdate {
+	_u.mutation.ClearVod()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PlaylistVodUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PlaylistVodUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PlaylistVodUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PlaylistVodUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PlaylistVodUpdate) check() error {
+	if _u.mutation.PlaylistCleared() && len(_u.mutation.PlaylistIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PlaylistVod.playlist"`)
+	}
+	if _u.mutation.VodCleared() && len(_u.mutation.VodIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PlaylistVod.vod"`)
+	}
+	return nil
+}
+
+func (_u *PlaylistVodUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(playlistvod.Table, playlistvod.Columns, sqlgraph.NewFieldSpec(playlistvod.FieldPlaylistID, field.TypeUUID), sqlgraph.NewFieldSpec(playlistvod.FieldVodID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Position(); ok {
+		_spec.SetField(playlistvod.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPosition(); ok {
+		_spec.AddField(playlistvod.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.SectionID(); ok {
+		_spec.SetField(playlistvod.FieldSectionID, field.TypeUUID, value)
+	}
+	if _u.mutation.SectionIDCleared() {
+		_spec.ClearField(playlistvod.FieldSectionID, field.TypeUUID)
+	}
+	if _u.mutation.PlaylistCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   playlistvod.PlaylistTable,
+			Columns: []string{playlistvod.PlaylistColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlist.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PlaylistIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   playlistvod.PlaylistTable,
+			Columns: []string{playlistvod.PlaylistColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlist.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.VodCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   playlistvod.VodTable,
+			Columns: []string{playlistvod.VodColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vod.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.VodIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   playlistvod.VodTable,
+			Columns: []string{playlistvod.VodColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vod.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{playlistvod.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PlaylistVodUpdateOne is the builder for updating a single PlaylistVod entity.
+type PlaylistVodUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PlaylistVodMutation
+}
+
+// SetPlaylistID sets the "playlist_id" field.
+func (_u *PlaylistVodUpdateOne) SetPlaylistID(v uuid.UUID) *PlaylistVodUpdateOne {
+	_u.mutation.SetPlaylistID(v)
+	return _u
+}
+
+// SetNillablePlaylistID sets the "playlist_id" field if the given value is not nil.
+func (_u *PlaylistVodUpdateOne) SetNillablePlaylistID(v *uuid.UUID) *PlaylistVodUpdateOne {
+	if v != nil {
+		_u.SetPlaylistID(*v)
+	}
+	return _u
+}
+
+// SetVodID sets the "vod_id" field.
+func (_u *PlaylistVodUpdateOne) SetVodID(v uuid.UUID) *PlaylistVodUpdateOne {
+	_u.mutation.SetVodID(v)
+	return _u
+}
+
+// SetNillableVodID sets the "vod_id" field if the given value is not nil.
+func (_u *PlaylistVodUpdateOne) SetNillableVodID(v *uuid.UUID) *PlaylistVodUpdateOne {
+	if v != nil {
+		_u.SetVodID(*v)
+	}
+	return _u
+}
+
+// SetPosition sets the "position" field.
+func (_u *PlaylistVodUpdateOne) SetPosition(v int) *PlaylistVodUpdateOne {
+	_u.mutation.ResetPosition()
+	_u.mutation.SetPosition(v)
+	return _u
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_u *PlaylistVodUpdateOne) SetNillablePosition(v *int) *PlaylistVodUpdateOne {
+	if v != nil {
+		_u.SetPosition(*v)
+	}
+	return _u
+}
+
+// AddPosition adds value to the "position" field.
+func (_u *PlaylistVodUpdateOne) AddPosition(v int) *PlaylistVodUpdateOne {
+	_u.mutation.AddPosition(v)
+	return _u
+}
+
+// SetSectionID sets the "section_id" field.
+func (_u *PlaylistVodUpdateOne) SetSectionID(v uuid.UUID) *PlaylistVodUpdateOne {
+	_u.mutation.SetSectionID(v)
+	return _u
+}
+
+// SetNillableSectionID sets the "section_id" field if the given value is not nil.
+func (_u *PlaylistVodUpdateOne) SetNillableSectionID(v *uuid.UUID) *PlaylistVodUpdateOne {
+	if v != nil {
+		_u.SetSectionID(*v)
+	}
+	return _u
+}
+
+// ClearSectionID clears the value of the "section_id" field.
+func (_u *PlaylistVodUpdateOne) ClearSectionID() *PlaylistVodUpdateOne {
+	_u.mutation.ClearSectionID()
+	return _u
+}
+
+// SetPlaylist sets the "playlist" edge to the Playlist entity.
+func (_u *PlaylistVodUpdateOne) SetPlaylist(v *Playlist) *PlaylistVodUpdateOne {
+	return _u.SetPlaylistID(v.ID)
+}
+
+// SetVod sets the "vod" edge to the Vod entity.
+func (_u *PlaylistVodUpdateOne) SetVod(v *Vod) *PlaylistVodUpdateOne {
+	return _u.SetVodID(v.ID)
+}
+
+// Mutation returns the PlaylistVodMutation object of the builder.
+func (_u *PlaylistVodUpdateOne) Mutation() *PlaylistVodMutation {
+	return _u.mutation
+}
+
+// ClearPlaylist clears the "playlist" edge to the Playlist entity.
+func (_u *PlaylistVodUpdateOne) ClearPlaylist() *PlaylistVodUpdateOne {
+	_u.mutation.ClearPlaylist()
+	return _u
+}
+
+// ClearVod clears the "vod" edge to the Vod entity.
+func (_u *PlaylistVodUpdateOne) ClearVod() *PlaylistVodUpdateOne {
+	_u.mutation.ClearVod()
+	return _u
+}
+
+// Where appends a list predicates to the PlaylistVodUpdate builder.
+func (_u *PlaylistVodUpdateOne) Where(ps ...predicate.PlaylistVod) *PlaylistVodUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PlaylistVodUpdateOne) Select(field string, fields ...string) *PlaylistVodUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PlaylistVod entity.
+func (_u *PlaylistVodUpdateOne) Save(ctx context.Context) (*PlaylistVod, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PlaylistVodUpdateOne) SaveX(ctx context.Context) *PlaylistVod {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PlaylistVodUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PlaylistVodUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PlaylistVodUpdateOne) check() error {
+	if _u.mutation.PlaylistCleared() && len(_u.mutation.PlaylistIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PlaylistVod.playlist"`)
+	}
+	if _u.mutation.VodCleared() && len(_u.mutation.VodIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PlaylistVod.vod"`)
+	}
+	return nil
+}
+
+func (_u *PlaylistVodUpdateOne) sqlSave(ctx context.Context) (_node *PlaylistVod, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(playlistvod.Table, playlistvod.Columns, sqlgraph.NewFieldSpec(playlistvod.FieldPlaylistID, field.TypeUUID), sqlgraph.NewFieldSpec(playlistvod.FieldVodID, field.TypeUUID))
+	if id, ok := _u.mutation.PlaylistID(); !ok {
+		return nil, &ValidationError{Name: "playlist_id", err: errors.New(`ent: missing "PlaylistVod.playlist_id" for update`)}
+	} else {
+		_spec.Node.CompositeID[0].Value = id
+	}
+	if id, ok := _u.mutation.VodID(); !ok {
+		return nil, &ValidationError{Name: "vod_id", err: errors.New(`ent: missing "PlaylistVod.vod_id" for update`)}
+	} else {
+		_spec.Node.CompositeID[1].Value = id
+	}
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, len(fields))
+		for i, f := range fields {
+			if !playlistvod.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			_spec.Node.Columns[i] = f
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Position(); ok {
+		_spec.SetField(playlistvod.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPosition(); ok {
+		_spec.AddField(playlistvod.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.SectionID(); ok {
+		_spec.SetField(playlistvod.FieldSectionID, field.TypeUUID, value)
+	}
+	if _u.mutation.SectionIDCleared() {
+		_spec.ClearField(playlistvod.FieldSectionID, field.TypeUUID)
+	}
+	if _u.mutation.PlaylistCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   playlistvod.PlaylistTable,
+			Columns: []string{playlistvod.PlaylistColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlist.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PlaylistIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   playlistvod.PlaylistTable,
+			Columns: []string{playlistvod.PlaylistColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlist.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.VodCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   playlistvod.VodTable,
+			Columns: []string{playlistvod.VodColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vod.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.VodIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   playlistvod.VodTable,
+			Columns: []string{playlistvod.VodColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vod.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &PlaylistVod{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{playlistvod.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
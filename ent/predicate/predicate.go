@@ -6,9 +6,18 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// ApiToken is the predicate function for apitoken builders.
+type ApiToken func(*sql.Selector)
+
+// AuditLog is the predicate function for auditlog builders.
+type AuditLog func(*sql.Selector)
+
 // BlockedVideos is the predicate function for blockedvideos builders.
 type BlockedVideos func(*sql.Selector)
 
+// BulkOperation is the predicate function for bulkoperation builders.
+type BulkOperation func(*sql.Selector)
+
 // Channel is the predicate function for channel builders.
 type Channel func(*sql.Selector)
 
@@ -24,12 +33,21 @@ type LiveCategory func(*sql.Selector)
 // LiveTitleRegex is the predicate function for livetitleregex builders.
 type LiveTitleRegex func(*sql.Selector)
 
+// LoginThrottle is the predicate function for loginthrottle builders.
+type LoginThrottle func(*sql.Selector)
+
 // MultistreamInfo is the predicate function for multistreaminfo builders.
 type MultistreamInfo func(*sql.Selector)
 
 // MutedSegment is the predicate function for mutedsegment builders.
 type MutedSegment func(*sql.Selector)
 
+// NotificationFailure is the predicate function for notificationfailure builders.
+type NotificationFailure func(*sql.Selector)
+
+// NotificationSubscription is the predicate function for notificationsubscription builders.
+type NotificationSubscription func(*sql.Selector)
+
 // Playback is the predicate function for playback builders.
 type Playback func(*sql.Selector)
 
@@ -42,12 +60,24 @@ type PlaylistRule func(*sql.Selector)
 // PlaylistRuleGroup is the predicate function for playlistrulegroup builders.
 type PlaylistRuleGroup func(*sql.Selector)
 
+// PlaylistSection is the predicate function for playlistsection builders.
+type PlaylistSection func(*sql.Selector)
+
+// PlaylistVod is the predicate function for playlistvod builders.
+type PlaylistVod func(*sql.Selector)
+
 // Queue is the predicate function for queue builders.
 type Queue func(*sql.Selector)
 
 // Sessions is the predicate function for sessions builders.
 type Sessions func(*sql.Selector)
 
+// ShareLink is the predicate function for sharelink builders.
+type ShareLink func(*sql.Selector)
+
+// Tag is the predicate function for tag builders.
+type Tag func(*sql.Selector)
+
 // TwitchCategory is the predicate function for twitchcategory builders.
 type TwitchCategory func(*sql.Selector)
 
@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -40,6 +41,8 @@ type Queue struct {
 	TaskVideoDownload utils.TaskStatus `json:"task_video_download,omitempty"`
 	// TaskVideoConvert holds the value of the "task_video_convert" field.
 	TaskVideoConvert utils.TaskStatus `json:"task_video_convert,omitempty"`
+	// TaskVideoValidate holds the value of the "task_video_validate" field.
+	TaskVideoValidate utils.TaskStatus `json:"task_video_validate,omitempty"`
 	// TaskVideoMove holds the value of the "task_video_move" field.
 	TaskVideoMove utils.TaskStatus `json:"task_video_move,omitempty"`
 	// TaskChatDownload holds the value of the "task_chat_download" field.
@@ -56,6 +59,32 @@ type Queue struct {
 	ArchiveChat bool `json:"archive_chat,omitempty"`
 	// RenderChat holds the value of the "render_chat" field.
 	RenderChat bool `json:"render_chat,omitempty"`
+	// Number of times the video download has been started.
+	VideoDownloadAttempts int `json:"video_download_attempts,omitempty"`
+	// Fragment the last download attempt resumed from, live progress is recorded in task_progress.
+	VideoDownloadResumeFragment int `json:"video_download_resume_fragment,omitempty"`
+	// Size of the partially downloaded files when the last download attempt started.
+	VideoDownloadResumeBytes int64 `json:"video_download_resume_bytes,omitempty"`
+	// Time the last download attempt started.
+	VideoDownloadProgressAt time.Time `json:"video_download_progress_at,omitempty"`
+	// Verdict of the last post-download validation of the video.
+	VideoValidationVerdict utils.VideoValidationVerdict `json:"video_validation_verdict,omitempty"`
+	// Reason the last post-download validation failed.
+	VideoValidationMessage string `json:"video_validation_message,omitempty"`
+	// Expected duration in seconds, the platform duration minus muted segments.
+	VideoValidationExpectedDuration float64 `json:"video_validation_expected_duration,omitempty"`
+	// Duration in seconds measured by ffprobe.
+	VideoValidationMeasuredDuration float64 `json:"video_validation_measured_duration,omitempty"`
+	// Whether a video stream was found.
+	VideoValidationHasVideo bool `json:"video_validation_has_video,omitempty"`
+	// Whether an audio stream was found.
+	VideoValidationHasAudio bool `json:"video_validation_has_audio,omitempty"`
+	// Number of times the video was re-downloaded after failing validation.
+	VideoValidationRetries int `json:"video_validation_retries,omitempty"`
+	// VideoValidatedAt holds the value of the "video_validated_at" field.
+	VideoValidatedAt time.Time `json:"video_validated_at,omitempty"`
+	// Latest progress of running tasks parsed from the output of the tool running them, keyed by task name.
+	TaskProgress map[utils.TaskName]utils.TaskProgress `json:"task_progress,omitempty"`
 	// WorkflowID holds the value of the "workflow_id" field.
 	WorkflowID string `json:"workflow_id,omitempty"`
 	// WorkflowRunID holds the value of the "workflow_run_id" field.
@@ -96,11 +125,17 @@ func (*Queue) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case queue.FieldLiveArchive, queue.FieldOnHold, queue.FieldVideoProcessing, queue.FieldChatProcessing, queue.FieldProcessing, queue.FieldArchiveChat, queue.FieldRenderChat:
+		case queue.FieldTaskProgress:
+			values[i] = new([]byte)
+		case queue.FieldLiveArchive, queue.FieldOnHold, queue.FieldVideoProcessing, queue.FieldChatProcessing, queue.FieldProcessing, queue.FieldArchiveChat, queue.FieldRenderChat, queue.FieldVideoValidationHasVideo, queue.FieldVideoValidationHasAudio:
 			values[i] = new(sql.NullBool)
-		case queue.FieldTaskVodCreateFolder, queue.FieldTaskVodDownloadThumbnail, queue.FieldTaskVodSaveInfo, queue.FieldTaskVideoDownload, queue.FieldTaskVideoConvert, queue.FieldTaskVideoMove, queue.FieldTaskChatDownload, queue.FieldTaskChatConvert, queue.FieldTaskChatRender, queue.FieldTaskChatMove, queue.FieldWorkflowID, queue.FieldWorkflowRunID:
+		case queue.FieldVideoValidationExpectedDuration, queue.FieldVideoValidationMeasuredDuration:
+			values[i] = new(sql.NullFloat64)
+		case queue.FieldVideoDownloadAttempts, queue.FieldVideoDownloadResumeFragment, queue.FieldVideoDownloadResumeBytes, queue.FieldVideoValidationRetries:
+			values[i] = new(sql.NullInt64)
+		case queue.FieldTaskVodCreateFolder, queue.FieldTaskVodDownloadThumbnail, queue.FieldTaskVodSaveInfo, queue.FieldTaskVideoDownload, queue.FieldTaskVideoConvert, queue.FieldTaskVideoValidate, queue.FieldTaskVideoMove, queue.FieldTaskChatDownload, queue.FieldTaskChatConvert, queue.FieldTaskChatRender, queue.FieldTaskChatMove, queue.FieldVideoValidationVerdict, queue.FieldVideoValidationMessage, queue.FieldWorkflowID, queue.FieldWorkflowRunID:
 			values[i] = new(sql.NullString)
-		case queue.FieldChatStart, queue.FieldUpdatedAt, queue.FieldCreatedAt:
+		case queue.FieldChatStart, queue.FieldVideoDownloadProgressAt, queue.FieldVideoValidatedAt, queue.FieldUpdatedAt, queue.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
 		case queue.FieldID:
 			values[i] = new(uuid.UUID)
@@ -187,6 +222,12 @@ func (_m *Queue) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.TaskVideoConvert = utils.TaskStatus(value.String)
 			}
+		case queue.FieldTaskVideoValidate:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field task_video_validate", values[i])
+			} else if value.Valid {
+				_m.TaskVideoValidate = utils.TaskStatus(value.String)
+			}
 		case queue.FieldTaskVideoMove:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field task_video_move", values[i])
@@ -235,6 +276,86 @@ func (_m *Queue) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.RenderChat = value.Bool
 			}
+		case queue.FieldVideoDownloadAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field video_download_attempts", values[i])
+			} else if value.Valid {
+				_m.VideoDownloadAttempts = int(value.Int64)
+			}
+		case queue.FieldVideoDownloadResumeFragment:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field video_download_resume_fragment", values[i])
+			} else if value.Valid {
+				_m.VideoDownloadResumeFragment = int(value.Int64)
+			}
+		case queue.FieldVideoDownloadResumeBytes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field video_download_resume_bytes", values[i])
+			} else if value.Valid {
+				_m.VideoDownloadResumeBytes = value.Int64
+			}
+		case queue.FieldVideoDownloadProgressAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field video_download_progress_at", values[i])
+			} else if value.Valid {
+				_m.VideoDownloadProgressAt = value.Time
+			}
+		case queue.FieldVideoValidationVerdict:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field video_validation_verdict", values[i])
+			} else if value.Valid {
+				_m.VideoValidationVerdict = utils.VideoValidationVerdict(value.String)
+			}
+		case queue.FieldVideoValidationMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field video_validation_message", values[i])
+			} else if value.Valid {
+				_m.VideoValidationMessage = value.String
+			}
+		case queue.FieldVideoValidationExpectedDuration:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field video_validation_expected_duration", values[i])
+			} else if value.Valid {
+				_m.VideoValidationExpectedDuration = value.Float64
+			}
+		case queue.FieldVideoValidationMeasuredDuration:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field video_validation_measured_duration", values[i])
+			} else if value.Valid {
+				_m.VideoValidationMeasuredDuration = value.Float64
+			}
+		case queue.FieldVideoValidationHasVideo:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field video_validation_has_video", values[i])
+			} else if value.Valid {
+				_m.VideoValidationHasVideo = value.Bool
+			}
+		case queue.FieldVideoValidationHasAudio:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field video_validation_has_audio", values[i])
+			} else if value.Valid {
+				_m.VideoValidationHasAudio = value.Bool
+			}
+		case queue.FieldVideoValidationRetries:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field video_validation_retries", values[i])
+			} else if value.Valid {
+				_m.VideoValidationRetries = int(value.Int64)
+			}
+		case queue.FieldVideoValidatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field video_validated_at", values[i])
+			} else if value.Valid {
+				_m.VideoValidatedAt = value.Time
+			}
+		case queue.FieldTaskProgress:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field task_progress", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.TaskProgress); err != nil {
+					return fmt.Errorf("unmarshal field task_progress: %w", err)
+				}
+			}
 		case queue.FieldWorkflowID:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field workflow_id", values[i])
@@ -337,6 +458,9 @@ func (_m *Queue) String() string {
 	builder.WriteString("task_video_convert=")
 	builder.WriteString(fmt.Sprintf("%v", _m.TaskVideoConvert))
 	builder.WriteString(", ")
+	builder.WriteString("task_video_validate=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TaskVideoValidate))
+	builder.WriteString(", ")
 	builder.WriteString("task_video_move=")
 	builder.WriteString(fmt.Sprintf("%v", _m.TaskVideoMove))
 	builder.WriteString(", ")
@@ -361,6 +485,45 @@ func (_m *Queue) String() string {
 	builder.WriteString("render_chat=")
 	builder.WriteString(fmt.Sprintf("%v", _m.RenderChat))
 	builder.WriteString(", ")
+	builder.WriteString("video_download_attempts=")
+	builder.WriteString(fmt.Sprintf("%v", _m.VideoDownloadAttempts))
+	builder.WriteString(", ")
+	builder.WriteString("video_download_resume_fragment=")
+	builder.WriteString(fmt.Sprintf("%v", _m.VideoDownloadResumeFragment))
+	builder.WriteString(", ")
+	builder.WriteString("video_download_resume_bytes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.VideoDownloadResumeBytes))
+	builder.WriteString(", ")
+	builder.WriteString("video_download_progress_at=")
+	builder.WriteString(_m.VideoDownloadProgressAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("video_validation_verdict=")
+	builder.WriteString(fmt.Sprintf("%v", _m.VideoValidationVerdict))
+	builder.WriteString(", ")
+	builder.WriteString("video_validation_message=")
+	builder.WriteString(_m.VideoValidationMessage)
+	builder.WriteString(", ")
+	builder.WriteString("video_validation_expected_duration=")
+	builder.WriteString(fmt.Sprintf("%v", _m.VideoValidationExpectedDuration))
+	builder.WriteString(", ")
+	builder.WriteString("video_validation_measured_duration=")
+	builder.WriteString(fmt.Sprintf("%v", _m.VideoValidationMeasuredDuration))
+	builder.WriteString(", ")
+	builder.WriteString("video_validation_has_video=")
+	builder.WriteString(fmt.Sprintf("%v", _m.VideoValidationHasVideo))
+	builder.WriteString(", ")
+	builder.WriteString("video_validation_has_audio=")
+	builder.WriteString(fmt.Sprintf("%v", _m.VideoValidationHasAudio))
+	builder.WriteString(", ")
+	builder.WriteString("video_validation_retries=")
+	builder.WriteString(fmt.Sprintf("%v", _m.VideoValidationRetries))
+	builder.WriteString(", ")
+	builder.WriteString("video_validated_at=")
+	builder.WriteString(_m.VideoValidatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("task_progress=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TaskProgress))
+	builder.WriteString(", ")
 	builder.WriteString("workflow_id=")
 	builder.WriteString(_m.WorkflowID)
 	builder.WriteString(", ")
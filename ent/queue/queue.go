@@ -37,6 +37,8 @@ const (
 	FieldTaskVideoDownload = "task_video_download"
 	// FieldTaskVideoConvert holds the string denoting the task_video_convert field in the database.
 	FieldTaskVideoConvert = "task_video_convert"
+	// FieldTaskVideoValidate holds the string denoting the task_video_validate field in the database.
+	FieldTaskVideoValidate = "task_video_validate"
 	// FieldTaskVideoMove holds the string denoting the task_video_move field in the database.
 	FieldTaskVideoMove = "task_video_move"
 	// FieldTaskChatDownload holds the string denoting the task_chat_download field in the database.
@@ -53,6 +55,32 @@ const (
 	FieldArchiveChat = "archive_chat"
 	// FieldRenderChat holds the string denoting the render_chat field in the database.
 	FieldRenderChat = "render_chat"
+	// FieldVideoDownloadAttempts holds the string denoting the video_download_attempts field in the database.
+	FieldVideoDownloadAttempts = "video_download_attempts"
+	// FieldVideoDownloadResumeFragment holds the string denoting the video_download_resume_fragment field in the database.
+	FieldVideoDownloadResumeFragment = "video_download_resume_fragment"
+	// FieldVideoDownloadResumeBytes holds the string denoting the video_download_resume_bytes field in the database.
+	FieldVideoDownloadResumeBytes = "video_download_resume_bytes"
+	// FieldVideoDownloadProgressAt holds the string denoting the video_download_progress_at field in the database.
+	FieldVideoDownloadProgressAt = "video_download_progress_at"
+	// FieldVideoValidationVerdict holds the string denoting the video_validation_verdict field in the database.
+	FieldVideoValidationVerdict = "video_validation_verdict"
+	// FieldVideoValidationMessage holds the string denoting the video_validation_message field in the database.
+	FieldVideoValidationMessage = "video_validation_message"
+	// FieldVideoValidationExpectedDuration holds the string denoting the video_validation_expected_duration field in the database.
+	FieldVideoValidationExpectedDuration = "video_validation_expected_duration"
+	// FieldVideoValidationMeasuredDuration holds the string denoting the video_validation_measured_duration field in the database.
+	FieldVideoValidationMeasuredDuration = "video_validation_measured_duration"
+	// FieldVideoValidationHasVideo holds the string denoting the video_validation_has_video field in the database.
+	FieldVideoValidationHasVideo = "video_validation_has_video"
+	// FieldVideoValidationHasAudio holds the string denoting the video_validation_has_audio field in the database.
+	FieldVideoValidationHasAudio = "video_validation_has_audio"
+	// FieldVideoValidationRetries holds the string denoting the video_validation_retries field in the database.
+	FieldVideoValidationRetries = "video_validation_retries"
+	// FieldVideoValidatedAt holds the string denoting the video_validated_at field in the database.
+	FieldVideoValidatedAt = "video_validated_at"
+	// FieldTaskProgress holds the string denoting the task_progress field in the database.
+	FieldTaskProgress = "task_progress"
 	// FieldWorkflowID holds the string denoting the workflow_id field in the database.
 	FieldWorkflowID = "workflow_id"
 	// FieldWorkflowRunID holds the string denoting the workflow_run_id field in the database.
@@ -87,6 +115,7 @@ var Columns = []string{
 	FieldTaskVodSaveInfo,
 	FieldTaskVideoDownload,
 	FieldTaskVideoConvert,
+	FieldTaskVideoValidate,
 	FieldTaskVideoMove,
 	FieldTaskChatDownload,
 	FieldTaskChatConvert,
@@ -95,6 +124,19 @@ var Columns = []string{
 	FieldChatStart,
 	FieldArchiveChat,
 	FieldRenderChat,
+	FieldVideoDownloadAttempts,
+	FieldVideoDownloadResumeFragment,
+	FieldVideoDownloadResumeBytes,
+	FieldVideoDownloadProgressAt,
+	FieldVideoValidationVerdict,
+	FieldVideoValidationMessage,
+	FieldVideoValidationExpectedDuration,
+	FieldVideoValidationMeasuredDuration,
+	FieldVideoValidationHasVideo,
+	FieldVideoValidationHasAudio,
+	FieldVideoValidationRetries,
+	FieldVideoValidatedAt,
+	FieldTaskProgress,
 	FieldWorkflowID,
 	FieldWorkflowRunID,
 	FieldUpdatedAt,
@@ -137,6 +179,10 @@ var (
 	DefaultArchiveChat bool
 	// DefaultRenderChat holds the default value on creation for the "render_chat" field.
 	DefaultRenderChat bool
+	// DefaultVideoDownloadAttempts holds the default value on creation for the "video_download_attempts" field.
+	DefaultVideoDownloadAttempts int
+	// DefaultVideoValidationRetries holds the default value on creation for the "video_validation_retries" field.
+	DefaultVideoValidationRetries int
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
 	DefaultUpdatedAt func() time.Time
 	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
@@ -207,6 +253,18 @@ func TaskVideoConvertValidator(tvc utils.TaskStatus) error {
 	}
 }
 
+const DefaultTaskVideoValidate utils.TaskStatus = "pending"
+
+// TaskVideoValidateValidator is a validator for the "task_video_validate" field enum values. It is called by the builders before save.
+func TaskVideoValidateValidator(tvv utils.TaskStatus) error {
+	switch tvv {
+	case "success", "running", "pending", "failed":
+		return nil
+	default:
+		return fmt.Errorf("queue: invalid enum value for task_video_validate field: %q", tvv)
+	}
+}
+
 const DefaultTaskVideoMove utils.TaskStatus = "pending"
 
 // TaskVideoMoveValidator is a validator for the "task_video_move" field enum values. It is called by the builders before save.
@@ -267,6 +325,16 @@ func TaskChatMoveValidator(tcm utils.TaskStatus) error {
 	}
 }
 
+// VideoValidationVerdictValidator is a validator for the "video_validation_verdict" field enum values. It is called by the builders before save.
+func VideoValidationVerdictValidator(vvv utils.VideoValidationVerdict) error {
+	switch vvv {
+	case "passed", "failed":
+		return nil
+	default:
+		return fmt.Errorf("queue: invalid enum value for video_validation_verdict field: %q", vvv)
+	}
+}
+
 // OrderOption defines the ordering options for the Queue queries.
 type OrderOption func(*sql.Selector)
 
@@ -325,6 +393,11 @@ func ByTaskVideoConvert(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTaskVideoConvert, opts...).ToFunc()
 }
 
+// ByTaskVideoValidate orders the results by the task_video_validate field.
+func ByTaskVideoValidate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTaskVideoValidate, opts...).ToFunc()
+}
+
 // ByTaskVideoMove orders the results by the task_video_move field.
 func ByTaskVideoMove(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTaskVideoMove, opts...).ToFunc()
@@ -365,6 +438,66 @@ func ByRenderChat(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldRenderChat, opts...).ToFunc()
 }
 
+// ByVideoDownloadAttempts orders the results by the video_download_attempts field.
+func ByVideoDownloadAttempts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVideoDownloadAttempts, opts...).ToFunc()
+}
+
+// ByVideoDownloadResumeFragment orders the results by the video_download_resume_fragment field.
+func ByVideoDownloadResumeFragment(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVideoDownloadResumeFragment, opts...).ToFunc()
+}
+
+// ByVideoDownloadResumeBytes orders the results by the video_download_resume_bytes field.
+func ByVideoDownloadResumeBytes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVideoDownloadResumeBytes, opts...).ToFunc()
+}
+
+// ByVideoDownloadProgressAt orders the results by the video_download_progress_at field.
+func ByVideoDownloadProgressAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVideoDownloadProgressAt, opts...).ToFunc()
+}
+
+// ByVideoValidationVerdict orders the results by the video_validation_verdict field.
+func ByVideoValidationVerdict(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVideoValidationVerdict, opts...).ToFunc()
+}
+
+// ByVideoValidationMessage orders the results by the video_validation_message field.
+func ByVideoValidationMessage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVideoValidationMessage, opts...).ToFunc()
+}
+
+// ByVideoValidationExpectedDuration orders the results by the video_validation_expected_duration field.
+func ByVideoValidationExpectedDuration(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVideoValidationExpectedDuration, opts...).ToFunc()
+}
+
+// ByVideoValidationMeasuredDuration orders the results by the video_validation_measured_duration field.
+func ByVideoValidationMeasuredDuration(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVideoValidationMeasuredDuration, opts...).ToFunc()
+}
+
+// ByVideoValidationHasVideo orders the results by the video_validation_has_video field.
+func ByVideoValidationHasVideo(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVideoValidationHasVideo, opts...).ToFunc()
+}
+
+// ByVideoValidationHasAudio orders the results by the video_validation_has_audio field.
+func ByVideoValidationHasAudio(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVideoValidationHasAudio, opts...).ToFunc()
+}
+
+// ByVideoValidationRetries orders the results by the video_validation_retries field.
+func ByVideoValidationRetries(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVideoValidationRetries, opts...).ToFunc()
+}
+
+// ByVideoValidatedAt orders the results by the video_validated_at field.
+func ByVideoValidatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVideoValidatedAt, opts...).ToFunc()
+}
+
 // ByWorkflowID orders the results by the workflow_id field.
 func ByWorkflowID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldWorkflowID, opts...).ToFunc()
@@ -97,6 +97,61 @@ func RenderChat(v bool) predicate.Queue {
 	return predicate.Queue(sql.FieldEQ(FieldRenderChat, v))
 }
 
+// VideoDownloadAttempts applies equality check predicate on the "video_download_attempts" field. It's identical to VideoDownloadAttemptsEQ.
+func VideoDownloadAttempts(v int) predicate.Queue {
+	return predicate.Queue(sql.FieldEQ(FieldVideoDownloadAttempts, v))
+}
+
+// VideoDownloadResumeFragment applies equality check predicate on the "video_download_resume_fragment" field. It's identical to VideoDownloadResumeFragmentEQ.
+func VideoDownloadResumeFragment(v int) predicate.Queue {
+	return predicate.Queue(sql.FieldEQ(FieldVideoDownloadResumeFragment, v))
+}
+
+// VideoDownloadResumeBytes applies equality check predicate on the "video_download_resume_bytes" field. It's identical to VideoDownloadResumeBytesEQ.
+func VideoDownloadResumeBytes(v int64) predicate.Queue {
+	return predicate.Queue(sql.FieldEQ(FieldVideoDownloadResumeBytes, v))
+}
+
+// VideoDownloadProgressAt applies equality check predicate on the "video_download_progress_at" field. It's identical to VideoDownloadProgressAtEQ.
+func VideoDownloadProgressAt(v time.Time) predicate.Queue {
+	return predicate.Queue(sql.FieldEQ(FieldVideoDownloadProgressAt, v))
+}
+
+// VideoValidationMessage applies equality check predicate on the "video_validation_message" field. It's identical to VideoValidationMessageEQ.
+func VideoValidationMessage(v string) predicate.Queue {
+	return predicate.Queue(sql.FieldEQ(FieldVideoValidationMessage, v))
+}
+
+// VideoValidationExpectedDuration applies equality check predicate on the "video_validation_expected_duration" field. It's identical to VideoValidationExpectedDurationEQ.
+func VideoValidationExpectedDuration(v float64) predicate.Queue {
+	return predicate.Queue(sql.FieldEQ(FieldVideoValidationExpectedDuration, v))
+}
+
+// VideoValidationMeasuredDuration applies equality check predicate on the "video_validation_measured_duration" field. It's identical to VideoValidationMeasuredDurationEQ.
+func VideoValidationMeasuredDuration(v float64) predicate.Queue {
+	return predicate.Queue(sql.FieldEQ(FieldVideoValidationMeasuredDuration, v))
+}
+
+// VideoValidationHasVideo applies equality check predicate on the "video_validation_has_video" field. It's identical to VideoValidationHasVideoEQ.
+func VideoValidationHasVideo(v bool) predicate.Queue {
+	return predicate.Queue(sql.FieldEQ(FieldVideoValidationHasVideo, v))
+}
+
+// VideoValidationHasAudio applies equality check predicate on the "video_validation_has_audio" field. It's identical to VideoValidationHasAudioEQ.
+func VideoValidationHasAudio(v bool) predicate.Queue {
+	return predicate.Queue(sql.FieldEQ(FieldVideoValidationHasAudio, v))
+}
+
+// VideoValidationRetries applies equality check predicate on the "video_validation_retries" field. It's identical to VideoValidationRetriesEQ.
+func VideoValidationRetries(v int) predicate.Queue {
+	return predicate.Queue(sql.FieldEQ(FieldVideoValidationRetries, v))
+}
+
+// VideoValidatedAt applies equality check predicate on the "video_validated_at" field. It's identical to VideoValidatedAtEQ.
+func VideoValidatedAt(v time.Time) predicate.Queue {
+	return predicate.Queue(sql.FieldEQ(FieldVideoValidatedAt, v))
+}
+
 // WorkflowID applies equality check predicate on the "workflow_id" field. It's identical to WorkflowIDEQ.
 func WorkflowID(v string) predicate.Queue {
 	return predicate.Queue(sql.FieldEQ(FieldWorkflowID, v))
@@ -367,6 +422,46 @@ func TaskVideoConvertNotNil() predicate.Queue {
 	return predicate.Queue(sql.FieldNotNull(FieldTaskVideoConvert))
 }
 
+// TaskVideoValidateEQ applies the EQ predicate on the "task_video_validate" field.
+func TaskVideoValidateEQ(v utils.TaskStatus) predicate.Queue {
+	vc := v
+	return predicate.Queue(sql.FieldEQ(FieldTaskVideoValidate, vc))
+}
+
+// TaskVideoValidateNEQ applies the NEQ predicate on the "task_video_validate" field.
+func TaskVideoValidateNEQ(v utils.TaskStatus) predicate.Queue {
+	vc := v
+	return predicate.Queue(sql.FieldNEQ(FieldTaskVideoValidate, vc))
+}
+
+// TaskVideoValidateIn applies the In predicate on the "task_video_validate" field.
+func TaskVideoValidateIn(vs ...utils.TaskStatus) predicate.Queue {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Queue(sql.FieldIn(FieldTaskVideoValidate, v...))
+}
+
+// TaskVideoValidateNotIn applies the NotIn predicate on the "task_video_validate" field.
+func TaskVideoValidateNotIn(vs ...utils.TaskStatus) predicate.Queue {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Queue(sql.FieldNotIn(FieldTaskVideoValidate, v...))
+}
+
+// TaskVideoValidateIsNil applies the IsNil predicate on the "task_video_validate" field.
+func TaskVideoValidateIsNil() predicate.Queue {
+	return predicate.Queue(sql.FieldIsNull(FieldTaskVideoValidate))
+}
+
+// TaskVideoValidateNotNil applies the NotNil predicate on the "task_video_validate" field.
+func TaskVideoValidateNotNil() predicate.Queue {
+	return predicate.Queue(sql.FieldNotNull(FieldTaskVideoValidate))
+}
+
 // TaskVideoMoveEQ applies the EQ predicate on the "task_video_move" field.
 func TaskVideoMoveEQ(v utils.TaskStatus) predicate.Queue {
 	vc := v
@@ -657,6 +752,571 @@ func RenderChatNotNil() predicate.Queue {
 	return predicate.Queue(sql.FieldNotNull(FieldRenderChat))
 }
 
+// VideoDownloadAttemptsEQ applies the EQ predicate on the "video_download_attempts" field.
+func VideoDownloadAttemptsEQ(v int) predicate.Queue {
+	return predicate.Queue(sql.FieldEQ(FieldVideoDownloadAttempts, v))
+}
+
+// VideoDownloadAttemptsNEQ applies the NEQ predicate on the "video_download_attempts" field.
+func VideoDownloadAttemptsNEQ(v int) predicate.Queue {
+	return predicate.Queue(sql.FieldNEQ(FieldVideoDownloadAttempts, v))
+}
+
+// VideoDownloadAttemptsIn applies the In predicate on the "video_download_attempts" field.
+func VideoDownloadAttemptsIn(vs ...int) predicate.Queue {
+	return predicate.Queue(sql.FieldIn(FieldVideoDownloadAttempts, vs...))
+}
+
+// VideoDownloadAttemptsNotIn applies the NotIn predicate on the "video_download_attempts" field.
+func VideoDownloadAttemptsNotIn(vs ...int) predicate.Queue {
+	return predicate.Queue(sql.FieldNotIn(FieldVideoDownloadAttempts, vs...))
+}
+
+// VideoDownloadAttemptsGT applies the GT predicate on the "video_download_attempts" field.
+func VideoDownloadAttemptsGT(v int) predicate.Queue {
+	return predicate.Queue(sql.FieldGT(FieldVideoDownloadAttempts, v))
+}
+
+// VideoDownloadAttemptsGTE applies the GTE predicate on the "video_download_attempts" field.
+func VideoDownloadAttemptsGTE(v int) predicate.Queue {
+	return predicate.Queue(sql.FieldGTE(FieldVideoDownloadAttempts, v))
+}
+
+// VideoDownloadAttemptsLT applies the LT predicate on the "video_download_attempts" field.
+func VideoDownloadAttemptsLT(v int) predicate.Queue {
+	return predicate.Queue(sql.FieldLT(FieldVideoDownloadAttempts, v))
+}
+
+// VideoDownloadAttemptsLTE applies the LTE predicate on the "video_download_attempts" field.
+func VideoDownloadAttemptsLTE(v int) predicate.Queue {
+	return predicate.Queue(sql.FieldLTE(FieldVideoDownloadAttempts, v))
+}
+
+// VideoDownloadAttemptsIsNil applies the IsNil predicate on the "video_download_attempts" field.
+func VideoDownloadAttemptsIsNil() predicate.Queue {
+	return predicate.Queue(sql.FieldIsNull(FieldVideoDownloadAttempts))
+}
+
+// VideoDownloadAttemptsNotNil applies the NotNil predicate on the "video_download_attempts" field.
+func VideoDownloadAttemptsNotNil() predicate.Queue {
+	return predicate.Queue(sql.FieldNotNull(FieldVideoDownloadAttempts))
+}
+
+// VideoDownloadResumeFragmentEQ applies the EQ predicate on the "video_download_resume_fragment" field.
+func VideoDownloadResumeFragmentEQ(v int) predicate.Queue {
+	return predicate.Queue(sql.FieldEQ(FieldVideoDownloadResumeFragment, v))
+}
+
+// VideoDownloadResumeFragmentNEQ applies the NEQ predicate on the "video_download_resume_fragment" field.
+func VideoDownloadResumeFragmentNEQ(v int) predicate.Queue {
+	return predicate.Queue(sql.FieldNEQ(FieldVideoDownloadResumeFragment, v))
+}
+
+// VideoDownloadResumeFragmentIn applies the In predicate on the "video_download_resume_fragment" field.
+func VideoDownloadResumeFragmentIn(vs ...int) predicate.Queue {
+	return predicate.Queue(sql.FieldIn(FieldVideoDownloadResumeFragment, vs...))
+}
+
+// VideoDownloadResumeFragmentNotIn applies the NotIn predicate on the "video_download_resume_fragment" field.
+func VideoDownloadResumeFragmentNotIn(vs ...int) predicate.Queue {
+	return predicate.Queue(sql.FieldNotIn(FieldVideoDownloadResumeFragment, vs...))
+}
+
+// VideoDownloadResumeFragmentGT applies the GT predicate on the "video_download_resume_fragment" field.
+func VideoDownloadResumeFragmentGT(v int) predicate.Queue {
+	return predicate.Queue(sql.FieldGT(FieldVideoDownloadResumeFragment, v))
+}
+
+// VideoDownloadResumeFragmentGTE applies the GTE predicate on the "video_download_resume_fragment" field.
+func VideoDownloadResumeFragmentGTE(v int) predicate.Queue {
+	return predicate.Queue(sql.FieldGTE(FieldVideoDownloadResumeFragment, v))
+}
+
+// VideoDownloadResumeFragmentLT applies the LT predicate on the "video_download_resume_fragment" field.
+func VideoDownloadResumeFragmentLT(v int) predicate.Queue {
+	return predicate.Queue(sql.FieldLT(FieldVideoDownloadResumeFragment, v))
+}
+
+// VideoDownloadResumeFragmentLTE applies the LTE predicate on the "video_download_resume_fragment" field.
+func VideoDownloadResumeFragmentLTE(v int) predicate.Queue {
+	return predicate.Queue(sql.FieldLTE(FieldVideoDownloadResumeFragment, v))
+}
+
+// VideoDownloadResumeFragmentIsNil applies the IsNil predicate on the "video_download_resume_fragment" field.
+func VideoDownloadResumeFragmentIsNil() predicate.Queue {
+	return predicate.Queue(sql.FieldIsNull(FieldVideoDownloadResumeFragment))
+}
+
+// VideoDownloadResumeFragmentNotNil applies the NotNil predicate on the "video_download_resume_fragment" field.
+func VideoDownloadResumeFragmentNotNil() predicate.Queue {
+	return predicate.Queue(sql.FieldNotNull(FieldVideoDownloadResumeFragment))
+}
+
+// VideoDownloadResumeBytesEQ applies the EQ predicate on the "video_download_resume_bytes" field.
+func VideoDownloadResumeBytesEQ(v int64) predicate.Queue {
+	return predicate.Queue(sql.FieldEQ(FieldVideoDownloadResumeBytes, v))
+}
+
+// VideoDownloadResumeBytesNEQ applies the NEQ predicate on the "video_download_resume_bytes" field.
+func VideoDownloadResumeBytesNEQ(v int64) predicate.Queue {
+	return predicate.Queue(sql.FieldNEQ(FieldVideoDownloadResumeBytes, v))
+}
+
+// VideoDownloadResumeBytesIn applies the In predicate on the "video_download_resume_bytes" field.
+func VideoDownloadResumeBytesIn(vs ...int64) predicate.Queue {
+	return predicate.Queue(sql.FieldIn(FieldVideoDownloadResumeBytes, vs...))
+}
+
+// VideoDownloadResumeBytesNotIn applies the NotIn predicate on the "video_download_resume_bytes" field.
+func VideoDownloadResumeBytesNotIn(vs ...int64) predicate.Queue {
+	return predicate.Queue(sql.FieldNotIn(FieldVideoDownloadResumeBytes, vs...))
+}
+
+// VideoDownloadResumeBytesGT applies the GT predicate on the "video_download_resume_bytes" field.
+func VideoDownloadResumeBytesGT(v int64) predicate.Queue {
+	return predicate.Queue(sql.FieldGT(FieldVideoDownloadResumeBytes, v))
+}
+
+// VideoDownloadResumeBytesGTE applies the GTE predicate on the "video_download_resume_bytes" field.
+func VideoDownloadResumeBytesGTE(v int64) predicate.Queue {
+	return predicate.Queue(sql.FieldGTE(FieldVideoDownloadResumeBytes, v))
+}
+
+// VideoDownloadResumeBytesLT applies the LT predicate on the "video_download_resume_bytes" field.
+func VideoDownloadResumeBytesLT(v int64) predicate.Queue {
+	return predicate.Queue(sql.FieldLT(FieldVideoDownloadResumeBytes, v))
+}
+
+// VideoDownloadResumeBytesLTE applies the LTE predicate on the "video_download_resume_bytes" field.
+func VideoDownloadResumeBytesLTE(v int64) predicate.Queue {
+	return predicate.Queue(sql.FieldLTE(FieldVideoDownloadResumeBytes, v))
+}
+
+// VideoDownloadResumeBytesIsNil applies the IsNil predicate on the "video_download_resume_bytes" field.
+func VideoDownloadResumeBytesIsNil() predicate.Queue {
+	return predicate.Queue(sql.FieldIsNull(FieldVideoDownloadResumeBytes))
+}
+
+// VideoDownloadResumeBytesNotNil applies the NotNil predicate on the "video_download_resume_bytes" field.
+func VideoDownloadResumeBytesNotNil() predicate.Queue {
+	return predicate.Queue(sql.FieldNotNull(FieldVideoDownloadResumeBytes))
+}
+
+// VideoDownloadProgressAtEQ applies the EQ predicate on the "video_download_progress_at" field.
+func VideoDownloadProgressAtEQ(v time.Time) predicate.Queue {
+	return predicate.Queue(sql.FieldEQ(FieldVideoDownloadProgressAt, v))
+}
+
+// VideoDownloadProgressAtNEQ applies the NEQ predicate on the "video_download_progress_at" field.
+func VideoDownloadProgressAtNEQ(v time.Time) predicate.Queue {
+	return predicate.Queue(sql.FieldNEQ(FieldVideoDownloadProgressAt, v))
+}
+
+// VideoDownloadProgressAtIn applies the In predicate on the "video_download_progress_at" field.
+func VideoDownloadProgressAtIn(vs ...time.Time) predicate.Queue {
+	return predicate.Queue(sql.FieldIn(FieldVideoDownloadProgressAt, vs...))
+}
+
+// VideoDownloadProgressAtNotIn applies the NotIn predicate on the "video_download_progress_at" field.
+func VideoDownloadProgressAtNotIn(vs ...time.Time) predicate.Queue {
+	return predicate.Queue(sql.FieldNotIn(FieldVideoDownloadProgressAt, vs...))
+}
+
+// VideoDownloadProgressAtGT applies the GT predicate on the "video_download_progress_at" field.
+func VideoDownloadProgressAtGT(v time.Time) predicate.Queue {
+	return predicate.Queue(sql.FieldGT(FieldVideoDownloadProgressAt, v))
+}
+
+// VideoDownloadProgressAtGTE applies the GTE predicate on the "video_download_progress_at" field.
+func VideoDownloadProgressAtGTE(v time.Time) predicate.Queue {
+	return predicate.Queue(sql.FieldGTE(FieldVideoDownloadProgressAt, v))
+}
+
+// VideoDownloadProgressAtLT applies the LT predicate on the "video_download_progress_at" field.
+func VideoDownloadProgressAtLT(v time.Time) predicate.Queue {
+	return predicate.Queue(sql.FieldLT(FieldVideoDownloadProgressAt, v))
+}
+
+// VideoDownloadProgressAtLTE applies the LTE predicate on the "video_download_progress_at" field.
+func VideoDownloadProgressAtLTE(v time.Time) predicate.Queue {
+	return predicate.Queue(sql.FieldLTE(FieldVideoDownloadProgressAt, v))
+}
+
+// VideoDownloadProgressAtIsNil applies the IsNil predicate on the "video_download_progress_at" field.
+func VideoDownloadProgressAtIsNil() predicate.Queue {
+	return predicate.Queue(sql.FieldIsNull(FieldVideoDownloadProgressAt))
+}
+
+// VideoDownloadProgressAtNotNil applies the NotNil predicate on the "video_download_progress_at" field.
+func VideoDownloadProgressAtNotNil() predicate.Queue {
+	return predicate.Queue(sql.FieldNotNull(FieldVideoDownloadProgressAt))
+}
+
+// VideoValidationVerdictEQ applies the EQ predicate on the "video_validation_verdict" field.
+func VideoValidationVerdictEQ(v utils.VideoValidationVerdict) predicate.Queue {
+	vc := v
+	return predicate.Queue(sql.FieldEQ(FieldVideoValidationVerdict, vc))
+}
+
+// VideoValidationVerdictNEQ applies the NEQ predicate on the "video_validation_verdict" field.
+func VideoValidationVerdictNEQ(v utils.VideoValidationVerdict) predicate.Queue {
+	vc := v
+	return predicate.Queue(sql.FieldNEQ(FieldVideoValidationVerdict, vc))
+}
+
+// VideoValidationVerdictIn applies the In predicate on the "video_validation_verdict" field.
+func VideoValidationVerdictIn(vs ...utils.VideoValidationVerdict) predicate.Queue {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Queue(sql.FieldIn(FieldVideoValidationVerdict, v...))
+}
+
+// VideoValidationVerdictNotIn applies the NotIn predicate on the "video_validation_verdict" field.
+func VideoValidationVerdictNotIn(vs ...utils.VideoValidationVerdict) predicate.Queue {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Queue(sql.FieldNotIn(FieldVideoValidationVerdict, v...))
+}
+
+// VideoValidationVerdictIsNil applies the IsNil predicate on the "video_validation_verdict" field.
+func VideoValidationVerdictIsNil() predicate.Queue {
+	return predicate.Queue(sql.FieldIsNull(FieldVideoValidationVerdict))
+}
+
+// VideoValidationVerdictNotNil applies the NotNil predicate on the "video_validation_verdict" field.
+func VideoValidationVerdictNotNil() predicate.Queue {
+	return predicate.Queue(sql.FieldNotNull(FieldVideoValidationVerdict))
+}
+
+// VideoValidationMessageEQ applies the EQ predicate on the "video_validation_message" field.
+func VideoValidationMessageEQ(v string) predicate.Queue {
+	return predicate.Queue(sql.FieldEQ(FieldVideoValidationMessage, v))
+}
+
+// VideoValidationMessageNEQ applies the NEQ predicate on the "video_validation_message" field.
+func VideoValidationMessageNEQ(v string) predicate.Queue {
+	return predicate.Queue(sql.FieldNEQ(FieldVideoValidationMessage, v))
+}
+
+// VideoValidationMessageIn applies the In predicate on the "video_validation_message" field.
+func VideoValidationMessageIn(vs ...string) predicate.Queue {
+	return predicate.Queue(sql.FieldIn(FieldVideoValidationMessage, vs...))
+}
+
+// VideoValidationMessageNotIn applies the NotIn predicate on the "video_validation_message" field.
+func VideoValidationMessageNotIn(vs ...string) predicate.Queue {
+	return predicate.Queue(sql.FieldNotIn(FieldVideoValidationMessage, vs...))
+}
+
+// VideoValidationMessageGT applies the GT predicate on the "video_validation_message" field.
+func VideoValidationMessageGT(v string) predicate.Queue {
+	return predicate.Queue(sql.FieldGT(FieldVideoValidationMessage, v))
+}
+
+// VideoValidationMessageGTE applies the GTE predicate on the "video_validation_message" field.
+func VideoValidationMessageGTE(v string) predicate.Queue {
+	return predicate.Queue(sql.FieldGTE(FieldVideoValidationMessage, v))
+}
+
+// VideoValidationMessageLT applies the LT predicate on the "video_validation_message" field.
+func VideoValidationMessageLT(v string) predicate.Queue {
+	return predicate.Queue(sql.FieldLT(FieldVideoValidationMessage, v))
+}
+
+// VideoValidationMessageLTE applies the LTE predicate on the "video_validation_message" field.
+func VideoValidationMessageLTE(v string) predicate.Queue {
+	return predicate.Queue(sql.FieldLTE(FieldVideoValidationMessage, v))
+}
+
+// VideoValidationMessageContains applies the Contains predicate on the "video_validation_message" field.
+func VideoValidationMessageContains(v string) predicate.Queue {
+	return predicate.Queue(sql.FieldContains(FieldVideoValidationMessage, v))
+}
+
+// VideoValidationMessageHasPrefix applies the HasPrefix predicate on the "video_validation_message" field.
+func VideoValidationMessageHasPrefix(v string) predicate.Queue {
+	return predicate.Queue(sql.FieldHasPrefix(FieldVideoValidationMessage, v))
+}
+
+// VideoValidationMessageHasSuffix applies the HasSuffix predicate on the "video_validation_message" field.
+func VideoValidationMessageHasSuffix(v string) predicate.Queue {
+	return predicate.Queue(sql.FieldHasSuffix(FieldVideoValidationMessage, v))
+}
+
+// VideoValidationMessageIsNil applies the IsNil predicate on the "video_validation_message" field.
+func VideoValidationMessageIsNil() predicate.Queue {
+	return predicate.Queue(sql.FieldIsNull(FieldVideoValidationMessage))
+}
+
+// VideoValidationMessageNotNil applies the NotNil predicate on the "video_validation_message" field.
+func VideoValidationMessageNotNil() predicate.Queue {
+	return predicate.Queue(sql.FieldNotNull(FieldVideoValidationMessage))
+}
+
+// VideoValidationMessageEqualFold applies the EqualFold predicate on the "video_validation_message" field.
+func VideoValidationMessageEqualFold(v string) predicate.Queue {
+	return predicate.Queue(sql.FieldEqualFold(FieldVideoValidationMessage, v))
+}
+
+// VideoValidationMessageContainsFold applies the ContainsFold predicate on the "video_validation_message" field.
+func VideoValidationMessageContainsFold(v string) predicate.Queue {
+	return predicate.Queue(sql.FieldContainsFold(FieldVideoValidationMessage, v))
+}
+
+// VideoValidationExpectedDurationEQ applies the EQ predicate on the "video_validation_expected_duration" field.
+func VideoValidationExpectedDurationEQ(v float64) predicate.Queue {
+	return predicate.Queue(sql.FieldEQ(FieldVideoValidationExpectedDuration, v))
+}
+
+// VideoValidationExpectedDurationNEQ applies the NEQ predicate on the "video_validation_expected_duration" field.
+func VideoValidationExpectedDurationNEQ(v float64) predicate.Queue {
+	return predicate.Queue(sql.FieldNEQ(FieldVideoValidationExpectedDuration, v))
+}
+
+// VideoValidationExpectedDurationIn applies the In predicate on the "video_validation_expected_duration" field.
+func VideoValidationExpectedDurationIn(vs ...float64) predicate.Queue {
+	return predicate.Queue(sql.FieldIn(FieldVideoValidationExpectedDuration, vs...))
+}
+
+// VideoValidationExpectedDurationNotIn applies the NotIn predicate on the "video_validation_expected_duration" field.
+func VideoValidationExpectedDurationNotIn(vs ...float64) predicate.Queue {
+	return predicate.Queue(sql.FieldNotIn(FieldVideoValidationExpectedDuration, vs...))
+}
+
+// VideoValidationExpectedDurationGT applies the GT predicate on the "video_validation_expected_duration" field.
+func VideoValidationExpectedDurationGT(v float64) predicate.Queue {
+	return predicate.Queue(sql.FieldGT(FieldVideoValidationExpectedDuration, v))
+}
+
+// VideoValidationExpectedDurationGTE applies the GTE predicate on the "video_validation_expected_duration" field.
+func VideoValidationExpectedDurationGTE(v float64) predicate.Queue {
+	return predicate.Queue(sql.FieldGTE(FieldVideoValidationExpectedDuration, v))
+}
+
+// VideoValidationExpectedDurationLT applies the LT predicate on the "video_validation_expected_duration" field.
+func VideoValidationExpectedDurationLT(v float64) predicate.Queue {
+	return predicate.Queue(sql.FieldLT(FieldVideoValidationExpectedDuration, v))
+}
+
+// VideoValidationExpectedDurationLTE applies the LTE predicate on the "video_validation_expected_duration" field.
+func VideoValidationExpectedDurationLTE(v float64) predicate.Queue {
+	return predicate.Queue(sql.FieldLTE(FieldVideoValidationExpectedDuration, v))
+}
+
+// VideoValidationExpectedDurationIsNil applies the IsNil predicate on the "video_validation_expected_duration" field.
+func VideoValidationExpectedDurationIsNil() predicate.Queue {
+	return predicate.Queue(sql.FieldIsNull(FieldVideoValidationExpectedDuration))
+}
+
+// VideoValidationExpectedDurationNotNil applies the NotNil predicate on the "video_validation_expected_duration" field.
+func VideoValidationExpectedDurationNotNil() predicate.Queue {
+	return predicate.Queue(sql.FieldNotNull(FieldVideoValidationExpectedDuration))
+}
+
+// VideoValidationMeasuredDurationEQ applies the EQ predicate on the "video_validation_measured_duration" field.
+func VideoValidationMeasuredDurationEQ(v float64) predicate.Queue {
+	return predicate.Queue(sql.FieldEQ(FieldVideoValidationMeasuredDuration, v))
+}
+
+// VideoValidationMeasuredDurationNEQ applies the NEQ predicate on the "video_validation_measured_duration" field.
+func VideoValidationMeasuredDurationNEQ(v float64) predicate.Queue {
+	return predicate.Queue(sql.FieldNEQ(FieldVideoValidationMeasuredDuration, v))
+}
+
+// VideoValidationMeasuredDurationIn applies the In predicate on the "video_validation_measured_duration" field.
+func VideoValidationMeasuredDurationIn(vs ...float64) predicate.Queue {
+	return predicate.Queue(sql.FieldIn(FieldVideoValidationMeasuredDuration, vs...))
+}
+
+// VideoValidationMeasuredDurationNotIn applies the NotIn predicate on the "video_validation_measured_duration" field.
+func VideoValidationMeasuredDurationNotIn(vs ...float64) predicate.Queue {
+	return predicate.Queue(sql.FieldNotIn(FieldVideoValidationMeasuredDuration, vs...))
+}
+
+// VideoValidationMeasuredDurationGT applies the GT predicate on the "video_validation_measured_duration" field.
+func VideoValidationMeasuredDurationGT(v float64) predicate.Queue {
+	return predicate.Queue(sql.FieldGT(FieldVideoValidationMeasuredDuration, v))
+}
+
+// VideoValidationMeasuredDurationGTE applies the GTE predicate on the "video_validation_measured_duration" field.
+func VideoValidationMeasuredDurationGTE(v float64) predicate.Queue {
+	return predicate.Queue(sql.FieldGTE(FieldVideoValidationMeasuredDuration, v))
+}
+
+// VideoValidationMeasuredDurationLT applies the LT predicate on the "video_validation_measured_duration" field.
+func VideoValidationMeasuredDurationLT(v float64) predicate.Queue {
+	return predicate.Queue(sql.FieldLT(FieldVideoValidationMeasuredDuration, v))
+}
+
+// VideoValidationMeasuredDurationLTE applies the LTE predicate on the "video_validation_measured_duration" field.
+func VideoValidationMeasuredDurationLTE(v float64) predicate.Queue {
+	return predicate.Queue(sql.FieldLTE(FieldVideoValidationMeasuredDuration, v))
+}
+
+// VideoValidationMeasuredDurationIsNil applies the IsNil predicate on the "video_validation_measured_duration" field.
+func VideoValidationMeasuredDurationIsNil() predicate.Queue {
+	return predicate.Queue(sql.FieldIsNull(FieldVideoValidationMeasuredDuration))
+}
+
+// VideoValidationMeasuredDurationNotNil applies the NotNil predicate on the "video_validation_measured_duration" field.
+func VideoValidationMeasuredDurationNotNil() predicate.Queue {
+	return predicate.Queue(sql.FieldNotNull(FieldVideoValidationMeasuredDuration))
+}
+
+// VideoValidationHasVideoEQ applies the EQ predicate on the "video_validation_has_video" field.
+func VideoValidationHasVideoEQ(v bool) predicate.Queue {
+	return predicate.Queue(sql.FieldEQ(FieldVideoValidationHasVideo, v))
+}
+
+// VideoValidationHasVideoNEQ applies the NEQ predicate on the "video_validation_has_video" field.
+func VideoValidationHasVideoNEQ(v bool) predicate.Queue {
+	return predicate.Queue(sql.FieldNEQ(FieldVideoValidationHasVideo, v))
+}
+
+// VideoValidationHasVideoIsNil applies the IsNil predicate on the "video_validation_has_video" field.
+func VideoValidationHasVideoIsNil() predicate.Queue {
+	return predicate.Queue(sql.FieldIsNull(FieldVideoValidationHasVideo))
+}
+
+// VideoValidationHasVideoNotNil applies the NotNil predicate on the "video_validation_has_video" field.
+func VideoValidationHasVideoNotNil() predicate.Queue {
+	return predicate.Queue(sql.FieldNotNull(FieldVideoValidationHasVideo))
+}
+
+// VideoValidationHasAudioEQ applies the EQ predicate on the "video_validation_has_audio" field.
+func VideoValidationHasAudioEQ(v bool) predicate.Queue {
+	return predicate.Queue(sql.FieldEQ(FieldVideoValidationHasAudio, v))
+}
+
+// VideoValidationHasAudioNEQ applies the NEQ predicate on the "video_validation_has_audio" field.
+func VideoValidationHasAudioNEQ(v bool) predicate.Queue {
+	return predicate.Queue(sql.FieldNEQ(FieldVideoValidationHasAudio, v))
+}
+
+// VideoValidationHasAudioIsNil applies the IsNil predicate on the "video_validation_has_audio" field.
+func VideoValidationHasAudioIsNil() predicate.Queue {
+	return predicate.Queue(sql.FieldIsNull(FieldVideoValidationHasAudio))
+}
+
+// VideoValidationHasAudioNotNil applies the NotNil predicate on the "video_validation_has_audio" field.
+func VideoValidationHasAudioNotNil() predicate.Queue {
+	return predicate.Queue(sql.FieldNotNull(FieldVideoValidationHasAudio))
+}
+
+// VideoValidationRetriesEQ applies the EQ predicate on the "video_validation_retries" field.
+func VideoValidationRetriesEQ(v int) predicate.Queue {
+	return predicate.Queue(sql.FieldEQ(FieldVideoValidationRetries, v))
+}
+
+// VideoValidationRetriesNEQ applies the NEQ predicate on the "video_validation_retries" field.
+func VideoValidationRetriesNEQ(v int) predicate.Queue {
+	return predicate.Queue(sql.FieldNEQ(FieldVideoValidationRetries, v))
+}
+
+// VideoValidationRetriesIn applies the In predicate on the "video_validation_retries" field.
+func VideoValidationRetriesIn(vs ...int) predicate.Queue {
+	return predicate.Queue(sql.FieldIn(FieldVideoValidationRetries, vs...))
+}
+
+// VideoValidationRetriesNotIn applies the NotIn predicate on the "video_validation_retries" field.
+func VideoValidationRetriesNotIn(vs ...int) predicate.Queue {
+	return predicate.Queue(sql.FieldNotIn(FieldVideoValidationRetries, vs...))
+}
+
+// VideoValidationRetriesGT applies the GT predicate on the "video_validation_retries" field.
+func VideoValidationRetriesGT(v int) predicate.Queue {
+	return predicate.Queue(sql.FieldGT(FieldVideoValidationRetries, v))
+}
+
+// VideoValidationRetriesGTE applies the GTE predicate on the "video_validation_retries" field.
+func VideoValidationRetriesGTE(v int) predicate.Queue {
+	return predicate.Queue(sql.FieldGTE(FieldVideoValidationRetries, v))
+}
+
+// VideoValidationRetriesLT applies the LT predicate on the "video_validation_retries" field.
+func VideoValidationRetriesLT(v int) predicate.Queue {
+	return predicate.Queue(sql.FieldLT(FieldVideoValidationRetries, v))
+}
+
+// VideoValidationRetriesLTE applies the LTE predicate on the "video_validation_retries" field.
+func VideoValidationRetriesLTE(v int) predicate.Queue {
+	return predicate.Queue(sql.FieldLTE(FieldVideoValidationRetries, v))
+}
+
+// VideoValidationRetriesIsNil applies the IsNil predicate on the "video_validation_retries" field.
+func VideoValidationRetriesIsNil() predicate.Queue {
+	return predicate.Queue(sql.FieldIsNull(FieldVideoValidationRetries))
+}
+
+// VideoValidationRetriesNotNil applies the NotNil predicate on the "video_validation_retries" field.
+func VideoValidationRetriesNotNil() predicate.Queue {
+	return predicate.Queue(sql.FieldNotNull(FieldVideoValidationRetries))
+}
+
+// VideoValidatedAtEQ applies the EQ predicate on the "video_validated_at" field.
+func VideoValidatedAtEQ(v time.Time) predicate.Queue {
+	return predicate.Queue(sql.FieldEQ(FieldVideoValidatedAt, v))
+}
+
+// VideoValidatedAtNEQ applies the NEQ predicate on the "video_validated_at" field.
+func VideoValidatedAtNEQ(v time.Time) predicate.Queue {
+	return predicate.Queue(sql.FieldNEQ(FieldVideoValidatedAt, v))
+}
+
+// VideoValidatedAtIn applies the In predicate on the "video_validated_at" field.
+func VideoValidatedAtIn(vs ...time.Time) predicate.Queue {
+	return predicate.Queue(sql.FieldIn(FieldVideoValidatedAt, vs...))
+}
+
+// VideoValidatedAtNotIn applies the NotIn predicate on the "video_validated_at" field.
+func VideoValidatedAtNotIn(vs ...time.Time) predicate.Queue {
+	return predicate.Queue(sql.FieldNotIn(FieldVideoValidatedAt, vs...))
+}
+
+// VideoValidatedAtGT applies the GT predicate on the "video_validated_at" field.
+func VideoValidatedAtGT(v time.Time) predicate.Queue {
+	return predicate.Queue(sql.FieldGT(FieldVideoValidatedAt, v))
+}
+
+// VideoValidatedAtGTE applies the GTE predicate on the "video_validated_at" field.
+func VideoValidatedAtGTE(v time.Time) predicate.Queue {
+	return predicate.Queue(sql.FieldGTE(FieldVideoValidatedAt, v))
+}
+
+// VideoValidatedAtLT applies the LT predicate on the "video_validated_at" field.
+func VideoValidatedAtLT(v time.Time) predicate.Queue {
+	return predicate.Queue(sql.FieldLT(FieldVideoValidatedAt, v))
+}
+
+// VideoValidatedAtLTE applies the LTE predicate on the "video_validated_at" field.
+func VideoValidatedAtLTE(v time.Time) predicate.Queue {
+	return predicate.Queue(sql.FieldLTE(FieldVideoValidatedAt, v))
+}
+
+// VideoValidatedAtIsNil applies the IsNil predicate on the "video_validated_at" field.
+func VideoValidatedAtIsNil() predicate.Queue {
+	return predicate.Queue(sql.FieldIsNull(FieldVideoValidatedAt))
+}
+
+// VideoValidatedAtNotNil applies the NotNil predicate on the "video_validated_at" field.
+func VideoValidatedAtNotNil() predicate.Queue {
+	return predicate.Queue(sql.FieldNotNull(FieldVideoValidatedAt))
+}
+
+// TaskProgressIsNil applies the IsNil predicate on the "task_progress" field.
+func TaskProgressIsNil() predicate.Queue {
+	return predicate.Queue(sql.FieldIsNull(FieldTaskProgress))
+}
+
+// TaskProgressNotNil applies the NotNil predicate on the "task_progress" field.
+func TaskProgressNotNil() predicate.Queue {
+	return predicate.Queue(sql.FieldNotNull(FieldTaskProgress))
+}
+
 // WorkflowIDEQ applies the EQ predicate on the "workflow_id" field.
 func WorkflowIDEQ(v string) predicate.Queue {
 	return predicate.Queue(sql.FieldEQ(FieldWorkflowID, v))
@@ -163,6 +163,20 @@ func (_c *QueueCreate) SetNillableTaskVideoConvert(v *utils.TaskStatus) *QueueCr
 	return _c
 }
 
+// SetTaskVideoValidate sets the "task_video_validate" field.
+func (_c *QueueCreate) SetTaskVideoValidate(v utils.TaskStatus) *QueueCreate {
+	_c.mutation.SetTaskVideoValidate(v)
+	return _c
+}
+
+// SetNillableTaskVideoValidate sets the "task_video_validate" field if the given value is not nil.
+func (_c *QueueCreate) SetNillableTaskVideoValidate(v *utils.TaskStatus) *QueueCreate {
+	if v != nil {
+		_c.SetTaskVideoValidate(*v)
+	}
+	return _c
+}
+
 // SetTaskVideoMove sets the "task_video_move" field.
 func (_c *QueueCreate) SetTaskVideoMove(v utils.TaskStatus) *QueueCreate {
 	_c.mutation.SetTaskVideoMove(v)
@@ -275,6 +289,180 @@ func (_c *QueueCreate) SetNillableRenderChat(v *bool) *QueueCreate {
 	return _c
 }
 
+// SetVideoDownloadAttempts sets the "video_download_attempts" field.
+func (_c *QueueCreate) SetVideoDownloadAttempts(v int) *QueueCreate {
+	_c.mutation.SetVideoDownloadAttempts(v)
+	return _c
+}
+
+// SetNillableVideoDownloadAttempts sets the "video_download_attempts" field if the given value is not nil.
+func (_c *QueueCreate) SetNillableVideoDownloadAttempts(v *int) *QueueCreate {
+	if v != nil {
+		_c.SetVideoDownloadAttempts(*v)
+	}
+	return _c
+}
+
+// SetVideoDownloadResumeFragment sets the "video_download_resume_fragment" field.
+func (_c *QueueCreate) SetVideoDownloadResumeFragment(v int) *QueueCreate {
+	_c.mutation.SetVideoDownloadResumeFragment(v)
+	return _c
+}
+
+// SetNillableVideoDownloadResumeFragment sets the "video_download_resume_fragment" field if the given value is not nil.
+func (_c *QueueCreate) SetNillableVideoDownloadResumeFragment(v *int) *QueueCreate {
+	if v != nil {
+		_c.SetVideoDownloadResumeFragment(*v)
+	}
+	return _c
+}
+
+// SetVideoDownloadResumeBytes sets the "video_download_resume_bytes" field.
+func (_c *QueueCreate) SetVideoDownloadResumeBytes(v int64) *QueueCreate {
+	_c.mutation.SetVideoDownloadResumeBytes(v)
+	return _c
+}
+
+// SetNillableVideoDownloadResumeBytes sets the "video_download_resume_bytes" field if the given value is not nil.
+func (_c *QueueCreate) SetNillableVideoDownloadResumeBytes(v *int64) *QueueCreate {
+	if v != nil {
+		_c.SetVideoDownloadResumeBytes(*v)
+	}
+	return _c
+}
+
+// SetVideoDownloadProgressAt sets the "video_download_progress_at" field.
+func (_c *QueueCreate) SetVideoDownloadProgressAt(v time.Time) *QueueCreate {
+	_c.mutation.SetVideoDownloadProgressAt(v)
+	return _c
+}
+
+// SetNillableVideoDownloadProgressAt sets the "video_download_progress_at" field if the given value is not nil.
+func (_c *QueueCreate) SetNillableVideoDownloadProgressAt(v *time.Time) *QueueCreate {
+	if v != nil {
+		_c.SetVideoDownloadProgressAt(*v)
+	}
+	return _c
+}
+
+// SetVideoValidationVerdict sets the "video_validation_verdict" field.
+func (_c *QueueCreate) SetVideoValidationVerdict(v utils.VideoValidationVerdict) *QueueCreate {
+	_c.mutation.SetVideoValidationVerdict(v)
+	return _c
+}
+
+// SetNillableVideoValidationVerdict sets the "video_validation_verdict" field if the given value is not nil.
+func (_c *QueueCreate) SetNillableVideoValidationVerdict(v *utils.VideoValidationVerdict) *QueueCreate {
+	if v != nil {
+		_c.SetVideoValidationVerdict(*v)
+	}
+	return _c
+}
+
+// SetVideoValidationMessage sets the "video_validation_message" field.
+func (_c *QueueCreate) SetVideoValidationMessage(v string) *QueueCreate {
+	_c.mutation.SetVideoValidationMessage(v)
+	return _c
+}
+
+// SetNillableVideoValidationMessage sets the "video_validation_message" field if the given value is not nil.
+func (_c *QueueCreate) SetNillableVideoValidationMessage(v *string) *QueueCreate {
+	if v != nil {
+		_c.SetVideoValidationMessage(*v)
+	}
+	return _c
+}
+
+// SetVideoValidationExpectedDuration sets the "video_validation_expected_duration" field.
+func (_c *QueueCreate) SetVideoValidationExpectedDuration(v float64) *QueueCreate {
+	_c.mutation.SetVideoValidationExpectedDuration(v)
+	return _c
+}
+
+// SetNillableVideoValidationExpectedDuration sets the "video_validation_expected_duration" field if the given value is not nil.
+func (_c *QueueCreate) SetNillableVideoValidationExpectedDuration(v *float64) *QueueCreate {
+	if v != nil {
+		_c.SetVideoValidationExpectedDuration(*v)
+	}
+	return _c
+}
+
+// SetVideoValidationMeasuredDuration sets the "video_validation_measured_duration" field.
+func (_c *QueueCreate) SetVideoValidationMeasuredDuration(v float64) *QueueCreate {
+	_c.mutation.SetVideoValidationMeasuredDuration(v)
+	return _c
+}
+
+// SetNillableVideoValidationMeasuredDuration sets the "video_validation_measured_duration" field if the given value is not nil.
+func (_c *QueueCreate) SetNillableVideoValidationMeasuredDuration(v *float64) *QueueCreate {
+	if v != nil {
+		_c.SetVideoValidationMeasuredDuration(*v)
+	}
+	return _c
+}
+
+// SetVideoValidationHasVideo sets the "video_validation_has_video" field.
+func (_c *QueueCreate) SetVideoValidationHasVideo(v bool) *QueueCreate {
+	_c.mutation.SetVideoValidationHasVideo(v)
+	return _c
+}
+
+// SetNillableVideoValidationHasVideo sets the "video_validation_has_video" field if the given value is not nil.
+func (_c *QueueCreate) SetNillableVideoValidationHasVideo(v *bool) *QueueCreate {
+	if v != nil {
+		_c.SetVideoValidationHasVideo(*v)
+	}
+	return _c
+}
+
+// SetVideoValidationHasAudio sets the "video_validation_has_audio" field.
+func (_c *QueueCreate) SetVideoValidationHasAudio(v bool) *QueueCreate {
+	_c.mutation.SetVideoValidationHasAudio(v)
+	return _c
+}
+
+// SetNillableVideoValidationHasAudio sets the "video_validation_has_audio" field if the given value is not nil.
+func (_c *QueueCreate) SetNillableVideoValidationHasAudio(v *bool) *QueueCreate {
+	if v != nil {
+		_c.SetVideoValidationHasAudio(*v)
+	}
+	return _c
+}
+
+// SetVideoValidationRetries sets the "video_validation_retries" field.
+func (_c *QueueCreate) SetVideoValidationRetries(v int) *QueueCreate {
+	_c.mutation.SetVideoValidationRetries(v)
+	return _c
+}
+
+// SetNillableVideoValidationRetries sets the "video_validation_retries" field if the given value is not nil.
+func (_c *QueueCreate) SetNillableVideoValidationRetries(v *int) *QueueCreate {
+	if v != nil {
+		_c.SetVideoValidationRetries(*v)
+	}
+	return _c
+}
+
+// SetVideoValidatedAt sets the "video_validated_at" field.
+func (_c *QueueCreate) SetVideoValidatedAt(v time.Time) *QueueCreate {
+	_c.mutation.SetVideoValidatedAt(v)
+	return _c
+}
+
+// SetNillableVideoValidatedAt sets the "video_validated_at" field if the given value is not nil.
+func (_c *QueueCreate) SetNillableVideoValidatedAt(v *time.Time) *QueueCreate {
+	if v != nil {
+		_c.SetVideoValidatedAt(*v)
+	}
+	return _c
+}
+
+// SetTaskProgress sets the "task_progress" field.
+func (_c *QueueCreate) SetTaskProgress(v map[utils.TaskName]utils.TaskProgress) *QueueCreate {
+	_c.mutation.SetTaskProgress(v)
+	return _c
+}
+
 // SetWorkflowID sets the "workflow_id" field.
 func (_c *QueueCreate) SetWorkflowID(v string) *QueueCreate {
 	_c.mutation.SetWorkflowID(v)
@@ -431,6 +619,10 @@ func (_c *QueueCreate) defaults() {
 		v := queue.DefaultTaskVideoConvert
 		_c.mutation.SetTaskVideoConvert(v)
 	}
+	if _, ok := _c.mutation.TaskVideoValidate(); !ok {
+		v := queue.DefaultTaskVideoValidate
+		_c.mutation.SetTaskVideoValidate(v)
+	}
 	if _, ok := _c.mutation.TaskVideoMove(); !ok {
 		v := queue.DefaultTaskVideoMove
 		_c.mutation.SetTaskVideoMove(v)
@@ -459,6 +651,14 @@ func (_c *QueueCreate) defaults() {
 		v := queue.DefaultRenderChat
 		_c.mutation.SetRenderChat(v)
 	}
+	if _, ok := _c.mutation.VideoDownloadAttempts(); !ok {
+		v := queue.DefaultVideoDownloadAttempts
+		_c.mutation.SetVideoDownloadAttempts(v)
+	}
+	if _, ok := _c.mutation.VideoValidationRetries(); !ok {
+		v := queue.DefaultVideoValidationRetries
+		_c.mutation.SetVideoValidationRetries(v)
+	}
 	if _, ok := _c.mutation.UpdatedAt(); !ok {
 		v := queue.DefaultUpdatedAt()
 		_c.mutation.SetUpdatedAt(v)
@@ -515,6 +715,11 @@ func (_c *QueueCreate) check() error {
 			return &ValidationError{Name: "task_video_convert", err: fmt.Errorf(`ent: validator failed for field "Queue.task_video_convert": %w`, err)}
 		}
 	}
+	if v, ok := _c.mutation.TaskVideoValidate(); ok {
+		if err := queue.TaskVideoValidateValidator(v); err != nil {
+			return &ValidationError{Name: "task_video_validate", err: fmt.Errorf(`ent: validator failed for field "Queue.task_video_validate": %w`, err)}
+		}
+	}
 	if v, ok := _c.mutation.TaskVideoMove(); ok {
 		if err := queue.TaskVideoMoveValidator(v); err != nil {
 			return &ValidationError{Name: "task_video_move", err: fmt.Errorf(`ent: validator failed for field "Queue.task_video_move": %w`, err)}
@@ -540,6 +745,11 @@ func (_c *QueueCreate) check() error {
 			return &ValidationError{Name: "task_chat_move", err: fmt.Errorf(`ent: validator failed for field "Queue.task_chat_move": %w`, err)}
 		}
 	}
+	if v, ok := _c.mutation.VideoValidationVerdict(); ok {
+		if err := queue.VideoValidationVerdictValidator(v); err != nil {
+			return &ValidationError{Name: "video_validation_verdict", err: fmt.Errorf(`ent: validator failed for field "Queue.video_validation_verdict": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Queue.updated_at"`)}
 	}
@@ -624,6 +834,10 @@ func (_c *QueueCreate) createSpec() (*Queue, *sqlgraph.CreateSpec) {
 		_spec.SetField(queue.FieldTaskVideoConvert, field.TypeEnum, value)
 		_node.TaskVideoConvert = value
 	}
+	if value, ok := _c.mutation.TaskVideoValidate(); ok {
+		_spec.SetField(queue.FieldTaskVideoValidate, field.TypeEnum, value)
+		_node.TaskVideoValidate = value
+	}
 	if value, ok := _c.mutation.TaskVideoMove(); ok {
 		_spec.SetField(queue.FieldTaskVideoMove, field.TypeEnum, value)
 		_node.TaskVideoMove = value
@@ -656,6 +870,58 @@ func (_c *QueueCreate) createSpec() (*Queue, *sqlgraph.CreateSpec) {
 		_spec.SetField(queue.FieldRenderChat, field.TypeBool, value)
 		_node.RenderChat = value
 	}
+	if value, ok := _c.mutation.VideoDownloadAttempts(); ok {
+		_spec.SetField(queue.FieldVideoDownloadAttempts, field.TypeInt, value)
+		_node.VideoDownloadAttempts = value
+	}
+	if value, ok := _c.mutation.VideoDownloadResumeFragment(); ok {
+		_spec.SetField(queue.FieldVideoDownloadResumeFragment, field.TypeInt, value)
+		_node.VideoDownloadResumeFragment = value
+	}
+	if value, ok := _c.mutation.VideoDownloadResumeBytes(); ok {
+		_spec.SetField(queue.FieldVideoDownloadResumeBytes, field.TypeInt64, value)
+		_node.VideoDownloadResumeBytes = value
+	}
+	if value, ok := _c.mutation.VideoDownloadProgressAt(); ok {
+		_spec.SetField(queue.FieldVideoDownloadProgressAt, field.TypeTime, value)
+		_node.VideoDownloadProgressAt = value
+	}
+	if value, ok := _c.mutation.VideoValidationVerdict(); ok {
+		_spec.SetField(queue.FieldVideoValidationVerdict, field.TypeEnum, value)
+		_node.VideoValidationVerdict = value
+	}
+	if value, ok := _c.mutation.VideoValidationMessage(); ok {
+		_spec.SetField(queue.FieldVideoValidationMessage, field.TypeString, value)
+		_node.VideoValidationMessage = value
+	}
+	if value, ok := _c.mutation.VideoValidationExpectedDuration(); ok {
+		_spec.SetField(queue.FieldVideoValidationExpectedDuration, field.TypeFloat64, value)
+		_node.VideoValidationExpectedDuration = value
+	}
+	if value, ok := _c.mutation.VideoValidationMeasuredDuration(); ok {
+		_spec.SetField(queue.FieldVideoValidationMeasuredDuration, field.TypeFloat64, value)
+		_node.VideoValidationMeasuredDuration = value
+	}
+	if value, ok := _c.mutation.VideoValidationHasVideo(); ok {
+		_spec.SetField(queue.FieldVideoValidationHasVideo, field.TypeBool, value)
+		_node.VideoValidationHasVideo = value
+	}
+	if value, ok := _c.mutation.VideoValidationHasAudio(); ok {
+		_spec.SetField(queue.FieldVideoValidationHasAudio, field.TypeBool, value)
+		_node.VideoValidationHasAudio = value
+	}
+	if value, ok := _c.mutation.VideoValidationRetries(); ok {
+		_spec.SetField(queue.FieldVideoValidationRetries, field.TypeInt, value)
+		_node.VideoValidationRetries = value
+	}
+	if value, ok := _c.mutation.VideoValidatedAt(); ok {
+		_spec.SetField(queue.FieldVideoValidatedAt, field.TypeTime, value)
+		_node.VideoValidatedAt = value
+	}
+	if value, ok := _c.mutation.TaskProgress(); ok {
+		_spec.SetField(queue.FieldTaskProgress, field.TypeJSON, value)
+		_node.TaskProgress = value
+	}
 	if value, ok := _c.mutation.WorkflowID(); ok {
 		_spec.SetField(queue.FieldWorkflowID, field.TypeString, value)
 		_node.WorkflowID = value
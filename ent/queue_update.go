@@ -201,6 +201,26 @@ func (_u *QueueUpdate) ClearTaskVideoConvert() *QueueUpdate {
 	return _u
 }
 
+// SetTaskVideoValidate sets the "task_video_validate" field.
+func (_u *QueueUpdate) SetTaskVideoValidate(v utils.TaskStatus) *QueueUpdate {
+	_u.mutation.SetTaskVideoValidate(v)
+	return _u
+}
+
+// SetNillableTaskVideoValidate sets the "task_video_validate" field if the given value is not nil.
+func (_u *QueueUpdate) SetNillableTaskVideoValidate(v *utils.TaskStatus) *QueueUpdate {
+	if v != nil {
+		_u.SetTaskVideoValidate(*v)
+	}
+	return _u
+}
+
+// ClearTaskVideoValidate clears the value of the "task_video_validate" field.
+func (_u *QueueUpdate) ClearTaskVideoValidate() *QueueUpdate {
+	_u.mutation.ClearTaskVideoValidate()
+	return _u
+}
+
 // SetTaskVideoMove sets the "task_video_move" field.
 func (_u *QueueUpdate) SetTaskVideoMove(v utils.TaskStatus) *QueueUpdate {
 	_u.mutation.SetTaskVideoMove(v)
@@ -361,6 +381,300 @@ func (_u *QueueUpdate) ClearRenderChat() *QueueUpdate {
 	return _u
 }
 
+// SetVideoDownloadAttempts sets the "video_download_attempts" field.
+func (_u *QueueUpdate) SetVideoDownloadAttempts(v int) *QueueUpdate {
+	_u.mutation.ResetVideoDownloadAttempts()
+	_u.mutation.SetVideoDownloadAttempts(v)
+	return _u
+}
+
+// SetNillableVideoDownloadAttempts sets the "video_download_attempts" field if the given value is not nil.
+func (_u *QueueUpdate) SetNillableVideoDownloadAttempts(v *int) *QueueUpdate {
+	if v != nil {
+		_u.SetVideoDownloadAttempts(*v)
+	}
+	return _u
+}
+
+// AddVideoDownloadAttempts adds value to the "video_download_attempts" field.
+func (_u *QueueUpdate) AddVideoDownloadAttempts(v int) *QueueUpdate {
+	_u.mutation.AddVideoDownloadAttempts(v)
+	return _u
+}
+
+// ClearVideoDownloadAttempts clears the value of the "video_download_attempts" field.
+func (_u *QueueUpdate) ClearVideoDownloadAttempts() *QueueUpdate {
+	_u.mutation.ClearVideoDownloadAttempts()
+	return _u
+}
+
+// SetVideoDownloadResumeFragment sets the "video_download_resume_fragment" field.
+func (_u *QueueUpdate) SetVideoDownloadResumeFragment(v int) *QueueUpdate {
+	_u.mutation.ResetVideoDownloadResumeFragment()
+	_u.mutation.SetVideoDownloadResumeFragment(v)
+	return _u
+}
+
+// SetNillableVideoDownloadResumeFragment sets the "video_download_resume_fragment" field if the given value is not nil.
+func (_u *QueueUpdate) SetNillableVideoDownloadResumeFragment(v *int) *QueueUpdate {
+	if v != nil {
+		_u.SetVideoDownloadResumeFragment(*v)
+	}
+	return _u
+}
+
+// AddVideoDownloadResumeFragment adds value to the "video_download_resume_fragment" field.
+func (_u *QueueUpdate) AddVideoDownloadResumeFragment(v int) *QueueUpdate {
+	_u.mutation.AddVideoDownloadResumeFragment(v)
+	return _u
+}
+
+// ClearVideoDownloadResumeFragment clears the value of the "video_download_resume_fragment" field.
+func (_u *QueueUpdate) ClearVideoDownloadResumeFragment() *QueueUpdate {
+	_u.mutation.ClearVideoDownloadResumeFragment()
+	return _u
+}
+
+// SetVideoDownloadResumeBytes sets the "video_download_resume_bytes" field.
+func (_u *QueueUpdate) SetVideoDownloadResumeBytes(v int64) *QueueUpdate {
+	_u.mutation.ResetVideoDownloadResumeBytes()
+	_u.mutation.SetVideoDownloadResumeBytes(v)
+	return _u
+}
+
+// SetNillableVideoDownloadResumeBytes sets the "video_download_resume_bytes" field if the given value is not nil.
+func (_u *QueueUpdate) SetNillableVideoDownloadResumeBytes(v *int64) *QueueUpdate {
+	if v != nil {
+		_u.SetVideoDownloadResumeBytes(*v)
+	}
+	return _u
+}
+
+// AddVideoDownloadResumeBytes adds value to the "video_download_resume_bytes" field.
+func (_u *QueueUpdate) AddVideoDownloadResumeBytes(v int64) *QueueUpdate {
+	_u.mutation.AddVideoDownloadResumeBytes(v)
+	return _u
+}
+
+// ClearVideoDownloadResumeBytes clears the value of the "video_download_resume_bytes" field.
+func (_u *QueueUpdate) ClearVideoDownloadResumeBytes() *QueueUpdate {
+	_u.mutation.ClearVideoDownloadResumeBytes()
+	return _u
+}
+
+// SetVideoDownloadProgressAt sets the "video_download_progress_at" field.
+func (_u *QueueUpdate) SetVideoDownloadProgressAt(v time.Time) *QueueUpdate {
+	_u.mutation.SetVideoDownloadProgressAt(v)
+	return _u
+}
+
+// SetNillableVideoDownloadProgressAt sets the "video_download_progress_at" field if the given value is not nil.
+func (_u *QueueUpdate) SetNillableVideoDownloadProgressAt(v *time.Time) *QueueUpdate {
+	if v != nil {
+		_u.SetVideoDownloadProgressAt(*v)
+	}
+	return _u
+}
+
+// ClearVideoDownloadProgressAt clears the value of the "video_download_progress_at" field.
+func (_u *QueueUpdate) ClearVideoDownloadProgressAt() *QueueUpdate {
+	_u.mutation.ClearVideoDownloadProgressAt()
+	return _u
+}
+
+// SetVideoValidationVerdict sets the "video_validation_verdict" field.
+func (_u *QueueUpdate) SetVideoValidationVerdict(v utils.VideoValidationVerdict) *QueueUpdate {
+	_u.mutation.SetVideoValidationVerdict(v)
+	return _u
+}
+
+// SetNillableVideoValidationVerdict sets the "video_validation_verdict" field if the given value is not nil.
+func (_u *QueueUpdate) SetNillableVideoValidationVerdict(v *utils.VideoValidationVerdict) *QueueUpdate {
+	if v != nil {
+		_u.SetVideoValidationVerdict(*v)
+	}
+	return _u
+}
+
+// ClearVideoValidationVerdict clears the value of the "video_validation_verdict" field.
+func (_u *QueueUpdate) ClearVideoValidationVerdict() *QueueUpdate {
+	_u.mutation.ClearVideoValidationVerdict()
+	return _u
+}
+
+// SetVideoValidationMessage sets the "video_validation_message" field.
+func (_u *QueueUpdate) SetVideoValidationMessage(v string) *QueueUpdate {
+	_u.mutation.SetVideoValidationMessage(v)
+	return _u
+}
+
+// SetNillableVideoValidationMessage sets the "video_validation_message" field if the given value is not nil.
+func (_u *QueueUpdate) SetNillableVideoValidationMessage(v *string) *QueueUpdate {
+	if v != nil {
+		_u.SetVideoValidationMessage(*v)
+	}
+	return _u
+}
+
+// ClearVideoValidationMessage clears the value of the "video_validation_message" field.
+func (_u *QueueUpdate) ClearVideoValidationMessage() *QueueUpdate {
+	_u.mutation.ClearVideoValidationMessage()
+	return _u
+}
+
+// SetVideoValidationExpectedDuration sets the "video_validation_expected_duration" field.
+func (_u *QueueUpdate) SetVideoValidationExpectedDuration(v float64) *QueueUpdate {
+	_u.mutation.ResetVideoValidationExpectedDuration()
+	_u.mutation.SetVideoValidationExpectedDuration(v)
+	return _u
+}
+
+// SetNillableVideoValidationExpectedDuration sets the "video_validation_expected_duration" field if the given value is not nil.
+func (_u *QueueUpdate) SetNillableVideoValidationExpectedDuration(v *float64) *QueueUpdate {
+	if v != nil {
+		_u.SetVideoValidationExpectedDuration(*v)
+	}
+	return _u
+}
+
+// AddVideoValidationExpectedDuration adds value to the "video_validation_expected_duration" field.
+func (_u *QueueUpdate) AddVideoValidationExpectedDuration(v float64) *QueueUpdate {
+	_u.mutation.AddVideoValidationExpectedDuration(v)
+	return _u
+}
+
+// ClearVideoValidationExpectedDuration clears the value of the "video_validation_expected_duration" field.
+func (_u *QueueUpdate) ClearVideoValidationExpectedDuration() *QueueUpdate {
+	_u.mutation.ClearVideoValidationExpectedDuration()
+	return _u
+}
+
+// SetVideoValidationMeasuredDuration sets the "video_validation_measured_duration" field.
+func (_u *QueueUpdate) SetVideoValidationMeasuredDuration(v float64) *QueueUpdate {
+	_u.mutation.ResetVideoValidationMeasuredDuration()
+	_u.mutation.SetVideoValidationMeasuredDuration(v)
+	return _u
+}
+
+// SetNillableVideoValidationMeasuredDuration sets the "video_validation_measured_duration" field if the given value is not nil.
+func (_u *QueueUpdate) SetNillableVideoValidationMeasuredDuration(v *float64) *QueueUpdate {
+	if v != nil {
+		_u.SetVideoValidationMeasuredDuration(*v)
+	}
+	return _u
+}
+
+// AddVideoValidationMeasuredDuration adds value to the "video_validation_measured_duration" field.
+func (_u *QueueUpdate) AddVideoValidationMeasuredDuration(v float64) *QueueUpdate {
+	_u.mutation.AddVideoValidationMeasuredDuration(v)
+	return _u
+}
+
+// ClearVideoValidationMeasuredDuration clears the value of the "video_validation_measured_duration" field.
+func (_u *QueueUpdate) ClearVideoValidationMeasuredDuration() *QueueUpdate {
+	_u.mutation.ClearVideoValidationMeasuredDuration()
+	return _u
+}
+
+// SetVideoValidationHasVideo sets the "video_validation_has_video" field.
+func (_u *QueueUpdate) SetVideoValidationHasVideo(v bool) *QueueUpdate {
+	_u.mutation.SetVideoValidationHasVideo(v)
+	return _u
+}
+
+// SetNillableVideoValidationHasVideo sets the "video_validation_has_video" field if the given value is not nil.
+func (_u *QueueUpdate) SetNillableVideoValidationHasVideo(v *bool) *QueueUpdate {
+	if v != nil {
+		_u.SetVideoValidationHasVideo(*v)
+	}
+	return _u
+}
+
+// ClearVideoValidationHasVideo clears the value of the "video_validation_has_video" field.
+func (_u *QueueUpdate) ClearVideoValidationHasVideo() *QueueUpdate {
+	_u.mutation.ClearVideoValidationHasVideo()
+	return _u
+}
+
+// SetVideoValidationHasAudio sets the "video_validation_has_audio" field.
+func (_u *QueueUpdate) SetVideoValidationHasAudio(v bool) *QueueUpdate {
+	_u.mutation.SetVideoValidationHasAudio(v)
+	return _u
+}
+
+// SetNillableVideoValidationHasAudio sets the "video_validation_has_audio" field if the given value is not nil.
+func (_u *QueueUpdate) SetNillableVideoValidationHasAudio(v *bool) *QueueUpdate {
+	if v != nil {
+		_u.SetVideoValidationHasAudio(*v)
+	}
+	return _u
+}
+
+// ClearVideoValidationHasAudio clears the value of the "video_validation_has_audio" field.
+func (_u *QueueUpdate) ClearVideoValidationHasAudio() *QueueUpdate {
+	_u.mutation.ClearVideoValidationHasAudio()
+	return _u
+}
+
+// SetVideoValidationRetries sets the "video_validation_retries" field.
+func (_u *QueueUpdate) SetVideoValidationRetries(v int) *QueueUpdate {
+	_u.mutation.ResetVideoValidationRetries()
+	_u.mutation.SetVideoValidationRetries(v)
+	return _u
+}
+
+// SetNillableVideoValidationRetries sets the "video_validation_retries" field if the given value is not nil.
+func (_u *QueueUpdate) SetNillableVideoValidationRetries(v *int) *QueueUpdate {
+	if v != nil {
+		_u.SetVideoValidationRetries(*v)
+	}
+	return _u
+}
+
+// AddVideoValidationRetries adds value to the "video_validation_retries" field.
+func (_u *QueueUpdate) AddVideoValidationRetries(v int) *QueueUpdate {
+	_u.mutation.AddVideoValidationRetries(v)
+	return _u
+}
+
+// ClearVideoValidationRetries clears the value of the "video_validation_retries" field.
+func (_u *QueueUpdate) ClearVideoValidationRetries() *QueueUpdate {
+	_u.mutation.ClearVideoValidationRetries()
+	return _u
+}
+
+// SetVideoValidatedAt sets the "video_validated_at" field.
+func (_u *QueueUpdate) SetVideoValidatedAt(v time.Time) *QueueUpdate {
+	_u.mutation.SetVideoValidatedAt(v)
+	return _u
+}
+
+// SetNillableVideoValidatedAt sets the "video_validated_at" field if the given value is not nil.
+func (_u *QueueUpdate) SetNillableVideoValidatedAt(v *time.Time) *QueueUpdate {
+	if v != nil {
+		_u.SetVideoValidatedAt(*v)
+	}
+	return _u
+}
+
+// ClearVideoValidatedAt clears the value of the "video_validated_at" field.
+func (_u *QueueUpdate) ClearVideoValidatedAt() *QueueUpdate {
+	_u.mutation.ClearVideoValidatedAt()
+	return _u
+}
+
+// SetTaskProgress sets the "task_progress" field.
+func (_u *QueueUpdate) SetTaskProgress(v map[utils.TaskName]utils.TaskProgress) *QueueUpdate {
+	_u.mutation.SetTaskProgress(v)
+	return _u
+}
+
+// ClearTaskProgress clears the value of the "task_progress" field.
+func (_u *QueueUpdate) ClearTaskProgress() *QueueUpdate {
+	_u.mutation.ClearTaskProgress()
+	return _u
+}
+
 // SetWorkflowID sets the "workflow_id" field.
 func (_u *QueueUpdate) SetWorkflowID(v string) *QueueUpdate {
 	_u.mutation.SetWorkflowID(v)
@@ -492,6 +806,11 @@ func (_u *QueueUpdate) check() error {
 			return &ValidationError{Name: "task_video_convert", err: fmt.Errorf(`ent: validator failed for field "Queue.task_video_convert": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.TaskVideoValidate(); ok {
+		if err := queue.TaskVideoValidateValidator(v); err != nil {
+			return &ValidationError{Name: "task_video_validate", err: fmt.Errorf(`ent: validator failed for field "Queue.task_video_validate": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.TaskVideoMove(); ok {
 		if err := queue.TaskVideoMoveValidator(v); err != nil {
 			return &ValidationError{Name: "task_video_move", err: fmt.Errorf(`ent: validator failed for field "Queue.task_video_move": %w`, err)}
@@ -517,6 +836,11 @@ func (_u *QueueUpdate) check() error {
 			return &ValidationError{Name: "task_chat_move", err: fmt.Errorf(`ent: validator failed for field "Queue.task_chat_move": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.VideoValidationVerdict(); ok {
+		if err := queue.VideoValidationVerdictValidator(v); err != nil {
+			return &ValidationError{Name: "video_validation_verdict", err: fmt.Errorf(`ent: validator failed for field "Queue.video_validation_verdict": %w`, err)}
+		}
+	}
 	if _u.mutation.VodCleared() && len(_u.mutation.VodIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Queue.vod"`)
 	}
@@ -580,6 +904,12 @@ func (_u *QueueUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.TaskVideoConvertCleared() {
 		_spec.ClearField(queue.FieldTaskVideoConvert, field.TypeEnum)
 	}
+	if value, ok := _u.mutation.TaskVideoValidate(); ok {
+		_spec.SetField(queue.FieldTaskVideoValidate, field.TypeEnum, value)
+	}
+	if _u.mutation.TaskVideoValidateCleared() {
+		_spec.ClearField(queue.FieldTaskVideoValidate, field.TypeEnum)
+	}
 	if value, ok := _u.mutation.TaskVideoMove(); ok {
 		_spec.SetField(queue.FieldTaskVideoMove, field.TypeEnum, value)
 	}
@@ -628,6 +958,102 @@ func (_u *QueueUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.RenderChatCleared() {
 		_spec.ClearField(queue.FieldRenderChat, field.TypeBool)
 	}
+	if value, ok := _u.mutation.VideoDownloadAttempts(); ok {
+		_spec.SetField(queue.FieldVideoDownloadAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVideoDownloadAttempts(); ok {
+		_spec.AddField(queue.FieldVideoDownloadAttempts, field.TypeInt, value)
+	}
+	if _u.mutation.VideoDownloadAttemptsCleared() {
+		_spec.ClearField(queue.FieldVideoDownloadAttempts, field.TypeInt)
+	}
+	if value, ok := _u.mutation.VideoDownloadResumeFragment(); ok {
+		_spec.SetField(queue.FieldVideoDownloadResumeFragment, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVideoDownloadResumeFragment(); ok {
+		_spec.AddField(queue.FieldVideoDownloadResumeFragment, field.TypeInt, value)
+	}
+	if _u.mutation.VideoDownloadResumeFragmentCleared() {
+		_spec.ClearField(queue.FieldVideoDownloadResumeFragment, field.TypeInt)
+	}
+	if value, ok := _u.mutation.VideoDownloadResumeBytes(); ok {
+		_spec.SetField(queue.FieldVideoDownloadResumeBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedVideoDownloadResumeBytes(); ok {
+		_spec.AddField(queue.FieldVideoDownloadResumeBytes, field.TypeInt64, value)
+	}
+	if _u.mutation.VideoDownloadResumeBytesCleared() {
+		_spec.ClearField(queue.FieldVideoDownloadResumeBytes, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.VideoDownloadProgressAt(); ok {
+		_spec.SetField(queue.FieldVideoDownloadProgressAt, field.TypeTime, value)
+	}
+	if _u.mutation.VideoDownloadProgressAtCleared() {
+		_spec.ClearField(queue.FieldVideoDownloadProgressAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.VideoValidationVerdict(); ok {
+		_spec.SetField(queue.FieldVideoValidationVerdict, field.TypeEnum, value)
+	}
+	if _u.mutation.VideoValidationVerdictCleared() {
+		_spec.ClearField(queue.FieldVideoValidationVerdict, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.VideoValidationMessage(); ok {
+		_spec.SetField(queue.FieldVideoValidationMessage, field.TypeString, value)
+	}
+	if _u.mutation.VideoValidationMessageCleared() {
+		_spec.ClearField(queue.FieldVideoValidationMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.VideoValidationExpectedDuration(); ok {
+		_spec.SetField(queue.FieldVideoValidationExpectedDuration, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedVideoValidationExpectedDuration(); ok {
+		_spec.AddField(queue.FieldVideoValidationExpectedDuration, field.TypeFloat64, value)
+	}
+	if _u.mutation.VideoValidationExpectedDurationCleared() {
+		_spec.ClearField(queue.FieldVideoValidationExpectedDuration, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.VideoValidationMeasuredDuration(); ok {
+		_spec.SetField(queue.FieldVideoValidationMeasuredDuration, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedVideoValidationMeasuredDuration(); ok {
+		_spec.AddField(queue.FieldVideoValidationMeasuredDuration, field.TypeFloat64, value)
+	}
+	if _u.mutation.VideoValidationMeasuredDurationCleared() {
+		_spec.ClearField(queue.FieldVideoValidationMeasuredDuration, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.VideoValidationHasVideo(); ok {
+		_spec.SetField(queue.FieldVideoValidationHasVideo, field.TypeBool, value)
+	}
+	if _u.mutation.VideoValidationHasVideoCleared() {
+		_spec.ClearField(queue.FieldVideoValidationHasVideo, field.TypeBool)
+	}
+	if value, ok := _u.mutation.VideoValidationHasAudio(); ok {
+		_spec.SetField(queue.FieldVideoValidationHasAudio, field.TypeBool, value)
+	}
+	if _u.mutation.VideoValidationHasAudioCleared() {
+		_spec.ClearField(queue.FieldVideoValidationHasAudio, field.TypeBool)
+	}
+	if value, ok := _u.mutation.VideoValidationRetries(); ok {
+		_spec.SetField(queue.FieldVideoValidationRetries, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVideoValidationRetries(); ok {
+		_spec.AddField(queue.FieldVideoValidationRetries, field.TypeInt, value)
+	}
+	if _u.mutation.VideoValidationRetriesCleared() {
+		_spec.ClearField(queue.FieldVideoValidationRetries, field.TypeInt)
+	}
+	if value, ok := _u.mutation.VideoValidatedAt(); ok {
+		_spec.SetField(queue.FieldVideoValidatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.VideoValidatedAtCleared() {
+		_spec.ClearField(queue.FieldVideoValidatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.TaskProgress(); ok {
+		_spec.SetField(queue.FieldTaskProgress, field.TypeJSON, value)
+	}
+	if _u.mutation.TaskProgressCleared() {
+		_spec.ClearField(queue.FieldTaskProgress, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.WorkflowID(); ok {
 		_spec.SetField(queue.FieldWorkflowID, field.TypeString, value)
 	}
@@ -862,6 +1288,26 @@ func (_u *QueueUpdateOne) ClearTaskVideoConvert() *QueueUpdateOne {
 	return _u
 }
 
+// SetTaskVideoValidate sets the "task_video_validate" field.
+func (_u *QueueUpdateOne) SetTaskVideoValidate(v utils.TaskStatus) *QueueUpdateOne {
+	_u.mutation.SetTaskVideoValidate(v)
+	return _u
+}
+
+// SetNillableTaskVideoValidate sets the "task_video_validate" field if the given value is not nil.
+func (_u *QueueUpdateOne) SetNillableTaskVideoValidate(v *utils.TaskStatus) *QueueUpdateOne {
+	if v != nil {
+		_u.SetTaskVideoValidate(*v)
+	}
+	return _u
+}
+
+// ClearTaskVideoValidate clears the value of the "task_video_validate" field.
+func (_u *QueueUpdateOne) ClearTaskVideoValidate() *QueueUpdateOne {
+	_u.mutation.ClearTaskVideoValidate()
+	return _u
+}
+
 // SetTaskVideoMove sets the "task_video_move" field.
 func (_u *QueueUpdateOne) SetTaskVideoMove(v utils.TaskStatus) *QueueUpdateOne {
 	_u.mutation.SetTaskVideoMove(v)
@@ -1022,6 +1468,300 @@ func (_u *QueueUpdateOne) ClearRenderChat() *QueueUpdateOne {
 	return _u
 }
 
+// SetVideoDownloadAttempts sets the "video_download_attempts" field.
+func (_u *QueueUpdateOne) SetVideoDownloadAttempts(v int) *QueueUpdateOne {
+	_u.mutation.ResetVideoDownloadAttempts()
+	_u.mutation.SetVideoDownloadAttempts(v)
+	return _u
+}
+
+// SetNillableVideoDownloadAttempts sets the "video_download_attempts" field if the given value is not nil.
+func (_u *QueueUpdateOne) SetNillableVideoDownloadAttempts(v *int) *QueueUpdateOne {
+	if v != nil {
+		_u.SetVideoDownloadAttempts(*v)
+	}
+	return _u
+}
+
+// AddVideoDownloadAttempts adds value to the "video_download_attempts" field.
+func (_u *QueueUpdateOne) AddVideoDownloadAttempts(v int) *QueueUpdateOne {
+	_u.mutation.AddVideoDownloadAttempts(v)
+	return _u
+}
+
+// ClearVideoDownloadAttempts clears the value of the "video_download_attempts" field.
+func (_u *QueueUpdateOne) ClearVideoDownloadAttempts() *QueueUpdateOne {
+	_u.mutation.ClearVideoDownloadAttempts()
+	return _u
+}
+
+// SetVideoDownloadResumeFragment sets the "video_download_resume_fragment" field.
+func (_u *QueueUpdateOne) SetVideoDownloadResumeFragment(v int) *QueueUpdateOne {
+	_u.mutation.ResetVideoDownloadResumeFragment()
+	_u.mutation.SetVideoDownloadResumeFragment(v)
+	return _u
+}
+
+// SetNillableVideoDownloadResumeFragment sets the "video_download_resume_fragment" field if the given value is not nil.
+func (_u *QueueUpdateOne) SetNillableVideoDownloadResumeFragment(v *int) *QueueUpdateOne {
+	if v != nil {
+		_u.SetVideoDownloadResumeFragment(*v)
+	}
+	return _u
+}
+
+// AddVideoDownloadResumeFragment adds value to the "video_download_resume_fragment" field.
+func (_u *QueueUpdateOne) AddVideoDownloadResumeFragment(v int) *QueueUpdateOne {
+	_u.mutation.AddVideoDownloadResumeFragment(v)
+	return _u
+}
+
+// ClearVideoDownloadResumeFragment clears the value of the "video_download_resume_fragment" field.
+func (_u *QueueUpdateOne) ClearVideoDownloadResumeFragment() *QueueUpdateOne {
+	_u.mutation.ClearVideoDownloadResumeFragment()
+	return _u
+}
+
+// SetVideoDownloadResumeBytes sets the "video_download_resume_bytes" field.
+func (_u *QueueUpdateOne) SetVideoDownloadResumeBytes(v int64) *QueueUpdateOne {
+	_u.mutation.ResetVideoDownloadResumeBytes()
+	_u.mutation.SetVideoDownloadResumeBytes(v)
+	return _u
+}
+
+// SetNillableVideoDownloadResumeBytes sets the "video_download_resume_bytes" field if the given value is not nil.
+func (_u *QueueUpdateOne) SetNillableVideoDownloadResumeBytes(v *int64) *QueueUpdateOne {
+	if v != nil {
+		_u.SetVideoDownloadResumeBytes(*v)
+	}
+	return _u
+}
+
+// AddVideoDownloadResumeBytes adds value to the "video_download_resume_bytes" field.
+func (_u *QueueUpdateOne) AddVideoDownloadResumeBytes(v int64) *QueueUpdateOne {
+	_u.mutation.AddVideoDownloadResumeBytes(v)
+	return _u
+}
+
+// ClearVideoDownloadResumeBytes clears the value of the "video_download_resume_bytes" field.
+func (_u *QueueUpdateOne) ClearVideoDownloadResumeBytes() *QueueUpdateOne {
+	_u.mutation.ClearVideoDownloadResumeBytes()
+	return _u
+}
+
+// SetVideoDownloadProgressAt sets the "video_download_progress_at" field.
+func (_u *QueueUpdateOne) SetVideoDownloadProgressAt(v time.Time) *QueueUpdateOne {
+	_u.mutation.SetVideoDownloadProgressAt(v)
+	return _u
+}
+
+// SetNillableVideoDownloadProgressAt sets the "video_download_progress_at" field if the given value is not nil.
+func (_u *QueueUpdateOne) SetNillableVideoDownloadProgressAt(v *time.Time) *QueueUpdateOne {
+	if v != nil {
+		_u.SetVideoDownloadProgressAt(*v)
+	}
+	return _u
+}
+
+// ClearVideoDownloadProgressAt clears the value of the "video_download_progress_at" field.
+func (_u *QueueUpdateOne) ClearVideoDownloadProgressAt() *QueueUpdateOne {
+	_u.mutation.ClearVideoDownloadProgressAt()
+	return _u
+}
+
+// SetVideoValidationVerdict sets the "video_validation_verdict" field.
+func (_u *QueueUpdateOne) SetVideoValidationVerdict(v utils.VideoValidationVerdict) *QueueUpdateOne {
+	_u.mutation.SetVideoValidationVerdict(v)
+	return _u
+}
+
+// SetNillableVideoValidationVerdict sets the "video_validation_verdict" field if the given value is not nil.
+func (_u *QueueUpdateOne) SetNillableVideoValidationVerdict(v *utils.VideoValidationVerdict) *QueueUpdateOne {
+	if v != nil {
+		_u.SetVideoValidationVerdict(*v)
+	}
+	return _u
+}
+
+// ClearVideoValidationVerdict clears the value of the "video_validation_verdict" field.
+func (_u *QueueUpdateOne) ClearVideoValidationVerdict() *QueueUpdateOne {
+	_u.mutation.ClearVideoValidationVerdict()
+	return _u
+}
+
+// SetVideoValidationMessage sets the "video_validation_message" field.
+func (_u *QueueUpdateOne) SetVideoValidationMessage(v string) *QueueUpdateOne {
+	_u.mutation.SetVideoValidationMessage(v)
+	return _u
+}
+
+// SetNillableVideoValidationMessage sets the "video_validation_message" field if the given value is not nil.
+func (_u *QueueUpdateOne) SetNillableVideoValidationMessage(v *string) *QueueUpdateOne {
+	if v != nil {
+		_u.SetVideoValidationMessage(*v)
+	}
+	return _u
+}
+
+// ClearVideoValidationMessage clears the value of the "video_validation_message" field.
+func (_u *QueueUpdateOne) ClearVideoValidationMessage() *QueueUpdateOne {
+	_u.mutation.ClearVideoValidationMessage()
+	return _u
+}
+
+// SetVideoValidationExpectedDuration sets the "video_validation_expected_duration" field.
+func (_u *QueueUpdateOne) SetVideoValidationExpectedDuration(v float64) *QueueUpdateOne {
+	_u.mutation.ResetVideoValidationExpectedDuration()
+	_u.mutation.SetVideoValidationExpectedDuration(v)
+	return _u
+}
+
+// SetNillableVideoValidationExpectedDuration sets the "video_validation_expected_duration" field if the given value is not nil.
+func (_u *QueueUpdateOne) SetNillableVideoValidationExpectedDuration(v *float64) *QueueUpdateOne {
+	if v != nil {
+		_u.SetVideoValidationExpectedDuration(*v)
+	}
+	return _u
+}
+
+// AddVideoValidationExpectedDuration adds value to the "video_validation_expected_duration" field.
+func (_u *QueueUpdateOne) AddVideoValidationExpectedDuration(v float64) *QueueUpdateOne {
+	_u.mutation.AddVideoValidationExpectedDuration(v)
+	return _u
+}
+
+// ClearVideoValidationExpectedDuration clears the value of the "video_validation_expected_duration" field.
+func (_u *QueueUpdateOne) ClearVideoValidationExpectedDuration() *QueueUpdateOne {
+	_u.mutation.ClearVideoValidationExpectedDuration()
+	return _u
+}
+
+// SetVideoValidationMeasuredDuration sets the "video_validation_measured_duration" field.
+func (_u *QueueUpdateOne) SetVideoValidationMeasuredDuration(v float64) *QueueUpdateOne {
+	_u.mutation.ResetVideoValidationMeasuredDuration()
+	_u.mutation.SetVideoValidationMeasuredDuration(v)
+	return _u
+}
+
+// SetNillableVideoValidationMeasuredDuration sets the "video_validation_measured_duration" field if the given value is not nil.
+func (_u *QueueUpdateOne) SetNillableVideoValidationMeasuredDuration(v *float64) *QueueUpdateOne {
+	if v != nil {
+		_u.SetVideoValidationMeasuredDuration(*v)
+	}
+	return _u
+}
+
+// AddVideoValidationMeasuredDuration adds value to the "video_validation_measured_duration" field.
+func (_u *QueueUpdateOne) AddVideoValidationMeasuredDuration(v float64) *QueueUpdateOne {
+	_u.mutation.AddVideoValidationMeasuredDuration(v)
+	return _u
+}
+
+// ClearVideoValidationMeasuredDuration clears the value of the "video_validation_measured_duration" field.
+func (_u *QueueUpdateOne) ClearVideoValidationMeasuredDuration() *QueueUpdateOne {
+	_u.mutation.ClearVideoValidationMeasuredDuration()
+	return _u
+}
+
+// SetVideoValidationHasVideo sets the "video_validation_has_video" field.
+func (_u *QueueUpdateOne) SetVideoValidationHasVideo(v bool) *QueueUpdateOne {
+	_u.mutation.SetVideoValidationHasVideo(v)
+	return _u
+}
+
+// SetNillableVideoValidationHasVideo sets the "video_validation_has_video" field if the given value is not nil.
+func (_u *QueueUpdateOne) SetNillableVideoValidationHasVideo(v *bool) *QueueUpdateOne {
+	if v != nil {
+		_u.SetVideoValidationHasVideo(*v)
+	}
+	return _u
+}
+
+// ClearVideoValidationHasVideo clears the value of the "video_validation_has_video" field.
+func (_u *QueueUpdateOne) ClearVideoValidationHasVideo() *QueueUpdateOne {
+	_u.mutation.ClearVideoValidationHasVideo()
+	return _u
+}
+
+// SetVideoValidationHasAudio sets the "video_validation_has_audio" field.
+func (_u *QueueUpdateOne) SetVideoValidationHasAudio(v bool) *QueueUpdateOne {
+	_u.mutation.SetVideoValidationHasAudio(v)
+	return _u
+}
+
+// SetNillableVideoValidationHasAudio sets the "video_validation_has_audio" field if the given value is not nil.
+func (_u *QueueUpdateOne) SetNillableVideoValidationHasAudio(v *bool) *QueueUpdateOne {
+	if v != nil {
+		_u.SetVideoValidationHasAudio(*v)
+	}
+	return _u
+}
+
+// ClearVideoValidationHasAudio clears the value of the "video_validation_has_audio" field.
+func (_u *QueueUpdateOne) ClearVideoValidationHasAudio() *QueueUpdateOne {
+	_u.mutation.ClearVideoValidationHasAudio()
+	return _u
+}
+
+// SetVideoValidationRetries sets the "video_validation_retries" field.
+func (_u *QueueUpdateOne) SetVideoValidationRetries(v int) *QueueUpdateOne {
+	_u.mutation.ResetVideoValidationRetries()
+	_u.mutation.SetVideoValidationRetries(v)
+	return _u
+}
+
+// SetNillableVideoValidationRetries sets the "video_validation_retries" field if the given value is not nil.
+func (_u *QueueUpdateOne) SetNillableVideoValidationRetries(v *int) *QueueUpdateOne {
+	if v != nil {
+		_u.SetVideoValidationRetries(*v)
+	}
+	return _u
+}
+
+// AddVideoValidationRetries adds value to the "video_validation_retries" field.
+func (_u *QueueUpdateOne) AddVideoValidationRetries(v int) *QueueUpdateOne {
+	_u.mutation.AddVideoValidationRetries(v)
+	return _u
+}
+
+// ClearVideoValidationRetries clears the value of the "video_validation_retries" field.
+func (_u *QueueUpdateOne) ClearVideoValidationRetries() *QueueUpdateOne {
+	_u.mutation.ClearVideoValidationRetries()
+	return _u
+}
+
+// SetVideoValidatedAt sets the "video_validated_at" field.
+func (_u *QueueUpdateOne) SetVideoValidatedAt(v time.Time) *QueueUpdateOne {
+	_u.mutation.SetVideoValidatedAt(v)
+	return _u
+}
+
+// SetNillableVideoValidatedAt sets the "video_validated_at" field if the given value is not nil.
+func (_u *QueueUpdateOne) SetNillableVideoValidatedAt(v *time.Time) *QueueUpdateOne {
+	if v != nil {
+		_u.SetVideoValidatedAt(*v)
+	}
+	return _u
+}
+
+// ClearVideoValidatedAt clears the value of the "video_validated_at" field.
+func (_u *QueueUpdateOne) ClearVideoValidatedAt() *QueueUpdateOne {
+	_u.mutation.ClearVideoValidatedAt()
+	return _u
+}
+
+// SetTaskProgress sets the "task_progress" field.
+func (_u *QueueUpdateOne) SetTaskProgress(v map[utils.TaskName]utils.TaskProgress) *QueueUpdateOne {
+	_u.mutation.SetTaskProgress(v)
+	return _u
+}
+
+// ClearTaskProgress clears the value of the "task_progress" field.
+func (_u *QueueUpdateOne) ClearTaskProgress() *QueueUpdateOne {
+	_u.mutation.ClearTaskProgress()
+	return _u
+}
+
 // SetWorkflowID sets the "workflow_id" field.
 func (_u *QueueUpdateOne) SetWorkflowID(v string) *QueueUpdateOne {
 	_u.mutation.SetWorkflowID(v)
@@ -1166,6 +1906,11 @@ func (_u *QueueUpdateOne) check() error {
 			return &ValidationError{Name: "task_video_convert", err: fmt.Errorf(`ent: validator failed for field "Queue.task_video_convert": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.TaskVideoValidate(); ok {
+		if err := queue.TaskVideoValidateValidator(v); err != nil {
+			return &ValidationError{Name: "task_video_validate", err: fmt.Errorf(`ent: validator failed for field "Queue.task_video_validate": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.TaskVideoMove(); ok {
 		if err := queue.TaskVideoMoveValidator(v); err != nil {
 			return &ValidationError{Name: "task_video_move", err: fmt.Errorf(`ent: validator failed for field "Queue.task_video_move": %w`, err)}
@@ -1191,6 +1936,11 @@ func (_u *QueueUpdateOne) check() error {
 			return &ValidationError{Name: "task_chat_move", err: fmt.Errorf(`ent: validator failed for field "Queue.task_chat_move": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.VideoValidationVerdict(); ok {
+		if err := queue.VideoValidationVerdictValidator(v); err != nil {
+			return &ValidationError{Name: "video_validation_verdict", err: fmt.Errorf(`ent: validator failed for field "Queue.video_validation_verdict": %w`, err)}
+		}
+	}
 	if _u.mutation.VodCleared() && len(_u.mutation.VodIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Queue.vod"`)
 	}
@@ -1271,6 +2021,12 @@ func (_u *QueueUpdateOne) sqlSave(ctx context.Context) (_node *Queue, err error)
 	if _u.mutation.TaskVideoConvertCleared() {
 		_spec.ClearField(queue.FieldTaskVideoConvert, field.TypeEnum)
 	}
+	if value, ok := _u.mutation.TaskVideoValidate(); ok {
+		_spec.SetField(queue.FieldTaskVideoValidate, field.TypeEnum, value)
+	}
+	if _u.mutation.TaskVideoValidateCleared() {
+		_spec.ClearField(queue.FieldTaskVideoValidate, field.TypeEnum)
+	}
 	if value, ok := _u.mutation.TaskVideoMove(); ok {
 		_spec.SetField(queue.FieldTaskVideoMove, field.TypeEnum, value)
 	}
@@ -1319,6 +2075,102 @@ func (_u *QueueUpdateOne) sqlSave(ctx context.Context) (_node *Queue, err error)
 	if _u.mutation.RenderChatCleared() {
 		_spec.ClearField(queue.FieldRenderChat, field.TypeBool)
 	}
+	if value, ok := _u.mutation.VideoDownloadAttempts(); ok {
+		_spec.SetField(queue.FieldVideoDownloadAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVideoDownloadAttempts(); ok {
+		_spec.AddField(queue.FieldVideoDownloadAttempts, field.TypeInt, value)
+	}
+	if _u.mutation.VideoDownloadAttemptsCleared() {
+		_spec.ClearField(queue.FieldVideoDownloadAttempts, field.TypeInt)
+	}
+	if value, ok := _u.mutation.VideoDownloadResumeFragment(); ok {
+		_spec.SetField(queue.FieldVideoDownloadResumeFragment, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVideoDownloadResumeFragment(); ok {
+		_spec.AddField(queue.FieldVideoDownloadResumeFragment, field.TypeInt, value)
+	}
+	if _u.mutation.VideoDownloadResumeFragmentCleared() {
+		_spec.ClearField(queue.FieldVideoDownloadResumeFragment, field.TypeInt)
+	}
+	if value, ok := _u.mutation.VideoDownloadResumeBytes(); ok {
+		_spec.SetField(queue.FieldVideoDownloadResumeBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedVideoDownloadResumeBytes(); ok {
+		_spec.AddField(queue.FieldVideoDownloadResumeBytes, field.TypeInt64, value)
+	}
+	if _u.mutation.VideoDownloadResumeBytesCleared() {
+		_spec.ClearField(queue.FieldVideoDownloadResumeBytes, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.VideoDownloadProgressAt(); ok {
+		_spec.SetField(queue.FieldVideoDownloadProgressAt, field.TypeTime, value)
+	}
+	if _u.mutation.VideoDownloadProgressAtCleared() {
+		_spec.ClearField(queue.FieldVideoDownloadProgressAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.VideoValidationVerdict(); ok {
+		_spec.SetField(queue.FieldVideoValidationVerdict, field.TypeEnum, value)
+	}
+	if _u.mutation.VideoValidationVerdictCleared() {
+		_spec.ClearField(queue.FieldVideoValidationVerdict, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.VideoValidationMessage(); ok {
+		_spec.SetField(queue.FieldVideoValidationMessage, field.TypeString, value)
+	}
+	if _u.mutation.VideoValidationMessageCleared() {
+		_spec.ClearField(queue.FieldVideoValidationMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.VideoValidationExpectedDuration(); ok {
+		_spec.SetField(queue.FieldVideoValidationExpectedDuration, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedVideoValidationExpectedDuration(); ok {
+		_spec.AddField(queue.FieldVideoValidationExpectedDuration, field.TypeFloat64, value)
+	}
+	if _u.mutation.VideoValidationExpectedDurationCleared() {
+		_spec.ClearField(queue.FieldVideoValidationExpectedDuration, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.VideoValidationMeasuredDuration(); ok {
+		_spec.SetField(queue.FieldVideoValidationMeasuredDuration, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedVideoValidationMeasuredDuration(); ok {
+		_spec.AddField(queue.FieldVideoValidationMeasuredDuration, field.TypeFloat64, value)
+	}
+	if _u.mutation.VideoValidationMeasuredDurationCleared() {
+		_spec.ClearField(queue.FieldVideoValidationMeasuredDuration, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.VideoValidationHasVideo(); ok {
+		_spec.SetField(queue.FieldVideoValidationHasVideo, field.TypeBool, value)
+	}
+	if _u.mutation.VideoValidationHasVideoCleared() {
+		_spec.ClearField(queue.FieldVideoValidationHasVideo, field.TypeBool)
+	}
+	if value, ok := _u.mutation.VideoValidationHasAudio(); ok {
+		_spec.SetField(queue.FieldVideoValidationHasAudio, field.TypeBool, value)
+	}
+	if _u.mutation.VideoValidationHasAudioCleared() {
+		_spec.ClearField(queue.FieldVideoValidationHasAudio, field.TypeBool)
+	}
+	if value, ok := _u.mutation.VideoValidationRetries(); ok {
+		_spec.SetField(queue.FieldVideoValidationRetries, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVideoValidationRetries(); ok {
+		_spec.AddField(queue.FieldVideoValidationRetries, field.TypeInt, value)
+	}
+	if _u.mutation.VideoValidationRetriesCleared() {
+		_spec.ClearField(queue.FieldVideoValidationRetries, field.TypeInt)
+	}
+	if value, ok := _u.mutation.VideoValidatedAt(); ok {
+		_spec.SetField(queue.FieldVideoValidatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.VideoValidatedAtCleared() {
+		_spec.ClearField(queue.FieldVideoValidatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.TaskProgress(); ok {
+		_spec.SetField(queue.FieldTaskProgress, field.TypeJSON, value)
+	}
+	if _u.mutation.TaskProgressCleared() {
+		_spec.ClearField(queue.FieldTaskProgress, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.WorkflowID(); ok {
 		_spec.SetField(queue.FieldWorkflowID, field.TypeString, value)
 	}
@@ -6,20 +6,30 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/apitoken"
+	"github.com/zibbp/ganymede/ent/auditlog"
 	"github.com/zibbp/ganymede/ent/blockedvideos"
+	"github.com/zibbp/ganymede/ent/bulkoperation"
 	"github.com/zibbp/ganymede/ent/channel"
 	"github.com/zibbp/ganymede/ent/chapter"
 	"github.com/zibbp/ganymede/ent/live"
 	"github.com/zibbp/ganymede/ent/livecategory"
 	"github.com/zibbp/ganymede/ent/livetitleregex"
+	"github.com/zibbp/ganymede/ent/loginthrottle"
 	"github.com/zibbp/ganymede/ent/mutedsegment"
+	"github.com/zibbp/ganymede/ent/notificationfailure"
+	"github.com/zibbp/ganymede/ent/notificationsubscription"
 	"github.com/zibbp/ganymede/ent/playback"
 	"github.com/zibbp/ganymede/ent/playlist"
 	"github.com/zibbp/ganymede/ent/playlistrule"
 	"github.com/zibbp/ganymede/ent/playlistrulegroup"
+	"github.com/zibbp/ganymede/ent/playlistsection"
+	"github.com/zibbp/ganymede/ent/playlistvod"
 	"github.com/zibbp/ganymede/ent/queue"
 	"github.com/zibbp/ganymede/ent/schema"
 	"github.com/zibbp/ganymede/ent/sessions"
+	"github.com/zibbp/ganymede/ent/sharelink"
+	"github.com/zibbp/ganymede/ent/tag"
 	"github.com/zibbp/ganymede/ent/twitchcategory"
 	"github.com/zibbp/ganymede/ent/user"
 	"github.com/zibbp/ganymede/ent/vod"
@@ -33,12 +43,60 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	apitokenFields := schema.ApiToken{}.Fields()
+	_ = apitokenFields
+	// apitokenDescCreatedAt is the schema descriptor for created_at field.
+	apitokenDescCreatedAt := apitokenFields[7].Descriptor()
+	// apitoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	apitoken.DefaultCreatedAt = apitokenDescCreatedAt.Default.(func() time.Time)
+	// apitokenDescID is the schema descriptor for id field.
+	apitokenDescID := apitokenFields[0].Descriptor()
+	// apitoken.DefaultID holds the default value on creation for the id field.
+	apitoken.DefaultID = apitokenDescID.Default.(func() uuid.UUID)
+	auditlogFields := schema.AuditLog{}.Fields()
+	_ = auditlogFields
+	// auditlogDescCreatedAt is the schema descriptor for created_at field.
+	auditlogDescCreatedAt := auditlogFields[9].Descriptor()
+	// auditlog.DefaultCreatedAt holds the default value on creation for the created_at field.
+	auditlog.DefaultCreatedAt = auditlogDescCreatedAt.Default.(func() time.Time)
+	// auditlogDescID is the schema descriptor for id field.
+	auditlogDescID := auditlogFields[0].Descriptor()
+	// auditlog.DefaultID holds the default value on creation for the id field.
+	auditlog.DefaultID = auditlogDescID.Default.(func() uuid.UUID)
 	blockedvideosFields := schema.BlockedVideos{}.Fields()
 	_ = blockedvideosFields
 	// blockedvideosDescCreatedAt is the schema descriptor for created_at field.
 	blockedvideosDescCreatedAt := blockedvideosFields[1].Descriptor()
 	// blockedvideos.DefaultCreatedAt holds the default value on creation for the created_at field.
 	blockedvideos.DefaultCreatedAt = blockedvideosDescCreatedAt.Default.(func() time.Time)
+	bulkoperationFields := schema.BulkOperation{}.Fields()
+	_ = bulkoperationFields
+	// bulkoperationDescTotal is the schema descriptor for total field.
+	bulkoperationDescTotal := bulkoperationFields[6].Descriptor()
+	// bulkoperation.DefaultTotal holds the default value on creation for the total field.
+	bulkoperation.DefaultTotal = bulkoperationDescTotal.Default.(int)
+	// bulkoperationDescSucceeded is the schema descriptor for succeeded field.
+	bulkoperationDescSucceeded := bulkoperationFields[7].Descriptor()
+	// bulkoperation.DefaultSucceeded holds the default value on creation for the succeeded field.
+	bulkoperation.DefaultSucceeded = bulkoperationDescSucceeded.Default.(int)
+	// bulkoperationDescFailed is the schema descriptor for failed field.
+	bulkoperationDescFailed := bulkoperationFields[8].Descriptor()
+	// bulkoperation.DefaultFailed holds the default value on creation for the failed field.
+	bulkoperation.DefaultFailed = bulkoperationDescFailed.Default.(int)
+	// bulkoperationDescUpdatedAt is the schema descriptor for updated_at field.
+	bulkoperationDescUpdatedAt := bulkoperationFields[13].Descriptor()
+	// bulkoperation.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	bulkoperation.DefaultUpdatedAt = bulkoperationDescUpdatedAt.Default.(func() time.Time)
+	// bulkoperation.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	bulkoperation.UpdateDefaultUpdatedAt = bulkoperationDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// bulkoperationDescCreatedAt is the schema descriptor for created_at field.
+	bulkoperationDescCreatedAt := bulkoperationFields[14].Descriptor()
+	// bulkoperation.DefaultCreatedAt holds the default value on creation for the created_at field.
+	bulkoperation.DefaultCreatedAt = bulkoperationDescCreatedAt.Default.(func() time.Time)
+	// bulkoperationDescID is the schema descriptor for id field.
+	bulkoperationDescID := bulkoperationFields[0].Descriptor()
+	// bulkoperation.DefaultID holds the default value on creation for the id field.
+	bulkoperation.DefaultID = bulkoperationDescID.Default.(func() uuid.UUID)
 	channelFields := schema.Channel{}.Fields()
 	_ = channelFields
 	// channelDescRetention is the schema descriptor for retention field.
@@ -46,17 +104,17 @@ func init() {
 	// channel.DefaultRetention holds the default value on creation for the retention field.
 	channel.DefaultRetention = channelDescRetention.Default.(bool)
 	// channelDescStorageSizeBytes is the schema descriptor for storage_size_bytes field.
-	channelDescStorageSizeBytes := channelFields[7].Descriptor()
+	channelDescStorageSizeBytes := channelFields[9].Descriptor()
 	// channel.DefaultStorageSizeBytes holds the default value on creation for the storage_size_bytes field.
 	channel.DefaultStorageSizeBytes = channelDescStorageSizeBytes.Default.(int64)
 	// channelDescUpdatedAt is the schema descriptor for updated_at field.
-	channelDescUpdatedAt := channelFields[8].Descriptor()
+	channelDescUpdatedAt := channelFields[11].Descriptor()
 	// channel.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	channel.DefaultUpdatedAt = channelDescUpdatedAt.Default.(func() time.Time)
 	// channel.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	channel.UpdateDefaultUpdatedAt = channelDescUpdatedAt.UpdateDefault.(func() time.Time)
 	// channelDescCreatedAt is the schema descriptor for created_at field.
-	channelDescCreatedAt := channelFields[9].Descriptor()
+	channelDescCreatedAt := channelFields[12].Descriptor()
 	// channel.DefaultCreatedAt holds the default value on creation for the created_at field.
 	channel.DefaultCreatedAt = channelDescCreatedAt.Default.(func() time.Time)
 	// channelDescID is the schema descriptor for id field.
@@ -187,12 +245,50 @@ func init() {
 	livetitleregexDescID := livetitleregexFields[0].Descriptor()
 	// livetitleregex.DefaultID holds the default value on creation for the id field.
 	livetitleregex.DefaultID = livetitleregexDescID.Default.(func() uuid.UUID)
+	loginthrottleFields := schema.LoginThrottle{}.Fields()
+	_ = loginthrottleFields
+	// loginthrottleDescAttempts is the schema descriptor for attempts field.
+	loginthrottleDescAttempts := loginthrottleFields[3].Descriptor()
+	// loginthrottle.DefaultAttempts holds the default value on creation for the attempts field.
+	loginthrottle.DefaultAttempts = loginthrottleDescAttempts.Default.(int)
+	// loginthrottleDescLastAttemptAt is the schema descriptor for last_attempt_at field.
+	loginthrottleDescLastAttemptAt := loginthrottleFields[4].Descriptor()
+	// loginthrottle.DefaultLastAttemptAt holds the default value on creation for the last_attempt_at field.
+	loginthrottle.DefaultLastAttemptAt = loginthrottleDescLastAttemptAt.Default.(func() time.Time)
+	// loginthrottleDescCreatedAt is the schema descriptor for created_at field.
+	loginthrottleDescCreatedAt := loginthrottleFields[6].Descriptor()
+	// loginthrottle.DefaultCreatedAt holds the default value on creation for the created_at field.
+	loginthrottle.DefaultCreatedAt = loginthrottleDescCreatedAt.Default.(func() time.Time)
+	// loginthrottleDescID is the schema descriptor for id field.
+	loginthrottleDescID := loginthrottleFields[0].Descriptor()
+	// loginthrottle.DefaultID holds the default value on creation for the id field.
+	loginthrottle.DefaultID = loginthrottleDescID.Default.(func() uuid.UUID)
 	mutedsegmentFields := schema.MutedSegment{}.Fields()
 	_ = mutedsegmentFields
 	// mutedsegmentDescID is the schema descriptor for id field.
 	mutedsegmentDescID := mutedsegmentFields[0].Descriptor()
 	// mutedsegment.DefaultID holds the default value on creation for the id field.
 	mutedsegment.DefaultID = mutedsegmentDescID.Default.(func() uuid.UUID)
+	notificationfailureFields := schema.NotificationFailure{}.Fields()
+	_ = notificationfailureFields
+	// notificationfailureDescCreatedAt is the schema descriptor for created_at field.
+	notificationfailureDescCreatedAt := notificationfailureFields[6].Descriptor()
+	// notificationfailure.DefaultCreatedAt holds the default value on creation for the created_at field.
+	notificationfailure.DefaultCreatedAt = notificationfailureDescCreatedAt.Default.(func() time.Time)
+	// notificationfailureDescID is the schema descriptor for id field.
+	notificationfailureDescID := notificationfailureFields[0].Descriptor()
+	// notificationfailure.DefaultID holds the default value on creation for the id field.
+	notificationfailure.DefaultID = notificationfailureDescID.Default.(func() uuid.UUID)
+	notificationsubscriptionFields := schema.NotificationSubscription{}.Fields()
+	_ = notificationsubscriptionFields
+	// notificationsubscriptionDescCreatedAt is the schema descriptor for created_at field.
+	notificationsubscriptionDescCreatedAt := notificationsubscriptionFields[5].Descriptor()
+	// notificationsubscription.DefaultCreatedAt holds the default value on creation for the created_at field.
+	notificationsubscription.DefaultCreatedAt = notificationsubscriptionDescCreatedAt.Default.(func() time.Time)
+	// notificationsubscriptionDescID is the schema descriptor for id field.
+	notificationsubscriptionDescID := notificationsubscriptionFields[0].Descriptor()
+	// notificationsubscription.DefaultID holds the default value on creation for the id field.
+	notificationsubscription.DefaultID = notificationsubscriptionDescID.Default.(func() uuid.UUID)
 	playbackFields := schema.Playback{}.Fields()
 	_ = playbackFields
 	// playbackDescTime is the schema descriptor for time field.
@@ -215,14 +311,18 @@ func init() {
 	playback.DefaultID = playbackDescID.Default.(func() uuid.UUID)
 	playlistFields := schema.Playlist{}.Fields()
 	_ = playlistFields
+	// playlistDescManualOrder is the schema descriptor for manual_order field.
+	playlistDescManualOrder := playlistFields[6].Descriptor()
+	// playlist.DefaultManualOrder holds the default value on creation for the manual_order field.
+	playlist.DefaultManualOrder = playlistDescManualOrder.Default.(bool)
 	// playlistDescUpdatedAt is the schema descriptor for updated_at field.
-	playlistDescUpdatedAt := playlistFields[4].Descriptor()
+	playlistDescUpdatedAt := playlistFields[7].Descriptor()
 	// playlist.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	playlist.DefaultUpdatedAt = playlistDescUpdatedAt.Default.(func() time.Time)
 	// playlist.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	playlist.UpdateDefaultUpdatedAt = playlistDescUpdatedAt.UpdateDefault.(func() time.Time)
 	// playlistDescCreatedAt is the schema descriptor for created_at field.
-	playlistDescCreatedAt := playlistFields[5].Descriptor()
+	playlistDescCreatedAt := playlistFields[8].Descriptor()
 	// playlist.DefaultCreatedAt holds the default value on creation for the created_at field.
 	playlist.DefaultCreatedAt = playlistDescCreatedAt.Default.(func() time.Time)
 	// playlistDescID is the schema descriptor for id field.
@@ -239,6 +339,10 @@ func init() {
 	playlistruleDescEnabled := playlistruleFields[6].Descriptor()
 	// playlistrule.DefaultEnabled holds the default value on creation for the enabled field.
 	playlistrule.DefaultEnabled = playlistruleDescEnabled.Default.(bool)
+	// playlistruleDescNegate is the schema descriptor for negate field.
+	playlistruleDescNegate := playlistruleFields[7].Descriptor()
+	// playlistrule.DefaultNegate holds the default value on creation for the negate field.
+	playlistrule.DefaultNegate = playlistruleDescNegate.Default.(bool)
 	// playlistruleDescID is the schema descriptor for id field.
 	playlistruleDescID := playlistruleFields[0].Descriptor()
 	// playlistrule.DefaultID holds the default value on creation for the id field.
@@ -249,10 +353,58 @@ func init() {
 	playlistrulegroupDescPosition := playlistrulegroupFields[2].Descriptor()
 	// playlistrulegroup.DefaultPosition holds the default value on creation for the position field.
 	playlistrulegroup.DefaultPosition = playlistrulegroupDescPosition.Default.(int)
+	// playlistrulegroupDescNegate is the schema descriptor for negate field.
+	playlistrulegroupDescNegate := playlistrulegroupFields[3].Descriptor()
+	// playlistrulegroup.DefaultNegate holds the default value on creation for the negate field.
+	playlistrulegroup.DefaultNegate = playlistrulegroupDescNegate.Default.(bool)
 	// playlistrulegroupDescID is the schema descriptor for id field.
 	playlistrulegroupDescID := playlistrulegroupFields[0].Descriptor()
 	// playlistrulegroup.DefaultID holds the default value on creation for the id field.
 	playlistrulegroup.DefaultID = playlistrulegroupDescID.Default.(func() uuid.UUID)
+	playlistsectionFields := schema.PlaylistSection{}.Fields()
+	_ = playlistsectionFields
+	// playlistsectionDescName is the schema descriptor for name field.
+	playlistsectionDescName := playlistsectionFields[1].Descriptor()
+	// playlistsection.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	playlistsection.NameValidator = func() func(string) error {
+		validators := playlistsectionDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// playlistsectionDescPosition is the schema descriptor for position field.
+	playlistsectionDescPosition := playlistsectionFields[2].Descriptor()
+	// playlistsection.DefaultPosition holds the default value on creation for the position field.
+	playlistsection.DefaultPosition = playlistsectionDescPosition.Default.(int)
+	// playlistsectionDescUpdatedAt is the schema descriptor for updated_at field.
+	playlistsectionDescUpdatedAt := playlistsectionFields[3].Descriptor()
+	// playlistsection.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	playlistsection.DefaultUpdatedAt = playlistsectionDescUpdatedAt.Default.(func() time.Time)
+	// playlistsection.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	playlistsection.UpdateDefaultUpdatedAt = playlistsectionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// playlistsectionDescCreatedAt is the schema descriptor for created_at field.
+	playlistsectionDescCreatedAt := playlistsectionFields[4].Descriptor()
+	// playlistsection.DefaultCreatedAt holds the default value on creation for the created_at field.
+	playlistsection.DefaultCreatedAt = playlistsectionDescCreatedAt.Default.(func() time.Time)
+	// playlistsectionDescID is the schema descriptor for id field.
+	playlistsectionDescID := playlistsectionFields[0].Descriptor()
+	// playlistsection.DefaultID holds the default value on creation for the id field.
+	playlistsection.DefaultID = playlistsectionDescID.Default.(func() uuid.UUID)
+	playlistvodFields := schema.PlaylistVod{}.Fields()
+	_ = playlistvodFields
+	// playlistvodDescPosition is the schema descriptor for position field.
+	playlistvodDescPosition := playlistvodFields[2].Descriptor()
+	// playlistvod.DefaultPosition holds the default value on creation for the position field.
+	playlistvod.DefaultPosition = playlistvodDescPosition.Default.(int)
 	queueFields := schema.Queue{}.Fields()
 	_ = queueFields
 	// queueDescLiveArchive is the schema descriptor for live_archive field.
@@ -276,21 +428,29 @@ func init() {
 	// queue.DefaultProcessing holds the default value on creation for the processing field.
 	queue.DefaultProcessing = queueDescProcessing.Default.(bool)
 	// queueDescArchiveChat is the schema descriptor for archive_chat field.
-	queueDescArchiveChat := queueFields[17].Descriptor()
+	queueDescArchiveChat := queueFields[18].Descriptor()
 	// queue.DefaultArchiveChat holds the default value on creation for the archive_chat field.
 	queue.DefaultArchiveChat = queueDescArchiveChat.Default.(bool)
 	// queueDescRenderChat is the schema descriptor for render_chat field.
-	queueDescRenderChat := queueFields[18].Descriptor()
+	queueDescRenderChat := queueFields[19].Descriptor()
 	// queue.DefaultRenderChat holds the default value on creation for the render_chat field.
 	queue.DefaultRenderChat = queueDescRenderChat.Default.(bool)
+	// queueDescVideoDownloadAttempts is the schema descriptor for video_download_attempts field.
+	queueDescVideoDownloadAttempts := queueFields[20].Descriptor()
+	// queue.DefaultVideoDownloadAttempts holds the default value on creation for the video_download_attempts field.
+	queue.DefaultVideoDownloadAttempts = queueDescVideoDownloadAttempts.Default.(int)
+	// queueDescVideoValidationRetries is the schema descriptor for video_validation_retries field.
+	queueDescVideoValidationRetries := queueFields[30].Descriptor()
+	// queue.DefaultVideoValidationRetries holds the default value on creation for the video_validation_retries field.
+	queue.DefaultVideoValidationRetries = queueDescVideoValidationRetries.Default.(int)
 	// queueDescUpdatedAt is the schema descriptor for updated_at field.
-	queueDescUpdatedAt := queueFields[21].Descriptor()
+	queueDescUpdatedAt := queueFields[35].Descriptor()
 	// queue.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	queue.DefaultUpdatedAt = queueDescUpdatedAt.Default.(func() time.Time)
 	// queue.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	queue.UpdateDefaultUpdatedAt = queueDescUpdatedAt.UpdateDefault.(func() time.Time)
 	// queueDescCreatedAt is the schema descriptor for created_at field.
-	queueDescCreatedAt := queueFields[22].Descriptor()
+	queueDescCreatedAt := queueFields[36].Descriptor()
 	// queue.DefaultCreatedAt holds the default value on creation for the created_at field.
 	queue.DefaultCreatedAt = queueDescCreatedAt.Default.(func() time.Time)
 	// queueDescID is the schema descriptor for id field.
@@ -307,6 +467,54 @@ func init() {
 	sessionsDescData := sessionsFields[1].Descriptor()
 	// sessions.DataValidator is a validator for the "data" field. It is called by the builders before save.
 	sessions.DataValidator = sessionsDescData.Validators[0].(func([]byte) error)
+	sharelinkFields := schema.ShareLink{}.Fields()
+	_ = sharelinkFields
+	// sharelinkDescViews is the schema descriptor for views field.
+	sharelinkDescViews := sharelinkFields[6].Descriptor()
+	// sharelink.DefaultViews holds the default value on creation for the views field.
+	sharelink.DefaultViews = sharelinkDescViews.Default.(int)
+	// sharelinkDescCreatedAt is the schema descriptor for created_at field.
+	sharelinkDescCreatedAt := sharelinkFields[8].Descriptor()
+	// sharelink.DefaultCreatedAt holds the default value on creation for the created_at field.
+	sharelink.DefaultCreatedAt = sharelinkDescCreatedAt.Default.(func() time.Time)
+	// sharelinkDescID is the schema descriptor for id field.
+	sharelinkDescID := sharelinkFields[0].Descriptor()
+	// sharelink.DefaultID holds the default value on creation for the id field.
+	sharelink.DefaultID = sharelinkDescID.Default.(func() uuid.UUID)
+	tagFields := schema.Tag{}.Fields()
+	_ = tagFields
+	// tagDescName is the schema descriptor for name field.
+	tagDescName := tagFields[1].Descriptor()
+	// tag.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	tag.NameValidator = func() func(string) error {
+		validators := tagDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// tagDescUpdatedAt is the schema descriptor for updated_at field.
+	tagDescUpdatedAt := tagFields[2].Descriptor()
+	// tag.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	tag.DefaultUpdatedAt = tagDescUpdatedAt.Default.(func() time.Time)
+	// tag.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	tag.UpdateDefaultUpdatedAt = tagDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// tagDescCreatedAt is the schema descriptor for created_at field.
+	tagDescCreatedAt := tagFields[3].Descriptor()
+	// tag.DefaultCreatedAt holds the default value on creation for the created_at field.
+	tag.DefaultCreatedAt = tagDescCreatedAt.Default.(func() time.Time)
+	// tagDescID is the schema descriptor for id field.
+	tagDescID := tagFields[0].Descriptor()
+	// tag.DefaultID holds the default value on creation for the id field.
+	tag.DefaultID = tagDescID.Default.(func() uuid.UUID)
 	twitchcategoryFields := schema.TwitchCategory{}.Fields()
 	_ = twitchcategoryFields
 	// twitchcategoryDescUpdatedAt is the schema descriptor for updated_at field.
@@ -325,14 +533,22 @@ func init() {
 	userDescOauth := userFields[4].Descriptor()
 	// user.DefaultOauth holds the default value on creation for the oauth field.
 	user.DefaultOauth = userDescOauth.Default.(bool)
+	// userDescLdap is the schema descriptor for ldap field.
+	userDescLdap := userFields[5].Descriptor()
+	// user.DefaultLdap holds the default value on creation for the ldap field.
+	user.DefaultLdap = userDescLdap.Default.(bool)
+	// userDescTotpEnabled is the schema descriptor for totp_enabled field.
+	userDescTotpEnabled := userFields[9].Descriptor()
+	// user.DefaultTotpEnabled holds the default value on creation for the totp_enabled field.
+	user.DefaultTotpEnabled = userDescTotpEnabled.Default.(bool)
 	// userDescUpdatedAt is the schema descriptor for updated_at field.
-	userDescUpdatedAt := userFields[7].Descriptor()
+	userDescUpdatedAt := userFields[13].Descriptor()
 	// user.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	user.DefaultUpdatedAt = userDescUpdatedAt.Default.(func() time.Time)
 	// user.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	user.UpdateDefaultUpdatedAt = userDescUpdatedAt.UpdateDefault.(func() time.Time)
 	// userDescCreatedAt is the schema descriptor for created_at field.
-	userDescCreatedAt := userFields[8].Descriptor()
+	userDescCreatedAt := userFields[14].Descriptor()
 	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
 	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
 	// userDescID is the schema descriptor for id field.
@@ -374,13 +590,13 @@ func init() {
 	// vod.DefaultStreamedAt holds the default value on creation for the streamed_at field.
 	vod.DefaultStreamedAt = vodDescStreamedAt.Default.(func() time.Time)
 	// vodDescUpdatedAt is the schema descriptor for updated_at field.
-	vodDescUpdatedAt := vodFields[42].Descriptor()
+	vodDescUpdatedAt := vodFields[44].Descriptor()
 	// vod.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	vod.DefaultUpdatedAt = vodDescUpdatedAt.Default.(func() time.Time)
 	// vod.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	vod.UpdateDefaultUpdatedAt = vodDescUpdatedAt.UpdateDefault.(func() time.Time)
 	// vodDescCreatedAt is the schema descriptor for created_at field.
-	vodDescCreatedAt := vodFields[43].Descriptor()
+	vodDescCreatedAt := vodFields[45].Descriptor()
 	// vod.DefaultCreatedAt holds the default value on creation for the created_at field.
 	vod.DefaultCreatedAt = vodDescCreatedAt.Default.(func() time.Time)
 	// vodDescID is the schema descriptor for id field.
@@ -0,0 +1,37 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// ApiToken holds the schema definition for the ApiToken entity.
+type ApiToken struct {
+	ent.Schema
+}
+
+// Fields of the ApiToken.
+func (ApiToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.String("name"),
+		field.String("token_hash").Unique().Sensitive().Comment("SHA-256 hash of the token, the token itself is only returned when created."),
+		field.String("token_prefix").Comment("First characters of the token to tell tokens apart."),
+		field.Enum("role").GoType(utils.Role("")).Optional().Nillable().Comment("Highest role requests made with the token have, the user's role is used if empty."),
+		field.Time("expires_at").Optional().Nillable(),
+		field.Time("last_used_at").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the ApiToken.
+func (ApiToken) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("api_tokens").Required().Unique(),
+	}
+}
@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// AuditLog holds the schema definition for the AuditLog entity.
+type AuditLog struct {
+	ent.Schema
+}
+
+// Fields of the AuditLog.
+func (AuditLog) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.String("action").Comment("What was done, e.g. vod.delete."),
+		field.String("resource_type"),
+		field.String("resource_id").Optional(),
+		field.UUID("actor_id", uuid.UUID{}).Optional().Nillable().Comment("User that made the change, not an edge so entries are kept when the user is deleted."),
+		field.String("actor_username").Optional(),
+		field.String("ip").Optional(),
+		field.JSON("before", map[string]any{}).Optional().Comment("Changed fields before the change."),
+		field.JSON("after", map[string]any{}).Optional().Comment("Changed fields after the change."),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the AuditLog.
+func (AuditLog) Edges() []ent.Edge {
+	return nil
+}
+
+func (AuditLog) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at"),
+		index.Fields("action"),
+		index.Fields("actor_id"),
+		index.Fields("resource_type", "resource_id"),
+	}
+}
@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// BulkOperation holds the schema definition for the BulkOperation entity.
+type BulkOperation struct {
+	ent.Schema
+}
+
+// Fields of the BulkOperation.
+func (BulkOperation) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.Enum("action").GoType(utils.BulkAction("")),
+		field.Enum("status").GoType(utils.TaskStatus("")).Default(string(utils.Pending)),
+		field.JSON("filter", utils.BulkVodFilter{}).Optional().Comment("Filter selecting the vods, used if no vod IDs are set."),
+		field.JSON("vod_ids", []uuid.UUID{}).Optional().Comment("Vods the action is applied to."),
+		field.UUID("playlist_id", uuid.UUID{}).Optional().Nillable().Comment("Playlist vods are added to or removed from."),
+		field.Int("total").Default(0),
+		field.Int("succeeded").Default(0),
+		field.Int("failed").Default(0),
+		field.JSON("results", []utils.BulkItemResult{}).Optional(),
+		field.String("error").Optional().Comment("Error that stopped the operation."),
+		field.Int64("job_id").Optional(),
+		field.Time("finished_at").Optional().Nillable(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the BulkOperation.
+func (BulkOperation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("created_by", User.Type).Ref("bulk_operations").Unique(),
+	}
+}
+
+// Indexes of the BulkOperation.
+func (BulkOperation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at"),
+	}
+}
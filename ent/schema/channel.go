@@ -4,9 +4,11 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/internal/utils"
 )
 
 // Channel holds the schema definition for the Channel entity.
@@ -24,7 +26,10 @@ func (Channel) Fields() []ent.Field {
 		field.String("image_path"),
 		field.Bool("retention").Default(false),
 		field.Int64("retention_days").Optional(),
+		field.Enum("visibility").GoType(utils.Visibility("")).Default(string(utils.VisibilityPublic)),
+		field.Strings("allowed_groups").Optional().Comment("User groups that can view the channel if the visibility is restricted."),
 		field.Int64("storage_size_bytes").Default(0).Comment("Total storage size in bytes for the channel's videos."),
+		field.Time("deleted_at").Optional().Nillable().Comment("The time the channel was moved to the trash with its VODs."),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
 		field.Time("created_at").Default(time.Now).Immutable(),
 	}
@@ -36,5 +41,7 @@ func (Channel) Edges() []ent.Edge {
 		edge.To("vods", Vod.Type),
 		edge.To("live", Live.Type),
 		edge.To("youtube_config", YoutubeConfig.Type).Unique(),
+		edge.To("notification_subscriptions", NotificationSubscription.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("allowed_users", User.Type).Comment("Users that can view the channel if the visibility is restricted."),
 	}
 }
@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// LoginThrottle holds the schema definition for the LoginThrottle entity.
+type LoginThrottle struct {
+	ent.Schema
+}
+
+// Fields of the LoginThrottle.
+func (LoginThrottle) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.Enum("kind").GoType(utils.LoginThrottleKind("")),
+		field.String("value").Comment("IP address or username."),
+		field.Int("attempts").Default(0).Comment("Failed or unfinished logins, or registrations, since the window started."),
+		field.Time("last_attempt_at").Default(time.Now),
+		field.Time("locked_until").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the LoginThrottle.
+func (LoginThrottle) Edges() []ent.Edge {
+	return nil
+}
+
+func (LoginThrottle) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("kind", "value").Unique(),
+		index.Fields("last_attempt_at"),
+	}
+}
@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// NotificationFailure holds the schema definition for the NotificationFailure entity.
+type NotificationFailure struct {
+	ent.Schema
+}
+
+// Fields of the NotificationFailure.
+func (NotificationFailure) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.String("provider_name").Comment("Name of the provider the notification was sent to."),
+		field.Enum("provider_type").GoType(utils.NotificationProviderType("")),
+		field.String("event").Comment("Notification event that was sent."),
+		field.Text("error").Comment("Error of the last delivery attempt."),
+		field.Int("attempts").Comment("Number of delivery attempts."),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the NotificationFailure.
+func (NotificationFailure) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the NotificationFailure.
+func (NotificationFailure) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at"),
+	}
+}
@@ -0,0 +1,37 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// NotificationSubscription holds the schema definition for the NotificationSubscription entity.
+type NotificationSubscription struct {
+	ent.Schema
+}
+
+// Fields of the NotificationSubscription.
+func (NotificationSubscription) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.Enum("event").GoType(utils.SubscriptionEvent("")),
+		field.Enum("provider_type").GoType(utils.NotificationProviderType("")).Default(string(utils.NotificationProviderWebhook)),
+		field.String("url").Optional().Comment("Destination of the notification, the user's webhook is used if empty."),
+		field.String("token").Optional().Sensitive().Comment("ntfy access token or Gotify application token."),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the NotificationSubscription.
+func (NotificationSubscription) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("notification_subscriptions").Required().Unique(),
+		edge.From("channel", Channel.Type).Ref("notification_subscriptions").Unique().Comment("Channel of channel_live and archive_completed subscriptions."),
+		edge.From("playlist", Playlist.Type).Ref("notification_subscriptions").Unique().Comment("Playlist of playlist_video_added subscriptions."),
+	}
+}
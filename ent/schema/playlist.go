@@ -8,6 +8,7 @@ import (
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/internal/utils"
 )
 
 // Playlist holds the schema definition for the Playlist entity.
@@ -22,6 +23,9 @@ func (Playlist) Fields() []ent.Field {
 		field.String("name").Unique(),
 		field.String("description").Optional(),
 		field.String("thumbnail_path").Optional(),
+		field.Enum("visibility").GoType(utils.Visibility("")).Default(string(utils.VisibilityPublic)),
+		field.Strings("allowed_groups").Optional().Comment("User groups that can view the playlist if the visibility is restricted."),
+		field.Bool("manual_order").Default(false).Comment("Order vods by their position in the playlist instead of the date they were streamed."),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
 		field.Time("created_at").Default(time.Now).Immutable(),
 	}
@@ -30,8 +34,12 @@ func (Playlist) Fields() []ent.Field {
 // Edges of the Playlist.
 func (Playlist) Edges() []ent.Edge {
 	return []ent.Edge{
-		edge.To("vods", Vod.Type),
+		edge.To("vods", Vod.Type).Through("entries", PlaylistVod.Type),
+		edge.To("sections", PlaylistSection.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
 		edge.To("multistream_info", MultistreamInfo.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
 		edge.To("rule_groups", PlaylistRuleGroup.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("notification_subscriptions", NotificationSubscription.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("allowed_users", User.Type).Comment("Users that can view the playlist if the visibility is restricted."),
+		edge.To("share_links", ShareLink.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
@@ -23,6 +23,7 @@ func (PlaylistRule) Fields() []ent.Field {
 		field.String("value").Comment("Value to match against."),
 		field.Int("position").Default(0).Comment("Order within group"),
 		field.Bool("enabled").Default(true).Comment("Is the rule active?"),
+		field.Bool("negate").Default(false).Comment("Invert the result of the rule."),
 	}
 }
 
@@ -24,6 +24,13 @@ func (PlaylistRuleGroup) Fields() []ent.Field {
 		field.Int("position").
 			Default(0).
 			Comment("Used to order groups within the playlist"),
+		field.Bool("negate").
+			Default(false).
+			Comment("Invert the result of the group"),
+		field.UUID("parent_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("Group this group is nested in, top level groups have none"),
 	}
 }
 
@@ -35,5 +42,10 @@ func (PlaylistRuleGroup) Edges() []ent.Edge {
 			Required().
 			Unique(),
 		edge.To("rules", PlaylistRule.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("children", PlaylistRuleGroup.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)).
+			From("parent").
+			Field("parent_id").
+			Unique(),
 	}
 }
@@ -0,0 +1,33 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// PlaylistSection holds the schema definition for the PlaylistSection entity.
+type PlaylistSection struct {
+	ent.Schema
+}
+
+// Fields of the PlaylistSection.
+func (PlaylistSection) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.String("name").NotEmpty().MaxLen(100),
+		field.Int("position").Default(0).Comment("Order of the section in the playlist."),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the PlaylistSection.
+func (PlaylistSection) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("playlist", Playlist.Type).Ref("sections").Unique().Required(),
+	}
+}
@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// PlaylistVod holds the schema definition for the PlaylistVod entity, an entry of a vod in a playlist.
+type PlaylistVod struct {
+	ent.Schema
+}
+
+// Annotations of the PlaylistVod.
+func (PlaylistVod) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		field.ID("playlist_id", "vod_id"),
+	}
+}
+
+// Fields of the PlaylistVod.
+func (PlaylistVod) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("playlist_id", uuid.UUID{}),
+		field.UUID("vod_id", uuid.UUID{}),
+		field.Int("position").Default(0).Comment("Order of the vod in the playlist."),
+		field.UUID("section_id", uuid.UUID{}).Optional().Nillable().Comment("Section of the playlist the vod is in, cleared when the section is deleted."),
+	}
+}
+
+// Edges of the PlaylistVod.
+func (PlaylistVod) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("playlist", Playlist.Type).Unique().Required().Field("playlist_id").Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("vod", Vod.Type).Unique().Required().Field("vod_id").Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
@@ -29,6 +29,7 @@ func (Queue) Fields() []ent.Field {
 		field.Enum("task_vod_save_info").GoType(utils.TaskStatus("")).Default(string(utils.Pending)).Optional(),
 		field.Enum("task_video_download").GoType(utils.TaskStatus("")).Default(string(utils.Pending)).Optional(),
 		field.Enum("task_video_convert").GoType(utils.TaskStatus("")).Default(string(utils.Pending)).Optional(),
+		field.Enum("task_video_validate").GoType(utils.TaskStatus("")).Default(string(utils.Pending)).Optional(),
 		field.Enum("task_video_move").GoType(utils.TaskStatus("")).Default(string(utils.Pending)).Optional(),
 		field.Enum("task_chat_download").GoType(utils.TaskStatus("")).Default(string(utils.Pending)).Optional(),
 		field.Enum("task_chat_convert").GoType(utils.TaskStatus("")).Default(string(utils.Pending)).Optional(),
@@ -37,6 +38,19 @@ func (Queue) Fields() []ent.Field {
 		field.Time("chat_start").Optional(),
 		field.Bool("archive_chat").Optional().Default(true),
 		field.Bool("render_chat").Optional().Default(true),
+		field.Int("video_download_attempts").Default(0).Optional().Comment("Number of times the video download has been started."),
+		field.Int("video_download_resume_fragment").Optional().Comment("Fragment the last download attempt resumed from, live progress is recorded in task_progress."),
+		field.Int64("video_download_resume_bytes").Optional().Comment("Size of the partially downloaded files when the last download attempt started."),
+		field.Time("video_download_progress_at").Optional().Comment("Time the last download attempt started."),
+		field.Enum("video_validation_verdict").GoType(utils.VideoValidationVerdict("")).Optional().Comment("Verdict of the last post-download validation of the video."),
+		field.String("video_validation_message").Optional().Comment("Reason the last post-download validation failed."),
+		field.Float("video_validation_expected_duration").Optional().Comment("Expected duration in seconds, the platform duration minus muted segments."),
+		field.Float("video_validation_measured_duration").Optional().Comment("Duration in seconds measured by ffprobe."),
+		field.Bool("video_validation_has_video").Optional().Comment("Whether a video stream was found."),
+		field.Bool("video_validation_has_audio").Optional().Comment("Whether an audio stream was found."),
+		field.Int("video_validation_retries").Default(0).Optional().Comment("Number of times the video was re-downloaded after failing validation."),
+		field.Time("video_validated_at").Optional(),
+		field.JSON("task_progress", map[utils.TaskName]utils.TaskProgress{}).Optional().Comment("Latest progress of running tasks parsed from the output of the tool running them, keyed by task name."),
 		field.String("workflow_id").Optional(),
 		field.String("workflow_run_id").Optional(),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
@@ -0,0 +1,39 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ShareLink holds the schema definition for the ShareLink entity.
+type ShareLink struct {
+	ent.Schema
+}
+
+// Fields of the ShareLink.
+func (ShareLink) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.String("token_hash").Unique().Sensitive().Comment("SHA-256 hash of the token, the token itself is only returned when created."),
+		field.String("token_prefix").Comment("First characters of the token to tell links apart."),
+		field.Int("start_seconds").Optional().Nillable().Comment("Start of the part of the vod that is shared."),
+		field.Int("end_seconds").Optional().Nillable().Comment("End of the part of the vod that is shared."),
+		field.Time("expires_at").Optional().Nillable(),
+		field.Int("views").Default(0),
+		field.Time("last_viewed_at").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the ShareLink.
+func (ShareLink) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("vod", Vod.Type).Ref("share_links").Unique().Comment("Vod the link shares, empty for playlist links."),
+		edge.From("playlist", Playlist.Type).Ref("share_links").Unique().Comment("Playlist the link shares, empty for vod links."),
+		edge.From("created_by", User.Type).Ref("share_links").Unique(),
+	}
+}
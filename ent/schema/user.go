@@ -2,6 +2,8 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/zibbp/ganymede/internal/utils"
@@ -21,8 +23,14 @@ func (User) Fields() []ent.Field {
 		field.String("username").Unique(),
 		field.String("password").Sensitive().Optional(),
 		field.Bool("oauth").Default(false),
+		field.Bool("ldap").Default(false).Comment("Authenticated against the LDAP directory, the password is not stored."),
 		field.Enum("role").GoType(utils.Role("")).Default(string(utils.UserRole)),
 		field.String("webhook").Optional(),
+		field.Strings("groups").Optional().Comment("Groups used by the access rules of channels and playlists."),
+		field.Bool("totp_enabled").Default(false),
+		field.String("totp_secret").Optional().Sensitive().Comment("Base32 TOTP secret, set when enrollment starts and only used once enabled."),
+		field.Int64("totp_last_counter").Optional().Comment("Time step of the last accepted TOTP code so codes cannot be reused."),
+		field.Strings("totp_recovery_codes").Optional().Sensitive().Comment("SHA-256 hashes of unused recovery codes."),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
 		field.Time("created_at").Default(time.Now).Immutable(),
 	}
@@ -30,5 +38,12 @@ func (User) Fields() []ent.Field {
 
 // Edges of the User.
 func (User) Edges() []ent.Edge {
-	return nil
+	return []ent.Edge{
+		edge.To("notification_subscriptions", NotificationSubscription.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("api_tokens", ApiToken.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.From("allowed_channels", Channel.Type).Ref("allowed_users"),
+		edge.From("allowed_playlists", Playlist.Type).Ref("allowed_users"),
+		edge.To("share_links", ShareLink.Type),
+		edge.To("bulk_operations", BulkOperation.Type),
+	}
 }
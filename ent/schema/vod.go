@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
@@ -60,6 +61,8 @@ func (Vod) Fields() []ent.Field {
 		field.Int("sprite_thumbnails_columns").Optional(),
 		field.Int64("storage_size_bytes").Default(0).Comment("The size of the VOD in bytes."),
 		field.Time("streamed_at").Default(time.Now).Comment("The time the VOD was streamed."),
+		field.Time("deleted_at").Optional().Nillable().Comment("The time the VOD was moved to the trash, deleted VODs are hidden until restored or purged."),
+		field.String("trash_path").Optional().Comment("Folder the VOD's files were moved to when deleted, empty if the files were kept in place."),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
 		field.Time("created_at").Default(time.Now).Immutable(),
 	}
@@ -70,10 +73,12 @@ func (Vod) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("channel", Channel.Type).Ref("vods").Unique().Required(),
 		edge.To("queue", Queue.Type).Unique(),
-		edge.From("playlists", Playlist.Type).Ref("vods"),
+		edge.From("playlists", Playlist.Type).Ref("vods").Through("playlist_entries", PlaylistVod.Type),
 		edge.To("chapters", Chapter.Type),
 		edge.To("muted_segments", MutedSegment.Type),
 		edge.From("multistream_info", MultistreamInfo.Type).Ref("vod"),
 		edge.To("youtube_upload", YoutubeUpload.Type).Unique(),
+		edge.To("share_links", ShareLink.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.From("tags", Tag.Type).Ref("vods"),
 	}
 }
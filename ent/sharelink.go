@@ -0,0 +1,294 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/playlist"
+	"github.com/zibbp/ganymede/ent/sharelink"
+	"github.com/zibbp/ganymede/ent/user"
+	"github.com/zibbp/ganymede/ent/vod"
+)
+
+// ShareLink is the model entity for the ShareLink schema.
+type ShareLink struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// SHA-256 hash of the token, the token itself is only returned when created.
+	TokenHash string `json:"-"`
+	// First characters of the token to tell links apart.
+	TokenPrefix string `json:"token_prefix,omitempty"`
+	// Start of the part of the vod that is shared.
+	StartSeconds *int `json:"start_seconds,omitempty"`
+	// End of the part of the vod that is shared.
+	EndSeconds *int `json:"end_seconds,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// Views holds the value of the "views" field.
+	Views int `json:"views,omitempty"`
+	// LastViewedAt holds the value of the "last_viewed_at" field.
+	LastViewedAt *time.Time `json:"last_viewed_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ShareLinkQuery when eager-loading is set.
+	Edges                ShareLinkEdges `json:"edges"`
+	playlist_share_links *uuid.UUID
+	user_share_links     *uuid.UUID
+	vod_share_links      *uuid.UUID
+	selectValues         sql.SelectValues
+}
+
+// ShareLinkEdges holds the relations/edges for other nodes in the graph.
+type ShareLinkEdges struct {
+	// Vod the link shares, empty for playlist links.
+	Vod *Vod `json:"vod,omitempty"`
+	// Playlist the link shares, empty for vod links.
+	Playlist *Playlist `json:"playlist,omitempty"`
+	// CreatedBy holds the value of the created_by edge.
+	CreatedBy *User `json:"created_by,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// VodOrErr returns the Vod value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ShareLinkEdges) VodOrErr() (*Vod, error) {
+	if e.Vod != nil {
+		return e.Vod, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: vod.Label}
+	}
+	return nil, &NotLoadedError{edge: "vod"}
+}
+
+// PlaylistOrErr returns the Playlist value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ShareLinkEdges) PlaylistOrErr() (*Playlist, error) {
+	if e.Playlist != nil {
+		return e.Playlist, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: playlist.Label}
+	}
+	return nil, &NotLoadedError{edge: "playlist"}
+}
+
+// CreatedByOrErr returns the CreatedBy value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ShareLinkEdges) CreatedByOrErr() (*User, error) {
+	if e.CreatedBy != nil {
+		return e.CreatedBy, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "created_by"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ShareLink) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case sharelink.FieldStartSeconds, sharelink.FieldEndSeconds, sharelink.FieldViews:
+			values[i] = new(sql.NullInt64)
+		case sharelink.FieldTokenHash, sharelink.FieldTokenPrefix:
+			values[i] = new(sql.NullString)
+		case sharelink.FieldExpiresAt, sharelink.FieldLastViewedAt, sharelink.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case sharelink.FieldID:
+			values[i] = new(uuid.UUID)
+		case sharelink.ForeignKeys[0]: // playlist_share_links
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case sharelink.ForeignKeys[1]: // user_share_links
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case sharelink.ForeignKeys[2]: // vod_share_links
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ShareLink fields.
+func (_m *ShareLink) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case sharelink.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case sharelink.FieldTokenHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token_hash", values[i])
+			} else if value.Valid {
+				_m.TokenHash = value.String
+			}
+		case sharelink.FieldTokenPrefix:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token_prefix", values[i])
+			} else if value.Valid {
+				_m.TokenPrefix = value.String
+			}
+		case sharelink.FieldStartSeconds:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field start_seconds", values[i])
+			} else if value.Valid {
+				_m.StartSeconds = new(int)
+				*_m.StartSeconds = int(value.Int64)
+			}
+		case sharelink.FieldEndSeconds:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field end_seconds", values[i])
+			} else if value.Valid {
+				_m.EndSeconds = new(int)
+				*_m.EndSeconds = int(value.Int64)
+			}
+		case sharelink.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = new(time.Time)
+				*_m.ExpiresAt = value.Time
+			}
+		case sharelink.FieldViews:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field views", values[i])
+			} else if value.Valid {
+				_m.Views = int(value.Int64)
+			}
+		case sharelink.FieldLastViewedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_viewed_at", values[i])
+			} else if value.Valid {
+				_m.LastViewedAt = new(time.Time)
+				*_m.LastViewedAt = value.Time
+			}
+		case sharelink.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case sharelink.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field playlist_share_links", values[i])
+			} else if value.Valid {
+				_m.playlist_share_links = new(uuid.UUID)
+				*_m.playlist_share_links = *value.S.(*uuid.UUID)
+			}
+		case sharelink.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_share_links", values[i])
+			} else if value.Valid {
+				_m.user_share_links = new(uuid.UUID)
+				*_m.user_share_links = *value.S.(*uuid.UUID)
+			}
+		case sharelink.ForeignKeys[2]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field vod_share_links", values[i])
+			} else if value.Valid {
+				_m.vod_share_links = new(uuid.UUID)
+				*_m.vod_share_links = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ShareLink.
+// This includes values selected through modifiers, order, etc.
+func (_m *ShareLink) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryVod queries the "vod" edge of the ShareLink entity.
+func (_m *ShareLink) QueryVod() *VodQuery {
+	return NewShareLinkClient(_m.config).QueryVod(_m)
+}
+
+// QueryPlaylist queries the "playlist" edge of the ShareLink entity.
+func (_m *ShareLink) QueryPlaylist() *PlaylistQuery {
+	return NewShareLinkClient(_m.config).QueryPlaylist(_m)
+}
+
+// QueryCreatedBy queries the "created_by" edge of the ShareLink entity.
+func (_m *ShareLink) QueryCreatedBy() *UserQuery {
+	return NewShareLinkClient(_m.config).QueryCreatedBy(_m)
+}
+
+// Update returns a builder for updating this ShareLink.
+// Note that you need to call ShareLink.Unwrap() before calling this method if this ShareLink
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ShareLink) Update() *ShareLinkUpdateOne {
+	return NewShareLinkClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ShareLink entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ShareLink) Unwrap() *ShareLink {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ShareLink is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ShareLink) String() string {
+	var builder strings.Builder
+	builder.WriteString("ShareLink(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("token_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("token_prefix=")
+	builder.WriteString(_m.TokenPrefix)
+	builder.WriteString(", ")
+	if v := _m.StartSeconds; v != nil {
+		builder.WriteString("start_seconds=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.EndSeconds; v != nil {
+		builder.WriteString("end_seconds=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("views=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Views))
+	builder.WriteString(", ")
+	if v := _m.LastViewedAt; v != nil {
+		builder.WriteString("last_viewed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ShareLinks is a parsable slice of ShareLink.
+type ShareLinks []*ShareLink
@@ -0,0 +1,198 @@
+// Code generated by ent, DO NOT EDIT.
+
+package sharelink
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the sharelink type in the database.
+	Label = "share_link"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTokenHash holds the string denoting the token_hash field in the database.
+	FieldTokenHash = "token_hash"
+	// FieldTokenPrefix holds the string denoting the token_prefix field in the database.
+	FieldTokenPrefix = "token_prefix"
+	// FieldStartSeconds holds the string denoting the start_seconds field in the database.
+	FieldStartSeconds = "start_seconds"
+	// FieldEndSeconds holds the string denoting the end_seconds field in the database.
+	FieldEndSeconds = "end_seconds"
+	// FieldExpiresAt holds the string denoting the expires_at field in the database.
+	FieldExpiresAt = "expires_at"
+	// FieldViews holds the string denoting the views field in the database.
+	FieldViews = "views"
+	// FieldLastViewedAt holds the string denoting the last_viewed_at field in the database.
+	FieldLastViewedAt = "last_viewed_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeVod holds the string denoting the vod edge name in mutations.
+	EdgeVod = "vod"
+	// EdgePlaylist holds the string denoting the playlist edge name in mutations.
+	EdgePlaylist = "playlist"
+	// EdgeCreatedBy holds the string denoting the created_by edge name in mutations.
+	EdgeCreatedBy = "created_by"
+	// Table holds the table name of the sharelink in the database.
+	Table = "share_links"
+	// VodTable is the table that holds the vod relation/edge.
+	VodTable = "share_links"
+	// VodInverseTable is the table name for the Vod entity.
+	// It exists in this package in order to avoid circular dependency with the "vod" package.
+	VodInverseTable = "vods"
+	// VodColumn is the table column denoting the vod relation/edge.
+	VodColumn = "vod_share_links"
+	// PlaylistTable is the table that holds the playlist relation/edge.
+	PlaylistTable = "share_links"
+	// PlaylistInverseTable is the table name for the Playlist entity.
+	// It exists in this package in order to avoid circular dependency with the "playlist" package.
+	PlaylistInverseTable = "playlists"
+	// PlaylistColumn is the table column denoting the playlist relation/edge.
+	PlaylistColumn = "playlist_share_links"
+	// CreatedByTable is the table that holds the created_by relation/edge.
+	CreatedByTable = "share_links"
+	// CreatedByInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	CreatedByInverseTable = "users"
+	// CreatedByColumn is the table column denoting the created_by relation/edge.
+	CreatedByColumn = "user_share_links"
+)
+
+// Columns holds all SQL columns for sharelink fields.
+var Columns = []string{
+	FieldID,
+	FieldTokenHash,
+	FieldTokenPrefix,
+	FieldStartSeconds,
+	FieldEndSeconds,
+	FieldExpiresAt,
+	FieldViews,
+	FieldLastViewedAt,
+	FieldCreatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "share_links"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"playlist_share_links",
+	"user_share_links",
+	"vod_share_links",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultViews holds the default value on creation for the "views" field.
+	DefaultViews int
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the ShareLink queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTokenHash orders the results by the token_hash field.
+func ByTokenHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTokenHash, opts...).ToFunc()
+}
+
+// ByTokenPrefix orders the results by the token_prefix field.
+func ByTokenPrefix(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTokenPrefix, opts...).ToFunc()
+}
+
+// ByStartSeconds orders the results by the start_seconds field.
+func ByStartSeconds(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartSeconds, opts...).ToFunc()
+}
+
+// ByEndSeconds orders the results by the end_seconds field.
+func ByEndSeconds(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndSeconds, opts...).ToFunc()
+}
+
+// ByExpiresAt orders the results by the expires_at field.
+func ByExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpiresAt, opts...).ToFunc()
+}
+
+// ByViews orders the results by the views field.
+func ByViews(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldViews, opts...).ToFunc()
+}
+
+// ByLastViewedAt orders the results by the last_viewed_at field.
+func ByLastViewedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastViewedAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByVodField orders the results by vod field.
+func ByVodField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newVodStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByPlaylistField orders the results by playlist field.
+func ByPlaylistField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPlaylistStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByCreatedByField orders the results by created_by field.
+func ByCreatedByField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCreatedByStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newVodStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(VodInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, VodTable, VodColumn),
+	)
+}
+func newPlaylistStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PlaylistInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, PlaylistTable, PlaylistColumn),
+	)
+}
+func newCreatedByStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CreatedByInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, CreatedByTable, CreatedByColumn),
+	)
+}
@@ -0,0 +1,591 @@
+// Code generated by ent, DO NOT EDIT.
+
+package sharelink
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldLTE(FieldID, id))
+}
+
+// TokenHash applies equality check predicate on the "token_hash" field. It's identical to TokenHashEQ.
+func TokenHash(v string) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldEQ(FieldTokenHash, v))
+}
+
+// TokenPrefix applies equality check predicate on the "token_prefix" field. It's identical to TokenPrefixEQ.
+func TokenPrefix(v string) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldEQ(FieldTokenPrefix, v))
+}
+
+// StartSeconds applies equality check predicate on the "start_seconds" field. It's identical to StartSecondsEQ.
+func StartSeconds(v int) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldEQ(FieldStartSeconds, v))
+}
+
+// EndSeconds applies equality check predicate on the "end_seconds" field. It's identical to EndSecondsEQ.
+func EndSeconds(v int) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldEQ(FieldEndSeconds, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// Views applies equality check predicate on the "views" field. It's identical to ViewsEQ.
+func Views(v int) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldEQ(FieldViews, v))
+}
+
+// LastViewedAt applies equality check predicate on the "last_viewed_at" field. It's identical to LastViewedAtEQ.
+func LastViewedAt(v time.Time) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldEQ(FieldLastViewedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// TokenHashEQ applies the EQ predicate on the "token_hash" field.
+func TokenHashEQ(v string) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldEQ(FieldTokenHash, v))
+}
+
+// TokenHashNEQ applies the NEQ predicate on the "token_hash" field.
+func TokenHashNEQ(v string) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldNEQ(FieldTokenHash, v))
+}
+
+// TokenHashIn applies the In predicate on the "token_hash" field.
+func TokenHashIn(vs ...string) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldIn(FieldTokenHash, vs...))
+}
+
+// TokenHashNotIn applies the NotIn predicate on the "token_hash" field.
+func TokenHashNotIn(vs ...string) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldNotIn(FieldTokenHash, vs...))
+}
+
+// TokenHashGT applies the GT predicate on the "token_hash" field.
+func TokenHashGT(v string) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldGT(FieldTokenHash, v))
+}
+
+// TokenHashGTE applies the GTE predicate on the "token_hash" field.
+func TokenHashGTE(v string) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldGTE(FieldTokenHash, v))
+}
+
+// TokenHashLT applies the LT predicate on the "token_hash" field.
+func TokenHashLT(v string) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldLT(FieldTokenHash, v))
+}
+
+// TokenHashLTE applies the LTE predicate on the "token_hash" field.
+func TokenHashLTE(v string) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldLTE(FieldTokenHash, v))
+}
+
+// TokenHashContains applies the Contains predicate on the "token_hash" field.
+func TokenHashContains(v string) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldContains(FieldTokenHash, v))
+}
+
+// TokenHashHasPrefix applies the HasPrefix predicate on the "token_hash" field.
+func TokenHashHasPrefix(v string) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldHasPrefix(FieldTokenHash, v))
+}
+
+// TokenHashHasSuffix applies the HasSuffix predicate on the "token_hash" field.
+func TokenHashHasSuffix(v string) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldHasSuffix(FieldTokenHash, v))
+}
+
+// TokenHashEqualFold applies the EqualFold predicate on the "token_hash" field.
+func TokenHashEqualFold(v string) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldEqualFold(FieldTokenHash, v))
+}
+
+// TokenHashContainsFold applies the ContainsFold predicate on the "token_hash" field.
+func TokenHashContainsFold(v string) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldContainsFold(FieldTokenHash, v))
+}
+
+// TokenPrefixEQ applies the EQ predicate on the "token_prefix" field.
+func TokenPrefixEQ(v string) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldEQ(FieldTokenPrefix, v))
+}
+
+// TokenPrefixNEQ applies the NEQ predicate on the "token_prefix" field.
+func TokenPrefixNEQ(v string) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldNEQ(FieldTokenPrefix, v))
+}
+
+// TokenPrefixIn applies the In predicate on the "token_prefix" field.
+func TokenPrefixIn(vs ...string) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldIn(FieldTokenPrefix, vs...))
+}
+
+// TokenPrefixNotIn applies the NotIn predicate on the "token_prefix" field.
+func TokenPrefixNotIn(vs ...string) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldNotIn(FieldTokenPrefix, vs...))
+}
+
+// TokenPrefixGT applies the GT predicate on the "token_prefix" field.
+func TokenPrefixGT(v string) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldGT(FieldTokenPrefix, v))
+}
+
+// TokenPrefixGTE applies the GTE predicate on the "token_prefix" field.
+func TokenPrefixGTE(v string) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldGTE(FieldTokenPrefix, v))
+}
+
+// TokenPrefixLT applies the LT predicate on the "token_prefix" field.
+func TokenPrefixLT(v string) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldLT(FieldTokenPrefix, v))
+}
+
+// TokenPrefixLTE applies the LTE predicate on the "token_prefix" field.
+func TokenPrefixLTE(v string) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldLTE(FieldTokenPrefix, v))
+}
+
+// TokenPrefixContains applies the Contains predicate on the "token_prefix" field.
+func TokenPrefixContains(v string) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldContains(FieldTokenPrefix, v))
+}
+
+// TokenPrefixHasPrefix applies the HasPrefix predicate on the "token_prefix" field.
+func TokenPrefixHasPrefix(v string) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldHasPrefix(FieldTokenPrefix, v))
+}
+
+// TokenPrefixHasSuffix applies the HasSuffix predicate on the "token_prefix" field.
+func TokenPrefixHasSuffix(v string) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldHasSuffix(FieldTokenPrefix, v))
+}
+
+// TokenPrefixEqualFold applies the EqualFold predicate on the "token_prefix" field.
+func TokenPrefixEqualFold(v string) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldEqualFold(FieldTokenPrefix, v))
+}
+
+// TokenPrefixContainsFold applies the ContainsFold predicate on the "token_prefix" field.
+func TokenPrefixContainsFold(v string) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldContainsFold(FieldTokenPrefix, v))
+}
+
+// StartSecondsEQ applies the EQ predicate on the "start_seconds" field.
+func StartSecondsEQ(v int) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldEQ(FieldStartSeconds, v))
+}
+
+// StartSecondsNEQ applies the NEQ predicate on the "start_seconds" field.
+func StartSecondsNEQ(v int) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldNEQ(FieldStartSeconds, v))
+}
+
+// StartSecondsIn applies the In predicate on the "start_seconds" field.
+func StartSecondsIn(vs ...int) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldIn(FieldStartSeconds, vs...))
+}
+
+// StartSecondsNotIn applies the NotIn predicate on the "start_seconds" field.
+func StartSecondsNotIn(vs ...int) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldNotIn(FieldStartSeconds, vs...))
+}
+
+// StartSecondsGT applies the GT predicate on the "start_seconds" field.
+func StartSecondsGT(v int) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldGT(FieldStartSeconds, v))
+}
+
+// StartSecondsGTE applies the GTE predicate on the "start_seconds" field.
+func StartSecondsGTE(v int) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldGTE(FieldStartSeconds, v))
+}
+
+// StartSecondsLT applies the LT predicate on the "start_seconds" field.
+func StartSecondsLT(v int) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldLT(FieldStartSeconds, v))
+}
+
+// StartSecondsLTE applies the LTE predicate on the "start_seconds" field.
+func StartSecondsLTE(v int) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldLTE(FieldStartSeconds, v))
+}
+
+// StartSecondsIsNil applies the IsNil predicate on the "start_seconds" field.
+func StartSecondsIsNil() predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldIsNull(FieldStartSeconds))
+}
+
+// StartSecondsNotNil applies the NotNil predicate on the "start_seconds" field.
+func StartSecondsNotNil() predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldNotNull(FieldStartSeconds))
+}
+
+// EndSecondsEQ applies the EQ predicate on the "end_seconds" field.
+func EndSecondsEQ(v int) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldEQ(FieldEndSeconds, v))
+}
+
+// EndSecondsNEQ applies the NEQ predicate on the "end_seconds" field.
+func EndSecondsNEQ(v int) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldNEQ(FieldEndSeconds, v))
+}
+
+// EndSecondsIn applies the In predicate on the "end_seconds" field.
+func EndSecondsIn(vs ...int) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldIn(FieldEndSeconds, vs...))
+}
+
+// EndSecondsNotIn applies the NotIn predicate on the "end_seconds" field.
+func EndSecondsNotIn(vs ...int) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldNotIn(FieldEndSeconds, vs...))
+}
+
+// EndSecondsGT applies the GT predicate on the "end_seconds" field.
+func EndSecondsGT(v int) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldGT(FieldEndSeconds, v))
+}
+
+// EndSecondsGTE applies the GTE predicate on the "end_seconds" field.
+func EndSecondsGTE(v int) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldGTE(FieldEndSeconds, v))
+}
+
+// EndSecondsLT applies the LT predicate on the "end_seconds" field.
+func EndSecondsLT(v int) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldLT(FieldEndSeconds, v))
+}
+
+// EndSecondsLTE applies the LTE predicate on the "end_seconds" field.
+func EndSecondsLTE(v int) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldLTE(FieldEndSeconds, v))
+}
+
+// EndSecondsIsNil applies the IsNil predicate on the "end_seconds" field.
+func EndSecondsIsNil() predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldIsNull(FieldEndSeconds))
+}
+
+// EndSecondsNotNil applies the NotNil predicate on the "end_seconds" field.
+func EndSecondsNotNil() predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldNotNull(FieldEndSeconds))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtIsNil applies the IsNil predicate on the "expires_at" field.
+func ExpiresAtIsNil() predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldIsNull(FieldExpiresAt))
+}
+
+// ExpiresAtNotNil applies the NotNil predicate on the "expires_at" field.
+func ExpiresAtNotNil() predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldNotNull(FieldExpiresAt))
+}
+
+// ViewsEQ applies the EQ predicate on the "views" field.
+func ViewsEQ(v int) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldEQ(FieldViews, v))
+}
+
+// ViewsNEQ applies the NEQ predicate on the "views" field.
+func ViewsNEQ(v int) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldNEQ(FieldViews, v))
+}
+
+// ViewsIn applies the In predicate on the "views" field.
+func ViewsIn(vs ...int) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldIn(FieldViews, vs...))
+}
+
+// ViewsNotIn applies the NotIn predicate on the "views" field.
+func ViewsNotIn(vs ...int) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldNotIn(FieldViews, vs...))
+}
+
+// ViewsGT applies the GT predicate on the "views" field.
+func ViewsGT(v int) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldGT(FieldViews, v))
+}
+
+// ViewsGTE applies the GTE predicate on the "views" field.
+func ViewsGTE(v int) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldGTE(FieldViews, v))
+}
+
+// ViewsLT applies the LT predicate on the "views" field.
+func ViewsLT(v int) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldLT(FieldViews, v))
+}
+
+// ViewsLTE applies the LTE predicate on the "views" field.
+func ViewsLTE(v int) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldLTE(FieldViews, v))
+}
+
+// LastViewedAtEQ applies the EQ predicate on the "last_viewed_at" field.
+func LastViewedAtEQ(v time.Time) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldEQ(FieldLastViewedAt, v))
+}
+
+// LastViewedAtNEQ applies the NEQ predicate on the "last_viewed_at" field.
+func LastViewedAtNEQ(v time.Time) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldNEQ(FieldLastViewedAt, v))
+}
+
+// LastViewedAtIn applies the In predicate on the "last_viewed_at" field.
+func LastViewedAtIn(vs ...time.Time) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldIn(FieldLastViewedAt, vs...))
+}
+
+// LastViewedAtNotIn applies the NotIn predicate on the "last_viewed_at" field.
+func LastViewedAtNotIn(vs ...time.Time) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldNotIn(FieldLastViewedAt, vs...))
+}
+
+// LastViewedAtGT applies the GT predicate on the "last_viewed_at" field.
+func LastViewedAtGT(v time.Time) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldGT(FieldLastViewedAt, v))
+}
+
+// LastViewedAtGTE applies the GTE predicate on the "last_viewed_at" field.
+func LastViewedAtGTE(v time.Time) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldGTE(FieldLastViewedAt, v))
+}
+
+// LastViewedAtLT applies the LT predicate on the "last_viewed_at" field.
+func LastViewedAtLT(v time.Time) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldLT(FieldLastViewedAt, v))
+}
+
+// LastViewedAtLTE applies the LTE predicate on the "last_viewed_at" field.
+func LastViewedAtLTE(v time.Time) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldLTE(FieldLastViewedAt, v))
+}
+
+// LastViewedAtIsNil applies the IsNil predicate on the "last_viewed_at" field.
+func LastViewedAtIsNil() predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldIsNull(FieldLastViewedAt))
+}
+
+// LastViewedAtNotNil applies the NotNil predicate on the "last_viewed_at" field.
+func LastViewedAtNotNil() predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldNotNull(FieldLastViewedAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ShareLink {
+	return predicate.ShareLink(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasVod applies the HasEdge predicate on the "vod" edge.
+func HasVod() predicate.ShareLink {
+	return predicate.ShareLink(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, VodTable, VodColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasVodWith applies the HasEdge predicate on the "vod" edge with a given conditions (other predicates).
+func HasVodWith(preds ...predicate.Vod) predicate.ShareLink {
+	return predicate.ShareLink(func(s *sql.Selector) {
+		step := newVodStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPlaylist applies the HasEdge predicate on the "playlist" edge.
+func HasPlaylist() predicate.ShareLink {
+	return predicate.ShareLink(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PlaylistTable, PlaylistColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPlaylistWith applies the HasEdge predicate on the "playlist" edge with a given conditions (other predicates).
+func HasPlaylistWith(preds ...predicate.Playlist) predicate.ShareLink {
+	return predicate.ShareLink(func(s *sql.Selector) {
+		step := newPlaylistStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCreatedBy applies the HasEdge predicate on the "created_by" edge.
+func HasCreatedBy() predicate.ShareLink {
+	return predicate.ShareLink(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CreatedByTable, CreatedByColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCreatedByWith applies the HasEdge predicate on the "created_by" edge with a given conditions (other predicates).
+func HasCreatedByWith(preds ...predicate.User) predicate.ShareLink {
+	return predicate.ShareLink(func(s *sql.Selector) {
+		step := newCreatedByStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ShareLink) predicate.ShareLink {
+	return predicate.ShareLink(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ShareLink) predicate.ShareLink {
+	return predicate.ShareLink(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ShareLink) predicate.ShareLink {
+	return predicate.ShareLink(sql.NotPredicates(p))
+}
@@ -0,0 +1,460 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/playlist"
+	"github.com/zibbp/ganymede/ent/sharelink"
+	"github.com/zibbp/ganymede/ent/user"
+	"github.com/zibbp/ganymede/ent/vod"
+)
+
+// ShareLinkCreate is the builder for creating a ShareLink entity.
+type ShareLinkCreate struct {
+	config
+	mutation *ShareLinkMutation
+	hooks    []Hook
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (_c *ShareLinkCreate) SetTokenHash(v string) *ShareLinkCreate {
+	_c.mutation.SetTokenHash(v)
+	return _c
+}
+
+// SetTokenPrefix sets the "token_prefix" field.
+func (_c *ShareLinkCreate) SetTokenPrefix(v string) *ShareLinkCreate {
+	_c.mutation.SetTokenPrefix(v)
+	return _c
+}
+
+// SetStartSeconds sets the "start_seconds" field.
+func (_c *ShareLinkCreate) SetStartSeconds(v int) *ShareLinkCreate {
+	_c.mutation.SetStartSeconds(v)
+	return _c
+}
+
+// SetNillableStartSeconds sets the "start_seconds" field if the given value is not nil.
+func (_c *ShareLinkCreate) SetNillableStartSeconds(v *int) *ShareLinkCreate {
+	if v != nil {
+		_c.SetStartSeconds(*v)
+	}
+	return _c
+}
+
+// SetEndSeconds sets the "end_seconds" field.
+func (_c *ShareLinkCreate) SetEndSeconds(v int) *ShareLinkCreate {
+	_c.mutation.SetEndSeconds(v)
+	return _c
+}
+
+// SetNillableEndSeconds sets the "end_seconds" field if the given value is not nil.
+func (_c *ShareLinkCreate) SetNillableEndSeconds(v *int) *ShareLinkCreate {
+	if v != nil {
+		_c.SetEndSeconds(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *ShareLinkCreate) SetExpiresAt(v time.Time) *ShareLinkCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_c *ShareLinkCreate) SetNillableExpiresAt(v *time.Time) *ShareLinkCreate {
+	if v != nil {
+		_c.SetExpiresAt(*v)
+	}
+	return _c
+}
+
+// SetViews sets the "views" field.
+func (_c *ShareLinkCreate) SetViews(v int) *ShareLinkCreate {
+	_c.mutation.SetViews(v)
+	return _c
+}
+
+// SetNillableViews sets the "views" field if the given value is not nil.
+func (_c *ShareLinkCreate) SetNillableViews(v *int) *ShareLinkCreate {
+	if v != nil {
+		_c.SetViews(*v)
+	}
+	return _c
+}
+
+// SetLastViewedAt sets the "last_viewed_at" field.
+func (_c *ShareLinkCreate) SetLastViewedAt(v time.Time) *ShareLinkCreate {
+	_c.mutation.SetLastViewedAt(v)
+	return _c
+}
+
+// SetNillableLastViewedAt sets the "last_viewed_at" field if the given value is not nil.
+func (_c *ShareLinkCreate) SetNillableLastViewedAt(v *time.Time) *ShareLinkCreate {
+	if v != nil {
+		_c.SetLastViewedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ShareLinkCreate) SetCreatedAt(v time.Time) *ShareLinkCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ShareLinkCreate) SetNillableCreatedAt(v *time.Time) *ShareLinkCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ShareLinkCreate) SetID(v uuid.UUID) *ShareLinkCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ShareLinkCreate) SetNillableID(v *uuid.UUID) *ShareLinkCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetVodID sets the "vod" edge to the Vod entity by ID.
+func (_c *ShareLinkCreate) SetVodID(id uuid.UUID) *ShareLinkCreate {
+	_c.mutation.SetVodID(id)
+	return _c
+}
+
+// SetNillableVodID sets the "vod" edge to the Vod entity by ID if the given value is not nil.
+func (_c *ShareLinkCreate) SetNillableVodID(id *uuid.UUID) *ShareLinkCreate {
+	if id != nil {
+		_c = _c.SetVodID(*id)
+	}
+	return _c
+}
+
+// SetVod sets the "vod" edge to the Vod entity.
+func (_c *ShareLinkCreate) SetVod(v *Vod) *ShareLinkCreate {
+	return _c.SetVodID(v.ID)
+}
+
+// SetPlaylistID sets the "playlist" edge to the Playlist entity by ID.
+func (_c *ShareLinkCreate) SetPlaylistID(id uuid.UUID) *ShareLinkCreate {
+	_c.mutation.SetPlaylistID(id)
+	return _c
+}
+
+// SetNillablePlaylistID sets the "playlist" edge to the Playlist entity by ID if the given value is not nil.
+func (_c *ShareLinkCreate) SetNillablePlaylistID(id *uuid.UUID) *ShareLinkCreate {
+	if id != nil {
+		_c = _c.SetPlaylistID(*id)
+	}
+	return _c
+}
+
+// SetPlaylist sets the "playlist" edge to the Playlist entity.
+func (_c *ShareLinkCreate) SetPlaylist(v *Playlist) *ShareLinkCreate {
+	return _c.SetPlaylistID(v.ID)
+}
+
+// SetCreatedByID sets the "created_by" edge to the User entity by ID.
+func (_c *ShareLinkCreate) SetCreatedByID(id uuid.UUID) *ShareLinkCreate {
+	_c.mutation.SetCreatedByID(id)
+	return _c
+}
+
+// SetNillableCreatedByID sets the "created_by" edge to the User entity by ID if the given value is not nil.
+func (_c *ShareLinkCreate) SetNillableCreatedByID(id *uuid.UUID) *ShareLinkCreate {
+	if id != nil {
+		_c = _c.SetCreatedByID(*id)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" edge to the User entity.
+func (_c *ShareLinkCreate) SetCreatedBy(v *User) *ShareLinkCreate {
+	return _c.SetCreatedByID(v.ID)
+}
+
+// Mutation returns the ShareLinkMutation object of the builder.
+func (_c *ShareLinkCreate) Mutation() *ShareLinkMutation {
+	return _c.mutation
+}
+
+// Save creates the ShareLink in the database.
+func (_c *ShareLinkCreate) Save(ctx context.Context) (*ShareLink, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ShareLinkCreate) SaveX(ctx context.Context) *ShareLink {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ShareLinkCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ShareLinkCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ShareLinkCreate) defaults() {
+	if _, ok := _c.mutation.Views(); !ok {
+		v := sharelink.DefaultViews
+		_c.mutation.SetViews(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := sharelink.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := sharelink.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ShareLinkCreate) check() error {
+	if _, ok := _c.mutation.TokenHash(); !ok {
+		return &ValidationError{Name: "token_hash", err: errors.New(`ent: missing required field "ShareLink.token_hash"`)}
+	}
+	if _, ok := _c.mutation.TokenPrefix(); !ok {
+		return &ValidationError{Name: "token_prefix", err: errors.New(`ent: missing required field "ShareLink.token_prefix"`)}
+	}
+	if _, ok := _c.mutation.Views(); !ok {
+		return &ValidationError{Name: "views", err: errors.New(`ent: missing required field "ShareLink.views"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ShareLink.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *ShareLinkCreate) sqlSave(ctx context.Context) (*ShareLink, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ShareLinkCreate) createSpec() (*ShareLink, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ShareLink{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(sharelink.Table, sqlgraph.NewFieldSpec(sharelink.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.TokenHash(); ok {
+		_spec.SetField(sharelink.FieldTokenHash, field.TypeString, value)
+		_node.TokenHash = value
+	}
+	if value, ok := _c.mutation.TokenPrefix(); ok {
+		_spec.SetField(sharelink.FieldTokenPrefix, field.TypeString, value)
+		_node.TokenPrefix = value
+	}
+	if value, ok := _c.mutation.StartSeconds(); ok {
+		_spec.SetField(sharelink.FieldStartSeconds, field.TypeInt, value)
+		_node.StartSeconds = &value
+	}
+	if value, ok := _c.mutation.EndSeconds(); ok {
+		_spec.SetField(sharelink.FieldEndSeconds, field.TypeInt, value)
+		_node.EndSeconds = &value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(sharelink.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := _c.mutation.Views(); ok {
+		_spec.SetField(sharelink.FieldViews, field.TypeInt, value)
+		_node.Views = value
+	}
+	if value, ok := _c.mutation.LastViewedAt(); ok {
+		_spec.SetField(sharelink.FieldLastViewedAt, field.TypeTime, value)
+		_node.LastViewedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(sharelink.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.VodIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sharelink.VodTable,
+			Columns: []string{sharelink.VodColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vod.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.vod_share_links = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PlaylistIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sharelink.PlaylistTable,
+			Columns: []string{sharelink.PlaylistColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlist.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.playlist_share_links = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.CreatedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sharelink.CreatedByTable,
+			Columns: []string{sharelink.CreatedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_share_links = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ShareLinkCreateBulk is the builder for creating many ShareLink entities in bulk.
+type ShareLinkCreateBulk struct {
+	config
+	err      error
+	builders []*ShareLinkCreate
+}
+
+// Save creates the ShareLink entities in the database.
+func (_c *ShareLinkCreateBulk) Save(ctx context.Context) ([]*ShareLink, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ShareLink, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ShareLinkMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ShareLinkCreateBulk) SaveX(ctx context.Context) []*ShareLink {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ShareLinkCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ShareLinkCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,874 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/playlist"
+	"github.com/zibbp/ganymede/ent/predicate"
+	"github.com/zibbp/ganymede/ent/sharelink"
+	"github.com/zibbp/ganymede/ent/user"
+	"github.com/zibbp/ganymede/ent/vod"
+)
+
+// ShareLinkUpdate is the builder for updating ShareLink entities.
+type ShareLinkUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ShareLinkMutation
+}
+
+// Where appends a list predicates to the ShareLinkUpdate builder.
+func (_u *ShareLinkUpdate) Where(ps ...predicate.ShareLink) *ShareLinkUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (_u *ShareLinkUpdate) SetTokenHash(v string) *ShareLinkUpdate {
+	_u.mutation.SetTokenHash(v)
+	return _u
+}
+
+// SetNillableTokenHash sets the "token_hash" field if the given value is not nil.
+func (_u *ShareLinkUpdate) SetNillableTokenHash(v *string) *ShareLinkUpdate {
+	if v != nil {
+		_u.SetTokenHash(*v)
+	}
+	return _u
+}
+
+// SetTokenPrefix sets the "token_prefix" field.
+func (_u *ShareLinkUpdate) SetTokenPrefix(v string) *ShareLinkUpdate {
+	_u.mutation.SetTokenPrefix(v)
+	return _u
+}
+
+// SetNillableTokenPrefix sets the "token_prefix" field if the given value is not nil.
+func (_u *ShareLinkUpdate) SetNillableTokenPrefix(v *string) *ShareLinkUpdate {
+	if v != nil {
+		_u.SetTokenPrefix(*v)
+	}
+	return _u
+}
+
+// SetStartSeconds sets the "start_seconds" field.
+func (_u *ShareLinkUpdate) SetStartSeconds(v int) *ShareLinkUpdate {
+	_u.mutation.ResetStartSeconds()
+	_u.mutation.SetStartSeconds(v)
+	return _u
+}
+
+// SetNillableStartSeconds sets the "start_seconds" field if the given value is not nil.
+func (_u *ShareLinkUpdate) SetNillableStartSeconds(v *int) *ShareLinkUpdate {
+	if v != nil {
+		_u.SetStartSeconds(*v)
+	}
+	return _u
+}
+
+// AddStartSeconds adds value to the "start_seconds" field.
+func (_u *ShareLinkUpdate) AddStartSeconds(v int) *ShareLinkUpdate {
+	_u.mutation.AddStartSeconds(v)
+	return _u
+}
+
+// ClearStartSeconds clears the value of the "start_seconds" field.
+func (_u *ShareLinkUpdate) ClearStartSeconds() *ShareLinkUpdate {
+	_u.mutation.ClearStartSeconds()
+	return _u
+}
+
+// SetEndSeconds sets the "end_seconds" field.
+func (_u *ShareLinkUpdate) SetEndSeconds(v int) *ShareLinkUpdate {
+	_u.mutation.ResetEndSeconds()
+	_u.mutation.SetEndSeconds(v)
+	return _u
+}
+
+// SetNillableEndSeconds sets the "end_seconds" field if the given value is not nil.
+func (_u *ShareLinkUpdate) SetNillableEndSeconds(v *int) *ShareLinkUpdate {
+	if v != nil {
+		_u.SetEndSeconds(*v)
+	}
+	return _u
+}
+
+// AddEndSeconds adds value to the "end_seconds" field.
+func (_u *ShareLinkUpdate) AddEndSeconds(v int) *ShareLinkUpdate {
+	_u.mutation.AddEndSeconds(v)
+	return _u
+}
+
+// ClearEndSeconds clears the value of the "end_seconds" field.
+func (_u *ShareLinkUpdate) ClearEndSeconds() *ShareLinkUpdate {
+	_u.mutation.ClearEndSeconds()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *ShareLinkUpdate) SetExpiresAt(v time.Time) *ShareLinkUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *ShareLinkUpdate) SetNillableExpiresAt(v *time.Time) *ShareLinkUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *ShareLinkUpdate) ClearExpiresAt() *ShareLinkUpdate {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// SetViews sets the "views" field.
+func (_u *ShareLinkUpdate) SetViews(v int) *ShareLinkUpdate {
+	_u.mutation.ResetViews()
+	_u.mutation.SetViews(v)
+	return _u
+}
+
+// SetNillableViews sets the "views" field if the given value is not nil.
+func (_u *ShareLinkUpdate) SetNillableViews(v *int) *ShareLinkUpdate {
+	if v != nil {
+		_u.SetViews(*v)
+	}
+	return _u
+}
+
+// AddViews adds value to the "views" field.
+func (_u *ShareLinkUpdate) AddViews(v int) *ShareLinkUpdate {
+	_u.mutation.AddViews(v)
+	return _u
+}
+
+// SetLastViewedAt sets the "last_viewed_at" field.
+func (_u *ShareLinkUpdate) SetLastViewedAt(v time.Time) *ShareLinkUpdate {
+	_u.mutation.SetLastViewedAt(v)
+	return _u
+}
+
+// SetNillableLastViewedAt sets the "last_viewed_at" field if the given value is not nil.
+func (_u *ShareLinkUpdate) SetNillableLastViewedAt(v *time.Time) *ShareLinkUpdate {
+	if v != nil {
+		_u.SetLastViewedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastViewedAt clears the value of the "last_viewed_at" field.
+func (_u *ShareLinkUpdate) ClearLastViewedAt() *ShareLinkUpdate {
+	_u.mutation.ClearLastViewedAt()
+	return _u
+}
+
+// SetVodID sets the "vod" edge to the Vod entity by ID.
+func (_u *ShareLinkUpdate) SetVodID(id uuid.UUID) *ShareLinkUpdate {
+	_u.mutation.SetVodID(id)
+	return _u
+}
+
+// SetNillableVodID sets the "vod" edge to the Vod entity by ID if the given value is not nil.
+func (_u *ShareLinkUpdate) SetNillableVodID(id *uuid.UUID) *ShareLinkUpdate {
+	if id != nil {
+		_u = _u.SetVodID(*id)
+	}
+	return _u
+}
+
+// SetVod sets the "vod" edge to the Vod entity.
+func (_u *ShareLinkUpdate) SetVod(v *Vod) *ShareLinkUpdate {
+	return _u.SetVodID(v.ID)
+}
+
+// SetPlaylistID sets the "playlist" edge to the Playlist entity by ID.
+func (_u *ShareLinkUpdate) SetPlaylistID(id uuid.UUID) *ShareLinkUpdate {
+	_u.mutation.SetPlaylistID(id)
+	return _u
+}
+
+// SetNillablePlaylistID sets the "playlist" edge to the Playlist entity by ID if the given value is not nil.
+func (_u *ShareLinkUpdate) SetNillablePlaylistID(id *uuid.UUID) *ShareLinkUpdate {
+	if id != nil {
+		_u = _u.SetPlaylistID(*id)
+	}
+	return _u
+}
+
+// SetPlaylist sets the "playlist" edge to the Playlist entity.
+func (_u *ShareLinkUpdate) SetPlaylist(v *Playlist) *ShareLinkUpdate {
+	return _u.SetPlaylistID(v.ID)
+}
+
+// SetCreatedByID sets the "created_by" edge to the User entity by ID.
+func (_u *ShareLinkUpdate) SetCreatedByID(id uuid.UUID) *ShareLinkUpdate {
+	_u.mutation.SetCreatedByID(id)
+	return _u
+}
+
+// SetNillableCreatedByID sets the "created_by" edge to the User entity by ID if the given value is not nil.
+func (_u *ShareLinkUpdate) SetNillableCreatedByID(id *uuid.UUID) *ShareLinkUpdate {
+	if id != nil {
+		_u = _u.SetCreatedByID(*id)
+	}
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" edge to the User entity.
+func (_u *ShareLinkUpdate) SetCreatedBy(v *User) *ShareLinkUpdate {
+	return _u.SetCreatedByID(v.ID)
+}
+
+// Mutation returns the ShareLinkMutation object of the builder.
+func (_u *ShareLinkUpdate) Mutation() *ShareLinkMutation {
+	return _u.mutation
+}
+
+// ClearVod clears the "vod" edge to the Vod entity.
+func (_u *ShareLinkUpdate) ClearVod() *ShareLinkUpdate {
+	_u.mutation.ClearVod()
+	return _u
+}
+
+// ClearPlaylist clears the "playlist" edge to the Playlist entity.
+func (_u *ShareLinkUpdate) ClearPlaylist() *ShareLinkUpdate {
+	_u.mutation.ClearPlaylist()
+	return _u
+}
+
+// ClearCreatedBy clears the "created_by" edge to the User entity.
+func (_u *ShareLinkUpdate) ClearCreatedBy() *ShareLinkUpdate {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ShareLinkUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ShareLinkUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ShareLinkUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ShareLinkUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *ShareLinkUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(sharelink.Table, sharelink.Columns, sqlgraph.NewFieldSpec(sharelink.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TokenHash(); ok {
+		_spec.SetField(sharelink.FieldTokenHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TokenPrefix(); ok {
+		_spec.SetField(sharelink.FieldTokenPrefix, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.StartSeconds(); ok {
+		_spec.SetField(sharelink.FieldStartSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedStartSeconds(); ok {
+		_spec.AddField(sharelink.FieldStartSeconds, field.TypeInt, value)
+	}
+	if _u.mutation.StartSecondsCleared() {
+		_spec.ClearField(sharelink.FieldStartSeconds, field.TypeInt)
+	}
+	if value, ok := _u.mutation.EndSeconds(); ok {
+		_spec.SetField(sharelink.FieldEndSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEndSeconds(); ok {
+		_spec.AddField(sharelink.FieldEndSeconds, field.TypeInt, value)
+	}
+	if _u.mutation.EndSecondsCleared() {
+		_spec.ClearField(sharelink.FieldEndSeconds, field.TypeInt)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(sharelink.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(sharelink.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Views(); ok {
+		_spec.SetField(sharelink.FieldViews, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedViews(); ok {
+		_spec.AddField(sharelink.FieldViews, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastViewedAt(); ok {
+		_spec.SetField(sharelink.FieldLastViewedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastViewedAtCleared() {
+		_spec.ClearField(sharelink.FieldLastViewedAt, field.TypeTime)
+	}
+	if _u.mutation.VodCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sharelink.VodTable,
+			Columns: []string{sharelink.VodColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vod.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.VodIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sharelink.VodTable,
+			Columns: []string{sharelink.VodColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vod.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PlaylistCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sharelink.PlaylistTable,
+			Columns: []string{sharelink.PlaylistColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlist.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PlaylistIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sharelink.PlaylistTable,
+			Columns: []string{sharelink.PlaylistColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlist.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CreatedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sharelink.CreatedByTable,
+			Columns: []string{sharelink.CreatedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CreatedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sharelink.CreatedByTable,
+			Columns: []string{sharelink.CreatedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sharelink.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ShareLinkUpdateOne is the builder for updating a single ShareLink entity.
+type ShareLinkUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ShareLinkMutation
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (_u *ShareLinkUpdateOne) SetTokenHash(v string) *ShareLinkUpdateOne {
+	_u.mutation.SetTokenHash(v)
+	return _u
+}
+
+// SetNillableTokenHash sets the "token_hash" field if the given value is not nil.
+func (_u *ShareLinkUpdateOne) SetNillableTokenHash(v *string) *ShareLinkUpdateOne {
+	if v != nil {
+		_u.SetTokenHash(*v)
+	}
+	return _u
+}
+
+// SetTokenPrefix sets the "token_prefix" field.
+func (_u *ShareLinkUpdateOne) SetTokenPrefix(v string) *ShareLinkUpdateOne {
+	_u.mutation.SetTokenPrefix(v)
+	return _u
+}
+
+// SetNillableTokenPrefix sets the "token_prefix" field if the given value is not nil.
+func (_u *ShareLinkUpdateOne) SetNillableTokenPrefix(v *string) *ShareLinkUpdateOne {
+	if v != nil {
+		_u.SetTokenPrefix(*v)
+	}
+	return _u
+}
+
+// SetStartSeconds sets the "start_seconds" field.
+func (_u *ShareLinkUpdateOne) SetStartSeconds(v int) *ShareLinkUpdateOne {
+	_u.mutation.ResetStartSeconds()
+	_u.mutation.SetStartSeconds(v)
+	return _u
+}
+
+// SetNillableStartSeconds sets the "start_seconds" field if the given value is not nil.
+func (_u *ShareLinkUpdateOne) SetNillableStartSeconds(v *int) *ShareLinkUpdateOne {
+	if v != nil {
+		_u.SetStartSeconds(*v)
+	}
+	return _u
+}
+
+// AddStartSeconds adds value to the "start_seconds" field.
+func (_u *ShareLinkUpdateOne) AddStartSeconds(v int) *ShareLinkUpdateOne {
+	_u.mutation.AddStartSeconds(v)
+	return _u
+}
+
+// ClearStartSeconds clears the value of the "start_seconds" field.
+func (_u *ShareLinkUpdateOne) ClearStartSeconds() *ShareLinkUpdateOne {
+	_u.mutation.ClearStartSeconds()
+	return _u
+}
+
+// SetEndSeconds sets the "end_seconds" field.
+func (_u *ShareLinkUpdateOne) SetEndSeconds(v int) *ShareLinkUpdateOne {
+	_u.mutation.ResetEndSeconds()
+	_u.mutation.SetEndSeconds(v)
+	return _u
+}
+
+// SetNillableEndSeconds sets the "end_seconds" field if the given value is not nil.
+func (_u *ShareLinkUpdateOne) SetNillableEndSeconds(v *int) *ShareLinkUpdateOne {
+	if v != nil {
+		_u.SetEndSeconds(*v)
+	}
+	return _u
+}
+
+// AddEndSeconds adds value to the "end_seconds" field.
+func (_u *ShareLinkUpdateOne) AddEndSeconds(v int) *ShareLinkUpdateOne {
+	_u.mutation.AddEndSeconds(v)
+	return _u
+}
+
+// ClearEndSeconds clears the value of the "end_seconds" field.
+func (_u *ShareLinkUpdateOne) ClearEndSeconds() *ShareLinkUpdateOne {
+	_u.mutation.ClearEndSeconds()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *ShareLinkUpdateOne) SetExpiresAt(v time.Time) *ShareLinkUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *ShareLinkUpdateOne) SetNillableExpiresAt(v *time.Time) *ShareLinkUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *ShareLinkUpdateOne) ClearExpiresAt() *ShareLinkUpdateOne {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// SetViews sets the "views" field.
+func (_u *ShareLinkUpdateOne) SetViews(v int) *ShareLinkUpdateOne {
+	_u.mutation.ResetViews()
+	_u.mutation.SetViews(v)
+	return _u
+}
+
+// SetNillableViews sets the "views" field if the given value is not nil.
+func (_u *ShareLinkUpdateOne) SetNillableViews(v *int) *ShareLinkUpdateOne {
+	if v != nil {
+		_u.SetViews(*v)
+	}
+	return _u
+}
+
+// AddViews adds value to the "views" field.
+func (_u *ShareLinkUpdateOne) AddViews(v int) *ShareLinkUpdateOne {
+	_u.mutation.AddViews(v)
+	return _u
+}
+
+// SetLastViewedAt sets the "last_viewed_at" field.
+func (_u *ShareLinkUpdateOne) SetLastViewedAt(v time.Time) *ShareLinkUpdateOne {
+	_u.mutation.SetLastViewedAt(v)
+	return _u
+}
+
+// SetNillableLastViewedAt sets the "last_viewed_at" field if the given value is not nil.
+func (_u *ShareLinkUpdateOne) SetNillableLastViewedAt(v *time.Time) *ShareLinkUpdateOne {
+	if v != nil {
+		_u.SetLastViewedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastViewedAt clears the value of the "last_viewed_at" field.
+func (_u *ShareLinkUpdateOne) ClearLastViewedAt() *ShareLinkUpdateOne {
+	_u.mutation.ClearLastViewedAt()
+	return _u
+}
+
+// SetVodID sets the "vod" edge to the Vod entity by ID.
+func (_u *ShareLinkUpdateOne) SetVodID(id uuid.UUID) *ShareLinkUpdateOne {
+	_u.mutation.SetVodID(id)
+	return _u
+}
+
+// SetNillableVodID sets the "vod" edge to the Vod entity by ID if the given value is not nil.
+func (_u *ShareLinkUpdateOne) SetNillableVodID(id *uuid.UUID) *ShareLinkUpdateOne {
+	if id != nil {
+		_u = _u.SetVodID(*id)
+	}
+	return _u
+}
+
+// SetVod sets the "vod" edge to the Vod entity.
+func (_u *ShareLinkUpdateOne) SetVod(v *Vod) *ShareLinkUpdateOne {
+	return _u.SetVodID(v.ID)
+}
+
+// SetPlaylistID sets the "playlist" edge to the Playlist entity by ID.
+func (_u *ShareLinkUpdateOne) SetPlaylistID(id uuid.UUID) *ShareLinkUpdateOne {
+	_u.mutation.SetPlaylistID(id)
+	return _u
+}
+
+// SetNillablePlaylistID sets the "playlist" edge to the Playlist entity by ID if the given value is not nil.
+func (_u *ShareLinkUpdateOne) SetNillablePlaylistID(id *uuid.UUID) *ShareLinkUpdateOne {
+	if id != nil {
+		_u = _u.SetPlaylistID(*id)
+	}
+	return _u
+}
+
+// SetPlaylist sets the "playlist" edge to the Playlist entity.
+func (_u *ShareLinkUpdateOne) SetPlaylist(v *Playlist) *ShareLinkUpdateOne {
+	return _u.SetPlaylistID(v.ID)
+}
+
+// SetCreatedByID sets the "created_by" edge to the User entity by ID.
+func (_u *ShareLinkUpdateOne) SetCreatedByID(id uuid.UUID) *ShareLinkUpdateOne {
+	_u.mutation.SetCreatedByID(id)
+	return _u
+}
+
+// SetNillableCreatedByID sets the "created_by" edge to the User entity by ID if the given value is not nil.
+func (_u *ShareLinkUpdateOne) SetNillableCreatedByID(id *uuid.UUID) *ShareLinkUpdateOne {
+	if id != nil {
+		_u = _u.SetCreatedByID(*id)
+	}
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" edge to the User entity.
+func (_u *ShareLinkUpdateOne) SetCreatedBy(v *User) *ShareLinkUpdateOne {
+	return _u.SetCreatedByID(v.ID)
+}
+
+// Mutation returns the ShareLinkMutation object of the builder.
+func (_u *ShareLinkUpdateOne) Mutation() *ShareLinkMutation {
+	return _u.mutation
+}
+
+// ClearVod clears the "vod" edge to the Vod entity.
+func (_u *ShareLinkUpdateOne) ClearVod() *ShareLinkUpdateOne {
+	_u.mutation.ClearVod()
+	return _u
+}
+
+// ClearPlaylist clears the "playlist" edge to the Playlist entity.
+func (_u *ShareLinkUpdateOne) ClearPlaylist() *ShareLinkUpdateOne {
+	_u.mutation.ClearPlaylist()
+	return _u
+}
+
+// ClearCreatedBy clears the "created_by" edge to the User entity.
+func (_u *ShareLinkUpdateOne) ClearCreatedBy() *ShareLinkUpdateOne {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// Where appends a list predicates to the ShareLinkUpdate builder.
+func (_u *ShareLinkUpdateOne) Where(ps ...predicate.ShareLink) *ShareLinkUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ShareLinkUpdateOne) Select(field string, fields ...string) *ShareLinkUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ShareLink entity.
+func (_u *ShareLinkUpdateOne) Save(ctx context.Context) (*ShareLink, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ShareLinkUpdateOne) SaveX(ctx context.Context) *ShareLink {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ShareLinkUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ShareLinkUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *ShareLinkUpdateOne) sqlSave(ctx context.Context) (_node *ShareLink, err error) {
+	_spec := sqlgraph.NewUpdateSpec(sharelink.Table, sharelink.Columns, sqlgraph.NewFieldSpec(sharelink.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ShareLink.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, sharelink.FieldID)
+		for _, f := range fields {
+			if !sharelink.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != sharelink.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TokenHash(); ok {
+		_spec.SetField(sharelink.FieldTokenHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TokenPrefix(); ok {
+		_spec.SetField(sharelink.FieldTokenPrefix, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.StartSeconds(); ok {
+		_spec.SetField(sharelink.FieldStartSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedStartSeconds(); ok {
+		_spec.AddField(sharelink.FieldStartSeconds, field.TypeInt, value)
+	}
+	if _u.mutation.StartSecondsCleared() {
+		_spec.ClearField(sharelink.FieldStartSeconds, field.TypeInt)
+	}
+	if value, ok := _u.mutation.EndSeconds(); ok {
+		_spec.SetField(sharelink.FieldEndSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEndSeconds(); ok {
+		_spec.AddField(sharelink.FieldEndSeconds, field.TypeInt, value)
+	}
+	if _u.mutation.EndSecondsCleared() {
+		_spec.ClearField(sharelink.FieldEndSeconds, field.TypeInt)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(sharelink.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(sharelink.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Views(); ok {
+		_spec.SetField(sharelink.FieldViews, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedViews(); ok {
+		_spec.AddField(sharelink.FieldViews, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastViewedAt(); ok {
+		_spec.SetField(sharelink.FieldLastViewedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastViewedAtCleared() {
+		_spec.ClearField(sharelink.FieldLastViewedAt, field.TypeTime)
+	}
+	if _u.mutation.VodCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sharelink.VodTable,
+			Columns: []string{sharelink.VodColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vod.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.VodIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sharelink.VodTable,
+			Columns: []string{sharelink.VodColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vod.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PlaylistCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sharelink.PlaylistTable,
+			Columns: []string{sharelink.PlaylistColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlist.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PlaylistIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sharelink.PlaylistTable,
+			Columns: []string{sharelink.PlaylistColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlist.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CreatedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sharelink.CreatedByTable,
+			Columns: []string{sharelink.CreatedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CreatedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sharelink.CreatedByTable,
+			Columns: []string{sharelink.CreatedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ShareLink{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sharelink.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -12,8 +12,14 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// ApiToken is the client for interacting with the ApiToken builders.
+	ApiToken *ApiTokenClient
+	// AuditLog is the client for interacting with the AuditLog builders.
+	AuditLog *AuditLogClient
 	// BlockedVideos is the client for interacting with the BlockedVideos builders.
 	BlockedVideos *BlockedVideosClient
+	// BulkOperation is the client for interacting with the BulkOperation builders.
+	BulkOperation *BulkOperationClient
 	// Channel is the client for interacting with the Channel builders.
 	Channel *ChannelClient
 	// Chapter is the client for interacting with the Chapter builders.
@@ -24,10 +30,16 @@ type Tx struct {
 	LiveCategory *LiveCategoryClient
 	// LiveTitleRegex is the client for interacting with the LiveTitleRegex builders.
 	LiveTitleRegex *LiveTitleRegexClient
+	// LoginThrottle is the client for interacting with the LoginThrottle builders.
+	LoginThrottle *LoginThrottleClient
 	// MultistreamInfo is the client for interacting with the MultistreamInfo builders.
 	MultistreamInfo *MultistreamInfoClient
 	// MutedSegment is the client for interacting with the MutedSegment builders.
 	MutedSegment *MutedSegmentClient
+	// NotificationFailure is the client for interacting with the NotificationFailure builders.
+	NotificationFailure *NotificationFailureClient
+	// NotificationSubscription is the client for interacting with the NotificationSubscription builders.
+	NotificationSubscription *NotificationSubscriptionClient
 	// Playback is the client for interacting with the Playback builders.
 	Playback *PlaybackClient
 	// Playlist is the client for interacting with the Playlist builders.
@@ -36,10 +48,18 @@ type Tx struct {
 	PlaylistRule *PlaylistRuleClient
 	// PlaylistRuleGroup is the client for interacting with the PlaylistRuleGroup builders.
 	PlaylistRuleGroup *PlaylistRuleGroupClient
+	// PlaylistSection is the client for interacting with the PlaylistSection builders.
+	PlaylistSection *PlaylistSectionClient
+	// PlaylistVod is the client for interacting with the PlaylistVod builders.
+	PlaylistVod *PlaylistVodClient
 	// Queue is the client for interacting with the Queue builders.
 	Queue *QueueClient
 	// Sessions is the client for interacting with the Sessions builders.
 	Sessions *SessionsClient
+	// ShareLink is the client for interacting with the ShareLink builders.
+	ShareLink *ShareLinkClient
+	// Tag is the client for interacting with the Tag builders.
+	Tag *TagClient
 	// TwitchCategory is the client for interacting with the TwitchCategory builders.
 	TwitchCategory *TwitchCategoryClient
 	// User is the client for interacting with the User builders.
@@ -185,20 +205,30 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.ApiToken = NewApiTokenClient(tx.config)
+	tx.AuditLog = NewAuditLogClient(tx.config)
 	tx.BlockedVideos = NewBlockedVideosClient(tx.config)
+	tx.BulkOperation = NewBulkOperationClient(tx.config)
 	tx.Channel = NewChannelClient(tx.config)
 	tx.Chapter = NewChapterClient(tx.config)
 	tx.Live = NewLiveClient(tx.config)
 	tx.LiveCategory = NewLiveCategoryClient(tx.config)
 	tx.LiveTitleRegex = NewLiveTitleRegexClient(tx.config)
+	tx.LoginThrottle = NewLoginThrottleClient(tx.config)
 	tx.MultistreamInfo = NewMultistreamInfoClient(tx.config)
 	tx.MutedSegment = NewMutedSegmentClient(tx.config)
+	tx.NotificationFailure = NewNotificationFailureClient(tx.config)
+	tx.NotificationSubscription = NewNotificationSubscriptionClient(tx.config)
 	tx.Playback = NewPlaybackClient(tx.config)
 	tx.Playlist = NewPlaylistClient(tx.config)
 	tx.PlaylistRule = NewPlaylistRuleClient(tx.config)
 	tx.PlaylistRuleGroup = NewPlaylistRuleGroupClient(tx.config)
+	tx.PlaylistSection = NewPlaylistSectionClient(tx.config)
+	tx.PlaylistVod = NewPlaylistVodClient(tx.config)
 	tx.Queue = NewQueueClient(tx.config)
 	tx.Sessions = NewSessionsClient(tx.config)
+	tx.ShareLink = NewShareLinkClient(tx.config)
+	tx.Tag = NewTagClient(tx.config)
 	tx.TwitchCategory = NewTwitchCategoryClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 	tx.Vod = NewVodClient(tx.config)
@@ -215,7 +245,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: BlockedVideos.QueryXXX(), the query will be executed
+// applies a query, for example: ApiToken.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -27,25 +28,117 @@ type User struct {
 	Password string `json:"-"`
 	// Oauth holds the value of the "oauth" field.
 	Oauth bool `json:"oauth,omitempty"`
+	// Authenticated against the LDAP directory, the password is not stored.
+	Ldap bool `json:"ldap,omitempty"`
 	// Role holds the value of the "role" field.
 	Role utils.Role `json:"role,omitempty"`
 	// Webhook holds the value of the "webhook" field.
 	Webhook string `json:"webhook,omitempty"`
+	// Groups used by the access rules of channels and playlists.
+	Groups []string `json:"groups,omitempty"`
+	// TotpEnabled holds the value of the "totp_enabled" field.
+	TotpEnabled bool `json:"totp_enabled,omitempty"`
+	// Base32 TOTP secret, set when enrollment starts and only used once enabled.
+	TotpSecret string `json:"-"`
+	// Time step of the last accepted TOTP code so codes cannot be reused.
+	TotpLastCounter int64 `json:"totp_last_counter,omitempty"`
+	// SHA-256 hashes of unused recovery codes.
+	TotpRecoveryCodes []string `json:"-"`
 	// UpdatedAt holds the value of the "updated_at" field.
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
-	CreatedAt    time.Time `json:"created_at,omitempty"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the UserQuery when eager-loading is set.
+	Edges        UserEdges `json:"edges"`
 	selectValues sql.SelectValues
 }
 
+// UserEdges holds the relations/edges for other nodes in the graph.
+type UserEdges struct {
+	// NotificationSubscriptions holds the value of the notification_subscriptions edge.
+	NotificationSubscriptions []*NotificationSubscription `json:"notification_subscriptions,omitempty"`
+	// APITokens holds the value of the api_tokens edge.
+	APITokens []*ApiToken `json:"api_tokens,omitempty"`
+	// AllowedChannels holds the value of the allowed_channels edge.
+	AllowedChannels []*Channel `json:"allowed_channels,omitempty"`
+	// AllowedPlaylists holds the value of the allowed_playlists edge.
+	AllowedPlaylists []*Playlist `json:"allowed_playlists,omitempty"`
+	// ShareLinks holds the value of the share_links edge.
+	ShareLinks []*ShareLink `json:"share_links,omitempty"`
+	// BulkOperations holds the value of the bulk_operations edge.
+	BulkOperations []*BulkOperation `json:"bulk_operations,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [6]bool
+}
+
+// NotificationSubscriptionsOrErr returns the NotificationSubscriptions value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) NotificationSubscriptionsOrErr() ([]*NotificationSubscription, error) {
+	if e.loadedTypes[0] {
+		return e.NotificationSubscriptions, nil
+	}
+	return nil, &NotLoadedError{edge: "notification_subscriptions"}
+}
+
+// APITokensOrErr returns the APITokens value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) APITokensOrErr() ([]*ApiToken, error) {
+	if e.loadedTypes[1] {
+		return e.APITokens, nil
+	}
+	return nil, &NotLoadedError{edge: "api_tokens"}
+}
+
+// AllowedChannelsOrErr returns the AllowedChannels value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) AllowedChannelsOrErr() ([]*Channel, error) {
+	if e.loadedTypes[2] {
+		return e.AllowedChannels, nil
+	}
+	return nil, &NotLoadedError{edge: "allowed_channels"}
+}
+
+// AllowedPlaylistsOrErr returns the AllowedPlaylists value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) AllowedPlaylistsOrErr() ([]*Playlist, error) {
+	if e.loadedTypes[3] {
+		return e.AllowedPlaylists, nil
+	}
+	return nil, &NotLoadedError{edge: "allowed_playlists"}
+}
+
+// ShareLinksOrErr returns the ShareLinks value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ShareLinksOrErr() ([]*ShareLink, error) {
+	if e.loadedTypes[4] {
+		return e.ShareLinks, nil
+	}
+	return nil, &NotLoadedError{edge: "share_links"}
+}
+
+// BulkOperationsOrErr returns the BulkOperations value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) BulkOperationsOrErr() ([]*BulkOperation, error) {
+	if e.loadedTypes[5] {
+		return e.BulkOperations, nil
+	}
+	return nil, &NotLoadedError{edge: "bulk_operations"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case user.FieldOauth:
+		case user.FieldGroups, user.FieldTotpRecoveryCodes:
+			values[i] = new([]byte)
+		case user.FieldOauth, user.FieldLdap, user.FieldTotpEnabled:
 			values[i] = new(sql.NullBool)
-		case user.FieldSub, user.FieldUsername, user.FieldPassword, user.FieldRole, user.FieldWebhook:
+		case user.FieldTotpLastCounter:
+			values[i] = new(sql.NullInt64)
+		case user.FieldSub, user.FieldUsername, user.FieldPassword, user.FieldRole, user.FieldWebhook, user.FieldTotpSecret:
 			values[i] = new(sql.NullString)
 		case user.FieldUpdatedAt, user.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
@@ -96,6 +189,12 @@ func (_m *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Oauth = value.Bool
 			}
+		case user.FieldLdap:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field ldap", values[i])
+			} else if value.Valid {
+				_m.Ldap = value.Bool
+			}
 		case user.FieldRole:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field role", values[i])
@@ -108,6 +207,40 @@ func (_m *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Webhook = value.String
 			}
+		case user.FieldGroups:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field groups", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Groups); err != nil {
+					return fmt.Errorf("unmarshal field groups: %w", err)
+				}
+			}
+		case user.FieldTotpEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field totp_enabled", values[i])
+			} else if value.Valid {
+				_m.TotpEnabled = value.Bool
+			}
+		case user.FieldTotpSecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field totp_secret", values[i])
+			} else if value.Valid {
+				_m.TotpSecret = value.String
+			}
+		case user.FieldTotpLastCounter:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field totp_last_counter", values[i])
+			} else if value.Valid {
+				_m.TotpLastCounter = value.Int64
+			}
+		case user.FieldTotpRecoveryCodes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field totp_recovery_codes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.TotpRecoveryCodes); err != nil {
+					return fmt.Errorf("unmarshal field totp_recovery_codes: %w", err)
+				}
+			}
 		case user.FieldUpdatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
@@ -133,6 +266,36 @@ func (_m *User) Value(name string) (ent.Value, error) {
 	return _m.selectValues.Get(name)
 }
 
+// QueryNotificationSubscriptions queries the "notification_subscriptions" edge of the User entity.
+func (_m *User) QueryNotificationSubscriptions() *NotificationSubscriptionQuery {
+	return NewUserClient(_m.config).QueryNotificationSubscriptions(_m)
+}
+
+// QueryAPITokens queries the "api_tokens" edge of the User entity.
+func (_m *User) QueryAPITokens() *ApiTokenQuery {
+	return NewUserClient(_m.config).QueryAPITokens(_m)
+}
+
+// QueryAllowedChannels queries the "allowed_channels" edge of the User entity.
+func (_m *User) QueryAllowedChannels() *ChannelQuery {
+	return NewUserClient(_m.config).QueryAllowedChannels(_m)
+}
+
+// QueryAllowedPlaylists queries the "allowed_playlists" edge of the User entity.
+func (_m *User) QueryAllowedPlaylists() *PlaylistQuery {
+	return NewUserClient(_m.config).QueryAllowedPlaylists(_m)
+}
+
+// QueryShareLinks queries the "share_links" edge of the User entity.
+func (_m *User) QueryShareLinks() *ShareLinkQuery {
+	return NewUserClient(_m.config).QueryShareLinks(_m)
+}
+
+// QueryBulkOperations queries the "bulk_operations" edge of the User entity.
+func (_m *User) QueryBulkOperations() *BulkOperationQuery {
+	return NewUserClient(_m.config).QueryBulkOperations(_m)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -167,12 +330,28 @@ func (_m *User) String() string {
 	builder.WriteString("oauth=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Oauth))
 	builder.WriteString(", ")
+	builder.WriteString("ldap=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Ldap))
+	builder.WriteString(", ")
 	builder.WriteString("role=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Role))
 	builder.WriteString(", ")
 	builder.WriteString("webhook=")
 	builder.WriteString(_m.Webhook)
 	builder.WriteString(", ")
+	builder.WriteString("groups=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Groups))
+	builder.WriteString(", ")
+	builder.WriteString("totp_enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TotpEnabled))
+	builder.WriteString(", ")
+	builder.WriteString("totp_secret=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("totp_last_counter=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TotpLastCounter))
+	builder.WriteString(", ")
+	builder.WriteString("totp_recovery_codes=<sensitive>")
+	builder.WriteString(", ")
 	builder.WriteString("updated_at=")
 	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/google/uuid"
 	"github.com/zibbp/ganymede/internal/utils"
 )
@@ -24,16 +25,78 @@ const (
 	FieldPassword = "password"
 	// FieldOauth holds the string denoting the oauth field in the database.
 	FieldOauth = "oauth"
+	// FieldLdap holds the string denoting the ldap field in the database.
+	FieldLdap = "ldap"
 	// FieldRole holds the string denoting the role field in the database.
 	FieldRole = "role"
 	// FieldWebhook holds the string denoting the webhook field in the database.
 	FieldWebhook = "webhook"
+	// FieldGroups holds the string denoting the groups field in the database.
+	FieldGroups = "groups"
+	// FieldTotpEnabled holds the string denoting the totp_enabled field in the database.
+	FieldTotpEnabled = "totp_enabled"
+	// FieldTotpSecret holds the string denoting the totp_secret field in the database.
+	FieldTotpSecret = "totp_secret"
+	// FieldTotpLastCounter holds the string denoting the totp_last_counter field in the database.
+	FieldTotpLastCounter = "totp_last_counter"
+	// FieldTotpRecoveryCodes holds the string denoting the totp_recovery_codes field in the database.
+	FieldTotpRecoveryCodes = "totp_recovery_codes"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
+	// EdgeNotificationSubscriptions holds the string denoting the notification_subscriptions edge name in mutations.
+	EdgeNotificationSubscriptions = "notification_subscriptions"
+	// EdgeAPITokens holds the string denoting the api_tokens edge name in mutations.
+	EdgeAPITokens = "api_tokens"
+	// EdgeAllowedChannels holds the string denoting the allowed_channels edge name in mutations.
+	EdgeAllowedChannels = "allowed_channels"
+	// EdgeAllowedPlaylists holds the string denoting the allowed_playlists edge name in mutations.
+	EdgeAllowedPlaylists = "allowed_playlists"
+	// EdgeShareLinks holds the string denoting the share_links edge name in mutations.
+	EdgeShareLinks = "share_links"
+	// EdgeBulkOperations holds the string denoting the bulk_operations edge name in mutations.
+	EdgeBulkOperations = "bulk_operations"
 	// Table holds the table name of the user in the database.
 	Table = "users"
+	// NotificationSubscriptionsTable is the table that holds the notification_subscriptions relation/edge.
+	NotificationSubscriptionsTable = "notification_subscriptions"
+	// NotificationSubscriptionsInverseTable is the table name for the NotificationSubscription entity.
+	// It exists in this package in order to avoid circular dependency with the "notificationsubscription" package.
+	NotificationSubscriptionsInverseTable = "notification_subscriptions"
+	// NotificationSubscriptionsColumn is the table column denoting the notification_subscriptions relation/edge.
+	NotificationSubscriptionsColumn = "user_notification_subscriptions"
+	// APITokensTable is the table that holds the api_tokens relation/edge.
+	APITokensTable = "api_tokens"
+	// APITokensInverseTable is the table name for the ApiToken entity.
+	// It exists in this package in order to avoid circular dependency with the "apitoken" package.
+	APITokensInverseTable = "api_tokens"
+	// APITokensColumn is the table column denoting the api_tokens relation/edge.
+	APITokensColumn = "user_api_tokens"
+	// AllowedChannelsTable is the table that holds the allowed_channels relation/edge. The primary key declared below.
+	AllowedChannelsTable = "channel_allowed_users"
+	// AllowedChannelsInverseTable is the table name for the Channel entity.
+	// It exists in this package in order to avoid circular dependency with the "channel" package.
+	AllowedChannelsInverseTable = "channels"
+	// AllowedPlaylistsTable is the table that holds the allowed_playlists relation/edge. The primary key declared below.
+	AllowedPlaylistsTable = "playlist_allowed_users"
+	// AllowedPlaylistsInverseTable is the table name for the Playlist entity.
+	// It exists in this package in order to avoid circular dependency with the "playlist" package.
+	AllowedPlaylistsInverseTable = "playlists"
+	// ShareLinksTable is the table that holds the share_links relation/edge.
+	ShareLinksTable = "share_links"
+	// ShareLinksInverseTable is the table name for the ShareLink entity.
+	// It exists in this package in order to avoid circular dependency with the "sharelink" package.
+	ShareLinksInverseTable = "share_links"
+	// ShareLinksColumn is the table column denoting the share_links relation/edge.
+	ShareLinksColumn = "user_share_links"
+	// BulkOperationsTable is the table that holds the bulk_operations relation/edge.
+	BulkOperationsTable = "bulk_operations"
+	// BulkOperationsInverseTable is the table name for the BulkOperation entity.
+	// It exists in this package in order to avoid circular dependency with the "bulkoperation" package.
+	BulkOperationsInverseTable = "bulk_operations"
+	// BulkOperationsColumn is the table column denoting the bulk_operations relation/edge.
+	BulkOperationsColumn = "user_bulk_operations"
 )
 
 // Columns holds all SQL columns for user fields.
@@ -43,12 +106,27 @@ var Columns = []string{
 	FieldUsername,
 	FieldPassword,
 	FieldOauth,
+	FieldLdap,
 	FieldRole,
 	FieldWebhook,
+	FieldGroups,
+	FieldTotpEnabled,
+	FieldTotpSecret,
+	FieldTotpLastCounter,
+	FieldTotpRecoveryCodes,
 	FieldUpdatedAt,
 	FieldCreatedAt,
 }
 
+var (
+	// AllowedChannelsPrimaryKey and AllowedChannelsColumn2 are the table columns denoting the
+	// primary key for the allowed_channels relation (M2M).
+	AllowedChannelsPrimaryKey = []string{"channel_id", "user_id"}
+	// AllowedPlaylistsPrimaryKey and AllowedPlaylistsColumn2 are the table columns denoting the
+	// primary key for the allowed_playlists relation (M2M).
+	AllowedPlaylistsPrimaryKey = []string{"playlist_id", "user_id"}
+)
+
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -62,6 +140,10 @@ func ValidColumn(column string) bool {
 var (
 	// DefaultOauth holds the default value on creation for the "oauth" field.
 	DefaultOauth bool
+	// DefaultLdap holds the default value on creation for the "ldap" field.
+	DefaultLdap bool
+	// DefaultTotpEnabled holds the default value on creation for the "totp_enabled" field.
+	DefaultTotpEnabled bool
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
 	DefaultUpdatedAt func() time.Time
 	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
@@ -112,6 +194,11 @@ func ByOauth(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldOauth, opts...).ToFunc()
 }
 
+// ByLdap orders the results by the ldap field.
+func ByLdap(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLdap, opts...).ToFunc()
+}
+
 // ByRole orders the results by the role field.
 func ByRole(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldRole, opts...).ToFunc()
@@ -122,6 +209,21 @@ func ByWebhook(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldWebhook, opts...).ToFunc()
 }
 
+// ByTotpEnabled orders the results by the totp_enabled field.
+func ByTotpEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotpEnabled, opts...).ToFunc()
+}
+
+// ByTotpSecret orders the results by the totp_secret field.
+func ByTotpSecret(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotpSecret, opts...).ToFunc()
+}
+
+// ByTotpLastCounter orders the results by the totp_last_counter field.
+func ByTotpLastCounter(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotpLastCounter, opts...).ToFunc()
+}
+
 // ByUpdatedAt orders the results by the updated_at field.
 func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
@@ -131,3 +233,129 @@ func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
 }
+
+// ByNotificationSubscriptionsCount orders the results by notification_subscriptions count.
+func ByNotificationSubscriptionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newNotificationSubscriptionsStep(), opts...)
+	}
+}
+
+// ByNotificationSubscriptions orders the results by notification_subscriptions terms.
+func ByNotificationSubscriptions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newNotificationSubscriptionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAPITokensCount orders the results by api_tokens count.
+func ByAPITokensCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAPITokensStep(), opts...)
+	}
+}
+
+// ByAPITokens orders the results by api_tokens terms.
+func ByAPITokens(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAPITokensStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAllowedChannelsCount orders the results by allowed_channels count.
+func ByAllowedChannelsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAllowedChannelsStep(), opts...)
+	}
+}
+
+// ByAllowedChannels orders the results by allowed_channels terms.
+func ByAllowedChannels(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAllowedChannelsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAllowedPlaylistsCount orders the results by allowed_playlists count.
+func ByAllowedPlaylistsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAllowedPlaylistsStep(), opts...)
+	}
+}
+
+// ByAllowedPlaylists orders the results by allowed_playlists terms.
+func ByAllowedPlaylists(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAllowedPlaylistsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByShareLinksCount orders the results by share_links count.
+func ByShareLinksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newShareLinksStep(), opts...)
+	}
+}
+
+// ByShareLinks orders the results by share_links terms.
+func ByShareLinks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newShareLinksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByBulkOperationsCount orders the results by bulk_operations count.
+func ByBulkOperationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBulkOperationsStep(), opts...)
+	}
+}
+
+// ByBulkOperations orders the results by bulk_operations terms.
+func ByBulkOperations(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBulkOperationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newNotificationSubscriptionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(NotificationSubscriptionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, NotificationSubscriptionsTable, NotificationSubscriptionsColumn),
+	)
+}
+func newAPITokensStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(APITokensInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, APITokensTable, APITokensColumn),
+	)
+}
+func newAllowedChannelsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AllowedChannelsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, AllowedChannelsTable, AllowedChannelsPrimaryKey...),
+	)
+}
+func newAllowedPlaylistsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AllowedPlaylistsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, AllowedPlaylistsTable, AllowedPlaylistsPrimaryKey...),
+	)
+}
+func newShareLinksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ShareLinksInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ShareLinksTable, ShareLinksColumn),
+	)
+}
+func newBulkOperationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BulkOperationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, BulkOperationsTable, BulkOperationsColumn),
+	)
+}
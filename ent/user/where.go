@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/google/uuid"
 	"github.com/zibbp/ganymede/ent/predicate"
 	"github.com/zibbp/ganymede/internal/utils"
@@ -76,11 +77,31 @@ func Oauth(v bool) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldOauth, v))
 }
 
+// Ldap applies equality check predicate on the "ldap" field. It's identical to LdapEQ.
+func Ldap(v bool) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldLdap, v))
+}
+
 // Webhook applies equality check predicate on the "webhook" field. It's identical to WebhookEQ.
 func Webhook(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldWebhook, v))
 }
 
+// TotpEnabled applies equality check predicate on the "totp_enabled" field. It's identical to TotpEnabledEQ.
+func TotpEnabled(v bool) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldTotpEnabled, v))
+}
+
+// TotpSecret applies equality check predicate on the "totp_secret" field. It's identical to TotpSecretEQ.
+func TotpSecret(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldTotpSecret, v))
+}
+
+// TotpLastCounter applies equality check predicate on the "totp_last_counter" field. It's identical to TotpLastCounterEQ.
+func TotpLastCounter(v int64) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldTotpLastCounter, v))
+}
+
 // UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
 func UpdatedAt(v time.Time) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldUpdatedAt, v))
@@ -316,6 +337,16 @@ func OauthNEQ(v bool) predicate.User {
 	return predicate.User(sql.FieldNEQ(FieldOauth, v))
 }
 
+// LdapEQ applies the EQ predicate on the "ldap" field.
+func LdapEQ(v bool) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldLdap, v))
+}
+
+// LdapNEQ applies the NEQ predicate on the "ldap" field.
+func LdapNEQ(v bool) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldLdap, v))
+}
+
 // RoleEQ applies the EQ predicate on the "role" field.
 func RoleEQ(v utils.Role) predicate.User {
 	vc := v
@@ -421,6 +452,161 @@ func WebhookContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldWebhook, v))
 }
 
+// GroupsIsNil applies the IsNil predicate on the "groups" field.
+func GroupsIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldGroups))
+}
+
+// GroupsNotNil applies the NotNil predicate on the "groups" field.
+func GroupsNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldGroups))
+}
+
+// TotpEnabledEQ applies the EQ predicate on the "totp_enabled" field.
+func TotpEnabledEQ(v bool) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldTotpEnabled, v))
+}
+
+// TotpEnabledNEQ applies the NEQ predicate on the "totp_enabled" field.
+func TotpEnabledNEQ(v bool) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldTotpEnabled, v))
+}
+
+// TotpSecretEQ applies the EQ predicate on the "totp_secret" field.
+func TotpSecretEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldTotpSecret, v))
+}
+
+// TotpSecretNEQ applies the NEQ predicate on the "totp_secret" field.
+func TotpSecretNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldTotpSecret, v))
+}
+
+// TotpSecretIn applies the In predicate on the "totp_secret" field.
+func TotpSecretIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldTotpSecret, vs...))
+}
+
+// TotpSecretNotIn applies the NotIn predicate on the "totp_secret" field.
+func TotpSecretNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldTotpSecret, vs...))
+}
+
+// TotpSecretGT applies the GT predicate on the "totp_secret" field.
+func TotpSecretGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldTotpSecret, v))
+}
+
+// TotpSecretGTE applies the GTE predicate on the "totp_secret" field.
+func TotpSecretGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldTotpSecret, v))
+}
+
+// TotpSecretLT applies the LT predicate on the "totp_secret" field.
+func TotpSecretLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldTotpSecret, v))
+}
+
+// TotpSecretLTE applies the LTE predicate on the "totp_secret" field.
+func TotpSecretLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldTotpSecret, v))
+}
+
+// TotpSecretContains applies the Contains predicate on the "totp_secret" field.
+func TotpSecretContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldTotpSecret, v))
+}
+
+// TotpSecretHasPrefix applies the HasPrefix predicate on the "totp_secret" field.
+func TotpSecretHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldTotpSecret, v))
+}
+
+// TotpSecretHasSuffix applies the HasSuffix predicate on the "totp_secret" field.
+func TotpSecretHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldTotpSecret, v))
+}
+
+// TotpSecretIsNil applies the IsNil predicate on the "totp_secret" field.
+func TotpSecretIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldTotpSecret))
+}
+
+// TotpSecretNotNil applies the NotNil predicate on the "totp_secret" field.
+func TotpSecretNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldTotpSecret))
+}
+
+// TotpSecretEqualFold applies the EqualFold predicate on the "totp_secret" field.
+func TotpSecretEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldTotpSecret, v))
+}
+
+// TotpSecretContainsFold applies the ContainsFold predicate on the "totp_secret" field.
+func TotpSecretContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldTotpSecret, v))
+}
+
+// TotpLastCounterEQ applies the EQ predicate on the "totp_last_counter" field.
+func TotpLastCounterEQ(v int64) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldTotpLastCounter, v))
+}
+
+// TotpLastCounterNEQ applies the NEQ predicate on the "totp_last_counter" field.
+func TotpLastCounterNEQ(v int64) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldTotpLastCounter, v))
+}
+
+// TotpLastCounterIn applies the In predicate on the "totp_last_counter" field.
+func TotpLastCounterIn(vs ...int64) predicate.User {
+	return predicate.User(sql.FieldIn(FieldTotpLastCounter, vs...))
+}
+
+// TotpLastCounterNotIn applies the NotIn predicate on the "totp_last_counter" field.
+func TotpLastCounterNotIn(vs ...int64) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldTotpLastCounter, vs...))
+}
+
+// TotpLastCounterGT applies the GT predicate on the "totp_last_counter" field.
+func TotpLastCounterGT(v int64) predicate.User {
+	return predicate.User(sql.FieldGT(FieldTotpLastCounter, v))
+}
+
+// TotpLastCounterGTE applies the GTE predicate on the "totp_last_counter" field.
+func TotpLastCounterGTE(v int64) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldTotpLastCounter, v))
+}
+
+// TotpLastCounterLT applies the LT predicate on the "totp_last_counter" field.
+func TotpLastCounterLT(v int64) predicate.User {
+	return predicate.User(sql.FieldLT(FieldTotpLastCounter, v))
+}
+
+// TotpLastCounterLTE applies the LTE predicate on the "totp_last_counter" field.
+func TotpLastCounterLTE(v int64) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldTotpLastCounter, v))
+}
+
+// TotpLastCounterIsNil applies the IsNil predicate on the "totp_last_counter" field.
+func TotpLastCounterIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldTotpLastCounter))
+}
+
+// TotpLastCounterNotNil applies the NotNil predicate on the "totp_last_counter" field.
+func TotpLastCounterNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldTotpLastCounter))
+}
+
+// TotpRecoveryCodesIsNil applies the IsNil predicate on the "totp_recovery_codes" field.
+func TotpRecoveryCodesIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldTotpRecoveryCodes))
+}
+
+// TotpRecoveryCodesNotNil applies the NotNil predicate on the "totp_recovery_codes" field.
+func TotpRecoveryCodesNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldTotpRecoveryCodes))
+}
+
 // UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
 func UpdatedAtEQ(v time.Time) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldUpdatedAt, v))
@@ -501,6 +687,144 @@ func CreatedAtLTE(v time.Time) predicate.User {
 	return predicate.User(sql.FieldLTE(FieldCreatedAt, v))
 }
 
+// HasNotificationSubscriptions applies the HasEdge predicate on the "notification_subscriptions" edge.
+func HasNotificationSubscriptions() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, NotificationSubscriptionsTable, NotificationSubscriptionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasNotificationSubscriptionsWith applies the HasEdge predicate on the "notification_subscriptions" edge with a given conditions (other predicates).
+func HasNotificationSubscriptionsWith(preds ...predicate.NotificationSubscription) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newNotificationSubscriptionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAPITokens applies the HasEdge predicate on the "api_tokens" edge.
+func HasAPITokens() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, APITokensTable, APITokensColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAPITokensWith applies the HasEdge predicate on the "api_tokens" edge with a given conditions (other predicates).
+func HasAPITokensWith(preds ...predicate.ApiToken) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newAPITokensStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAllowedChannels applies the HasEdge predicate on the "allowed_channels" edge.
+func HasAllowedChannels() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, AllowedChannelsTable, AllowedChannelsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAllowedChannelsWith applies the HasEdge predicate on the "allowed_channels" edge with a given conditions (other predicates).
+func HasAllowedChannelsWith(preds ...predicate.Channel) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newAllowedChannelsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAllowedPlaylists applies the HasEdge predicate on the "allowed_playlists" edge.
+func HasAllowedPlaylists() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, AllowedPlaylistsTable, AllowedPlaylistsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAllowedPlaylistsWith applies the HasEdge predicate on the "allowed_playlists" edge with a given conditions (other predicates).
+func HasAllowedPlaylistsWith(preds ...predicate.Playlist) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newAllowedPlaylistsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasShareLinks applies the HasEdge predicate on the "share_links" edge.
+func HasShareLinks() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ShareLinksTable, ShareLinksColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasShareLinksWith applies the HasEdge predicate on the "share_links" edge with a given conditions (other predicates).
+func HasShareLinksWith(preds ...predicate.ShareLink) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newShareLinksStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBulkOperations applies the HasEdge predicate on the "bulk_operations" edge.
+func HasBulkOperations() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, BulkOperationsTable, BulkOperationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBulkOperationsWith applies the HasEdge predicate on the "bulk_operations" edge with a given conditions (other predicates).
+func HasBulkOperationsWith(preds ...predicate.BulkOperation) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newBulkOperationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.User) predicate.User {
 	return predicate.User(sql.AndPredicates(predicates...))
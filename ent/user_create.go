@@ -11,6 +11,12 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/apitoken"
+	"github.com/zibbp/ganymede/ent/bulkoperation"
+	"github.com/zibbp/ganymede/ent/channel"
+	"github.com/zibbp/ganymede/ent/notificationsubscription"
+	"github.com/zibbp/ganymede/ent/playlist"
+	"github.com/zibbp/ganymede/ent/sharelink"
 	"github.com/zibbp/ganymede/ent/user"
 	"github.com/zibbp/ganymede/internal/utils"
 )
@@ -70,6 +76,20 @@ func (_c *UserCreate) SetNillableOauth(v *bool) *UserCreate {
 	return _c
 }
 
+// SetLdap sets the "ldap" field.
+func (_c *UserCreate) SetLdap(v bool) *UserCreate {
+	_c.mutation.SetLdap(v)
+	return _c
+}
+
+// SetNillableLdap sets the "ldap" field if the given value is not nil.
+func (_c *UserCreate) SetNillableLdap(v *bool) *UserCreate {
+	if v != nil {
+		_c.SetLdap(*v)
+	}
+	return _c
+}
+
 // SetRole sets the "role" field.
 func (_c *UserCreate) SetRole(v utils.Role) *UserCreate {
 	_c.mutation.SetRole(v)
@@ -98,6 +118,60 @@ func (_c *UserCreate) SetNillableWebhook(v *string) *UserCreate {
 	return _c
 }
 
+// SetGroups sets the "groups" field.
+func (_c *UserCreate) SetGroups(v []string) *UserCreate {
+	_c.mutation.SetGroups(v)
+	return _c
+}
+
+// SetTotpEnabled sets the "totp_enabled" field.
+func (_c *UserCreate) SetTotpEnabled(v bool) *UserCreate {
+	_c.mutation.SetTotpEnabled(v)
+	return _c
+}
+
+// SetNillableTotpEnabled sets the "totp_enabled" field if the given value is not nil.
+func (_c *UserCreate) SetNillableTotpEnabled(v *bool) *UserCreate {
+	if v != nil {
+		_c.SetTotpEnabled(*v)
+	}
+	return _c
+}
+
+// SetTotpSecret sets the "totp_secret" field.
+func (_c *UserCreate) SetTotpSecret(v string) *UserCreate {
+	_c.mutation.SetTotpSecret(v)
+	return _c
+}
+
+// SetNillableTotpSecret sets the "totp_secret" field if the given value is not nil.
+func (_c *UserCreate) SetNillableTotpSecret(v *string) *UserCreate {
+	if v != nil {
+		_c.SetTotpSecret(*v)
+	}
+	return _c
+}
+
+// SetTotpLastCounter sets the "totp_last_counter" field.
+func (_c *UserCreate) SetTotpLastCounter(v int64) *UserCreate {
+	_c.mutation.SetTotpLastCounter(v)
+	return _c
+}
+
+// SetNillableTotpLastCounter sets the "totp_last_counter" field if the given value is not nil.
+func (_c *UserCreate) SetNillableTotpLastCounter(v *int64) *UserCreate {
+	if v != nil {
+		_c.SetTotpLastCounter(*v)
+	}
+	return _c
+}
+
+// SetTotpRecoveryCodes sets the "totp_recovery_codes" field.
+func (_c *UserCreate) SetTotpRecoveryCodes(v []string) *UserCreate {
+	_c.mutation.SetTotpRecoveryCodes(v)
+	return _c
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_c *UserCreate) SetUpdatedAt(v time.Time) *UserCreate {
 	_c.mutation.SetUpdatedAt(v)
@@ -140,6 +214,96 @@ func (_c *UserCreate) SetNillableID(v *uuid.UUID) *UserCreate {
 	return _c
 }
 
+// AddNotificationSubscriptionIDs adds the "notification_subscriptions" edge to the NotificationSubscription entity by IDs.
+func (_c *UserCreate) AddNotificationSubscriptionIDs(ids ...uuid.UUID) *UserCreate {
+	_c.mutation.AddNotificationSubscriptionIDs(ids...)
+	return _c
+}
+
+// AddNotificationSubscriptions adds the "notification_subscriptions" edges to the NotificationSubscription entity.
+func (_c *UserCreate) AddNotificationSubscriptions(v ...*NotificationSubscription) *UserCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddNotificationSubscriptionIDs(ids...)
+}
+
+// AddAPITokenIDs adds the "api_tokens" edge to the ApiToken entity by IDs.
+func (_c *UserCreate) AddAPITokenIDs(ids ...uuid.UUID) *UserCreate {
+	_c.mutation.AddAPITokenIDs(ids...)
+	return _c
+}
+
+// AddAPITokens adds the "api_tokens" edges to the ApiToken entity.
+func (_c *UserCreate) AddAPITokens(v ...*ApiToken) *UserCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAPITokenIDs(ids...)
+}
+
+// AddAllowedChannelIDs adds the "allowed_channels" edge to the Channel entity by IDs.
+func (_c *UserCreate) AddAllowedChannelIDs(ids ...uuid.UUID) *UserCreate {
+	_c.mutation.AddAllowedChannelIDs(ids...)
+	return _c
+}
+
+// AddAllowedChannels adds the "allowed_channels" edges to the Channel entity.
+func (_c *UserCreate) AddAllowedChannels(v ...*Channel) *UserCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAllowedChannelIDs(ids...)
+}
+
+// AddAllowedPlaylistIDs adds the "allowed_playlists" edge to the Playlist entity by IDs.
+func (_c *UserCreate) AddAllowedPlaylistIDs(ids ...uuid.UUID) *UserCreate {
+	_c.mutation.AddAllowedPlaylistIDs(ids...)
+	return _c
+}
+
+// AddAllowedPlaylists adds the "allowed_playlists" edges to the Playlist entity.
+func (_c *UserCreate) AddAllowedPlaylists(v ...*Playlist) *UserCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAllowedPlaylistIDs(ids...)
+}
+
+// AddShareLinkIDs adds the "share_links" edge to the ShareLink entity by IDs.
+func (_c *UserCreate) AddShareLinkIDs(ids ...uuid.UUID) *UserCreate {
+	_c.mutation.AddShareLinkIDs(ids...)
+	return _c
+}
+
+// AddShareLinks adds the "share_links" edges to the ShareLink entity.
+func (_c *UserCreate) AddShareLinks(v ...*ShareLink) *UserCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddShareLinkIDs(ids...)
+}
+
+// AddBulkOperationIDs adds the "bulk_operations" edge to the BulkOperation entity by IDs.
+func (_c *UserCreate) AddBulkOperationIDs(ids ...uuid.UUID) *UserCreate {
+	_c.mutation.AddBulkOperationIDs(ids...)
+	return _c
+}
+
+// AddBulkOperations adds the "bulk_operations" edges to the BulkOperation entity.
+func (_c *UserCreate) AddBulkOperations(v ...*BulkOperation) *UserCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddBulkOperationIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_c *UserCreate) Mutation() *UserMutation {
 	return _c.mutation
@@ -179,10 +343,18 @@ func (_c *UserCreate) defaults() {
 		v := user.DefaultOauth
 		_c.mutation.SetOauth(v)
 	}
+	if _, ok := _c.mutation.Ldap(); !ok {
+		v := user.DefaultLdap
+		_c.mutation.SetLdap(v)
+	}
 	if _, ok := _c.mutation.Role(); !ok {
 		v := user.DefaultRole
 		_c.mutation.SetRole(v)
 	}
+	if _, ok := _c.mutation.TotpEnabled(); !ok {
+		v := user.DefaultTotpEnabled
+		_c.mutation.SetTotpEnabled(v)
+	}
 	if _, ok := _c.mutation.UpdatedAt(); !ok {
 		v := user.DefaultUpdatedAt()
 		_c.mutation.SetUpdatedAt(v)
@@ -205,6 +377,9 @@ func (_c *UserCreate) check() error {
 	if _, ok := _c.mutation.Oauth(); !ok {
 		return &ValidationError{Name: "oauth", err: errors.New(`ent: missing required field "User.oauth"`)}
 	}
+	if _, ok := _c.mutation.Ldap(); !ok {
+		return &ValidationError{Name: "ldap", err: errors.New(`ent: missing required field "User.ldap"`)}
+	}
 	if _, ok := _c.mutation.Role(); !ok {
 		return &ValidationError{Name: "role", err: errors.New(`ent: missing required field "User.role"`)}
 	}
@@ -213,6 +388,9 @@ func (_c *UserCreate) check() error {
 			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "User.role": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.TotpEnabled(); !ok {
+		return &ValidationError{Name: "totp_enabled", err: errors.New(`ent: missing required field "User.totp_enabled"`)}
+	}
 	if _, ok := _c.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "User.updated_at"`)}
 	}
@@ -270,6 +448,10 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldOauth, field.TypeBool, value)
 		_node.Oauth = value
 	}
+	if value, ok := _c.mutation.Ldap(); ok {
+		_spec.SetField(user.FieldLdap, field.TypeBool, value)
+		_node.Ldap = value
+	}
 	if value, ok := _c.mutation.Role(); ok {
 		_spec.SetField(user.FieldRole, field.TypeEnum, value)
 		_node.Role = value
@@ -278,6 +460,26 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldWebhook, field.TypeString, value)
 		_node.Webhook = value
 	}
+	if value, ok := _c.mutation.Groups(); ok {
+		_spec.SetField(user.FieldGroups, field.TypeJSON, value)
+		_node.Groups = value
+	}
+	if value, ok := _c.mutation.TotpEnabled(); ok {
+		_spec.SetField(user.FieldTotpEnabled, field.TypeBool, value)
+		_node.TotpEnabled = value
+	}
+	if value, ok := _c.mutation.TotpSecret(); ok {
+		_spec.SetField(user.FieldTotpSecret, field.TypeString, value)
+		_node.TotpSecret = value
+	}
+	if value, ok := _c.mutation.TotpLastCounter(); ok {
+		_spec.SetField(user.FieldTotpLastCounter, field.TypeInt64, value)
+		_node.TotpLastCounter = value
+	}
+	if value, ok := _c.mutation.TotpRecoveryCodes(); ok {
+		_spec.SetField(user.FieldTotpRecoveryCodes, field.TypeJSON, value)
+		_node.TotpRecoveryCodes = value
+	}
 	if value, ok := _c.mutation.UpdatedAt(); ok {
 		_spec.SetField(user.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
@@ -286,6 +488,102 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
 	}
+	if nodes := _c.mutation.NotificationSubscriptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NotificationSubscriptionsTable,
+			Columns: []string{user.NotificationSubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationsubscription.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.APITokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.APITokensTable,
+			Columns: []string{user.APITokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apitoken.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AllowedChannelsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.AllowedChannelsTable,
+			Columns: user.AllowedChannelsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(channel.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AllowedPlaylistsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.AllowedPlaylistsTable,
+			Columns: user.AllowedPlaylistsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlist.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ShareLinksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ShareLinksTable,
+			Columns: []string{user.ShareLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sharelink.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.BulkOperationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BulkOperationsTable,
+			Columns: []string{user.BulkOperationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bulkoperation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
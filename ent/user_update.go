@@ -10,8 +10,16 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/apitoken"
+	"github.com/zibbp/ganymede/ent/bulkoperation"
+	"github.com/zibbp/ganymede/ent/channel"
+	"github.com/zibbp/ganymede/ent/notificationsubscription"
+	"github.com/zibbp/ganymede/ent/playlist"
 	"github.com/zibbp/ganymede/ent/predicate"
+	"github.com/zibbp/ganymede/ent/sharelink"
 	"github.com/zibbp/ganymede/ent/user"
 	"github.com/zibbp/ganymede/internal/utils"
 )
@@ -97,6 +105,20 @@ func (_u *UserUpdate) SetNillableOauth(v *bool) *UserUpdate {
 	return _u
 }
 
+// SetLdap sets the "ldap" field.
+func (_u *UserUpdate) SetLdap(v bool) *UserUpdate {
+	_u.mutation.SetLdap(v)
+	return _u
+}
+
+// SetNillableLdap sets the "ldap" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableLdap(v *bool) *UserUpdate {
+	if v != nil {
+		_u.SetLdap(*v)
+	}
+	return _u
+}
+
 // SetRole sets the "role" field.
 func (_u *UserUpdate) SetRole(v utils.Role) *UserUpdate {
 	_u.mutation.SetRole(v)
@@ -131,17 +153,330 @@ func (_u *UserUpdate) ClearWebhook() *UserUpdate {
 	return _u
 }
 
+// SetGroups sets the "groups" field.
+func (_u *UserUpdate) SetGroups(v []string) *UserUpdate {
+	_u.mutation.SetGroups(v)
+	return _u
+}
+
+// AppendGroups appends value to the "groups" field.
+func (_u *UserUpdate) AppendGroups(v []string) *UserUpdate {
+	_u.mutation.AppendGroups(v)
+	return _u
+}
+
+// ClearGroups clears the value of the "groups" field.
+func (_u *UserUpdate) ClearGroups() *UserUpdate {
+	_u.mutation.ClearGroups()
+	return _u
+}
+
+// SetTotpEnabled sets the "totp_enabled" field.
+func (_u *UserUpdate) SetTotpEnabled(v bool) *UserUpdate {
+	_u.mutation.SetTotpEnabled(v)
+	return _u
+}
+
+// SetNillableTotpEnabled sets the "totp_enabled" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableTotpEnabled(v *bool) *UserUpdate {
+	if v != nil {
+		_u.SetTotpEnabled(*v)
+	}
+	return _u
+}
+
+// SetTotpSecret sets the "totp_secret" field.
+func (_u *UserUpdate) SetTotpSecret(v string) *UserUpdate {
+	_u.mutation.SetTotpSecret(v)
+	return _u
+}
+
+// SetNillableTotpSecret sets the "totp_secret" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableTotpSecret(v *string) *UserUpdate {
+	if v != nil {
+		_u.SetTotpSecret(*v)
+	}
+	return _u
+}
+
+// ClearTotpSecret clears the value of the "totp_secret" field.
+func (_u *UserUpdate) ClearTotpSecret() *UserUpdate {
+	_u.mutation.ClearTotpSecret()
+	return _u
+}
+
+// SetTotpLastCounter sets the "totp_last_counter" field.
+func (_u *UserUpdate) SetTotpLastCounter(v int64) *UserUpdate {
+	_u.mutation.ResetTotpLastCounter()
+	_u.mutation.SetTotpLastCounter(v)
+	return _u
+}
+
+// SetNillableTotpLastCounter sets the "totp_last_counter" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableTotpLastCounter(v *int64) *UserUpdate {
+	if v != nil {
+		_u.SetTotpLastCounter(*v)
+	}
+	return _u
+}
+
+// AddTotpLastCounter adds value to the "totp_last_counter" field.
+func (_u *UserUpdate) AddTotpLastCounter(v int64) *UserUpdate {
+	_u.mutation.AddTotpLastCounter(v)
+	return _u
+}
+
+// ClearTotpLastCounter clears the value of the "totp_last_counter" field.
+func (_u *UserUpdate) ClearTotpLastCounter() *UserUpdate {
+	_u.mutation.ClearTotpLastCounter()
+	return _u
+}
+
+// SetTotpRecoveryCodes sets the "totp_recovery_codes" field.
+func (_u *UserUpdate) SetTotpRecoveryCodes(v []string) *UserUpdate {
+	_u.mutation.SetTotpRecoveryCodes(v)
+	return _u
+}
+
+// AppendTotpRecoveryCodes appends value to the "totp_recovery_codes" field.
+func (_u *UserUpdate) AppendTotpRecoveryCodes(v []string) *UserUpdate {
+	_u.mutation.AppendTotpRecoveryCodes(v)
+	return _u
+}
+
+// ClearTotpRecoveryCodes clears the value of the "totp_recovery_codes" field.
+func (_u *UserUpdate) ClearTotpRecoveryCodes() *UserUpdate {
+	_u.mutation.ClearTotpRecoveryCodes()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *UserUpdate) SetUpdatedAt(v time.Time) *UserUpdate {
 	_u.mutation.SetUpdatedAt(v)
 	return _u
 }
 
+// AddNotificationSubscriptionIDs adds the "notification_subscriptions" edge to the NotificationSubscription entity by IDs.
+func (_u *UserUpdate) AddNotificationSubscriptionIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.AddNotificationSubscriptionIDs(ids...)
+	return _u
+}
+
+// AddNotificationSubscriptions adds the "notification_subscriptions" edges to the NotificationSubscription entity.
+func (_u *UserUpdate) AddNotificationSubscriptions(v ...*NotificationSubscription) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddNotificationSubscriptionIDs(ids...)
+}
+
+// AddAPITokenIDs adds the "api_tokens" edge to the ApiToken entity by IDs.
+func (_u *UserUpdate) AddAPITokenIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.AddAPITokenIDs(ids...)
+	return _u
+}
+
+// AddAPITokens adds the "api_tokens" edges to the ApiToken entity.
+func (_u *UserUpdate) AddAPITokens(v ...*ApiToken) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAPITokenIDs(ids...)
+}
+
+// AddAllowedChannelIDs adds the "allowed_channels" edge to the Channel entity by IDs.
+func (_u *UserUpdate) AddAllowedChannelIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.AddAllowedChannelIDs(ids...)
+	return _u
+}
+
+// AddAllowedChannels adds the "allowed_channels" edges to the Channel entity.
+func (_u *UserUpdate) AddAllowedChannels(v ...*Channel) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAllowedChannelIDs(ids...)
+}
+
+// AddAllowedPlaylistIDs adds the "allowed_playlists" edge to the Playlist entity by IDs.
+func (_u *UserUpdate) AddAllowedPlaylistIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.AddAllowedPlaylistIDs(ids...)
+	return _u
+}
+
+// AddAllowedPlaylists adds the "allowed_playlists" edges to the Playlist entity.
+func (_u *UserUpdate) AddAllowedPlaylists(v ...*Playlist) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAllowedPlaylistIDs(ids...)
+}
+
+// AddShareLinkIDs adds the "share_links" edge to the ShareLink entity by IDs.
+func (_u *UserUpdate) AddShareLinkIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.AddShareLinkIDs(ids...)
+	return _u
+}
+
+// AddShareLinks adds the "share_links" edges to the ShareLink entity.
+func (_u *UserUpdate) AddShareLinks(v ...*ShareLink) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddShareLinkIDs(ids...)
+}
+
+// AddBulkOperationIDs adds the "bulk_operations" edge to the BulkOperation entity by IDs.
+func (_u *UserUpdate) AddBulkOperationIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.AddBulkOperationIDs(ids...)
+	return _u
+}
+
+// AddBulkOperations adds the "bulk_operations" edges to the BulkOperation entity.
+func (_u *UserUpdate) AddBulkOperations(v ...*BulkOperation) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBulkOperationIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_u *UserUpdate) Mutation() *UserMutation {
 	return _u.mutation
 }
 
+// ClearNotificationSubscriptions clears all "notification_subscriptions" edges to the NotificationSubscription entity.
+func (_u *UserUpdate) ClearNotificationSubscriptions() *UserUpdate {
+	_u.mutation.ClearNotificationSubscriptions()
+	return _u
+}
+
+// RemoveNotificationSubscriptionIDs removes the "notification_subscriptions" edge to NotificationSubscription entities by IDs.
+func (_u *UserUpdate) RemoveNotificationSubscriptionIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.RemoveNotificationSubscriptionIDs(ids...)
+	return _u
+}
+
+// RemoveNotificationSubscriptions removes "notification_subscriptions" edges to NotificationSubscription entities.
+func (_u *UserUpdate) RemoveNotificationSubscriptions(v ...*NotificationSubscription) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveNotificationSubscriptionIDs(ids...)
+}
+
+// ClearAPITokens clears all "api_tokens" edges to the ApiToken entity.
+func (_u *UserUpdate) ClearAPITokens() *UserUpdate {
+	_u.mutation.ClearAPITokens()
+	return _u
+}
+
+// RemoveAPITokenIDs removes the "api_tokens" edge to ApiToken entities by IDs.
+func (_u *UserUpdate) RemoveAPITokenIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.RemoveAPITokenIDs(ids...)
+	return _u
+}
+
+// RemoveAPITokens removes "api_tokens" edges to ApiToken entities.
+func (_u *UserUpdate) RemoveAPITokens(v ...*ApiToken) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAPITokenIDs(ids...)
+}
+
+// ClearAllowedChannels clears all "allowed_channels" edges to the Channel entity.
+func (_u *UserUpdate) ClearAllowedChannels() *UserUpdate {
+	_u.mutation.ClearAllowedChannels()
+	return _u
+}
+
+// RemoveAllowedChannelIDs removes the "allowed_channels" edge to Channel entities by IDs.
+func (_u *UserUpdate) RemoveAllowedChannelIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.RemoveAllowedChannelIDs(ids...)
+	return _u
+}
+
+// RemoveAllowedChannels removes "allowed_channels" edges to Channel entities.
+func (_u *UserUpdate) RemoveAllowedChannels(v ...*Channel) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAllowedChannelIDs(ids...)
+}
+
+// ClearAllowedPlaylists clears all "allowed_playlists" edges to the Playlist entity.
+func (_u *UserUpdate) ClearAllowedPlaylists() *UserUpdate {
+	_u.mutation.ClearAllowedPlaylists()
+	return _u
+}
+
+// RemoveAllowedPlaylistIDs removes the "allowed_playlists" edge to Playlist entities by IDs.
+func (_u *UserUpdate) RemoveAllowedPlaylistIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.RemoveAllowedPlaylistIDs(ids...)
+	return _u
+}
+
+// RemoveAllowedPlaylists removes "allowed_playlists" edges to Playlist entities.
+func (_u *UserUpdate) RemoveAllowedPlaylists(v ...*Playlist) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAllowedPlaylistIDs(ids...)
+}
+
+// ClearShareLinks clears all "share_links" edges to the ShareLink entity.
+func (_u *UserUpdate) ClearShareLinks() *UserUpdate {
+	_u.mutation.ClearShareLinks()
+	return _u
+}
+
+// RemoveShareLinkIDs removes the "share_links" edge to ShareLink entities by IDs.
+func (_u *UserUpdate) RemoveShareLinkIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.RemoveShareLinkIDs(ids...)
+	return _u
+}
+
+// RemoveShareLinks removes "share_links" edges to ShareLink entities.
+func (_u *UserUpdate) RemoveShareLinks(v ...*ShareLink) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveShareLinkIDs(ids...)
+}
+
+// ClearBulkOperations clears all "bulk_operations" edges to the BulkOperation entity.
+func (_u *UserUpdate) ClearBulkOperations() *UserUpdate {
+	_u.mutation.ClearBulkOperations()
+	return _u
+}
+
+// RemoveBulkOperationIDs removes the "bulk_operations" edge to BulkOperation entities by IDs.
+func (_u *UserUpdate) RemoveBulkOperationIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.RemoveBulkOperationIDs(ids...)
+	return _u
+}
+
+// RemoveBulkOperations removes "bulk_operations" edges to BulkOperation entities.
+func (_u *UserUpdate) RemoveBulkOperations(v ...*BulkOperation) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBulkOperationIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *UserUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -218,6 +553,9 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Oauth(); ok {
 		_spec.SetField(user.FieldOauth, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.Ldap(); ok {
+		_spec.SetField(user.FieldLdap, field.TypeBool, value)
+	}
 	if value, ok := _u.mutation.Role(); ok {
 		_spec.SetField(user.FieldRole, field.TypeEnum, value)
 	}
@@ -227,9 +565,319 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.WebhookCleared() {
 		_spec.ClearField(user.FieldWebhook, field.TypeString)
 	}
+	if value, ok := _u.mutation.Groups(); ok {
+		_spec.SetField(user.FieldGroups, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedGroups(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, user.FieldGroups, value)
+		})
+	}
+	if _u.mutation.GroupsCleared() {
+		_spec.ClearField(user.FieldGroups, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TotpEnabled(); ok {
+		_spec.SetField(user.FieldTotpEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.TotpSecret(); ok {
+		_spec.SetField(user.FieldTotpSecret, field.TypeString, value)
+	}
+	if _u.mutation.TotpSecretCleared() {
+		_spec.ClearField(user.FieldTotpSecret, field.TypeString)
+	}
+	if value, ok := _u.mutation.TotpLastCounter(); ok {
+		_spec.SetField(user.FieldTotpLastCounter, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTotpLastCounter(); ok {
+		_spec.AddField(user.FieldTotpLastCounter, field.TypeInt64, value)
+	}
+	if _u.mutation.TotpLastCounterCleared() {
+		_spec.ClearField(user.FieldTotpLastCounter, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.TotpRecoveryCodes(); ok {
+		_spec.SetField(user.FieldTotpRecoveryCodes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTotpRecoveryCodes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, user.FieldTotpRecoveryCodes, value)
+		})
+	}
+	if _u.mutation.TotpRecoveryCodesCleared() {
+		_spec.ClearField(user.FieldTotpRecoveryCodes, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(user.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if _u.mutation.NotificationSubscriptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NotificationSubscriptionsTable,
+			Columns: []string{user.NotificationSubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationsubscription.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedNotificationSubscriptionsIDs(); len(nodes) > 0 && !_u.mutation.NotificationSubscriptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NotificationSubscriptionsTable,
+			Columns: []string{user.NotificationSubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationsubscription.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.NotificationSubscriptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NotificationSubscriptionsTable,
+			Columns: []string{user.NotificationSubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationsubscription.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.APITokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.APITokensTable,
+			Columns: []string{user.APITokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apitoken.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAPITokensIDs(); len(nodes) > 0 && !_u.mutation.APITokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.APITokensTable,
+			Columns: []string{user.APITokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apitoken.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.APITokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.APITokensTable,
+			Columns: []string{user.APITokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apitoken.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AllowedChannelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.AllowedChannelsTable,
+			Columns: user.AllowedChannelsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(channel.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAllowedChannelsIDs(); len(nodes) > 0 && !_u.mutation.AllowedChannelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.AllowedChannelsTable,
+			Columns: user.AllowedChannelsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(channel.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AllowedChannelsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.AllowedChannelsTable,
+			Columns: user.AllowedChannelsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(channel.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AllowedPlaylistsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.AllowedPlaylistsTable,
+			Columns: user.AllowedPlaylistsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlist.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAllowedPlaylistsIDs(); len(nodes) > 0 && !_u.mutation.AllowedPlaylistsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.AllowedPlaylistsTable,
+			Columns: user.AllowedPlaylistsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlist.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AllowedPlaylistsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.AllowedPlaylistsTable,
+			Columns: user.AllowedPlaylistsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlist.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ShareLinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ShareLinksTable,
+			Columns: []string{user.ShareLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sharelink.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedShareLinksIDs(); len(nodes) > 0 && !_u.mutation.ShareLinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ShareLinksTable,
+			Columns: []string{user.ShareLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sharelink.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ShareLinksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ShareLinksTable,
+			Columns: []string{user.ShareLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sharelink.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BulkOperationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BulkOperationsTable,
+			Columns: []string{user.BulkOperationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bulkoperation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBulkOperationsIDs(); len(nodes) > 0 && !_u.mutation.BulkOperationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BulkOperationsTable,
+			Columns: []string{user.BulkOperationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bulkoperation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BulkOperationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BulkOperationsTable,
+			Columns: []string{user.BulkOperationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bulkoperation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{user.Label}
@@ -318,6 +966,20 @@ func (_u *UserUpdateOne) SetNillableOauth(v *bool) *UserUpdateOne {
 	return _u
 }
 
+// SetLdap sets the "ldap" field.
+func (_u *UserUpdateOne) SetLdap(v bool) *UserUpdateOne {
+	_u.mutation.SetLdap(v)
+	return _u
+}
+
+// SetNillableLdap sets the "ldap" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableLdap(v *bool) *UserUpdateOne {
+	if v != nil {
+		_u.SetLdap(*v)
+	}
+	return _u
+}
+
 // SetRole sets the "role" field.
 func (_u *UserUpdateOne) SetRole(v utils.Role) *UserUpdateOne {
 	_u.mutation.SetRole(v)
@@ -352,17 +1014,330 @@ func (_u *UserUpdateOne) ClearWebhook() *UserUpdateOne {
 	return _u
 }
 
+// SetGroups sets the "groups" field.
+func (_u *UserUpdateOne) SetGroups(v []string) *UserUpdateOne {
+	_u.mutation.SetGroups(v)
+	return _u
+}
+
+// AppendGroups appends value to the "groups" field.
+func (_u *UserUpdateOne) AppendGroups(v []string) *UserUpdateOne {
+	_u.mutation.AppendGroups(v)
+	return _u
+}
+
+// ClearGroups clears the value of the "groups" field.
+func (_u *UserUpdateOne) ClearGroups() *UserUpdateOne {
+	_u.mutation.ClearGroups()
+	return _u
+}
+
+// SetTotpEnabled sets the "totp_enabled" field.
+func (_u *UserUpdateOne) SetTotpEnabled(v bool) *UserUpdateOne {
+	_u.mutation.SetTotpEnabled(v)
+	return _u
+}
+
+// SetNillableTotpEnabled sets the "totp_enabled" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableTotpEnabled(v *bool) *UserUpdateOne {
+	if v != nil {
+		_u.SetTotpEnabled(*v)
+	}
+	return _u
+}
+
+// SetTotpSecret sets the "totp_secret" field.
+func (_u *UserUpdateOne) SetTotpSecret(v string) *UserUpdateOne {
+	_u.mutation.SetTotpSecret(v)
+	return _u
+}
+
+// SetNillableTotpSecret sets the "totp_secret" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableTotpSecret(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetTotpSecret(*v)
+	}
+	return _u
+}
+
+// ClearTotpSecret clears the value of the "totp_secret" field.
+func (_u *UserUpdateOne) ClearTotpSecret() *UserUpdateOne {
+	_u.mutation.ClearTotpSecret()
+	return _u
+}
+
+// SetTotpLastCounter sets the "totp_last_counter" field.
+func (_u *UserUpdateOne) SetTotpLastCounter(v int64) *UserUpdateOne {
+	_u.mutation.ResetTotpLastCounter()
+	_u.mutation.SetTotpLastCounter(v)
+	return _u
+}
+
+// SetNillableTotpLastCounter sets the "totp_last_counter" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableTotpLastCounter(v *int64) *UserUpdateOne {
+	if v != nil {
+		_u.SetTotpLastCounter(*v)
+	}
+	return _u
+}
+
+// AddTotpLastCounter adds value to the "totp_last_counter" field.
+func (_u *UserUpdateOne) AddTotpLastCounter(v int64) *UserUpdateOne {
+	_u.mutation.AddTotpLastCounter(v)
+	return _u
+}
+
+// ClearTotpLastCounter clears the value of the "totp_last_counter" field.
+func (_u *UserUpdateOne) ClearTotpLastCounter() *UserUpdateOne {
+	_u.mutation.ClearTotpLastCounter()
+	return _u
+}
+
+// SetTotpRecoveryCodes sets the "totp_recovery_codes" field.
+func (_u *UserUpdateOne) SetTotpRecoveryCodes(v []string) *UserUpdateOne {
+	_u.mutation.SetTotpRecoveryCodes(v)
+	return _u
+}
+
+// AppendTotpRecoveryCodes appends value to the "totp_recovery_codes" field.
+func (_u *UserUpdateOne) AppendTotpRecoveryCodes(v []string) *UserUpdateOne {
+	_u.mutation.AppendTotpRecoveryCodes(v)
+	return _u
+}
+
+// ClearTotpRecoveryCodes clears the value of the "totp_recovery_codes" field.
+func (_u *UserUpdateOne) ClearTotpRecoveryCodes() *UserUpdateOne {
+	_u.mutation.ClearTotpRecoveryCodes()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *UserUpdateOne) SetUpdatedAt(v time.Time) *UserUpdateOne {
 	_u.mutation.SetUpdatedAt(v)
 	return _u
 }
 
+// AddNotificationSubscriptionIDs adds the "notification_subscriptions" edge to the NotificationSubscription entity by IDs.
+func (_u *UserUpdateOne) AddNotificationSubscriptionIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.AddNotificationSubscriptionIDs(ids...)
+	return _u
+}
+
+// AddNotificationSubscriptions adds the "notification_subscriptions" edges to the NotificationSubscription entity.
+func (_u *UserUpdateOne) AddNotificationSubscriptions(v ...*NotificationSubscription) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddNotificationSubscriptionIDs(ids...)
+}
+
+// AddAPITokenIDs adds the "api_tokens" edge to the ApiToken entity by IDs.
+func (_u *UserUpdateOne) AddAPITokenIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.AddAPITokenIDs(ids...)
+	return _u
+}
+
+// AddAPITokens adds the "api_tokens" edges to the ApiToken entity.
+func (_u *UserUpdateOne) AddAPITokens(v ...*ApiToken) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAPITokenIDs(ids...)
+}
+
+// AddAllowedChannelIDs adds the "allowed_channels" edge to the Channel entity by IDs.
+func (_u *UserUpdateOne) AddAllowedChannelIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.AddAllowedChannelIDs(ids...)
+	return _u
+}
+
+// AddAllowedChannels adds the "allowed_channels" edges to the Channel entity.
+func (_u *UserUpdateOne) AddAllowedChannels(v ...*Channel) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAllowedChannelIDs(ids...)
+}
+
+// AddAllowedPlaylistIDs adds the "allowed_playlists" edge to the Playlist entity by IDs.
+func (_u *UserUpdateOne) AddAllowedPlaylistIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.AddAllowedPlaylistIDs(ids...)
+	return _u
+}
+
+// AddAllowedPlaylists adds the "allowed_playlists" edges to the Playlist entity.
+func (_u *UserUpdateOne) AddAllowedPlaylists(v ...*Playlist) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAllowedPlaylistIDs(ids...)
+}
+
+// AddShareLinkIDs adds the "share_links" edge to the ShareLink entity by IDs.
+func (_u *UserUpdateOne) AddShareLinkIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.AddShareLinkIDs(ids...)
+	return _u
+}
+
+// AddShareLinks adds the "share_links" edges to the ShareLink entity.
+func (_u *UserUpdateOne) AddShareLinks(v ...*ShareLink) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddShareLinkIDs(ids...)
+}
+
+// AddBulkOperationIDs adds the "bulk_operations" edge to the BulkOperation entity by IDs.
+func (_u *UserUpdateOne) AddBulkOperationIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.AddBulkOperationIDs(ids...)
+	return _u
+}
+
+// AddBulkOperations adds the "bulk_operations" edges to the BulkOperation entity.
+func (_u *UserUpdateOne) AddBulkOperations(v ...*BulkOperation) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBulkOperationIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_u *UserUpdateOne) Mutation() *UserMutation {
 	return _u.mutation
 }
 
+// ClearNotificationSubscriptions clears all "notification_subscriptions" edges to the NotificationSubscription entity.
+func (_u *UserUpdateOne) ClearNotificationSubscriptions() *UserUpdateOne {
+	_u.mutation.ClearNotificationSubscriptions()
+	return _u
+}
+
+// RemoveNotificationSubscriptionIDs removes the "notification_subscriptions" edge to NotificationSubscription entities by IDs.
+func (_u *UserUpdateOne) RemoveNotificationSubscriptionIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.RemoveNotificationSubscriptionIDs(ids...)
+	return _u
+}
+
+// RemoveNotificationSubscriptions removes "notification_subscriptions" edges to NotificationSubscription entities.
+func (_u *UserUpdateOne) RemoveNotificationSubscriptions(v ...*NotificationSubscription) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveNotificationSubscriptionIDs(ids...)
+}
+
+// ClearAPITokens clears all "api_tokens" edges to the ApiToken entity.
+func (_u *UserUpdateOne) ClearAPITokens() *UserUpdateOne {
+	_u.mutation.ClearAPITokens()
+	return _u
+}
+
+// RemoveAPITokenIDs removes the "api_tokens" edge to ApiToken entities by IDs.
+func (_u *UserUpdateOne) RemoveAPITokenIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.RemoveAPITokenIDs(ids...)
+	return _u
+}
+
+// RemoveAPITokens removes "api_tokens" edges to ApiToken entities.
+func (_u *UserUpdateOne) RemoveAPITokens(v ...*ApiToken) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAPITokenIDs(ids...)
+}
+
+// ClearAllowedChannels clears all "allowed_channels" edges to the Channel entity.
+func (_u *UserUpdateOne) ClearAllowedChannels() *UserUpdateOne {
+	_u.mutation.ClearAllowedChannels()
+	return _u
+}
+
+// RemoveAllowedChannelIDs removes the "allowed_channels" edge to Channel entities by IDs.
+func (_u *UserUpdateOne) RemoveAllowedChannelIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.RemoveAllowedChannelIDs(ids...)
+	return _u
+}
+
+// RemoveAllowedChannels removes "allowed_channels" edges to Channel entities.
+func (_u *UserUpdateOne) RemoveAllowedChannels(v ...*Channel) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAllowedChannelIDs(ids...)
+}
+
+// ClearAllowedPlaylists clears all "allowed_playlists" edges to the Playlist entity.
+func (_u *UserUpdateOne) ClearAllowedPlaylists() *UserUpdateOne {
+	_u.mutation.ClearAllowedPlaylists()
+	return _u
+}
+
+// RemoveAllowedPlaylistIDs removes the "allowed_playlists" edge to Playlist entities by IDs.
+func (_u *UserUpdateOne) RemoveAllowedPlaylistIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.RemoveAllowedPlaylistIDs(ids...)
+	return _u
+}
+
+// RemoveAllowedPlaylists removes "allowed_playlists" edges to Playlist entities.
+func (_u *UserUpdateOne) RemoveAllowedPlaylists(v ...*Playlist) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAllowedPlaylistIDs(ids...)
+}
+
+// ClearShareLinks clears all "share_links" edges to the ShareLink entity.
+func (_u *UserUpdateOne) ClearShareLinks() *UserUpdateOne {
+	_u.mutation.ClearShareLinks()
+	return _u
+}
+
+// RemoveShareLinkIDs removes the "share_links" edge to ShareLink entities by IDs.
+func (_u *UserUpdateOne) RemoveShareLinkIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.RemoveShareLinkIDs(ids...)
+	return _u
+}
+
+// RemoveShareLinks removes "share_links" edges to ShareLink entities.
+func (_u *UserUpdateOne) RemoveShareLinks(v ...*ShareLink) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveShareLinkIDs(ids...)
+}
+
+// ClearBulkOperations clears all "bulk_operations" edges to the BulkOperation entity.
+func (_u *UserUpdateOne) ClearBulkOperations() *UserUpdateOne {
+	_u.mutation.ClearBulkOperations()
+	return _u
+}
+
+// RemoveBulkOperationIDs removes the "bulk_operations" edge to BulkOperation entities by IDs.
+func (_u *UserUpdateOne) RemoveBulkOperationIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.RemoveBulkOperationIDs(ids...)
+	return _u
+}
+
+// RemoveBulkOperations removes "bulk_operations" edges to BulkOperation entities.
+func (_u *UserUpdateOne) RemoveBulkOperations(v ...*BulkOperation) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBulkOperationIDs(ids...)
+}
+
 // Where appends a list predicates to the UserUpdate builder.
 func (_u *UserUpdateOne) Where(ps ...predicate.User) *UserUpdateOne {
 	_u.mutation.Where(ps...)
@@ -469,6 +1444,9 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 	if value, ok := _u.mutation.Oauth(); ok {
 		_spec.SetField(user.FieldOauth, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.Ldap(); ok {
+		_spec.SetField(user.FieldLdap, field.TypeBool, value)
+	}
 	if value, ok := _u.mutation.Role(); ok {
 		_spec.SetField(user.FieldRole, field.TypeEnum, value)
 	}
@@ -478,9 +1456,319 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 	if _u.mutation.WebhookCleared() {
 		_spec.ClearField(user.FieldWebhook, field.TypeString)
 	}
+	if value, ok := _u.mutation.Groups(); ok {
+		_spec.SetField(user.FieldGroups, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedGroups(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, user.FieldGroups, value)
+		})
+	}
+	if _u.mutation.GroupsCleared() {
+		_spec.ClearField(user.FieldGroups, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TotpEnabled(); ok {
+		_spec.SetField(user.FieldTotpEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.TotpSecret(); ok {
+		_spec.SetField(user.FieldTotpSecret, field.TypeString, value)
+	}
+	if _u.mutation.TotpSecretCleared() {
+		_spec.ClearField(user.FieldTotpSecret, field.TypeString)
+	}
+	if value, ok := _u.mutation.TotpLastCounter(); ok {
+		_spec.SetField(user.FieldTotpLastCounter, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTotpLastCounter(); ok {
+		_spec.AddField(user.FieldTotpLastCounter, field.TypeInt64, value)
+	}
+	if _u.mutation.TotpLastCounterCleared() {
+		_spec.ClearField(user.FieldTotpLastCounter, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.TotpRecoveryCodes(); ok {
+		_spec.SetField(user.FieldTotpRecoveryCodes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTotpRecoveryCodes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, user.FieldTotpRecoveryCodes, value)
+		})
+	}
+	if _u.mutation.TotpRecoveryCodesCleared() {
+		_spec.ClearField(user.FieldTotpRecoveryCodes, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(user.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if _u.mutation.NotificationSubscriptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NotificationSubscriptionsTable,
+			Columns: []string{user.NotificationSubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationsubscription.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedNotificationSubscriptionsIDs(); len(nodes) > 0 && !_u.mutation.NotificationSubscriptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NotificationSubscriptionsTable,
+			Columns: []string{user.NotificationSubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationsubscription.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.NotificationSubscriptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NotificationSubscriptionsTable,
+			Columns: []string{user.NotificationSubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationsubscription.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.APITokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.APITokensTable,
+			Columns: []string{user.APITokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apitoken.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAPITokensIDs(); len(nodes) > 0 && !_u.mutation.APITokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.APITokensTable,
+			Columns: []string{user.APITokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apitoken.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.APITokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.APITokensTable,
+			Columns: []string{user.APITokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apitoken.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AllowedChannelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.AllowedChannelsTable,
+			Columns: user.AllowedChannelsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(channel.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAllowedChannelsIDs(); len(nodes) > 0 && !_u.mutation.AllowedChannelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.AllowedChannelsTable,
+			Columns: user.AllowedChannelsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(channel.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AllowedChannelsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.AllowedChannelsTable,
+			Columns: user.AllowedChannelsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(channel.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AllowedPlaylistsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.AllowedPlaylistsTable,
+			Columns: user.AllowedPlaylistsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlist.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAllowedPlaylistsIDs(); len(nodes) > 0 && !_u.mutation.AllowedPlaylistsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.AllowedPlaylistsTable,
+			Columns: user.AllowedPlaylistsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlist.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AllowedPlaylistsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.AllowedPlaylistsTable,
+			Columns: user.AllowedPlaylistsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlist.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ShareLinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ShareLinksTable,
+			Columns: []string{user.ShareLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sharelink.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedShareLinksIDs(); len(nodes) > 0 && !_u.mutation.ShareLinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ShareLinksTable,
+			Columns: []string{user.ShareLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sharelink.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ShareLinksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ShareLinksTable,
+			Columns: []string{user.ShareLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sharelink.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BulkOperationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BulkOperationsTable,
+			Columns: []string{user.BulkOperationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bulkoperation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBulkOperationsIDs(); len(nodes) > 0 && !_u.mutation.BulkOperationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BulkOperationsTable,
+			Columns: []string{user.BulkOperationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bulkoperation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BulkOperationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BulkOperationsTable,
+			Columns: []string{user.BulkOperationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bulkoperation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &User{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -105,6 +105,10 @@ type Vod struct {
 	StorageSizeBytes int64 `json:"storage_size_bytes,omitempty"`
 	// The time the VOD was streamed.
 	StreamedAt time.Time `json:"streamed_at,omitempty"`
+	// The time the VOD was moved to the trash, deleted VODs are hidden until restored or purged.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// Folder the VOD's files were moved to when deleted, empty if the files were kept in place.
+	TrashPath string `json:"trash_path,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
@@ -132,9 +136,15 @@ type VodEdges struct {
 	MultistreamInfo []*MultistreamInfo `json:"multistream_info,omitempty"`
 	// YoutubeUpload holds the value of the youtube_upload edge.
 	YoutubeUpload *YoutubeUpload `json:"youtube_upload,omitempty"`
+	// ShareLinks holds the value of the share_links edge.
+	ShareLinks []*ShareLink `json:"share_links,omitempty"`
+	// Tags holds the value of the tags edge.
+	Tags []*Tag `json:"tags,omitempty"`
+	// PlaylistEntries holds the value of the playlist_entries edge.
+	PlaylistEntries []*PlaylistVod `json:"playlist_entries,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [7]bool
+	loadedTypes [10]bool
 }
 
 // ChannelOrErr returns the Channel value or an error if the edge
@@ -206,6 +216,33 @@ func (e VodEdges) YoutubeUploadOrErr() (*YoutubeUpload, error) {
 	return nil, &NotLoadedError{edge: "youtube_upload"}
 }
 
+// ShareLinksOrErr returns the ShareLinks value or an error if the edge
+// was not loaded in eager-loading.
+func (e VodEdges) ShareLinksOrErr() ([]*ShareLink, error) {
+	if e.loadedTypes[7] {
+		return e.ShareLinks, nil
+	}
+	return nil, &NotLoadedError{edge: "share_links"}
+}
+
+// TagsOrErr returns the Tags value or an error if the edge
+// was not loaded in eager-loading.
+func (e VodEdges) TagsOrErr() ([]*Tag, error) {
+	if e.loadedTypes[8] {
+		return e.Tags, nil
+	}
+	return nil, &NotLoadedError{edge: "tags"}
+}
+
+// PlaylistEntriesOrErr returns the PlaylistEntries value or an error if the edge
+// was not loaded in eager-loading.
+func (e VodEdges) PlaylistEntriesOrErr() ([]*PlaylistVod, error) {
+	if e.loadedTypes[9] {
+		return e.PlaylistEntries, nil
+	}
+	return nil, &NotLoadedError{edge: "playlist_entries"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Vod) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -217,9 +254,9 @@ func (*Vod) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullBool)
 		case vod.FieldDuration, vod.FieldClipVodOffset, vod.FieldViews, vod.FieldLocalViews, vod.FieldSpriteThumbnailsInterval, vod.FieldSpriteThumbnailsWidth, vod.FieldSpriteThumbnailsHeight, vod.FieldSpriteThumbnailsRows, vod.FieldSpriteThumbnailsColumns, vod.FieldStorageSizeBytes:
 			values[i] = new(sql.NullInt64)
-		case vod.FieldExtID, vod.FieldClipExtVodID, vod.FieldExtStreamID, vod.FieldPlatform, vod.FieldType, vod.FieldTitle, vod.FieldResolution, vod.FieldThumbnailPath, vod.FieldWebThumbnailPath, vod.FieldVideoPath, vod.FieldVideoHlsPath, vod.FieldChatPath, vod.FieldLiveChatPath, vod.FieldLiveChatConvertPath, vod.FieldChatVideoPath, vod.FieldInfoPath, vod.FieldCaptionPath, vod.FieldFolderName, vod.FieldFileName, vod.FieldTmpVideoDownloadPath, vod.FieldTmpVideoConvertPath, vod.FieldTmpChatDownloadPath, vod.FieldTmpLiveChatDownloadPath, vod.FieldTmpLiveChatConvertPath, vod.FieldTmpChatRenderPath, vod.FieldTmpVideoHlsPath:
+		case vod.FieldExtID, vod.FieldClipExtVodID, vod.FieldExtStreamID, vod.FieldPlatform, vod.FieldType, vod.FieldTitle, vod.FieldResolution, vod.FieldThumbnailPath, vod.FieldWebThumbnailPath, vod.FieldVideoPath, vod.FieldVideoHlsPath, vod.FieldChatPath, vod.FieldLiveChatPath, vod.FieldLiveChatConvertPath, vod.FieldChatVideoPath, vod.FieldInfoPath, vod.FieldCaptionPath, vod.FieldFolderName, vod.FieldFileName, vod.FieldTmpVideoDownloadPath, vod.FieldTmpVideoConvertPath, vod.FieldTmpChatDownloadPath, vod.FieldTmpLiveChatDownloadPath, vod.FieldTmpLiveChatConvertPath, vod.FieldTmpChatRenderPath, vod.FieldTmpVideoHlsPath, vod.FieldTrashPath:
 			values[i] = new(sql.NullString)
-		case vod.FieldStreamedAt, vod.FieldUpdatedAt, vod.FieldCreatedAt:
+		case vod.FieldStreamedAt, vod.FieldDeletedAt, vod.FieldUpdatedAt, vod.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
 		case vod.FieldID:
 			values[i] = new(uuid.UUID)
@@ -494,6 +531,19 @@ func (_m *Vod) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.StreamedAt = value.Time
 			}
+		case vod.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
+		case vod.FieldTrashPath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field trash_path", values[i])
+			} else if value.Valid {
+				_m.TrashPath = value.String
+			}
 		case vod.FieldUpdatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
@@ -561,6 +611,21 @@ func (_m *Vod) QueryYoutubeUpload() *YoutubeUploadQuery {
 	return NewVodClient(_m.config).QueryYoutubeUpload(_m)
 }
 
+// QueryShareLinks queries the "share_links" edge of the Vod entity.
+func (_m *Vod) QueryShareLinks() *ShareLinkQuery {
+	return NewVodClient(_m.config).QueryShareLinks(_m)
+}
+
+// QueryTags queries the "tags" edge of the Vod entity.
+func (_m *Vod) QueryTags() *TagQuery {
+	return NewVodClient(_m.config).QueryTags(_m)
+}
+
+// QueryPlaylistEntries queries the "playlist_entries" edge of the Vod entity.
+func (_m *Vod) QueryPlaylistEntries() *PlaylistVodQuery {
+	return NewVodClient(_m.config).QueryPlaylistEntries(_m)
+}
+
 // Update returns a builder for updating this Vod.
 // Note that you need to call Vod.Unwrap() before calling this method if this Vod
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -707,6 +772,14 @@ func (_m *Vod) String() string {
 	builder.WriteString("streamed_at=")
 	builder.WriteString(_m.StreamedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("trash_path=")
+	builder.WriteString(_m.TrashPath)
+	builder.WriteString(", ")
 	builder.WriteString("updated_at=")
 	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -99,6 +99,10 @@ const (
 	FieldStorageSizeBytes = "storage_size_bytes"
 	// FieldStreamedAt holds the string denoting the streamed_at field in the database.
 	FieldStreamedAt = "streamed_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldTrashPath holds the string denoting the trash_path field in the database.
+	FieldTrashPath = "trash_path"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
@@ -117,6 +121,12 @@ const (
 	EdgeMultistreamInfo = "multistream_info"
 	// EdgeYoutubeUpload holds the string denoting the youtube_upload edge name in mutations.
 	EdgeYoutubeUpload = "youtube_upload"
+	// EdgeShareLinks holds the string denoting the share_links edge name in mutations.
+	EdgeShareLinks = "share_links"
+	// EdgeTags holds the string denoting the tags edge name in mutations.
+	EdgeTags = "tags"
+	// EdgePlaylistEntries holds the string denoting the playlist_entries edge name in mutations.
+	EdgePlaylistEntries = "playlist_entries"
 	// Table holds the table name of the vod in the database.
 	Table = "vods"
 	// ChannelTable is the table that holds the channel relation/edge.
@@ -166,6 +176,25 @@ const (
 	YoutubeUploadInverseTable = "youtube_uploads"
 	// YoutubeUploadColumn is the table column denoting the youtube_upload relation/edge.
 	YoutubeUploadColumn = "vod_youtube_upload"
+	// ShareLinksTable is the table that holds the share_links relation/edge.
+	ShareLinksTable = "share_links"
+	// ShareLinksInverseTable is the table name for the ShareLink entity.
+	// It exists in this package in order to avoid circular dependency with the "sharelink" package.
+	ShareLinksInverseTable = "share_links"
+	// ShareLinksColumn is the table column denoting the share_links relation/edge.
+	ShareLinksColumn = "vod_share_links"
+	// TagsTable is the table that holds the tags relation/edge. The primary key declared below.
+	TagsTable = "tag_vods"
+	// TagsInverseTable is the table name for the Tag entity.
+	// It exists in this package in order to avoid circular dependency with the "tag" package.
+	TagsInverseTable = "tags"
+	// PlaylistEntriesTable is the table that holds the playlist_entries relation/edge.
+	PlaylistEntriesTable = "playlist_vods"
+	// PlaylistEntriesInverseTable is the table name for the PlaylistVod entity.
+	// It exists in this package in order to avoid circular dependency with the "playlistvod" package.
+	PlaylistEntriesInverseTable = "playlist_vods"
+	// PlaylistEntriesColumn is the table column denoting the playlist_entries relation/edge.
+	PlaylistEntriesColumn = "vod_id"
 )
 
 // Columns holds all SQL columns for vod fields.
@@ -212,6 +241,8 @@ var Columns = []string{
 	FieldSpriteThumbnailsColumns,
 	FieldStorageSizeBytes,
 	FieldStreamedAt,
+	FieldDeletedAt,
+	FieldTrashPath,
 	FieldUpdatedAt,
 	FieldCreatedAt,
 }
@@ -226,6 +257,9 @@ var (
 	// PlaylistsPrimaryKey and PlaylistsColumn2 are the table columns denoting the
 	// primary key for the playlists relation (M2M).
 	PlaylistsPrimaryKey = []string{"playlist_id", "vod_id"}
+	// TagsPrimaryKey and TagsColumn2 are the table columns denoting the
+	// primary key for the tags relation (M2M).
+	TagsPrimaryKey = []string{"tag_id", "vod_id"}
 )
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -502,6 +536,16 @@ func ByStreamedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStreamedAt, opts...).ToFunc()
 }
 
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByTrashPath orders the results by the trash_path field.
+func ByTrashPath(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrashPath, opts...).ToFunc()
+}
+
 // ByUpdatedAt orders the results by the updated_at field.
 func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
@@ -588,6 +632,48 @@ func ByYoutubeUploadField(field string, opts ...sql.OrderTermOption) OrderOption
 		sqlgraph.OrderByNeighborTerms(s, newYoutubeUploadStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByShareLinksCount orders the results by share_links count.
+func ByShareLinksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newShareLinksStep(), opts...)
+	}
+}
+
+// ByShareLinks orders the results by share_links terms.
+func ByShareLinks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newShareLinksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTagsCount orders the results by tags count.
+func ByTagsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTagsStep(), opts...)
+	}
+}
+
+// ByTags orders the results by tags terms.
+func ByTags(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTagsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByPlaylistEntriesCount orders the results by playlist_entries count.
+func ByPlaylistEntriesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPlaylistEntriesStep(), opts...)
+	}
+}
+
+// ByPlaylistEntries orders the results by playlist_entries terms.
+func ByPlaylistEntries(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPlaylistEntriesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newChannelStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -637,3 +723,24 @@ func newYoutubeUploadStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2O, false, YoutubeUploadTable, YoutubeUploadColumn),
 	)
 }
+func newShareLinksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ShareLinksInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ShareLinksTable, ShareLinksColumn),
+	)
+}
+func newTagsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TagsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, TagsTable, TagsPrimaryKey...),
+	)
+}
+func newPlaylistEntriesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PlaylistEntriesInverseTable, PlaylistEntriesColumn),
+		sqlgraph.Edge(sqlgraph.O2M, true, PlaylistEntriesTable, PlaylistEntriesColumn),
+	)
+}
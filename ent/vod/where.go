@@ -247,6 +247,16 @@ func StreamedAt(v time.Time) predicate.Vod {
 	return predicate.Vod(sql.FieldEQ(FieldStreamedAt, v))
 }
 
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.Vod {
+	return predicate.Vod(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// TrashPath applies equality check predicate on the "trash_path" field. It's identical to TrashPathEQ.
+func TrashPath(v string) predicate.Vod {
+	return predicate.Vod(sql.FieldEQ(FieldTrashPath, v))
+}
+
 // UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
 func UpdatedAt(v time.Time) predicate.Vod {
 	return predicate.Vod(sql.FieldEQ(FieldUpdatedAt, v))
@@ -2617,6 +2627,131 @@ func StreamedAtLTE(v time.Time) predicate.Vod {
 	return predicate.Vod(sql.FieldLTE(FieldStreamedAt, v))
 }
 
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.Vod {
+	return predicate.Vod(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.Vod {
+	return predicate.Vod(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.Vod {
+	return predicate.Vod(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.Vod {
+	return predicate.Vod(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.Vod {
+	return predicate.Vod(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.Vod {
+	return predicate.Vod(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.Vod {
+	return predicate.Vod(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.Vod {
+	return predicate.Vod(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Vod {
+	return predicate.Vod(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Vod {
+	return predicate.Vod(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// TrashPathEQ applies the EQ predicate on the "trash_path" field.
+func TrashPathEQ(v string) predicate.Vod {
+	return predicate.Vod(sql.FieldEQ(FieldTrashPath, v))
+}
+
+// TrashPathNEQ applies the NEQ predicate on the "trash_path" field.
+func TrashPathNEQ(v string) predicate.Vod {
+	return predicate.Vod(sql.FieldNEQ(FieldTrashPath, v))
+}
+
+// TrashPathIn applies the In predicate on the "trash_path" field.
+func TrashPathIn(vs ...string) predicate.Vod {
+	return predicate.Vod(sql.FieldIn(FieldTrashPath, vs...))
+}
+
+// TrashPathNotIn applies the NotIn predicate on the "trash_path" field.
+func TrashPathNotIn(vs ...string) predicate.Vod {
+	return predicate.Vod(sql.FieldNotIn(FieldTrashPath, vs...))
+}
+
+// TrashPathGT applies the GT predicate on the "trash_path" field.
+func TrashPathGT(v string) predicate.Vod {
+	return predicate.Vod(sql.FieldGT(FieldTrashPath, v))
+}
+
+// TrashPathGTE applies the GTE predicate on the "trash_path" field.
+func TrashPathGTE(v string) predicate.Vod {
+	return predicate.Vod(sql.FieldGTE(FieldTrashPath, v))
+}
+
+// TrashPathLT applies the LT predicate on the "trash_path" field.
+func TrashPathLT(v string) predicate.Vod {
+	return predicate.Vod(sql.FieldLT(FieldTrashPath, v))
+}
+
+// TrashPathLTE applies the LTE predicate on the "trash_path" field.
+func TrashPathLTE(v string) predicate.Vod {
+	return predicate.Vod(sql.FieldLTE(FieldTrashPath, v))
+}
+
+// TrashPathContains applies the Contains predicate on the "trash_path" field.
+func TrashPathContains(v string) predicate.Vod {
+	return predicate.Vod(sql.FieldContains(FieldTrashPath, v))
+}
+
+// TrashPathHasPrefix applies the HasPrefix predicate on the "trash_path" field.
+func TrashPathHasPrefix(v string) predicate.Vod {
+	return predicate.Vod(sql.FieldHasPrefix(FieldTrashPath, v))
+}
+
+// TrashPathHasSuffix applies the HasSuffix predicate on the "trash_path" field.
+func TrashPathHasSuffix(v string) predicate.Vod {
+	return predicate.Vod(sql.FieldHasSuffix(FieldTrashPath, v))
+}
+
+// TrashPathIsNil applies the IsNil predicate on the "trash_path" field.
+func TrashPathIsNil() predicate.Vod {
+	return predicate.Vod(sql.FieldIsNull(FieldTrashPath))
+}
+
+// TrashPathNotNil applies the NotNil predicate on the "trash_path" field.
+func TrashPathNotNil() predicate.Vod {
+	return predicate.Vod(sql.FieldNotNull(FieldTrashPath))
+}
+
+// TrashPathEqualFold applies the EqualFold predicate on the "trash_path" field.
+func TrashPathEqualFold(v string) predicate.Vod {
+	return predicate.Vod(sql.FieldEqualFold(FieldTrashPath, v))
+}
+
+// TrashPathContainsFold applies the ContainsFold predicate on the "trash_path" field.
+func TrashPathContainsFold(v string) predicate.Vod {
+	return predicate.Vod(sql.FieldContainsFold(FieldTrashPath, v))
+}
+
 // UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
 func UpdatedAtEQ(v time.Time) predicate.Vod {
 	return predicate.Vod(sql.FieldEQ(FieldUpdatedAt, v))
@@ -2858,6 +2993,75 @@ func HasYoutubeUploadWith(preds ...predicate.YoutubeUpload) predicate.Vod {
 	})
 }
 
+// HasShareLinks applies the HasEdge predicate on the "share_links" edge.
+func HasShareLinks() predicate.Vod {
+	return predicate.Vod(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ShareLinksTable, ShareLinksColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasShareLinksWith applies the HasEdge predicate on the "share_links" edge with a given conditions (other predicates).
+func HasShareLinksWith(preds ...predicate.ShareLink) predicate.Vod {
+	return predicate.Vod(func(s *sql.Selector) {
+		step := newShareLinksStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTags applies the HasEdge predicate on the "tags" edge.
+func HasTags() predicate.Vod {
+	return predicate.Vod(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, TagsTable, TagsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTagsWith applies the HasEdge predicate on the "tags" edge with a given conditions (other predicates).
+func HasTagsWith(preds ...predicate.Tag) predicate.Vod {
+	return predicate.Vod(func(s *sql.Selector) {
+		step := newTagsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPlaylistEntries applies the HasEdge predicate on the "playlist_entries" edge.
+func HasPlaylistEntries() predicate.Vod {
+	return predicate.Vod(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, PlaylistEntriesTable, PlaylistEntriesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPlaylistEntriesWith applies the HasEdge predicate on the "playlist_entries" edge with a given conditions (other predicates).
+func HasPlaylistEntriesWith(preds ...predicate.PlaylistVod) predicate.Vod {
+	return predicate.Vod(func(s *sql.Selector) {
+		step := newPlaylistEntriesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Vod) predicate.Vod {
 	return predicate.Vod(sql.AndPredicates(predicates...))
@@ -17,6 +17,8 @@ import (
 	"github.com/zibbp/ganymede/ent/mutedsegment"
 	"github.com/zibbp/ganymede/ent/playlist"
 	"github.com/zibbp/ganymede/ent/queue"
+	"github.com/zibbp/ganymede/ent/sharelink"
+	"github.com/zibbp/ganymede/ent/tag"
 	"github.com/zibbp/ganymede/ent/vod"
 	"github.com/zibbp/ganymede/ent/youtubeupload"
 	"github.com/zibbp/ganymede/internal/utils"
@@ -563,6 +565,34 @@ func (_c *VodCreate) SetNillableStreamedAt(v *time.Time) *VodCreate {
 	return _c
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *VodCreate) SetDeletedAt(v time.Time) *VodCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *VodCreate) SetNillableDeletedAt(v *time.Time) *VodCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetTrashPath sets the "trash_path" field.
+func (_c *VodCreate) SetTrashPath(v string) *VodCreate {
+	_c.mutation.SetTrashPath(v)
+	return _c
+}
+
+// SetNillableTrashPath sets the "trash_path" field if the given value is not nil.
+func (_c *VodCreate) SetNillableTrashPath(v *string) *VodCreate {
+	if v != nil {
+		_c.SetTrashPath(*v)
+	}
+	return _c
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_c *VodCreate) SetUpdatedAt(v time.Time) *VodCreate {
 	_c.mutation.SetUpdatedAt(v)
@@ -714,6 +744,36 @@ func (_c *VodCreate) SetYoutubeUpload(v *YoutubeUpload) *VodCreate {
 	return _c.SetYoutubeUploadID(v.ID)
 }
 
+// AddShareLinkIDs adds the "share_links" edge to the ShareLink entity by IDs.
+func (_c *VodCreate) AddShareLinkIDs(ids ...uuid.UUID) *VodCreate {
+	_c.mutation.AddShareLinkIDs(ids...)
+	return _c
+}
+
+// AddShareLinks adds the "share_links" edges to the ShareLink entity.
+func (_c *VodCreate) AddShareLinks(v ...*ShareLink) *VodCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddShareLinkIDs(ids...)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (_c *VodCreate) AddTagIDs(ids ...uuid.UUID) *VodCreate {
+	_c.mutation.AddTagIDs(ids...)
+	return _c
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (_c *VodCreate) AddTags(v ...*Tag) *VodCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddTagIDs(ids...)
+}
+
 // Mutation returns the VodMutation object of the builder.
 func (_c *VodCreate) Mutation() *VodMutation {
 	return _c.mutation
@@ -1065,6 +1125,14 @@ func (_c *VodCreate) createSpec() (*Vod, *sqlgraph.CreateSpec) {
 		_spec.SetField(vod.FieldStreamedAt, field.TypeTime, value)
 		_node.StreamedAt = value
 	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(vod.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.TrashPath(); ok {
+		_spec.SetField(vod.FieldTrashPath, field.TypeString, value)
+		_node.TrashPath = value
+	}
 	if value, ok := _c.mutation.UpdatedAt(); ok {
 		_spec.SetField(vod.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
@@ -1120,6 +1188,10 @@ func (_c *VodCreate) createSpec() (*Vod, *sqlgraph.CreateSpec) {
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		createE := &PlaylistVodCreate{config: _c.config, mutation: newPlaylistVodMutation(_c.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
 		_spec.Edges = append(_spec.Edges, edge)
 	}
 	if nodes := _c.mutation.ChaptersIDs(); len(nodes) > 0 {
@@ -1186,6 +1258,38 @@ func (_c *VodCreate) createSpec() (*Vod, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.ShareLinksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vod.ShareLinksTable,
+			Columns: []string{vod.ShareLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sharelink.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   vod.TagsTable,
+			Columns: vod.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -18,8 +18,11 @@ import (
 	"github.com/zibbp/ganymede/ent/multistreaminfo"
 	"github.com/zibbp/ganymede/ent/mutedsegment"
 	"github.com/zibbp/ganymede/ent/playlist"
+	"github.com/zibbp/ganymede/ent/playlistvod"
 	"github.com/zibbp/ganymede/ent/predicate"
 	"github.com/zibbp/ganymede/ent/queue"
+	"github.com/zibbp/ganymede/ent/sharelink"
+	"github.com/zibbp/ganymede/ent/tag"
 	"github.com/zibbp/ganymede/ent/vod"
 	"github.com/zibbp/ganymede/ent/youtubeupload"
 )
@@ -38,6 +41,9 @@ type VodQuery struct {
 	withMutedSegments   *MutedSegmentQuery
 	withMultistreamInfo *MultistreamInfoQuery
 	withYoutubeUpload   *YoutubeUploadQuery
+	withShareLinks      *ShareLinkQuery
+	withTags            *TagQuery
+	withPlaylistEntries *PlaylistVodQuery
 	withFKs             bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -229,6 +235,72 @@ func (_q *VodQuery) QueryYoutubeUpload() *YoutubeUploadQuery {
 	return query
 }
 
+// QueryShareLinks chains the current query on the "share_links" edge.
+func (_q *VodQuery) QueryShareLinks() *ShareLinkQuery {
+	query := (&ShareLinkClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(vod.Table, vod.FieldID, selector),
+			sqlgraph.To(sharelink.Table, sharelink.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, vod.ShareLinksTable, vod.ShareLinksColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTags chains the current query on the "tags" edge.
+func (_q *VodQuery) QueryTags() *TagQuery {
+	query := (&TagClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(vod.Table, vod.FieldID, selector),
+			sqlgraph.To(tag.Table, tag.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, vod.TagsTable, vod.TagsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPlaylistEntries chains the current query on the "playlist_entries" edge.
+func (_q *VodQuery) QueryPlaylistEntries() *PlaylistVodQuery {
+	query := (&PlaylistVodClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(vod.Table, vod.FieldID, selector),
+			sqlgraph.To(playlistvod.Table, playlistvod.VodColumn),
+			sqlgraph.Edge(sqlgraph.O2M, true, vod.PlaylistEntriesTable, vod.PlaylistEntriesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Vod entity from the query.
 // Returns a *NotFoundError when no Vod was found.
 func (_q *VodQuery) First(ctx context.Context) (*Vod, error) {
@@ -428,6 +500,9 @@ func (_q *VodQuery) Clone() *VodQuery {
 		withMutedSegments:   _q.withMutedSegments.Clone(),
 		withMultistreamInfo: _q.withMultistreamInfo.Clone(),
 		withYoutubeUpload:   _q.withYoutubeUpload.Clone(),
+		withShareLinks:      _q.withShareLinks.Clone(),
+		withTags:            _q.withTags.Clone(),
+		withPlaylistEntries: _q.withPlaylistEntries.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -511,6 +586,39 @@ func (_q *VodQuery) WithYoutubeUpload(opts ...func(*YoutubeUploadQuery)) *VodQue
 	return _q
 }
 
+// WithShareLinks tells the query-builder to eager-load the nodes that are connected to
+// the "share_links" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *VodQuery) WithShareLinks(opts ...func(*ShareLinkQuery)) *VodQuery {
+	query := (&ShareLinkClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withShareLinks = query
+	return _q
+}
+
+// WithTags tells the query-builder to eager-load the nodes that are connected to
+// the "tags" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *VodQuery) WithTags(opts ...func(*TagQuery)) *VodQuery {
+	query := (&TagClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTags = query
+	return _q
+}
+
+// WithPlaylistEntries tells the query-builder to eager-load the nodes that are connected to
+// the "playlist_entries" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *VodQuery) WithPlaylistEntries(opts ...func(*PlaylistVodQuery)) *VodQuery {
+	query := (&PlaylistVodClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPlaylistEntries = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -590,7 +698,7 @@ func (_q *VodQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Vod, err
 		nodes       = []*Vod{}
 		withFKs     = _q.withFKs
 		_spec       = _q.querySpec()
-		loadedTypes = [7]bool{
+		loadedTypes = [10]bool{
 			_q.withChannel != nil,
 			_q.withQueue != nil,
 			_q.withPlaylists != nil,
@@ -598,6 +706,9 @@ func (_q *VodQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Vod, err
 			_q.withMutedSegments != nil,
 			_q.withMultistreamInfo != nil,
 			_q.withYoutubeUpload != nil,
+			_q.withShareLinks != nil,
+			_q.withTags != nil,
+			_q.withPlaylistEntries != nil,
 		}
 	)
 	if _q.withChannel != nil {
@@ -670,6 +781,27 @@ func (_q *VodQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Vod, err
 			return nil, err
 		}
 	}
+	if query := _q.withShareLinks; query != nil {
+		if err := _q.loadShareLinks(ctx, query, nodes,
+			func(n *Vod) { n.Edges.ShareLinks = []*ShareLink{} },
+			func(n *Vod, e *ShareLink) { n.Edges.ShareLinks = append(n.Edges.ShareLinks, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withTags; query != nil {
+		if err := _q.loadTags(ctx, query, nodes,
+			func(n *Vod) { n.Edges.Tags = []*Tag{} },
+			func(n *Vod, e *Tag) { n.Edges.Tags = append(n.Edges.Tags, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withPlaylistEntries; query != nil {
+		if err := _q.loadPlaylistEntries(ctx, query, nodes,
+			func(n *Vod) { n.Edges.PlaylistEntries = []*PlaylistVod{} },
+			func(n *Vod, e *PlaylistVod) { n.Edges.PlaylistEntries = append(n.Edges.PlaylistEntries, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -915,6 +1047,128 @@ func (_q *VodQuery) loadYoutubeUpload(ctx context.Context, query *YoutubeUploadQ
 	}
 	return nil
 }
+func (_q *VodQuery) loadShareLinks(ctx context.Context, query *ShareLinkQuery, nodes []*Vod, init func(*Vod), assign func(*Vod, *ShareLink)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Vod)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.ShareLink(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(vod.ShareLinksColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.vod_share_links
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "vod_share_links" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "vod_share_links" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *VodQuery) loadTags(ctx context.Context, query *TagQuery, nodes []*Vod, init func(*Vod), assign func(*Vod, *Tag)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[uuid.UUID]*Vod)
+	nids := make(map[uuid.UUID]map[*Vod]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(vod.TagsTable)
+		s.Join(joinT).On(s.C(tag.FieldID), joinT.C(vod.TagsPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(vod.TagsPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(vod.TagsPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(uuid.UUID)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := *values[0].(*uuid.UUID)
+				inValue := *values[1].(*uuid.UUID)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Vod]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Tag](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "tags" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (_q *VodQuery) loadPlaylistEntries(ctx context.Context, query *PlaylistVodQuery, nodes []*Vod, init func(*Vod), assign func(*Vod, *PlaylistVod)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Vod)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(playlistvod.FieldVodID)
+	}
+	query.Where(predicate.PlaylistVod(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(vod.PlaylistEntriesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.VodID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "vod_id" returned %v for node %v`, fk, n)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *VodQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -0,0 +1,126 @@
+// Package acl limits the channels, playlists and vods a user can view based on their visibility.
+package acl
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/ent/channel"
+	"github.com/zibbp/ganymede/ent/playlist"
+	"github.com/zibbp/ganymede/ent/predicate"
+	"github.com/zibbp/ganymede/ent/tag"
+	"github.com/zibbp/ganymede/ent/user"
+	"github.com/zibbp/ganymede/ent/vod"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// Access is who can view a channel or playlist.
+type Access struct {
+	Visibility utils.Visibility
+	UserIDs    []uuid.UUID // users that can view it if the visibility is restricted
+	Groups     []string    // user groups that can view it if the visibility is restricted
+}
+
+type viewerKey struct{}
+
+// viewer is the user requests are made by, User is nil for anonymous requests.
+type viewer struct {
+	User *ent.User
+}
+
+// WithViewer returns a context whose queries are limited to what the user can view. A nil user is an anonymous viewer that can only view public content.
+func WithViewer(ctx context.Context, u *ent.User) context.Context {
+	return context.WithValue(ctx, viewerKey{}, viewer{User: u})
+}
+
+// viewerFromContext returns the viewer of the context. Contexts without a viewer, such as background tasks, are not limited.
+func viewerFromContext(ctx context.Context) (*ent.User, bool) {
+	v, ok := ctx.Value(viewerKey{}).(viewer)
+	return v.User, ok
+}
+
+// CanViewAll returns true if the user bypasses visibility. Editors and admins manage every channel and playlist so they can view all of them.
+func CanViewAll(u *ent.User) bool {
+	return u != nil && u.Role.HasRole(utils.EditorRole)
+}
+
+// Channels returns a predicate matching the channels the viewer of the context can view. Channels in the trash are hidden from every viewer.
+func Channels(ctx context.Context) predicate.Channel {
+	u, ok := viewerFromContext(ctx)
+	if !ok {
+		return func(*sql.Selector) {}
+	}
+	if CanViewAll(u) {
+		return channel.DeletedAtIsNil()
+	}
+	if u == nil {
+		return channel.And(channel.DeletedAtIsNil(), channel.VisibilityEQ(utils.VisibilityPublic))
+	}
+	return channel.And(
+		channel.DeletedAtIsNil(),
+		channel.Or(
+			channel.VisibilityIn(utils.VisibilityPublic, utils.VisibilityAuthenticated),
+			channel.And(
+				channel.VisibilityEQ(utils.VisibilityRestricted),
+				channel.Or(channel.HasAllowedUsersWith(user.ID(u.ID)), inAllowedGroups(channel.FieldAllowedGroups, u.Groups)),
+			),
+		),
+	)
+}
+
+// Playlists returns a predicate matching the playlists the viewer of the context can view.
+func Playlists(ctx context.Context) predicate.Playlist {
+	u, ok := viewerFromContext(ctx)
+	if !ok || CanViewAll(u) {
+		return func(*sql.Selector) {}
+	}
+	if u == nil {
+		return playlist.VisibilityEQ(utils.VisibilityPublic)
+	}
+	return playlist.Or(
+		playlist.VisibilityIn(utils.VisibilityPublic, utils.VisibilityAuthenticated),
+		playlist.And(
+			playlist.VisibilityEQ(utils.VisibilityRestricted),
+			playlist.Or(playlist.HasAllowedUsersWith(user.ID(u.ID)), inAllowedGroups(playlist.FieldAllowedGroups, u.Groups)),
+		),
+	)
+}
+
+// Vods returns a predicate matching the vods the viewer of the context can view. Vods have the visibility of their channel and vods in the trash are hidden from every viewer.
+func Vods(ctx context.Context) predicate.Vod {
+	u, ok := viewerFromContext(ctx)
+	if !ok {
+		return func(*sql.Selector) {}
+	}
+	if CanViewAll(u) {
+		return vod.DeletedAtIsNil()
+	}
+	return vod.And(vod.DeletedAtIsNil(), vod.HasChannelWith(Channels(ctx)))
+}
+
+// Tags returns a predicate matching the tags of vods the viewer of the context can view. Editors and admins also see unused tags so they can manage them.
+func Tags(ctx context.Context) predicate.Tag {
+	u, ok := viewerFromContext(ctx)
+	if !ok || CanViewAll(u) {
+		return func(*sql.Selector) {}
+	}
+	return tag.HasVodsWith(Vods(ctx))
+}
+
+// inAllowedGroups matches rows whose allowed groups column contains any of the groups.
+func inAllowedGroups(field string, groups []string) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		predicates := make([]*sql.Predicate, 0, len(groups))
+		for _, group := range groups {
+			predicates = append(predicates, sqljson.ValueContains(s.C(field), group))
+		}
+		if len(predicates) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.Or(predicates...))
+	}
+}
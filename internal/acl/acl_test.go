@@ -0,0 +1,76 @@
+package acl
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/ent/channel"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// channelQuery renders the query of the channels the viewer of the context can view.
+func channelQuery(ctx context.Context) (string, []any) {
+	s := sql.Dialect(dialect.Postgres).Select("*").From(sql.Table(channel.Table))
+	Channels(ctx)(s)
+	return s.Query()
+}
+
+func TestChannels(t *testing.T) {
+	member := &ent.User{ID: uuid.New(), Role: utils.UserRole, Groups: []string{"community-a", "community-b"}}
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		contains   []string
+		unfiltered bool
+	}{
+		{name: "no viewer", ctx: context.Background(), unfiltered: true},
+		{name: "editor", ctx: WithViewer(context.Background(), &ent.User{Role: utils.EditorRole}), contains: []string{`"channels"."deleted_at" IS NULL`}},
+		{name: "anonymous", ctx: WithViewer(context.Background(), nil), contains: []string{`"channels"."deleted_at" IS NULL`, `"channels"."visibility" = $1`}},
+		{name: "user", ctx: WithViewer(context.Background(), member), contains: []string{`"visibility" IN ($1, $2)`, `"channel_allowed_users"."user_id"`, `"allowed_groups" @> $5`, `"allowed_groups" @> $6`}},
+		{name: "user without groups", ctx: WithViewer(context.Background(), &ent.User{ID: uuid.New(), Role: utils.ArchiverRole}), contains: []string{"FALSE"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, args := channelQuery(tt.ctx)
+			if tt.unfiltered {
+				if strings.Contains(query, "WHERE") {
+					t.Errorf("expected no filter, got %s", query)
+				}
+				return
+			}
+			for _, expected := range tt.contains {
+				if !strings.Contains(query, expected) {
+					t.Errorf("query %s does not contain %s", query, expected)
+				}
+			}
+			if len(args) > 0 && args[0] != utils.VisibilityPublic {
+				t.Errorf("unexpected first argument %v", args[0])
+			}
+		})
+	}
+}
+
+func TestCanViewAll(t *testing.T) {
+	tests := []struct {
+		user     *ent.User
+		expected bool
+	}{
+		{user: nil, expected: false},
+		{user: &ent.User{Role: utils.UserRole}, expected: false},
+		{user: &ent.User{Role: utils.ArchiverRole}, expected: false},
+		{user: &ent.User{Role: utils.EditorRole}, expected: true},
+		{user: &ent.User{Role: utils.AdminRole}, expected: true},
+	}
+	for _, tt := range tests {
+		if got := CanViewAll(tt.user); got != tt.expected {
+			t.Errorf("CanViewAll(%v) = %v, want %v", tt.user, got, tt.expected)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package archive
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -12,10 +13,12 @@ import (
 	"github.com/zibbp/ganymede/internal/channel"
 	"github.com/zibbp/ganymede/internal/config"
 	"github.com/zibbp/ganymede/internal/database"
+	"github.com/zibbp/ganymede/internal/diskguard"
 	"github.com/zibbp/ganymede/internal/platform"
 	"github.com/zibbp/ganymede/internal/queue"
 	"github.com/zibbp/ganymede/internal/tasks"
 	tasks_client "github.com/zibbp/ganymede/internal/tasks/client"
+	"github.com/zibbp/ganymede/internal/trash"
 	"github.com/zibbp/ganymede/internal/utils"
 	"github.com/zibbp/ganymede/internal/vod"
 )
@@ -60,6 +63,9 @@ func (s *Service) ArchiveChannel(ctx context.Context, channelName string) (*ent.
 	// Check if channel exists in DB
 	cCheck := s.ChannelService.CheckChannelExists(platformChannel.Login)
 	if cCheck {
+		if err := s.ChannelService.CheckChannelInTrash(platformChannel.Login); err != nil {
+			return nil, err
+		}
 		return nil, fmt.Errorf("channel already exists")
 	}
 
@@ -105,6 +111,10 @@ func (s *Service) ArchiveVideo(ctx context.Context, input ArchiveVideoInput) (*A
 
 	envConfig := config.GetEnvConfig()
 
+	if err := diskguard.CheckArchive(); err != nil {
+		return nil, err
+	}
+
 	// check if video is blocked
 	blocked, err := s.BlockedVodsService.IsVideoBlocked(ctx, input.VideoId)
 	if err != nil {
@@ -127,6 +137,9 @@ func (s *Service) ArchiveVideo(ctx context.Context, input ArchiveVideoInput) (*A
 
 	// Check if video is already archived
 	vCheck, err := s.VodService.CheckVodExists(video.ID)
+	if errors.Is(err, trash.ErrVodInTrash) {
+		return nil, err
+	}
 	if err != nil {
 		return nil, fmt.Errorf("error checking if vod exists: %v", err)
 	}
@@ -136,7 +149,11 @@ func (s *Service) ArchiveVideo(ctx context.Context, input ArchiveVideoInput) (*A
 
 	// Check if channel exists
 	cCheck := s.ChannelService.CheckChannelExists(video.UserLogin)
-	if !cCheck {
+	if cCheck {
+		if err := s.ChannelService.CheckChannelInTrash(video.UserLogin); err != nil {
+			return nil, err
+		}
+	} else {
 		log.Debug().Msgf("channel does not exist: %s while archiving vod. creating now.", video.UserLogin)
 		_, err := s.ArchiveChannel(ctx, video.UserLogin)
 		if err != nil {
@@ -309,6 +326,10 @@ func (s *Service) ArchiveClip(ctx context.Context, input ArchiveClipInput) (*Arc
 
 	envConfig := config.GetEnvConfig()
 
+	if err := diskguard.CheckArchive(); err != nil {
+		return nil, err
+	}
+
 	// check if video is blocked
 	blocked, err := s.BlockedVodsService.IsVideoBlocked(ctx, input.ID)
 	if err != nil {
@@ -326,6 +347,9 @@ func (s *Service) ArchiveClip(ctx context.Context, input ArchiveClipInput) (*Arc
 
 	// Check if video is already archived
 	vCheck, err := s.VodService.CheckVodExists(clip.ID)
+	if errors.Is(err, trash.ErrVodInTrash) {
+		return nil, err
+	}
 	if err != nil {
 		return nil, fmt.Errorf("error checking if clip exists: %v", err)
 	}
@@ -335,7 +359,11 @@ func (s *Service) ArchiveClip(ctx context.Context, input ArchiveClipInput) (*Arc
 
 	// Check if channel exists
 	cCheck := s.ChannelService.CheckChannelExistsByExtId(clip.ChannelID)
-	if !cCheck {
+	if cCheck {
+		if err := s.ChannelService.CheckChannelInTrashByExtId(clip.ChannelID); err != nil {
+			return nil, err
+		}
+	} else {
 		log.Debug().Msg("channel does not exist: %s while archiving clip. creating now")
 		_, err := s.ArchiveChannel(ctx, *clip.ChannelName)
 		if err != nil {
@@ -501,6 +529,10 @@ func (s *Service) ArchiveClip(ctx context.Context, input ArchiveClipInput) (*Arc
 func (s *Service) ArchiveLivestream(ctx context.Context, input ArchiveVideoInput) (*ArchiveResponse, error) {
 	envConfig := config.GetEnvConfig()
 
+	if err := diskguard.CheckLiveArchive(); err != nil {
+		return nil, err
+	}
+
 	channel, err := s.ChannelService.GetChannel(input.ChannelId)
 	if err != nil {
 		return nil, fmt.Errorf("error fetching channel: %v", err)
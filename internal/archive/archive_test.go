@@ -171,12 +171,20 @@ func TestArchiveVideo(t *testing.T) {
 	err = app.VodService.DeleteVod(t.Context(), v.ID, true)
 	assert.NoError(t, err)
 
-	// Assert video directory is removed
+	// Assert video directory is moved to the trash
 	_, err = os.Stat(videoDirectory)
 	assert.Error(t, err)
 	if !os.IsNotExist(err) {
 		t.Fatalf("Expected video directory %s to be removed, but it still exists: %v", videoDirectory, err)
 	}
+	v, err = app.Database.Client.Vod.Get(context.Background(), v.ID)
+	assert.NoError(t, err)
+	assert.NotNil(t, v.DeletedAt)
+	assert.DirExists(t, v.TrashPath)
+
+	err = app.TrashService.PurgeVod(t.Context(), v.ID)
+	assert.NoError(t, err)
+	assert.NoDirExists(t, v.TrashPath)
 
 	// Assert video was deleted from database
 	_, err = app.Database.Client.Vod.Query().Where(vod.ID(v.ID)).Only(context.Background())
@@ -437,12 +445,20 @@ func TestArchiveVideoHLS(t *testing.T) {
 	err = app.VodService.DeleteVod(t.Context(), v.ID, true)
 	assert.NoError(t, err)
 
-	// Assert video directory is removed
+	// Assert video directory is moved to the trash
 	_, err = os.Stat(videoDirectory)
 	assert.Error(t, err)
 	if !os.IsNotExist(err) {
 		t.Fatalf("Expected video directory %s to be removed, but it still exists: %v", videoDirectory, err)
 	}
+	v, err = app.Database.Client.Vod.Get(context.Background(), v.ID)
+	assert.NoError(t, err)
+	assert.NotNil(t, v.DeletedAt)
+	assert.DirExists(t, v.TrashPath)
+
+	err = app.TrashService.PurgeVod(t.Context(), v.ID)
+	assert.NoError(t, err)
+	assert.NoDirExists(t, v.TrashPath)
 
 	// Assert video was deleted from database
 	_, err = app.Database.Client.Vod.Query().Where(vod.ID(v.ID)).Only(context.Background())
@@ -0,0 +1,270 @@
+// Package audit records who made administrative and destructive changes.
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"github.com/zibbp/ganymede/ent"
+	entAuditLog "github.com/zibbp/ganymede/ent/auditlog"
+	"github.com/zibbp/ganymede/internal/database"
+)
+
+// Actions recorded in the audit log.
+const (
+	ActionVodDelete            = "vod.delete"
+	ActionVodRestore           = "vod.restore"
+	ActionVodPurge             = "vod.purge"
+	ActionVodBulk              = "vod.bulk"
+	ActionChannelDelete        = "channel.delete"
+	ActionChannelRestore       = "channel.restore"
+	ActionChannelPurge         = "channel.purge"
+	ActionConfigUpdate         = "config.update"
+	ActionUserUpdate           = "user.update"
+	ActionUserDelete           = "user.delete"
+	ActionQueueStop            = "queue.stop"
+	ActionBlockedVideoCreate   = "blocked_video.create"
+	ActionBlockedVideoDelete   = "blocked_video.delete"
+	ActionPlaylistRulesUpdate  = "playlist.rules_update"
+	ActionTagDelete            = "tag.delete"
+	ActionTaskStart            = "task.start"
+	ActionWatchedChannelCreate = "watched_channel.create"
+	ActionWatchedChannelUpdate = "watched_channel.update"
+	ActionWatchedChannelDelete = "watched_channel.delete"
+)
+
+// redacted replaces the values of fields that may contain secrets.
+const redacted = "[redacted]"
+
+// secretFields are parts of field names whose values are not written to the audit log. URLs of notification providers and proxies can contain credentials.
+var secretFields = []string{"token", "password", "secret", "webhook", "url", "proxy_parameters"}
+
+type Service struct {
+	Store *database.Database
+}
+
+func NewService(store *database.Database) *Service {
+	return &Service{Store: store}
+}
+
+// Entry is a change to record. Before and After are the resource before and after the change, nil if it was created or deleted. Only fields that differ are stored.
+type Entry struct {
+	Action       string
+	ResourceType string
+	ResourceID   string
+	Before       any
+	After        any
+}
+
+// Actor is who requests are made by.
+type Actor struct {
+	UserID   uuid.UUID
+	Username string
+	IP       string
+}
+
+type actorKey struct{}
+
+// WithActor returns a context whose changes are recorded as made by the actor.
+func WithActor(ctx context.Context, actor Actor) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// Record writes the change to the audit log with the actor of the context. Errors are logged and not returned so failing to write the log does not fail the change that was already made.
+func Record(ctx context.Context, store *database.Database, entry Entry) {
+	before, after, err := Diff(entry.Before, entry.After)
+	if err != nil {
+		log.Error().Err(err).Str("action", entry.Action).Msg("error creating audit log diff")
+	}
+
+	create := store.Client.AuditLog.Create().
+		SetAction(entry.Action).
+		SetResourceType(entry.ResourceType).
+		SetResourceID(entry.ResourceID).
+		SetBefore(before).
+		SetAfter(after)
+	if actor, ok := ctx.Value(actorKey{}).(Actor); ok {
+		create.SetActorID(actor.UserID).SetActorUsername(actor.Username).SetIP(actor.IP)
+	}
+
+	if err := create.Exec(ctx); err != nil {
+		log.Error().Err(err).Str("action", entry.Action).Str("resource_id", entry.ResourceID).Msg("error writing audit log")
+	}
+}
+
+// Filter limits the entries returned by GetAuditLogs, zero values match every entry.
+type Filter struct {
+	Action       string
+	ResourceType string
+	ResourceID   string
+	ActorID      uuid.UUID
+	From         time.Time
+	To           time.Time
+	Limit        int
+	Offset       int
+}
+
+type Pagination struct {
+	Offset     int             `json:"offset"`
+	Limit      int             `json:"limit"`
+	TotalCount int             `json:"total_count"`
+	Pages      int             `json:"pages"`
+	Data       []*ent.AuditLog `json:"data"`
+}
+
+// GetAuditLogs returns the entries matching the filter, newest first.
+func (s *Service) GetAuditLogs(ctx context.Context, filter Filter) (Pagination, error) {
+	query := s.Store.Client.AuditLog.Query()
+	if filter.Action != "" {
+		query = query.Where(entAuditLog.Action(filter.Action))
+	}
+	if filter.ResourceType != "" {
+		query = query.Where(entAuditLog.ResourceType(filter.ResourceType))
+	}
+	if filter.ResourceID != "" {
+		query = query.Where(entAuditLog.ResourceID(filter.ResourceID))
+	}
+	if filter.ActorID != uuid.Nil {
+		query = query.Where(entAuditLog.ActorID(filter.ActorID))
+	}
+	if !filter.From.IsZero() {
+		query = query.Where(entAuditLog.CreatedAtGTE(filter.From))
+	}
+	if !filter.To.IsZero() {
+		query = query.Where(entAuditLog.CreatedAtLTE(filter.To))
+	}
+
+	totalCount, err := query.Clone().Count(ctx)
+	if err != nil {
+		return Pagination{}, fmt.Errorf("error counting audit logs: %v", err)
+	}
+
+	logs, err := query.
+		Order(ent.Desc(entAuditLog.FieldCreatedAt)).
+		Limit(filter.Limit).
+		Offset(filter.Offset).
+		All(ctx)
+	if err != nil {
+		return Pagination{}, fmt.Errorf("error getting audit logs: %v", err)
+	}
+
+	return Pagination{
+		Offset:     filter.Offset,
+		Limit:      filter.Limit,
+		TotalCount: totalCount,
+		Pages:      (totalCount + filter.Limit - 1) / filter.Limit,
+		Data:       logs,
+	}, nil
+}
+
+// PruneAuditLogs deletes entries older than the retention, a retention of 0 days keeps entries forever.
+func PruneAuditLogs(ctx context.Context, store *database.Database, retentionDays int) (int, error) {
+	if retentionDays <= 0 {
+		return 0, nil
+	}
+	deleted, err := store.Client.AuditLog.Delete().
+		Where(entAuditLog.CreatedAtLT(time.Now().AddDate(0, 0, -retentionDays))).
+		Exec(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("error pruning audit logs: %v", err)
+	}
+	return deleted, nil
+}
+
+// Diff returns the fields of before and after that differ. Nested objects, and objects in arrays, are flattened to dotted field names and fields that may contain secrets are redacted.
+func Diff(before, after any) (map[string]any, map[string]any, error) {
+	beforeFields, err := flatten(before)
+	if err != nil {
+		return nil, nil, err
+	}
+	afterFields, err := flatten(after)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	beforeDiff := map[string]any{}
+	afterDiff := map[string]any{}
+	for field, value := range beforeFields {
+		if afterValue, ok := afterFields[field]; !ok || !reflect.DeepEqual(value, afterValue) {
+			beforeDiff[field] = redact(field, value)
+		}
+	}
+	for field, value := range afterFields {
+		if beforeValue, ok := beforeFields[field]; !ok || !reflect.DeepEqual(value, beforeValue) {
+			afterDiff[field] = redact(field, value)
+		}
+	}
+	return beforeDiff, afterDiff, nil
+}
+
+// flatten marshals the value to JSON and returns its fields with nested object fields joined by dots. Values that are not objects are returned as the "value" field.
+func flatten(v any) (map[string]any, error) {
+	fields := map[string]any{}
+	if v == nil {
+		return fields, nil
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling audit log value: %v", err)
+	}
+	var decoded any
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return nil, fmt.Errorf("error unmarshalling audit log value: %v", err)
+	}
+
+	object, ok := decoded.(map[string]any)
+	if !ok {
+		if decoded != nil {
+			fields["value"] = decoded
+		}
+		return fields, nil
+	}
+	flattenInto(fields, "", object)
+	return fields, nil
+}
+
+func flattenInto(fields map[string]any, prefix string, object map[string]any) {
+	for key, value := range object {
+		if nested, ok := value.(map[string]any); ok && len(nested) > 0 {
+			flattenInto(fields, prefix+key+".", nested)
+			continue
+		}
+		// elements of arrays of objects, e.g. notification providers, are flattened by index so their secrets are redacted
+		if elements, ok := value.([]any); ok && containsObject(elements) {
+			for i, element := range elements {
+				flattenInto(fields, prefix, map[string]any{fmt.Sprintf("%s.%d", key, i): element})
+			}
+			continue
+		}
+		fields[prefix+key] = value
+	}
+}
+
+func containsObject(elements []any) bool {
+	for _, element := range elements {
+		if _, ok := element.(map[string]any); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func redact(field string, value any) any {
+	field = strings.ToLower(field)
+	for _, secret := range secretFields {
+		if strings.Contains(field, secret) {
+			if value == nil || value == "" {
+				return value
+			}
+			return redacted
+		}
+	}
+	return value
+}
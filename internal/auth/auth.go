@@ -3,7 +3,6 @@ package auth
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/coreos/go-oidc/v3/oidc"
 	"github.com/google/uuid"
@@ -13,7 +12,6 @@ import (
 	"github.com/zibbp/ganymede/internal/config"
 	"github.com/zibbp/ganymede/internal/database"
 	"github.com/zibbp/ganymede/internal/user"
-	"github.com/zibbp/ganymede/internal/utils"
 	"golang.org/x/crypto/bcrypt"
 	"golang.org/x/oauth2"
 )
@@ -31,6 +29,15 @@ func NewService(store *database.Database, envConfig *config.EnvConfig) *Service
 	ctx := context.Background()
 	env := config.GetEnvConfig()
 
+	if env.LDAPEnabled && (env.LDAPURL == "" || env.LDAPUserSearchBase == "") {
+		log.Fatal().Msg("missing environment variables for ldap authentication")
+	}
+	if env.LDAPEnabled {
+		if err := checkLDAPTransport(env); err != nil {
+			log.Fatal().Err(err).Msg("invalid ldap configuration")
+		}
+	}
+
 	if env.OAuthEnabled {
 		// Fetch environment variables
 		providerURL := env.OAuthProviderURL
@@ -82,6 +89,18 @@ func (s *Service) Register(ctx context.Context, user user.User) (*ent.User, erro
 	if !config.Get().RegistrationEnabled {
 		return nil, fmt.Errorf("registration is disabled")
 	}
+	// the username would otherwise block the LDAP user from logging in
+	if env := config.GetEnvConfig(); env.LDAPEnabled {
+		exists, err := ldapUserExists(env, user.Username)
+		if err != nil {
+			log.Error().Err(err).Str("username", user.Username).Msg("error checking ldap user")
+			return nil, fmt.Errorf("error creating user")
+		}
+		if exists {
+			return nil, ErrLDAPUsernameTaken
+		}
+	}
+
 	// hash password
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(user.Password), 14)
 	if err != nil {
@@ -98,8 +117,15 @@ func (s *Service) Register(ctx context.Context, user user.User) (*ent.User, erro
 	return u, nil
 }
 
+// Login verifies the password of a local user. Users unknown locally, and users created by LDAP, are authenticated against the LDAP directory if it is enabled.
 func (s *Service) Login(ctx context.Context, uDto user.User) (*ent.User, error) {
 	u, err := s.Store.Client.User.Query().Where(entUser.Username(uDto.Username)).Only(ctx)
+
+	env := config.GetEnvConfig()
+	if env.LDAPEnabled && ((err != nil && ent.IsNotFound(err)) || (err == nil && u.Ldap)) {
+		return s.ldapLogin(ctx, env, uDto.Username, uDto.Password)
+	}
+
 	if err != nil {
 		return nil, fmt.Errorf("invalid credentials")
 	}
@@ -146,6 +172,9 @@ func (s *Service) ChangePassword(ctx context.Context, userId uuid.UUID, oldPassw
 	if err != nil {
 		return fmt.Errorf("error getting user: %v", err)
 	}
+	if u.Ldap {
+		return fmt.Errorf("password is managed by the LDAP directory")
+	}
 
 	// validate old password is correct
 	err = bcrypt.CompareHashAndPassword([]byte(u.Password), []byte(oldPassword))
@@ -166,10 +195,18 @@ func (s *Service) ChangePassword(ctx context.Context, userId uuid.UUID, oldPassw
 	return nil
 }
 
-// OAuthUserCheck checks if the user from an OIDC flow needs to be created or updated.
+// OAuthUserCheck checks if the user from an OIDC flow needs to be created or updated. The role is mapped from the claims on every login so demotions in the identity provider apply too.
 func (s *Service) OAuthUserCheck(ctx context.Context, userClaims OIDCCLaims) (*ent.User, error) {
 	log.Debug().Msgf("Checking if OAuth user exists: %v", userClaims.PreferredUsername)
 
+	// Determine role from claims
+	role, err := mapOIDCRole(config.Get().Auth, userClaims)
+	if err != nil {
+		log.Warn().Str("username", userClaims.PreferredUsername).Msg("denied OAuth login without a mapped role")
+		return nil, err
+	}
+	log.Debug().Str("username", userClaims.PreferredUsername).Str("role", string(role)).Msg("mapped OAuth user role")
+
 	// Check if user exists
 	user, err := s.Store.Client.User.Query().Where(entUser.Sub(userClaims.Sub)).Only(ctx)
 	if err != nil {
@@ -179,49 +216,25 @@ func (s *Service) OAuthUserCheck(ctx context.Context, userClaims OIDCCLaims) (*e
 
 		log.Debug().Msgf("OAuth user not found, creating user: %v", userClaims.PreferredUsername)
 
-		// Determine role from groups
-		role := utils.UserRole
-		for _, group := range userClaims.Groups {
-			if strings.HasPrefix(group, "ganymede-") {
-				groupRole := strings.TrimPrefix(group, "ganymede-")
-				if utils.IsValidRole(groupRole) {
-					log.Debug().Msgf("Found Ganymede role in user group %v", group)
-					role = utils.Role(groupRole)
-					break
-				}
-			}
-		}
-
 		// Create new user
-		if _, err := s.Store.Client.User.Create().
+		user, err := s.Store.Client.User.Create().
 			SetSub(userClaims.Sub).
 			SetUsername(userClaims.PreferredUsername).
 			SetRole(role).
 			SetOauth(true).
-			Save(ctx); err != nil {
+			Save(ctx)
+		if err != nil {
 			return nil, fmt.Errorf("failed to create user: %w", err)
 		}
 		return user, nil
 	}
 
-	// Determine role from groups
-	newRole := utils.UserRole
-	for _, group := range userClaims.Groups {
-		if strings.HasPrefix(group, "ganymede-") {
-			groupRole := strings.TrimPrefix(group, "ganymede-")
-			if utils.IsValidRole(groupRole) {
-				log.Debug().Msgf("Found Ganymede role in user group %v", group)
-				newRole = utils.Role(groupRole)
-				break
-			}
-		}
-	}
-
 	// Update existing user
-	if _, err := s.Store.Client.User.UpdateOne(user).
+	user, err = s.Store.Client.User.UpdateOne(user).
 		SetUsername(userClaims.PreferredUsername).
-		SetRole(newRole).
-		Save(ctx); err != nil {
+		SetRole(role).
+		Save(ctx)
+	if err != nil {
 		return nil, fmt.Errorf("failed to update user: %w", err)
 	}
 
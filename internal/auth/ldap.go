@@ -0,0 +1,224 @@
+package auth
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/go-ldap/ldap/v3"
+	"github.com/rs/zerolog/log"
+	"github.com/zibbp/ganymede/ent"
+	entUser "github.com/zibbp/ganymede/ent/user"
+	"github.com/zibbp/ganymede/internal/config"
+)
+
+var (
+	ErrLDAPInvalidCredentials = errors.New("invalid credentials")
+	ErrLDAPInsecure           = errors.New("refusing to send passwords over ldap:// without StartTLS, enable LDAP_START_TLS, use ldaps:// or set LDAP_ALLOW_INSECURE")
+	ErrLDAPUsernameTaken      = errors.New("user already exists") // same as an existing local user so registration does not reveal directory users
+)
+
+// checkLDAPTransport returns ErrLDAPInsecure if passwords would be sent in cleartext.
+func checkLDAPTransport(env config.EnvConfig) error {
+	u, err := url.Parse(env.LDAPURL)
+	if err != nil {
+		return fmt.Errorf("invalid ldap url: %v", err)
+	}
+	if u.Scheme == "ldap" && !env.LDAPStartTLS && !env.LDAPAllowInsecure {
+		return ErrLDAPInsecure
+	}
+	return nil
+}
+
+// ldapConn is the part of *ldap.Conn used to authenticate users, tests replace it with a stub.
+type ldapConn interface {
+	Bind(username, password string) error
+	Search(searchRequest *ldap.SearchRequest) (*ldap.SearchResult, error)
+	Close() error
+}
+
+// dialLDAP connects to the directory, replaced in tests.
+var dialLDAP = func(env config.EnvConfig) (ldapConn, error) {
+	if err := checkLDAPTransport(env); err != nil {
+		return nil, err
+	}
+	u, err := url.Parse(env.LDAPURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ldap url: %v", err)
+	}
+	tlsConfig := &tls.Config{
+		ServerName:         u.Hostname(),
+		InsecureSkipVerify: env.LDAPInsecureSkipVerify,
+	}
+
+	conn, err := ldap.DialURL(env.LDAPURL, ldap.DialWithTLSConfig(tlsConfig))
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to ldap: %v", err)
+	}
+	// ldaps:// connections are encrypted already
+	if u.Scheme == "ldap" && env.LDAPStartTLS {
+		if err := conn.StartTLS(tlsConfig); err != nil {
+			_ = conn.Close()
+			return nil, fmt.Errorf("error starting tls: %v", err)
+		}
+	}
+	return conn, nil
+}
+
+// LDAPUser is a user found in the directory.
+type LDAPUser struct {
+	DN       string
+	Username string
+	Groups   []string // DNs of the groups the user is a member of
+}
+
+// ldapAuthenticate searches the user with the service account and binds as the user to verify the password.
+func ldapAuthenticate(env config.EnvConfig, username string, password string) (*LDAPUser, error) {
+	// an empty password would be an unauthenticated bind that most directories accept
+	if username == "" || password == "" {
+		return nil, ErrLDAPInvalidCredentials
+	}
+
+	conn, err := dialLDAP(env)
+	if err != nil {
+		return nil, err
+	}
+	defer closeLDAP(conn)
+
+	entry, err := ldapSearchUser(conn, env, username)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := conn.Bind(entry.DN, password); err != nil {
+		if ldap.IsErrorWithCode(err, ldap.LDAPResultInvalidCredentials) {
+			return nil, ErrLDAPInvalidCredentials
+		}
+		return nil, fmt.Errorf("error binding ldap user: %v", err)
+	}
+
+	ldapUser := &LDAPUser{
+		DN:       entry.DN,
+		Username: entry.GetAttributeValue(env.LDAPUsernameAttribute),
+		Groups:   entry.GetAttributeValues(env.LDAPGroupMembershipAttribute),
+	}
+	if ldapUser.Username == "" {
+		ldapUser.Username = username
+	}
+	return ldapUser, nil
+}
+
+// ldapUserExists returns whether the username belongs to a user of the directory.
+func ldapUserExists(env config.EnvConfig, username string) (bool, error) {
+	conn, err := dialLDAP(env)
+	if err != nil {
+		return false, err
+	}
+	defer closeLDAP(conn)
+
+	_, err = ldapSearchUser(conn, env, username)
+	if errors.Is(err, ErrLDAPInvalidCredentials) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// ldapSearchUser finds the entry of the username with the service account, or anonymously if no service account is configured.
+func ldapSearchUser(conn ldapConn, env config.EnvConfig, username string) (*ldap.Entry, error) {
+	if env.LDAPBindDN != "" {
+		if err := conn.Bind(env.LDAPBindDN, env.LDAPBindPassword); err != nil {
+			return nil, fmt.Errorf("error binding ldap service account: %v", err)
+		}
+	}
+
+	result, err := conn.Search(ldap.NewSearchRequest(
+		env.LDAPUserSearchBase,
+		ldap.ScopeWholeSubtree, ldap.NeverDerefAliases, 2, 0, false,
+		strings.ReplaceAll(env.LDAPUserFilter, "{username}", ldap.EscapeFilter(username)),
+		[]string{"dn", env.LDAPUsernameAttribute, env.LDAPGroupMembershipAttribute},
+		nil,
+	))
+	if err != nil {
+		return nil, fmt.Errorf("error searching ldap user: %v", err)
+	}
+	if len(result.Entries) != 1 {
+		if len(result.Entries) > 1 {
+			log.Warn().Str("username", username).Msg("ldap user filter matched multiple users")
+		}
+		return nil, ErrLDAPInvalidCredentials
+	}
+	return result.Entries[0], nil
+}
+
+func closeLDAP(conn ldapConn) {
+	if err := conn.Close(); err != nil {
+		log.Debug().Err(err).Msg("error closing ldap connection")
+	}
+}
+
+// ldapGroupValues returns the DNs and common names of the groups so either can be mapped to a role.
+func ldapGroupValues(groups []string) []string {
+	values := make([]string, 0, len(groups)*2)
+	for _, group := range groups {
+		values = append(values, group)
+		dn, err := ldap.ParseDN(group)
+		if err != nil || len(dn.RDNs) == 0 || len(dn.RDNs[0].Attributes) == 0 {
+			continue
+		}
+		values = append(values, dn.RDNs[0].Attributes[0].Value)
+	}
+	return values
+}
+
+// ldapLogin authenticates the user against the directory and creates or updates the user like OAuthUserCheck.
+func (s *Service) ldapLogin(ctx context.Context, env config.EnvConfig, username string, password string) (*ent.User, error) {
+	ldapUser, err := ldapAuthenticate(env, username, password)
+	if err != nil {
+		if !errors.Is(err, ErrLDAPInvalidCredentials) {
+			log.Error().Err(err).Str("username", username).Msg("error authenticating ldap user")
+		}
+		return nil, fmt.Errorf("invalid credentials")
+	}
+
+	authConfig := config.Get().Auth
+	role, err := mapRole(authConfig.LDAPRoleMappings, authConfig.LDAPDenyUnmapped, ldapGroupValues(ldapUser.Groups))
+	if err != nil {
+		log.Warn().Str("username", ldapUser.Username).Msg("denied LDAP login without a mapped group")
+		return nil, err
+	}
+
+	u, err := s.Store.Client.User.Query().Where(entUser.Username(ldapUser.Username)).Only(ctx)
+	if err != nil {
+		if !ent.IsNotFound(err) {
+			return nil, fmt.Errorf("failed to query user: %w", err)
+		}
+
+		log.Debug().Str("username", ldapUser.Username).Msg("LDAP user not found, creating user")
+		u, err = s.Store.Client.User.Create().
+			SetUsername(ldapUser.Username).
+			SetRole(role).
+			SetLdap(true).
+			Save(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create user: %w", err)
+		}
+		return u, nil
+	}
+
+	if !u.Ldap {
+		log.Warn().Str("username", ldapUser.Username).Msg("LDAP username is used by a local or OAuth account")
+		return nil, fmt.Errorf("invalid credentials")
+	}
+
+	u, err = s.Store.Client.User.UpdateOne(u).SetRole(role).Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update user: %w", err)
+	}
+	return u, nil
+}
@@ -0,0 +1,195 @@
+package auth
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/go-ldap/ldap/v3"
+	"github.com/zibbp/ganymede/internal/config"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// stubLDAPConn is an in-process directory with users by DN and password.
+type stubLDAPConn struct {
+	passwords map[string]string
+	entries   []*ldap.Entry
+	filters   []string
+}
+
+func (c *stubLDAPConn) Bind(username, password string) error {
+	if expected, ok := c.passwords[username]; ok && expected == password {
+		return nil
+	}
+	return ldap.NewError(ldap.LDAPResultInvalidCredentials, errors.New("invalid credentials"))
+}
+
+func (c *stubLDAPConn) Search(searchRequest *ldap.SearchRequest) (*ldap.SearchResult, error) {
+	c.filters = append(c.filters, searchRequest.Filter)
+	var entries []*ldap.Entry
+	for _, entry := range c.entries {
+		if searchRequest.Filter == "(uid="+entry.GetAttributeValue("uid")+")" {
+			entries = append(entries, entry)
+		}
+	}
+	return &ldap.SearchResult{Entries: entries}, nil
+}
+
+func (c *stubLDAPConn) Close() error { return nil }
+
+func testLDAPEnv() config.EnvConfig {
+	return config.EnvConfig{
+		LDAPEnabled:                  true,
+		LDAPURL:                      "ldap://ldap.example.com",
+		LDAPBindDN:                   "cn=ganymede,dc=example,dc=com",
+		LDAPBindPassword:             "service",
+		LDAPUserSearchBase:           "ou=people,dc=example,dc=com",
+		LDAPUserFilter:               "(uid={username})",
+		LDAPUsernameAttribute:        "uid",
+		LDAPGroupMembershipAttribute: "memberOf",
+	}
+}
+
+func useStubLDAP(t *testing.T, conn *stubLDAPConn) {
+	original := dialLDAP
+	dialLDAP = func(env config.EnvConfig) (ldapConn, error) { return conn, nil }
+	t.Cleanup(func() { dialLDAP = original })
+}
+
+func TestLDAPAuthenticate(t *testing.T) {
+	conn := &stubLDAPConn{
+		passwords: map[string]string{
+			"cn=ganymede,dc=example,dc=com":         "service",
+			"uid=alice,ou=people,dc=example,dc=com": "secret",
+		},
+		entries: []*ldap.Entry{
+			ldap.NewEntry("uid=alice,ou=people,dc=example,dc=com", map[string][]string{
+				"uid":      {"alice"},
+				"memberOf": {"cn=media-editors,ou=groups,dc=example,dc=com"},
+			}),
+		},
+	}
+	useStubLDAP(t, conn)
+
+	tests := []struct {
+		name     string
+		username string
+		password string
+		wantErr  error
+	}{
+		{name: "valid", username: "alice", password: "secret"},
+		{name: "wrong password", username: "alice", password: "wrong", wantErr: ErrLDAPInvalidCredentials},
+		{name: "empty password", username: "alice", password: "", wantErr: ErrLDAPInvalidCredentials},
+		{name: "unknown user", username: "bob", password: "secret", wantErr: ErrLDAPInvalidCredentials},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ldapUser, err := ldapAuthenticate(testLDAPEnv(), tt.username, tt.password)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ldapAuthenticate() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if ldapUser.Username != "alice" || ldapUser.DN != "uid=alice,ou=people,dc=example,dc=com" {
+				t.Errorf("unexpected user %+v", ldapUser)
+			}
+			if !reflect.DeepEqual(ldapUser.Groups, []string{"cn=media-editors,ou=groups,dc=example,dc=com"}) {
+				t.Errorf("unexpected groups %v", ldapUser.Groups)
+			}
+		})
+	}
+}
+
+func TestLDAPAuthenticateEscapesFilter(t *testing.T) {
+	conn := &stubLDAPConn{passwords: map[string]string{"cn=ganymede,dc=example,dc=com": "service"}}
+	useStubLDAP(t, conn)
+
+	if _, err := ldapAuthenticate(testLDAPEnv(), "*)(uid=*", "secret"); !errors.Is(err, ErrLDAPInvalidCredentials) {
+		t.Fatalf("ldapAuthenticate() error = %v", err)
+	}
+	if len(conn.filters) != 1 || conn.filters[0] != `(uid=\2a\29\28uid=\2a)` {
+		t.Errorf("filter was not escaped: %v", conn.filters)
+	}
+}
+
+func TestLDAPGroupRole(t *testing.T) {
+	groups := []string{"cn=media-editors,ou=groups,dc=example,dc=com", "cn=ganymede-admin,ou=groups,dc=example,dc=com"}
+
+	tests := []struct {
+		name     string
+		mappings []config.RoleMapping
+		expected utils.Role
+	}{
+		{name: "common name", mappings: []config.RoleMapping{{Value: "media-editors", Role: utils.EditorRole}}, expected: utils.EditorRole},
+		{name: "dn", mappings: []config.RoleMapping{{Value: "CN=media-editors,OU=groups,DC=example,DC=com", Role: utils.ArchiverRole}}, expected: utils.ArchiverRole},
+		{name: "legacy group name", expected: utils.AdminRole},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			role, err := mapRole(tt.mappings, false, ldapGroupValues(groups))
+			if err != nil {
+				t.Fatalf("mapRole() error = %v", err)
+			}
+			if role != tt.expected {
+				t.Errorf("mapRole() = %q, want %q", role, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCheckLDAPTransport(t *testing.T) {
+	tests := []struct {
+		name     string
+		url      string
+		startTLS bool
+		insecure bool
+		wantErr  error
+	}{
+		{name: "starttls", url: "ldap://ldap.example.com", startTLS: true},
+		{name: "ldaps", url: "ldaps://ldap.example.com"},
+		{name: "plain", url: "ldap://ldap.example.com", wantErr: ErrLDAPInsecure},
+		{name: "plain allowed", url: "ldap://ldap.example.com", insecure: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := testLDAPEnv()
+			env.LDAPURL = tt.url
+			env.LDAPStartTLS = tt.startTLS
+			env.LDAPAllowInsecure = tt.insecure
+			if err := checkLDAPTransport(env); !errors.Is(err, tt.wantErr) {
+				t.Errorf("checkLDAPTransport() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	// plain connections are refused before dialing
+	if _, err := dialLDAP(testLDAPEnv()); !errors.Is(err, ErrLDAPInsecure) {
+		t.Errorf("dialLDAP() error = %v, want %v", err, ErrLDAPInsecure)
+	}
+}
+
+func TestLDAPUserExists(t *testing.T) {
+	conn := &stubLDAPConn{
+		passwords: map[string]string{"cn=ganymede,dc=example,dc=com": "service"},
+		entries: []*ldap.Entry{
+			ldap.NewEntry("uid=alice,ou=people,dc=example,dc=com", map[string][]string{"uid": {"alice"}}),
+		},
+	}
+	useStubLDAP(t, conn)
+
+	exists, err := ldapUserExists(testLDAPEnv(), "alice")
+	if err != nil || !exists {
+		t.Errorf("ldapUserExists(alice) = %v, %v", exists, err)
+	}
+	exists, err = ldapUserExists(testLDAPEnv(), "bob")
+	if err != nil || exists {
+		t.Errorf("ldapUserExists(bob) = %v, %v", exists, err)
+	}
+
+	env := testLDAPEnv()
+	env.LDAPBindPassword = "wrong"
+	if _, err := ldapUserExists(env, "alice"); err == nil {
+		t.Error("ldapUserExists() with a wrong service account password succeeded")
+	}
+}
@@ -0,0 +1,108 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/zibbp/ganymede/internal/config"
+)
+
+var ErrMediaTokenInvalid = errors.New("invalid media token")
+
+// MediaToken grants read access to the files under Path until ExpiresAt.
+type MediaToken struct {
+	Path      string    `json:"path"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+var (
+	mediaKeyOnce sync.Once
+	mediaKey     []byte
+)
+
+// mediaSigningKey returns the key media tokens are signed with. A random key is generated if MEDIA_SIGNING_KEY is not set, tokens are then only valid for this process.
+func mediaSigningKey() []byte {
+	mediaKeyOnce.Do(func() {
+		if key := config.GetEnvConfig().MediaSigningKey; key != "" {
+			mediaKey = []byte(key)
+			return
+		}
+		log.Warn().Msg("MEDIA_SIGNING_KEY is not set, signed media URLs are not valid after a restart or on other replicas")
+		mediaKey = make([]byte, 32)
+		if _, err := rand.Read(mediaKey); err != nil {
+			log.Panic().Err(err).Msg("error generating media signing key")
+		}
+	})
+	return mediaKey
+}
+
+// SignMediaToken returns a token granting access to the files under the directory until the expiry.
+func SignMediaToken(dir string, expiresAt time.Time) (string, MediaToken) {
+	token := MediaToken{Path: mediaDir(dir), ExpiresAt: expiresAt.Truncate(time.Second)}
+	return signMediaToken(mediaSigningKey(), token), token
+}
+
+// VerifyMediaToken returns ErrMediaTokenInvalid if the token is invalid, expired or does not grant access to the file.
+func VerifyMediaToken(token string, file string) error {
+	return verifyMediaToken(mediaSigningKey(), token, file, time.Now())
+}
+
+func signMediaToken(key []byte, token MediaToken) string {
+	payload := fmt.Sprintf("%d:%s", token.ExpiresAt.Unix(), token.Path)
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(payload))
+	return base64.RawURLEncoding.EncodeToString([]byte(payload)) + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func verifyMediaToken(key []byte, token string, file string, now time.Time) error {
+	encodedPayload, encodedSignature, ok := strings.Cut(token, ".")
+	if !ok {
+		return ErrMediaTokenInvalid
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil {
+		return ErrMediaTokenInvalid
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(encodedSignature)
+	if err != nil {
+		return ErrMediaTokenInvalid
+	}
+	mac := hmac.New(sha256.New, key)
+	mac.Write(payload)
+	if !hmac.Equal(signature, mac.Sum(nil)) {
+		return ErrMediaTokenInvalid
+	}
+
+	expiresAt, dir, ok := strings.Cut(string(payload), ":")
+	if !ok {
+		return ErrMediaTokenInvalid
+	}
+	expiresUnix, err := strconv.ParseInt(expiresAt, 10, 64)
+	if err != nil || !now.Before(time.Unix(expiresUnix, 0)) {
+		return ErrMediaTokenInvalid
+	}
+	// the cleaned path cannot escape the directory with ..
+	if !strings.HasPrefix(path.Clean("/"+file), dir) {
+		return ErrMediaTokenInvalid
+	}
+	return nil
+}
+
+// mediaDir cleans the directory and adds a trailing slash so a token for /videos/a does not match /videos/ab.
+func mediaDir(dir string) string {
+	dir = path.Clean("/" + dir)
+	if dir == "/" {
+		return dir
+	}
+	return dir + "/"
+}
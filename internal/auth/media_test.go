@@ -0,0 +1,44 @@
+package auth
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestVerifyMediaToken(t *testing.T) {
+	key := []byte("test-key")
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	token := signMediaToken(key, MediaToken{Path: mediaDir("/data/videos/channel/video"), ExpiresAt: now.Add(time.Hour)})
+
+	tests := []struct {
+		name    string
+		key     []byte
+		token   string
+		file    string
+		now     time.Time
+		wantErr bool
+	}{
+		{name: "file in directory", key: key, token: token, file: "/data/videos/channel/video/video.mp4", now: now},
+		{name: "nested file", key: key, token: token, file: "/data/videos/channel/video/hls/segment0.ts", now: now},
+		{name: "expired", key: key, token: token, file: "/data/videos/channel/video/video.mp4", now: now.Add(time.Hour), wantErr: true},
+		{name: "other directory", key: key, token: token, file: "/data/videos/channel/other/video.mp4", now: now, wantErr: true},
+		{name: "directory with same prefix", key: key, token: token, file: "/data/videos/channel/video2/video.mp4", now: now, wantErr: true},
+		{name: "path traversal", key: key, token: token, file: "/data/videos/channel/video/../other/video.mp4", now: now, wantErr: true},
+		{name: "other key", key: []byte("other-key"), token: token, file: "/data/videos/channel/video/video.mp4", now: now, wantErr: true},
+		{name: "tampered", key: key, token: "x" + token, file: "/data/videos/channel/video/video.mp4", now: now, wantErr: true},
+		{name: "malformed", key: key, token: "token", file: "/data/videos/channel/video/video.mp4", now: now, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := verifyMediaToken(tt.key, tt.token, tt.file, tt.now)
+			if tt.wantErr && !errors.Is(err, ErrMediaTokenInvalid) {
+				t.Errorf("verifyMediaToken() error = %v, want ErrMediaTokenInvalid", err)
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("verifyMediaToken() error = %v", err)
+			}
+		})
+	}
+}
@@ -32,6 +32,8 @@ type OIDCCLaims struct {
 	PreferredUsername string   `json:"preferred_username"`
 	Nickname          string   `json:"nickname"`
 	Groups            []string `json:"groups"`
+	// Claims are all claims of the ID token, used to map roles from any claim
+	Claims map[string]any `json:"-"`
 }
 
 type UserInfo struct {
@@ -141,6 +143,9 @@ func (s *Service) OAuthCallback(c echo.Context) (*ent.User, error) {
 	if err := idToken.Claims(&claims); err != nil {
 		return nil, fmt.Errorf("failed to parse claims: %w", err)
 	}
+	if err := idToken.Claims(&claims.Claims); err != nil {
+		return nil, fmt.Errorf("failed to parse claims: %w", err)
+	}
 
 	// Debug claims in dev
 	if s.EnvConfig.Development {
@@ -167,7 +172,7 @@ func (s *Service) OAuthCallback(c echo.Context) (*ent.User, error) {
 	// create or update user
 	user, err := s.OAuthUserCheck(c.Request().Context(), claims)
 	if err != nil {
-		return nil, fmt.Errorf("error creating or updating users: %v", err)
+		return nil, fmt.Errorf("error creating or updating users: %w", err)
 	}
 
 	return user, nil
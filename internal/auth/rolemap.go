@@ -0,0 +1,101 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/zibbp/ganymede/internal/config"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// legacyRoleGroupPrefix is the group prefix used to assign roles before role mappings were configurable, e.g. ganymede-admin.
+const legacyRoleGroupPrefix = "ganymede-"
+
+var ErrNoMappedRole = errors.New("no role is mapped to the user's groups or claims, contact your administrator")
+
+// mapOIDCRole returns the highest role mapped to the values of the configured claim.
+func mapOIDCRole(authConfig config.Auth, claims OIDCCLaims) (utils.Role, error) {
+	claimName := authConfig.OIDCRoleClaim
+	if claimName == "" {
+		claimName = "groups"
+	}
+
+	var values []string
+	if claims.Claims != nil {
+		values = claimValues(claims.Claims, claimName)
+	} else if claimName == "groups" {
+		values = claims.Groups
+	}
+
+	return mapRole(authConfig.OIDCRoleMappings, authConfig.OIDCDenyUnmapped, values)
+}
+
+// mapRole returns the highest role mapped to the values. The user role is returned if nothing matches, unless unmapped users are denied.
+func mapRole(mappings []config.RoleMapping, denyUnmapped bool, values []string) (utils.Role, error) {
+	var role utils.Role
+	for _, value := range values {
+		mapped, ok := mappedRole(mappings, value)
+		if ok && (role == "" || mapped.HasRole(role)) {
+			role = mapped
+		}
+	}
+
+	if role == "" {
+		if denyUnmapped {
+			return "", ErrNoMappedRole
+		}
+		return utils.UserRole, nil
+	}
+	return role, nil
+}
+
+// mappedRole returns the role of a claim value or group. Without configured mappings the value is expected to be ganymede-<role>.
+func mappedRole(mappings []config.RoleMapping, value string) (utils.Role, bool) {
+	if len(mappings) == 0 {
+		role := strings.TrimPrefix(value, legacyRoleGroupPrefix)
+		if role != value && utils.IsValidRole(role) {
+			return utils.Role(role), true
+		}
+		return "", false
+	}
+
+	for _, mapping := range mappings {
+		if strings.EqualFold(mapping.Value, value) {
+			return mapping.Role, true
+		}
+	}
+	return "", false
+}
+
+// claimValues returns the string values of a claim. The claim can be nested with dots, e.g. realm_access.roles, and be a string or a list.
+func claimValues(claims map[string]any, path string) []string {
+	var current any = claims
+	for _, key := range strings.Split(path, ".") {
+		object, ok := current.(map[string]any)
+		if !ok {
+			return nil
+		}
+		current = object[key]
+	}
+
+	switch value := current.(type) {
+	case string:
+		return []string{value}
+	case []any:
+		values := make([]string, 0, len(value))
+		for _, item := range value {
+			switch item := item.(type) {
+			case string:
+				values = append(values, item)
+			case nil:
+			default:
+				values = append(values, fmt.Sprint(item))
+			}
+		}
+		return values
+	case []string:
+		return value
+	}
+	return nil
+}
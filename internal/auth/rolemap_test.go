@@ -0,0 +1,86 @@
+package auth
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/zibbp/ganymede/internal/config"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+func TestMapOIDCRole(t *testing.T) {
+	mappings := []config.RoleMapping{
+		{Value: "media-admins", Role: utils.AdminRole},
+		{Value: "media-editors", Role: utils.EditorRole},
+		{Value: "media-viewers", Role: utils.UserRole},
+	}
+
+	tests := []struct {
+		name       string
+		authConfig config.Auth
+		claims     OIDCCLaims
+		expected   utils.Role
+		wantErr    error
+	}{
+		{
+			name:       "mapped group",
+			authConfig: config.Auth{OIDCRoleClaim: "groups", OIDCRoleMappings: mappings},
+			claims:     OIDCCLaims{Claims: map[string]any{"groups": []any{"other", "media-editors"}}},
+			expected:   utils.EditorRole,
+		},
+		{
+			name:       "highest role wins",
+			authConfig: config.Auth{OIDCRoleClaim: "groups", OIDCRoleMappings: mappings},
+			claims:     OIDCCLaims{Claims: map[string]any{"groups": []any{"media-viewers", "media-admins", "media-editors"}}},
+			expected:   utils.AdminRole,
+		},
+		{
+			name:       "nested claim",
+			authConfig: config.Auth{OIDCRoleClaim: "realm_access.roles", OIDCRoleMappings: mappings},
+			claims:     OIDCCLaims{Claims: map[string]any{"realm_access": map[string]any{"roles": []any{"media-editors"}}}},
+			expected:   utils.EditorRole,
+		},
+		{
+			name:       "string claim",
+			authConfig: config.Auth{OIDCRoleClaim: "role", OIDCRoleMappings: mappings},
+			claims:     OIDCCLaims{Claims: map[string]any{"role": "media-admins"}},
+			expected:   utils.AdminRole,
+		},
+		{
+			name:       "unmapped defaults to user",
+			authConfig: config.Auth{OIDCRoleClaim: "groups", OIDCRoleMappings: mappings},
+			claims:     OIDCCLaims{Claims: map[string]any{"groups": []any{"other"}}},
+			expected:   utils.UserRole,
+		},
+		{
+			name:       "unmapped denied",
+			authConfig: config.Auth{OIDCRoleClaim: "groups", OIDCRoleMappings: mappings, OIDCDenyUnmapped: true},
+			claims:     OIDCCLaims{Claims: map[string]any{}},
+			wantErr:    ErrNoMappedRole,
+		},
+		{
+			name:       "legacy group prefix without mappings",
+			authConfig: config.Auth{OIDCRoleClaim: "groups"},
+			claims:     OIDCCLaims{Groups: []string{"ganymede-archiver"}},
+			expected:   utils.ArchiverRole,
+		},
+		{
+			name:       "legacy group prefix ignores invalid roles",
+			authConfig: config.Auth{},
+			claims:     OIDCCLaims{Claims: map[string]any{"groups": []any{"ganymede-owner"}}},
+			expected:   utils.UserRole,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			role, err := mapOIDCRole(tt.authConfig, tt.claims)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("mapOIDCRole() error = %v, want %v", err, tt.wantErr)
+			}
+			if role != tt.expected {
+				t.Errorf("mapOIDCRole() = %q, want %q", role, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,361 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"github.com/zibbp/ganymede/ent"
+	entLoginThrottle "github.com/zibbp/ganymede/ent/loginthrottle"
+	"github.com/zibbp/ganymede/internal/config"
+	"github.com/zibbp/ganymede/internal/notification"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// maxLoginDelay caps the delay between failed logins before the lockout threshold is reached.
+const maxLoginDelay = time.Minute
+
+// registrationWindow is the period registrations per IP address are limited in.
+const registrationWindow = time.Hour
+
+var ErrTooManyAttempts = errors.New("too many attempts")
+
+// ThrottleError is returned when a login or registration has to wait.
+type ThrottleError struct {
+	RetryAfter time.Duration
+	Locked     bool // locked out rather than delayed
+}
+
+func (e *ThrottleError) Error() string {
+	if e.Locked {
+		return fmt.Sprintf("too many failed attempts, locked for %s", e.RetryAfter.Round(time.Second))
+	}
+	return fmt.Sprintf("too many attempts, try again in %s", e.RetryAfter.Round(time.Second))
+}
+
+func (e *ThrottleError) Is(target error) bool {
+	return target == ErrTooManyAttempts
+}
+
+// loginThrottleKey is an IP address or username login attempts are counted for.
+type loginThrottleKey struct {
+	kind        utils.LoginThrottleKind
+	value       string
+	maxFailures int
+}
+
+func loginThrottleKeys(authConfig config.Auth, ip string, username string) []loginThrottleKey {
+	keys := []loginThrottleKey{}
+	if authConfig.LoginMaxFailuresPerIP > 0 {
+		keys = append(keys, loginThrottleKey{kind: utils.LoginThrottleIP, value: ip, maxFailures: authConfig.LoginMaxFailuresPerIP})
+	}
+	if authConfig.LoginMaxFailures > 0 {
+		keys = append(keys, loginThrottleKey{kind: utils.LoginThrottleUsername, value: username, maxFailures: authConfig.LoginMaxFailures})
+	}
+	return keys
+}
+
+// StartLoginAttempt returns a ThrottleError if the IP address or username is delayed or locked out, otherwise the attempt is counted until it is forgiven after a successful login.
+// Checking and counting is one conditional update so parallel requests cannot get past the delay.
+func (s *Service) StartLoginAttempt(ctx context.Context, ip string, username string) error {
+	authConfig := config.Get().Auth
+	for _, key := range loginThrottleKeys(authConfig, ip, username) {
+		if err := s.startAttempt(ctx, key.kind, key.value, lockoutDuration(authConfig)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RecordLoginFailure locks out the IP address and username once the attempt started with StartLoginAttempt failed the configured number of times.
+func (s *Service) RecordLoginFailure(ctx context.Context, ip string, username string) error {
+	authConfig := config.Get().Auth
+	for _, key := range loginThrottleKeys(authConfig, ip, username) {
+		if err := s.lockIfExceeded(ctx, key.kind, key.value, key.maxFailures, lockoutDuration(authConfig)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ForgiveLoginAttempt stops counting the attempt of the IP address after the password or second factor was correct. Earlier failures of the IP address are kept so one valid account cannot be used to reset them.
+func (s *Service) ForgiveLoginAttempt(ctx context.Context, ip string) error {
+	_, err := s.Store.Client.LoginThrottle.Update().
+		Where(entLoginThrottle.KindEQ(utils.LoginThrottleIP), entLoginThrottle.Value(ip), entLoginThrottle.AttemptsGT(0)).
+		AddAttempts(-1).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("error updating login throttle: %v", err)
+	}
+	return nil
+}
+
+// ResetLoginThrottle forgets the failed logins of the username after a successful login, including the second factor.
+func (s *Service) ResetLoginThrottle(ctx context.Context, username string) error {
+	_, err := s.Store.Client.LoginThrottle.Delete().
+		Where(entLoginThrottle.KindEQ(utils.LoginThrottleUsername), entLoginThrottle.Value(username)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("error resetting login throttle: %v", err)
+	}
+	return nil
+}
+
+// CheckRegistrationThrottle returns a ThrottleError if the IP address registered too many times in the last hour.
+func (s *Service) CheckRegistrationThrottle(ctx context.Context, ip string) error {
+	limit := config.Get().Auth.RegistrationsPerIPPerHour
+	if limit == 0 {
+		return nil
+	}
+	throttle, err := s.getLoginThrottle(ctx, utils.LoginThrottleRegistration, ip)
+	if err != nil {
+		return err
+	}
+	if throttle == nil {
+		return nil
+	}
+	windowEnd := throttle.CreatedAt.Add(registrationWindow)
+	if throttle.Attempts >= limit && time.Now().Before(windowEnd) {
+		return &ThrottleError{RetryAfter: time.Until(windowEnd)}
+	}
+	return nil
+}
+
+// RecordRegistration counts a registration attempt of the IP address.
+func (s *Service) RecordRegistration(ctx context.Context, ip string) error {
+	if config.Get().Auth.RegistrationsPerIPPerHour == 0 {
+		return nil
+	}
+	throttle, err := s.getLoginThrottle(ctx, utils.LoginThrottleRegistration, ip)
+	if err != nil {
+		return err
+	}
+	// start a new window, the creation time is when the window started
+	if throttle != nil && time.Since(throttle.CreatedAt) > registrationWindow {
+		if err := s.Store.Client.LoginThrottle.DeleteOneID(throttle.ID).Exec(ctx); err != nil && !ent.IsNotFound(err) {
+			return fmt.Errorf("error resetting registration throttle: %v", err)
+		}
+	}
+	_, err = s.incrementAttempts(ctx, utils.LoginThrottleRegistration, ip)
+	return err
+}
+
+// GetLoginThrottles returns the IP addresses and usernames with recent failed logins or registrations.
+func (s *Service) GetLoginThrottles(ctx context.Context) ([]*ent.LoginThrottle, error) {
+	since := time.Now().Add(-max(lockoutDuration(config.Get().Auth), registrationWindow))
+	throttles, err := s.Store.Client.LoginThrottle.Query().
+		Where(entLoginThrottle.Or(entLoginThrottle.LastAttemptAtGT(since), entLoginThrottle.LockedUntilGT(time.Now()))).
+		Order(ent.Desc(entLoginThrottle.FieldLastAttemptAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting login throttles: %v", err)
+	}
+	return throttles, nil
+}
+
+// DeleteLoginThrottle unlocks an IP address or username.
+func (s *Service) DeleteLoginThrottle(ctx context.Context, id uuid.UUID) error {
+	if err := s.Store.Client.LoginThrottle.DeleteOneID(id).Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return err
+		}
+		return fmt.Errorf("error deleting login throttle: %v", err)
+	}
+	return nil
+}
+
+// startAttempt counts an attempt of the IP address or username unless it has to wait. The attempt is only counted if no concurrent request counted one since the throttle was read.
+func (s *Service) startAttempt(ctx context.Context, kind utils.LoginThrottleKind, value string, window time.Duration) error {
+	for range 3 {
+		now := time.Now()
+		throttle, err := s.getLoginThrottle(ctx, kind, value)
+		if err != nil {
+			return err
+		}
+		if throttle == nil {
+			s.pruneLoginThrottles(ctx)
+			_, err := s.Store.Client.LoginThrottle.Create().
+				SetKind(kind).
+				SetValue(value).
+				SetAttempts(1).
+				SetLastAttemptAt(now).
+				Save(ctx)
+			// a concurrent request created the row first, check it instead
+			if ent.IsConstraintError(err) {
+				continue
+			}
+			if err != nil {
+				return fmt.Errorf("error creating login throttle: %v", err)
+			}
+			return nil
+		}
+
+		if wait := loginWait(throttle, now, window); wait > 0 {
+			locked := throttle.LockedUntil != nil && throttle.LockedUntil.After(now)
+			return &ThrottleError{RetryAfter: wait, Locked: locked}
+		}
+
+		update := s.Store.Client.LoginThrottle.Update().
+			Where(
+				entLoginThrottle.ID(throttle.ID),
+				entLoginThrottle.Attempts(throttle.Attempts),
+				entLoginThrottle.LastAttemptAt(throttle.LastAttemptAt),
+			).
+			SetLastAttemptAt(now)
+		if throttleExpired(throttle, now, window) {
+			// forget failures older than the lockout duration and failures before an expired lockout
+			update.SetAttempts(1).ClearLockedUntil()
+		} else {
+			update.AddAttempts(1)
+		}
+		updated, err := update.Save(ctx)
+		if err != nil {
+			return fmt.Errorf("error updating login throttle: %v", err)
+		}
+		if updated > 0 {
+			return nil
+		}
+		// a concurrent request counted an attempt first, check the delay again
+	}
+	return &ThrottleError{RetryAfter: time.Second}
+}
+
+// lockIfExceeded locks the IP address or username out once its counted attempts reach maxFailures.
+func (s *Service) lockIfExceeded(ctx context.Context, kind utils.LoginThrottleKind, value string, maxFailures int, lockout time.Duration) error {
+	now := time.Now()
+	throttle, err := s.getLoginThrottle(ctx, kind, value)
+	if err != nil {
+		return err
+	}
+	if throttle == nil || throttle.Attempts < maxFailures {
+		return nil
+	}
+
+	// only the first request to reach the limit locks and notifies
+	lockedUntil := now.Add(lockout)
+	updated, err := s.Store.Client.LoginThrottle.Update().
+		Where(
+			entLoginThrottle.ID(throttle.ID),
+			entLoginThrottle.Or(entLoginThrottle.LockedUntilIsNil(), entLoginThrottle.LockedUntilLTE(now)),
+		).
+		SetLockedUntil(lockedUntil).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("error locking login: %v", err)
+	}
+	if updated == 0 {
+		return nil
+	}
+	log.Warn().Str("kind", string(kind)).Str("value", value).Int("attempts", throttle.Attempts).Time("locked_until", lockedUntil).Msg("login locked after repeated failures")
+
+	go notification.SendLoginLockoutNotification(notification.LoginLockout{
+		Kind:        kind,
+		Value:       value,
+		Attempts:    throttle.Attempts,
+		LockedUntil: lockedUntil,
+	})
+	return nil
+}
+
+// incrementAttempts atomically increments the attempts of the IP address or username, creating the row if needed.
+func (s *Service) incrementAttempts(ctx context.Context, kind utils.LoginThrottleKind, value string) (*ent.LoginThrottle, error) {
+	for range 2 {
+		updated, err := s.Store.Client.LoginThrottle.Update().
+			Where(entLoginThrottle.KindEQ(kind), entLoginThrottle.Value(value)).
+			AddAttempts(1).
+			SetLastAttemptAt(time.Now()).
+			Save(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("error updating login throttle: %v", err)
+		}
+		if updated == 0 {
+			s.pruneLoginThrottles(ctx)
+			_, err = s.Store.Client.LoginThrottle.Create().
+				SetKind(kind).
+				SetValue(value).
+				SetAttempts(1).
+				Save(ctx)
+			// another replica created the row first, increment it instead
+			if ent.IsConstraintError(err) {
+				continue
+			}
+			if err != nil {
+				return nil, fmt.Errorf("error creating login throttle: %v", err)
+			}
+		}
+		return s.getLoginThrottle(ctx, kind, value)
+	}
+	return nil, fmt.Errorf("error updating login throttle of %s %s", kind, value)
+}
+
+// pruneLoginThrottles deletes rows that no longer count so guessing random usernames does not grow the table forever.
+func (s *Service) pruneLoginThrottles(ctx context.Context) {
+	before := time.Now().Add(-max(lockoutDuration(config.Get().Auth), registrationWindow))
+	_, err := s.Store.Client.LoginThrottle.Delete().
+		Where(
+			entLoginThrottle.LastAttemptAtLT(before),
+			entLoginThrottle.Or(entLoginThrottle.LockedUntilIsNil(), entLoginThrottle.LockedUntilLT(time.Now())),
+		).
+		Exec(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("error pruning login throttles")
+	}
+}
+
+func (s *Service) getLoginThrottle(ctx context.Context, kind utils.LoginThrottleKind, value string) (*ent.LoginThrottle, error) {
+	throttle, err := s.Store.Client.LoginThrottle.Query().
+		Where(entLoginThrottle.KindEQ(kind), entLoginThrottle.Value(value)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error getting login throttle: %v", err)
+	}
+	return throttle, nil
+}
+
+func lockoutDuration(authConfig config.Auth) time.Duration {
+	return time.Duration(max(authConfig.LockoutMinutes, 1)) * time.Minute
+}
+
+// throttleExpired returns whether the failures of the throttle no longer count.
+func throttleExpired(throttle *ent.LoginThrottle, now time.Time, window time.Duration) bool {
+	if throttle.LockedUntil != nil {
+		return !throttle.LockedUntil.After(now)
+	}
+	return now.Sub(throttle.LastAttemptAt) > window
+}
+
+// loginWait returns how long until the next login is allowed.
+func loginWait(throttle *ent.LoginThrottle, now time.Time, window time.Duration) time.Duration {
+	if throttle.LockedUntil != nil {
+		if throttle.LockedUntil.After(now) {
+			return throttle.LockedUntil.Sub(now)
+		}
+		return 0
+	}
+	if throttleExpired(throttle, now, window) {
+		return 0
+	}
+	next := throttle.LastAttemptAt.Add(loginDelay(throttle.Attempts))
+	if next.After(now) {
+		return next.Sub(now)
+	}
+	return 0
+}
+
+// loginDelay doubles the delay after every failed login, starting at one second after the second failure.
+func loginDelay(failures int) time.Duration {
+	if failures < 2 {
+		return 0
+	}
+	// stop doubling before the shift overflows
+	if failures-2 >= 30 {
+		return maxLoginDelay
+	}
+	return min(time.Duration(1<<(failures-2))*time.Second, maxLoginDelay)
+}
@@ -0,0 +1,66 @@
+package auth
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/zibbp/ganymede/ent"
+)
+
+func TestLoginDelay(t *testing.T) {
+	tests := []struct {
+		failures int
+		expected time.Duration
+	}{
+		{failures: 0, expected: 0},
+		{failures: 1, expected: 0},
+		{failures: 2, expected: time.Second},
+		{failures: 4, expected: 4 * time.Second},
+		{failures: 8, expected: maxLoginDelay},
+		{failures: 1000, expected: maxLoginDelay},
+	}
+	for _, tt := range tests {
+		if got := loginDelay(tt.failures); got != tt.expected {
+			t.Errorf("loginDelay(%d) = %s, want %s", tt.failures, got, tt.expected)
+		}
+	}
+}
+
+func TestLoginWait(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	window := 15 * time.Minute
+	lockedUntil := now.Add(10 * time.Minute)
+	expiredLock := now.Add(-time.Minute)
+
+	tests := []struct {
+		name     string
+		throttle *ent.LoginThrottle
+		expected time.Duration
+	}{
+		{name: "first failure", throttle: &ent.LoginThrottle{Attempts: 1, LastAttemptAt: now}, expected: 0},
+		{name: "delayed", throttle: &ent.LoginThrottle{Attempts: 3, LastAttemptAt: now.Add(-time.Second)}, expected: time.Second},
+		{name: "delay passed", throttle: &ent.LoginThrottle{Attempts: 3, LastAttemptAt: now.Add(-5 * time.Second)}, expected: 0},
+		{name: "failures expired", throttle: &ent.LoginThrottle{Attempts: 100, LastAttemptAt: now.Add(-time.Hour)}, expected: 0},
+		{name: "locked", throttle: &ent.LoginThrottle{Attempts: 5, LastAttemptAt: now, LockedUntil: &lockedUntil}, expected: 10 * time.Minute},
+		{name: "lock expired", throttle: &ent.LoginThrottle{Attempts: 5, LastAttemptAt: now.Add(-20 * time.Minute), LockedUntil: &expiredLock}, expected: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := loginWait(tt.throttle, now, window); got != tt.expected {
+				t.Errorf("loginWait() = %s, want %s", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestThrottleError(t *testing.T) {
+	var err error = &ThrottleError{RetryAfter: 90 * time.Second, Locked: true}
+	if !errors.Is(err, ErrTooManyAttempts) {
+		t.Error("ThrottleError is not ErrTooManyAttempts")
+	}
+	var throttleErr *ThrottleError
+	if !errors.As(err, &throttleErr) || throttleErr.RetryAfter != 90*time.Second {
+		t.Errorf("errors.As() = %v", throttleErr)
+	}
+}
@@ -0,0 +1,159 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"github.com/zibbp/ganymede/ent"
+	entApiToken "github.com/zibbp/ganymede/ent/apitoken"
+	entUser "github.com/zibbp/ganymede/ent/user"
+	"github.com/zibbp/ganymede/internal/database"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// apiTokenPrefix marks Ganymede API tokens so they can be recognized in logs and secret scanners.
+const apiTokenPrefix = "gmd_"
+
+// apiTokenLastUsedInterval limits how often the last used timestamp is written.
+const apiTokenLastUsedInterval = time.Minute
+
+var (
+	ErrAPITokenInvalid  = errors.New("invalid api token")
+	ErrAPITokenNotFound = errors.New("api token not found")
+	ErrAPITokenRole     = errors.New("api token role cannot be higher than the user's role")
+)
+
+type APIToken struct {
+	Name      string
+	Role      utils.Role // optional, caps the role of requests made with the token
+	ExpiresAt *time.Time
+}
+
+// GetAPITokens returns the API tokens of the user.
+func (s *Service) GetAPITokens(ctx context.Context, userID uuid.UUID) ([]*ent.ApiToken, error) {
+	tokens, err := s.Store.Client.ApiToken.Query().
+		Where(entApiToken.HasUserWith(entUser.ID(userID))).
+		Order(ent.Desc(entApiToken.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting api tokens: %v", err)
+	}
+	return tokens, nil
+}
+
+// CreateAPIToken creates an API token for the user. The token is only returned here, only its hash is stored.
+func (s *Service) CreateAPIToken(ctx context.Context, u *ent.User, input APIToken) (*ent.ApiToken, string, error) {
+	if input.Role != "" && !u.Role.HasRole(input.Role) {
+		return nil, "", ErrAPITokenRole
+	}
+	if input.ExpiresAt != nil && input.ExpiresAt.Before(time.Now()) {
+		return nil, "", fmt.Errorf("expiry must be in the future")
+	}
+
+	token, err := generateAPIToken()
+	if err != nil {
+		return nil, "", fmt.Errorf("error generating api token: %v", err)
+	}
+
+	create := s.Store.Client.ApiToken.Create().
+		SetUserID(u.ID).
+		SetName(input.Name).
+		SetTokenHash(hashToken(token)).
+		SetTokenPrefix(token[:len(apiTokenPrefix)+6]).
+		SetNillableExpiresAt(input.ExpiresAt)
+	if input.Role != "" {
+		create.SetRole(input.Role)
+	}
+
+	apiToken, err := create.Save(ctx)
+	if err != nil {
+		return nil, "", fmt.Errorf("error creating api token: %v", err)
+	}
+	return apiToken, token, nil
+}
+
+// DeleteAPIToken revokes an API token of the user.
+func (s *Service) DeleteAPIToken(ctx context.Context, userID uuid.UUID, tokenID uuid.UUID) error {
+	deleted, err := s.Store.Client.ApiToken.Delete().
+		Where(entApiToken.ID(tokenID), entApiToken.HasUserWith(entUser.ID(userID))).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("error deleting api token: %v", err)
+	}
+	if deleted == 0 {
+		return ErrAPITokenNotFound
+	}
+	return nil
+}
+
+// DeleteUserAPITokens revokes all API tokens of the user and returns how many were revoked.
+func (s *Service) DeleteUserAPITokens(ctx context.Context, userID uuid.UUID) (int, error) {
+	deleted, err := s.Store.Client.ApiToken.Delete().
+		Where(entApiToken.HasUserWith(entUser.ID(userID))).
+		Exec(ctx)
+	if err != nil {
+		return deleted, fmt.Errorf("error deleting api tokens: %v", err)
+	}
+	return deleted, nil
+}
+
+// ValidateAPIToken returns the API token with its user if the token exists and has not expired.
+func ValidateAPIToken(ctx context.Context, store *database.Database, token string) (*ent.ApiToken, error) {
+	if !strings.HasPrefix(token, apiTokenPrefix) {
+		return nil, ErrAPITokenInvalid
+	}
+
+	apiToken, err := store.Client.ApiToken.Query().
+		Where(entApiToken.TokenHash(hashToken(token))).
+		WithUser().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrAPITokenInvalid
+		}
+		return nil, fmt.Errorf("error getting api token: %v", err)
+	}
+	if apiToken.ExpiresAt != nil && apiToken.ExpiresAt.Before(time.Now()) {
+		return nil, ErrAPITokenInvalid
+	}
+
+	if apiToken.LastUsedAt == nil || time.Since(*apiToken.LastUsedAt) > apiTokenLastUsedInterval {
+		if err := store.Client.ApiToken.UpdateOneID(apiToken.ID).SetLastUsedAt(time.Now()).Exec(ctx); err != nil {
+			log.Error().Err(err).Str("token_id", apiToken.ID.String()).Msg("error updating api token last used")
+		}
+	}
+
+	return apiToken, nil
+}
+
+// APITokenUser returns the user of the API token with its role capped by the token's role.
+func APITokenUser(apiToken *ent.ApiToken) *ent.User {
+	user := apiToken.Edges.User
+	if user != nil && apiToken.Role != nil {
+		user.Role = utils.LowestRole(user.Role, *apiToken.Role)
+	}
+	return user
+}
+
+func generateAPIToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return apiTokenPrefix + base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// hashToken hashes API tokens and recovery codes with SHA-256. They are random so a slow password hash is not needed and the hash can be looked up directly.
+func hashToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
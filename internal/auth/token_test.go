@@ -0,0 +1,37 @@
+package auth
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerateAPIToken(t *testing.T) {
+	token, err := generateAPIToken()
+	if err != nil {
+		t.Fatalf("generateAPIToken() error = %v", err)
+	}
+	if !strings.HasPrefix(token, apiTokenPrefix) {
+		t.Errorf("token %q is missing prefix %q", token, apiTokenPrefix)
+	}
+
+	other, err := generateAPIToken()
+	if err != nil {
+		t.Fatalf("generateAPIToken() error = %v", err)
+	}
+	if token == other {
+		t.Error("generated the same token twice")
+	}
+}
+
+func TestHashToken(t *testing.T) {
+	token := "gmd_test"
+	if hashToken(token) != hashToken(token) {
+		t.Error("hash is not deterministic")
+	}
+	if hashToken(token) == hashToken("gmd_other") {
+		t.Error("different tokens have the same hash")
+	}
+	if strings.Contains(hashToken(token), token) {
+		t.Error("hash contains the token")
+	}
+}
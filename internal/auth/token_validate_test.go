@@ -0,0 +1,85 @@
+package auth_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	entUser "github.com/zibbp/ganymede/ent/user"
+	"github.com/zibbp/ganymede/internal/auth"
+	"github.com/zibbp/ganymede/internal/utils"
+	"github.com/zibbp/ganymede/tests"
+)
+
+// TestValidateAPIToken tests validating API tokens against the database
+func TestValidateAPIToken(t *testing.T) {
+	app, err := tests.Setup(t)
+	require.NoError(t, err)
+	ctx := t.Context()
+
+	admin, err := app.Database.Client.User.Query().Where(entUser.Username("admin")).Only(ctx)
+	require.NoError(t, err)
+
+	t.Run("valid token with role cap", func(t *testing.T) {
+		created, token, err := app.AuthService.CreateAPIToken(ctx, admin, auth.APIToken{Name: "viewer", Role: utils.UserRole})
+		require.NoError(t, err)
+
+		apiToken, err := auth.ValidateAPIToken(ctx, app.Database, token)
+		require.NoError(t, err)
+		assert.Equal(t, created.ID, apiToken.ID)
+		require.NotNil(t, apiToken.LastUsedAt)
+
+		// the token's role caps the admin's role
+		user := auth.APITokenUser(apiToken)
+		assert.Equal(t, admin.ID, user.ID)
+		assert.Equal(t, utils.UserRole, user.Role)
+	})
+
+	t.Run("token without role keeps the user's role", func(t *testing.T) {
+		_, token, err := app.AuthService.CreateAPIToken(ctx, admin, auth.APIToken{Name: "full"})
+		require.NoError(t, err)
+
+		apiToken, err := auth.ValidateAPIToken(ctx, app.Database, token)
+		require.NoError(t, err)
+		assert.Equal(t, utils.AdminRole, auth.APITokenUser(apiToken).Role)
+	})
+
+	t.Run("role above the user's role is rejected", func(t *testing.T) {
+		user, err := app.Database.Client.User.Create().SetUsername("token-user").SetPassword("unused").SetRole(utils.UserRole).Save(ctx)
+		require.NoError(t, err)
+
+		_, _, err = app.AuthService.CreateAPIToken(ctx, user, auth.APIToken{Name: "admin", Role: utils.AdminRole})
+		assert.ErrorIs(t, err, auth.ErrAPITokenRole)
+	})
+
+	t.Run("expired token", func(t *testing.T) {
+		expiresAt := time.Now().Add(time.Hour)
+		created, token, err := app.AuthService.CreateAPIToken(ctx, admin, auth.APIToken{Name: "expiring", ExpiresAt: &expiresAt})
+		require.NoError(t, err)
+
+		_, err = auth.ValidateAPIToken(ctx, app.Database, token)
+		require.NoError(t, err)
+
+		require.NoError(t, app.Database.Client.ApiToken.UpdateOneID(created.ID).SetExpiresAt(time.Now().Add(-time.Minute)).Exec(ctx))
+		_, err = auth.ValidateAPIToken(ctx, app.Database, token)
+		assert.ErrorIs(t, err, auth.ErrAPITokenInvalid)
+	})
+
+	t.Run("revoked token", func(t *testing.T) {
+		created, token, err := app.AuthService.CreateAPIToken(ctx, admin, auth.APIToken{Name: "revoked"})
+		require.NoError(t, err)
+
+		require.NoError(t, app.AuthService.DeleteAPIToken(ctx, admin.ID, created.ID))
+		_, err = auth.ValidateAPIToken(ctx, app.Database, token)
+		assert.ErrorIs(t, err, auth.ErrAPITokenInvalid)
+	})
+
+	t.Run("unknown token", func(t *testing.T) {
+		_, err := auth.ValidateAPIToken(ctx, app.Database, "gmd_unknown")
+		assert.ErrorIs(t, err, auth.ErrAPITokenInvalid)
+
+		_, err = auth.ValidateAPIToken(ctx, app.Database, "not-a-token")
+		assert.ErrorIs(t, err, auth.ErrAPITokenInvalid)
+	})
+}
@@ -0,0 +1,322 @@
+package auth
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/base32"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent"
+	entUser "github.com/zibbp/ganymede/ent/user"
+	"github.com/zibbp/ganymede/internal/config"
+)
+
+const (
+	totpIssuer = "Ganymede"
+	totpPeriod = 30 // seconds
+	totpDigits = 6
+	totpSkew   = 1 // time steps accepted before and after the current one to allow for clock drift
+
+	recoveryCodeCount = 10
+)
+
+var (
+	ErrTwoFactorInvalidCode  = errors.New("invalid two-factor code")
+	ErrTwoFactorNotEnrolled  = errors.New("two-factor authentication is not enabled")
+	ErrTwoFactorEnabled      = errors.New("two-factor authentication is already enabled")
+	ErrTwoFactorRequired     = errors.New("two-factor authentication is required for your role")
+	ErrTwoFactorOAuth        = errors.New("two-factor authentication of OAuth users is managed by the OAuth provider")
+	ErrTwoFactorUserNotFound = errors.New("user not found")
+)
+
+var base32NoPadding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+type TOTPEnrollment struct {
+	Secret string `json:"secret"`
+	URI    string `json:"uri"` // otpauth URI to render as a QR code
+}
+
+// TwoFactorRequired returns whether the user's role must have two-factor authentication enabled.
+func TwoFactorRequired(u *ent.User) bool {
+	if u.Oauth {
+		return false
+	}
+	for _, role := range config.Get().Auth.RequireTwoFactorRoles {
+		if role == u.Role {
+			return true
+		}
+	}
+	return false
+}
+
+// EnrollTOTP generates a new TOTP secret for the user. Two-factor authentication is enabled once a code is verified with EnableTOTP.
+func (s *Service) EnrollTOTP(ctx context.Context, u *ent.User) (*TOTPEnrollment, error) {
+	if u.Oauth {
+		return nil, ErrTwoFactorOAuth
+	}
+	if u.TotpEnabled {
+		return nil, ErrTwoFactorEnabled
+	}
+
+	secret, err := generateTOTPSecret()
+	if err != nil {
+		return nil, fmt.Errorf("error generating totp secret: %v", err)
+	}
+	if err := s.Store.Client.User.UpdateOneID(u.ID).SetTotpSecret(secret).Exec(ctx); err != nil {
+		return nil, fmt.Errorf("error saving totp secret: %v", err)
+	}
+
+	return &TOTPEnrollment{
+		Secret: secret,
+		URI:    totpURI(u.Username, secret),
+	}, nil
+}
+
+// EnableTOTP enables two-factor authentication if the code matches the enrolled secret. The recovery codes are returned, only their hashes are stored.
+func (s *Service) EnableTOTP(ctx context.Context, u *ent.User, code string) ([]string, error) {
+	if u.TotpEnabled {
+		return nil, ErrTwoFactorEnabled
+	}
+	if u.TotpSecret == "" {
+		return nil, ErrTwoFactorNotEnrolled
+	}
+
+	counter, ok := validateTOTP(u.TotpSecret, code, time.Now(), 0)
+	if !ok {
+		return nil, ErrTwoFactorInvalidCode
+	}
+
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, fmt.Errorf("error generating recovery codes: %v", err)
+	}
+
+	err = s.Store.Client.User.UpdateOneID(u.ID).
+		SetTotpEnabled(true).
+		SetTotpLastCounter(counter).
+		SetTotpRecoveryCodes(hashes).
+		Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error enabling two-factor authentication: %v", err)
+	}
+	return codes, nil
+}
+
+// DisableTOTP disables two-factor authentication after verifying a TOTP or recovery code.
+func (s *Service) DisableTOTP(ctx context.Context, u *ent.User, code string) error {
+	if !u.TotpEnabled {
+		return ErrTwoFactorNotEnrolled
+	}
+	if TwoFactorRequired(u) {
+		return ErrTwoFactorRequired
+	}
+	if err := s.verifySecondFactor(ctx, u, code); err != nil {
+		return err
+	}
+	return s.ResetTOTP(ctx, u.ID)
+}
+
+// RegenerateRecoveryCodes replaces the user's recovery codes after verifying a TOTP code.
+func (s *Service) RegenerateRecoveryCodes(ctx context.Context, u *ent.User, code string) ([]string, error) {
+	if !u.TotpEnabled {
+		return nil, ErrTwoFactorNotEnrolled
+	}
+	if err := s.verifyTOTP(ctx, u, code); err != nil {
+		return nil, err
+	}
+
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, fmt.Errorf("error generating recovery codes: %v", err)
+	}
+	if err := s.Store.Client.User.UpdateOneID(u.ID).SetTotpRecoveryCodes(hashes).Exec(ctx); err != nil {
+		return nil, fmt.Errorf("error saving recovery codes: %v", err)
+	}
+	return codes, nil
+}
+
+// VerifyTwoFactor verifies the second login step of the user with a TOTP or recovery code.
+func (s *Service) VerifyTwoFactor(ctx context.Context, userID uuid.UUID, code string) (*ent.User, error) {
+	u, err := s.Store.Client.User.Get(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting user: %v", err)
+	}
+	if !u.TotpEnabled {
+		return nil, ErrTwoFactorNotEnrolled
+	}
+	if err := s.verifySecondFactor(ctx, u, code); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+// ResetTOTP disables two-factor authentication of the user and removes the secret and recovery codes.
+func (s *Service) ResetTOTP(ctx context.Context, userID uuid.UUID) error {
+	err := s.Store.Client.User.UpdateOneID(userID).
+		SetTotpEnabled(false).
+		ClearTotpSecret().
+		ClearTotpLastCounter().
+		ClearTotpRecoveryCodes().
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ErrTwoFactorUserNotFound
+		}
+		return fmt.Errorf("error resetting two-factor authentication: %v", err)
+	}
+	return nil
+}
+
+// verifySecondFactor accepts either a TOTP code or an unused recovery code.
+func (s *Service) verifySecondFactor(ctx context.Context, u *ent.User, code string) error {
+	code = normalizeCode(code)
+	if len(code) == totpDigits {
+		return s.verifyTOTP(ctx, u, code)
+	}
+	return s.useRecoveryCode(ctx, u, code)
+}
+
+func (s *Service) verifyTOTP(ctx context.Context, u *ent.User, code string) error {
+	counter, ok := validateTOTP(u.TotpSecret, normalizeCode(code), time.Now(), u.TotpLastCounter)
+	if !ok {
+		return ErrTwoFactorInvalidCode
+	}
+
+	// only accept the code if no concurrent request used the same or a later time step
+	updated, err := s.Store.Client.User.Update().
+		Where(entUser.ID(u.ID), entUser.Or(entUser.TotpLastCounterIsNil(), entUser.TotpLastCounterLT(counter))).
+		SetTotpLastCounter(counter).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("error saving totp counter: %v", err)
+	}
+	if updated == 0 {
+		return ErrTwoFactorInvalidCode
+	}
+	return nil
+}
+
+func (s *Service) useRecoveryCode(ctx context.Context, u *ent.User, code string) error {
+	hash := hashToken(code)
+	for {
+		i := slices.IndexFunc(u.TotpRecoveryCodes, func(recoveryCode string) bool {
+			return hmac.Equal([]byte(recoveryCode), []byte(hash))
+		})
+		if i == -1 {
+			return ErrTwoFactorInvalidCode
+		}
+		remaining := append(append([]string{}, u.TotpRecoveryCodes[:i]...), u.TotpRecoveryCodes[i+1:]...)
+
+		// recovery codes are only ever removed or regenerated, so the code being present and the count being unchanged means no concurrent request changed them
+		updated, err := s.Store.Client.User.Update().
+			Where(entUser.ID(u.ID), func(sel *sql.Selector) {
+				sel.Where(sql.And(
+					sqljson.ValueContains(sel.C(entUser.FieldTotpRecoveryCodes), hash),
+					sqljson.LenEQ(sel.C(entUser.FieldTotpRecoveryCodes), len(u.TotpRecoveryCodes)),
+				))
+			}).
+			SetTotpRecoveryCodes(remaining).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("error saving recovery codes: %v", err)
+		}
+		if updated > 0 {
+			return nil
+		}
+
+		// another code was used concurrently, retry with the current codes
+		u, err = s.Store.Client.User.Get(ctx, u.ID)
+		if err != nil {
+			return fmt.Errorf("error getting user: %v", err)
+		}
+	}
+}
+
+func generateTOTPSecret() (string, error) {
+	b := make([]byte, 20)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base32NoPadding.EncodeToString(b), nil
+}
+
+func totpURI(username, secret string) string {
+	q := url.Values{}
+	q.Set("secret", secret)
+	q.Set("issuer", totpIssuer)
+	q.Set("algorithm", "SHA1")
+	q.Set("digits", fmt.Sprintf("%d", totpDigits))
+	q.Set("period", fmt.Sprintf("%d", totpPeriod))
+	return fmt.Sprintf("otpauth://totp/%s:%s?%s", url.PathEscape(totpIssuer), url.PathEscape(username), q.Encode())
+}
+
+// validateTOTP checks the code against the time steps around t that are after lastCounter. The matching time step is returned.
+func validateTOTP(secret, code string, t time.Time, lastCounter int64) (int64, bool) {
+	key, err := base32NoPadding.DecodeString(strings.ToUpper(secret))
+	if err != nil || len(code) != totpDigits {
+		return 0, false
+	}
+
+	current := t.Unix() / totpPeriod
+	for i := int64(-totpSkew); i <= totpSkew; i++ {
+		counter := current + i
+		if counter <= lastCounter {
+			continue
+		}
+		if hmac.Equal([]byte(totpCode(key, counter)), []byte(code)) {
+			return counter, true
+		}
+	}
+	return 0, false
+}
+
+// totpCode generates the code of a time step as defined in RFC 4226 and RFC 6238.
+func totpCode(key []byte, counter int64) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(counter))
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	mod := uint32(1)
+	for range totpDigits {
+		mod *= 10
+	}
+	return fmt.Sprintf("%0*d", totpDigits, value%mod)
+}
+
+// generateRecoveryCodes returns recovery codes formatted as xxxxx-xxxxx and their hashes.
+func generateRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, 0, recoveryCodeCount)
+	hashes := make([]string, 0, recoveryCodeCount)
+	for range recoveryCodeCount {
+		b := make([]byte, 7)
+		if _, err := rand.Read(b); err != nil {
+			return nil, nil, err
+		}
+		code := strings.ToLower(base32NoPadding.EncodeToString(b))[:10]
+		codes = append(codes, code[:5]+"-"+code[5:])
+		hashes = append(hashes, hashToken(code))
+	}
+	return codes, hashes, nil
+}
+
+// normalizeCode removes separators users may type or copy with a code.
+func normalizeCode(code string) string {
+	return strings.ToLower(strings.NewReplacer(" ", "", "-", "").Replace(strings.TrimSpace(code)))
+}
@@ -0,0 +1,90 @@
+package auth
+
+import (
+	"encoding/base32"
+	"strings"
+	"testing"
+	"time"
+)
+
+// rfcSecret is the SHA-1 key of the RFC 6238 test vectors.
+var rfcSecret = base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString([]byte("12345678901234567890"))
+
+func TestTOTPCode(t *testing.T) {
+	key := []byte("12345678901234567890")
+	// RFC 6238 appendix B, truncated to 6 digits
+	tests := []struct {
+		unix     int64
+		expected string
+	}{
+		{unix: 59, expected: "287082"},
+		{unix: 1111111109, expected: "081804"},
+		{unix: 1234567890, expected: "005924"},
+		{unix: 2000000000, expected: "279037"},
+	}
+	for _, tt := range tests {
+		if got := totpCode(key, tt.unix/totpPeriod); got != tt.expected {
+			t.Errorf("totpCode(%d) = %s, want %s", tt.unix, got, tt.expected)
+		}
+	}
+}
+
+func TestValidateTOTP(t *testing.T) {
+	now := time.Unix(1111111109, 0)
+	current := now.Unix() / totpPeriod
+
+	tests := []struct {
+		name        string
+		code        string
+		lastCounter int64
+		wantOK      bool
+		wantCounter int64
+	}{
+		{name: "current code", code: "081804", wantOK: true, wantCounter: current},
+		{name: "previous step within skew", code: totpCode([]byte("12345678901234567890"), current-1), wantOK: true, wantCounter: current - 1},
+		{name: "outside skew", code: totpCode([]byte("12345678901234567890"), current-3)},
+		{name: "already used", code: "081804", lastCounter: current},
+		{name: "wrong code", code: "000000"},
+		{name: "wrong length", code: "81804"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			counter, ok := validateTOTP(rfcSecret, tt.code, now, tt.lastCounter)
+			if ok != tt.wantOK {
+				t.Fatalf("validateTOTP() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if ok && counter != tt.wantCounter {
+				t.Errorf("validateTOTP() counter = %d, want %d", counter, tt.wantCounter)
+			}
+		})
+	}
+}
+
+func TestTOTPURI(t *testing.T) {
+	uri := totpURI("john doe", "ABC")
+	if !strings.HasPrefix(uri, "otpauth://totp/Ganymede:john%20doe?") {
+		t.Errorf("unexpected uri %s", uri)
+	}
+	if !strings.Contains(uri, "secret=ABC") || !strings.Contains(uri, "issuer=Ganymede") {
+		t.Errorf("uri is missing parameters: %s", uri)
+	}
+}
+
+func TestGenerateRecoveryCodes(t *testing.T) {
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		t.Fatalf("generateRecoveryCodes() error = %v", err)
+	}
+	if len(codes) != recoveryCodeCount || len(hashes) != recoveryCodeCount {
+		t.Fatalf("got %d codes and %d hashes, want %d", len(codes), len(hashes), recoveryCodeCount)
+	}
+	for i, code := range codes {
+		if len(code) != 11 || code[5] != '-' {
+			t.Errorf("unexpected code format %q", code)
+		}
+		// codes are accepted with or without separators and in any case
+		if hashToken(normalizeCode(strings.ToUpper(code))) != hashes[i] {
+			t.Errorf("hash of %q does not match", code)
+		}
+	}
+}
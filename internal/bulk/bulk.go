@@ -0,0 +1,173 @@
+// Package bulk applies an action to many vods at once as a tracked background task.
+package bulk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/riverqueue/river/rivertype"
+	"github.com/zibbp/ganymede/ent"
+	entBulkOperation "github.com/zibbp/ganymede/ent/bulkoperation"
+	entChannel "github.com/zibbp/ganymede/ent/channel"
+	entPlaylist "github.com/zibbp/ganymede/ent/playlist"
+	"github.com/zibbp/ganymede/ent/predicate"
+	entVod "github.com/zibbp/ganymede/ent/vod"
+	"github.com/zibbp/ganymede/internal/database"
+	tasks_client "github.com/zibbp/ganymede/internal/tasks/client"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+var (
+	ErrBulkOperationNotFound = errors.New("bulk operation not found")
+	ErrNoVodsSelected        = errors.New("a filter or vod ids are required")
+	ErrPlaylistRequired      = errors.New("a playlist is required for the action")
+	ErrPlaylistNotFound      = errors.New("playlist not found")
+	ErrBulkOperationFinished = errors.New("bulk operation is already finished")
+)
+
+type Service struct {
+	Store       *database.Database
+	RiverClient *tasks_client.RiverClient
+}
+
+func NewService(store *database.Database, riverClient *tasks_client.RiverClient) *Service {
+	return &Service{Store: store, RiverClient: riverClient}
+}
+
+// BulkOperation is a bulk operation to start. The action is applied to the vods if set, otherwise to the vods matching the filter.
+type BulkOperation struct {
+	Action     utils.BulkAction
+	VodIDs     []uuid.UUID
+	Filter     utils.BulkVodFilter
+	PlaylistID *uuid.UUID
+}
+
+// CreateBulkOperation saves the bulk operation and queues the task applying it.
+func (s *Service) CreateBulkOperation(ctx context.Context, userID uuid.UUID, input BulkOperation) (*ent.BulkOperation, error) {
+	if len(input.VodIDs) == 0 && input.Filter.IsEmpty() {
+		return nil, ErrNoVodsSelected
+	}
+	if input.Action == utils.BulkActionAddToPlaylist || input.Action == utils.BulkActionRemoveFromPlaylist {
+		if input.PlaylistID == nil {
+			return nil, ErrPlaylistRequired
+		}
+		exists, err := s.Store.Client.Playlist.Query().Where(entPlaylist.ID(*input.PlaylistID)).Exist(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("error getting playlist: %v", err)
+		}
+		if !exists {
+			return nil, ErrPlaylistNotFound
+		}
+	} else {
+		input.PlaylistID = nil
+	}
+
+	create := s.Store.Client.BulkOperation.Create().
+		SetAction(input.Action).
+		SetCreatedByID(userID).
+		SetNillablePlaylistID(input.PlaylistID)
+	if len(input.VodIDs) > 0 {
+		create.SetVodIds(input.VodIDs)
+	} else {
+		create.SetFilter(input.Filter)
+	}
+	op, err := create.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error creating bulk operation: %v", err)
+	}
+
+	job, err := s.RiverClient.Client.Insert(ctx, BulkVodOperationArgs{OperationID: op.ID.String()}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error queuing bulk operation: %v", err)
+	}
+
+	op, err = s.Store.Client.BulkOperation.UpdateOne(op).SetJobID(job.Job.ID).Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error updating bulk operation: %v", err)
+	}
+	return op, nil
+}
+
+// GetBulkOperation returns the bulk operation with its per vod results.
+func (s *Service) GetBulkOperation(ctx context.Context, id uuid.UUID) (*ent.BulkOperation, error) {
+	op, err := s.Store.Client.BulkOperation.Query().Where(entBulkOperation.ID(id)).WithCreatedBy().Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrBulkOperationNotFound
+		}
+		return nil, fmt.Errorf("error getting bulk operation: %v", err)
+	}
+	return op, nil
+}
+
+// GetBulkOperations returns the most recent bulk operations.
+func (s *Service) GetBulkOperations(ctx context.Context, limit int, offset int) ([]*ent.BulkOperation, error) {
+	ops, err := s.Store.Client.BulkOperation.Query().
+		WithCreatedBy().
+		Order(ent.Desc(entBulkOperation.FieldCreatedAt)).
+		Limit(limit).
+		Offset(offset).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting bulk operations: %v", err)
+	}
+	return ops, nil
+}
+
+// CancelBulkOperation stops a pending or running bulk operation. Vods already processed are not reverted.
+func (s *Service) CancelBulkOperation(ctx context.Context, id uuid.UUID) error {
+	op, err := s.GetBulkOperation(ctx, id)
+	if err != nil {
+		return err
+	}
+	if op.Status != utils.Pending && op.Status != utils.Running {
+		return ErrBulkOperationFinished
+	}
+	job, err := s.RiverClient.Client.JobCancel(ctx, op.JobID)
+	if err != nil && !errors.Is(err, rivertype.ErrNotFound) {
+		return fmt.Errorf("error canceling bulk operation: %v", err)
+	}
+	if job != nil && job.State == rivertype.JobStateRunning {
+		// the worker stops and sets the final status
+		return nil
+	}
+
+	// the worker won't run, the operation is finished here unless the worker finished it in the meantime
+	err = s.Store.Client.BulkOperation.Update().
+		Where(entBulkOperation.ID(op.ID), entBulkOperation.StatusIn(utils.Pending, utils.Running)).
+		SetStatus(utils.Failed).
+		SetError("bulk operation canceled").
+		SetFinishedAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("error updating bulk operation: %v", err)
+	}
+	return nil
+}
+
+// vodPredicates returns the predicates matching the vods of the filter.
+func vodPredicates(filter utils.BulkVodFilter) []predicate.Vod {
+	predicates := []predicate.Vod{entVod.DeletedAtIsNil()}
+	if filter.ChannelID != nil {
+		predicates = append(predicates, entVod.HasChannelWith(entChannel.ID(*filter.ChannelID)))
+	}
+	if filter.PlaylistID != nil {
+		predicates = append(predicates, entVod.HasPlaylistsWith(entPlaylist.ID(*filter.PlaylistID)))
+	}
+	if len(filter.Types) > 0 {
+		predicates = append(predicates, entVod.TypeIn(filter.Types...))
+	}
+	if filter.From != nil {
+		predicates = append(predicates, entVod.StreamedAtGTE(*filter.From))
+	}
+	if filter.To != nil {
+		predicates = append(predicates, entVod.StreamedAtLTE(*filter.To))
+	}
+	if filter.Query != "" {
+		predicates = append(predicates, entVod.TitleContainsFold(filter.Query))
+	}
+	return predicates
+}
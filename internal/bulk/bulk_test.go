@@ -0,0 +1,62 @@
+package bulk
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	entVod "github.com/zibbp/ganymede/ent/vod"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// vodQuery renders the query of the vods matching the filter.
+func vodQuery(filter utils.BulkVodFilter) string {
+	s := sql.Dialect(dialect.Postgres).Select("*").From(sql.Table(entVod.Table))
+	entVod.And(vodPredicates(filter)...)(s)
+	query, _ := s.Query()
+	return query
+}
+
+func TestVodPredicates(t *testing.T) {
+	channelID := uuid.New()
+	playlistID := uuid.New()
+	from := time.Now().AddDate(0, -1, 0)
+
+	tests := []struct {
+		name     string
+		filter   utils.BulkVodFilter
+		contains []string
+	}{
+		{name: "channel", filter: utils.BulkVodFilter{ChannelID: &channelID}, contains: []string{`"channels"."id" = $1`}},
+		{name: "playlist", filter: utils.BulkVodFilter{PlaylistID: &playlistID}, contains: []string{`"playlist_vods"`}},
+		{name: "types and date", filter: utils.BulkVodFilter{Types: []utils.VodType{utils.Archive, utils.Clip}, From: &from}, contains: []string{`"vods"."type" IN ($1, $2)`, `"vods"."streamed_at" >= $3`}},
+		{name: "query", filter: utils.BulkVodFilter{Query: "speedrun"}, contains: []string{`"vods"."title"`}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query := vodQuery(tt.filter)
+			// vods in the trash are never included
+			if !strings.Contains(query, `"vods"."deleted_at" IS NULL`) {
+				t.Errorf("query %s does not exclude deleted vods", query)
+			}
+			for _, expected := range tt.contains {
+				if !strings.Contains(query, expected) {
+					t.Errorf("query %s does not contain %s", query, expected)
+				}
+			}
+		})
+	}
+}
+
+func TestBulkVodFilterIsEmpty(t *testing.T) {
+	if !(utils.BulkVodFilter{}).IsEmpty() {
+		t.Error("expected empty filter to be empty")
+	}
+	if (utils.BulkVodFilter{Query: "a"}).IsEmpty() {
+		t.Error("expected filter with a query not to be empty")
+	}
+}
@@ -0,0 +1,243 @@
+package bulk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/riverqueue/river"
+	"github.com/rs/zerolog/log"
+	"github.com/zibbp/ganymede/ent"
+	entPlaylist "github.com/zibbp/ganymede/ent/playlist"
+	"github.com/zibbp/ganymede/ent/predicate"
+	entVod "github.com/zibbp/ganymede/ent/vod"
+	"github.com/zibbp/ganymede/internal/database"
+	"github.com/zibbp/ganymede/internal/playlist"
+	"github.com/zibbp/ganymede/internal/tasks"
+	"github.com/zibbp/ganymede/internal/trash"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// errSkipped is returned by actions that have nothing to do for the vod.
+type errSkipped struct {
+	reason string
+}
+
+func (e errSkipped) Error() string { return e.reason }
+
+type BulkVodOperationArgs struct {
+	OperationID string `json:"operation_id"`
+}
+
+func (BulkVodOperationArgs) Kind() string { return tasks.TaskBulkVodOperation }
+
+func (args BulkVodOperationArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		MaxAttempts: 1,
+	}
+}
+
+func (w BulkVodOperationArgs) Timeout(job *river.Job[BulkVodOperationArgs]) time.Duration {
+	return 24 * time.Hour
+}
+
+type BulkVodOperationWorker struct {
+	river.WorkerDefaults[BulkVodOperationArgs]
+}
+
+func (w BulkVodOperationWorker) Work(ctx context.Context, job *river.Job[BulkVodOperationArgs]) error {
+	logger := log.With().Str("task", job.Kind).Str("job_id", fmt.Sprintf("%d", job.ID)).Logger()
+
+	store, err := tasks.StoreFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	opID, err := uuid.Parse(job.Args.OperationID)
+	if err != nil {
+		return err
+	}
+	op, err := store.Client.BulkOperation.Get(ctx, opID)
+	if err != nil {
+		return err
+	}
+
+	vods, err := store.Client.Vod.Query().Where(operationVods(op)...).Order(ent.Asc(entVod.FieldStreamedAt)).All(ctx)
+	if err != nil {
+		return finishOperation(store, op, fmt.Errorf("error getting vods: %v", err))
+	}
+
+	op, err = op.Update().SetStatus(utils.Running).SetTotal(len(vods)).Save(ctx)
+	if err != nil {
+		return err
+	}
+	logger.Info().Str("action", string(op.Action)).Int("total", len(vods)).Msg("starting bulk operation")
+
+	results := make([]utils.BulkItemResult, 0, len(vods))
+	succeeded, failed := 0, 0
+	for _, v := range vods {
+		if ctx.Err() != nil {
+			break
+		}
+
+		result := utils.BulkItemResult{VodID: v.ID, Title: v.Title, Status: utils.BulkItemSuccess}
+		jobID, err := applyAction(ctx, store, op, v)
+		var skipped errSkipped
+		switch {
+		case errors.As(err, &skipped):
+			result.Status = utils.BulkItemSkipped
+			result.Error = skipped.reason
+		case err != nil:
+			result.Status = utils.BulkItemFailed
+			result.Error = err.Error()
+			logger.Warn().Err(err).Str("vod_id", v.ID.String()).Msg("bulk operation failed for vod")
+		case jobID != 0:
+			result.Status = utils.BulkItemQueued
+			result.JobID = jobID
+		}
+		if result.Status == utils.BulkItemFailed {
+			failed++
+		} else {
+			succeeded++
+		}
+		results = append(results, result)
+
+		updated, err := op.Update().SetResults(results).SetSucceeded(succeeded).SetFailed(failed).Save(ctx)
+		if err != nil {
+			return finishOperation(store, op, fmt.Errorf("error saving progress: %v", err))
+		}
+		op = updated
+	}
+
+	logger.Info().Int("succeeded", succeeded).Int("failed", failed).Msg("bulk operation completed")
+
+	if ctx.Err() != nil {
+		return finishOperation(store, op, fmt.Errorf("bulk operation stopped: %v", ctx.Err()))
+	}
+	return finishOperation(store, op, nil)
+}
+
+// finishOperation sets the final status of the operation. The update uses a new context so it is saved when the task is canceled.
+func finishOperation(store *database.Database, op *ent.BulkOperation, opErr error) error {
+	status := utils.Success
+	if opErr != nil || op.Failed > 0 {
+		status = utils.Failed
+	}
+	update := store.Client.BulkOperation.UpdateOne(op).SetStatus(status).SetFinishedAt(time.Now())
+	if opErr != nil {
+		update.SetError(opErr.Error())
+	}
+	if err := update.Exec(context.Background()); err != nil {
+		return err
+	}
+	return opErr
+}
+
+// operationVods returns the predicates matching the vods of the operation. Vods in the trash are never included.
+func operationVods(op *ent.BulkOperation) []predicate.Vod {
+	if len(op.VodIds) > 0 {
+		return []predicate.Vod{entVod.IDIn(op.VodIds...), entVod.DeletedAtIsNil()}
+	}
+	return vodPredicates(op.Filter)
+}
+
+// applyAction applies the action of the operation to the vod. The ID of the task is returned if the work was queued.
+func applyAction(ctx context.Context, store *database.Database, op *ent.BulkOperation, v *ent.Vod) (int64, error) {
+	switch op.Action {
+	case utils.BulkActionDelete:
+		if v.Locked {
+			return 0, errSkipped{"vod is locked"}
+		}
+		return 0, trash.TrashVod(ctx, store, v.ID, true)
+
+	case utils.BulkActionLock, utils.BulkActionUnlock:
+		locked := op.Action == utils.BulkActionLock
+		if v.Locked == locked {
+			return 0, errSkipped{fmt.Sprintf("vod is already %sed", op.Action)}
+		}
+		return 0, store.Client.Vod.UpdateOne(v).SetLocked(locked).Exec(ctx)
+
+	case utils.BulkActionAddToPlaylist, utils.BulkActionRemoveFromPlaylist:
+		inPlaylist, err := v.QueryPlaylists().Where(entPlaylist.ID(*op.PlaylistID)).Exist(ctx)
+		if err != nil {
+			return 0, err
+		}
+		playlistService := playlist.NewService(store)
+		if op.Action == utils.BulkActionAddToPlaylist {
+			if inPlaylist {
+				return 0, errSkipped{"vod is already in the playlist"}
+			}
+			return 0, playlistService.AddVodToPlaylist(ctx, *op.PlaylistID, v.ID)
+		}
+		if !inPlaylist {
+			return 0, errSkipped{"vod is not in the playlist"}
+		}
+		return 0, playlistService.DeleteVodFromPlaylist(ctx, *op.PlaylistID, v.ID)
+
+	case utils.BulkActionGenerateStaticThumbnail:
+		return insertJob(ctx, tasks.GenerateStaticThumbnailArgs{VideoId: v.ID.String()})
+
+	case utils.BulkActionGenerateSpriteThumbnails:
+		return insertJob(ctx, tasks.GenerateSpriteThumbnailArgs{VideoId: v.ID.String()})
+
+	case utils.BulkActionRenderChat:
+		if v.ChatPath == "" || !utils.FileExists(v.ChatPath) {
+			return 0, errSkipped{"vod has no downloaded chat"}
+		}
+		return insertJob(ctx, tasks.RerenderChatArgs{VideoId: v.ID.String()})
+
+	case utils.BulkActionConvertToHls:
+		if v.VideoHlsPath != "" {
+			return 0, errSkipped{"vod is already HLS"}
+		}
+		return insertJob(ctx, tasks.ConvertVideoToHlsArgs{VideoId: v.ID.String()})
+
+	case utils.BulkActionRefreshMetadata:
+		return 0, refreshMetadata(ctx, store, v)
+	}
+	return 0, fmt.Errorf("unknown action: %s", op.Action)
+}
+
+func insertJob(ctx context.Context, args river.JobArgs) (int64, error) {
+	job, err := river.ClientFromContext[pgx.Tx](ctx).Insert(ctx, args, nil)
+	if err != nil {
+		return 0, fmt.Errorf("error queuing task: %v", err)
+	}
+	return job.Job.ID, nil
+}
+
+// refreshMetadata updates the title and views of the vod from the platform.
+func refreshMetadata(ctx context.Context, store *database.Database, v *ent.Vod) error {
+	if v.Platform != utils.PlatformTwitch {
+		return errSkipped{fmt.Sprintf("metadata can't be fetched from %s", v.Platform)}
+	}
+	if v.Type == utils.Live {
+		return errSkipped{"live archives have no platform video"}
+	}
+
+	platformService, err := tasks.PlatformFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	var title string
+	var views int
+	if v.Type == utils.Clip {
+		info, err := platformService.GetClip(ctx, v.ExtID)
+		if err != nil {
+			return err
+		}
+		title, views = info.Title, info.ViewCount
+	} else {
+		info, err := platformService.GetVideo(ctx, v.ExtID, false, false)
+		if err != nil {
+			return err
+		}
+		title, views = info.Title, int(info.ViewCount)
+	}
+
+	return store.Client.Vod.UpdateOne(v).SetTitle(title).SetViews(views).Exec(ctx)
+}
@@ -0,0 +1,149 @@
+package bulk_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zibbp/ganymede/ent"
+	entPlaylist "github.com/zibbp/ganymede/ent/playlist"
+	entUser "github.com/zibbp/ganymede/ent/user"
+	"github.com/zibbp/ganymede/internal/bulk"
+	"github.com/zibbp/ganymede/internal/channel"
+	"github.com/zibbp/ganymede/internal/playlist"
+	"github.com/zibbp/ganymede/internal/server"
+	"github.com/zibbp/ganymede/internal/utils"
+	"github.com/zibbp/ganymede/internal/vod"
+	"github.com/zibbp/ganymede/tests"
+)
+
+const bulkOperationTimeout = 30 * time.Second
+
+// setupAppAndVods initializes the application with a channel, an unlocked and a locked vod.
+func setupAppAndVods(t *testing.T) (*server.Application, uuid.UUID, *ent.Vod, *ent.Vod) {
+	app, err := tests.Setup(t)
+	require.NoError(t, err)
+
+	admin, err := app.Database.Client.User.Query().Where(entUser.Username("admin")).Only(t.Context())
+	require.NoError(t, err)
+
+	c, err := app.ChannelService.CreateChannel(channel.Channel{ExtID: "123456789", Name: "TestChannel"})
+	require.NoError(t, err)
+
+	createVod := func(extID string, locked bool) *ent.Vod {
+		v, err := app.VodService.CreateVod(vod.Vod{
+			ID:         uuid.New(),
+			ExtID:      extID,
+			Platform:   utils.PlatformTwitch,
+			Type:       utils.Archive,
+			Title:      fmt.Sprintf("Test video %s", extID),
+			Resolution: string(utils.Best),
+			StreamedAt: time.Now(),
+			Locked:     locked,
+		}, c.ID)
+		require.NoError(t, err)
+		return v
+	}
+	return app, admin.ID, createVod("1", false), createVod("2", true)
+}
+
+// runBulkOperation starts the bulk operation and waits for the worker to finish it.
+func runBulkOperation(t *testing.T, app *server.Application, userID uuid.UUID, input bulk.BulkOperation) *ent.BulkOperation {
+	op, err := app.BulkService.CreateBulkOperation(t.Context(), userID, input)
+	require.NoError(t, err)
+
+	startTime := time.Now()
+	for time.Since(startTime) < bulkOperationTimeout {
+		op, err = app.BulkService.GetBulkOperation(t.Context(), op.ID)
+		require.NoError(t, err)
+		if op.Status != utils.Pending && op.Status != utils.Running {
+			return op
+		}
+		time.Sleep(500 * time.Millisecond)
+	}
+	t.Fatalf("timeout reached while waiting for bulk operation %s", op.ID)
+	return nil
+}
+
+// itemStatuses returns the result status of each vod of the operation.
+func itemStatuses(op *ent.BulkOperation) map[uuid.UUID]utils.BulkItemStatus {
+	statuses := make(map[uuid.UUID]utils.BulkItemStatus)
+	for _, result := range op.Results {
+		statuses[result.VodID] = result.Status
+	}
+	return statuses
+}
+
+// TestBulkDeleteSkipsLockedVods tests that deleting moves unlocked vods to the trash and skips locked vods
+func TestBulkDeleteSkipsLockedVods(t *testing.T) {
+	app, userID, unlocked, locked := setupAppAndVods(t)
+
+	op := runBulkOperation(t, app, userID, bulk.BulkOperation{Action: utils.BulkActionDelete, VodIDs: []uuid.UUID{unlocked.ID, locked.ID}})
+	assert.Equal(t, utils.Success, op.Status)
+	assert.Equal(t, map[uuid.UUID]utils.BulkItemStatus{unlocked.ID: utils.BulkItemSuccess, locked.ID: utils.BulkItemSkipped}, itemStatuses(op))
+
+	unlocked, err := app.Database.Client.Vod.Get(t.Context(), unlocked.ID)
+	require.NoError(t, err)
+	assert.NotNil(t, unlocked.DeletedAt)
+
+	locked, err = app.Database.Client.Vod.Get(t.Context(), locked.ID)
+	require.NoError(t, err)
+	assert.Nil(t, locked.DeletedAt)
+}
+
+// TestBulkLockIsIdempotent tests that locking and unlocking skip vods that are already in the state
+func TestBulkLockIsIdempotent(t *testing.T) {
+	app, userID, unlocked, locked := setupAppAndVods(t)
+	vodIDs := []uuid.UUID{unlocked.ID, locked.ID}
+
+	op := runBulkOperation(t, app, userID, bulk.BulkOperation{Action: utils.BulkActionLock, VodIDs: vodIDs})
+	assert.Equal(t, map[uuid.UUID]utils.BulkItemStatus{unlocked.ID: utils.BulkItemSuccess, locked.ID: utils.BulkItemSkipped}, itemStatuses(op))
+
+	op = runBulkOperation(t, app, userID, bulk.BulkOperation{Action: utils.BulkActionLock, VodIDs: vodIDs})
+	assert.Equal(t, map[uuid.UUID]utils.BulkItemStatus{unlocked.ID: utils.BulkItemSkipped, locked.ID: utils.BulkItemSkipped}, itemStatuses(op))
+
+	op = runBulkOperation(t, app, userID, bulk.BulkOperation{Action: utils.BulkActionUnlock, VodIDs: vodIDs})
+	assert.Equal(t, map[uuid.UUID]utils.BulkItemStatus{unlocked.ID: utils.BulkItemSuccess, locked.ID: utils.BulkItemSuccess}, itemStatuses(op))
+
+	op = runBulkOperation(t, app, userID, bulk.BulkOperation{Action: utils.BulkActionUnlock, VodIDs: vodIDs})
+	assert.Equal(t, utils.Success, op.Status)
+	assert.Equal(t, map[uuid.UUID]utils.BulkItemStatus{unlocked.ID: utils.BulkItemSkipped, locked.ID: utils.BulkItemSkipped}, itemStatuses(op))
+
+	for _, id := range vodIDs {
+		v, err := app.Database.Client.Vod.Get(t.Context(), id)
+		require.NoError(t, err)
+		assert.False(t, v.Locked)
+	}
+}
+
+// TestBulkPlaylist tests adding vods to and removing vods from a playlist
+func TestBulkPlaylist(t *testing.T) {
+	app, userID, first, second := setupAppAndVods(t)
+	vodIDs := []uuid.UUID{first.ID, second.ID}
+
+	p, err := app.PlaylistService.CreatePlaylist(t.Context(), playlist.Playlist{Name: "Test Playlist"})
+	require.NoError(t, err)
+	require.NoError(t, app.PlaylistService.AddVodToPlaylist(t.Context(), p.ID, first.ID))
+
+	playlistVods := func() []uuid.UUID {
+		ids, err := app.Database.Client.Playlist.Query().Where(entPlaylist.ID(p.ID)).QueryVods().IDs(t.Context())
+		require.NoError(t, err)
+		return ids
+	}
+
+	op := runBulkOperation(t, app, userID, bulk.BulkOperation{Action: utils.BulkActionAddToPlaylist, VodIDs: vodIDs, PlaylistID: &p.ID})
+	assert.Equal(t, utils.Success, op.Status)
+	assert.Equal(t, map[uuid.UUID]utils.BulkItemStatus{first.ID: utils.BulkItemSkipped, second.ID: utils.BulkItemSuccess}, itemStatuses(op))
+	assert.ElementsMatch(t, vodIDs, playlistVods())
+
+	op = runBulkOperation(t, app, userID, bulk.BulkOperation{Action: utils.BulkActionRemoveFromPlaylist, VodIDs: []uuid.UUID{second.ID}, PlaylistID: &p.ID})
+	assert.Equal(t, utils.Success, op.Status)
+	assert.Equal(t, map[uuid.UUID]utils.BulkItemStatus{second.ID: utils.BulkItemSuccess}, itemStatuses(op))
+	assert.ElementsMatch(t, []uuid.UUID{first.ID}, playlistVods())
+
+	op = runBulkOperation(t, app, userID, bulk.BulkOperation{Action: utils.BulkActionRemoveFromPlaylist, VodIDs: []uuid.UUID{second.ID}, PlaylistID: &p.ID})
+	assert.Equal(t, map[uuid.UUID]utils.BulkItemStatus{second.ID: utils.BulkItemSkipped}, itemStatuses(op))
+}
@@ -2,6 +2,7 @@ package channel
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -9,12 +10,19 @@ import (
 	"github.com/rs/zerolog/log"
 	"github.com/zibbp/ganymede/ent"
 	"github.com/zibbp/ganymede/ent/channel"
+	"github.com/zibbp/ganymede/ent/predicate"
+	"github.com/zibbp/ganymede/ent/vod"
+	"github.com/zibbp/ganymede/internal/acl"
 	"github.com/zibbp/ganymede/internal/config"
 	"github.com/zibbp/ganymede/internal/database"
 	"github.com/zibbp/ganymede/internal/platform"
+	"github.com/zibbp/ganymede/internal/trash"
 	"github.com/zibbp/ganymede/internal/utils"
 )
 
+// ErrChannelNotFound is returned when the channel does not exist.
+var ErrChannelNotFound = errors.New("channel not found")
+
 type Service struct {
 	Store          *database.Database
 	PlatformTwitch platform.Platform
@@ -50,8 +58,8 @@ func (s *Service) CreateChannel(channelDto Channel) (*ent.Channel, error) {
 	return cha, nil
 }
 
-func (s *Service) GetChannels() ([]*ent.Channel, error) {
-	channels, err := s.Store.Client.Channel.Query().Order(ent.Desc(channel.FieldCreatedAt)).All(context.Background())
+func (s *Service) GetChannels(ctx context.Context) ([]*ent.Channel, error) {
+	channels, err := s.Store.Client.Channel.Query().Where(acl.Channels(ctx)).Order(ent.Desc(channel.FieldCreatedAt)).All(ctx)
 	if err != nil {
 		log.Debug().Err(err).Msg("error getting channels")
 		return nil, fmt.Errorf("error getting channels: %v", err)
@@ -61,7 +69,9 @@ func (s *Service) GetChannels() ([]*ent.Channel, error) {
 }
 
 func (s *Service) GetChannel(channelID uuid.UUID) (*ent.Channel, error) {
-	cha, err := s.Store.Client.Channel.Query().Where(channel.ID(channelID)).WithVods().Only(context.Background())
+	cha, err := s.Store.Client.Channel.Query().Where(channel.ID(channelID), channel.DeletedAtIsNil()).WithVods(func(q *ent.VodQuery) {
+		q.Where(vod.DeletedAtIsNil())
+	}).Only(context.Background())
 	if err != nil {
 		// if channel not found
 		if _, ok := err.(*ent.NotFoundError); ok {
@@ -103,11 +113,11 @@ func (s *Service) GetChannelByExtId(id string) (*ent.Channel, error) {
 	return cha, nil
 }
 
+// DeleteChannel moves the channel and its vods to the trash, they are permanently deleted when the trash is purged.
 func (s *Service) DeleteChannel(channelID uuid.UUID) error {
-	err := s.Store.Client.Channel.DeleteOneID(channelID).Exec(context.Background())
+	err := trash.TrashChannel(context.Background(), s.Store, channelID)
 	if err != nil {
-		// if channel not found
-		if _, ok := err.(*ent.NotFoundError); ok {
+		if errors.Is(err, trash.ErrChannelNotFound) {
 			return fmt.Errorf("channel not found")
 		}
 		log.Debug().Err(err).Msg("error deleting channel")
@@ -131,6 +141,37 @@ func (s *Service) UpdateChannel(cId uuid.UUID, channelDto Channel) (*ent.Channel
 	return cha, nil
 }
 
+// SetChannelAccess sets who can view the channel and its vods.
+func (s *Service) SetChannelAccess(ctx context.Context, channelID uuid.UUID, access acl.Access) (*ent.Channel, error) {
+	cha, err := s.Store.Client.Channel.UpdateOneID(channelID).
+		SetVisibility(access.Visibility).
+		SetAllowedGroups(access.Groups).
+		ClearAllowedUsers().
+		AddAllowedUserIDs(access.UserIDs...).
+		Save(ctx)
+	if err != nil {
+		if _, ok := err.(*ent.NotFoundError); ok {
+			return nil, ErrChannelNotFound
+		}
+		return nil, fmt.Errorf("error updating channel access: %v", err)
+	}
+
+	return s.GetChannelAccess(ctx, cha.ID)
+}
+
+// GetChannelAccess returns the channel with the users allowed to view it.
+func (s *Service) GetChannelAccess(ctx context.Context, channelID uuid.UUID) (*ent.Channel, error) {
+	cha, err := s.Store.Client.Channel.Query().Where(channel.ID(channelID)).WithAllowedUsers().Only(ctx)
+	if err != nil {
+		if _, ok := err.(*ent.NotFoundError); ok {
+			return nil, ErrChannelNotFound
+		}
+		return nil, fmt.Errorf("error getting channel access: %v", err)
+	}
+
+	return cha, nil
+}
+
 func (s *Service) CheckChannelExists(cName string) bool {
 	_, err := s.Store.Client.Channel.Query().Where(channel.Name(cName)).Only(context.Background())
 	if err != nil {
@@ -160,6 +201,27 @@ func (s *Service) CheckChannelExistsByExtId(id string) bool {
 	return true
 }
 
+// CheckChannelInTrash returns trash.ErrChannelInTrash if the channel with the name is in the trash
+func (s *Service) CheckChannelInTrash(cName string) error {
+	return s.checkChannelInTrash(channel.Name(cName))
+}
+
+// CheckChannelInTrashByExtId returns trash.ErrChannelInTrash if the channel with the external (platform) ID is in the trash
+func (s *Service) CheckChannelInTrashByExtId(id string) error {
+	return s.checkChannelInTrash(channel.ExtID(id))
+}
+
+func (s *Service) checkChannelInTrash(where predicate.Channel) error {
+	trashed, err := s.Store.Client.Channel.Query().Where(where, channel.DeletedAtNotNil()).Exist(context.Background())
+	if err != nil {
+		return fmt.Errorf("error checking channel trash: %v", err)
+	}
+	if trashed {
+		return trash.ErrChannelInTrash
+	}
+	return nil
+}
+
 func (s *Service) PopulateExternalChannelID(ctx context.Context) {
 	channels, err := database.DB().Client.Channel.Query().All(context.Background())
 	if err != nil {
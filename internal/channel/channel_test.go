@@ -1,6 +1,7 @@
 package channel_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -68,7 +69,7 @@ func (s *ChannelTest) CreateChannelInvalidTest(t *testing.T) {
 
 // GetChannelsTest tests the GetChannels function
 func (s *ChannelTest) GetChannelsTest(t *testing.T) {
-	channels, err := s.App.ChannelService.GetChannels()
+	channels, err := s.App.ChannelService.GetChannels(context.Background())
 	assert.NoError(t, err)
 	assert.Equal(t, 1, len(channels))
 }
@@ -125,6 +126,11 @@ func (s *ChannelTest) DeleteChannelTest(t *testing.T) {
 	assert.NoError(t, err)
 	_, err = s.App.ChannelService.GetChannel(channel.ID)
 	assert.Error(t, err)
+
+	_, err = s.App.TrashService.RestoreChannel(context.Background(), channel.ID)
+	assert.NoError(t, err)
+	_, err = s.App.ChannelService.GetChannel(channel.ID)
+	assert.NoError(t, err)
 }
 
 // UpdateChannelTest tests the UpdateChannel function
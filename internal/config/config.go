@@ -25,6 +25,10 @@ type Config struct {
 		GenerateSpriteThumbnails bool `json:"generate_sprite_thumbnails"` // Generate sprite thumbnails for scrubbing.
 	} `json:"archive"`
 	Notification     Notification    `json:"notifications"`     // Notification templates and settings.
+	DiskGuard        DiskGuard       `json:"disk_guard"`        // Pausing of archiving before the videos or temp directory runs out of space.
+	Auth             Auth            `json:"auth"`              // Authentication settings of local accounts.
+	Audit            Audit           `json:"audit"`             // Audit log of administrative and destructive changes.
+	Trash            Trash           `json:"trash"`             // Restoring deleted vods and channels.
 	StorageTemplates StorageTemplate `json:"storage_templates"` // Storage folder/file templates.
 	Livestream       struct {
 		Proxies         []ProxyListItem `json:"proxies" validate:"dive"` // List of proxies for live stream download.
@@ -37,6 +41,47 @@ type Config struct {
 	} `json:"experimental"`
 }
 
+// DiskGuard defines the free space thresholds of the videos and temp directories.
+type DiskGuard struct {
+	Enabled            bool `json:"enabled"`
+	PauseThresholdGB   int  `json:"pause_threshold_gb" validate:"min=0"`  // Free space below which video downloads and post processing are paused and new video archives are refused.
+	ResumeThresholdGB  int  `json:"resume_threshold_gb" validate:"min=0"` // Free space above which paused queues are resumed, higher than the pause threshold to avoid pausing repeatedly.
+	LiveFloorGB        int  `json:"live_floor_gb" validate:"min=0"`       // Free space below which live recordings are stopped and new live archives are refused.
+	PruneBeforePausing bool `json:"prune_before_pausing"`                 // Delete videos past their channel's retention before pausing.
+}
+
+// Audit defines how long audit log entries are kept.
+type Audit struct {
+	RetentionDays int `json:"retention_days" validate:"min=0"` // Days audit log entries are kept, 0 keeps them forever.
+}
+
+// Trash defines how long deleted vods and channels are kept.
+type Trash struct {
+	RetentionDays int `json:"retention_days" validate:"min=0"` // Days deleted vods and channels can be restored before they are purged, 0 deletes them immediately. Trashed files still use disk space until purged.
+}
+
+// Auth defines authentication settings of local, OAuth and LDAP accounts.
+type Auth struct {
+	RequireTwoFactorRoles     []utils.Role  `json:"require_two_factor_roles" validate:"dive,oneof=admin editor archiver user"` // Roles that must enable two-factor authentication before using the API, OAuth users are exempt.
+	LoginMaxFailures          int           `json:"login_max_failures" validate:"min=0"`                                       // Failed logins of a username before it is locked out, 0 disables the lockout.
+	LoginMaxFailuresPerIP     int           `json:"login_max_failures_per_ip" validate:"min=0"`                                // Failed logins from an IP address before it is locked out, 0 disables the lockout.
+	LockoutMinutes            int           `json:"lockout_minutes" validate:"min=1"`                                          // How long lockouts last and how long failed logins are remembered.
+	RegistrationsPerIPPerHour int           `json:"registrations_per_ip_per_hour" validate:"min=0"`                            // Registrations allowed from an IP address per hour, 0 disables the limit.
+	OIDCRoleClaim             string        `json:"oidc_role_claim"`                                                           // ID token claim mapped to roles, nested claims are separated by dots, e.g. realm_access.roles.
+	OIDCRoleMappings          []RoleMapping `json:"oidc_role_mappings" validate:"dive"`                                        // Claim values mapped to roles, the highest mapped role is used. Groups named ganymede-<role> are used if empty.
+	OIDCDenyUnmapped          bool          `json:"oidc_deny_unmapped"`                                                        // Deny OAuth logins without a mapped claim value instead of giving them the user role.
+	LDAPRoleMappings          []RoleMapping `json:"ldap_role_mappings" validate:"dive"`                                        // LDAP groups, by DN or common name, mapped to roles, the highest mapped role is used. Groups named ganymede-<role> are used if empty.
+	LDAPDenyUnmapped          bool          `json:"ldap_deny_unmapped"`                                                        // Deny LDAP logins without a mapped group instead of giving them the user role.
+	MediaTokenMinutes         int           `json:"media_token_minutes" validate:"min=1"`                                      // How long signed media URLs and cookies are valid.
+	RequireMediaToken         bool          `json:"require_media_token"`                                                       // Only serve media files with a signed URL or cookie, otherwise files of channels the session can view are served too.
+}
+
+// RoleMapping maps an OIDC claim value or LDAP group to a role.
+type RoleMapping struct {
+	Value string     `json:"value" validate:"required"`
+	Role  utils.Role `json:"role" validate:"required,oneof=admin editor archiver user"`
+}
+
 // Notification defines webhook URLs and templates for various events.
 type Notification struct {
 	VideoSuccessWebhookUrl string `json:"video_success_webhook_url"`
@@ -51,6 +96,56 @@ type Notification struct {
 	IsLiveWebhookUrl       string `json:"is_live_webhook_url"`
 	IsLiveTemplate         string `json:"is_live_template"`
 	IsLiveEnabled          bool   `json:"is_live_enabled"`
+
+	ArchiveQueuedWebhookUrl     string `json:"archive_queued_webhook_url"`
+	ArchiveQueuedTemplate       string `json:"archive_queued_template"`
+	ArchiveQueuedEnabled        bool   `json:"archive_queued_enabled"`
+	ArchiveStartedWebhookUrl    string `json:"archive_started_webhook_url"`
+	ArchiveStartedTemplate      string `json:"archive_started_template"`
+	ArchiveStartedEnabled       bool   `json:"archive_started_enabled"`
+	VideoDeletedWebhookUrl      string `json:"video_deleted_webhook_url"`
+	VideoDeletedTemplate        string `json:"video_deleted_template"`
+	VideoDeletedEnabled         bool   `json:"video_deleted_enabled"`
+	DiskLowWebhookUrl           string `json:"disk_low_webhook_url"`
+	DiskLowTemplate             string `json:"disk_low_template"`
+	DiskLowEnabled              bool   `json:"disk_low_enabled"`
+	DiskLowThresholdGB          int    `json:"disk_low_threshold_gb" validate:"min=0"` // Free space of the videos or temp directory below which the disk low notification is sent.
+	CredentialInvalidWebhookUrl string `json:"credential_invalid_webhook_url"`
+	CredentialInvalidTemplate   string `json:"credential_invalid_template"`
+	CredentialInvalidEnabled    bool   `json:"credential_invalid_enabled"`
+	WatchdogFailedWebhookUrl    string `json:"watchdog_failed_webhook_url"`
+	WatchdogFailedTemplate      string `json:"watchdog_failed_template"`
+	WatchdogFailedEnabled       bool   `json:"watchdog_failed_enabled"`
+	LoginLockoutWebhookUrl      string `json:"login_lockout_webhook_url"`
+	LoginLockoutTemplate        string `json:"login_lockout_template"`
+	LoginLockoutEnabled         bool   `json:"login_lockout_enabled"`
+
+	ApplicationURL string                 `json:"application_url"`           // Public URL of Ganymede used for links and images in notifications.
+	Providers      []NotificationProvider `json:"providers" validate:"dive"` // Notification providers events are routed to in addition to the webhook URLs above.
+}
+
+// NotificationProvider defines a notification destination and the events sent to it.
+type NotificationProvider struct {
+	Name         string                         `json:"name" validate:"required,min=1"`
+	Type         utils.NotificationProviderType `json:"type" validate:"required,oneof=webhook discord slack ntfy gotify apprise smtp"`
+	Enabled      bool                           `json:"enabled"`
+	Events       []utils.NotificationEvent      `json:"events" validate:"dive,oneof=video_success live_success error is_live archive_queued archive_started video_deleted disk_low credential_invalid watchdog_failed login_lockout"` // Events sent to this provider.
+	URL          string                         `json:"url" validate:"required_unless=Type smtp"`                                                                                                                                     // Webhook URL, ntfy topic URL, Gotify server URL or Apprise notify URL.
+	Token        string                         `json:"token"`                                                                                                                                                                        // ntfy access token or Gotify application token.
+	Headers      map[string]string              `json:"headers"`                                                                                                                                                                      // Extra headers sent with HTTP requests.
+	SMTP         SMTPSettings                   `json:"smtp"`                                                                                                                                                                         // Settings for the smtp provider.
+	BodyTemplate string                         `json:"body_template"`                                                                                                                                                                // Go template of the entire JSON request body, replaces the payload of HTTP providers.
+}
+
+// SMTPSettings defines the mail server and recipients of the smtp notification provider.
+type SMTPSettings struct {
+	Host     string   `json:"host"`
+	Port     int      `json:"port"`
+	Username string   `json:"username"`
+	Password string   `json:"password"`
+	From     string   `json:"from"`
+	To       []string `json:"to"`
+	TLS      bool     `json:"tls"` // Use implicit TLS. STARTTLS is used when the server supports it otherwise.
 }
 
 // StorageTemplate defines folder and file naming patterns.
@@ -179,6 +274,57 @@ func (c *Config) setDefaults() {
 	c.Notification.IsLiveWebhookUrl = ""
 	c.Notification.IsLiveTemplate = "🔴 {{channel_display_name}} is live!"
 	c.Notification.IsLiveEnabled = true
+	c.Notification.ArchiveQueuedWebhookUrl = ""
+	c.Notification.ArchiveQueuedTemplate = "📥 Archive Queued: {{vod_title}} by {{channel_display_name}}."
+	c.Notification.ArchiveQueuedEnabled = true
+	c.Notification.ArchiveStartedWebhookUrl = ""
+	c.Notification.ArchiveStartedTemplate = "⏬ Archive Started: {{vod_title}} by {{channel_display_name}}."
+	c.Notification.ArchiveStartedEnabled = true
+	c.Notification.VideoDeletedWebhookUrl = ""
+	c.Notification.VideoDeletedTemplate = "🗑️ Video Deleted: {{.Vod.Title}} by {{.Channel.DisplayName}} was deleted by {{.Reason}}, freeing {{formatBytes .FreedBytes}}."
+	c.Notification.VideoDeletedEnabled = true
+	c.Notification.DiskLowWebhookUrl = ""
+	c.Notification.DiskLowTemplate = "💾 Disk Low: {{.Disk.Path}} has {{formatBytes .Disk.FreeBytes}} of {{formatBytes .Disk.TotalBytes}} free."
+	c.Notification.DiskLowEnabled = true
+	c.Notification.DiskLowThresholdGB = 20
+	c.Notification.CredentialInvalidWebhookUrl = ""
+	c.Notification.CredentialInvalidTemplate = "🔑 Credential Invalid: {{.Credential}} is no longer valid: {{.Reason}}"
+	c.Notification.CredentialInvalidEnabled = true
+	c.Notification.WatchdogFailedWebhookUrl = ""
+	c.Notification.WatchdogFailedTemplate = "⚠️ Watchdog: Queue {{queue_id}} stopped responding at task {{failed_task}} and was failed."
+	c.Notification.WatchdogFailedEnabled = true
+	c.Notification.LoginLockoutWebhookUrl = ""
+	c.Notification.LoginLockoutTemplate = "🔒 Login Locked: {{.Lockout.Kind}} {{.Lockout.Value}} is locked until {{formatDate \"15:04 MST\" .Lockout.LockedUntil}} after {{.Lockout.Attempts}} failed login attempts."
+	c.Notification.LoginLockoutEnabled = true
+	c.Notification.ApplicationURL = ""
+	c.Notification.Providers = []NotificationProvider{}
+
+	// disk guard
+	c.DiskGuard.Enabled = false
+	c.DiskGuard.PauseThresholdGB = 20
+	c.DiskGuard.ResumeThresholdGB = 30
+	c.DiskGuard.LiveFloorGB = 5
+	c.DiskGuard.PruneBeforePausing = false
+
+	// auth
+	c.Auth.RequireTwoFactorRoles = []utils.Role{}
+	c.Auth.LoginMaxFailures = 5
+	c.Auth.LoginMaxFailuresPerIP = 20
+	c.Auth.LockoutMinutes = 15
+	c.Auth.RegistrationsPerIPPerHour = 5
+	c.Auth.OIDCRoleClaim = "groups"
+	c.Auth.OIDCRoleMappings = []RoleMapping{}
+	c.Auth.OIDCDenyUnmapped = false
+	c.Auth.LDAPRoleMappings = []RoleMapping{}
+	c.Auth.LDAPDenyUnmapped = false
+	c.Auth.MediaTokenMinutes = 240
+	c.Auth.RequireMediaToken = false
+
+	// audit
+	c.Audit.RetentionDays = 365
+
+	// trash
+	c.Trash.RetentionDays = 30
 
 	// storage templates
 	c.StorageTemplates.FolderTemplate = "{{date}}-{{id}}-{{type}}-{{uuid}}"
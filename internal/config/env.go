@@ -25,12 +25,15 @@ type EnvConfig struct {
 	// application
 	Development bool `env:"DEVELOPMENT"`
 	DEBUG       bool `env:"DEBUG, default=false"`
+	// Reverse proxies X-Forwarded-For is trusted from, as IP addresses or CIDR ranges. The connecting address is used as the client IP if empty.
+	TrustedProxies []string `env:"TRUSTED_PROXIES"`
 	// customizable paths
 	VideosDir            string `env:"VIDEOS_DIR, default=/data/videos"`
 	TempDir              string `env:"TEMP_DIR, default=/data/temp"`
 	ConfigDir            string `env:"CONFIG_DIR, default=/data/config"`
 	LogsDir              string `env:"LOGS_DIR, default=/data/logs"`
 	PathMigrationEnabled bool   `env:"PATH_MIGRATION_ENABLED, default=true"`
+	TrashDir             string `env:"TRASH_DIR, default="` // Folder files of deleted vods are moved to until purged, .trash in VIDEOS_DIR if empty so moving does not copy files.
 	// platform variables
 	TwitchClientId     string `env:"TWITCH_CLIENT_ID, required"`
 	TwitchClientSecret string `env:"TWITCH_CLIENT_SECRET, required"`
@@ -54,6 +57,22 @@ type EnvConfig struct {
 	OAuthClientSecret string `env:"OAUTH_CLIENT_SECRET, default="`
 	OAuthRedirectURL  string `env:"OAUTH_REDIRECT_URL, default="`
 
+	// ldap
+	LDAPEnabled                  bool   `env:"LDAP_ENABLED, default=false"`
+	LDAPURL                      string `env:"LDAP_URL, default="`                 // ldap://host:389 or ldaps://host:636
+	LDAPStartTLS                 bool   `env:"LDAP_START_TLS, default=true"`       // upgrades ldap:// connections, ldaps:// connections are encrypted already
+	LDAPAllowInsecure            bool   `env:"LDAP_ALLOW_INSECURE, default=false"` // allow ldap:// without StartTLS, passwords are sent in cleartext
+	LDAPInsecureSkipVerify       bool   `env:"LDAP_INSECURE_SKIP_VERIFY, default=false"`
+	LDAPBindDN                   string `env:"LDAP_BIND_DN, default="` // service account used to search users, anonymous search if empty
+	LDAPBindPassword             string `env:"LDAP_BIND_PASSWORD, default="`
+	LDAPUserSearchBase           string `env:"LDAP_USER_SEARCH_BASE, default="`
+	LDAPUserFilter               string `env:"LDAP_USER_FILTER, default=(uid={username})"` // use (sAMAccountName={username}) for Active Directory
+	LDAPUsernameAttribute        string `env:"LDAP_USERNAME_ATTRIBUTE, default=uid"`
+	LDAPGroupMembershipAttribute string `env:"LDAP_GROUP_MEMBERSHIP_ATTRIBUTE, default=memberOf"`
+
+	// media
+	MediaSigningKey string `env:"MEDIA_SIGNING_KEY, default="` // Key signed media URLs are signed with, a random key is used if empty which is not shared between replicas.
+
 	// frontend
 	CDN_URL string `env:"CDN_URL, default="` // Populate if using an external host for the static files (Nginx, S3, etc). By default Ganymede will serve the VIDEOS_DIR directory.
 }
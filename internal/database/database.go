@@ -125,6 +125,14 @@ func NewDatabase(ctx context.Context, input DatabaseConnectionInput) *Database {
 	return db
 }
 
+// Rollback rolls back the transaction and returns err, with the rollback error if rolling back failed.
+func Rollback(tx *ent.Tx, err error) error {
+	if rerr := tx.Rollback(); rerr != nil {
+		return fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+	}
+	return err
+}
+
 func seedDatabase(client *ent.Client) error {
 
 	// Create initial user
@@ -0,0 +1,75 @@
+// Package diskguard checks the free space of the videos and temp directories so archiving can be paused before the volume fills.
+package diskguard
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/zibbp/ganymede/internal/config"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+const bytesPerGB = 1024 * 1024 * 1024
+
+var ErrDiskSpaceLow = errors.New("not enough free disk space")
+
+// Usage is the lowest free space of the videos and temp directories.
+type Usage struct {
+	Path      string `json:"path"`
+	FreeBytes int64  `json:"free_bytes"`
+}
+
+// LowestFreeSpace returns the directory with the least free space.
+func LowestFreeSpace() (Usage, error) {
+	env := config.GetEnvConfig()
+	return lowestFreeSpace(env.VideosDir, env.TempDir)
+}
+
+func lowestFreeSpace(paths ...string) (Usage, error) {
+	var lowest Usage
+	for i, path := range paths {
+		free, _, err := utils.GetDiskUsage(path)
+		if err != nil {
+			return Usage{}, err
+		}
+		if i == 0 || free < lowest.FreeBytes {
+			lowest = Usage{Path: path, FreeBytes: free}
+		}
+	}
+	return lowest, nil
+}
+
+// CheckArchive returns ErrDiskSpaceLow if there is not enough free space to archive videos.
+func CheckArchive() error {
+	guard := config.Get().DiskGuard
+	if !guard.Enabled {
+		return nil
+	}
+	return checkThreshold(LowestFreeSpace, guard.PauseThresholdGB)
+}
+
+// CheckLiveArchive returns ErrDiskSpaceLow if there is not enough free space to record live streams.
+func CheckLiveArchive() error {
+	guard := config.Get().DiskGuard
+	if !guard.Enabled {
+		return nil
+	}
+	return checkThreshold(LowestFreeSpace, guard.LiveFloorGB)
+}
+
+func checkThreshold(freeSpace func() (Usage, error), thresholdGB int) error {
+	usage, err := freeSpace()
+	if err != nil {
+		// do not refuse archives if the free space is unknown
+		return nil
+	}
+	if usage.FreeBytes < GBToBytes(thresholdGB) {
+		return fmt.Errorf("%w: %s has %.1f GB free, %d GB required", ErrDiskSpaceLow, usage.Path, float64(usage.FreeBytes)/bytesPerGB, thresholdGB)
+	}
+	return nil
+}
+
+// GBToBytes converts a threshold from the config to bytes.
+func GBToBytes(gb int) int64 {
+	return int64(gb) * bytesPerGB
+}
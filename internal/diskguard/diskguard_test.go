@@ -0,0 +1,46 @@
+package diskguard
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCheckThreshold(t *testing.T) {
+	tests := []struct {
+		name        string
+		usage       Usage
+		err         error
+		thresholdGB int
+		wantLow     bool
+	}{
+		{name: "enough space", usage: Usage{Path: "/data/videos", FreeBytes: GBToBytes(50)}, thresholdGB: 20},
+		{name: "below threshold", usage: Usage{Path: "/data/temp", FreeBytes: GBToBytes(10)}, thresholdGB: 20, wantLow: true},
+		{name: "no threshold", usage: Usage{Path: "/data/videos", FreeBytes: 0}, thresholdGB: 0},
+		{name: "unknown usage", err: errors.New("no such file or directory"), thresholdGB: 20},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkThreshold(func() (Usage, error) { return tt.usage, tt.err }, tt.thresholdGB)
+			if low := errors.Is(err, ErrDiskSpaceLow); low != tt.wantLow {
+				t.Errorf("checkThreshold() error = %v, want low %v", err, tt.wantLow)
+			}
+		})
+	}
+}
+
+func TestLowestFreeSpace(t *testing.T) {
+	videosDir, tempDir := t.TempDir(), t.TempDir()
+
+	usage, err := lowestFreeSpace(videosDir, tempDir)
+	if err != nil {
+		t.Fatalf("lowestFreeSpace() error = %v", err)
+	}
+	if usage.Path != videosDir && usage.Path != tempDir {
+		t.Errorf("lowestFreeSpace() path = %q", usage.Path)
+	}
+
+	if _, err := lowestFreeSpace(videosDir, "/does/not/exist"); err == nil {
+		t.Error("expected error for missing directory")
+	}
+}
@@ -0,0 +1,200 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog/log"
+	"github.com/zibbp/ganymede/internal/database"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// channelName is the Postgres notification channel events are published on.
+//
+// Events are sent through Postgres so events published by a worker process reach clients connected to the server process.
+const channelName = "ganymede_events"
+
+// subscriberBufferSize is the number of events buffered per subscriber. Events are dropped for subscribers that fall behind.
+const subscriberBufferSize = 64
+
+type EventType string
+
+const (
+	QueueItemCreated  EventType = "queue_item_created"
+	TaskStatusChanged EventType = "task_status_changed"
+	ArchiveCompleted  EventType = "archive_completed"
+	ChannelLive       EventType = "channel_live"
+	ChannelOffline    EventType = "channel_offline"
+	VideoDeleted      EventType = "video_deleted"
+)
+
+func (EventType) Values() []string {
+	return []string{string(QueueItemCreated), string(TaskStatusChanged), string(ArchiveCompleted), string(ChannelLive), string(ChannelOffline), string(VideoDeleted)}
+}
+
+type Event struct {
+	Type EventType       `json:"type"`
+	Time time.Time       `json:"time"`
+	Data json.RawMessage `json:"data"`
+}
+
+type QueueItemCreatedData struct {
+	QueueID     uuid.UUID `json:"queue_id"`
+	VideoID     uuid.UUID `json:"video_id"`
+	LiveArchive bool      `json:"live_archive"`
+}
+
+type TaskStatusChangedData struct {
+	QueueID uuid.UUID        `json:"queue_id"`
+	Task    utils.TaskName   `json:"task"`
+	Status  utils.TaskStatus `json:"status"`
+}
+
+type ArchiveCompletedData struct {
+	QueueID     uuid.UUID `json:"queue_id"`
+	VideoID     uuid.UUID `json:"video_id"`
+	ChannelID   uuid.UUID `json:"channel_id"`
+	LiveArchive bool      `json:"live_archive"`
+}
+
+type ChannelLiveData struct {
+	ChannelID   uuid.UUID  `json:"channel_id"`
+	ChannelName string     `json:"channel_name"`
+	VideoID     *uuid.UUID `json:"video_id,omitempty"` // video of the live archive, only set when the channel went live
+}
+
+type VideoDeletedData struct {
+	VideoID   uuid.UUID `json:"video_id"`
+	ChannelID uuid.UUID `json:"channel_id"`
+	Reason    string    `json:"reason"`
+}
+
+// Publish sends an event to all subscribers in every server process. Errors are logged as events are best effort.
+func Publish(ctx context.Context, eventType EventType, data any) {
+	store := database.DB()
+	if store == nil || store.ConnPool == nil {
+		return
+	}
+
+	payload, err := json.Marshal(data)
+	if err != nil {
+		log.Error().Err(err).Str("event", string(eventType)).Msg("error marshalling event data")
+		return
+	}
+	event, err := json.Marshal(Event{
+		Type: eventType,
+		Time: time.Now(),
+		Data: payload,
+	})
+	if err != nil {
+		log.Error().Err(err).Str("event", string(eventType)).Msg("error marshalling event")
+		return
+	}
+
+	if _, err := store.ConnPool.Exec(ctx, "SELECT pg_notify($1, $2)", channelName, string(event)); err != nil {
+		log.Error().Err(err).Str("event", string(eventType)).Msg("error publishing event")
+	}
+}
+
+// Broker listens for published events and fans them out to subscribers of this process.
+type Broker struct {
+	Store       *database.Database
+	mu          sync.RWMutex
+	subscribers map[chan Event]struct{}
+}
+
+func NewBroker(store *database.Database) *Broker {
+	return &Broker{
+		Store:       store,
+		subscribers: make(map[chan Event]struct{}),
+	}
+}
+
+// Subscribe returns a channel receiving all events and a function to unsubscribe.
+func (b *Broker) Subscribe() (<-chan Event, func()) {
+	ch := make(chan Event, subscriberBufferSize)
+
+	b.mu.Lock()
+	b.subscribers[ch] = struct{}{}
+	b.mu.Unlock()
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			b.mu.Lock()
+			delete(b.subscribers, ch)
+			b.mu.Unlock()
+			close(ch)
+		})
+	}
+}
+
+func (b *Broker) broadcast(event Event) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	for ch := range b.subscribers {
+		select {
+		case ch <- event:
+		default:
+			log.Debug().Str("event", string(event.Type)).Msg("dropping event for slow subscriber")
+		}
+	}
+}
+
+// Start listens for events until the context is cancelled, reconnecting if the connection is lost.
+func (b *Broker) Start(ctx context.Context) {
+	retryDelay := time.Second
+	for {
+		err := b.listen(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		log.Error().Err(err).Msgf("error listening for events, reconnecting in %s", retryDelay)
+
+		timer := time.NewTimer(retryDelay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+		retryDelay = min(retryDelay*2, 30*time.Second)
+	}
+}
+
+func (b *Broker) listen(ctx context.Context) error {
+	// a dedicated connection is used as LISTEN is bound to the connection
+	conn, err := pgx.ConnectConfig(ctx, b.Store.ConnPool.Config().ConnConfig)
+	if err != nil {
+		return fmt.Errorf("error connecting: %w", err)
+	}
+	defer func() {
+		if err := conn.Close(context.Background()); err != nil {
+			log.Debug().Err(err).Msg("error closing events connection")
+		}
+	}()
+
+	if _, err := conn.Exec(ctx, "LISTEN "+pgx.Identifier{channelName}.Sanitize()); err != nil {
+		return fmt.Errorf("error listening: %w", err)
+	}
+	log.Debug().Msg("listening for events")
+
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return fmt.Errorf("error waiting for notification: %w", err)
+		}
+
+		var event Event
+		if err := json.Unmarshal([]byte(notification.Payload), &event); err != nil {
+			log.Debug().Err(err).Msg("error unmarshalling event")
+			continue
+		}
+		b.broadcast(event)
+	}
+}
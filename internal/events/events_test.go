@@ -0,0 +1,52 @@
+package events
+
+import (
+	"testing"
+)
+
+func TestBrokerBroadcast(t *testing.T) {
+	broker := NewBroker(nil)
+
+	first, unsubscribeFirst := broker.Subscribe()
+	second, unsubscribeSecond := broker.Subscribe()
+	defer unsubscribeSecond()
+
+	broker.broadcast(Event{Type: QueueItemCreated})
+
+	for _, ch := range []<-chan Event{first, second} {
+		select {
+		case event := <-ch:
+			if event.Type != QueueItemCreated {
+				t.Errorf("received event type %s, want %s", event.Type, QueueItemCreated)
+			}
+		default:
+			t.Fatal("subscriber did not receive event")
+		}
+	}
+
+	unsubscribeFirst()
+	// unsubscribing twice must not panic
+	unsubscribeFirst()
+	if _, ok := <-first; ok {
+		t.Error("channel was not closed after unsubscribing")
+	}
+
+	broker.broadcast(Event{Type: ChannelLive})
+	if event := <-second; event.Type != ChannelLive {
+		t.Errorf("received event type %s, want %s", event.Type, ChannelLive)
+	}
+}
+
+func TestBrokerDropsEventsForSlowSubscribers(t *testing.T) {
+	broker := NewBroker(nil)
+	ch, unsubscribe := broker.Subscribe()
+	defer unsubscribe()
+
+	for range subscriberBufferSize + 10 {
+		broker.broadcast(Event{Type: TaskStatusChanged})
+	}
+
+	if len(ch) != subscriberBufferSize {
+		t.Errorf("buffered %d events, want %d", len(ch), subscriberBufferSize)
+	}
+}
@@ -27,7 +27,7 @@ const (
 )
 
 // DownloadTwitchVideo downloads a Twitch video.
-func DownloadTwitchVideo(ctx context.Context, video ent.Vod) error {
+func DownloadTwitchVideo(ctx context.Context, video ent.Vod, onProgress ProgressFunc) error {
 	// Get video channel
 	videoChannel := video.QueryChannel()
 	channel, err := videoChannel.Only(ctx)
@@ -39,8 +39,9 @@ func DownloadTwitchVideo(ctx context.Context, video ent.Vod) error {
 	env := config.GetEnvConfig()
 
 	// Open download log file
+	// The log is appended to so output of previous attempts is kept when the download is resumed
 	logFilePath := fmt.Sprintf("%s/%s-video.log", env.LogsDir, video.ID.String())
-	file, err := os.Create(logFilePath)
+	file, err := os.OpenFile(logFilePath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
 	if err != nil {
 		return fmt.Errorf("failed to open log file: %w", err)
 	}
@@ -78,10 +79,7 @@ func DownloadTwitchVideo(ctx context.Context, video ent.Vod) error {
 	qualityString := ytdlpSvc.CreateQualityOption(closestQuality)
 
 	// Build output path
-	// yt-dlp will sometimes download two separate files for audio and video
-	// so we need to remove the extension and let yt-dlp add the extension
-	tmpVideoDownloadExt := filepath.Ext(video.TmpVideoDownloadPath)
-	tmpVideoDownloadPathNoExt := strings.TrimSuffix(video.TmpVideoDownloadPath, tmpVideoDownloadExt)
+	tmpVideoDownloadPathNoExt := VideoDownloadPathNoExt(video)
 
 	// Get user arguments from config
 	configYtDlpArgs := config.Get().Parameters.YtDlpVideo
@@ -92,7 +90,8 @@ func DownloadTwitchVideo(ctx context.Context, video ent.Vod) error {
 		"-f", qualityString,
 		url,
 		"-o", fmt.Sprintf("%s.%%(ext)s", tmpVideoDownloadPathNoExt),
-		"--merge-output-format", "mp4", "--no-part",
+		// part files are kept so a retried download resumes where the previous attempt stopped
+		"--merge-output-format", "mp4", "--continue",
 		"--no-warnings", "--progress", "--newline", "--no-check-certificate",
 	)
 
@@ -121,8 +120,8 @@ func DownloadTwitchVideo(ctx context.Context, video ent.Vod) error {
 
 	log.Debug().Str("video_id", video.ID.String()).Str("cmd", strings.Join(cmd.Args, " ")).Msgf("running yt-dlp")
 
-	cmd.Stderr = file
-	cmd.Stdout = file
+	flushProgress := setCommandOutput(cmd, file, parseYtDlpProgressLine, onProgress)
+	defer flushProgress()
 
 	setupProcessGroup(cmd)
 	if err := cmd.Start(); err != nil {
@@ -157,7 +156,15 @@ func DownloadTwitchVideo(ctx context.Context, video ent.Vod) error {
 	return nil
 }
 
-func DownloadTwitchLiveVideo(ctx context.Context, video ent.Vod, channel ent.Channel, startChat chan bool) error {
+// VideoDownloadPathNoExt returns the yt-dlp output path of the video without the extension.
+//
+// yt-dlp will sometimes download two separate files for audio and video
+// so we need to remove the extension and let yt-dlp add the extension.
+func VideoDownloadPathNoExt(video ent.Vod) string {
+	return strings.TrimSuffix(video.TmpVideoDownloadPath, filepath.Ext(video.TmpVideoDownloadPath))
+}
+
+func DownloadTwitchLiveVideo(ctx context.Context, video ent.Vod, channel ent.Channel, startChat chan bool, onProgress ProgressFunc) error {
 	video.Edges.Channel = &channel
 	env := config.GetEnvConfig()
 
@@ -269,8 +276,8 @@ func DownloadTwitchLiveVideo(ctx context.Context, video ent.Vod, channel ent.Cha
 	// start chat download
 	startChat <- true
 
-	cmd.Stderr = file
-	cmd.Stdout = file
+	flushProgress := setCommandOutput(cmd, file, newFfmpegProgressParser(0), onProgress)
+	defer flushProgress()
 
 	if err := cmd.Start(); err != nil {
 		return fmt.Errorf("error starting ffmpeg: %w", err)
@@ -319,7 +326,7 @@ func DownloadTwitchLiveVideo(ctx context.Context, video ent.Vod, channel ent.Cha
 	return nil
 }
 
-func PostProcessVideo(ctx context.Context, video ent.Vod) error {
+func PostProcessVideo(ctx context.Context, video ent.Vod, onProgress ProgressFunc) error {
 	env := config.GetEnvConfig()
 	configFfmpegArgs := config.Get().Parameters.VideoConvert
 	arr := strings.Fields(configFfmpegArgs)
@@ -345,8 +352,8 @@ func PostProcessVideo(ctx context.Context, video ent.Vod) error {
 
 	cmd := osExec.CommandContext(ctx, "ffmpeg", ffmpegArgs...)
 
-	cmd.Stderr = file
-	cmd.Stdout = file
+	flushProgress := setCommandOutput(cmd, file, newFfmpegProgressParser(float64(video.Duration)), onProgress)
+	defer flushProgress()
 
 	if err := cmd.Start(); err != nil {
 		return fmt.Errorf("error starting ffmpeg: %w", err)
@@ -377,7 +384,7 @@ func PostProcessVideo(ctx context.Context, video ent.Vod) error {
 	return nil
 }
 
-func ConvertVideoToHLS(ctx context.Context, video ent.Vod) error {
+func ConvertVideoToHLS(ctx context.Context, video ent.Vod, onProgress ProgressFunc) error {
 	env := config.GetEnvConfig()
 	ffmpegArgs := []string{"-y", "-hide_banner", "-i", video.TmpVideoConvertPath, "-c", "copy", "-start_number", "0", "-hls_time", "10", "-hls_list_size", "0", "-hls_segment_filename", fmt.Sprintf("%s/%s_segment%s.ts", video.TmpVideoHlsPath, video.ExtID, "%d"), "-f", "hls", fmt.Sprintf("%s/%s-video.m3u8", video.TmpVideoHlsPath, video.ExtID)}
 
@@ -399,8 +406,8 @@ func ConvertVideoToHLS(ctx context.Context, video ent.Vod) error {
 
 	cmd := osExec.CommandContext(ctx, "ffmpeg", ffmpegArgs...)
 
-	cmd.Stderr = file
-	cmd.Stdout = file
+	flushProgress := setCommandOutput(cmd, file, newFfmpegProgressParser(float64(video.Duration)), onProgress)
+	defer flushProgress()
 
 	if err := cmd.Start(); err != nil {
 		return fmt.Errorf("error starting ffmpeg: %w", err)
@@ -431,7 +438,7 @@ func ConvertVideoToHLS(ctx context.Context, video ent.Vod) error {
 	return nil
 }
 
-func DownloadTwitchChat(ctx context.Context, video ent.Vod) error {
+func DownloadTwitchChat(ctx context.Context, video ent.Vod, onProgress ProgressFunc) error {
 	env := config.GetEnvConfig()
 	// open log file
 	logFilePath := fmt.Sprintf("%s/%s-chat.log", env.LogsDir, video.ID.String())
@@ -453,8 +460,8 @@ func DownloadTwitchChat(ctx context.Context, video ent.Vod) error {
 
 	cmd := osExec.CommandContext(ctx, "TwitchDownloaderCLI", cmdArgs...)
 
-	cmd.Stderr = file
-	cmd.Stdout = file
+	flushProgress := setCommandOutput(cmd, file, parseTwitchDownloaderProgressLine, onProgress)
+	defer flushProgress()
 
 	if err := cmd.Start(); err != nil {
 		return fmt.Errorf("error starting TwitchDownloader: %w", err)
@@ -557,7 +564,7 @@ func DownloadTwitchLiveChat(ctx context.Context, video ent.Vod, channel ent.Chan
 	return nil
 }
 
-func RenderTwitchChat(ctx context.Context, video ent.Vod) error {
+func RenderTwitchChat(ctx context.Context, video ent.Vod, onProgress ProgressFunc) error {
 	env := config.GetEnvConfig()
 	// open log file
 	logFilePath := fmt.Sprintf("%s/%s-chat-render.log", env.LogsDir, video.ID.String())
@@ -586,8 +593,8 @@ func RenderTwitchChat(ctx context.Context, video ent.Vod) error {
 
 	cmd := osExec.CommandContext(ctx, "TwitchDownloaderCLI", cmdArgs...)
 
-	cmd.Stderr = file
-	cmd.Stdout = file
+	flushProgress := setCommandOutput(cmd, file, parseTwitchDownloaderProgressLine, onProgress)
+	defer flushProgress()
 
 	if err := cmd.Start(); err != nil {
 		return fmt.Errorf("error starting TwitchDownloader: %w", err)
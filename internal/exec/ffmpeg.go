@@ -129,3 +129,22 @@ func GetFfprobeVideoData(ctx context.Context, path string) (*FFprobeJsonData, er
 
 	return &data, nil
 }
+
+// CheckVideoDecodes decodes the first and last second of the given video file to ensure the start and end of the file are not corrupt.
+func CheckVideoDecodes(ctx context.Context, path string) error {
+	checks := []struct {
+		name string
+		args []string
+	}{
+		{name: "first second", args: []string{"-v", "error", "-xerror", "-t", "1", "-i", path, "-f", "null", "-"}},
+		{name: "last second", args: []string{"-v", "error", "-xerror", "-sseof", "-1", "-i", path, "-f", "null", "-"}},
+	}
+	for _, check := range checks {
+		cmd := osExec.CommandContext(ctx, "ffmpeg", check.args...)
+		out, err := cmd.CombinedOutput()
+		if err != nil {
+			return fmt.Errorf("error decoding %s of %s: %w: %s", check.name, path, err, strings.TrimSpace(string(out)))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,267 @@
+package exec
+
+import (
+	"bytes"
+	"io"
+	osExec "os/exec"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// ProgressFunc is called with the latest progress parsed from a command's output. It may be nil.
+type ProgressFunc func(progress utils.TaskProgress)
+
+// progressParser parses a single line of command output. It returns false if the line does not contain progress.
+type progressParser func(line string, progress *utils.TaskProgress) bool
+
+var (
+	// [download]  45.3% of ~  2.35GiB at    5.12MiB/s ETA 03:12 (frag 123/456)
+	// [download] 100% of  2.35GiB in 00:10:12 at 3.91MiB/s
+	ytDlpProgressRegex = regexp.MustCompile(`^\[download\]\s+([\d.]+)%\s+of\s+~?\s*([\d.]+\s*[KMGT]?i?B)(?:\s+in\s+[\d:]+)?(?:\s+at\s+(?:([\d.]+\s*[KMGT]?i?B)/s|Unknown\s*B/s))?(?:\s+ETA\s+([\d:]+))?`)
+	// frame= 1234 fps=120 q=-1.0 size=  102400KiB time=00:01:23.45 bitrate=1000.0kbits/s speed=4.5x
+	ffmpegSizeRegex  = regexp.MustCompile(`size=\s*(\d+)\s*([kKMG]i?B)`)
+	ffmpegTimeRegex  = regexp.MustCompile(`time=\s*(-?\d+):(\d+):([\d.]+)`)
+	ffmpegSpeedRegex = regexp.MustCompile(`speed=\s*([\d.]+)x`)
+	// [STATUS] - Downloading 45%
+	// [STATUS] - Rendering Video 45% (00:12:34 Elapsed | 00:05:00 Remaining)
+	twitchDownloaderProgressRegex = regexp.MustCompile(`^\[STATUS\].*?(\d+(?:\.\d+)?)%(?:.*?([\d:]+)\s+Remaining)?`)
+)
+
+// parseByteSize parses sizes such as "2.35GiB", "512KiB" or "1.2MB" to bytes.
+func parseByteSize(s string) (int64, bool) {
+	s = strings.TrimSpace(s)
+	units := []struct {
+		suffix     string
+		multiplier float64
+	}{
+		{"TiB", 1 << 40}, {"GiB", 1 << 30}, {"MiB", 1 << 20}, {"KiB", 1 << 10}, {"kiB", 1 << 10},
+		{"TB", 1e12}, {"GB", 1e9}, {"MB", 1e6}, {"KB", 1e3}, {"kB", 1e3}, {"B", 1},
+	}
+	for _, unit := range units {
+		if strings.HasSuffix(s, unit.suffix) {
+			value, err := strconv.ParseFloat(strings.TrimSpace(strings.TrimSuffix(s, unit.suffix)), 64)
+			if err != nil {
+				return 0, false
+			}
+			return int64(value * unit.multiplier), true
+		}
+	}
+	return 0, false
+}
+
+// parseClockDuration parses durations such as "03:12" or "01:03:12" to seconds.
+func parseClockDuration(s string) (float64, bool) {
+	parts := strings.Split(s, ":")
+	if len(parts) == 0 || len(parts) > 3 {
+		return 0, false
+	}
+	var seconds float64
+	for _, part := range parts {
+		value, err := strconv.ParseFloat(part, 64)
+		if err != nil {
+			return 0, false
+		}
+		seconds = seconds*60 + value
+	}
+	return seconds, true
+}
+
+// parseYtDlpProgressLine parses a yt-dlp `--progress --newline` line.
+func parseYtDlpProgressLine(line string, progress *utils.TaskProgress) bool {
+	matches := ytDlpProgressRegex.FindStringSubmatch(strings.TrimSpace(line))
+	if matches == nil {
+		return false
+	}
+
+	percent, err := strconv.ParseFloat(matches[1], 64)
+	if err != nil {
+		return false
+	}
+	progress.Percent = percent
+
+	if total, ok := parseByteSize(matches[2]); ok {
+		progress.TotalBytes = total
+		progress.DownloadedBytes = int64(float64(total) * percent / 100)
+	}
+	progress.Speed = 0
+	if matches[3] != "" {
+		if speed, ok := parseByteSize(matches[3]); ok {
+			progress.Speed = float64(speed)
+		}
+	}
+	progress.ETASeconds = 0
+	if matches[4] != "" {
+		if eta, ok := parseClockDuration(matches[4]); ok {
+			progress.ETASeconds = int64(eta)
+		}
+	}
+
+	return true
+}
+
+// newFfmpegProgressParser returns a parser for ffmpeg stats lines. The percent is calculated if the total duration in seconds is known.
+func newFfmpegProgressParser(totalDuration float64) progressParser {
+	return func(line string, progress *utils.TaskProgress) bool {
+		timeMatches := ffmpegTimeRegex.FindStringSubmatch(line)
+		if timeMatches == nil {
+			return false
+		}
+		// time is negative (N/A) before the first packet is written
+		hours, _ := strconv.ParseFloat(timeMatches[1], 64)
+		minutes, _ := strconv.ParseFloat(timeMatches[2], 64)
+		seconds, _ := strconv.ParseFloat(timeMatches[3], 64)
+		duration := hours*3600 + minutes*60 + seconds
+		if duration < 0 {
+			duration = 0
+		}
+		progress.DurationSeconds = duration
+
+		if sizeMatches := ffmpegSizeRegex.FindStringSubmatch(line); sizeMatches != nil {
+			// ffmpeg reports both kB and KiB as multiples of 1024 bytes
+			unit := strings.ToUpper(sizeMatches[2][:1]) + "iB"
+			if size, ok := parseByteSize(sizeMatches[1] + unit); ok {
+				progress.DownloadedBytes = size
+			}
+		}
+
+		progress.SpeedFactor = 0
+		if speedMatches := ffmpegSpeedRegex.FindStringSubmatch(line); speedMatches != nil {
+			progress.SpeedFactor, _ = strconv.ParseFloat(speedMatches[1], 64)
+		}
+
+		if totalDuration > 0 {
+			progress.Percent = min(duration/totalDuration*100, 100)
+			if progress.SpeedFactor > 0 {
+				progress.ETASeconds = int64((totalDuration - duration) / progress.SpeedFactor)
+			}
+		}
+
+		return true
+	}
+}
+
+// parseTwitchDownloaderProgressLine parses a TwitchDownloaderCLI status line.
+func parseTwitchDownloaderProgressLine(line string, progress *utils.TaskProgress) bool {
+	matches := twitchDownloaderProgressRegex.FindStringSubmatch(strings.TrimSpace(line))
+	if matches == nil {
+		return false
+	}
+	percent, err := strconv.ParseFloat(matches[1], 64)
+	if err != nil {
+		return false
+	}
+	progress.Percent = percent
+	progress.ETASeconds = 0
+	if matches[2] != "" {
+		if eta, ok := parseClockDuration(matches[2]); ok {
+			progress.ETASeconds = int64(eta)
+		}
+	}
+	return true
+}
+
+// progressWriter writes command output to the underlying writer and parses progress from each line.
+//
+// Lines are split on both \n and \r as ffmpeg and TwitchDownloaderCLI redraw their progress line with carriage returns.
+type progressWriter struct {
+	mu         sync.Mutex
+	w          io.Writer
+	parse      progressParser
+	onProgress ProgressFunc
+	interval   time.Duration
+	lastReport time.Time
+	progress   utils.TaskProgress
+	pending    bool // progress was parsed but not reported yet
+	buf        []byte
+}
+
+// progressReportInterval is the minimum time between progress callbacks.
+const progressReportInterval = 2 * time.Second
+
+// maxProgressLineLength limits the buffered partial line if the output contains no line breaks.
+const maxProgressLineLength = 64 * 1024
+
+func newProgressWriter(w io.Writer, parse progressParser, onProgress ProgressFunc) *progressWriter {
+	return &progressWriter{
+		w:          w,
+		parse:      parse,
+		onProgress: onProgress,
+		interval:   progressReportInterval,
+	}
+}
+
+func (p *progressWriter) Write(b []byte) (int, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	n, err := p.w.Write(b)
+	if err != nil {
+		return n, err
+	}
+
+	p.buf = append(p.buf, b...)
+	for {
+		i := bytes.IndexAny(p.buf, "\r\n")
+		if i < 0 {
+			break
+		}
+		p.handleLine(string(p.buf[:i]))
+		p.buf = p.buf[i+1:]
+	}
+	if len(p.buf) > maxProgressLineLength {
+		p.buf = p.buf[:0]
+	}
+
+	return n, nil
+}
+
+func (p *progressWriter) handleLine(line string) {
+	if line == "" || !p.parse(line, &p.progress) {
+		return
+	}
+	p.pending = true
+	now := time.Now()
+	if now.Sub(p.lastReport) < p.interval {
+		return
+	}
+	p.report(now)
+}
+
+func (p *progressWriter) report(now time.Time) {
+	p.pending = false
+	p.lastReport = now
+	p.progress.UpdatedAt = now
+	if p.onProgress != nil {
+		p.onProgress(p.progress)
+	}
+}
+
+// Flush reports the last parsed progress if it was throttled.
+func (p *progressWriter) Flush() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.pending {
+		p.report(time.Now())
+	}
+}
+
+// setCommandOutput writes the command's output to the log file and parses progress from it if onProgress is set.
+// The returned function reports the last throttled progress and must be called once the command has exited.
+func setCommandOutput(cmd *osExec.Cmd, file io.Writer, parse progressParser, onProgress ProgressFunc) func() {
+	if onProgress == nil {
+		cmd.Stdout = file
+		cmd.Stderr = file
+		return func() {}
+	}
+	writer := newProgressWriter(file, parse, onProgress)
+	cmd.Stdout = writer
+	cmd.Stderr = writer
+	// output is copied by goroutines when the writer is not a file, don't wait on them forever if a child process keeps the pipes open
+	cmd.WaitDelay = 10 * time.Second
+	return writer.Flush
+}
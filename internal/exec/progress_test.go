@@ -0,0 +1,173 @@
+package exec
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+func TestParseYtDlpProgressLine(t *testing.T) {
+	tests := []struct {
+		name     string
+		line     string
+		ok       bool
+		expected utils.TaskProgress
+	}{
+		{
+			name:     "fragmented download",
+			line:     "[download]  45.0% of ~   2.00GiB at    5.00MiB/s ETA 03:12 (frag 123/456)",
+			ok:       true,
+			expected: utils.TaskProgress{Percent: 45, TotalBytes: 2 << 30, DownloadedBytes: 966367641, Speed: 5 << 20, ETASeconds: 192},
+		},
+		{
+			name:     "unknown speed",
+			line:     "[download]   0.1% of  100.00MiB at  Unknown B/s ETA Unknown",
+			ok:       true,
+			expected: utils.TaskProgress{Percent: 0.1, TotalBytes: 100 << 20, DownloadedBytes: 104857},
+		},
+		{
+			name:     "finished",
+			line:     "[download] 100% of  100.00MiB in 00:00:10 at 10.00MiB/s",
+			ok:       true,
+			expected: utils.TaskProgress{Percent: 100, TotalBytes: 100 << 20, DownloadedBytes: 100 << 20, Speed: 10 << 20},
+		},
+		{
+			name: "not progress",
+			line: "[download] Destination: /tmp/video.mp4",
+			ok:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var progress utils.TaskProgress
+			ok := parseYtDlpProgressLine(tt.line, &progress)
+			if ok != tt.ok {
+				t.Fatalf("parseYtDlpProgressLine() ok = %v, want %v", ok, tt.ok)
+			}
+			if ok && progress != tt.expected {
+				t.Errorf("parseYtDlpProgressLine() = %+v, want %+v", progress, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFfmpegProgressParser(t *testing.T) {
+	tests := []struct {
+		name          string
+		totalDuration float64
+		line          string
+		ok            bool
+		expected      utils.TaskProgress
+	}{
+		{
+			name:          "known duration",
+			totalDuration: 200,
+			line:          "frame= 1234 fps=120 q=-1.0 size=  1024KiB time=00:01:40.00 bitrate=1000.0kbits/s speed=2.0x",
+			ok:            true,
+			expected:      utils.TaskProgress{Percent: 50, DownloadedBytes: 1 << 20, SpeedFactor: 2, ETASeconds: 50, DurationSeconds: 100},
+		},
+		{
+			name: "live recording",
+			line: "size=  2048kB time=01:00:00.50 bitrate=1000.0kbits/s speed=1.01x",
+			ok:   true,
+			expected: utils.TaskProgress{
+				DownloadedBytes: 2 << 20, SpeedFactor: 1.01, DurationSeconds: 3600.5,
+			},
+		},
+		{
+			name: "not progress",
+			line: "Input #0, hls, from 'https://example.com/playlist.m3u8':",
+			ok:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var progress utils.TaskProgress
+			ok := newFfmpegProgressParser(tt.totalDuration)(tt.line, &progress)
+			if ok != tt.ok {
+				t.Fatalf("ffmpeg progress parser ok = %v, want %v", ok, tt.ok)
+			}
+			if ok && progress != tt.expected {
+				t.Errorf("ffmpeg progress parser = %+v, want %+v", progress, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseTwitchDownloaderProgressLine(t *testing.T) {
+	tests := []struct {
+		name     string
+		line     string
+		ok       bool
+		expected utils.TaskProgress
+	}{
+		{
+			name:     "chat download",
+			line:     "[STATUS] - Downloading 45%",
+			ok:       true,
+			expected: utils.TaskProgress{Percent: 45},
+		},
+		{
+			name:     "chat render",
+			line:     "[STATUS] - Rendering Video 12% (00:01:00 Elapsed | 00:05:30 Remaining)",
+			ok:       true,
+			expected: utils.TaskProgress{Percent: 12, ETASeconds: 330},
+		},
+		{
+			name: "not progress",
+			line: "[INFO] - Fetching chat info",
+			ok:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var progress utils.TaskProgress
+			ok := parseTwitchDownloaderProgressLine(tt.line, &progress)
+			if ok != tt.ok {
+				t.Fatalf("parseTwitchDownloaderProgressLine() ok = %v, want %v", ok, tt.ok)
+			}
+			if ok && progress != tt.expected {
+				t.Errorf("parseTwitchDownloaderProgressLine() = %+v, want %+v", progress, tt.expected)
+			}
+		})
+	}
+}
+
+func TestProgressWriter(t *testing.T) {
+	var log bytes.Buffer
+	var reported []utils.TaskProgress
+	writer := newProgressWriter(&log, parseTwitchDownloaderProgressLine, func(progress utils.TaskProgress) {
+		reported = append(reported, progress)
+	})
+
+	output := "[INFO] - Fetching chat info\n[STATUS] - Downloading 10%\r[STATUS] - Downl"
+	if _, err := writer.Write([]byte(output)); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	if _, err := writer.Write([]byte("oading 20%\r")); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+
+	if log.String() != output+"oading 20%\r" {
+		t.Errorf("output was not written through, got %q", log.String())
+	}
+	// the second line is throttled
+	if len(reported) != 1 || reported[0].Percent != 10 {
+		t.Fatalf("reported = %+v, want a single report of 10%%", reported)
+	}
+
+	writer.Flush()
+	if len(reported) != 2 || reported[1].Percent != 20 {
+		t.Errorf("reported after flush = %+v, want last report of 20%%", reported)
+	}
+
+	// nothing new to report
+	writer.Flush()
+	if len(reported) != 2 {
+		t.Errorf("reported after second flush = %+v, want no new reports", reported)
+	}
+}
@@ -0,0 +1,107 @@
+package ytdlp
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ResumeState describes the partially downloaded files a previous yt-dlp run left behind.
+//
+// yt-dlp writes the download to a .part file and, for fragmented (HLS) downloads, records the current fragment in a .ytdl file next to it. Running yt-dlp again with the same output template continues from that state.
+type ResumeState struct {
+	PartFiles     []string `json:"part_files"`
+	PartBytes     int64    `json:"part_bytes"`
+	FragmentIndex int      `json:"fragment_index"` // index of the fragment yt-dlp was downloading, 0 if unknown
+}
+
+// ytdlpFragmentState is the content of a .ytdl file.
+type ytdlpFragmentState struct {
+	Downloader struct {
+		CurrentFragment struct {
+			Index int `json:"index"`
+		} `json:"current_fragment"`
+	} `json:"downloader"`
+}
+
+// isPartialFile returns true if the path is a file yt-dlp uses while downloading.
+func isPartialFile(path string) bool {
+	return strings.HasSuffix(path, ".part") || strings.HasSuffix(path, ".ytdl") || strings.Contains(filepath.Base(path), ".part-Frag")
+}
+
+// partialFiles returns the partial download files for the output path without extension.
+func partialFiles(outputPathNoExt string) ([]string, error) {
+	matches, err := filepath.Glob(fmt.Sprintf("%s.*", escapeGlob(outputPathNoExt)))
+	if err != nil {
+		return nil, err
+	}
+	files := []string{}
+	for _, match := range matches {
+		if isPartialFile(match) {
+			files = append(files, match)
+		}
+	}
+	return files, nil
+}
+
+// GetResumeState returns the state of a previous download for the output path without extension. Nil is returned if there is nothing to resume.
+func GetResumeState(outputPathNoExt string) (*ResumeState, error) {
+	files, err := partialFiles(outputPathNoExt)
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, nil
+	}
+
+	state := &ResumeState{}
+	for _, file := range files {
+		info, err := os.Stat(file)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			return nil, err
+		}
+
+		if strings.HasSuffix(file, ".ytdl") {
+			data, err := os.ReadFile(file)
+			if err != nil {
+				return nil, err
+			}
+			var fragmentState ytdlpFragmentState
+			if err := json.Unmarshal(data, &fragmentState); err == nil && fragmentState.Downloader.CurrentFragment.Index > state.FragmentIndex {
+				state.FragmentIndex = fragmentState.Downloader.CurrentFragment.Index
+			}
+			continue
+		}
+
+		state.PartFiles = append(state.PartFiles, file)
+		state.PartBytes += info.Size()
+	}
+
+	return state, nil
+}
+
+// RemovePartialFiles removes the partial download files for the output path without extension so the next download starts from scratch.
+func RemovePartialFiles(outputPathNoExt string) error {
+	files, err := partialFiles(outputPathNoExt)
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		if err := os.Remove(file); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
+	return nil
+}
+
+// escapeGlob escapes glob meta characters in a path.
+func escapeGlob(path string) string {
+	replacer := strings.NewReplacer("*", "\\*", "?", "\\?", "[", "\\[")
+	return replacer.Replace(path)
+}
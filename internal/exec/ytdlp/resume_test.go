@@ -0,0 +1,41 @@
+package ytdlp
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestGetResumeState tests the GetResumeState and RemovePartialFiles functions.
+func TestGetResumeState(t *testing.T) {
+	dir := t.TempDir()
+	pathNoExt := filepath.Join(dir, "123_abc-video")
+
+	// nothing to resume
+	state, err := GetResumeState(pathNoExt)
+	assert.NoError(t, err)
+	assert.Nil(t, state)
+
+	assert.NoError(t, os.WriteFile(pathNoExt+".mp4.part", make([]byte, 1024), 0644))
+	assert.NoError(t, os.WriteFile(pathNoExt+".mp4.ytdl", []byte(`{"downloader": {"current_fragment": {"index": 42}, "extra_state": {}}}`), 0644))
+	assert.NoError(t, os.WriteFile(pathNoExt+".mp4.part-Frag43", make([]byte, 512), 0644))
+	// unrelated files are ignored
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "other-video.mp4.part"), make([]byte, 2048), 0644))
+
+	state, err = GetResumeState(pathNoExt)
+	assert.NoError(t, err)
+	assert.NotNil(t, state)
+	assert.Equal(t, 42, state.FragmentIndex)
+	assert.Equal(t, int64(1536), state.PartBytes)
+	assert.Len(t, state.PartFiles, 2)
+
+	assert.NoError(t, RemovePartialFiles(pathNoExt))
+	state, err = GetResumeState(pathNoExt)
+	assert.NoError(t, err)
+	assert.Nil(t, state)
+
+	_, err = os.Stat(filepath.Join(dir, "other-video.mp4.part"))
+	assert.NoError(t, err)
+}
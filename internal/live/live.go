@@ -19,9 +19,11 @@ import (
 	entQueue "github.com/zibbp/ganymede/ent/queue"
 	entVod "github.com/zibbp/ganymede/ent/vod"
 	"github.com/zibbp/ganymede/internal/archive"
+	"github.com/zibbp/ganymede/internal/audit"
 	"github.com/zibbp/ganymede/internal/chapter"
 	"github.com/zibbp/ganymede/internal/config"
 	"github.com/zibbp/ganymede/internal/database"
+	"github.com/zibbp/ganymede/internal/events"
 	"github.com/zibbp/ganymede/internal/notification"
 	"github.com/zibbp/ganymede/internal/platform"
 	"github.com/zibbp/ganymede/internal/queue"
@@ -147,6 +149,9 @@ func (s *Service) AddLiveWatchedChannel(ctx context.Context, liveDto Live) (*ent
 			}
 		}
 	}
+
+	audit.Record(ctx, s.Store, audit.Entry{Action: audit.ActionWatchedChannelCreate, ResourceType: "live", ResourceID: l.ID.String(), After: l})
+
 	return l, nil
 }
 
@@ -156,6 +161,14 @@ func (s *Service) UpdateLiveWatchedChannel(ctx context.Context, liveDto Live) (*
 		return nil, fmt.Errorf("conflicting category options: strict_categories_live and blacklist_categories cannot both be enabled")
 	}
 
+	before, err := s.Store.Client.Live.Get(ctx, liveDto.ID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("watched channel not found")
+		}
+		return nil, fmt.Errorf("error getting watched channel: %v", err)
+	}
+
 	l, err := s.Store.Client.Live.UpdateOneID(liveDto.ID).
 		SetWatchLive(liveDto.WatchLive).
 		SetWatchVod(liveDto.WatchVod).
@@ -213,6 +226,8 @@ func (s *Service) UpdateLiveWatchedChannel(ctx context.Context, liveDto Live) (*
 		}
 	}
 
+	audit.Record(ctx, s.Store, audit.Entry{Action: audit.ActionWatchedChannelUpdate, ResourceType: "live", ResourceID: l.ID.String(), Before: before, After: l})
+
 	return l, nil
 }
 
@@ -238,6 +253,9 @@ func (s *Service) DeleteLiveWatchedChannel(c echo.Context, lID uuid.UUID) error
 	if err != nil {
 		return fmt.Errorf("error deleting watched channel: %v", err)
 	}
+
+	audit.Record(c.Request().Context(), s.Store, audit.Entry{Action: audit.ActionWatchedChannelDelete, ResourceType: "live", ResourceID: lID.String(), Before: v})
+
 	return nil
 }
 
@@ -434,6 +452,12 @@ OUTER:
 					continue
 				}
 				go notification.SendLiveNotification(lwc.Edges.Channel, vod, vod.Edges.Queue, stream.GameName)
+				go notification.SendChannelSubscriptionNotifications(utils.SubscriptionEventChannelLive, vod.ID, stream.GameName)
+				events.Publish(ctx, events.ChannelLive, events.ChannelLiveData{
+					ChannelID:   lwc.Edges.Channel.ID,
+					ChannelName: lwc.Edges.Channel.Name,
+					VideoID:     &vod.ID,
+				})
 
 				// Create initial chapter
 				_, err = s.ChapterService.CreateChapter(chapter.Chapter{
@@ -455,6 +479,10 @@ OUTER:
 				if err != nil {
 					log.Error().Err(err).Msg("error updating live watched channel")
 				}
+				events.Publish(ctx, events.ChannelOffline, events.ChannelLiveData{
+					ChannelID:   lwc.Edges.Channel.ID,
+					ChannelName: lwc.Edges.Channel.Name,
+				})
 			}
 		}
 	}
@@ -0,0 +1,88 @@
+package notification
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/zibbp/ganymede/ent"
+	entNotificationFailure "github.com/zibbp/ganymede/ent/notificationfailure"
+	"github.com/zibbp/ganymede/internal/config"
+	"github.com/zibbp/ganymede/internal/database"
+)
+
+// maxDeliveryAttempts is the number of times a notification is sent before it is recorded as failed.
+const maxDeliveryAttempts = 4
+
+// deliveryTimeout is the timeout of a single delivery attempt.
+const deliveryTimeout = 30 * time.Second
+
+// deliveryBackoff is the delay before the first retry, it doubles after every attempt.
+var deliveryBackoff = 5 * time.Second
+
+// deliver sends the message to the provider, retrying with backoff. The failure is recorded if every attempt fails.
+func deliver(providerConfig config.NotificationProvider, message Message) {
+	logger := log.With().Str("provider", providerConfig.Name).Str("provider_type", string(providerConfig.Type)).Str("event", string(message.Event)).Logger()
+
+	provider, err := newProvider(providerConfig)
+	if err != nil {
+		logger.Error().Err(err).Msg("error creating notification provider")
+		recordFailure(providerConfig, message, err, 0)
+		return
+	}
+
+	attempts, err := sendWithRetry(provider, message)
+	if err != nil {
+		logger.Error().Err(err).Int("attempts", attempts).Msg("error sending notification")
+		recordFailure(providerConfig, message, err, attempts)
+		return
+	}
+
+	logger.Debug().Int("attempts", attempts).Msg("notification sent")
+}
+
+// sendWithRetry sends the message until it succeeds or the attempts run out. It returns the number of attempts made.
+func sendWithRetry(provider Provider, message Message) (int, error) {
+	backoff := deliveryBackoff
+	var err error
+	for attempt := 1; attempt <= maxDeliveryAttempts; attempt++ {
+		ctx, cancel := context.WithTimeout(context.Background(), deliveryTimeout)
+		err = provider.Send(ctx, message)
+		cancel()
+		if err == nil {
+			return attempt, nil
+		}
+		if attempt < maxDeliveryAttempts {
+			log.Debug().Err(err).Int("attempt", attempt).Msgf("error sending notification, retrying in %s", backoff)
+			time.Sleep(backoff)
+			backoff *= 2
+		}
+	}
+	return maxDeliveryAttempts, err
+}
+
+// recordFailure stores the failed delivery so it can be reviewed by an admin.
+func recordFailure(providerConfig config.NotificationProvider, message Message, deliveryErr error, attempts int) {
+	store := database.DB()
+	if store == nil {
+		return
+	}
+	_, err := store.Client.NotificationFailure.Create().
+		SetProviderName(providerConfig.Name).
+		SetProviderType(providerConfig.Type).
+		SetEvent(string(message.Event)).
+		SetError(deliveryErr.Error()).
+		SetAttempts(attempts).
+		Save(context.Background())
+	if err != nil {
+		log.Error().Err(err).Msg("error recording notification failure")
+	}
+}
+
+// GetDeliveryFailures returns the most recent failed notification deliveries.
+func GetDeliveryFailures(ctx context.Context, limit int) ([]*ent.NotificationFailure, error) {
+	return database.DB().Client.NotificationFailure.Query().
+		Order(ent.Desc(entNotificationFailure.FieldCreatedAt)).
+		Limit(limit).
+		All(ctx)
+}
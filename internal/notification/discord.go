@@ -0,0 +1,88 @@
+package notification
+
+import (
+	"context"
+	"time"
+
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// discordProvider sends the message as a Discord embed.
+type discordProvider struct {
+	url     string
+	headers map[string]string
+}
+
+type discordWebhookBody struct {
+	Content string         `json:"content,omitempty"`
+	Embeds  []discordEmbed `json:"embeds"`
+}
+
+type discordEmbed struct {
+	Title       string              `json:"title"`
+	Description string              `json:"description"`
+	URL         string              `json:"url,omitempty"`
+	Color       int                 `json:"color"`
+	Timestamp   string              `json:"timestamp"`
+	Author      *discordEmbedAuthor `json:"author,omitempty"`
+	Image       *discordEmbedImage  `json:"image,omitempty"`
+	Fields      []discordEmbedField `json:"fields,omitempty"`
+	Footer      *discordEmbedFooter `json:"footer,omitempty"`
+	Thumbnail   *discordEmbedImage  `json:"thumbnail,omitempty"`
+}
+
+type discordEmbedAuthor struct {
+	Name    string `json:"name"`
+	IconURL string `json:"icon_url,omitempty"`
+}
+
+type discordEmbedImage struct {
+	URL string `json:"url"`
+}
+
+type discordEmbedField struct {
+	Name   string `json:"name"`
+	Value  string `json:"value"`
+	Inline bool   `json:"inline"`
+}
+
+type discordEmbedFooter struct {
+	Text string `json:"text"`
+}
+
+// discordEventColors are the embed colors of the events.
+var discordEventColors = map[utils.NotificationEvent]int{
+	utils.NotificationEventVideoSuccess:      0x2ecc71,
+	utils.NotificationEventLiveSuccess:       0x2ecc71,
+	utils.NotificationEventError:             0xe74c3c,
+	utils.NotificationEventIsLive:            0x9146ff,
+	utils.NotificationEventArchiveQueued:     0x3498db,
+	utils.NotificationEventArchiveStarted:    0x3498db,
+	utils.NotificationEventVideoDeleted:      0x95a5a6,
+	utils.NotificationEventDiskLow:           0xf39c12,
+	utils.NotificationEventCredentialInvalid: 0xf39c12,
+	utils.NotificationEventWatchdogFailed:    0xe74c3c,
+	utils.NotificationEventLoginLockout:      0xf39c12,
+}
+
+func (p *discordProvider) Send(ctx context.Context, message Message) error {
+	embed := discordEmbed{
+		Title:       message.Title,
+		Description: message.Body,
+		URL:         message.URL,
+		Color:       discordEventColors[message.Event],
+		Timestamp:   time.Now().Format(time.RFC3339),
+		Footer:      &discordEmbedFooter{Text: "Ganymede"},
+	}
+	if message.ChannelName != "" {
+		embed.Author = &discordEmbedAuthor{Name: message.ChannelName, IconURL: message.ChannelImageURL}
+	}
+	if message.ThumbnailURL != "" {
+		embed.Image = &discordEmbedImage{URL: message.ThumbnailURL}
+	}
+	if message.Duration > 0 {
+		embed.Fields = append(embed.Fields, discordEmbedField{Name: "Duration", Value: formatDuration(message.Duration), Inline: true})
+	}
+
+	return postJSON(ctx, p.url, p.headers, discordWebhookBody{Embeds: []discordEmbed{embed}})
+}
@@ -0,0 +1,47 @@
+package notification
+
+import (
+	"context"
+	"strings"
+)
+
+// gotifyProvider sends the message to a Gotify server using an application token.
+type gotifyProvider struct {
+	url     string
+	token   string
+	headers map[string]string
+}
+
+type gotifyMessage struct {
+	Title    string         `json:"title"`
+	Message  string         `json:"message"`
+	Priority int            `json:"priority"`
+	Extras   map[string]any `json:"extras,omitempty"`
+}
+
+func (p *gotifyProvider) Send(ctx context.Context, message Message) error {
+	priority := 5
+	if isAlertEvent(message.Event) {
+		priority = 8
+	}
+
+	body := gotifyMessage{
+		Title:    message.Title,
+		Message:  message.Body,
+		Priority: priority,
+	}
+	if message.URL != "" {
+		body.Extras = map[string]any{
+			"client::notification": map[string]any{
+				"click": map[string]string{"url": message.URL},
+			},
+		}
+	}
+
+	headers := map[string]string{"X-Gotify-Key": p.token}
+	for key, value := range p.headers {
+		headers[key] = value
+	}
+
+	return postJSON(ctx, strings.TrimSuffix(p.url, "/")+"/message", headers, body)
+}
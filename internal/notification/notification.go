@@ -1,199 +1,227 @@
 package notification
 
 import (
-	"bytes"
-	"encoding/json"
+	"context"
 	"fmt"
-	"net/http"
 	"regexp"
+	"slices"
 	"strings"
 
+	"github.com/google/uuid"
 	"github.com/rs/zerolog/log"
 	"github.com/zibbp/ganymede/ent"
 	"github.com/zibbp/ganymede/internal/config"
+	"github.com/zibbp/ganymede/internal/utils"
 )
 
 var (
 	notificationVariableRegex = regexp.MustCompile(`\{{([^}]+)\}}`)
 )
 
-type WebhookRequestBody struct {
-	Content string `json:"content"`
-	Body    string `json:"body"`
-}
+func SendVideoArchiveSuccessNotification(channelItem *ent.Channel, vodItem *ent.Vod, qItem *ent.Queue) {
+	// Get notification settings
+	notificationConfig := config.Get().Notification
 
-func sendWebhook(url string, body []byte) error {
+	if (!notificationConfig.VideoSuccessEnabled) || (notificationConfig.VideoSuccessTemplate == "") {
+		log.Debug().Msg("Video archive success notification is disabled")
+		return
+	}
 
-	client := &http.Client{}
+	data := newTemplateData(context.Background(), utils.NotificationEventVideoSuccess, channelItem, vodItem, qItem, "", "")
+	variableMap := getVariableMap(channelItem, vodItem, qItem, "", nil)
 
-	req, err := http.NewRequest("POST", url, bytes.NewBuffer(body))
+	notify(notificationConfig, notificationConfig.VideoSuccessWebhookUrl, "Video Archived", notificationConfig.VideoSuccessTemplate, data, variableMap)
+}
 
-	if err != nil {
-		return fmt.Errorf("error creating webhook request: %w", err)
+func SendLiveArchiveSuccessNotification(channelItem *ent.Channel, vodItem *ent.Vod, qItem *ent.Queue) {
+	// Get notification settings
+	notificationConfig := config.Get().Notification
+
+	if (!notificationConfig.LiveSuccessEnabled) || (notificationConfig.LiveSuccessTemplate == "") {
+		log.Debug().Msg("Live archive success notification is disabled")
+		return
 	}
 
-	req.Header.Set("Content-Type", "application/json")
+	data := newTemplateData(context.Background(), utils.NotificationEventLiveSuccess, channelItem, vodItem, qItem, "", "")
+	variableMap := getVariableMap(channelItem, vodItem, qItem, "", nil)
+
+	notify(notificationConfig, notificationConfig.LiveSuccessWebhookUrl, "Live Stream Archived", notificationConfig.LiveSuccessTemplate, data, variableMap)
+}
 
-	resp, err := client.Do(req)
+func SendErrorNotification(channelItem *ent.Channel, vodItem *ent.Vod, qItem *ent.Queue, failedTask string) {
+	// Get notification settings
+	notificationConfig := config.Get().Notification
 
-	if err != nil {
-		return fmt.Errorf("error sending webhook request: %w", err)
+	if (!notificationConfig.ErrorEnabled) || (notificationConfig.ErrorTemplate == "") {
+		log.Debug().Msg("Error notification is disabled")
+		return
 	}
 
-	defer func() {
-		if err := resp.Body.Close(); err != nil {
-			log.Debug().Err(err).Msg("error closing response body")
-		}
-	}()
+	data := newTemplateData(context.Background(), utils.NotificationEventError, channelItem, vodItem, qItem, failedTask, "")
+	variableMap := getVariableMap(channelItem, vodItem, qItem, failedTask, nil)
 
-	return nil
+	notify(notificationConfig, notificationConfig.ErrorWebhookUrl, "Archive Failed", notificationConfig.ErrorTemplate, data, variableMap)
 }
 
-func SendVideoArchiveSuccessNotification(channelItem *ent.Channel, vodItem *ent.Vod, qItem *ent.Queue) {
+func SendLiveNotification(channelItem *ent.Channel, vodItem *ent.Vod, qItem *ent.Queue, category string) {
 	// Get notification settings
-	videoSuccessWebhookUrl := config.Get().Notification.VideoSuccessWebhookUrl
-	videoSuccessTemplate := config.Get().Notification.VideoSuccessTemplate
-	videoSuccessEnabled := config.Get().Notification.VideoSuccessEnabled
+	notificationConfig := config.Get().Notification
 
-	if (!videoSuccessEnabled) || (videoSuccessWebhookUrl == "") || (videoSuccessTemplate == "") {
-		log.Debug().Msg("Video archive success notification is disabled")
+	if (!notificationConfig.IsLiveEnabled) || (notificationConfig.IsLiveTemplate == "") {
+		log.Debug().Msg("Live notification is disabled")
 		return
 	}
 
-	variableMap := getVariableMap(channelItem, vodItem, qItem, "", nil)
+	data := newTemplateData(context.Background(), utils.NotificationEventIsLive, channelItem, vodItem, qItem, "", category)
+	variableMap := getVariableMap(channelItem, vodItem, qItem, "", &category)
 
-	res := notificationVariableRegex.FindAllStringSubmatch(videoSuccessTemplate, -1)
-	for _, match := range res {
-		// Get variable name
-		variableName := match[1]
-		// Get variable value
-		variableValue := variableMap[variableName]
-		// Replace variable in template
-		variableValueString := fmt.Sprintf("%v", variableValue)
-		videoSuccessTemplate = strings.ReplaceAll(videoSuccessTemplate, match[0], variableValueString)
-	}
+	notify(notificationConfig, notificationConfig.IsLiveWebhookUrl, "Channel Live", notificationConfig.IsLiveTemplate, data, variableMap)
+}
 
-	var webhookRequestBody = WebhookRequestBody{
-		Content: videoSuccessTemplate,
-		Body:    videoSuccessTemplate,
-	}
+func SendArchiveQueuedNotification(channelItem *ent.Channel, vodItem *ent.Vod, qItem *ent.Queue) {
+	// Get notification settings
+	notificationConfig := config.Get().Notification
 
-	body, err := json.Marshal(webhookRequestBody)
-	if err != nil {
-		log.Error().Err(err).Msg("error marshalling webhook request body")
+	if (!notificationConfig.ArchiveQueuedEnabled) || (notificationConfig.ArchiveQueuedTemplate == "") {
+		log.Debug().Msg("Archive queued notification is disabled")
 		return
 	}
 
-	err = sendWebhook(videoSuccessWebhookUrl, body)
-	if err != nil {
-		log.Error().Err(err).Msg("error sending webhook")
+	data := newTemplateData(context.Background(), utils.NotificationEventArchiveQueued, channelItem, vodItem, qItem, "", "")
+	variableMap := getVariableMap(channelItem, vodItem, qItem, "", nil)
+
+	notify(notificationConfig, notificationConfig.ArchiveQueuedWebhookUrl, "Archive Queued", notificationConfig.ArchiveQueuedTemplate, data, variableMap)
+}
+
+func SendArchiveStartedNotification(channelItem *ent.Channel, vodItem *ent.Vod, qItem *ent.Queue) {
+	// Get notification settings
+	notificationConfig := config.Get().Notification
+
+	if (!notificationConfig.ArchiveStartedEnabled) || (notificationConfig.ArchiveStartedTemplate == "") {
+		log.Debug().Msg("Archive started notification is disabled")
 		return
 	}
 
+	data := newTemplateData(context.Background(), utils.NotificationEventArchiveStarted, channelItem, vodItem, qItem, "", "")
+	variableMap := getVariableMap(channelItem, vodItem, qItem, "", nil)
+
+	notify(notificationConfig, notificationConfig.ArchiveStartedWebhookUrl, "Archive Started", notificationConfig.ArchiveStartedTemplate, data, variableMap)
 }
 
-func SendLiveArchiveSuccessNotification(channelItem *ent.Channel, vodItem *ent.Vod, qItem *ent.Queue) {
+// SendVideoDeletedNotification notifies that the video was deleted, the freed bytes are the storage usage of the video.
+func SendVideoDeletedNotification(channelItem *ent.Channel, vodItem *ent.Vod, reason string) {
 	// Get notification settings
-	liveSuccessWebhookUrl := config.Get().Notification.LiveSuccessWebhookUrl
-	liveSuccessTemplate := config.Get().Notification.LiveSuccessTemplate
-	liveSuccessEnabled := config.Get().Notification.LiveSuccessEnabled
+	notificationConfig := config.Get().Notification
 
-	if (!liveSuccessEnabled) || (liveSuccessWebhookUrl == "") || (liveSuccessTemplate == "") {
-		log.Debug().Msg("Live archive success notification is disabled")
+	if (!notificationConfig.VideoDeletedEnabled) || (notificationConfig.VideoDeletedTemplate == "") {
+		log.Debug().Msg("Video deleted notification is disabled")
 		return
 	}
 
+	qItem := &ent.Queue{}
+	data := newTemplateData(context.Background(), utils.NotificationEventVideoDeleted, channelItem, vodItem, qItem, "", "")
+	// the video no longer exists
+	data.URL = ""
+	data.Reason = reason
+	data.FreedBytes = vodItem.StorageSizeBytes
 	variableMap := getVariableMap(channelItem, vodItem, qItem, "", nil)
 
-	res := notificationVariableRegex.FindAllStringSubmatch(liveSuccessTemplate, -1)
-	for _, match := range res {
-		// Get variable name
-		variableName := match[1]
-		// Get variable value
-		variableValue := variableMap[variableName]
-		// Replace variable in template
-		variableValueString := fmt.Sprintf("%v", variableValue)
-		liveSuccessTemplate = strings.ReplaceAll(liveSuccessTemplate, match[0], variableValueString)
+	notify(notificationConfig, notificationConfig.VideoDeletedWebhookUrl, "Video Deleted", notificationConfig.VideoDeletedTemplate, data, variableMap)
+}
+
+func SendDiskLowNotification(disk DiskUsage) {
+	// Get notification settings
+	notificationConfig := config.Get().Notification
 
+	if (!notificationConfig.DiskLowEnabled) || (notificationConfig.DiskLowTemplate == "") {
+		log.Debug().Msg("Disk low notification is disabled")
+		return
 	}
 
-	var webhookRequestBody = WebhookRequestBody{
-		Content: liveSuccessTemplate,
-		Body:    liveSuccessTemplate,
+	data := TemplateData{
+		Event:      utils.NotificationEventDiskLow,
+		Disk:       &disk,
+		Categories: []string{},
 	}
 
-	body, err := json.Marshal(webhookRequestBody)
-	if err != nil {
-		log.Error().Err(err).Msg("error marshalling webhook request body")
+	notify(notificationConfig, notificationConfig.DiskLowWebhookUrl, "Disk Low", notificationConfig.DiskLowTemplate, data, emptyVariableMap())
+}
+
+// SendCredentialInvalidNotification notifies that a credential, e.g. the Twitch token, is no longer valid.
+func SendCredentialInvalidNotification(credential string, reason string) {
+	// Get notification settings
+	notificationConfig := config.Get().Notification
+
+	if (!notificationConfig.CredentialInvalidEnabled) || (notificationConfig.CredentialInvalidTemplate == "") {
+		log.Debug().Msg("Credential invalid notification is disabled")
 		return
 	}
 
-	err = sendWebhook(liveSuccessWebhookUrl, body)
-	if err != nil {
-		log.Error().Err(err).Msg("error sending webhook")
-		return
+	data := TemplateData{
+		Event:      utils.NotificationEventCredentialInvalid,
+		Credential: credential,
+		Reason:     reason,
+		Categories: []string{},
 	}
 
+	notify(notificationConfig, notificationConfig.CredentialInvalidWebhookUrl, "Credential Invalid", notificationConfig.CredentialInvalidTemplate, data, emptyVariableMap())
 }
 
-func SendErrorNotification(channelItem *ent.Channel, vodItem *ent.Vod, qItem *ent.Queue, failedTask string) {
+func SendWatchdogFailedNotification(channelItem *ent.Channel, vodItem *ent.Vod, qItem *ent.Queue, failedTask string) {
 	// Get notification settings
-	errorWebhookUrl := config.Get().Notification.ErrorWebhookUrl
-	errorTemplate := config.Get().Notification.ErrorTemplate
-	errorEnabled := config.Get().Notification.ErrorEnabled
+	notificationConfig := config.Get().Notification
 
-	if (!errorEnabled) || (errorWebhookUrl == "") || (errorTemplate == "") {
-		log.Debug().Msg("Error notification is disabled")
+	if (!notificationConfig.WatchdogFailedEnabled) || (notificationConfig.WatchdogFailedTemplate == "") {
+		log.Debug().Msg("Watchdog failed notification is disabled")
 		return
 	}
 
+	data := newTemplateData(context.Background(), utils.NotificationEventWatchdogFailed, channelItem, vodItem, qItem, failedTask, "")
 	variableMap := getVariableMap(channelItem, vodItem, qItem, failedTask, nil)
 
-	res := notificationVariableRegex.FindAllStringSubmatch(errorTemplate, -1)
-	for _, match := range res {
-		// Get variable name
-		variableName := match[1]
-		// Get variable value
-		variableValue := variableMap[variableName]
-		// Replace variable in template
-		variableValueString := fmt.Sprintf("%v", variableValue)
-		errorTemplate = strings.ReplaceAll(errorTemplate, match[0], variableValueString)
-
-	}
+	notify(notificationConfig, notificationConfig.WatchdogFailedWebhookUrl, "Job Failed by Watchdog", notificationConfig.WatchdogFailedTemplate, data, variableMap)
+}
 
-	var webhookRequestBody = WebhookRequestBody{
-		Content: errorTemplate,
-		Body:    errorTemplate,
-	}
+// SendLoginLockoutNotification notifies that an IP address or username was locked out after repeated failed logins.
+func SendLoginLockoutNotification(lockout LoginLockout) {
+	// Get notification settings
+	notificationConfig := config.Get().Notification
 
-	body, err := json.Marshal(webhookRequestBody)
-	if err != nil {
-		log.Error().Err(err).Msg("error marshalling webhook request body")
+	if (!notificationConfig.LoginLockoutEnabled) || (notificationConfig.LoginLockoutTemplate == "") {
+		log.Debug().Msg("Login lockout notification is disabled")
 		return
 	}
 
-	err = sendWebhook(errorWebhookUrl, body)
-	if err != nil {
-		log.Error().Err(err).Msg("error sending webhook")
-		return
+	data := TemplateData{
+		Event:      utils.NotificationEventLoginLockout,
+		Lockout:    &lockout,
+		Categories: []string{},
 	}
 
+	notify(notificationConfig, notificationConfig.LoginLockoutWebhookUrl, "Login Locked", notificationConfig.LoginLockoutTemplate, data, emptyVariableMap())
 }
 
-func SendLiveNotification(channelItem *ent.Channel, vodItem *ent.Vod, qItem *ent.Queue, category string) {
-	// Get notification settings
-	liveWebhookUrl := config.Get().Notification.IsLiveWebhookUrl
-	liveTemplate := config.Get().Notification.IsLiveTemplate
-	liveEnabled := config.Get().Notification.IsLiveEnabled
-
-	if (!liveEnabled) || (liveWebhookUrl == "") || (liveTemplate == "") {
-		log.Debug().Msg("Live notification is disabled")
-		return
+// notify renders the template of the event and sends the message to the providers of the event.
+func notify(notificationConfig config.Notification, webhookURL string, title string, text string, data TemplateData, variableMap map[string]interface{}) {
+	body, err := renderTemplate(text, data, variableMap)
+	if err != nil {
+		// templates written for the original {{variable}} syntax may not be valid Go templates, e.g. when using an unknown variable
+		log.Warn().Err(err).Str("event", string(data.Event)).Msg("error rendering notification template, falling back to variable substitution")
+		body = renderLegacyTemplate(text, variableMap)
 	}
 
-	variableMap := getVariableMap(channelItem, vodItem, qItem, "", &category)
+	message := newMessage(data.Event, title, body, data.Channel, data.Vod)
+	message.URL = data.URL
+	data.Message = body
+	message.templateData = &data
+	message.variableMap = variableMap
 
-	res := notificationVariableRegex.FindAllStringSubmatch(liveTemplate, -1)
+	send(notificationConfig, webhookURL, message)
+}
+
+// renderLegacyTemplate replaces the {{variable}} placeholders in the template.
+func renderLegacyTemplate(template string, variableMap map[string]interface{}) string {
+	res := notificationVariableRegex.FindAllStringSubmatch(template, -1)
 	for _, match := range res {
 		// Get variable name
 		variableName := match[1]
@@ -201,27 +229,91 @@ func SendLiveNotification(channelItem *ent.Channel, vodItem *ent.Vod, qItem *ent
 		variableValue := variableMap[variableName]
 		// Replace variable in template
 		variableValueString := fmt.Sprintf("%v", variableValue)
-		liveTemplate = strings.ReplaceAll(liveTemplate, match[0], variableValueString)
+		template = strings.ReplaceAll(template, match[0], variableValueString)
+	}
+	return template
+}
 
+// newMessage returns the message for the rendered body, with links to the video and images if the application URL is configured.
+func newMessage(event utils.NotificationEvent, title string, body string, channelItem *ent.Channel, vodItem *ent.Vod) Message {
+	// events not about a video, e.g. disk low, have no channel or video
+	if channelItem == nil {
+		channelItem = &ent.Channel{}
+	}
+	if vodItem == nil {
+		vodItem = &ent.Vod{}
+	}
+	applicationURL := strings.TrimSuffix(config.Get().Notification.ApplicationURL, "/")
+	mediaURL := strings.TrimSuffix(config.GetEnvConfig().CDN_URL, "/")
+	if mediaURL == "" {
+		mediaURL = applicationURL
 	}
 
-	var webhookRequestBody = WebhookRequestBody{
-		Content: liveTemplate,
-		Body:    liveTemplate,
+	message := Message{
+		Event:       event,
+		Title:       title,
+		Body:        body,
+		ChannelName: channelItem.DisplayName,
+		Duration:    vodItem.Duration,
 	}
+	message.URL = videoURL(vodItem)
+	if mediaURL != "" {
+		if vodItem.WebThumbnailPath != "" {
+			message.ThumbnailURL = mediaURL + vodItem.WebThumbnailPath
+		}
+		if channelItem.ImagePath != "" {
+			message.ChannelImageURL = mediaURL + channelItem.ImagePath
+		}
+	}
+	return message
+}
 
-	body, err := json.Marshal(webhookRequestBody)
-	if err != nil {
-		log.Error().Err(err).Msg("error marshalling webhook request body")
-		return
+// videoURL returns the link to the video, empty if the application URL is not configured.
+func videoURL(vodItem *ent.Vod) string {
+	applicationURL := strings.TrimSuffix(config.Get().Notification.ApplicationURL, "/")
+	if applicationURL == "" || vodItem.ID == uuid.Nil {
+		return ""
 	}
+	return fmt.Sprintf("%s/videos/%s", applicationURL, vodItem.ID)
+}
 
-	err = sendWebhook(liveWebhookUrl, body)
-	if err != nil {
-		log.Error().Err(err).Msg("error sending webhook")
+// providersForEvent returns the webhook URL of the event as a webhook provider and every enabled provider the event is routed to.
+func providersForEvent(notificationConfig config.Notification, webhookURL string, event utils.NotificationEvent) []config.NotificationProvider {
+	providers := []config.NotificationProvider{}
+	if webhookURL != "" {
+		providers = append(providers, config.NotificationProvider{
+			Name:    fmt.Sprintf("%s webhook url", event),
+			Type:    utils.NotificationProviderWebhook,
+			Enabled: true,
+			URL:     webhookURL,
+		})
+	}
+	for _, provider := range notificationConfig.Providers {
+		if provider.Enabled && slices.Contains(provider.Events, event) {
+			providers = append(providers, provider)
+		}
+	}
+	return providers
+}
+
+// send delivers the message to every provider of the event.
+//
+// Delivery happens in the background as failed deliveries are retried.
+func send(notificationConfig config.Notification, webhookURL string, message Message) {
+	providers := providersForEvent(notificationConfig, webhookURL, message.Event)
+	if len(providers) == 0 {
+		log.Debug().Str("event", string(message.Event)).Msg("no notification providers configured for event")
 		return
 	}
 
+	for _, provider := range providers {
+		go deliver(provider, message)
+	}
+}
+
+// emptyVariableMap returns the variables for events not about a video so templates using them still render.
+func emptyVariableMap() map[string]interface{} {
+	return getVariableMap(&ent.Channel{}, &ent.Vod{}, &ent.Queue{}, "", nil)
 }
 
 func getVariableMap(channelItem *ent.Channel, vodItem *ent.Vod, qItem *ent.Queue, failedTask string, category *string) map[string]interface{} {
@@ -0,0 +1,52 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// ntfyProvider publishes the message to an ntfy topic URL.
+type ntfyProvider struct {
+	url     string
+	token   string
+	headers map[string]string
+}
+
+func (p *ntfyProvider) Send(ctx context.Context, message Message) error {
+	headers := map[string]string{
+		"Title": message.Title,
+	}
+	switch {
+	case isAlertEvent(message.Event):
+		headers["Tags"] = "warning"
+		headers["Priority"] = "high"
+	case message.Event == utils.NotificationEventIsLive:
+		headers["Tags"] = "red_circle"
+	case message.Event == utils.NotificationEventArchiveQueued:
+		headers["Tags"] = "inbox_tray"
+	case message.Event == utils.NotificationEventArchiveStarted:
+		headers["Tags"] = "arrow_down"
+	case message.Event == utils.NotificationEventVideoDeleted:
+		headers["Tags"] = "wastebasket"
+	default:
+		headers["Tags"] = "white_check_mark"
+	}
+	if message.URL != "" {
+		headers["Click"] = message.URL
+	}
+	if message.ThumbnailURL != "" {
+		headers["Attach"] = message.ThumbnailURL
+	}
+	if message.ChannelImageURL != "" {
+		headers["Icon"] = message.ChannelImageURL
+	}
+	if p.token != "" {
+		headers["Authorization"] = "Bearer " + p.token
+	}
+	for key, value := range p.headers {
+		headers[key] = value
+	}
+
+	return post(ctx, p.url, headers, []byte(message.Body))
+}
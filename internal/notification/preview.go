@@ -0,0 +1,139 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent"
+	entChapter "github.com/zibbp/ganymede/ent/chapter"
+	entQueue "github.com/zibbp/ganymede/ent/queue"
+	entVod "github.com/zibbp/ganymede/ent/vod"
+	"github.com/zibbp/ganymede/internal/config"
+	"github.com/zibbp/ganymede/internal/database"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// previewFailedTask is the failed task used when previewing error notifications.
+const previewFailedTask = "video_download"
+
+// PreviewInput is a template to render against a video or the video of a queue item.
+type PreviewInput struct {
+	Template     string
+	BodyTemplate bool // render the template as a JSON request body, the message is rendered from the event's configured template
+	Event        utils.NotificationEvent
+	VideoID      uuid.UUID
+	QueueID      uuid.UUID
+}
+
+// RenderPreview renders the template as it would be rendered for the event without sending it.
+func RenderPreview(ctx context.Context, input PreviewInput) (string, error) {
+	vodID := input.VideoID
+	if input.QueueID != uuid.Nil {
+		qItem, err := database.DB().Client.Queue.Query().Where(entQueue.ID(input.QueueID)).WithVod().Only(ctx)
+		if err != nil {
+			return "", fmt.Errorf("error getting queue item: %w", err)
+		}
+		if qItem.Edges.Vod == nil {
+			return "", fmt.Errorf("queue item has no video")
+		}
+		vodID = qItem.Edges.Vod.ID
+	}
+
+	vodItem, err := database.DB().Client.Vod.Query().Where(entVod.ID(vodID)).WithChannel().WithQueue().WithChapters(func(q *ent.ChapterQuery) {
+		q.Order(ent.Asc(entChapter.FieldStart))
+	}).Only(ctx)
+	if err != nil {
+		return "", fmt.Errorf("error getting video: %w", err)
+	}
+	qItem := vodItem.Edges.Queue
+	if qItem == nil {
+		qItem = &ent.Queue{}
+	}
+
+	failedTask := ""
+	if input.Event == utils.NotificationEventError || input.Event == utils.NotificationEventWatchdogFailed {
+		failedTask = previewFailedTask
+	}
+	category := ""
+	if input.Event == utils.NotificationEventIsLive && len(vodItem.Edges.Chapters) > 0 {
+		category = vodItem.Edges.Chapters[len(vodItem.Edges.Chapters)-1].Title
+	}
+	var categoryPtr *string
+	if category != "" {
+		categoryPtr = &category
+	}
+
+	data := newTemplateData(ctx, input.Event, vodItem.Edges.Channel, vodItem, qItem, failedTask, category)
+	variableMap := getVariableMap(vodItem.Edges.Channel, vodItem, qItem, failedTask, categoryPtr)
+	setPreviewEventData(&data, vodItem)
+
+	if !input.BodyTemplate {
+		return renderTemplate(input.Template, data, variableMap)
+	}
+
+	// body templates can include the message rendered from the event's template
+	data.Message, err = renderTemplate(eventTemplate(input.Event), data, variableMap)
+	if err != nil {
+		return "", fmt.Errorf("error rendering %s template: %w", input.Event, err)
+	}
+	body, err := renderBodyTemplate(input.Template, data, variableMap)
+	if err != nil {
+		return "", err
+	}
+	return string(body), nil
+}
+
+// setPreviewEventData sets example data of events that are not about the video.
+func setPreviewEventData(data *TemplateData, vodItem *ent.Vod) {
+	switch data.Event {
+	case utils.NotificationEventVideoDeleted:
+		data.Reason = "retention"
+		data.FreedBytes = vodItem.StorageSizeBytes
+	case utils.NotificationEventDiskLow:
+		path := config.GetEnvConfig().VideosDir
+		free, total, _ := utils.GetDiskUsage(path)
+		data.Disk = &DiskUsage{
+			Path:           path,
+			FreeBytes:      free,
+			TotalBytes:     total,
+			ThresholdBytes: int64(config.Get().Notification.DiskLowThresholdGB) * 1024 * 1024 * 1024,
+		}
+	case utils.NotificationEventCredentialInvalid:
+		data.Credential = "Twitch token"
+		data.Reason = "token is invalid or expired"
+	case utils.NotificationEventLoginLockout:
+		data.Lockout = &LoginLockout{Kind: utils.LoginThrottleIP, Value: "203.0.113.7", Attempts: 20, LockedUntil: time.Now().Add(15 * time.Minute)}
+	}
+}
+
+// eventTemplate returns the configured message template of the event.
+func eventTemplate(event utils.NotificationEvent) string {
+	notificationConfig := config.Get().Notification
+	switch event {
+	case utils.NotificationEventVideoSuccess:
+		return notificationConfig.VideoSuccessTemplate
+	case utils.NotificationEventLiveSuccess:
+		return notificationConfig.LiveSuccessTemplate
+	case utils.NotificationEventError:
+		return notificationConfig.ErrorTemplate
+	case utils.NotificationEventIsLive:
+		return notificationConfig.IsLiveTemplate
+	case utils.NotificationEventArchiveQueued:
+		return notificationConfig.ArchiveQueuedTemplate
+	case utils.NotificationEventArchiveStarted:
+		return notificationConfig.ArchiveStartedTemplate
+	case utils.NotificationEventVideoDeleted:
+		return notificationConfig.VideoDeletedTemplate
+	case utils.NotificationEventDiskLow:
+		return notificationConfig.DiskLowTemplate
+	case utils.NotificationEventCredentialInvalid:
+		return notificationConfig.CredentialInvalidTemplate
+	case utils.NotificationEventWatchdogFailed:
+		return notificationConfig.WatchdogFailedTemplate
+	case utils.NotificationEventLoginLockout:
+		return notificationConfig.LoginLockoutTemplate
+	}
+	return ""
+}
@@ -0,0 +1,169 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/zibbp/ganymede/internal/config"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// Message is a notification rendered from a template, with details providers can use for rich payloads.
+type Message struct {
+	Event           utils.NotificationEvent `json:"event"`
+	Title           string                  `json:"title"`
+	Body            string                  `json:"body"`              // rendered template
+	URL             string                  `json:"url"`               // link to the video, empty if the application URL is not configured
+	ThumbnailURL    string                  `json:"thumbnail_url"`     // empty if unknown
+	ChannelName     string                  `json:"channel_name"`      // display name of the channel
+	ChannelImageURL string                  `json:"channel_image_url"` // empty if unknown
+	Duration        int                     `json:"duration"`          // video duration in seconds, 0 if unknown
+
+	// data the message was rendered with, used by providers with a body template
+	templateData *TemplateData
+	variableMap  map[string]interface{}
+}
+
+// Provider delivers a message to a notification service.
+type Provider interface {
+	Send(ctx context.Context, message Message) error
+}
+
+// httpClient is used by all HTTP based providers.
+var httpClient = &http.Client{Timeout: 30 * time.Second}
+
+// newProvider returns the provider for the configuration.
+func newProvider(cfg config.NotificationProvider) (Provider, error) {
+	if cfg.BodyTemplate != "" && cfg.Type != utils.NotificationProviderSMTP {
+		return newTemplateBodyProvider(cfg), nil
+	}
+	switch cfg.Type {
+	case utils.NotificationProviderWebhook:
+		return &webhookProvider{url: cfg.URL, headers: cfg.Headers}, nil
+	case utils.NotificationProviderDiscord:
+		return &discordProvider{url: cfg.URL, headers: cfg.Headers}, nil
+	case utils.NotificationProviderSlack:
+		return &slackProvider{url: cfg.URL, headers: cfg.Headers}, nil
+	case utils.NotificationProviderNtfy:
+		return &ntfyProvider{url: cfg.URL, token: cfg.Token, headers: cfg.Headers}, nil
+	case utils.NotificationProviderGotify:
+		return &gotifyProvider{url: cfg.URL, token: cfg.Token, headers: cfg.Headers}, nil
+	case utils.NotificationProviderApprise:
+		return &appriseProvider{url: cfg.URL, headers: cfg.Headers}, nil
+	case utils.NotificationProviderSMTP:
+		return &smtpProvider{settings: cfg.SMTP}, nil
+	default:
+		return nil, fmt.Errorf("unknown notification provider type %s", cfg.Type)
+	}
+}
+
+// postJSON sends the body as JSON and returns an error if the response status is not 2xx.
+func postJSON(ctx context.Context, url string, headers map[string]string, body any) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("error marshalling request body: %w", err)
+	}
+	requestHeaders := map[string]string{"Content-Type": "application/json"}
+	for key, value := range headers {
+		requestHeaders[key] = value
+	}
+	return post(ctx, url, requestHeaders, data)
+}
+
+// post sends the body and returns an error if the response status is not 2xx.
+func post(ctx context.Context, url string, headers map[string]string, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error sending request: %w", err)
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.Debug().Err(err).Msg("error closing response body")
+		}
+	}()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, bytes.TrimSpace(respBody))
+	}
+
+	return nil
+}
+
+// isAlertEvent reports whether the event needs attention, providers send these with a higher priority.
+func isAlertEvent(event utils.NotificationEvent) bool {
+	switch event {
+	case utils.NotificationEventError, utils.NotificationEventWatchdogFailed, utils.NotificationEventDiskLow, utils.NotificationEventCredentialInvalid, utils.NotificationEventLoginLockout:
+		return true
+	}
+	return false
+}
+
+// formatDuration formats seconds as e.g. 1h2m3s.
+func formatDuration(seconds int) string {
+	return (time.Duration(seconds) * time.Second).String()
+}
+
+// webhookProvider sends the `{content, body}` JSON used by the webhook URLs since the first release.
+type webhookProvider struct {
+	url     string
+	headers map[string]string
+}
+
+type WebhookRequestBody struct {
+	Content string `json:"content"`
+	Body    string `json:"body"`
+}
+
+func (p *webhookProvider) Send(ctx context.Context, message Message) error {
+	return postJSON(ctx, p.url, p.headers, WebhookRequestBody{
+		Content: message.Body,
+		Body:    message.Body,
+	})
+}
+
+// appriseProvider sends generic JSON compatible with the Apprise API notify endpoint.
+type appriseProvider struct {
+	url     string
+	headers map[string]string
+}
+
+type appriseRequestBody struct {
+	Title string `json:"title"`
+	Body  string `json:"body"`
+	Type  string `json:"type"` // info, success, warning or failure
+	// extra fields for generic JSON receivers, ignored by Apprise
+	Message
+}
+
+func (p *appriseProvider) Send(ctx context.Context, message Message) error {
+	notifyType := "info"
+	switch message.Event {
+	case utils.NotificationEventVideoSuccess, utils.NotificationEventLiveSuccess:
+		notifyType = "success"
+	case utils.NotificationEventError, utils.NotificationEventWatchdogFailed:
+		notifyType = "failure"
+	case utils.NotificationEventDiskLow, utils.NotificationEventCredentialInvalid, utils.NotificationEventLoginLockout:
+		notifyType = "warning"
+	}
+	return postJSON(ctx, p.url, p.headers, appriseRequestBody{
+		Title:   message.Title,
+		Body:    message.Body,
+		Type:    notifyType,
+		Message: message,
+	})
+}
@@ -0,0 +1,140 @@
+package notification
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/zibbp/ganymede/internal/config"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+func TestProvidersForEvent(t *testing.T) {
+	notificationConfig := config.Notification{
+		Providers: []config.NotificationProvider{
+			{Name: "discord", Type: utils.NotificationProviderDiscord, Enabled: true, Events: []utils.NotificationEvent{utils.NotificationEventVideoSuccess, utils.NotificationEventError}},
+			{Name: "ntfy", Type: utils.NotificationProviderNtfy, Enabled: true, Events: []utils.NotificationEvent{utils.NotificationEventIsLive}},
+			{Name: "disabled", Type: utils.NotificationProviderSlack, Enabled: false, Events: []utils.NotificationEvent{utils.NotificationEventVideoSuccess}},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		webhookURL string
+		event      utils.NotificationEvent
+		expected   []string
+	}{
+		{name: "webhook url and provider", webhookURL: "https://example.com", event: utils.NotificationEventVideoSuccess, expected: []string{"video_success webhook url", "discord"}},
+		{name: "provider only", event: utils.NotificationEventIsLive, expected: []string{"ntfy"}},
+		{name: "no providers", event: utils.NotificationEventLiveSuccess, expected: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			providers := providersForEvent(notificationConfig, tt.webhookURL, tt.event)
+			names := []string{}
+			for _, provider := range providers {
+				names = append(names, provider.Name)
+			}
+			if strings.Join(names, ",") != strings.Join(tt.expected, ",") {
+				t.Errorf("providersForEvent() = %v, want %v", names, tt.expected)
+			}
+		})
+	}
+}
+
+func TestDiscordProviderSendsEmbed(t *testing.T) {
+	var received discordWebhookBody
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Custom") != "value" {
+			t.Errorf("custom header was not sent")
+		}
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			t.Errorf("error decoding body: %v", err)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	provider, err := newProvider(config.NotificationProvider{Type: utils.NotificationProviderDiscord, URL: server.URL, Headers: map[string]string{"X-Custom": "value"}})
+	if err != nil {
+		t.Fatalf("newProvider() error = %v", err)
+	}
+
+	err = provider.Send(t.Context(), Message{
+		Event:        utils.NotificationEventVideoSuccess,
+		Title:        "Video Archived",
+		Body:         "Demo title by Demo Channel",
+		URL:          "https://ganymede.example.com/videos/1",
+		ThumbnailURL: "https://ganymede.example.com/thumb.jpg",
+		ChannelName:  "Demo Channel",
+		Duration:     3723,
+	})
+	if err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+
+	if len(received.Embeds) != 1 {
+		t.Fatalf("received %d embeds, want 1", len(received.Embeds))
+	}
+	embed := received.Embeds[0]
+	if embed.Description != "Demo title by Demo Channel" || embed.URL != "https://ganymede.example.com/videos/1" {
+		t.Errorf("unexpected embed %+v", embed)
+	}
+	if embed.Image == nil || embed.Image.URL != "https://ganymede.example.com/thumb.jpg" {
+		t.Errorf("thumbnail was not set: %+v", embed.Image)
+	}
+	if len(embed.Fields) != 1 || embed.Fields[0].Value != "1h2m3s" {
+		t.Errorf("duration field = %+v, want 1h2m3s", embed.Fields)
+	}
+}
+
+func TestSendWithRetry(t *testing.T) {
+	deliveryBackoff = time.Millisecond
+	defer func() { deliveryBackoff = 5 * time.Second }()
+
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.Copy(io.Discard, r.Body)
+		if requests.Add(1) < 3 {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	attempts, err := sendWithRetry(&webhookProvider{url: server.URL}, Message{Body: "test"})
+	if err != nil {
+		t.Fatalf("sendWithRetry() error = %v", err)
+	}
+	if attempts != 3 {
+		t.Errorf("sendWithRetry() attempts = %d, want 3", attempts)
+	}
+}
+
+func TestSendWithRetryFails(t *testing.T) {
+	deliveryBackoff = time.Millisecond
+	defer func() { deliveryBackoff = 5 * time.Second }()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "invalid token", http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	attempts, err := sendWithRetry(&gotifyProvider{url: server.URL, token: "token"}, Message{Body: "test"})
+	if err == nil {
+		t.Fatal("sendWithRetry() expected an error")
+	}
+	if attempts != maxDeliveryAttempts {
+		t.Errorf("sendWithRetry() attempts = %d, want %d", attempts, maxDeliveryAttempts)
+	}
+	if !strings.Contains(err.Error(), "401") {
+		t.Errorf("error %q does not contain the status code", err)
+	}
+}
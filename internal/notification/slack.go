@@ -0,0 +1,78 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+)
+
+// slackProvider sends the message as Slack blocks to an incoming webhook.
+type slackProvider struct {
+	url     string
+	headers map[string]string
+}
+
+type slackWebhookBody struct {
+	Text   string       `json:"text"` // shown in notifications and clients without block support
+	Blocks []slackBlock `json:"blocks"`
+}
+
+type slackBlock struct {
+	Type      string      `json:"type"`
+	Text      *slackText  `json:"text,omitempty"`
+	Elements  []any       `json:"elements,omitempty"`
+	Accessory *slackImage `json:"accessory,omitempty"`
+}
+
+type slackText struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+type slackImage struct {
+	Type     string `json:"type"`
+	ImageURL string `json:"image_url"`
+	AltText  string `json:"alt_text"`
+}
+
+type slackButton struct {
+	Type string    `json:"type"`
+	Text slackText `json:"text"`
+	URL  string    `json:"url"`
+}
+
+func (p *slackProvider) Send(ctx context.Context, message Message) error {
+	section := slackBlock{
+		Type: "section",
+		Text: &slackText{Type: "mrkdwn", Text: message.Body},
+	}
+	if message.ThumbnailURL != "" {
+		section.Accessory = &slackImage{Type: "image", ImageURL: message.ThumbnailURL, AltText: "thumbnail"}
+	}
+
+	blocks := []slackBlock{
+		{Type: "header", Text: &slackText{Type: "plain_text", Text: message.Title}},
+		section,
+	}
+
+	contextElements := []any{}
+	if message.ChannelName != "" {
+		if message.ChannelImageURL != "" {
+			contextElements = append(contextElements, slackImage{Type: "image", ImageURL: message.ChannelImageURL, AltText: message.ChannelName})
+		}
+		contextElements = append(contextElements, slackText{Type: "mrkdwn", Text: message.ChannelName})
+	}
+	if message.Duration > 0 {
+		contextElements = append(contextElements, slackText{Type: "mrkdwn", Text: fmt.Sprintf("Duration: %s", formatDuration(message.Duration))})
+	}
+	if len(contextElements) > 0 {
+		blocks = append(blocks, slackBlock{Type: "context", Elements: contextElements})
+	}
+
+	if message.URL != "" {
+		blocks = append(blocks, slackBlock{Type: "actions", Elements: []any{
+			slackButton{Type: "button", Text: slackText{Type: "plain_text", Text: "Open"}, URL: message.URL},
+		}})
+	}
+
+	return postJSON(ctx, p.url, p.headers, slackWebhookBody{Text: message.Body, Blocks: blocks})
+}
@@ -0,0 +1,163 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"github.com/zibbp/ganymede/ent"
+	entChannel "github.com/zibbp/ganymede/ent/channel"
+	entNotificationSubscription "github.com/zibbp/ganymede/ent/notificationsubscription"
+	entPlaylist "github.com/zibbp/ganymede/ent/playlist"
+	entVod "github.com/zibbp/ganymede/ent/vod"
+	"github.com/zibbp/ganymede/internal/acl"
+	"github.com/zibbp/ganymede/internal/config"
+	"github.com/zibbp/ganymede/internal/database"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// subscriptionTemplates are the messages sent to subscribers.
+var subscriptionTemplates = map[utils.SubscriptionEvent]string{
+	utils.SubscriptionEventChannelLive:        `🔴 {{.Channel.DisplayName}} is live{{with .Category}} playing {{.}}{{end}}!`,
+	utils.SubscriptionEventArchiveCompleted:   `✅ {{.Vod.Title}} by {{.Channel.DisplayName}} was archived.`,
+	utils.SubscriptionEventPlaylistVideoAdded: `➕ {{.Vod.Title}} by {{.Channel.DisplayName}} was added to {{.Playlist.Name}}.`,
+}
+
+var subscriptionTitles = map[utils.SubscriptionEvent]string{
+	utils.SubscriptionEventChannelLive:        "Channel Live",
+	utils.SubscriptionEventArchiveCompleted:   "Video Archived",
+	utils.SubscriptionEventPlaylistVideoAdded: "Video Added to Playlist",
+}
+
+// SendChannelSubscriptionNotifications notifies the users subscribed to the event of the video's channel.
+func SendChannelSubscriptionNotifications(event utils.SubscriptionEvent, vodID uuid.UUID, category string) {
+	sendSubscriptionNotifications(event, vodID, uuid.Nil, category)
+}
+
+// SendPlaylistSubscriptionNotifications notifies the users subscribed to the playlist that the video was added.
+func SendPlaylistSubscriptionNotifications(playlistID uuid.UUID, vodID uuid.UUID) {
+	sendSubscriptionNotifications(utils.SubscriptionEventPlaylistVideoAdded, vodID, playlistID, "")
+}
+
+func sendSubscriptionNotifications(event utils.SubscriptionEvent, vodID uuid.UUID, playlistID uuid.UUID, category string) {
+	store := database.DB()
+	if store == nil {
+		return
+	}
+	ctx := context.Background()
+	logger := log.With().Str("event", string(event)).Str("video_id", vodID.String()).Logger()
+
+	vodItem, err := store.Client.Vod.Query().Where(entVod.ID(vodID)).WithChannel().WithQueue().Only(ctx)
+	if err != nil {
+		logger.Error().Err(err).Msg("error getting video for subscription notifications")
+		return
+	}
+	if vodItem.Edges.Channel == nil {
+		return
+	}
+	qItem := vodItem.Edges.Queue
+	if qItem == nil {
+		qItem = &ent.Queue{}
+	}
+
+	query := store.Client.NotificationSubscription.Query().Where(entNotificationSubscription.EventEQ(event)).WithUser()
+	var playlistItem *ent.Playlist
+	if event == utils.SubscriptionEventPlaylistVideoAdded {
+		playlistItem, err = store.Client.Playlist.Get(ctx, playlistID)
+		if err != nil {
+			logger.Error().Err(err).Msg("error getting playlist for subscription notifications")
+			return
+		}
+		query = query.Where(entNotificationSubscription.HasPlaylistWith(entPlaylist.ID(playlistID)))
+	} else {
+		query = query.Where(entNotificationSubscription.HasChannelWith(entChannel.ID(vodItem.Edges.Channel.ID)))
+	}
+
+	subscriptions, err := query.All(ctx)
+	if err != nil {
+		logger.Error().Err(err).Msg("error getting notification subscriptions")
+		return
+	}
+	if len(subscriptions) == 0 {
+		return
+	}
+
+	notificationEvent := subscriptionNotificationEvent(event, vodItem)
+	data := newTemplateData(ctx, notificationEvent, vodItem.Edges.Channel, vodItem, qItem, "", category)
+	data.Playlist = playlistItem
+	variableMap := getVariableMap(vodItem.Edges.Channel, vodItem, qItem, "", &category)
+	body, err := renderTemplate(subscriptionTemplates[event], data, variableMap)
+	if err != nil {
+		logger.Error().Err(err).Msg("error rendering subscription notification")
+		return
+	}
+	data.Message = body
+	message := newMessage(notificationEvent, subscriptionTitles[event], body, vodItem.Edges.Channel, vodItem)
+	message.templateData = &data
+	message.variableMap = variableMap
+
+	for _, subscription := range subscriptions {
+		provider, ok := subscriptionProvider(subscription)
+		if !ok {
+			logger.Debug().Str("subscription_id", subscription.ID.String()).Msg("subscription has no destination")
+			continue
+		}
+		// access may have changed since the user subscribed
+		visible, err := subscriberCanView(ctx, store, subscription.Edges.User, vodID, playlistItem)
+		if err != nil {
+			logger.Error().Err(err).Str("subscription_id", subscription.ID.String()).Msg("error checking subscription access")
+			continue
+		}
+		if !visible {
+			logger.Debug().Str("subscription_id", subscription.ID.String()).Msg("subscriber can not view the video")
+			continue
+		}
+		go deliver(provider, message)
+	}
+}
+
+// subscriberCanView returns whether the subscriber can view the video, and the playlist if the notification is about one.
+func subscriberCanView(ctx context.Context, store *database.Database, subscriber *ent.User, vodID uuid.UUID, playlistItem *ent.Playlist) (bool, error) {
+	ctx = acl.WithViewer(ctx, subscriber)
+	visible, err := store.Client.Vod.Query().Where(entVod.ID(vodID), acl.Vods(ctx)).Exist(ctx)
+	if err != nil || !visible || playlistItem == nil {
+		return visible, err
+	}
+	return store.Client.Playlist.Query().Where(entPlaylist.ID(playlistItem.ID), acl.Playlists(ctx)).Exist(ctx)
+}
+
+// subscriptionNotificationEvent returns the notification event used for the payload of providers, e.g. the embed color.
+func subscriptionNotificationEvent(event utils.SubscriptionEvent, vodItem *ent.Vod) utils.NotificationEvent {
+	switch event {
+	case utils.SubscriptionEventChannelLive:
+		return utils.NotificationEventIsLive
+	case utils.SubscriptionEventArchiveCompleted:
+		if vodItem.Type == utils.Live {
+			return utils.NotificationEventLiveSuccess
+		}
+	}
+	return utils.NotificationEventVideoSuccess
+}
+
+// subscriptionProvider returns the provider of the subscription. The user's webhook is used if the subscription has no URL.
+func subscriptionProvider(subscription *ent.NotificationSubscription) (config.NotificationProvider, bool) {
+	url := subscription.URL
+	username := ""
+	if subscription.Edges.User != nil {
+		username = subscription.Edges.User.Username
+		if url == "" {
+			url = subscription.Edges.User.Webhook
+		}
+	}
+	if url == "" {
+		return config.NotificationProvider{}, false
+	}
+	return config.NotificationProvider{
+		Name:    fmt.Sprintf("%s %s subscription", username, subscription.Event),
+		Type:    subscription.ProviderType,
+		Enabled: true,
+		URL:     url,
+		Token:   subscription.Token,
+	}, true
+}
@@ -0,0 +1,52 @@
+package notification
+
+import (
+	"testing"
+
+	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+func TestSubscriptionProvider(t *testing.T) {
+	userItem := &ent.User{Username: "viewer", Webhook: "https://example.com/user"}
+
+	tests := []struct {
+		name         string
+		subscription *ent.NotificationSubscription
+		expectedURL  string
+		expectedOK   bool
+	}{
+		{
+			name:         "subscription url",
+			subscription: &ent.NotificationSubscription{Event: utils.SubscriptionEventChannelLive, ProviderType: utils.NotificationProviderNtfy, URL: "https://ntfy.sh/topic", Edges: ent.NotificationSubscriptionEdges{User: userItem}},
+			expectedURL:  "https://ntfy.sh/topic",
+			expectedOK:   true,
+		},
+		{
+			name:         "user webhook",
+			subscription: &ent.NotificationSubscription{Event: utils.SubscriptionEventChannelLive, ProviderType: utils.NotificationProviderWebhook, Edges: ent.NotificationSubscriptionEdges{User: userItem}},
+			expectedURL:  "https://example.com/user",
+			expectedOK:   true,
+		},
+		{
+			name:         "no destination",
+			subscription: &ent.NotificationSubscription{Event: utils.SubscriptionEventChannelLive, ProviderType: utils.NotificationProviderWebhook, Edges: ent.NotificationSubscriptionEdges{User: &ent.User{Username: "viewer"}}},
+			expectedOK:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider, ok := subscriptionProvider(tt.subscription)
+			if ok != tt.expectedOK {
+				t.Fatalf("subscriptionProvider() ok = %v, want %v", ok, tt.expectedOK)
+			}
+			if provider.URL != tt.expectedURL {
+				t.Errorf("subscriptionProvider() url = %q, want %q", provider.URL, tt.expectedURL)
+			}
+			if ok && provider.Type != tt.subscription.ProviderType {
+				t.Errorf("subscriptionProvider() type = %q, want %q", provider.Type, tt.subscription.ProviderType)
+			}
+		})
+	}
+}
@@ -0,0 +1,222 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/zibbp/ganymede/ent"
+	entChapter "github.com/zibbp/ganymede/ent/chapter"
+	entVod "github.com/zibbp/ganymede/ent/vod"
+	"github.com/zibbp/ganymede/internal/config"
+	"github.com/zibbp/ganymede/internal/database"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// TemplateData is the data notification templates are rendered with.
+//
+// Templates are Go text/template templates. The variables of the original {{variable}} syntax, e.g. {{vod_title}}, are available as functions so existing templates keep working.
+type TemplateData struct {
+	Event      utils.NotificationEvent `json:"event"`
+	Channel    *ent.Channel            `json:"channel"`
+	Vod        *ent.Vod                `json:"vod"`
+	Queue      *ent.Queue              `json:"queue"`
+	Playlist   *ent.Playlist           `json:"playlist"` // playlist the video was added to for subscription notifications
+	Chapters   []*ent.Chapter          `json:"chapters"`
+	Categories []string                `json:"categories"`  // unique categories of the chapters in order of appearance
+	Category   string                  `json:"category"`    // category the channel went live with
+	FailedTask string                  `json:"failed_task"` // task that failed for error notifications
+	URL        string                  `json:"url"`         // link to the video, empty if the application URL is not configured
+	Message    string                  `json:"message"`     // rendered message template, only set when rendering body templates
+	Reason     string                  `json:"reason"`      // why the video was deleted or the credential is invalid
+	FreedBytes int64                   `json:"freed_bytes"` // storage used by the deleted video
+	Disk       *DiskUsage              `json:"disk"`        // directory that is low on space for disk low notifications
+	Credential string                  `json:"credential"`  // credential that is no longer valid, e.g. "Twitch token"
+	Lockout    *LoginLockout           `json:"lockout"`     // locked IP address or username for login lockout notifications
+}
+
+// LoginLockout is an IP address or username locked out after failed logins.
+type LoginLockout struct {
+	Kind        utils.LoginThrottleKind `json:"kind"`
+	Value       string                  `json:"value"`
+	Attempts    int                     `json:"attempts"`
+	LockedUntil time.Time               `json:"locked_until"`
+}
+
+// DiskUsage is the free space of a directory.
+type DiskUsage struct {
+	Path           string `json:"path"`
+	FreeBytes      int64  `json:"free_bytes"`
+	TotalBytes     int64  `json:"total_bytes"`
+	ThresholdBytes int64  `json:"threshold_bytes"`
+}
+
+// templateFuncs are the helpers available in every template.
+var templateFuncs = template.FuncMap{
+	"formatDate": func(layout string, t time.Time) string {
+		return t.Format(layout)
+	},
+	"formatDuration": func(seconds int) string {
+		return formatDuration(seconds)
+	},
+	"formatBytes": formatBytes,
+	// clock formats seconds as e.g. 01:02:03
+	"clock": func(seconds int) string {
+		return fmt.Sprintf("%02d:%02d:%02d", seconds/3600, seconds%3600/60, seconds%60)
+	},
+	"now":       time.Now,
+	"lower":     strings.ToLower,
+	"upper":     strings.ToUpper,
+	"trim":      strings.TrimSpace,
+	"replace":   strings.ReplaceAll,
+	"contains":  strings.Contains,
+	"hasPrefix": strings.HasPrefix,
+	"hasSuffix": strings.HasSuffix,
+	"join":      strings.Join,
+	"inList": func(list []string, value string) bool {
+		return slices.Contains(list, value)
+	},
+	"list": func(values ...string) []string {
+		return values
+	},
+	"default": func(fallback any, value any) any {
+		if value == nil || fmt.Sprint(value) == "" {
+			return fallback
+		}
+		return value
+	},
+	// json encodes the value so it can be embedded in a JSON body template, e.g. {"content": {{json .Message}}}
+	"json": func(value any) (string, error) {
+		data, err := json.Marshal(value)
+		return string(data), err
+	},
+}
+
+// newTemplate parses the template with the helper functions and the legacy variables.
+func newTemplate(name string, text string, variableMap map[string]interface{}) (*template.Template, error) {
+	funcs := template.FuncMap{}
+	for key, value := range variableMap {
+		funcs[key] = func() interface{} { return value }
+	}
+	return template.New(name).Funcs(templateFuncs).Funcs(funcs).Option("missingkey=zero").Parse(text)
+}
+
+// renderTemplate renders the template with the data.
+func renderTemplate(text string, data TemplateData, variableMap map[string]interface{}) (string, error) {
+	tmpl, err := newTemplate("notification", text, variableMap)
+	if err != nil {
+		return "", fmt.Errorf("error parsing template: %w", err)
+	}
+	var b bytes.Buffer
+	if err := tmpl.Execute(&b, data); err != nil {
+		return "", fmt.Errorf("error rendering template: %w", err)
+	}
+	return b.String(), nil
+}
+
+// renderBodyTemplate renders a template of an entire JSON request body and checks the result is valid JSON.
+func renderBodyTemplate(text string, data TemplateData, variableMap map[string]interface{}) ([]byte, error) {
+	body, err := renderTemplate(text, data, variableMap)
+	if err != nil {
+		return nil, err
+	}
+	if !json.Valid([]byte(body)) {
+		return nil, fmt.Errorf("body template did not render valid JSON: %s", body)
+	}
+	return []byte(body), nil
+}
+
+// newTemplateData returns the template data for the video. Chapters are loaded from the database if they are not loaded on the video.
+func newTemplateData(ctx context.Context, event utils.NotificationEvent, channelItem *ent.Channel, vodItem *ent.Vod, qItem *ent.Queue, failedTask string, category string) TemplateData {
+	data := TemplateData{
+		Event:      event,
+		Channel:    channelItem,
+		Vod:        vodItem,
+		Queue:      qItem,
+		Chapters:   vodItem.Edges.Chapters,
+		Category:   category,
+		FailedTask: failedTask,
+		URL:        videoURL(vodItem),
+	}
+
+	if data.Chapters == nil {
+		if store := database.DB(); store != nil {
+			chapters, err := store.Client.Chapter.Query().Where(entChapter.HasVodWith(entVod.ID(vodItem.ID))).Order(ent.Asc(entChapter.FieldStart)).All(ctx)
+			if err == nil {
+				data.Chapters = chapters
+			}
+		}
+	}
+
+	data.Categories = []string{}
+	for _, chapter := range data.Chapters {
+		if chapter.Title != "" && !slices.Contains(data.Categories, chapter.Title) {
+			data.Categories = append(data.Categories, chapter.Title)
+		}
+	}
+	if category != "" && !slices.Contains(data.Categories, category) {
+		data.Categories = append(data.Categories, category)
+	}
+
+	return data
+}
+
+// templateBodyProvider sends the rendered body template of the provider instead of the provider's own payload.
+type templateBodyProvider struct {
+	url          string
+	headers      map[string]string
+	bodyTemplate string
+}
+
+func newTemplateBodyProvider(cfg config.NotificationProvider) *templateBodyProvider {
+	p := &templateBodyProvider{
+		url:          cfg.URL,
+		headers:      map[string]string{"Content-Type": "application/json"},
+		bodyTemplate: cfg.BodyTemplate,
+	}
+	// keep the authentication of the provider type
+	switch cfg.Type {
+	case utils.NotificationProviderGotify:
+		p.url = strings.TrimSuffix(cfg.URL, "/") + "/message"
+		p.headers["X-Gotify-Key"] = cfg.Token
+	case utils.NotificationProviderNtfy:
+		if cfg.Token != "" {
+			p.headers["Authorization"] = "Bearer " + cfg.Token
+		}
+	}
+	for key, value := range cfg.Headers {
+		p.headers[key] = value
+	}
+	return p
+}
+
+func (p *templateBodyProvider) Send(ctx context.Context, message Message) error {
+	data := TemplateData{Event: message.Event, URL: message.URL, Message: message.Body}
+	if message.templateData != nil {
+		data = *message.templateData
+	}
+	body, err := renderBodyTemplate(p.bodyTemplate, data, message.variableMap)
+	if err != nil {
+		return err
+	}
+	return post(ctx, p.url, p.headers, body)
+}
+
+// formatBytes formats bytes as e.g. 1.5 GiB.
+func formatBytes(bytes int64) string {
+	const unit = 1024
+	if bytes < unit {
+		return fmt.Sprintf("%d B", bytes)
+	}
+	div, exp := int64(unit), 0
+	for n := bytes / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(bytes)/float64(div), "KMGTPE"[exp])
+}
@@ -0,0 +1,182 @@
+package notification
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/internal/config"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+func testTemplateData() (TemplateData, map[string]interface{}) {
+	channelItem := &ent.Channel{ID: uuid.New(), ExtID: "123", DisplayName: "Test Channel"}
+	vodItem := &ent.Vod{
+		ID:         uuid.New(),
+		Title:      "Test Stream",
+		Duration:   3723,
+		StreamedAt: time.Date(2024, 5, 1, 18, 30, 0, 0, time.UTC),
+	}
+	qItem := &ent.Queue{ID: uuid.New()}
+	data := TemplateData{
+		Event:   utils.NotificationEventVideoSuccess,
+		Channel: channelItem,
+		Vod:     vodItem,
+		Queue:   qItem,
+		Chapters: []*ent.Chapter{
+			{Title: "Just Chatting", Start: 0},
+			{Title: "Minecraft", Start: 600},
+		},
+		Categories: []string{"Just Chatting", "Minecraft"},
+	}
+	return data, getVariableMap(channelItem, vodItem, qItem, "", nil)
+}
+
+func TestRenderTemplate(t *testing.T) {
+	data, variableMap := testTemplateData()
+
+	tests := []struct {
+		name     string
+		template string
+		expected string
+	}{
+		{name: "legacy variables", template: "{{vod_title}} by {{channel_display_name}}", expected: "Test Stream by Test Channel"},
+		{name: "fields", template: "{{.Vod.Title}} by {{.Channel.DisplayName}}", expected: "Test Stream by Test Channel"},
+		{name: "conditional", template: `{{if eq .Channel.DisplayName "Test Channel"}}<@&123> {{end}}{{vod_title}}`, expected: "<@&123> Test Stream"},
+		{name: "conditional not matching", template: `{{if eq channel_display_name "Other"}}<@&123> {{end}}{{vod_title}}`, expected: "Test Stream"},
+		{name: "chapters loop", template: `{{range .Chapters}}{{clock .Start}} {{.Title}};{{end}}`, expected: "00:00:00 Just Chatting;00:10:00 Minecraft;"},
+		{name: "categories", template: `{{join .Categories ", "}}`, expected: "Just Chatting, Minecraft"},
+		{name: "date and duration", template: `{{formatDate "2006-01-02 15:04" .Vod.StreamedAt}} {{formatDuration .Vod.Duration}}`, expected: "2024-05-01 18:30 1h2m3s"},
+		{name: "default", template: `{{default "no category" .Category}}`, expected: "no category"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rendered, err := renderTemplate(tt.template, data, variableMap)
+			if err != nil {
+				t.Fatalf("renderTemplate() error = %v", err)
+			}
+			if rendered != tt.expected {
+				t.Errorf("renderTemplate() = %q, want %q", rendered, tt.expected)
+			}
+		})
+	}
+}
+
+func TestRenderTemplateErrors(t *testing.T) {
+	data, variableMap := testTemplateData()
+
+	if _, err := renderTemplate("{{unknown_variable}}", data, variableMap); err == nil {
+		t.Error("expected error for unknown variable")
+	}
+	if rendered := renderLegacyTemplate("{{unknown_variable}} {{vod_title}}", variableMap); rendered != "<nil> Test Stream" {
+		t.Errorf("renderLegacyTemplate() = %q", rendered)
+	}
+	if _, err := renderBodyTemplate(`{"content": {{.Message}}}`, TemplateData{Message: "not json"}, variableMap); err == nil {
+		t.Error("expected error for invalid JSON body")
+	}
+}
+
+func TestTemplateBodyProvider(t *testing.T) {
+	data, variableMap := testTemplateData()
+	data.Message = `Archived "Test Stream"`
+
+	var received map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/message" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		if r.Header.Get("X-Gotify-Key") != "token" {
+			t.Errorf("gotify token was not sent")
+		}
+		body, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(body, &received); err != nil {
+			t.Errorf("error decoding body %s: %v", body, err)
+		}
+	}))
+	defer server.Close()
+
+	provider, err := newProvider(config.NotificationProvider{
+		Type:         utils.NotificationProviderGotify,
+		URL:          server.URL,
+		Token:        "token",
+		BodyTemplate: `{"message": {{json .Message}}, "title": {{json .Channel.DisplayName}}, "chapters": {{len .Chapters}}}`,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := provider.Send(t.Context(), Message{Event: data.Event, templateData: &data, variableMap: variableMap}); err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+
+	if received["message"] != `Archived "Test Stream"` || received["title"] != "Test Channel" || received["chapters"] != float64(2) {
+		t.Errorf("unexpected body %v", received)
+	}
+}
+
+func TestRenderSystemEventTemplate(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		data     TemplateData
+		expected string
+	}{
+		{
+			name:     "disk low",
+			template: "💾 Disk Low: {{.Disk.Path}} has {{formatBytes .Disk.FreeBytes}} of {{formatBytes .Disk.TotalBytes}} free.",
+			data:     TemplateData{Event: utils.NotificationEventDiskLow, Disk: &DiskUsage{Path: "/data/videos", FreeBytes: 5 << 30, TotalBytes: 500 << 30}},
+			expected: "💾 Disk Low: /data/videos has 5.0 GiB of 500.0 GiB free.",
+		},
+		{
+			name:     "credential invalid",
+			template: "🔑 Credential Invalid: {{.Credential}} is no longer valid: {{.Reason}}",
+			data:     TemplateData{Event: utils.NotificationEventCredentialInvalid, Credential: "Twitch token", Reason: "token is invalid or expired"},
+			expected: "🔑 Credential Invalid: Twitch token is no longer valid: token is invalid or expired",
+		},
+		{
+			name:     "video deleted",
+			template: "🗑️ Video Deleted: {{.Vod.Title}} by {{.Channel.DisplayName}} was deleted by {{.Reason}}, freeing {{formatBytes .FreedBytes}}.",
+			data:     TemplateData{Event: utils.NotificationEventVideoDeleted, Vod: &ent.Vod{Title: "Test Stream"}, Channel: &ent.Channel{DisplayName: "Test Channel"}, Reason: "retention", FreedBytes: 3 << 30},
+			expected: "🗑️ Video Deleted: Test Stream by Test Channel was deleted by retention, freeing 3.0 GiB.",
+		},
+		{
+			name:     "legacy variables are empty",
+			template: "{{vod_title}}{{channel_display_name}}",
+			data:     TemplateData{Event: utils.NotificationEventDiskLow},
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rendered, err := renderTemplate(tt.template, tt.data, emptyVariableMap())
+			if err != nil {
+				t.Fatalf("renderTemplate() error = %v", err)
+			}
+			if rendered != tt.expected {
+				t.Errorf("renderTemplate() = %q, want %q", rendered, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFormatBytes(t *testing.T) {
+	tests := []struct {
+		bytes    int64
+		expected string
+	}{
+		{bytes: 512, expected: "512 B"},
+		{bytes: 1536, expected: "1.5 KiB"},
+		{bytes: 3 << 30, expected: "3.0 GiB"},
+	}
+	for _, tt := range tests {
+		if got := formatBytes(tt.bytes); got != tt.expected {
+			t.Errorf("formatBytes(%d) = %q, want %q", tt.bytes, got, tt.expected)
+		}
+	}
+}
@@ -5,3 +5,9 @@ type ErrorNoStreamsFound struct{}
 func (e ErrorNoStreamsFound) Error() string {
 	return "no streams found"
 }
+
+type ErrorTokenInvalid struct{}
+
+func (e ErrorTokenInvalid) Error() string {
+	return "token is invalid or expired"
+}
@@ -1,6 +1,7 @@
 package platform
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -316,3 +317,30 @@ func (c *TwitchConnection) twitchMakeHTTPRequest(method, url string, queryParams
 
 	return nil, fmt.Errorf("max retry attempts reached")
 }
+
+// ValidateTwitchUserToken checks the user token used for subscriber-only videos and ad-free live streams. ErrorTokenInvalid is returned if Twitch rejects the token.
+func ValidateTwitchUserToken(ctx context.Context, token string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://id.twitch.tv/oauth2/validate", nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %v", err)
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("OAuth %s", token))
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to validate token: %v", err)
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.Debug().Err(err).Msg("error closing response body")
+		}
+	}()
+
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized:
+		return ErrorTokenInvalid{}
+	case resp.StatusCode != http.StatusOK:
+		return fmt.Errorf("failed to validate token: unexpected status code %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package playback
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"entgo.io/ent/dialect/sql"
@@ -10,6 +11,7 @@ import (
 	"github.com/zibbp/ganymede/ent"
 	"github.com/zibbp/ganymede/ent/playback"
 	entVod "github.com/zibbp/ganymede/ent/vod"
+	"github.com/zibbp/ganymede/internal/acl"
 	"github.com/zibbp/ganymede/internal/database"
 	"github.com/zibbp/ganymede/internal/utils"
 )
@@ -34,7 +36,25 @@ type GetPlayback struct {
 
 var ErrorPlaybackNotFound = fmt.Errorf("playback not found")
 
+// ErrVodNotFound is returned when the vod does not exist or the viewer cannot view it.
+var ErrVodNotFound = errors.New("vod not found")
+
+// checkVodVisible returns ErrVodNotFound if the viewer of the context cannot view the vod.
+func (s *Service) checkVodVisible(ctx context.Context, videoId uuid.UUID) error {
+	exists, err := s.Store.Client.Vod.Query().Where(entVod.ID(videoId), acl.Vods(ctx)).Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("error checking vod: %v", err)
+	}
+	if !exists {
+		return ErrVodNotFound
+	}
+	return nil
+}
+
 func (s *Service) UpdateProgress(ctx context.Context, userId uuid.UUID, videoId uuid.UUID, time int) error {
+	if err := s.checkVodVisible(ctx, videoId); err != nil {
+		return err
+	}
 	check, err := s.Store.Client.Playback.Query().Where(playback.UserID(userId)).Where(playback.VodID(videoId)).Order(playback.ByUpdatedAt(sql.OrderAsc())).First(ctx)
 	if err != nil {
 		if _, ok := err.(*ent.NotFoundError); ok {
@@ -76,10 +96,33 @@ func (s *Service) GetAllProgress(ctx context.Context, userId uuid.UUID) ([]*ent.
 		return nil, fmt.Errorf("error getting all playback: %v", err)
 	}
 
-	return playbackEntries, nil
+	// hide the progress of vods the user can no longer view
+	vodIDs := make([]uuid.UUID, 0, len(playbackEntries))
+	for _, playbackEntry := range playbackEntries {
+		vodIDs = append(vodIDs, playbackEntry.VodID)
+	}
+	visibleIDs, err := s.Store.Client.Vod.Query().Where(entVod.IDIn(vodIDs...), acl.Vods(ctx)).IDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting all playback: %v", err)
+	}
+	visible := make(map[uuid.UUID]bool, len(visibleIDs))
+	for _, id := range visibleIDs {
+		visible[id] = true
+	}
+	visibleEntries := make([]*ent.Playback, 0, len(playbackEntries))
+	for _, playbackEntry := range playbackEntries {
+		if visible[playbackEntry.VodID] {
+			visibleEntries = append(visibleEntries, playbackEntry)
+		}
+	}
+
+	return visibleEntries, nil
 }
 
 func (s *Service) UpdateStatus(ctx context.Context, userId uuid.UUID, videoId uuid.UUID, status string) error {
+	if err := s.checkVodVisible(ctx, videoId); err != nil {
+		return err
+	}
 	_, err := s.Store.Client.Playback.Query().Where(playback.UserID(userId)).Where(playback.VodID(videoId)).Order(playback.ByUpdatedAt(sql.OrderAsc())).First(ctx)
 	if err != nil {
 		if _, ok := err.(*ent.NotFoundError); ok {
@@ -132,9 +175,9 @@ func (s *Service) GetLastPlaybacks(ctx context.Context, userId uuid.UUID, limit
 
 	// Process the fetched playbacks
 	for _, playbackEntry := range playbackEntries {
-		vod, err := s.Store.Client.Vod.Query().Where(entVod.ID(playbackEntry.VodID)).WithChannel().Only(ctx)
+		vod, err := s.Store.Client.Vod.Query().Where(entVod.ID(playbackEntry.VodID), acl.Vods(ctx)).WithChannel().Only(ctx)
 		if err != nil {
-			// Skip if vod not found
+			// Skip if vod not found or no longer visible
 			continue
 		}
 
@@ -160,7 +203,7 @@ func (s *Service) GetLastPlaybacks(ctx context.Context, userId uuid.UUID, limit
 }
 
 func (s *Service) StartPlayback(c echo.Context, videoId uuid.UUID) error {
-	video, err := s.Store.Client.Vod.Query().Where(entVod.ID(videoId)).WithChannel().Only(c.Request().Context())
+	video, err := s.Store.Client.Vod.Query().Where(entVod.ID(videoId), acl.Vods(c.Request().Context())).WithChannel().Only(c.Request().Context())
 	if err != nil {
 		return fmt.Errorf("error getting video: %v", err)
 	}
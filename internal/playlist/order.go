@@ -0,0 +1,381 @@
+package playlist
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent"
+	entPlayback "github.com/zibbp/ganymede/ent/playback"
+	"github.com/zibbp/ganymede/ent/playlist"
+	"github.com/zibbp/ganymede/ent/playlistsection"
+	"github.com/zibbp/ganymede/ent/playlistvod"
+	"github.com/zibbp/ganymede/ent/vod"
+	"github.com/zibbp/ganymede/internal/acl"
+	"github.com/zibbp/ganymede/internal/database"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+var (
+	ErrPlaylistNotFound = errors.New("playlist not found")
+	ErrSectionNotFound  = errors.New("section not found")
+	ErrVodNotInPlaylist = errors.New("vod is not in playlist")
+	ErrNoNextVod        = errors.New("no unwatched vods left in playlist")
+)
+
+// Entry is the position of a vod in a playlist, set when reordering.
+type Entry struct {
+	VodID     uuid.UUID
+	SectionID *uuid.UUID
+}
+
+// orderedEntries returns the entries of the playlist in the order they are played, vods in the trash are left out.
+// Playlists without manual order are ordered by the date the vods were streamed.
+func orderedEntries(ctx context.Context, client *ent.Client, p *ent.Playlist) ([]*ent.PlaylistVod, error) {
+	query := client.PlaylistVod.Query().Where(playlistvod.PlaylistID(p.ID), playlistvod.HasVodWith(vod.DeletedAtIsNil()))
+	if p.ManualOrder {
+		query = query.Order(playlistvod.ByPosition())
+	}
+	entries, err := query.
+		Order(playlistvod.ByVodField(vod.FieldStreamedAt, sql.OrderDesc())).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting playlist entries: %v", err)
+	}
+	return entries, nil
+}
+
+// nextPosition returns the position after the last entry of the playlist.
+func nextPosition(ctx context.Context, client *ent.Client, playlistID uuid.UUID) (int, error) {
+	last, err := client.PlaylistVod.Query().
+		Where(playlistvod.PlaylistID(playlistID)).
+		Order(playlistvod.ByPosition(sql.OrderDesc())).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("error getting playlist entries: %v", err)
+	}
+	return last.Position + 1, nil
+}
+
+// writePositions stores the order and sections of the entries and enables manual order on the playlist.
+func writePositions(ctx context.Context, tx *ent.Tx, playlistID uuid.UUID, entries []*ent.PlaylistVod) error {
+	for i, e := range entries {
+		update := tx.PlaylistVod.Update().
+			Where(playlistvod.PlaylistID(playlistID), playlistvod.VodID(e.VodID)).
+			SetPosition(i)
+		if e.SectionID != nil {
+			update = update.SetSectionID(*e.SectionID)
+		} else {
+			update = update.ClearSectionID()
+		}
+		if err := update.Exec(ctx); err != nil {
+			return fmt.Errorf("error updating playlist entry: %v", err)
+		}
+	}
+	if err := tx.Playlist.UpdateOneID(playlistID).SetManualOrder(true).Exec(ctx); err != nil {
+		return fmt.Errorf("error updating playlist: %v", err)
+	}
+	return nil
+}
+
+// SetPlaylistOrder sets the order and sections of the vods in the playlist. Vods are ordered as listed,
+// vods that are not listed are kept in their current order after them.
+// Disabling manual order keeps the positions but orders the playlist by date again.
+func (s *Service) SetPlaylistOrder(ctx context.Context, playlistID uuid.UUID, manualOrder bool, order []Entry) ([]*ent.PlaylistVod, error) {
+	p, err := s.Store.Client.Playlist.Get(ctx, playlistID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrPlaylistNotFound
+		}
+		return nil, fmt.Errorf("error getting playlist: %v", err)
+	}
+
+	if !manualOrder {
+		if err := s.Store.Client.Playlist.UpdateOneID(playlistID).SetManualOrder(false).Exec(ctx); err != nil {
+			return nil, fmt.Errorf("error updating playlist: %v", err)
+		}
+		p.ManualOrder = false
+		return orderedEntries(ctx, s.Store.Client, p)
+	}
+
+	current, err := orderedEntries(ctx, s.Store.Client, p)
+	if err != nil {
+		return nil, err
+	}
+	byVod := make(map[uuid.UUID]*ent.PlaylistVod, len(current))
+	for _, e := range current {
+		byVod[e.VodID] = e
+	}
+
+	sectionIDs, err := s.Store.Client.PlaylistSection.Query().
+		Where(playlistsection.HasPlaylistWith(playlist.ID(playlistID))).
+		IDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting playlist sections: %v", err)
+	}
+	sections := make(map[uuid.UUID]bool, len(sectionIDs))
+	for _, id := range sectionIDs {
+		sections[id] = true
+	}
+
+	entries := make([]*ent.PlaylistVod, 0, len(current))
+	listed := make(map[uuid.UUID]bool, len(order))
+	for _, o := range order {
+		e, ok := byVod[o.VodID]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrVodNotInPlaylist, o.VodID)
+		}
+		if listed[o.VodID] {
+			continue
+		}
+		if o.SectionID != nil && !sections[*o.SectionID] {
+			return nil, fmt.Errorf("%w: %s", ErrSectionNotFound, o.SectionID)
+		}
+		listed[o.VodID] = true
+		e.SectionID = o.SectionID
+		entries = append(entries, e)
+	}
+	for _, e := range current {
+		if !listed[e.VodID] {
+			entries = append(entries, e)
+		}
+	}
+
+	tx, err := s.Store.Client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error starting transaction: %v", err)
+	}
+	if err := writePositions(ctx, tx, playlistID, entries); err != nil {
+		return nil, database.Rollback(tx, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("error committing transaction: %v", err)
+	}
+
+	p.ManualOrder = true
+	return orderedEntries(ctx, s.Store.Client, p)
+}
+
+// PlayNext moves the vod directly after another vod of the playlist, or to the start if there is none.
+// The vod is added to the playlist if it isn't in it yet and joins the section of the vod it follows.
+func (s *Service) PlayNext(ctx context.Context, playlistID uuid.UUID, vodID uuid.UUID, afterVodID *uuid.UUID) ([]*ent.PlaylistVod, error) {
+	p, err := s.Store.Client.Playlist.Get(ctx, playlistID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrPlaylistNotFound
+		}
+		return nil, fmt.Errorf("error getting playlist: %v", err)
+	}
+
+	if afterVodID != nil {
+		exists, err := s.Store.Client.PlaylistVod.Query().
+			Where(playlistvod.PlaylistID(playlistID), playlistvod.VodID(*afterVodID)).
+			Exist(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("error getting playlist entry: %v", err)
+		}
+		if !exists {
+			return nil, fmt.Errorf("%w: %s", ErrVodNotInPlaylist, afterVodID)
+		}
+	}
+
+	inPlaylist, err := s.Store.Client.PlaylistVod.Query().
+		Where(playlistvod.PlaylistID(playlistID), playlistvod.VodID(vodID)).
+		Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting playlist entry: %v", err)
+	}
+	if !inPlaylist {
+		if err := s.AddVodToPlaylist(ctx, playlistID, vodID); err != nil {
+			return nil, err
+		}
+	}
+
+	current, err := orderedEntries(ctx, s.Store.Client, p)
+	if err != nil {
+		return nil, err
+	}
+
+	var moved *ent.PlaylistVod
+	entries := make([]*ent.PlaylistVod, 0, len(current))
+	for _, e := range current {
+		if e.VodID == vodID {
+			moved = e
+			continue
+		}
+		entries = append(entries, e)
+	}
+	if moved == nil {
+		return nil, ErrVodNotInPlaylist
+	}
+
+	index := 0
+	if afterVodID != nil {
+		index = -1
+		for i, e := range entries {
+			if e.VodID == *afterVodID {
+				index = i + 1
+				break
+			}
+		}
+		if index == -1 {
+			return nil, fmt.Errorf("%w: %s", ErrVodNotInPlaylist, afterVodID)
+		}
+		moved.SectionID = entries[index-1].SectionID
+	}
+	entries = append(entries[:index], append([]*ent.PlaylistVod{moved}, entries[index:]...)...)
+
+	tx, err := s.Store.Client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error starting transaction: %v", err)
+	}
+	if err := writePositions(ctx, tx, playlistID, entries); err != nil {
+		return nil, database.Rollback(tx, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("error committing transaction: %v", err)
+	}
+
+	p.ManualOrder = true
+	return orderedEntries(ctx, s.Store.Client, p)
+}
+
+// GetNextVod returns the vod the user should watch next. With afterVodID it is the first vod after it
+// the user hasn't finished, otherwise the first vod of the playlist the user hasn't finished.
+func (s *Service) GetNextVod(ctx context.Context, playlistID uuid.UUID, userID uuid.UUID, afterVodID *uuid.UUID) (*ent.Vod, error) {
+	p, err := s.Store.Client.Playlist.Get(ctx, playlistID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrPlaylistNotFound
+		}
+		return nil, fmt.Errorf("error getting playlist: %v", err)
+	}
+
+	entries, err := orderedEntries(ctx, s.Store.Client, p)
+	if err != nil {
+		return nil, err
+	}
+
+	if afterVodID != nil {
+		index := -1
+		for i, e := range entries {
+			if e.VodID == *afterVodID {
+				index = i
+				break
+			}
+		}
+		if index == -1 {
+			return nil, ErrVodNotInPlaylist
+		}
+		entries = entries[index+1:]
+	}
+
+	vodIDs := make([]uuid.UUID, len(entries))
+	for i, e := range entries {
+		vodIDs[i] = e.VodID
+	}
+
+	var finishedIDs []uuid.UUID
+	err = s.Store.Client.Playback.Query().
+		Where(entPlayback.UserID(userID), entPlayback.VodIDIn(vodIDs...), entPlayback.StatusEQ(utils.Finished)).
+		Select(entPlayback.FieldVodID).
+		Scan(ctx, &finishedIDs)
+	if err != nil {
+		return nil, fmt.Errorf("error getting playback: %v", err)
+	}
+	finished := make(map[uuid.UUID]bool, len(finishedIDs))
+	for _, id := range finishedIDs {
+		finished[id] = true
+	}
+
+	// vods the user can't view are skipped
+	viewable, err := s.Store.Client.Vod.Query().
+		Where(vod.IDIn(vodIDs...), acl.Vods(ctx)).
+		WithChannel().
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting vods: %v", err)
+	}
+	byID := make(map[uuid.UUID]*ent.Vod, len(viewable))
+	for _, v := range viewable {
+		byID[v.ID] = v
+	}
+
+	for _, id := range vodIDs {
+		if v, ok := byID[id]; ok && !finished[id] {
+			return v, nil
+		}
+	}
+	return nil, ErrNoNextVod
+}
+
+// CreateSection creates a named section at the end of the playlist.
+func (s *Service) CreateSection(ctx context.Context, playlistID uuid.UUID, name string) (*ent.PlaylistSection, error) {
+	count, err := s.Store.Client.PlaylistSection.Query().
+		Where(playlistsection.HasPlaylistWith(playlist.ID(playlistID))).
+		Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting playlist sections: %v", err)
+	}
+	section, err := s.Store.Client.PlaylistSection.Create().
+		SetPlaylistID(playlistID).
+		SetName(name).
+		SetPosition(count).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, ErrPlaylistNotFound
+		}
+		return nil, fmt.Errorf("error creating section: %v", err)
+	}
+	return section, nil
+}
+
+// UpdateSection renames and moves a section of the playlist.
+func (s *Service) UpdateSection(ctx context.Context, playlistID uuid.UUID, sectionID uuid.UUID, name string, position int) (*ent.PlaylistSection, error) {
+	n, err := s.Store.Client.PlaylistSection.Update().
+		Where(playlistsection.ID(sectionID), playlistsection.HasPlaylistWith(playlist.ID(playlistID))).
+		SetName(name).
+		SetPosition(position).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error updating section: %v", err)
+	}
+	if n == 0 {
+		return nil, ErrSectionNotFound
+	}
+	return s.Store.Client.PlaylistSection.Get(ctx, sectionID)
+}
+
+// DeleteSection deletes a section of the playlist, its vods stay in the playlist without a section.
+func (s *Service) DeleteSection(ctx context.Context, playlistID uuid.UUID, sectionID uuid.UUID) error {
+	tx, err := s.Store.Client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %v", err)
+	}
+	n, err := tx.PlaylistSection.Delete().
+		Where(playlistsection.ID(sectionID), playlistsection.HasPlaylistWith(playlist.ID(playlistID))).
+		Exec(ctx)
+	if err != nil {
+		return database.Rollback(tx, fmt.Errorf("error deleting section: %v", err))
+	}
+	if n == 0 {
+		return database.Rollback(tx, ErrSectionNotFound)
+	}
+	_, err = tx.PlaylistVod.Update().
+		Where(playlistvod.PlaylistID(playlistID), playlistvod.SectionID(sectionID)).
+		ClearSectionID().
+		Save(ctx)
+	if err != nil {
+		return database.Rollback(tx, fmt.Errorf("error clearing section of playlist entries: %v", err))
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("error committing transaction: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,175 @@
+package playlist_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zibbp/ganymede/ent"
+	entUser "github.com/zibbp/ganymede/ent/user"
+	"github.com/zibbp/ganymede/internal/acl"
+	"github.com/zibbp/ganymede/internal/channel"
+	"github.com/zibbp/ganymede/internal/playlist"
+	"github.com/zibbp/ganymede/internal/server"
+	"github.com/zibbp/ganymede/internal/trash"
+	"github.com/zibbp/ganymede/internal/utils"
+	"github.com/zibbp/ganymede/internal/vod"
+	"github.com/zibbp/ganymede/tests"
+)
+
+// setupOrderPlaylist initializes the application with a playlist of three vods. Ordered by date the vods are third, second, first.
+func setupOrderPlaylist(t *testing.T) (*server.Application, *ent.Playlist, *ent.Channel, []*ent.Vod) {
+	app, err := tests.Setup(t)
+	require.NoError(t, err)
+
+	p, err := app.PlaylistService.CreatePlaylist(t.Context(), playlist.Playlist{Name: "Test Playlist"})
+	require.NoError(t, err)
+
+	c, err := app.ChannelService.CreateChannel(channel.Channel{ExtID: "123456789", Name: "TestChannel"})
+	require.NoError(t, err)
+
+	vods := make([]*ent.Vod, 3)
+	for i := range vods {
+		vods[i] = createPlaylistVod(t, app, p.ID, c.ID, time.Now().AddDate(0, 0, i-3))
+	}
+	return app, p, c, vods
+}
+
+func createPlaylistVod(t *testing.T, app *server.Application, playlistID uuid.UUID, channelID uuid.UUID, streamedAt time.Time) *ent.Vod {
+	v, err := app.VodService.CreateVod(vod.Vod{
+		ID:         uuid.New(),
+		ExtID:      uuid.NewString(),
+		Platform:   utils.PlatformTwitch,
+		Type:       utils.Archive,
+		Title:      "Test video",
+		Resolution: string(utils.Best),
+		StreamedAt: streamedAt,
+	}, channelID)
+	require.NoError(t, err)
+	require.NoError(t, app.PlaylistService.AddVodToPlaylist(t.Context(), playlistID, v.ID))
+	return v
+}
+
+// entryVodIDs returns the vod IDs of the entries in order.
+func entryVodIDs(entries []*ent.PlaylistVod) []uuid.UUID {
+	ids := make([]uuid.UUID, len(entries))
+	for i, e := range entries {
+		ids[i] = e.VodID
+	}
+	return ids
+}
+
+func TestSetPlaylistOrder(t *testing.T) {
+	app, p, _, vods := setupOrderPlaylist(t)
+	first, second, third := vods[0], vods[1], vods[2]
+
+	t.Run("unlisted vods are kept at the end", func(t *testing.T) {
+		entries, err := app.PlaylistService.SetPlaylistOrder(t.Context(), p.ID, true, []playlist.Entry{{VodID: first.ID}})
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{first.ID, third.ID, second.ID}, entryVodIDs(entries))
+	})
+
+	t.Run("unknown section is rejected", func(t *testing.T) {
+		unknown := uuid.New()
+		_, err := app.PlaylistService.SetPlaylistOrder(t.Context(), p.ID, true, []playlist.Entry{{VodID: first.ID, SectionID: &unknown}})
+		assert.ErrorIs(t, err, playlist.ErrSectionNotFound)
+	})
+
+	t.Run("vods in the trash are left out", func(t *testing.T) {
+		require.NoError(t, trash.TrashVod(t.Context(), app.Database, third.ID, false))
+		entries, err := app.PlaylistService.SetPlaylistOrder(t.Context(), p.ID, true, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{first.ID, second.ID}, entryVodIDs(entries))
+	})
+}
+
+func TestPlayNext(t *testing.T) {
+	app, p, _, vods := setupOrderPlaylist(t)
+	first, second, third := vods[0], vods[1], vods[2]
+
+	t.Run("start of the playlist", func(t *testing.T) {
+		entries, err := app.PlaylistService.PlayNext(t.Context(), p.ID, first.ID, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{first.ID, third.ID, second.ID}, entryVodIDs(entries))
+	})
+
+	t.Run("after a vod inherits its section", func(t *testing.T) {
+		section, err := app.PlaylistService.CreateSection(t.Context(), p.ID, "Part 1")
+		require.NoError(t, err)
+		_, err = app.PlaylistService.SetPlaylistOrder(t.Context(), p.ID, true, []playlist.Entry{{VodID: third.ID, SectionID: &section.ID}})
+		require.NoError(t, err)
+
+		entries, err := app.PlaylistService.PlayNext(t.Context(), p.ID, second.ID, &third.ID)
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{third.ID, second.ID, first.ID}, entryVodIDs(entries))
+		require.NotNil(t, entries[1].SectionID)
+		assert.Equal(t, section.ID, *entries[1].SectionID)
+		assert.Nil(t, entries[2].SectionID)
+	})
+
+	t.Run("after a vod not in the playlist", func(t *testing.T) {
+		unknown := uuid.New()
+		_, err := app.PlaylistService.PlayNext(t.Context(), p.ID, first.ID, &unknown)
+		assert.ErrorIs(t, err, playlist.ErrVodNotInPlaylist)
+	})
+}
+
+func TestGetNextVod(t *testing.T) {
+	app, p, _, vods := setupOrderPlaylist(t)
+	first, second, third := vods[0], vods[1], vods[2]
+
+	admin, err := app.Database.Client.User.Query().Where(entUser.Username("admin")).Only(t.Context())
+	require.NoError(t, err)
+
+	restrictedChannel, err := app.ChannelService.CreateChannel(channel.Channel{ExtID: "987654321", Name: "RestrictedChannel"})
+	require.NoError(t, err)
+	_, err = app.ChannelService.SetChannelAccess(t.Context(), restrictedChannel.ID, acl.Access{Visibility: utils.VisibilityRestricted, Groups: []string{}})
+	require.NoError(t, err)
+	restricted := createPlaylistVod(t, app, p.ID, restrictedChannel.ID, time.Now())
+
+	_, err = app.PlaylistService.SetPlaylistOrder(t.Context(), p.ID, true, []playlist.Entry{{VodID: third.ID}, {VodID: restricted.ID}, {VodID: second.ID}, {VodID: first.ID}})
+	require.NoError(t, err)
+	_, err = app.Database.Client.Playback.Create().SetUserID(admin.ID).SetVodID(third.ID).SetStatus(utils.Finished).Save(t.Context())
+	require.NoError(t, err)
+
+	// an anonymous viewer can't view the vods of the restricted channel
+	ctx := acl.WithViewer(t.Context(), nil)
+
+	t.Run("skips finished and non-viewable vods", func(t *testing.T) {
+		next, err := app.PlaylistService.GetNextVod(ctx, p.ID, admin.ID, nil)
+		require.NoError(t, err)
+		assert.Equal(t, second.ID, next.ID)
+	})
+
+	t.Run("after a vod", func(t *testing.T) {
+		next, err := app.PlaylistService.GetNextVod(ctx, p.ID, admin.ID, &second.ID)
+		require.NoError(t, err)
+		assert.Equal(t, first.ID, next.ID)
+
+		_, err = app.PlaylistService.GetNextVod(ctx, p.ID, admin.ID, &first.ID)
+		assert.ErrorIs(t, err, playlist.ErrNoNextVod)
+	})
+}
+
+func TestDeleteSection(t *testing.T) {
+	app, p, _, vods := setupOrderPlaylist(t)
+
+	section, err := app.PlaylistService.CreateSection(t.Context(), p.ID, "Part 1")
+	require.NoError(t, err)
+	_, err = app.PlaylistService.SetPlaylistOrder(t.Context(), p.ID, true, []playlist.Entry{{VodID: vods[0].ID, SectionID: &section.ID}, {VodID: vods[1].ID, SectionID: &section.ID}})
+	require.NoError(t, err)
+
+	require.NoError(t, app.PlaylistService.DeleteSection(t.Context(), p.ID, section.ID))
+
+	entries, err := app.PlaylistService.SetPlaylistOrder(t.Context(), p.ID, true, nil)
+	require.NoError(t, err)
+	assert.Len(t, entries, 3)
+	for _, e := range entries {
+		assert.Nil(t, e.SectionID)
+	}
+
+	err = app.PlaylistService.DeleteSection(t.Context(), p.ID, section.ID)
+	assert.ErrorIs(t, err, playlist.ErrSectionNotFound)
+}
@@ -9,8 +9,12 @@ import (
 	"github.com/zibbp/ganymede/ent"
 	"github.com/zibbp/ganymede/ent/multistreaminfo"
 	"github.com/zibbp/ganymede/ent/playlist"
+	"github.com/zibbp/ganymede/ent/playlistsection"
+	"github.com/zibbp/ganymede/ent/playlistvod"
 	"github.com/zibbp/ganymede/ent/vod"
+	"github.com/zibbp/ganymede/internal/acl"
 	"github.com/zibbp/ganymede/internal/database"
+	"github.com/zibbp/ganymede/internal/notification"
 )
 
 type Service struct {
@@ -48,7 +52,13 @@ func (s *Service) AddVodToPlaylist(ctx context.Context, playlistID uuid.UUID, vo
 		return fmt.Errorf("playlist not found")
 	}
 
-	_, err = s.Store.Client.Playlist.UpdateOneID(playlistID).AddVodIDs(vodID).Save(ctx)
+	// new vods are added at the end of manually ordered playlists
+	position, err := nextPosition(ctx, s.Store.Client, playlistID)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.Store.Client.PlaylistVod.Create().SetPlaylistID(playlistID).SetVodID(vodID).SetPosition(position).Save(ctx)
 	if err != nil {
 		if _, ok := err.(*ent.ConstraintError); ok {
 			return fmt.Errorf("vod already exists in playlist")
@@ -56,11 +66,13 @@ func (s *Service) AddVodToPlaylist(ctx context.Context, playlistID uuid.UUID, vo
 		return fmt.Errorf("error adding vod to playlist: %v", err)
 	}
 
+	go notification.SendPlaylistSubscriptionNotifications(playlistID, vodID)
+
 	return nil
 }
 
 func (s *Service) GetPlaylists(ctx context.Context) ([]*ent.Playlist, error) {
-	playlists, err := s.Store.Client.Playlist.Query().Order(ent.Desc(playlist.FieldCreatedAt)).All(ctx)
+	playlists, err := s.Store.Client.Playlist.Query().Where(acl.Playlists(ctx)).Order(ent.Desc(playlist.FieldCreatedAt)).All(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("error getting playlists: %v", err)
 	}
@@ -70,18 +82,31 @@ func (s *Service) GetPlaylists(ctx context.Context) ([]*ent.Playlist, error) {
 
 func (s *Service) GetPlaylist(ctx context.Context, playlistID uuid.UUID, withMultistreamInfo bool) (*ent.Playlist, error) {
 	playlistQuery := s.Store.Client.Playlist.Query().Where(playlist.ID(playlistID)).WithVods(func(q *ent.VodQuery) {
-		q.WithChannel()
+		q.Where(acl.Vods(ctx)).WithChannel()
+	}).WithEntries(func(q *ent.PlaylistVodQuery) {
+		q.Where(playlistvod.HasVodWith(acl.Vods(ctx)))
+	}).WithSections(func(q *ent.PlaylistSectionQuery) {
+		q.Order(ent.Asc(playlistsection.FieldPosition))
 	})
 	if withMultistreamInfo {
-		playlistQuery.WithMultistreamInfo(func(miq *ent.MultistreamInfoQuery) { miq.WithVod() })
+		playlistQuery.WithMultistreamInfo(func(miq *ent.MultistreamInfoQuery) {
+			miq.Where(multistreaminfo.HasVodWith(acl.Vods(ctx))).WithVod()
+		})
 	}
 	rPlaylist, err := playlistQuery.Order(ent.Desc(playlist.FieldCreatedAt)).Only(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("error getting playlist: %v", err)
 	}
-	// Order VODs by date streamed
+	// Order VODs by date streamed, or by their position if the playlist is ordered manually
+	positions := make(map[uuid.UUID]int, len(rPlaylist.Edges.Entries))
+	for _, e := range rPlaylist.Edges.Entries {
+		positions[e.VodID] = e.Position
+	}
 	tmpVods := rPlaylist.Edges.Vods
-	sort.Slice(tmpVods, func(i, j int) bool {
+	sort.SliceStable(tmpVods, func(i, j int) bool {
+		if rPlaylist.ManualOrder && positions[tmpVods[i].ID] != positions[tmpVods[j].ID] {
+			return positions[tmpVods[i].ID] < positions[tmpVods[j].ID]
+		}
 		return tmpVods[i].StreamedAt.After(tmpVods[j].StreamedAt)
 	})
 	rPlaylist.Edges.Vods = tmpVods
@@ -103,6 +128,37 @@ func (s *Service) UpdatePlaylist(ctx context.Context, playlistID uuid.UUID, play
 	return uPlaylist, nil
 }
 
+// SetPlaylistAccess sets who can view the playlist. Vods in the playlist keep the visibility of their channel.
+func (s *Service) SetPlaylistAccess(ctx context.Context, playlistID uuid.UUID, access acl.Access) (*ent.Playlist, error) {
+	_, err := s.Store.Client.Playlist.UpdateOneID(playlistID).
+		SetVisibility(access.Visibility).
+		SetAllowedGroups(access.Groups).
+		ClearAllowedUsers().
+		AddAllowedUserIDs(access.UserIDs...).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrPlaylistNotFound
+		}
+		return nil, fmt.Errorf("error updating playlist access: %v", err)
+	}
+
+	return s.GetPlaylistAccess(ctx, playlistID)
+}
+
+// GetPlaylistAccess returns the playlist with the users allowed to view it.
+func (s *Service) GetPlaylistAccess(ctx context.Context, playlistID uuid.UUID) (*ent.Playlist, error) {
+	rPlaylist, err := s.Store.Client.Playlist.Query().Where(playlist.ID(playlistID)).WithAllowedUsers().Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrPlaylistNotFound
+		}
+		return nil, fmt.Errorf("error getting playlist access: %v", err)
+	}
+
+	return rPlaylist, nil
+}
+
 func (s *Service) DeletePlaylist(ctx context.Context, playlistID uuid.UUID) error {
 	_, err := s.Store.Client.Playlist.Query().Where(playlist.ID(playlistID)).Only(ctx)
 	if err != nil {
@@ -4,14 +4,19 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"strconv"
 
 	"github.com/google/uuid"
 	"github.com/zibbp/ganymede/ent"
 	"github.com/zibbp/ganymede/ent/playlist"
+	"github.com/zibbp/ganymede/ent/playlistrule"
 	playlistrulegroup "github.com/zibbp/ganymede/ent/playlistrulegroup"
 	"github.com/zibbp/ganymede/internal/utils"
 )
 
+// maxRuleGroupDepth is how deep rule groups can be nested.
+const maxRuleGroupDepth = 5
+
 type RuleInput struct {
 	Name     string
 	Field    utils.PlaylistRuleField
@@ -19,26 +24,22 @@ type RuleInput struct {
 	Value    string
 	Position int
 	Enabled  bool
+	Negate   bool
 }
 
 type RuleGroupInput struct {
 	Operator string
 	Position int
+	Negate   bool
 	Rules    []RuleInput
+	Groups   []RuleGroupInput // nested groups, evaluated like rules of this group
 }
 
 // SetPlaylistRules replaces all rule groups and rules for a given playlist.
 func (s *Service) SetPlaylistRules(ctx context.Context, playlistID uuid.UUID, ruleGroups []RuleGroupInput) ([]*ent.PlaylistRuleGroup, error) {
 	// Validate input
-	for _, g := range ruleGroups {
-		if g.Operator != "AND" && g.Operator != "OR" {
-			return nil, fmt.Errorf("invalid group operator: %s", g.Operator)
-		}
-		for _, r := range g.Rules {
-			if err := validateRule(r); err != nil {
-				return nil, fmt.Errorf("invalid rule: %w", err)
-			}
-		}
+	if err := validateRuleGroups(ruleGroups, 1); err != nil {
+		return nil, err
 	}
 
 	// Start transaction
@@ -72,17 +73,32 @@ func (s *Service) SetPlaylistRules(ctx context.Context, playlistID uuid.UUID, ru
 		}
 		return nil, fmt.Errorf("failed to get playlist: %w", err)
 	}
-	var createdGroups []*ent.PlaylistRuleGroup
 
+	if err := createRuleGroups(ctx, tx, playlist, nil, ruleGroups); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return s.GetPlaylistRules(ctx, playlistID)
+}
+
+// createRuleGroups creates the groups and their rules, nested groups are created with the group as parent.
+func createRuleGroups(ctx context.Context, tx *ent.Tx, playlist *ent.Playlist, parentID *uuid.UUID, ruleGroups []RuleGroupInput) error {
 	for _, g := range ruleGroups {
 		group, err := tx.PlaylistRuleGroup.Create().
 			SetOperator(playlistrulegroup.Operator(g.Operator)).
 			SetPosition(g.Position).
+			SetNegate(g.Negate).
+			SetNillableParentID(parentID).
 			SetPlaylist(playlist).
 			Save(ctx)
 		if err != nil {
-			_ = tx.Rollback()
-			return nil, err
+			return err
 		}
 
 		for _, r := range g.Rules {
@@ -93,34 +109,24 @@ func (s *Service) SetPlaylistRules(ctx context.Context, playlistID uuid.UUID, ru
 				SetValue(r.Value).
 				SetPosition(r.Position).
 				SetEnabled(r.Enabled).
+				SetNegate(r.Negate).
 				SetGroup(group).
 				Save(ctx)
 			if err != nil {
-				_ = tx.Rollback()
-				return nil, err
+				return err
 			}
 		}
 
-		createdGroups = append(createdGroups, group)
-	}
-
-	// Commit transaction
-	if err := tx.Commit(); err != nil {
-		return nil, err
+		if err := createRuleGroups(ctx, tx, playlist, &group.ID, g.Groups); err != nil {
+			return err
+		}
 	}
-
-	return createdGroups, nil
+	return nil
 }
 
-// GetPlaylistRules retrieves all rule groups and their rules for a given playlist.
+// GetPlaylistRules retrieves the top level rule groups of a given playlist with their rules and nested groups.
 func (s *Service) GetPlaylistRules(ctx context.Context, playlistID uuid.UUID) ([]*ent.PlaylistRuleGroup, error) {
-	// Fetch the playlist with its rule groups and rules
-	playlist, err := s.Store.Client.Playlist.Query().
-		Where(playlist.ID(playlistID)).
-		WithRuleGroups(func(q *ent.PlaylistRuleGroupQuery) {
-			q.WithRules()
-		}).
-		Only(ctx)
+	_, err := s.Store.Client.Playlist.Query().Where(playlist.ID(playlistID)).OnlyID(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			return nil, fmt.Errorf("playlist not found: %w", err)
@@ -128,7 +134,46 @@ func (s *Service) GetPlaylistRules(ctx context.Context, playlistID uuid.UUID) ([
 		return nil, fmt.Errorf("failed to get playlist rules: %w", err)
 	}
 
-	return playlist.Edges.RuleGroups, nil
+	return s.getRuleGroups(ctx, playlistID)
+}
+
+// getRuleGroups loads all rule groups of the playlist and nests them under their parents.
+func (s *Service) getRuleGroups(ctx context.Context, playlistID uuid.UUID) ([]*ent.PlaylistRuleGroup, error) {
+	groups, err := s.Store.Client.PlaylistRuleGroup.Query().
+		Where(playlistrulegroup.HasPlaylistWith(playlist.IDEQ(playlistID))).
+		WithRules(func(q *ent.PlaylistRuleQuery) {
+			q.Order(ent.Asc(playlistrule.FieldPosition))
+		}).
+		Order(ent.Asc(playlistrulegroup.FieldPosition)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get playlist rules: %w", err)
+	}
+
+	return buildRuleGroupTree(groups), nil
+}
+
+// buildRuleGroupTree sets the children of each group and returns the top level groups.
+// Groups keep their order so children are ordered by position too.
+func buildRuleGroupTree(groups []*ent.PlaylistRuleGroup) []*ent.PlaylistRuleGroup {
+	byID := make(map[uuid.UUID]*ent.PlaylistRuleGroup, len(groups))
+	for _, g := range groups {
+		g.Edges.Children = []*ent.PlaylistRuleGroup{}
+		byID[g.ID] = g
+	}
+
+	roots := []*ent.PlaylistRuleGroup{}
+	for _, g := range groups {
+		if g.ParentID == nil {
+			roots = append(roots, g)
+			continue
+		}
+		// a group whose parent is missing can't be reached, skip it
+		if parent, ok := byID[*g.ParentID]; ok {
+			parent.Edges.Children = append(parent.Edges.Children, g)
+		}
+	}
+	return roots
 }
 
 // TestPlaylistRules checks if a video should be included in a playlist based on its rules.
@@ -137,17 +182,51 @@ func (s *Service) TestPlaylistRules(ctx context.Context, playlistID uuid.UUID, v
 	return s.ShouldVideoBeInPlaylist(ctx, videoID, playlistID)
 }
 
-// isValidFieldOperator checks if the operator is valid for the given field.
-func isValidFieldOperator(field utils.PlaylistRuleField, op utils.PlaylistRuleOperator) bool {
-	// Define valid operators for each field
-	validFieldOperators := map[utils.PlaylistRuleField][]utils.PlaylistRuleOperator{
-		utils.FieldTitle:       {utils.OperatorEquals, utils.OperatorContains, utils.OperatorRegex},
-		utils.FieldType:        {utils.OperatorEquals, utils.OperatorContains, utils.OperatorRegex},
-		utils.FieldCategory:    {utils.OperatorEquals, utils.OperatorContains, utils.OperatorRegex},
-		utils.FieldPlatform:    {utils.OperatorEquals, utils.OperatorContains, utils.OperatorRegex},
-		utils.FieldChannelName: {utils.OperatorEquals, utils.OperatorContains, utils.OperatorRegex},
+// validateRuleGroups checks the groups, their rules and nested groups.
+func validateRuleGroups(ruleGroups []RuleGroupInput, depth int) error {
+	if depth > maxRuleGroupDepth {
+		return fmt.Errorf("rule groups can be nested at most %d levels deep", maxRuleGroupDepth)
 	}
+	for _, g := range ruleGroups {
+		if g.Operator != "AND" && g.Operator != "OR" {
+			return fmt.Errorf("invalid group operator: %s", g.Operator)
+		}
+		for _, r := range g.Rules {
+			if err := validateRule(r); err != nil {
+				return fmt.Errorf("invalid rule: %w", err)
+			}
+		}
+		if err := validateRuleGroups(g.Groups, depth+1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var (
+	stringOperators     = []utils.PlaylistRuleOperator{utils.OperatorEquals, utils.OperatorContains, utils.OperatorRegex}
+	comparisonOperators = []utils.PlaylistRuleOperator{utils.OperatorGreaterThan, utils.OperatorGreaterThanOrEqual, utils.OperatorLessThan, utils.OperatorLessThanOrEqual}
+	numberOperators     = append([]utils.PlaylistRuleOperator{utils.OperatorEquals}, comparisonOperators...)
+)
+
+// validFieldOperators are the operators valid for each field.
+var validFieldOperators = map[utils.PlaylistRuleField][]utils.PlaylistRuleOperator{
+	utils.FieldTitle:            stringOperators,
+	utils.FieldType:             stringOperators,
+	utils.FieldCategory:         stringOperators,
+	utils.FieldPlatform:         stringOperators,
+	utils.FieldChannelName:      stringOperators,
+	utils.FieldTag:              stringOperators,
+	utils.FieldDuration:         numberOperators,
+	utils.FieldViews:            numberOperators,
+	utils.FieldLocalViews:       numberOperators,
+	utils.FieldStorageSizeBytes: numberOperators,
+	utils.FieldAgeDays:          numberOperators,
+	utils.FieldStreamedAt:       comparisonOperators,
+}
 
+// isValidFieldOperator checks if the operator is valid for the given field.
+func isValidFieldOperator(field utils.PlaylistRuleField, op utils.PlaylistRuleOperator) bool {
 	for _, allowed := range validFieldOperators[field] {
 		if allowed == op {
 			return true
@@ -158,22 +237,13 @@ func isValidFieldOperator(field utils.PlaylistRuleField, op utils.PlaylistRuleOp
 
 // validateRule checks if the rule input is valid.
 func validateRule(r RuleInput) error {
-	validFields := map[utils.PlaylistRuleField]bool{
-		utils.FieldTitle:       true,
-		utils.FieldType:        true,
-		utils.FieldCategory:    true,
-		utils.FieldPlatform:    true,
-		utils.FieldChannelName: true,
-	}
-
-	if !validFields[r.Field] {
+	if _, ok := validFieldOperators[r.Field]; !ok {
 		return fmt.Errorf("invalid rule field: %s", r.Field)
 	}
 
-	validOperators := map[utils.PlaylistRuleOperator]bool{
-		utils.OperatorEquals:   true,
-		utils.OperatorContains: true,
-		utils.OperatorRegex:    true,
+	validOperators := map[utils.PlaylistRuleOperator]bool{}
+	for _, op := range utils.PlaylistRuleOperator("").Values() {
+		validOperators[utils.PlaylistRuleOperator(op)] = true
 	}
 
 	if !validOperators[r.Operator] {
@@ -194,5 +264,16 @@ func validateRule(r RuleInput) error {
 		}
 	}
 
+	switch r.Field {
+	case utils.FieldStreamedAt:
+		if _, err := parseDate(r.Value); err != nil {
+			return fmt.Errorf("invalid date %s, must be YYYY-MM-DD or RFC 3339", r.Value)
+		}
+	case utils.FieldDuration, utils.FieldViews, utils.FieldLocalViews, utils.FieldStorageSizeBytes, utils.FieldAgeDays:
+		if _, err := strconv.ParseFloat(r.Value, 64); err != nil {
+			return fmt.Errorf("invalid number %s", r.Value)
+		}
+	}
+
 	return nil
 }
@@ -2,8 +2,12 @@ package playlist
 
 import (
 	"context"
+	"fmt"
+	"math"
 	"regexp"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/rs/zerolog"
@@ -15,8 +19,21 @@ import (
 	"github.com/zibbp/ganymede/internal/utils"
 )
 
-// EvaluateRule tests a single rule against a video.
+// EvaluateRule tests a single rule against a video. The result is inverted if the rule is negated.
 func EvaluateRule(logger zerolog.Logger, video *ent.Vod, rule *ent.PlaylistRule) bool {
+	result := evaluateRuleField(logger, video, rule)
+	if rule.Negate {
+		logger.Debug().
+			Str("rule_id", rule.ID.String()).
+			Bool("result", !result).
+			Msg("Rule is negated")
+		return !result
+	}
+	return result
+}
+
+// evaluateRuleField tests the field of the rule against the video.
+func evaluateRuleField(logger zerolog.Logger, video *ent.Vod, rule *ent.PlaylistRule) bool {
 	val := rule.Value
 	logger.Debug().
 		Str("rule_id", rule.ID.String()).
@@ -88,6 +105,37 @@ func EvaluateRule(logger zerolog.Logger, video *ent.Vod, rule *ent.PlaylistRule)
 			Msg("Rule evaluation result (channel name)")
 		return result
 
+	case utils.FieldTag:
+		tags := make([]string, 0, len(video.Edges.Tags))
+		for _, t := range video.Edges.Tags {
+			if t != nil {
+				tags = append(tags, t.Name)
+			}
+		}
+		result := matchTag(tags, rule.Operator, val)
+		logger.Debug().
+			Strs("tags", tags).
+			Bool("result", result).
+			Msg("Rule evaluation result (tag)")
+		return result
+
+	case utils.FieldDuration, utils.FieldViews, utils.FieldLocalViews, utils.FieldStorageSizeBytes, utils.FieldAgeDays:
+		fieldValue := numberField(video, rule.Field)
+		result := matchNumber(fieldValue, rule.Operator, val)
+		logger.Debug().
+			Float64("field_value", fieldValue).
+			Bool("result", result).
+			Msgf("Rule evaluation result (%s)", rule.Field)
+		return result
+
+	case utils.FieldStreamedAt:
+		result := matchDate(video.StreamedAt, rule.Operator, val)
+		logger.Debug().
+			Time("field_value", video.StreamedAt).
+			Bool("result", result).
+			Msg("Rule evaluation result (streamed at)")
+		return result
+
 	default:
 		logger.Debug().Msg("Unknown rule field")
 		return false
@@ -122,36 +170,113 @@ func matchCategory(categories []string, op utils.PlaylistRuleOperator, val strin
 	return false
 }
 
-// EvaluateRuleGroup evaluates all rules in a group using group's operator (AND/OR).
+// numberField returns the value of a number field of the video.
+func numberField(video *ent.Vod, field utils.PlaylistRuleField) float64 {
+	switch field {
+	case utils.FieldDuration:
+		return float64(video.Duration)
+	case utils.FieldViews:
+		return float64(video.Views)
+	case utils.FieldLocalViews:
+		return float64(video.LocalViews)
+	case utils.FieldStorageSizeBytes:
+		return float64(video.StorageSizeBytes)
+	case utils.FieldAgeDays:
+		// full days so equals matches on the whole day
+		return math.Floor(time.Since(video.StreamedAt).Hours() / 24)
+	}
+	return 0
+}
+
+// matchNumber compares the field to the value based on the operator.
+func matchNumber(field float64, op utils.PlaylistRuleOperator, val string) bool {
+	v, err := strconv.ParseFloat(val, 64)
+	if err != nil {
+		return false
+	}
+	switch op {
+	case utils.OperatorEquals:
+		return field == v
+	case utils.OperatorGreaterThan:
+		return field > v
+	case utils.OperatorGreaterThanOrEqual:
+		return field >= v
+	case utils.OperatorLessThan:
+		return field < v
+	case utils.OperatorLessThanOrEqual:
+		return field <= v
+	default:
+		return false
+	}
+}
+
+// matchDate compares the field to the date value based on the operator.
+func matchDate(field time.Time, op utils.PlaylistRuleOperator, val string) bool {
+	v, err := parseDate(val)
+	if err != nil {
+		return false
+	}
+	switch op {
+	case utils.OperatorGreaterThan:
+		return field.After(v)
+	case utils.OperatorGreaterThanOrEqual:
+		return !field.Before(v)
+	case utils.OperatorLessThan:
+		return field.Before(v)
+	case utils.OperatorLessThanOrEqual:
+		return !field.After(v)
+	default:
+		return false
+	}
+}
+
+// parseDate parses a date value of a rule, either a day (YYYY-MM-DD) in UTC or an RFC 3339 timestamp.
+func parseDate(val string) (time.Time, error) {
+	if t, err := time.Parse(time.DateOnly, val); err == nil {
+		return t, nil
+	}
+	return time.Parse(time.RFC3339, val)
+}
+
+// matchTag checks if any of the tags match the value based on the operator. Tags are case insensitive so equals is too.
+func matchTag(tags []string, op utils.PlaylistRuleOperator, val string) bool {
+	for _, t := range tags {
+		if op == utils.OperatorEquals && strings.EqualFold(t, val) {
+			return true
+		}
+		if matchString(t, op, val) {
+			return true
+		}
+	}
+	return false
+}
+
+// EvaluateRuleGroup evaluates all rules and nested groups in a group using group's operator (AND/OR).
+// The result is inverted if the group is negated.
 func EvaluateRuleGroup(logger zerolog.Logger, video *ent.Vod, group *ent.PlaylistRuleGroup) bool {
+	result := evaluateRuleGroup(logger, video, group)
+	if group.Negate {
+		logger.Debug().
+			Str("group_id", group.ID.String()).
+			Bool("result", !result).
+			Msg("Rule group is negated")
+		return !result
+	}
+	return result
+}
+
+func evaluateRuleGroup(logger zerolog.Logger, video *ent.Vod, group *ent.PlaylistRuleGroup) bool {
 	rules := group.Edges.Rules
+	children := group.Edges.Children
 	logger.Debug().
 		Str("group_id", group.ID.String()).
 		Str("operator", string(group.Operator)).
 		Int("rule_count", len(rules)).
+		Int("group_count", len(children)).
 		Msg("Evaluating rule group")
-	if group.Operator == playlistrulegroup.OperatorAND {
-		for _, r := range rules {
-			if !r.Enabled {
-				logger.Debug().
-					Str("rule_id", r.ID.String()).
-					Msg("Skipping disabled rule")
-				continue
-			}
-			if !EvaluateRule(logger, video, r) {
-				logger.Debug().
-					Str("group_id", group.ID.String()).
-					Str("rule_id", r.ID.String()).
-					Msg("Rule group AND failed")
-				return false
-			}
-		}
-		logger.Debug().
-			Str("group_id", group.ID.String()).
-			Msg("Rule group AND passed")
-		return true
-	}
-	// OR logic
+
+	// AND fails on the first rule that doesn't match, OR passes on the first rule that does
+	isAnd := group.Operator == playlistrulegroup.OperatorAND
 	for _, r := range rules {
 		if !r.Enabled {
 			logger.Debug().
@@ -159,18 +284,28 @@ func EvaluateRuleGroup(logger zerolog.Logger, video *ent.Vod, group *ent.Playlis
 				Msg("Skipping disabled rule")
 			continue
 		}
-		if EvaluateRule(logger, video, r) {
+		if EvaluateRule(logger, video, r) != isAnd {
 			logger.Debug().
 				Str("group_id", group.ID.String()).
 				Str("rule_id", r.ID.String()).
-				Msg("Rule group OR passed")
-			return true
+				Msgf("Rule group %s decided by rule", group.Operator)
+			return !isAnd
+		}
+	}
+	for _, child := range children {
+		if EvaluateRuleGroup(logger, video, child) != isAnd {
+			logger.Debug().
+				Str("group_id", group.ID.String()).
+				Str("child_group_id", child.ID.String()).
+				Msgf("Rule group %s decided by nested group", group.Operator)
+			return !isAnd
 		}
 	}
 	logger.Debug().
 		Str("group_id", group.ID.String()).
-		Msg("Rule group OR failed")
-	return false
+		Bool("result", isAnd).
+		Msgf("Rule group %s evaluated", group.Operator)
+	return isAnd
 }
 
 // EvaluatePlaylist returns true if any rule group matches the video.
@@ -201,6 +336,7 @@ func (s *Service) ShouldVideoBeInPlaylist(ctx context.Context, videoId uuid.UUID
 		Where(entVod.ID(videoId)).
 		WithChapters().
 		WithChannel().
+		WithTags().
 		Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
@@ -211,11 +347,7 @@ func (s *Service) ShouldVideoBeInPlaylist(ctx context.Context, videoId uuid.UUID
 
 	logger.Debug().Msg("Evaluating video against playlist rules")
 
-	groups, err := s.Store.Client.PlaylistRuleGroup.
-		Query().
-		Where(playlistrulegroup.HasPlaylistWith(playlist.IDEQ(playlistID))).
-		WithRules().
-		All(ctx)
+	groups, err := s.getRuleGroups(ctx, playlistID)
 	if err != nil {
 		return false, err
 	}
@@ -228,3 +360,50 @@ func (s *Service) ShouldVideoBeInPlaylist(ctx context.Context, videoId uuid.UUID
 
 	return EvaluatePlaylist(logger, video, groups), nil
 }
+
+// ApplyPlaylistRules evaluates the rules of the playlist against the video and adds or removes the video.
+// Returns true if the video is in the playlist afterwards.
+func (s *Service) ApplyPlaylistRules(ctx context.Context, playlistID uuid.UUID, videoID uuid.UUID) (bool, error) {
+	shouldBeIn, err := s.ShouldVideoBeInPlaylist(ctx, videoID, playlistID)
+	if err != nil {
+		return false, fmt.Errorf("failed to evaluate video against playlist rules: %w", err)
+	}
+
+	inPlaylist, err := s.Store.Client.Playlist.Query().
+		Where(playlist.IDEQ(playlistID), playlist.HasVodsWith(entVod.IDEQ(videoID))).
+		Exist(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to check if video is in playlist: %w", err)
+	}
+
+	switch {
+	case shouldBeIn && !inPlaylist:
+		if err := s.AddVodToPlaylist(ctx, playlistID, videoID); err != nil {
+			return false, fmt.Errorf("failed to add video to playlist: %w", err)
+		}
+		log.Info().Msgf("video %s added to playlist %s", videoID, playlistID)
+	case !shouldBeIn && inPlaylist:
+		if err := s.DeleteVodFromPlaylist(ctx, playlistID, videoID); err != nil {
+			return false, fmt.Errorf("failed to remove video from playlist: %w", err)
+		}
+		log.Info().Msgf("video %s removed from playlist %s", videoID, playlistID)
+	}
+	return shouldBeIn, nil
+}
+
+// ApplyVideoPlaylistRules applies the rules of all playlists with rules to the video.
+func (s *Service) ApplyVideoPlaylistRules(ctx context.Context, videoID uuid.UUID) error {
+	playlistIDs, err := s.Store.Client.Playlist.Query().
+		Where(playlist.HasRuleGroups()).
+		IDs(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get playlists: %w", err)
+	}
+
+	for _, playlistID := range playlistIDs {
+		if _, err := s.ApplyPlaylistRules(ctx, playlistID, videoID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+package playlist
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/ent/playlistrulegroup"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+func rule(field utils.PlaylistRuleField, op utils.PlaylistRuleOperator, value string, negate bool) *ent.PlaylistRule {
+	return &ent.PlaylistRule{ID: uuid.New(), Field: field, Operator: op, Value: value, Enabled: true, Negate: negate}
+}
+
+func group(op playlistrulegroup.Operator, negate bool, rules []*ent.PlaylistRule, children ...*ent.PlaylistRuleGroup) *ent.PlaylistRuleGroup {
+	return &ent.PlaylistRuleGroup{
+		ID:       uuid.New(),
+		Operator: op,
+		Negate:   negate,
+		Edges:    ent.PlaylistRuleGroupEdges{Rules: rules, Children: children},
+	}
+}
+
+func TestEvaluateRule(t *testing.T) {
+	video := &ent.Vod{
+		Title:            "Long stream",
+		Duration:         4 * 60 * 60,
+		Views:            1500,
+		StorageSizeBytes: 10 << 30,
+		StreamedAt:       time.Now().AddDate(0, 0, -10),
+	}
+
+	tests := []struct {
+		name     string
+		rule     *ent.PlaylistRule
+		expected bool
+	}{
+		{name: "duration greater than", rule: rule(utils.FieldDuration, utils.OperatorGreaterThan, "10800", false), expected: true},
+		{name: "duration less than", rule: rule(utils.FieldDuration, utils.OperatorLessThan, "3600", false), expected: false},
+		{name: "views equals", rule: rule(utils.FieldViews, utils.OperatorEquals, "1500", false), expected: true},
+		{name: "local views less than or equal", rule: rule(utils.FieldLocalViews, utils.OperatorLessThanOrEqual, "0", false), expected: true},
+		{name: "storage size greater than or equal", rule: rule(utils.FieldStorageSizeBytes, utils.OperatorGreaterThanOrEqual, "10737418240", false), expected: true},
+		{name: "age in days", rule: rule(utils.FieldAgeDays, utils.OperatorLessThanOrEqual, "30", false), expected: true},
+		{name: "age in days equals", rule: rule(utils.FieldAgeDays, utils.OperatorEquals, "10", false), expected: true},
+		{name: "streamed after date", rule: rule(utils.FieldStreamedAt, utils.OperatorGreaterThan, time.Now().AddDate(0, -1, 0).Format(time.DateOnly), false), expected: true},
+		{name: "streamed before timestamp", rule: rule(utils.FieldStreamedAt, utils.OperatorLessThan, "2020-01-01T00:00:00Z", false), expected: false},
+		{name: "negated title", rule: rule(utils.FieldTitle, utils.OperatorContains, "long", true), expected: false},
+		{name: "invalid number", rule: rule(utils.FieldViews, utils.OperatorGreaterThan, "many", false), expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := EvaluateRule(zerolog.Nop(), video, tt.rule); got != tt.expected {
+				t.Errorf("EvaluateRule() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestEvaluateRuleGroup_Nested(t *testing.T) {
+	video := &ent.Vod{Title: "Speedrun", Type: utils.Archive, Duration: 7200}
+
+	// duration > 1h AND (type is highlight OR NOT title contains race)
+	g := group(playlistrulegroup.OperatorAND, false,
+		[]*ent.PlaylistRule{rule(utils.FieldDuration, utils.OperatorGreaterThan, "3600", false)},
+		group(playlistrulegroup.OperatorOR, false, []*ent.PlaylistRule{
+			rule(utils.FieldType, utils.OperatorEquals, string(utils.Highlight), false),
+			rule(utils.FieldTitle, utils.OperatorContains, "race", true),
+		}),
+	)
+	if !EvaluateRuleGroup(zerolog.Nop(), video, g) {
+		t.Error("expected nested group to match")
+	}
+
+	g.Negate = true
+	if EvaluateRuleGroup(zerolog.Nop(), video, g) {
+		t.Error("expected negated group not to match")
+	}
+
+	// a failing nested group fails an AND group
+	g = group(playlistrulegroup.OperatorAND, false, nil,
+		group(playlistrulegroup.OperatorAND, false, []*ent.PlaylistRule{rule(utils.FieldViews, utils.OperatorGreaterThan, "100", false)}),
+	)
+	if EvaluateRuleGroup(zerolog.Nop(), video, g) {
+		t.Error("expected group with failing nested group not to match")
+	}
+}
+
+func TestBuildRuleGroupTree(t *testing.T) {
+	root := &ent.PlaylistRuleGroup{ID: uuid.New()}
+	child := &ent.PlaylistRuleGroup{ID: uuid.New(), ParentID: &root.ID}
+	grandchild := &ent.PlaylistRuleGroup{ID: uuid.New(), ParentID: &child.ID}
+	orphanParent := uuid.New()
+	orphan := &ent.PlaylistRuleGroup{ID: uuid.New(), ParentID: &orphanParent}
+
+	roots := buildRuleGroupTree([]*ent.PlaylistRuleGroup{grandchild, root, orphan, child})
+	if len(roots) != 1 || roots[0] != root {
+		t.Fatalf("expected only the root group, got %d groups", len(roots))
+	}
+	if len(root.Edges.Children) != 1 || root.Edges.Children[0] != child {
+		t.Fatal("expected child to be nested under root")
+	}
+	if len(child.Edges.Children) != 1 || child.Edges.Children[0] != grandchild {
+		t.Fatal("expected grandchild to be nested under child")
+	}
+}
+
+func TestValidateRuleGroups(t *testing.T) {
+	valid := RuleGroupInput{Operator: "AND", Rules: []RuleInput{{Field: utils.FieldAgeDays, Operator: utils.OperatorLessThan, Value: "30"}}}
+	if err := validateRuleGroups([]RuleGroupInput{valid}, 1); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	invalid := []RuleGroupInput{
+		{Operator: "AND", Rules: []RuleInput{{Field: utils.FieldDuration, Operator: utils.OperatorContains, Value: "1"}}},
+		{Operator: "AND", Rules: []RuleInput{{Field: utils.FieldViews, Operator: utils.OperatorGreaterThan, Value: "a lot"}}},
+		{Operator: "AND", Rules: []RuleInput{{Field: utils.FieldStreamedAt, Operator: utils.OperatorEquals, Value: "2024-01-01"}}},
+		{Operator: "AND", Rules: []RuleInput{{Field: utils.FieldStreamedAt, Operator: utils.OperatorLessThan, Value: "yesterday"}}},
+		{Operator: "OR", Groups: []RuleGroupInput{{Operator: "XOR"}}},
+	}
+	for _, g := range invalid {
+		if err := validateRuleGroups([]RuleGroupInput{g}, 1); err == nil {
+			t.Errorf("expected error for %+v", g)
+		}
+	}
+
+	// nesting deeper than the limit is rejected
+	deep := RuleGroupInput{Operator: "AND"}
+	for range maxRuleGroupDepth {
+		deep = RuleGroupInput{Operator: "AND", Groups: []RuleGroupInput{deep}}
+	}
+	if err := validateRuleGroups([]RuleGroupInput{deep}, 1); err == nil {
+		t.Error("expected error for deeply nested groups")
+	}
+}
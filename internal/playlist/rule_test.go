@@ -69,11 +69,54 @@ func TestShouldBeInPlaylist_TableDriven(t *testing.T) {
 	assert.NoError(t, err, "Failed to query video with chapters")
 	assert.NotNil(t, video, "Video should not be nil")
 
+	_, err = app.TagService.SetVodTags(t.Context(), video.ID, []string{"Speedrun"})
+	assert.NoError(t, err, "Failed to set video tags")
+
 	tests := []struct {
 		name       string
 		ruleGroups []playlist.RuleGroupInput
 		expected   bool
 	}{
+		{
+			name: "Tag equals speedrun",
+			ruleGroups: []playlist.RuleGroupInput{
+				{
+					Operator: "OR",
+					Position: 0,
+					Rules: []playlist.RuleInput{
+						{
+							Name:     "Tag equals speedrun",
+							Field:    utils.FieldTag,
+							Operator: utils.OperatorEquals,
+							Value:    "speedrun",
+							Position: 0,
+							Enabled:  true,
+						},
+					},
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "Tag equals highlight",
+			ruleGroups: []playlist.RuleGroupInput{
+				{
+					Operator: "OR",
+					Position: 0,
+					Rules: []playlist.RuleInput{
+						{
+							Name:     "Tag equals highlight",
+							Field:    utils.FieldTag,
+							Operator: utils.OperatorEquals,
+							Value:    "highlight",
+							Position: 0,
+							Enabled:  true,
+						},
+					},
+				},
+			},
+			expected: false,
+		},
 		{
 			name: "Title contains BG3",
 			ruleGroups: []playlist.RuleGroupInput{
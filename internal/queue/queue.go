@@ -12,9 +12,12 @@ import (
 	"github.com/rs/zerolog/log"
 	"github.com/zibbp/ganymede/ent"
 	"github.com/zibbp/ganymede/ent/queue"
+	entVod "github.com/zibbp/ganymede/ent/vod"
 	"github.com/zibbp/ganymede/internal/channel"
 	"github.com/zibbp/ganymede/internal/config"
 	"github.com/zibbp/ganymede/internal/database"
+	"github.com/zibbp/ganymede/internal/events"
+	"github.com/zibbp/ganymede/internal/notification"
 	"github.com/zibbp/ganymede/internal/tasks"
 	tasks_client "github.com/zibbp/ganymede/internal/tasks/client"
 	"github.com/zibbp/ganymede/internal/utils"
@@ -50,6 +53,7 @@ type Queue struct {
 	TaskVodSaveInfo          utils.TaskStatus `json:"task_vod_save_info"`
 	TaskVideoDownload        utils.TaskStatus `json:"task_video_download"`
 	TaskVideoConvert         utils.TaskStatus `json:"task_video_convert"`
+	TaskVideoValidate        utils.TaskStatus `json:"task_video_validate"`
 	TaskVideoMove            utils.TaskStatus `json:"task_video_move"`
 	TaskChatDownload         utils.TaskStatus `json:"task_chat_download"`
 	TaskChatConvert          utils.TaskStatus `json:"task_chat_convert"`
@@ -71,6 +75,8 @@ func (s *Service) CreateQueueItem(queueDto Queue, vID uuid.UUID) (*ent.Queue, er
 			log.Debug().Err(err).Msg("error creating queue")
 			return nil, fmt.Errorf("error creating queue: %v", err)
 		}
+		publishQueueItemCreated(q, vID)
+		go s.sendArchiveQueuedNotification(q, vID)
 		return q, nil
 	} else {
 		q, err := s.Store.Client.Queue.Create().SetVodID(vID).SetArchiveChat(queueDto.ArchiveChat).SetRenderChat(queueDto.RenderChat).Save(context.Background())
@@ -81,13 +87,39 @@ func (s *Service) CreateQueueItem(queueDto Queue, vID uuid.UUID) (*ent.Queue, er
 			log.Debug().Err(err).Msg("error creating queue")
 			return nil, fmt.Errorf("error creating queue: %v", err)
 		}
+		publishQueueItemCreated(q, vID)
+		go s.sendArchiveQueuedNotification(q, vID)
 		return q, nil
 	}
 
 }
 
+// publishQueueItemCreated publishes an event for a newly created queue item.
+func publishQueueItemCreated(q *ent.Queue, vID uuid.UUID) {
+	events.Publish(context.Background(), events.QueueItemCreated, events.QueueItemCreatedData{
+		QueueID:     q.ID,
+		VideoID:     vID,
+		LiveArchive: q.LiveArchive,
+	})
+}
+
+// sendArchiveQueuedNotification sends the archive queued notification for a newly created queue item.
+func (s *Service) sendArchiveQueuedNotification(q *ent.Queue, vID uuid.UUID) {
+	v, err := s.Store.Client.Vod.Query().Where(entVod.ID(vID)).WithChannel().Only(context.Background())
+	if err != nil {
+		log.Error().Err(err).Str("video_id", vID.String()).Msg("error getting video for archive queued notification")
+		return
+	}
+	notification.SendArchiveQueuedNotification(v.Edges.Channel, v, q)
+}
+
 func (s *Service) UpdateQueueItem(queueDto Queue, qID uuid.UUID) (*ent.Queue, error) {
-	q, err := s.Store.Client.Queue.UpdateOneID(qID).SetLiveArchive(queueDto.LiveArchive).SetOnHold(queueDto.OnHold).SetVideoProcessing(queueDto.VideoProcessing).SetChatProcessing(queueDto.ChatProcessing).SetProcessing(queueDto.Processing).SetTaskVodCreateFolder(queueDto.TaskVodCreateFolder).SetTaskVodDownloadThumbnail(queueDto.TaskVodDownloadThumbnail).SetTaskVodSaveInfo(queueDto.TaskVodSaveInfo).SetTaskVideoDownload(queueDto.TaskVideoDownload).SetTaskVideoConvert(queueDto.TaskVideoConvert).SetTaskVideoMove(queueDto.TaskVideoMove).SetTaskChatDownload(queueDto.TaskChatDownload).SetTaskChatConvert(queueDto.TaskChatConvert).SetArchiveChat(queueDto.ArchiveChat).SetRenderChat(queueDto.RenderChat).SetTaskChatRender(queueDto.TaskChatRender).SetTaskChatMove(queueDto.TaskChatMove).Save(context.Background())
+	update := s.Store.Client.Queue.UpdateOneID(qID).SetLiveArchive(queueDto.LiveArchive).SetOnHold(queueDto.OnHold).SetVideoProcessing(queueDto.VideoProcessing).SetChatProcessing(queueDto.ChatProcessing).SetProcessing(queueDto.Processing).SetTaskVodCreateFolder(queueDto.TaskVodCreateFolder).SetTaskVodDownloadThumbnail(queueDto.TaskVodDownloadThumbnail).SetTaskVodSaveInfo(queueDto.TaskVodSaveInfo).SetTaskVideoDownload(queueDto.TaskVideoDownload).SetTaskVideoConvert(queueDto.TaskVideoConvert).SetTaskVideoMove(queueDto.TaskVideoMove).SetTaskChatDownload(queueDto.TaskChatDownload).SetTaskChatConvert(queueDto.TaskChatConvert).SetArchiveChat(queueDto.ArchiveChat).SetRenderChat(queueDto.RenderChat).SetTaskChatRender(queueDto.TaskChatRender).SetTaskChatMove(queueDto.TaskChatMove)
+	// the validate task is left unchanged when it isn't sent
+	if queueDto.TaskVideoValidate != "" {
+		update.SetTaskVideoValidate(queueDto.TaskVideoValidate)
+	}
+	q, err := update.Save(context.Background())
 	if err != nil {
 		return nil, fmt.Errorf("error updating queue: %v", err)
 	}
@@ -207,6 +239,12 @@ func (s *Service) StartQueueTask(ctx context.Context, input StartQueueTaskInput)
 			Input:    taskInput,
 		}
 
+	case "task_video_validate":
+		task = tasks.ValidateVideoArgs{
+			Continue: input.Continue,
+			Input:    taskInput,
+		}
+
 	case "task_video_move":
 		task = tasks.MoveVideoArgs{
 			Continue: input.Continue,
@@ -12,13 +12,16 @@ import (
 	"github.com/rs/zerolog/pkgerrors"
 	"github.com/zibbp/ganymede/internal/admin"
 	"github.com/zibbp/ganymede/internal/archive"
+	"github.com/zibbp/ganymede/internal/audit"
 	"github.com/zibbp/ganymede/internal/auth"
 	"github.com/zibbp/ganymede/internal/blocked"
+	"github.com/zibbp/ganymede/internal/bulk"
 	"github.com/zibbp/ganymede/internal/category"
 	"github.com/zibbp/ganymede/internal/channel"
 	"github.com/zibbp/ganymede/internal/chapter"
 	"github.com/zibbp/ganymede/internal/config"
 	"github.com/zibbp/ganymede/internal/database"
+	"github.com/zibbp/ganymede/internal/events"
 	_ "github.com/zibbp/ganymede/internal/kv"
 	"github.com/zibbp/ganymede/internal/live"
 	"github.com/zibbp/ganymede/internal/metrics"
@@ -26,9 +29,12 @@ import (
 	"github.com/zibbp/ganymede/internal/playback"
 	"github.com/zibbp/ganymede/internal/playlist"
 	"github.com/zibbp/ganymede/internal/queue"
+	"github.com/zibbp/ganymede/internal/share"
+	"github.com/zibbp/ganymede/internal/tag"
 	"github.com/zibbp/ganymede/internal/task"
 	tasks_client "github.com/zibbp/ganymede/internal/tasks/client"
 	transportHttp "github.com/zibbp/ganymede/internal/transport/http"
+	"github.com/zibbp/ganymede/internal/trash"
 	"github.com/zibbp/ganymede/internal/user"
 	"github.com/zibbp/ganymede/internal/vod"
 	"github.com/zibbp/ganymede/internal/youtube"
@@ -56,6 +62,12 @@ type Application struct {
 	CategoryService   *category.Service
 	BlockedVodService *blocked.Service
 	YoutubeService    *youtube.Service
+	ShareService      *share.Service
+	AuditService      *audit.Service
+	TrashService      *trash.Service
+	BulkService       *bulk.Service
+	TagService        *tag.Service
+	EventsBroker      *events.Broker
 	RiverUIServer     *riverui.Handler
 	RiverClient       *tasks_client.RiverClient
 }
@@ -158,6 +170,13 @@ func SetupApplication(ctx context.Context) (*Application, error) {
 	taskService := task.NewService(db, liveService, riverClient)
 	categoryService := category.NewService(db)
 	youtubeService := youtube.NewService(db)
+	shareService := share.NewService(db)
+	auditService := audit.NewService(db)
+	trashService := trash.NewService(db)
+	bulkService := bulk.NewService(db, riverClient)
+	tagService := tag.NewService(db, riverClient)
+	eventsBroker := events.NewBroker(db)
+	go eventsBroker.Start(ctx)
 
 	return &Application{
 		EnvConfig:         envConfig,
@@ -178,6 +197,12 @@ func SetupApplication(ctx context.Context) (*Application, error) {
 		ChapterService:    chapterService,
 		CategoryService:   categoryService,
 		YoutubeService:    youtubeService,
+		ShareService:      shareService,
+		AuditService:      auditService,
+		TrashService:      trashService,
+		BulkService:       bulkService,
+		TagService:        tagService,
+		EventsBroker:      eventsBroker,
 		PlatformTwitch:    platformTwitch,
 		RiverUIServer:     riverUIServer,
 		RiverClient:       riverClient,
@@ -191,7 +216,7 @@ func Run(ctx context.Context) error {
 		return err
 	}
 
-	httpHandler := transportHttp.NewHandler(app.Database, app.AuthService, app.ChannelService, app.VodService, app.QueueService, app.ArchiveService, app.AdminService, app.UserService, app.LiveService, app.PlaybackService, app.MetricsService, app.PlaylistService, app.TaskService, app.ChapterService, app.CategoryService, app.BlockedVodService, app.YoutubeService, app.PlatformTwitch, app.RiverUIServer)
+	httpHandler := transportHttp.NewHandler(app.Database, app.AuthService, app.ChannelService, app.VodService, app.QueueService, app.ArchiveService, app.AdminService, app.UserService, app.LiveService, app.PlaybackService, app.MetricsService, app.PlaylistService, app.TaskService, app.ChapterService, app.CategoryService, app.BlockedVodService, app.YoutubeService, app.EventsBroker, app.ShareService, app.AuditService, app.TrashService, app.BulkService, app.TagService, app.PlatformTwitch, app.RiverUIServer)
 
 	if err := httpHandler.Serve(ctx); err != nil {
 		return err
@@ -0,0 +1,227 @@
+// Package share manages links that give access to a single vod or playlist without an account.
+package share
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent"
+	entPlaylist "github.com/zibbp/ganymede/ent/playlist"
+	entShareLink "github.com/zibbp/ganymede/ent/sharelink"
+	entVod "github.com/zibbp/ganymede/ent/vod"
+	"github.com/zibbp/ganymede/internal/database"
+)
+
+// tokenPrefix marks share link tokens so they can be told apart from API tokens.
+const tokenPrefix = "gms_"
+
+var (
+	ErrShareLinkInvalid  = errors.New("invalid share link")
+	ErrShareLinkNotFound = errors.New("share link not found")
+	ErrShareLinkRange    = errors.New("end must be after start")
+)
+
+type Service struct {
+	Store *database.Database
+}
+
+func NewService(store *database.Database) *Service {
+	return &Service{Store: store}
+}
+
+// ShareLink is a link to create. Exactly one of VodID and PlaylistID is set, the time range is only used for vods.
+type ShareLink struct {
+	VodID        *uuid.UUID
+	PlaylistID   *uuid.UUID
+	StartSeconds *int
+	EndSeconds   *int
+	ExpiresAt    *time.Time
+}
+
+// CreateShareLink creates a share link. The token is only returned here, only its hash is stored.
+func (s *Service) CreateShareLink(ctx context.Context, userID uuid.UUID, input ShareLink) (*ent.ShareLink, string, error) {
+	if (input.VodID == nil) == (input.PlaylistID == nil) {
+		return nil, "", fmt.Errorf("either a vod or a playlist is required")
+	}
+	if input.PlaylistID != nil && (input.StartSeconds != nil || input.EndSeconds != nil) {
+		return nil, "", fmt.Errorf("time ranges can only be used for vods")
+	}
+	if input.StartSeconds != nil && input.EndSeconds != nil && *input.EndSeconds <= *input.StartSeconds {
+		return nil, "", ErrShareLinkRange
+	}
+	if input.ExpiresAt != nil && input.ExpiresAt.Before(time.Now()) {
+		return nil, "", fmt.Errorf("expiry must be in the future")
+	}
+
+	token, err := generateToken()
+	if err != nil {
+		return nil, "", fmt.Errorf("error generating share link token: %v", err)
+	}
+
+	create := s.Store.Client.ShareLink.Create().
+		SetCreatedByID(userID).
+		SetTokenHash(hashToken(token)).
+		SetTokenPrefix(token[:len(tokenPrefix)+6]).
+		SetNillableStartSeconds(input.StartSeconds).
+		SetNillableEndSeconds(input.EndSeconds).
+		SetNillableExpiresAt(input.ExpiresAt).
+		SetNillableVodID(input.VodID).
+		SetNillablePlaylistID(input.PlaylistID)
+
+	link, err := create.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, "", ErrShareLinkNotFound
+		}
+		return nil, "", fmt.Errorf("error creating share link: %v", err)
+	}
+	return link, token, nil
+}
+
+// GetVodShareLinks returns the share links of the vod.
+func (s *Service) GetVodShareLinks(ctx context.Context, vodID uuid.UUID) ([]*ent.ShareLink, error) {
+	links, err := s.Store.Client.ShareLink.Query().
+		Where(entShareLink.HasVodWith(entVod.ID(vodID))).
+		WithCreatedBy().
+		Order(ent.Desc(entShareLink.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting share links: %v", err)
+	}
+	return links, nil
+}
+
+// GetPlaylistShareLinks returns the share links of the playlist.
+func (s *Service) GetPlaylistShareLinks(ctx context.Context, playlistID uuid.UUID) ([]*ent.ShareLink, error) {
+	links, err := s.Store.Client.ShareLink.Query().
+		Where(entShareLink.HasPlaylistWith(entPlaylist.ID(playlistID))).
+		WithCreatedBy().
+		Order(ent.Desc(entShareLink.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting share links: %v", err)
+	}
+	return links, nil
+}
+
+// DeleteShareLink revokes a share link.
+func (s *Service) DeleteShareLink(ctx context.Context, id uuid.UUID) error {
+	err := s.Store.Client.ShareLink.DeleteOneID(id).Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ErrShareLinkNotFound
+		}
+		return fmt.Errorf("error deleting share link: %v", err)
+	}
+	return nil
+}
+
+// OpenShareLink returns the share link of the token with its vod or playlist and counts the view.
+func (s *Service) OpenShareLink(ctx context.Context, token string) (*ent.ShareLink, error) {
+	link, err := ValidateShareLink(ctx, s.Store, token)
+	if err != nil {
+		return nil, err
+	}
+
+	link, err = s.Store.Client.ShareLink.UpdateOne(link).
+		AddViews(1).
+		SetLastViewedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error updating share link views: %v", err)
+	}
+
+	link, err = s.Store.Client.ShareLink.Query().
+		Where(entShareLink.ID(link.ID)).
+		WithVod(func(q *ent.VodQuery) { q.WithChannel() }).
+		WithPlaylist(func(q *ent.PlaylistQuery) {
+			q.WithVods(func(q *ent.VodQuery) { q.Where(entVod.DeletedAtIsNil()).WithChannel() })
+		}).
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting share link: %v", err)
+	}
+	return link, nil
+}
+
+// ValidateShareLink returns the share link of the token with the id of its vod or playlist if the link exists, has not expired and its vod is not in the trash.
+func ValidateShareLink(ctx context.Context, store *database.Database, token string) (*ent.ShareLink, error) {
+	if !strings.HasPrefix(token, tokenPrefix) {
+		return nil, ErrShareLinkInvalid
+	}
+
+	link, err := store.Client.ShareLink.Query().
+		Where(entShareLink.TokenHash(hashToken(token))).
+		WithVod(func(q *ent.VodQuery) { q.Select(entVod.FieldID, entVod.FieldDeletedAt) }).
+		WithPlaylist(func(q *ent.PlaylistQuery) { q.Select(entPlaylist.FieldID) }).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrShareLinkInvalid
+		}
+		return nil, fmt.Errorf("error getting share link: %v", err)
+	}
+	if link.ExpiresAt != nil && link.ExpiresAt.Before(time.Now()) {
+		return nil, ErrShareLinkInvalid
+	}
+	// links to vods in the trash are hidden until the vod is restored
+	if link.Edges.Vod != nil && link.Edges.Vod.DeletedAt != nil {
+		return nil, ErrShareLinkInvalid
+	}
+	return link, nil
+}
+
+// CoversVod returns true if the link shares the vod, either directly or through a playlist containing it. Vods in the trash are not shared.
+func CoversVod(ctx context.Context, store *database.Database, link *ent.ShareLink, vodID uuid.UUID) (bool, error) {
+	if link.Edges.Vod != nil {
+		return link.Edges.Vod.ID == vodID && link.Edges.Vod.DeletedAt == nil, nil
+	}
+	if link.Edges.Playlist == nil {
+		return false, nil
+	}
+	exists, err := store.Client.Vod.Query().
+		Where(entVod.ID(vodID), entVod.DeletedAtIsNil(), entVod.HasPlaylistsWith(entPlaylist.ID(link.Edges.Playlist.ID))).
+		Exist(ctx)
+	if err != nil {
+		return false, fmt.Errorf("error checking playlist vod: %v", err)
+	}
+	return exists, nil
+}
+
+// ClampRange limits the start and end seconds to the time range of the link. Links without a range are returned unchanged.
+func ClampRange(link *ent.ShareLink, start, end float64) (float64, float64) {
+	if link == nil {
+		return start, end
+	}
+	if link.StartSeconds != nil {
+		start = max(start, float64(*link.StartSeconds))
+		end = max(end, float64(*link.StartSeconds))
+	}
+	if link.EndSeconds != nil {
+		start = min(start, float64(*link.EndSeconds))
+		end = min(end, float64(*link.EndSeconds))
+	}
+	return start, end
+}
+
+func generateToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return tokenPrefix + base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// hashToken hashes share link tokens with SHA-256, they are random so the hash can be looked up directly.
+func hashToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
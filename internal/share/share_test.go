@@ -0,0 +1,38 @@
+package share
+
+import (
+	"testing"
+
+	"github.com/zibbp/ganymede/ent"
+)
+
+func TestClampRange(t *testing.T) {
+	start, end := 60, 120
+
+	tests := []struct {
+		name      string
+		link      *ent.ShareLink
+		start     float64
+		end       float64
+		wantStart float64
+		wantEnd   float64
+	}{
+		{name: "no link", start: 0, end: 500, wantStart: 0, wantEnd: 500},
+		{name: "no range", link: &ent.ShareLink{}, start: 0, end: 500, wantStart: 0, wantEnd: 500},
+		{name: "inside range", link: &ent.ShareLink{StartSeconds: &start, EndSeconds: &end}, start: 70, end: 80, wantStart: 70, wantEnd: 80},
+		{name: "overlapping range", link: &ent.ShareLink{StartSeconds: &start, EndSeconds: &end}, start: 0, end: 500, wantStart: 60, wantEnd: 120},
+		{name: "before range", link: &ent.ShareLink{StartSeconds: &start, EndSeconds: &end}, start: 0, end: 30, wantStart: 60, wantEnd: 60},
+		{name: "after range", link: &ent.ShareLink{StartSeconds: &start, EndSeconds: &end}, start: 200, end: 300, wantStart: 120, wantEnd: 120},
+		{name: "start only", link: &ent.ShareLink{StartSeconds: &start}, start: 0, end: 500, wantStart: 60, wantEnd: 500},
+		{name: "end only", link: &ent.ShareLink{EndSeconds: &end}, start: 0, end: 500, wantStart: 0, wantEnd: 120},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotStart, gotEnd := ClampRange(tt.link, tt.start, tt.end)
+			if gotStart != tt.wantStart || gotEnd != tt.wantEnd {
+				t.Errorf("ClampRange() = %v, %v, want %v, %v", gotStart, gotEnd, tt.wantStart, tt.wantEnd)
+			}
+		})
+	}
+}
@@ -0,0 +1,192 @@
+// Package tag manages free-form tags used to organise vods.
+package tag
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/ent/predicate"
+	entTag "github.com/zibbp/ganymede/ent/tag"
+	entVod "github.com/zibbp/ganymede/ent/vod"
+	"github.com/zibbp/ganymede/internal/acl"
+	"github.com/zibbp/ganymede/internal/database"
+	"github.com/zibbp/ganymede/internal/tasks"
+	tasks_client "github.com/zibbp/ganymede/internal/tasks/client"
+)
+
+var (
+	ErrTagNotFound = errors.New("tag not found")
+	ErrTagExists   = errors.New("tag already exists")
+	ErrTagInvalid  = errors.New("tag name must be between 1 and 50 characters")
+	ErrVodNotFound = errors.New("vod not found")
+)
+
+type Service struct {
+	Store       *database.Database
+	RiverClient *tasks_client.RiverClient
+}
+
+func NewService(store *database.Database, riverClient *tasks_client.RiverClient) *Service {
+	return &Service{Store: store, RiverClient: riverClient}
+}
+
+// NormalizeName trims the name and collapses whitespace so tags that only differ in spacing are the same.
+func NormalizeName(name string) string {
+	return strings.Join(strings.Fields(name), " ")
+}
+
+func validateName(name string) error {
+	if name == "" || utf8.RuneCountInString(name) > 50 {
+		return ErrTagInvalid
+	}
+	return nil
+}
+
+// GetTags returns the tags the viewer can see sorted by name.
+func (s *Service) GetTags(ctx context.Context) ([]*ent.Tag, error) {
+	tags, err := s.Store.Client.Tag.Query().Where(acl.Tags(ctx)).Order(ent.Asc(entTag.FieldName)).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting tags: %v", err)
+	}
+	return tags, nil
+}
+
+// CreateTag creates a tag. Names are unique regardless of case.
+func (s *Service) CreateTag(ctx context.Context, name string) (*ent.Tag, error) {
+	name = NormalizeName(name)
+	if err := validateName(name); err != nil {
+		return nil, err
+	}
+	exists, err := s.Store.Client.Tag.Query().Where(entTag.NameEqualFold(name)).Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error checking tag exists: %v", err)
+	}
+	if exists {
+		return nil, ErrTagExists
+	}
+
+	t, err := s.Store.Client.Tag.Create().SetName(name).Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, ErrTagExists
+		}
+		return nil, fmt.Errorf("error creating tag: %v", err)
+	}
+	return t, nil
+}
+
+// UpdateTag renames a tag.
+func (s *Service) UpdateTag(ctx context.Context, id uuid.UUID, name string) (*ent.Tag, error) {
+	name = NormalizeName(name)
+	if err := validateName(name); err != nil {
+		return nil, err
+	}
+	exists, err := s.Store.Client.Tag.Query().Where(entTag.NameEqualFold(name), entTag.IDNEQ(id)).Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error checking tag exists: %v", err)
+	}
+	if exists {
+		return nil, ErrTagExists
+	}
+
+	t, err := s.Store.Client.Tag.UpdateOneID(id).SetName(name).Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrTagNotFound
+		}
+		if ent.IsConstraintError(err) {
+			return nil, ErrTagExists
+		}
+		return nil, fmt.Errorf("error updating tag: %v", err)
+	}
+	return t, nil
+}
+
+// DeleteTag deletes a tag and removes it from its vods.
+func (s *Service) DeleteTag(ctx context.Context, id uuid.UUID) error {
+	err := s.Store.Client.Tag.DeleteOneID(id).Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ErrTagNotFound
+		}
+		return fmt.Errorf("error deleting tag: %v", err)
+	}
+	return nil
+}
+
+// GetVodTags returns the tags of the vod sorted by name.
+func (s *Service) GetVodTags(ctx context.Context, vodID uuid.UUID) ([]*ent.Tag, error) {
+	tags, err := s.Store.Client.Tag.Query().
+		Where(entTag.HasVodsWith(entVod.ID(vodID))).
+		Order(ent.Asc(entTag.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting vod tags: %v", err)
+	}
+	return tags, nil
+}
+
+// SetVodTags replaces the tags of the vod. Tags that don't exist yet are created and the playlist rules are applied to the vod again.
+func (s *Service) SetVodTags(ctx context.Context, vodID uuid.UUID, names []string) ([]*ent.Tag, error) {
+	tx, err := s.Store.Client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error starting transaction: %v", err)
+	}
+
+	tagIDs := make([]uuid.UUID, 0, len(names))
+	seen := make(map[string]bool, len(names))
+	for _, name := range names {
+		name = NormalizeName(name)
+		if err := validateName(name); err != nil {
+			return nil, database.Rollback(tx, err)
+		}
+		if seen[strings.ToLower(name)] {
+			continue
+		}
+		seen[strings.ToLower(name)] = true
+
+		t, err := tx.Tag.Query().Where(entTag.NameEqualFold(name)).Only(ctx)
+		if ent.IsNotFound(err) {
+			t, err = tx.Tag.Create().SetName(name).Save(ctx)
+		}
+		if err != nil {
+			return nil, database.Rollback(tx, fmt.Errorf("error getting tag %s: %v", name, err))
+		}
+		tagIDs = append(tagIDs, t.ID)
+	}
+
+	err = tx.Vod.UpdateOneID(vodID).ClearTags().AddTagIDs(tagIDs...).Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, database.Rollback(tx, ErrVodNotFound)
+		}
+		return nil, database.Rollback(tx, fmt.Errorf("error setting vod tags: %v", err))
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("error committing transaction: %v", err)
+	}
+
+	// tags are a playlist rule field so rules are applied again
+	if _, err := s.RiverClient.Client.Insert(ctx, tasks.ApplyVideoPlaylistRulesArgs{VideoID: vodID}, nil); err != nil {
+		log.Error().Err(err).Str("vod_id", vodID.String()).Msg("error queuing video playlist rules task")
+	}
+
+	return s.GetVodTags(ctx, vodID)
+}
+
+// Predicates returns predicates matching vods that have all of the tags.
+func Predicates(names []string) []predicate.Vod {
+	predicates := make([]predicate.Vod, 0, len(names))
+	for _, name := range names {
+		if name = NormalizeName(name); name != "" {
+			predicates = append(predicates, entVod.HasTagsWith(entTag.NameEqualFold(name)))
+		}
+	}
+	return predicates
+}
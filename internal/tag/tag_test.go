@@ -0,0 +1,48 @@
+package tag
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNormalizeName(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected string
+	}{
+		{name: "speedrun", expected: "speedrun"},
+		{name: "  Any%   Glitchless ", expected: "Any% Glitchless"},
+		{name: "\tvod\nreview", expected: "vod review"},
+		{name: "   ", expected: ""},
+	}
+	for _, tt := range tests {
+		if got := NormalizeName(tt.name); got != tt.expected {
+			t.Errorf("NormalizeName(%q) = %q, want %q", tt.name, got, tt.expected)
+		}
+	}
+}
+
+func TestPredicates(t *testing.T) {
+	if got := Predicates([]string{"speedrun", " ", "Highlight"}); len(got) != 2 {
+		t.Errorf("expected 2 predicates, got %d", len(got))
+	}
+}
+
+func TestValidateName(t *testing.T) {
+	tests := []struct {
+		name  string
+		valid bool
+	}{
+		{name: "speedrun", valid: true},
+		{name: "", valid: false},
+		{name: strings.Repeat("a", 50), valid: true},
+		{name: strings.Repeat("a", 51), valid: false},
+		{name: strings.Repeat("ゲーム", 16), valid: true},
+		{name: strings.Repeat("ゲーム", 17), valid: false},
+	}
+	for _, tt := range tests {
+		if err := validateName(tt.name); (err == nil) != tt.valid {
+			t.Errorf("validateName(%q) = %v, want valid %v", tt.name, err, tt.valid)
+		}
+	}
+}
@@ -2,10 +2,15 @@ package tasks
 
 import (
 	"context"
+	"fmt"
+	"path/filepath"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/riverqueue/river"
+	"github.com/rs/zerolog/log"
+	"github.com/zibbp/ganymede/internal/config"
 	"github.com/zibbp/ganymede/internal/database"
 	"github.com/zibbp/ganymede/internal/errors"
 	"github.com/zibbp/ganymede/internal/exec"
@@ -67,7 +72,9 @@ func (w DownloadChatWorker) Work(ctx context.Context, job *river.Job[DownloadCha
 	}
 
 	// download video
-	err = exec.DownloadTwitchChat(ctx, dbItems.Video)
+	progressReporter := startTaskProgressReporter(ctx, store.ConnPool, dbItems.Queue.ID, utils.TaskDownloadChat)
+	err = exec.DownloadTwitchChat(ctx, dbItems.Video, progressReporter.Report)
+	progressReporter.Stop()
 	if err != nil {
 		return err
 	}
@@ -169,7 +176,9 @@ func (w RenderChatWorker) Work(ctx context.Context, job *river.Job[RenderChatArg
 	continueArchive := true
 
 	// download video
-	err = exec.RenderTwitchChat(ctx, dbItems.Video)
+	progressReporter := startTaskProgressReporter(ctx, store.ConnPool, dbItems.Queue.ID, utils.TaskRenderChat)
+	err = exec.RenderTwitchChat(ctx, dbItems.Video, progressReporter.Report)
+	progressReporter.Stop()
 	if err != nil {
 
 		// check if chat render has no messages
@@ -314,3 +323,101 @@ func (w MoveChatWorker) Work(ctx context.Context, job *river.Job[MoveChatArgs])
 
 	return nil
 }
+
+// //////////////////////
+// Re-render Chat (VOD) //
+// //////////////////////
+// RerenderChatArgs renders the chat of an archived video again with the current chat render parameters.
+type RerenderChatArgs struct {
+	VideoId string `json:"video_id"`
+}
+
+func (RerenderChatArgs) Kind() string { return TaskRerenderChat }
+
+func (args RerenderChatArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		MaxAttempts: 1,
+		Queue:       QueueChatRender,
+	}
+}
+
+func (w RerenderChatArgs) Timeout(job *river.Job[RerenderChatArgs]) time.Duration {
+	return 49 * time.Hour
+}
+
+type RerenderChatWorker struct {
+	river.WorkerDefaults[RerenderChatArgs]
+}
+
+func (w RerenderChatWorker) Work(ctx context.Context, job *river.Job[RerenderChatArgs]) error {
+	logger := log.With().Str("task", job.Kind).Str("job_id", fmt.Sprintf("%d", job.ID)).Logger()
+	// get store from context
+	store, err := StoreFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	// start task heartbeat
+	go startHeartBeatForTask(ctx, HeartBeatInput{
+		TaskId: job.ID,
+		conn:   store.ConnPool,
+	})
+
+	videoUUID, err := uuid.Parse(job.Args.VideoId)
+	if err != nil {
+		return err
+	}
+
+	video, err := store.Client.Vod.Get(ctx, videoUUID)
+	if err != nil {
+		return err
+	}
+	if video.ChatPath == "" || !utils.FileExists(video.ChatPath) {
+		return fmt.Errorf("video has no downloaded chat")
+	}
+
+	chatVideoPath := video.ChatVideoPath
+	if chatVideoPath == "" {
+		chatVideoPath = filepath.Join(filepath.Dir(video.ChatPath), fmt.Sprintf("%s-chat.mp4", video.FileName))
+	}
+
+	// render from a copy of the chat in the temp directory like the archive does
+	env := config.GetEnvConfig()
+	tmpID := uuid.New()
+	renderVideo := *video
+	renderVideo.TmpChatDownloadPath = fmt.Sprintf("%s/%s_%s-chat.json", env.TempDir, video.ExtID, tmpID)
+	renderVideo.TmpChatRenderPath = fmt.Sprintf("%s/%s_%s-chat.mp4", env.TempDir, video.ExtID, tmpID)
+	defer func() {
+		for _, path := range []string{renderVideo.TmpChatDownloadPath, renderVideo.TmpChatRenderPath} {
+			if utils.FileExists(path) {
+				if err := utils.DeleteFile(path); err != nil {
+					logger.Warn().Err(err).Str("path", path).Msg("error deleting temp chat file")
+				}
+			}
+		}
+	}()
+
+	if err := utils.CopyFile(video.ChatPath, renderVideo.TmpChatDownloadPath); err != nil {
+		return err
+	}
+
+	logger.Info().Str("video_id", video.ID.String()).Msg("rendering chat for video")
+
+	if err := exec.RenderTwitchChat(ctx, renderVideo, nil); err != nil {
+		return err
+	}
+
+	if err := utils.MoveFile(ctx, renderVideo.TmpChatRenderPath, chatVideoPath); err != nil {
+		return err
+	}
+
+	if video.ChatVideoPath != chatVideoPath {
+		if _, err := store.Client.Vod.UpdateOneID(video.ID).SetChatVideoPath(chatVideoPath).Save(ctx); err != nil {
+			return err
+		}
+	}
+
+	logger.Info().Str("video_id", video.ID.String()).Msg("chat rendered")
+
+	return nil
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/zibbp/ganymede/ent/vod"
 	"github.com/zibbp/ganymede/internal/chapter"
 	"github.com/zibbp/ganymede/internal/config"
+	"github.com/zibbp/ganymede/internal/notification"
 	"github.com/zibbp/ganymede/internal/platform"
 	"github.com/zibbp/ganymede/internal/utils"
 )
@@ -81,6 +82,11 @@ func (w CreateDirectoryWorker) Work(ctx context.Context, job *river.Job[CreateDi
 		return err
 	}
 
+	// creating the directory is the first task of an archive
+	if job.Args.Continue && job.Attempt == 1 {
+		notification.SendArchiveStartedNotification(&dbItems.Channel, &dbItems.Video, &dbItems.Queue)
+	}
+
 	// set queue status to completed
 	err = setQueueStatus(ctx, store.Client, QueueStatusInput{
 		Status:  utils.Success,
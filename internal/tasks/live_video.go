@@ -92,7 +92,9 @@ func (w DownloadLiveVideoWorker) Work(ctx context.Context, job *river.Job[Downlo
 	}()
 
 	// download live video
-	err = exec.DownloadTwitchLiveVideo(ctx, dbItems.Video, dbItems.Channel, startChatDownload)
+	progressReporter := startTaskProgressReporter(ctx, store.ConnPool, dbItems.Queue.ID, utils.TaskDownloadVideo)
+	err = exec.DownloadTwitchLiveVideo(ctx, dbItems.Video, dbItems.Channel, startChatDownload, progressReporter.Report)
+	progressReporter.Stop()
 	if err != nil {
 		if errors.Is(err, context.Canceled) {
 			// create new context to finish the task
@@ -0,0 +1,396 @@
+package tasks_periodic
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/riverqueue/river"
+	"github.com/riverqueue/river/rivertype"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"github.com/zibbp/ganymede/internal/config"
+	"github.com/zibbp/ganymede/internal/diskguard"
+	"github.com/zibbp/ganymede/internal/notification"
+	"github.com/zibbp/ganymede/internal/platform"
+	"github.com/zibbp/ganymede/internal/tasks"
+	"github.com/zibbp/ganymede/internal/utils"
+	"github.com/zibbp/ganymede/internal/vod"
+	"github.com/zibbp/ganymede/internal/youtube"
+)
+
+// Check free space of the videos and temp directories
+type CheckDiskSpaceArgs struct{}
+
+func (CheckDiskSpaceArgs) Kind() string { return tasks.TaskCheckDiskSpace }
+
+func (w CheckDiskSpaceArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		MaxAttempts: 1,
+	}
+}
+
+func (w CheckDiskSpaceArgs) Timeout(job *river.Job[CheckDiskSpaceArgs]) time.Duration {
+	return 1 * time.Minute
+}
+
+type CheckDiskSpaceWorker struct {
+	river.WorkerDefaults[CheckDiskSpaceArgs]
+}
+
+// notifiedLowDiskKey is set in the metadata of the default queue to the directories a disk low notification was sent for, so it is only sent again after space was freed. Keeping it in the database survives restarts of the worker.
+const notifiedLowDiskKey = "notified_low_disk_paths"
+
+func (w CheckDiskSpaceWorker) Work(ctx context.Context, job *river.Job[CheckDiskSpaceArgs]) error {
+	logger := log.With().Str("task", job.Kind).Str("job_id", fmt.Sprintf("%d", job.ID)).Logger()
+	client := river.ClientFromContext[pgx.Tx](ctx)
+
+	thresholdBytes := int64(config.Get().Notification.DiskLowThresholdGB) * 1024 * 1024 * 1024
+	if thresholdBytes == 0 {
+		return nil
+	}
+
+	lowDiskPaths, err := getNotifiedSet(ctx, client, notifiedLowDiskKey)
+	if err != nil {
+		return err
+	}
+
+	env := config.GetEnvConfig()
+	for _, path := range []string{env.VideosDir, env.TempDir} {
+		free, total, err := utils.GetDiskUsage(path)
+		if err != nil {
+			logger.Error().Err(err).Msg("error checking disk space")
+			continue
+		}
+
+		if free >= thresholdBytes {
+			delete(lowDiskPaths, path)
+			continue
+		}
+		if lowDiskPaths[path] {
+			continue
+		}
+		lowDiskPaths[path] = true
+
+		logger.Warn().Str("path", path).Int64("free_bytes", free).Msg("disk space is low")
+		notification.SendDiskLowNotification(notification.DiskUsage{
+			Path:           path,
+			FreeBytes:      free,
+			TotalBytes:     total,
+			ThresholdBytes: thresholdBytes,
+		})
+	}
+
+	return setNotifiedSet(ctx, client, notifiedLowDiskKey, lowDiskPaths)
+}
+
+// Check the Twitch token and YouTube credentials are valid
+type CheckCredentialsArgs struct{}
+
+func (CheckCredentialsArgs) Kind() string { return tasks.TaskCheckCredentials }
+
+func (w CheckCredentialsArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		MaxAttempts: 1,
+	}
+}
+
+func (w CheckCredentialsArgs) Timeout(job *river.Job[CheckCredentialsArgs]) time.Duration {
+	return 1 * time.Minute
+}
+
+type CheckCredentialsWorker struct {
+	river.WorkerDefaults[CheckCredentialsArgs]
+}
+
+// notifiedInvalidCredentialsKey is set in the metadata of the default queue to the credentials a credential invalid notification was sent for, so it is only sent again after the credential was valid.
+const notifiedInvalidCredentialsKey = "notified_invalid_credentials"
+
+func (w CheckCredentialsWorker) Work(ctx context.Context, job *river.Job[CheckCredentialsArgs]) error {
+	logger := log.With().Str("task", job.Kind).Str("job_id", fmt.Sprintf("%d", job.ID)).Logger()
+	client := river.ClientFromContext[pgx.Tx](ctx)
+
+	store, err := tasks.StoreFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	invalidCredentials, err := getNotifiedSet(ctx, client, notifiedInvalidCredentialsKey)
+	if err != nil {
+		return err
+	}
+
+	if twitchToken := config.Get().Parameters.TwitchToken; twitchToken != "" {
+		err := platform.ValidateTwitchUserToken(ctx, twitchToken)
+		var invalidErr platform.ErrorTokenInvalid
+		switch {
+		case errors.As(err, &invalidErr):
+			updateCredentialState(invalidCredentials, "Twitch token", err)
+		case err != nil:
+			logger.Error().Err(err).Msg("error validating Twitch token")
+		default:
+			updateCredentialState(invalidCredentials, "Twitch token", nil)
+		}
+	}
+
+	err = youtube.NewService(store).ValidateCredentials(ctx)
+	switch {
+	case errors.Is(err, youtube.ErrNoCredentials):
+	case errors.Is(err, youtube.ErrCredentialsInvalid):
+		updateCredentialState(invalidCredentials, "YouTube credential", err)
+	case err != nil:
+		logger.Error().Err(err).Msg("error validating YouTube credentials")
+	default:
+		updateCredentialState(invalidCredentials, "YouTube credential", nil)
+	}
+
+	return setNotifiedSet(ctx, client, notifiedInvalidCredentialsKey, invalidCredentials)
+}
+
+// updateCredentialState records whether the credential is valid and sends a notification when it became invalid.
+func updateCredentialState(invalidCredentials map[string]bool, credential string, invalidErr error) {
+	if invalidErr == nil {
+		delete(invalidCredentials, credential)
+		return
+	}
+	if invalidCredentials[credential] {
+		return
+	}
+	invalidCredentials[credential] = true
+
+	log.Warn().Err(invalidErr).Str("credential", credential).Msg("credential is invalid")
+	notification.SendCredentialInvalidNotification(credential, invalidErr.Error())
+}
+
+// getNotifiedSet returns the values stored under the key in the metadata of the default queue.
+func getNotifiedSet(ctx context.Context, client *river.Client[pgx.Tx], key string) (map[string]bool, error) {
+	set := map[string]bool{}
+	queue, err := client.QueueGet(ctx, river.QueueDefault)
+	if err != nil {
+		if errors.Is(err, rivertype.ErrNotFound) {
+			return set, nil
+		}
+		return nil, err
+	}
+	values, _ := queueMetadata(queue)[key].([]any)
+	for _, value := range values {
+		if s, ok := value.(string); ok {
+			set[s] = true
+		}
+	}
+	return set, nil
+}
+
+// setNotifiedSet stores the values under the key in the metadata of the default queue.
+func setNotifiedSet(ctx context.Context, client *river.Client[pgx.Tx], key string, set map[string]bool) error {
+	queue, err := client.QueueGet(ctx, river.QueueDefault)
+	if err != nil {
+		if errors.Is(err, rivertype.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+	values := make([]string, 0, len(set))
+	for value := range set {
+		values = append(values, value)
+	}
+	slices.Sort(values)
+	metadata := queueMetadata(queue)
+	metadata[key] = values
+	return updateQueueMetadata(ctx, client, river.QueueDefault, metadata)
+}
+
+// Pause archiving when the videos or temp directory is low on space
+type DiskGuardArgs struct{}
+
+func (DiskGuardArgs) Kind() string { return tasks.TaskDiskGuard }
+
+func (w DiskGuardArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		MaxAttempts: 1,
+	}
+}
+
+func (w DiskGuardArgs) Timeout(job *river.Job[DiskGuardArgs]) time.Duration {
+	return 10 * time.Minute
+}
+
+type DiskGuardWorker struct {
+	river.WorkerDefaults[DiskGuardArgs]
+}
+
+// diskGuardQueues are paused when free space is below the pause threshold. Live recordings run on the default queue and are only stopped below the live floor.
+var diskGuardQueues = []string{tasks.QueueVideoDownload, tasks.QueueVideoPostProcess}
+
+// diskGuardPausedKey is set in the metadata of queues paused by the disk guard so queues paused by an admin are not resumed.
+const diskGuardPausedKey = "paused_by_disk_guard"
+
+func (w DiskGuardWorker) Work(ctx context.Context, job *river.Job[DiskGuardArgs]) error {
+	logger := log.With().Str("task", job.Kind).Str("job_id", fmt.Sprintf("%d", job.ID)).Logger()
+	client := river.ClientFromContext[pgx.Tx](ctx)
+
+	guard := config.Get().DiskGuard
+	if !guard.Enabled {
+		// resume queues paused before the guard was disabled
+		return resumeDiskGuardQueues(ctx, client, logger)
+	}
+
+	usage, err := diskguard.LowestFreeSpace()
+	if err != nil {
+		return err
+	}
+
+	pauseBytes := diskguard.GBToBytes(guard.PauseThresholdGB)
+	resumeBytes := max(diskguard.GBToBytes(guard.ResumeThresholdGB), pauseBytes)
+
+	if usage.FreeBytes < pauseBytes && guard.PruneBeforePausing {
+		paused, err := diskGuardQueuesPaused(ctx, client)
+		if err != nil {
+			return err
+		}
+		// only prune when space first runs low, pruning again will not free more space
+		if !paused {
+			store, err := tasks.StoreFromContext(ctx)
+			if err != nil {
+				return err
+			}
+			logger.Info().Str("path", usage.Path).Int64("free_bytes", usage.FreeBytes).Msg("disk space is low, pruning videos before pausing")
+			if err := vod.PruneVideos(ctx, store); err != nil {
+				logger.Error().Err(err).Msg("error pruning videos")
+			}
+			usage, err = diskguard.LowestFreeSpace()
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	switch {
+	case usage.FreeBytes < pauseBytes:
+		if err := pauseDiskGuardQueues(ctx, client, logger, usage); err != nil {
+			return err
+		}
+	case usage.FreeBytes >= resumeBytes:
+		if err := resumeDiskGuardQueues(ctx, client, logger); err != nil {
+			return err
+		}
+	}
+
+	if usage.FreeBytes < diskguard.GBToBytes(guard.LiveFloorGB) {
+		return stopLiveRecordings(ctx, client, logger, usage)
+	}
+
+	return nil
+}
+
+// diskGuardQueuesPaused returns whether the disk guard paused any queue.
+func diskGuardQueuesPaused(ctx context.Context, client *river.Client[pgx.Tx]) (bool, error) {
+	for _, name := range diskGuardQueues {
+		queue, err := client.QueueGet(ctx, name)
+		if err != nil {
+			if errors.Is(err, rivertype.ErrNotFound) {
+				continue
+			}
+			return false, err
+		}
+		if queue.PausedAt != nil && queueMetadata(queue)[diskGuardPausedKey] == true {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func pauseDiskGuardQueues(ctx context.Context, client *river.Client[pgx.Tx], logger zerolog.Logger, usage diskguard.Usage) error {
+	for _, name := range diskGuardQueues {
+		queue, err := client.QueueGet(ctx, name)
+		if err != nil {
+			if errors.Is(err, rivertype.ErrNotFound) {
+				continue
+			}
+			return err
+		}
+		if queue.PausedAt != nil {
+			continue
+		}
+
+		if err := client.QueuePause(ctx, name, nil); err != nil {
+			return fmt.Errorf("error pausing queue %s: %w", name, err)
+		}
+		metadata := queueMetadata(queue)
+		metadata[diskGuardPausedKey] = true
+		if err := updateQueueMetadata(ctx, client, name, metadata); err != nil {
+			return err
+		}
+		logger.Warn().Str("queue", name).Str("path", usage.Path).Int64("free_bytes", usage.FreeBytes).Msg("paused queue as disk space is low")
+	}
+	return nil
+}
+
+func resumeDiskGuardQueues(ctx context.Context, client *river.Client[pgx.Tx], logger zerolog.Logger) error {
+	for _, name := range diskGuardQueues {
+		queue, err := client.QueueGet(ctx, name)
+		if err != nil {
+			if errors.Is(err, rivertype.ErrNotFound) {
+				continue
+			}
+			return err
+		}
+		metadata := queueMetadata(queue)
+		if metadata[diskGuardPausedKey] != true {
+			continue
+		}
+
+		if queue.PausedAt != nil {
+			if err := client.QueueResume(ctx, name, nil); err != nil {
+				return fmt.Errorf("error resuming queue %s: %w", name, err)
+			}
+			logger.Info().Str("queue", name).Msg("resumed queue as disk space was freed")
+		}
+		delete(metadata, diskGuardPausedKey)
+		if err := updateQueueMetadata(ctx, client, name, metadata); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// stopLiveRecordings cancels live video downloads, cancelled downloads finish archiving what was recorded.
+func stopLiveRecordings(ctx context.Context, client *river.Client[pgx.Tx], logger zerolog.Logger, usage diskguard.Usage) error {
+	params := river.NewJobListParams().Kinds(string(utils.TaskDownloadLiveVideo)).States(rivertype.JobStateRunning).First(10000)
+	jobs, err := client.JobList(ctx, params)
+	if err != nil {
+		return err
+	}
+	for _, job := range jobs.Jobs {
+		if _, err := client.JobCancel(ctx, job.ID); err != nil {
+			return fmt.Errorf("error cancelling live recording job %d: %w", job.ID, err)
+		}
+		logger.Warn().Int64("job_id", job.ID).Str("path", usage.Path).Int64("free_bytes", usage.FreeBytes).Msg("stopped live recording as disk space is below the live floor")
+	}
+	return nil
+}
+
+func queueMetadata(queue *rivertype.Queue) map[string]any {
+	metadata := map[string]any{}
+	if len(queue.Metadata) > 0 {
+		if err := json.Unmarshal(queue.Metadata, &metadata); err != nil {
+			log.Debug().Err(err).Str("queue", queue.Name).Msg("error unmarshalling queue metadata")
+		}
+	}
+	return metadata
+}
+
+func updateQueueMetadata(ctx context.Context, client *river.Client[pgx.Tx], name string, metadata map[string]any) error {
+	data, err := json.Marshal(metadata)
+	if err != nil {
+		return err
+	}
+	if _, err := client.QueueUpdate(ctx, name, &river.QueueUpdateParams{Metadata: data}); err != nil {
+		return fmt.Errorf("error updating metadata of queue %s: %w", name, err)
+	}
+	return nil
+}
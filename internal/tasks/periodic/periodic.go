@@ -11,13 +11,15 @@ import (
 	entPlaylist "github.com/zibbp/ganymede/ent/playlist"
 	entPlaylistGroup "github.com/zibbp/ganymede/ent/playlistrulegroup"
 	entTwitchCategory "github.com/zibbp/ganymede/ent/twitchcategory"
-	entVod "github.com/zibbp/ganymede/ent/vod"
+	"github.com/zibbp/ganymede/internal/audit"
 	"github.com/zibbp/ganymede/internal/auth"
+	"github.com/zibbp/ganymede/internal/config"
 	"github.com/zibbp/ganymede/internal/errors"
 	"github.com/zibbp/ganymede/internal/live"
 	"github.com/zibbp/ganymede/internal/playlist"
 	"github.com/zibbp/ganymede/internal/tasks"
 	tasks_shared "github.com/zibbp/ganymede/internal/tasks/shared"
+	"github.com/zibbp/ganymede/internal/trash"
 	"github.com/zibbp/ganymede/internal/vod"
 )
 
@@ -182,6 +184,82 @@ func (w PruneVideosWorker) Work(ctx context.Context, job *river.Job[PruneVideosA
 	return nil
 }
 
+// Prune audit logs
+type PruneAuditLogsArgs struct{}
+
+func (PruneAuditLogsArgs) Kind() string { return tasks.TaskPruneAuditLogs }
+
+func (w PruneAuditLogsArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		MaxAttempts: 5,
+	}
+}
+
+func (w PruneAuditLogsArgs) Timeout(job *river.Job[PruneAuditLogsArgs]) time.Duration {
+	return 5 * time.Minute
+}
+
+type PruneAuditLogsWorker struct {
+	river.WorkerDefaults[PruneAuditLogsArgs]
+}
+
+func (w PruneAuditLogsWorker) Work(ctx context.Context, job *river.Job[PruneAuditLogsArgs]) error {
+	logger := log.With().Str("task", job.Kind).Str("job_id", fmt.Sprintf("%d", job.ID)).Logger()
+	logger.Info().Msg("starting task")
+
+	store, err := tasks.StoreFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := audit.PruneAuditLogs(ctx, store, config.Get().Audit.RetentionDays)
+	if err != nil {
+		return err
+	}
+
+	logger.Info().Int("deleted", deleted).Msg("task completed")
+
+	return nil
+}
+
+// Purge trash
+type PurgeTrashArgs struct{}
+
+func (PurgeTrashArgs) Kind() string { return tasks.TaskPurgeTrash }
+
+func (w PurgeTrashArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		MaxAttempts: 5,
+	}
+}
+
+func (w PurgeTrashArgs) Timeout(job *river.Job[PurgeTrashArgs]) time.Duration {
+	return 30 * time.Minute
+}
+
+type PurgeTrashWorker struct {
+	river.WorkerDefaults[PurgeTrashArgs]
+}
+
+func (w PurgeTrashWorker) Work(ctx context.Context, job *river.Job[PurgeTrashArgs]) error {
+	logger := log.With().Str("task", job.Kind).Str("job_id", fmt.Sprintf("%d", job.ID)).Logger()
+	logger.Info().Msg("starting task")
+
+	store, err := tasks.StoreFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	purged, err := trash.PurgeExpired(ctx, store, config.Get().Trash.RetentionDays)
+	if err != nil {
+		return err
+	}
+
+	logger.Info().Int("purged", purged).Msg("task completed")
+
+	return nil
+}
+
 // Import Twitch categories
 type ImportCategoriesArgs struct{}
 
@@ -377,58 +455,26 @@ func (w ProcessPlaylistVideoRulesWorker) Work(ctx context.Context, job *river.Jo
 		logger := logger.With().Str("playlist_id", playlistID.String()).Logger()
 		logger.Info().Msg("processing playlist video rules")
 
-		// Query rule groups for the playlist
-		groups, err := store.Client.PlaylistRuleGroup.
+		// Skip playlists without rules
+		hasRules, err := store.Client.PlaylistRuleGroup.
 			Query().
 			Where(entPlaylistGroup.HasPlaylistWith(entPlaylist.IDEQ(playlistID))).
-			WithRules().
-			All(ctx)
+			Exist(ctx)
 		if err != nil {
 			logger.Error().Err(err).Msg("failed to get playlist rule groups")
 			continue
 		}
-		if len(groups) == 0 {
+		if !hasRules {
 			logger.Info().Msg("no rule groups found for playlist, skipping")
 			continue
 		}
 
 		// Evaluate each video against the playlist rules
 		for _, videoID := range videoIds {
-			shouldBeIn, err := playlistService.ShouldVideoBeInPlaylist(ctx, videoID, playlistID)
-			if err != nil {
-				logger.Error().Err(err).Msg("failed to evaluate video against playlist rules")
+			if _, err := playlistService.ApplyPlaylistRules(ctx, playlistID, videoID); err != nil {
+				logger.Error().Err(err).Str("video_id", videoID.String()).Msg("failed to apply playlist rules to video")
 				continue
 			}
-
-			if shouldBeIn {
-				logger.Debug().Msgf("video %s should be in playlist %s", videoID, playlistID)
-				err = playlistService.AddVodToPlaylist(ctx, playlistID, videoID)
-				if err != nil {
-					logger.Error().Err(err).Msg("failed to add video to playlist")
-					continue
-				}
-				logger.Info().Msgf("video %s added to playlist %s", videoID, playlistID)
-
-			} else {
-				logger.Debug().Msgf("video %s should NOT be in playlist %s", videoID, playlistID)
-				// Check if video is actually in the playlist before attempting removal
-				inPlaylist, err := store.Client.Playlist.Query().
-					Where(entPlaylist.HasVodsWith(entVod.IDEQ(videoID))).
-					Where(entPlaylist.IDEQ(playlistID)).
-					Exist(ctx)
-				if err != nil {
-					logger.Error().Err(err).Msg("failed to check if video is in playlist")
-					continue
-				}
-				if inPlaylist {
-					err = playlistService.DeleteVodFromPlaylist(ctx, playlistID, videoID)
-					if err != nil {
-						logger.Error().Err(err).Msg("failed to remove video from playlist")
-						continue
-					}
-					logger.Info().Msgf("video %s removed from playlist %s", videoID, playlistID)
-				}
-			}
 		}
 	}
 
@@ -0,0 +1,105 @@
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog/log"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// taskProgressReporter records the progress of a task on its queue item.
+//
+// Progress is written by a single goroutine so reporting never blocks the command output. Only the latest progress is kept if writes fall behind.
+type taskProgressReporter struct {
+	conn    *pgxpool.Pool
+	queueId uuid.UUID
+	task    utils.TaskName
+	updates chan utils.TaskProgress
+	done    chan struct{}
+}
+
+// startTaskProgressReporter clears the previous progress of the task and starts recording new progress until Stop is called.
+func startTaskProgressReporter(ctx context.Context, conn *pgxpool.Pool, queueId uuid.UUID, task utils.TaskName) *taskProgressReporter {
+	r := &taskProgressReporter{
+		conn:    conn,
+		queueId: queueId,
+		task:    task,
+		updates: make(chan utils.TaskProgress, 1),
+		done:    make(chan struct{}),
+	}
+
+	if err := clearTaskProgress(ctx, conn, queueId, task); err != nil {
+		log.Debug().Err(err).Str("task", string(task)).Msg("failed to clear task progress")
+	}
+
+	go func() {
+		defer close(r.done)
+		for progress := range r.updates {
+			if err := saveTaskProgress(ctx, conn, queueId, task, progress); err != nil && ctx.Err() == nil {
+				log.Debug().Err(err).Str("task", string(task)).Msg("failed to save task progress")
+			}
+		}
+	}()
+
+	return r
+}
+
+// Report queues the progress to be recorded, replacing progress that has not been written yet.
+func (r *taskProgressReporter) Report(progress utils.TaskProgress) {
+	for {
+		select {
+		case r.updates <- progress:
+			return
+		default:
+		}
+		select {
+		case <-r.updates:
+		default:
+		}
+	}
+}
+
+// Stop waits for the queued progress to be written.
+func (r *taskProgressReporter) Stop() {
+	close(r.updates)
+	<-r.done
+}
+
+// saveTaskProgress sets the progress of a single task without overwriting the progress of tasks running in parallel.
+func saveTaskProgress(ctx context.Context, conn *pgxpool.Pool, queueId uuid.UUID, task utils.TaskName, progress utils.TaskProgress) error {
+	jsonBytes, err := json.Marshal(progress)
+	if err != nil {
+		return fmt.Errorf("error marshalling progress: %w", err)
+	}
+
+	query := `
+		UPDATE queues
+		SET task_progress = jsonb_set(COALESCE(task_progress, '{}'::jsonb), ARRAY[$1::text], $2::jsonb)
+		WHERE id = $3
+	`
+
+	if _, err := conn.Exec(ctx, query, string(task), jsonBytes, queueId); err != nil {
+		return fmt.Errorf("error updating task progress: %w", err)
+	}
+
+	return nil
+}
+
+// clearTaskProgress removes the progress of a single task.
+func clearTaskProgress(ctx context.Context, conn *pgxpool.Pool, queueId uuid.UUID, task utils.TaskName) error {
+	query := `
+		UPDATE queues
+		SET task_progress = task_progress - $1::text
+		WHERE id = $2 AND task_progress IS NOT NULL
+	`
+
+	if _, err := conn.Exec(ctx, query, string(task), queueId); err != nil {
+		return fmt.Errorf("error clearing task progress: %w", err)
+	}
+
+	return nil
+}
@@ -23,8 +23,10 @@ import (
 	entVod "github.com/zibbp/ganymede/ent/vod"
 	"github.com/zibbp/ganymede/internal/config"
 	"github.com/zibbp/ganymede/internal/database"
+	"github.com/zibbp/ganymede/internal/events"
 	"github.com/zibbp/ganymede/internal/notification"
 	"github.com/zibbp/ganymede/internal/platform"
+	"github.com/zibbp/ganymede/internal/playlist"
 	tasks_shared "github.com/zibbp/ganymede/internal/tasks/shared"
 	"github.com/zibbp/ganymede/internal/utils"
 	vods_utility "github.com/zibbp/ganymede/internal/vod/utility"
@@ -49,6 +51,15 @@ var (
 	TaskUpdateVideoStorageUsage     = "update_video_storage_usage"
 	TaskUpdateChannelStorageUsage   = "update_channel_storage_usage"
 	TaskProcessPlaylistVideoRules   = "process_playlist_video_rules"
+	TaskApplyVideoPlaylistRules     = "apply_video_playlist_rules"
+	TaskCheckDiskSpace              = "check_disk_space"
+	TaskCheckCredentials            = "check_credentials"
+	TaskDiskGuard                   = "disk_guard"
+	TaskPruneAuditLogs              = "prune_audit_logs"
+	TaskPurgeTrash                  = "purge_trash"
+	TaskRerenderChat                = "rerender_chat"
+	TaskConvertVideoToHls           = "convert_video_to_hls"
+	TaskBulkVodOperation            = "bulk_vod_operation"
 )
 
 var (
@@ -132,6 +143,8 @@ func setQueueStatus(ctx context.Context, entClient *ent.Client, queueStatusInput
 		q = q.SetTaskVideoDownload(queueStatusInput.Status)
 	case utils.TaskPostProcessVideo:
 		q = q.SetTaskVideoConvert(queueStatusInput.Status)
+	case utils.TaskValidateVideo:
+		q = q.SetTaskVideoValidate(queueStatusInput.Status)
 	case utils.TaskMoveVideo:
 		q = q.SetTaskVideoMove(queueStatusInput.Status)
 	case utils.TaskDownloadChat:
@@ -149,6 +162,12 @@ func setQueueStatus(ctx context.Context, entClient *ent.Client, queueStatusInput
 		return err
 	}
 
+	events.Publish(ctx, events.TaskStatusChanged, events.TaskStatusChangedData{
+		QueueID: queueStatusInput.QueueId,
+		Task:    queueStatusInput.Task,
+		Status:  queueStatusInput.Status,
+	})
+
 	return nil
 }
 
@@ -184,6 +203,13 @@ func checkIfTasksAreDone(ctx context.Context, entClient *ent.Client, input Archi
 			}
 
 			notification.SendLiveArchiveSuccessNotification(&dbItems.Channel, &dbItems.Video, &dbItems.Queue)
+			go notification.SendChannelSubscriptionNotifications(utils.SubscriptionEventArchiveCompleted, dbItems.Video.ID, "")
+			events.Publish(ctx, events.ArchiveCompleted, events.ArchiveCompletedData{
+				QueueID:     dbItems.Queue.ID,
+				VideoID:     dbItems.Video.ID,
+				ChannelID:   dbItems.Channel.ID,
+				LiveArchive: true,
+			})
 
 			// Queue task to calculate video storage usage
 			_, err = river.ClientFromContext[pgx.Tx](ctx).Insert(ctx, &UpdateVideoStorageUsage{
@@ -193,6 +219,14 @@ func checkIfTasksAreDone(ctx context.Context, entClient *ent.Client, input Archi
 				log.Error().Err(err).Msg("error queuing video storage usage update task")
 			}
 
+			// Queue task to apply playlist rules to the archived video, they are applied again once the storage usage is known
+			_, err = river.ClientFromContext[pgx.Tx](ctx).Insert(ctx, &ApplyVideoPlaylistRulesArgs{
+				VideoID: dbItems.Video.ID,
+			}, nil)
+			if err != nil {
+				log.Error().Err(err).Msg("error queuing video playlist rules task")
+			}
+
 			// Queue YouTube upload task if configured
 			youtubeConfig, err := dbItems.Channel.QueryYoutubeConfig().Only(ctx)
 			if err == nil && youtubeConfig.UploadEnabled {
@@ -221,6 +255,12 @@ func checkIfTasksAreDone(ctx context.Context, entClient *ent.Client, input Archi
 			}
 
 			notification.SendVideoArchiveSuccessNotification(&dbItems.Channel, &dbItems.Video, &dbItems.Queue)
+			go notification.SendChannelSubscriptionNotifications(utils.SubscriptionEventArchiveCompleted, dbItems.Video.ID, "")
+			events.Publish(ctx, events.ArchiveCompleted, events.ArchiveCompletedData{
+				QueueID:   dbItems.Queue.ID,
+				VideoID:   dbItems.Video.ID,
+				ChannelID: dbItems.Channel.ID,
+			})
 
 			// Queue task to calculate video storage usage
 			_, err = river.ClientFromContext[pgx.Tx](ctx).Insert(ctx, &UpdateVideoStorageUsage{
@@ -230,6 +270,14 @@ func checkIfTasksAreDone(ctx context.Context, entClient *ent.Client, input Archi
 				log.Error().Err(err).Msg("error queuing video storage usage update task")
 			}
 
+			// Queue task to apply playlist rules to the archived video, they are applied again once the storage usage is known
+			_, err = river.ClientFromContext[pgx.Tx](ctx).Insert(ctx, &ApplyVideoPlaylistRulesArgs{
+				VideoID: dbItems.Video.ID,
+			}, nil)
+			if err != nil {
+				log.Error().Err(err).Msg("error queuing video playlist rules task")
+			}
+
 			// Queue YouTube upload task if configured
 			youtubeConfig, err := dbItems.Channel.QueryYoutubeConfig().Only(ctx)
 			if err == nil && youtubeConfig.UploadEnabled {
@@ -549,6 +597,14 @@ func (w UpdateVideoStorageUsageWorker) Work(ctx context.Context, job *river.Job[
 		if err := updateVideoStorageSize(ctx, logger, store, video); err != nil {
 			return err
 		}
+
+		// Storage size is a playlist rule field so rules are applied once it is known
+		_, err = river.ClientFromContext[pgx.Tx](ctx).Insert(ctx, &ApplyVideoPlaylistRulesArgs{
+			VideoID: video.ID,
+		}, nil)
+		if err != nil {
+			logger.Error().Err(err).Msg("error queuing video playlist rules task")
+		}
 	} else {
 		const batchSize = 100
 		offset := 0
@@ -582,6 +638,45 @@ func (w UpdateVideoStorageUsageWorker) Work(ctx context.Context, job *river.Job[
 	return nil
 }
 
+// Apply playlist rules to a single video
+type ApplyVideoPlaylistRulesArgs struct {
+	VideoID uuid.UUID `json:"video_id"`
+}
+
+func (ApplyVideoPlaylistRulesArgs) Kind() string { return TaskApplyVideoPlaylistRules }
+
+func (w ApplyVideoPlaylistRulesArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		MaxAttempts: 5,
+	}
+}
+
+func (w ApplyVideoPlaylistRulesArgs) Timeout(job *river.Job[ApplyVideoPlaylistRulesArgs]) time.Duration {
+	return 5 * time.Minute
+}
+
+type ApplyVideoPlaylistRulesWorker struct {
+	river.WorkerDefaults[ApplyVideoPlaylistRulesArgs]
+}
+
+// Work adds or removes the video from playlists with rules, so new videos don't wait for the periodic playlist rules task.
+func (w ApplyVideoPlaylistRulesWorker) Work(ctx context.Context, job *river.Job[ApplyVideoPlaylistRulesArgs]) error {
+	logger := log.With().Str("task", job.Kind).Str("job_id", fmt.Sprintf("%d", job.ID)).Str("video_id", job.Args.VideoID.String()).Logger()
+	logger.Info().Msg("starting task")
+
+	store, err := StoreFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := playlist.NewService(store).ApplyVideoPlaylistRules(ctx, job.Args.VideoID); err != nil {
+		return err
+	}
+
+	logger.Info().Msg("task completed")
+	return nil
+}
+
 // Update channel storage usage
 type UpdateChannelStorageUsage struct {
 }
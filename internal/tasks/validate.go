@@ -0,0 +1,290 @@
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/riverqueue/river"
+	"github.com/rs/zerolog/log"
+	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/internal/exec"
+	"github.com/zibbp/ganymede/internal/exec/ytdlp"
+	"github.com/zibbp/ganymede/internal/notification"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// maxVideoValidationRetries is the number of times a video is re-downloaded after failing validation before the archive is marked as failed.
+const maxVideoValidationRetries = 2
+
+// minDurationTolerance is the minimum allowed difference in seconds between the expected and measured duration.
+const minDurationTolerance = 10.0
+
+// videoValidationMeasurements holds the values measured from the downloaded video.
+type videoValidationMeasurements struct {
+	PlatformDuration float64 // duration reported by the platform, 0 if unknown
+	ExpectedDuration float64 // platform duration minus muted segments, 0 if unknown
+	MeasuredDuration float64
+	HasVideo         bool
+	HasAudio         bool
+	AudioOnly        bool // video was archived in audio only quality
+}
+
+// evaluateVideoValidation returns whether the measurements are acceptable and the reason if they are not.
+func evaluateVideoValidation(m videoValidationMeasurements) (bool, string) {
+	if !m.HasAudio {
+		return false, "no audio stream found"
+	}
+	if !m.HasVideo && !m.AudioOnly {
+		return false, "no video stream found"
+	}
+	if m.MeasuredDuration <= 0 {
+		return false, "video has no duration"
+	}
+	if m.ExpectedDuration <= 0 {
+		// duration is not known ahead of time (e.g. live archives)
+		return true, ""
+	}
+
+	tolerance := math.Max(minDurationTolerance, m.ExpectedDuration*0.01)
+	if m.MeasuredDuration < m.ExpectedDuration-tolerance {
+		return false, fmt.Sprintf("video is too short: measured %.0fs, expected at least %.0fs", m.MeasuredDuration, m.ExpectedDuration-tolerance)
+	}
+	if m.PlatformDuration > 0 && m.MeasuredDuration > m.PlatformDuration+tolerance {
+		return false, fmt.Sprintf("video is too long: measured %.0fs, expected at most %.0fs", m.MeasuredDuration, m.PlatformDuration+tolerance)
+	}
+
+	return true, ""
+}
+
+// /////////////////
+// Validate Video //
+// /////////////////
+type ValidateVideoArgs struct {
+	Continue bool              `json:"continue"`
+	Input    ArchiveVideoInput `json:"input"`
+}
+
+func (ValidateVideoArgs) Kind() string { return string(utils.TaskValidateVideo) }
+
+func (args ValidateVideoArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		MaxAttempts: 5,
+		Queue:       "default",
+		Tags:        []string{"archive"},
+	}
+}
+
+func (w ValidateVideoArgs) Timeout(job *river.Job[ValidateVideoArgs]) time.Duration {
+	return 1 * time.Hour
+}
+
+type ValidateVideoWorker struct {
+	river.WorkerDefaults[ValidateVideoArgs]
+}
+
+func (w ValidateVideoWorker) Work(ctx context.Context, job *river.Job[ValidateVideoArgs]) error {
+	logger := log.With().Str("task", job.Kind).Str("job_id", fmt.Sprintf("%d", job.ID)).Logger()
+
+	// get store from context
+	store, err := StoreFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	// set queue status to running
+	err = setQueueStatus(ctx, store.Client, QueueStatusInput{
+		Status:  utils.Running,
+		QueueId: job.Args.Input.QueueId,
+		Task:    utils.TaskValidateVideo,
+	})
+	if err != nil {
+		return err
+	}
+
+	// start task heartbeat
+	go startHeartBeatForTask(ctx, HeartBeatInput{
+		TaskId: job.ID,
+		conn:   store.ConnPool,
+	})
+
+	dbItems, err := getDatabaseItems(ctx, store.Client, job.Args.Input.QueueId)
+	if err != nil {
+		return err
+	}
+
+	// the file that will be moved by the move video task
+	videoPath := dbItems.Video.TmpVideoConvertPath
+	if dbItems.Queue.LiveArchive {
+		videoPath = dbItems.Video.TmpVideoDownloadPath
+	}
+
+	measurements := videoValidationMeasurements{
+		AudioOnly: dbItems.Video.Resolution == string(utils.Audio),
+	}
+
+	// live archives do not have a platform duration
+	if !dbItems.Queue.LiveArchive {
+		mutedSegments, err := dbItems.Video.QueryMutedSegments().All(ctx)
+		if err != nil {
+			return err
+		}
+		measurements.PlatformDuration = float64(dbItems.Video.Duration)
+		measurements.ExpectedDuration = expectedVideoDuration(dbItems.Video.Duration, mutedSegments)
+	}
+
+	passed, message := false, ""
+	probeData, err := exec.GetFfprobeVideoData(ctx, videoPath)
+	if err != nil {
+		message = err.Error()
+	} else {
+		for _, stream := range probeData.Streams {
+			switch stream.CodecType {
+			case "video":
+				measurements.HasVideo = true
+			case "audio":
+				measurements.HasAudio = true
+			}
+		}
+		measurements.MeasuredDuration, _ = strconv.ParseFloat(probeData.Format.Duration, 64)
+
+		passed, message = evaluateVideoValidation(measurements)
+		if passed {
+			if err := exec.CheckVideoDecodes(ctx, videoPath); err != nil {
+				passed, message = false, err.Error()
+			}
+		}
+	}
+
+	verdict := utils.VideoValidationPassed
+	if !passed {
+		verdict = utils.VideoValidationFailed
+	}
+
+	_, err = store.Client.Queue.UpdateOneID(dbItems.Queue.ID).
+		SetVideoValidationVerdict(verdict).
+		SetVideoValidationMessage(message).
+		SetVideoValidationExpectedDuration(measurements.ExpectedDuration).
+		SetVideoValidationMeasuredDuration(measurements.MeasuredDuration).
+		SetVideoValidationHasVideo(measurements.HasVideo).
+		SetVideoValidationHasAudio(measurements.HasAudio).
+		SetVideoValidatedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !passed {
+		logger.Warn().Str("video_id", dbItems.Video.ID.String()).Str("reason", message).Msg("video failed validation")
+
+		// live archives cannot be downloaded again so keep what was recorded
+		if dbItems.Queue.LiveArchive {
+			logger.Warn().Str("video_id", dbItems.Video.ID.String()).Msg("live archive failed validation, continuing with the recorded video")
+		} else {
+			if dbItems.Queue.VideoValidationRetries >= maxVideoValidationRetries {
+				// cancel the job so river does not run the validation again, the error handler is skipped for cancelled jobs
+				if err := setQueueStatus(ctx, store.Client, QueueStatusInput{
+					Status:  utils.Failed,
+					QueueId: job.Args.Input.QueueId,
+					Task:    utils.TaskValidateVideo,
+				}); err != nil {
+					return err
+				}
+				notification.SendErrorNotification(&dbItems.Channel, &dbItems.Video, &dbItems.Queue, job.Kind)
+				return river.JobCancel(fmt.Errorf("video failed validation after %d retries: %s", dbItems.Queue.VideoValidationRetries, message))
+			}
+			return retryVideoDownload(ctx, store.Client, dbItems, job.Args)
+		}
+	}
+
+	// set queue status to completed
+	err = setQueueStatus(ctx, store.Client, QueueStatusInput{
+		Status:  utils.Success,
+		QueueId: job.Args.Input.QueueId,
+		Task:    utils.TaskValidateVideo,
+	})
+	if err != nil {
+		return err
+	}
+
+	// continue with next job
+	if job.Args.Continue {
+		client := river.ClientFromContext[pgx.Tx](ctx)
+		_, err = client.Insert(ctx, &MoveVideoArgs{
+			Continue: true,
+			Input:    job.Args.Input,
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	// check if tasks are done
+	if err := checkIfTasksAreDone(ctx, store.Client, job.Args.Input); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// expectedVideoDuration returns the platform duration minus the muted segments.
+func expectedVideoDuration(duration int, mutedSegments []*ent.MutedSegment) float64 {
+	expected := duration
+	for _, segment := range mutedSegments {
+		if segment.End > segment.Start {
+			expected -= segment.End - segment.Start
+		}
+	}
+	if expected < 0 {
+		return 0
+	}
+	return float64(expected)
+}
+
+// retryVideoDownload removes the temporary video files, resets the video tasks and queues the video download again.
+func retryVideoDownload(ctx context.Context, entClient *ent.Client, dbItems *GetDatabaseItemsResponse, args ValidateVideoArgs) error {
+	for _, path := range []string{dbItems.Video.TmpVideoDownloadPath, dbItems.Video.TmpVideoConvertPath} {
+		if path != "" && utils.FileExists(path) {
+			if err := utils.DeleteFile(path); err != nil {
+				return err
+			}
+		}
+	}
+	// partial files from the previous download must not be resumed
+	if err := ytdlp.RemovePartialFiles(exec.VideoDownloadPathNoExt(dbItems.Video)); err != nil {
+		return err
+	}
+	if dbItems.Video.TmpVideoHlsPath != "" && utils.FileExists(dbItems.Video.TmpVideoHlsPath) {
+		if err := utils.DeleteDirectory(dbItems.Video.TmpVideoHlsPath); err != nil {
+			return err
+		}
+	}
+
+	_, err := entClient.Queue.UpdateOneID(dbItems.Queue.ID).
+		AddVideoValidationRetries(1).
+		SetVideoDownloadResumeFragment(0).
+		SetVideoDownloadResumeBytes(0).
+		SetTaskVideoDownload(utils.Pending).
+		SetTaskVideoConvert(utils.Pending).
+		SetTaskVideoValidate(utils.Pending).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	client := river.ClientFromContext[pgx.Tx](ctx)
+	_, err = client.Insert(ctx, &DownloadVideoArgs{
+		Continue: args.Continue,
+		Input:    ArchiveVideoInput{QueueId: args.Input.QueueId},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	log.Info().Str("video_id", dbItems.Video.ID.String()).Msg("queued video download after failed validation")
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package tasks
+
+import (
+	"testing"
+
+	"github.com/zibbp/ganymede/ent"
+)
+
+func TestEvaluateVideoValidation(t *testing.T) {
+	tests := []struct {
+		name         string
+		measurements videoValidationMeasurements
+		expected     bool
+	}{
+		{
+			name:         "complete video",
+			measurements: videoValidationMeasurements{PlatformDuration: 3600, ExpectedDuration: 3600, MeasuredDuration: 3598, HasVideo: true, HasAudio: true},
+			expected:     true,
+		},
+		{
+			name:         "truncated video",
+			measurements: videoValidationMeasurements{PlatformDuration: 3600, ExpectedDuration: 3600, MeasuredDuration: 3400, HasVideo: true, HasAudio: true},
+			expected:     false,
+		},
+		{
+			name:         "muted segments removed",
+			measurements: videoValidationMeasurements{PlatformDuration: 3600, ExpectedDuration: 3000, MeasuredDuration: 3000, HasVideo: true, HasAudio: true},
+			expected:     true,
+		},
+		{
+			name:         "longer than platform duration",
+			measurements: videoValidationMeasurements{PlatformDuration: 3600, ExpectedDuration: 3600, MeasuredDuration: 7200, HasVideo: true, HasAudio: true},
+			expected:     false,
+		},
+		{
+			name:         "missing video stream",
+			measurements: videoValidationMeasurements{PlatformDuration: 3600, ExpectedDuration: 3600, MeasuredDuration: 3600, HasAudio: true},
+			expected:     false,
+		},
+		{
+			name:         "audio only archive",
+			measurements: videoValidationMeasurements{PlatformDuration: 3600, ExpectedDuration: 3600, MeasuredDuration: 3600, HasAudio: true, AudioOnly: true},
+			expected:     true,
+		},
+		{
+			name:         "missing audio stream",
+			measurements: videoValidationMeasurements{PlatformDuration: 3600, ExpectedDuration: 3600, MeasuredDuration: 3600, HasVideo: true},
+			expected:     false,
+		},
+		{
+			name:         "unknown expected duration",
+			measurements: videoValidationMeasurements{MeasuredDuration: 120, HasVideo: true, HasAudio: true},
+			expected:     true,
+		},
+		{
+			name:         "empty video",
+			measurements: videoValidationMeasurements{HasVideo: true, HasAudio: true},
+			expected:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			passed, message := evaluateVideoValidation(tt.measurements)
+			if passed != tt.expected {
+				t.Errorf("expected %v, got %v (%s)", tt.expected, passed, message)
+			}
+		})
+	}
+}
+
+func TestExpectedVideoDuration(t *testing.T) {
+	segments := []*ent.MutedSegment{
+		{Start: 100, End: 200},
+		{Start: 500, End: 560},
+	}
+	if got := expectedVideoDuration(1000, segments); got != 840 {
+		t.Errorf("expected 840, got %f", got)
+	}
+	if got := expectedVideoDuration(100, []*ent.MutedSegment{{Start: 0, End: 500}}); got != 0 {
+		t.Errorf("expected 0, got %f", got)
+	}
+}
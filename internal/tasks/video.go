@@ -3,13 +3,17 @@ package tasks
 import (
 	"context"
 	"fmt"
+	"path/filepath"
+	"strings"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/riverqueue/river"
 	"github.com/rs/zerolog/log"
 	"github.com/zibbp/ganymede/internal/config"
 	"github.com/zibbp/ganymede/internal/exec"
+	"github.com/zibbp/ganymede/internal/exec/ytdlp"
 	"github.com/zibbp/ganymede/internal/utils"
 )
 
@@ -66,8 +70,27 @@ func (w DownloadVideoWorker) Work(ctx context.Context, job *river.Job[DownloadVi
 		return err
 	}
 
+	// record the state left by a previous attempt, yt-dlp resumes from the partial files
+	downloadPathNoExt := exec.VideoDownloadPathNoExt(dbItems.Video)
+	resumeState, err := ytdlp.GetResumeState(downloadPathNoExt)
+	if err != nil {
+		return err
+	}
+	update := store.Client.Queue.UpdateOneID(dbItems.Queue.ID).AddVideoDownloadAttempts(1).SetVideoDownloadProgressAt(time.Now())
+	if resumeState != nil {
+		log.Info().Str("video_id", dbItems.Video.ID.String()).Int("fragment", resumeState.FragmentIndex).Int64("bytes", resumeState.PartBytes).Msg("resuming video download")
+		update = update.SetVideoDownloadResumeFragment(resumeState.FragmentIndex).SetVideoDownloadResumeBytes(resumeState.PartBytes)
+	} else {
+		update = update.SetVideoDownloadResumeFragment(0).SetVideoDownloadResumeBytes(0)
+	}
+	if _, err := update.Save(ctx); err != nil {
+		return err
+	}
+
 	// download video
-	err = exec.DownloadTwitchVideo(ctx, dbItems.Video)
+	progressReporter := startTaskProgressReporter(ctx, store.ConnPool, dbItems.Queue.ID, utils.TaskDownloadVideo)
+	err = exec.DownloadTwitchVideo(ctx, dbItems.Video, progressReporter.Report)
+	progressReporter.Stop()
 	if err != nil {
 		return err
 	}
@@ -156,9 +179,12 @@ func (w PostProcessVideoWorker) Work(ctx context.Context, job *river.Job[PostPro
 		return err
 	}
 
+	progressReporter := startTaskProgressReporter(ctx, store.ConnPool, dbItems.Queue.ID, utils.TaskPostProcessVideo)
+	defer progressReporter.Stop()
+
 	// download video non archive video
 	if !dbItems.Queue.LiveArchive {
-		err = exec.PostProcessVideo(ctx, dbItems.Video)
+		err = exec.PostProcessVideo(ctx, dbItems.Video, progressReporter.Report)
 		if err != nil {
 			return err
 		}
@@ -204,7 +230,7 @@ func (w PostProcessVideoWorker) Work(ctx context.Context, job *river.Job[PostPro
 			}
 
 			// convert to hls
-			err = exec.ConvertVideoToHLS(ctx, dbItems.Video)
+			err = exec.ConvertVideoToHLS(ctx, dbItems.Video, progressReporter.Report)
 			if err != nil {
 				return err
 			}
@@ -232,7 +258,7 @@ func (w PostProcessVideoWorker) Work(ctx context.Context, job *river.Job[PostPro
 	// continue with next job
 	if job.Args.Continue {
 		client := river.ClientFromContext[pgx.Tx](ctx)
-		_, err = client.Insert(ctx, &MoveVideoArgs{
+		_, err = client.Insert(ctx, &ValidateVideoArgs{
 			Continue: true,
 			Input:    job.Args.Input,
 		}, nil)
@@ -380,3 +406,117 @@ func (w MoveVideoWorker) Work(ctx context.Context, job *river.Job[MoveVideoArgs]
 
 	return nil
 }
+
+// ///////////////////////
+// Convert Video to HLS //
+// ///////////////////////
+// ConvertVideoToHlsArgs converts the video file of an archived video to HLS, replacing the video file.
+type ConvertVideoToHlsArgs struct {
+	VideoId string `json:"video_id"`
+}
+
+func (ConvertVideoToHlsArgs) Kind() string { return TaskConvertVideoToHls }
+
+func (args ConvertVideoToHlsArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		MaxAttempts: 1,
+		Queue:       QueueVideoPostProcess,
+	}
+}
+
+func (w ConvertVideoToHlsArgs) Timeout(job *river.Job[ConvertVideoToHlsArgs]) time.Duration {
+	return 49 * time.Hour
+}
+
+type ConvertVideoToHlsWorker struct {
+	river.WorkerDefaults[ConvertVideoToHlsArgs]
+}
+
+func (w ConvertVideoToHlsWorker) Work(ctx context.Context, job *river.Job[ConvertVideoToHlsArgs]) error {
+	logger := log.With().Str("task", job.Kind).Str("job_id", fmt.Sprintf("%d", job.ID)).Logger()
+	// get store from context
+	store, err := StoreFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	// start task heartbeat
+	go startHeartBeatForTask(ctx, HeartBeatInput{
+		TaskId: job.ID,
+		conn:   store.ConnPool,
+	})
+
+	videoUUID, err := uuid.Parse(job.Args.VideoId)
+	if err != nil {
+		return err
+	}
+
+	video, err := store.Client.Vod.Get(ctx, videoUUID)
+	if err != nil {
+		return err
+	}
+	if video.VideoHlsPath != "" {
+		logger.Info().Str("video_id", video.ID.String()).Msg("video is already HLS")
+		return nil
+	}
+	if !utils.FileExists(video.VideoPath) {
+		return fmt.Errorf("video file %s does not exist", video.VideoPath)
+	}
+
+	// use the same names as videos archived as HLS
+	fileName := video.FileName
+	if fileName == "" {
+		fileName = strings.TrimSuffix(strings.TrimSuffix(filepath.Base(video.VideoPath), filepath.Ext(video.VideoPath)), "-video")
+	}
+	hlsPath := filepath.Join(filepath.Dir(video.VideoPath), fmt.Sprintf("%s-video_hls", fileName))
+
+	env := config.GetEnvConfig()
+	convertVideo := *video
+	convertVideo.TmpVideoConvertPath = video.VideoPath
+	convertVideo.TmpVideoHlsPath = fmt.Sprintf("%s/%s_%s-video_hls0", env.TempDir, video.ExtID, uuid.New())
+	if err := utils.CreateDirectory(convertVideo.TmpVideoHlsPath); err != nil {
+		return err
+	}
+	defer func() {
+		if utils.FileExists(convertVideo.TmpVideoHlsPath) {
+			if err := utils.DeleteDirectory(convertVideo.TmpVideoHlsPath); err != nil {
+				logger.Warn().Err(err).Msg("error deleting temp hls directory")
+			}
+		}
+	}()
+
+	logger.Info().Str("video_id", video.ID.String()).Msg("converting video to hls")
+
+	if err := exec.ConvertVideoToHLS(ctx, convertVideo, nil); err != nil {
+		return err
+	}
+
+	if err := utils.MoveDirectory(ctx, convertVideo.TmpVideoHlsPath, hlsPath); err != nil {
+		return err
+	}
+
+	_, err = store.Client.Vod.UpdateOneID(video.ID).
+		SetVideoHlsPath(hlsPath).
+		SetVideoPath(filepath.Join(hlsPath, fmt.Sprintf("%s-video.m3u8", video.ExtID))).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	// the playlist now points to the segments, remove the original video
+	if err := utils.DeleteFile(video.VideoPath); err != nil {
+		logger.Warn().Err(err).Str("path", video.VideoPath).Msg("error deleting converted video")
+	}
+
+	// Queue task to calculate video storage usage
+	_, err = river.ClientFromContext[pgx.Tx](ctx).Insert(ctx, &UpdateVideoStorageUsage{
+		VideoID: &video.ID,
+	}, nil)
+	if err != nil {
+		logger.Error().Err(err).Msg("error queuing video storage usage update task")
+	}
+
+	logger.Info().Str("video_id", video.ID.String()).Msg("video converted to hls")
+
+	return nil
+}
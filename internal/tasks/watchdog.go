@@ -10,6 +10,7 @@ import (
 	"github.com/riverqueue/river"
 	"github.com/riverqueue/river/rivertype"
 	"github.com/rs/zerolog/log"
+	"github.com/zibbp/ganymede/internal/notification"
 	"github.com/zibbp/ganymede/internal/utils"
 )
 
@@ -97,6 +98,12 @@ func runWatchdog(ctx context.Context, riverClient *river.Client[pgx.Tx]) error {
 					}
 					logger.Info().Str("job_id", fmt.Sprintf("%d", job.ID)).Msg("job set to failed and deleted")
 
+					if dbItems, err := getDatabaseItems(ctx, store.Client, args.Input.QueueId); err == nil {
+						notification.SendWatchdogFailedNotification(&dbItems.Channel, &dbItems.Video, &dbItems.Queue, job.Kind)
+					} else {
+						logger.Error().Err(err).Str("job_id", fmt.Sprintf("%d", job.ID)).Msg("error getting database items for watchdog notification")
+					}
+
 					// attempt to finish archiving live video
 					// if job was live video download then proceed with next jobs
 					if job.Kind == string(utils.TaskDownloadLiveVideo) {
@@ -12,6 +12,7 @@ import (
 	"github.com/riverqueue/river/riverdriver/riverpgxv5"
 	"github.com/robfig/cron/v3"
 	"github.com/rs/zerolog/log"
+	"github.com/zibbp/ganymede/internal/bulk"
 	"github.com/zibbp/ganymede/internal/config"
 	"github.com/zibbp/ganymede/internal/database"
 	"github.com/zibbp/ganymede/internal/live"
@@ -61,6 +62,9 @@ func NewRiverWorker(input RiverWorkerInput) (*RiverWorkerClient, error) {
 	if err := river.AddWorkerSafely(workers, &tasks.PostProcessVideoWorker{}); err != nil {
 		return rc, err
 	}
+	if err := river.AddWorkerSafely(workers, &tasks.ValidateVideoWorker{}); err != nil {
+		return rc, err
+	}
 	if err := river.AddWorkerSafely(workers, &tasks.MoveVideoWorker{}); err != nil {
 		return rc, err
 	}
@@ -86,6 +90,12 @@ func NewRiverWorker(input RiverWorkerInput) (*RiverWorkerClient, error) {
 	if err := river.AddWorkerSafely(workers, &tasks_periodic.CheckChannelsForNewVideosWorker{}); err != nil {
 		return rc, err
 	}
+	if err := river.AddWorkerSafely(workers, &tasks_periodic.PruneAuditLogsWorker{}); err != nil {
+		return rc, err
+	}
+	if err := river.AddWorkerSafely(workers, &tasks_periodic.PurgeTrashWorker{}); err != nil {
+		return rc, err
+	}
 	if err := river.AddWorkerSafely(workers, &tasks_periodic.PruneVideosWorker{}); err != nil {
 		return rc, err
 	}
@@ -104,6 +114,15 @@ func NewRiverWorker(input RiverWorkerInput) (*RiverWorkerClient, error) {
 	if err := river.AddWorkerSafely(workers, &tasks.UpdateStreamVideoIdWorker{}); err != nil {
 		return rc, err
 	}
+	if err := river.AddWorkerSafely(workers, &tasks.RerenderChatWorker{}); err != nil {
+		return rc, err
+	}
+	if err := river.AddWorkerSafely(workers, &tasks.ConvertVideoToHlsWorker{}); err != nil {
+		return rc, err
+	}
+	if err := river.AddWorkerSafely(workers, &bulk.BulkVodOperationWorker{}); err != nil {
+		return rc, err
+	}
 	if err := river.AddWorkerSafely(workers, &tasks.GenerateStaticThubmnailWorker{}); err != nil {
 		return rc, err
 	}
@@ -128,9 +147,21 @@ func NewRiverWorker(input RiverWorkerInput) (*RiverWorkerClient, error) {
 	if err := river.AddWorkerSafely(workers, &tasks_periodic.ProcessPlaylistVideoRulesWorker{}); err != nil {
 		return rc, err
 	}
+	if err := river.AddWorkerSafely(workers, &tasks.ApplyVideoPlaylistRulesWorker{}); err != nil {
+		return rc, err
+	}
 	if err := river.AddWorkerSafely(workers, &tasks.UploadToYouTubeWorker{}); err != nil {
 		return rc, err
 	}
+	if err := river.AddWorkerSafely(workers, &tasks_periodic.CheckDiskSpaceWorker{}); err != nil {
+		return rc, err
+	}
+	if err := river.AddWorkerSafely(workers, &tasks_periodic.CheckCredentialsWorker{}); err != nil {
+		return rc, err
+	}
+	if err := river.AddWorkerSafely(workers, &tasks_periodic.DiskGuardWorker{}); err != nil {
+		return rc, err
+	}
 
 	rc.Ctx = context.Background()
 
@@ -259,6 +290,26 @@ func (rc *RiverWorkerClient) GetPeriodicTasks(liveService *live.Service) ([]*riv
 			&river.PeriodicJobOpts{RunOnStart: false},
 		),
 
+		// prune audit logs
+		// runs once a day at midnight
+		river.NewPeriodicJob(
+			midnightCron,
+			func() (river.JobArgs, *river.InsertOpts) {
+				return tasks_periodic.PruneAuditLogsArgs{}, nil
+			},
+			&river.PeriodicJobOpts{RunOnStart: false},
+		),
+
+		// purge trash
+		// runs every hour
+		river.NewPeriodicJob(
+			river.PeriodicInterval(1*time.Hour),
+			func() (river.JobArgs, *river.InsertOpts) {
+				return tasks_periodic.PurgeTrashArgs{}, nil
+			},
+			&river.PeriodicJobOpts{RunOnStart: false},
+		),
+
 		// import categories
 		// runs once a day at midnight
 		river.NewPeriodicJob(
@@ -308,6 +359,36 @@ func (rc *RiverWorkerClient) GetPeriodicTasks(liveService *live.Service) ([]*riv
 			},
 			&river.PeriodicJobOpts{RunOnStart: false},
 		),
+
+		// check free disk space
+		// runs every 5 minutes
+		river.NewPeriodicJob(
+			river.PeriodicInterval(5*time.Minute),
+			func() (river.JobArgs, *river.InsertOpts) {
+				return tasks_periodic.CheckDiskSpaceArgs{}, nil
+			},
+			&river.PeriodicJobOpts{RunOnStart: true},
+		),
+
+		// pause archiving when disk space is low
+		// runs every minute
+		river.NewPeriodicJob(
+			river.PeriodicInterval(1*time.Minute),
+			func() (river.JobArgs, *river.InsertOpts) {
+				return tasks_periodic.DiskGuardArgs{}, nil
+			},
+			&river.PeriodicJobOpts{RunOnStart: true},
+		),
+
+		// check twitch token and youtube credentials
+		// runs every hour
+		river.NewPeriodicJob(
+			river.PeriodicInterval(1*time.Hour),
+			func() (river.JobArgs, *river.InsertOpts) {
+				return tasks_periodic.CheckCredentialsArgs{}, nil
+			},
+			&river.PeriodicJobOpts{RunOnStart: true},
+		),
 	}
 
 	// check jwks
@@ -0,0 +1,153 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/zibbp/ganymede/internal/acl"
+	"github.com/zibbp/ganymede/internal/channel"
+	"github.com/zibbp/ganymede/internal/playlist"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+type SetAccessRequest struct {
+	Visibility utils.Visibility `json:"visibility" validate:"required,oneof=public authenticated restricted"`
+	UserIDs    []uuid.UUID      `json:"user_ids"`
+	Groups     []string         `json:"groups" validate:"dive,required"`
+}
+
+func (r SetAccessRequest) access() acl.Access {
+	return acl.Access{Visibility: r.Visibility, UserIDs: r.UserIDs, Groups: r.Groups}
+}
+
+// GetChannelAccess godoc
+//
+//	@Summary		Get channel access
+//	@Description	Returns the channel with its visibility and the users allowed to view it
+//	@Tags			channel
+//	@Produce		json
+//	@Param			id	path		string	true	"Channel ID"
+//	@Success		200	{object}	ent.Channel
+//	@Failure		400	{object}	utils.ErrorResponse
+//	@Failure		404	{object}	utils.ErrorResponse
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/channel/{id}/access [get]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) GetChannelAccess(c echo.Context) error {
+	cUUID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	cha, err := h.Service.ChannelService.GetChannelAccess(c.Request().Context(), cUUID)
+	if err != nil {
+		if errors.Is(err, channel.ErrChannelNotFound) {
+			return ErrorResponse(c, http.StatusNotFound, err.Error())
+		}
+		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+	}
+	return SuccessResponse(c, cha, "channel access")
+}
+
+// SetChannelAccess godoc
+//
+//	@Summary		Set channel access
+//	@Description	Set who can view the channel and its vods. Restricted channels can only be viewed by the listed users and groups, editors and admins can view every channel.
+//	@Tags			channel
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string				true	"Channel ID"
+//	@Param			body	body		SetAccessRequest	true	"Access"
+//	@Success		200		{object}	ent.Channel
+//	@Failure		400		{object}	utils.ErrorResponse
+//	@Failure		404		{object}	utils.ErrorResponse
+//	@Failure		500		{object}	utils.ErrorResponse
+//	@Router			/channel/{id}/access [put]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) SetChannelAccess(c echo.Context) error {
+	cUUID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	body := new(SetAccessRequest)
+	if err := c.Bind(body); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	if err := c.Validate(body); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	cha, err := h.Service.ChannelService.SetChannelAccess(c.Request().Context(), cUUID, body.access())
+	if err != nil {
+		if errors.Is(err, channel.ErrChannelNotFound) {
+			return ErrorResponse(c, http.StatusNotFound, err.Error())
+		}
+		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+	}
+	return SuccessResponse(c, cha, "channel access updated")
+}
+
+// GetPlaylistAccess godoc
+//
+//	@Summary		Get playlist access
+//	@Description	Returns the playlist with its visibility and the users allowed to view it
+//	@Tags			Playlist
+//	@Produce		json
+//	@Param			id	path		string	true	"Playlist ID"
+//	@Success		200	{object}	ent.Playlist
+//	@Failure		400	{object}	utils.ErrorResponse
+//	@Failure		404	{object}	utils.ErrorResponse
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/playlist/{id}/access [get]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) GetPlaylistAccess(c echo.Context) error {
+	pUUID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	p, err := h.Service.PlaylistService.GetPlaylistAccess(c.Request().Context(), pUUID)
+	if err != nil {
+		if errors.Is(err, playlist.ErrPlaylistNotFound) {
+			return ErrorResponse(c, http.StatusNotFound, err.Error())
+		}
+		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+	}
+	return SuccessResponse(c, p, "playlist access")
+}
+
+// SetPlaylistAccess godoc
+//
+//	@Summary		Set playlist access
+//	@Description	Set who can view the playlist. Vods in the playlist keep the visibility of their channel.
+//	@Tags			Playlist
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string				true	"Playlist ID"
+//	@Param			body	body		SetAccessRequest	true	"Access"
+//	@Success		200		{object}	ent.Playlist
+//	@Failure		400		{object}	utils.ErrorResponse
+//	@Failure		404		{object}	utils.ErrorResponse
+//	@Failure		500		{object}	utils.ErrorResponse
+//	@Router			/playlist/{id}/access [put]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) SetPlaylistAccess(c echo.Context) error {
+	pUUID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	body := new(SetAccessRequest)
+	if err := c.Bind(body); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	if err := c.Validate(body); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	p, err := h.Service.PlaylistService.SetPlaylistAccess(c.Request().Context(), pUUID, body.access())
+	if err != nil {
+		if errors.Is(err, playlist.ErrPlaylistNotFound) {
+			return ErrorResponse(c, http.StatusNotFound, err.Error())
+		}
+		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+	}
+	return SuccessResponse(c, p, "playlist access updated")
+}
@@ -2,6 +2,7 @@ package http
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"regexp"
@@ -13,6 +14,8 @@ import (
 	"github.com/zibbp/ganymede/ent"
 	"github.com/zibbp/ganymede/internal/archive"
 	"github.com/zibbp/ganymede/internal/config"
+	"github.com/zibbp/ganymede/internal/diskguard"
+	"github.com/zibbp/ganymede/internal/trash"
 	"github.com/zibbp/ganymede/internal/utils"
 )
 
@@ -60,6 +63,7 @@ func CheckIDType(id string) string {
 //	@Param			channel	body		ArchiveChannelRequest	true	"Channel"
 //	@Success		200		{object}	ent.Channel
 //	@Failure		400		{object}	utils.ErrorResponse
+//	@Failure		409		{object}	utils.ErrorResponse
 //	@Failure		500		{object}	utils.ErrorResponse
 //	@Router			/archive/channel [post]
 //	@Security		ApiKeyCookieAuth
@@ -73,7 +77,7 @@ func (h *Handler) ArchiveChannel(c echo.Context) error {
 	}
 	channel, err := h.Service.ArchiveService.ArchiveChannel(c.Request().Context(), body.ChannelName)
 	if err != nil {
-		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return ErrorResponse(c, archiveErrorStatus(err), err.Error())
 	}
 	return SuccessResponse(c, channel, "twitch channel created")
 }
@@ -88,6 +92,7 @@ func (h *Handler) ArchiveChannel(c echo.Context) error {
 //	@Param			vod	body		ArchiveVodRequest	true	"Vod"
 //	@Success		200	{object}	archive.TwitchVodResponse
 //	@Failure		400	{object}	utils.ErrorResponse
+//	@Failure		409	{object}	utils.ErrorResponse
 //	@Failure		500	{object}	utils.ErrorResponse
 //	@Router			/archive/vod [post]
 //	@Security		ApiKeyCookieAuth
@@ -125,7 +130,7 @@ func (h *Handler) ArchiveVideo(c echo.Context) error {
 			RenderChat:  body.RenderChat,
 		})
 		if err != nil {
-			return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+			return ErrorResponse(c, archiveErrorStatus(err), err.Error())
 		}
 	} else if body.VideoId != "" {
 		idType := CheckIDType(body.VideoId)
@@ -139,7 +144,7 @@ func (h *Handler) ArchiveVideo(c echo.Context) error {
 				RenderChat:  body.RenderChat,
 			})
 			if err != nil {
-				return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+				return ErrorResponse(c, archiveErrorStatus(err), err.Error())
 			}
 
 		case "alphanumeric":
@@ -150,7 +155,7 @@ func (h *Handler) ArchiveVideo(c echo.Context) error {
 				RenderChat:  body.RenderChat,
 			})
 			if err != nil {
-				return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+				return ErrorResponse(c, archiveErrorStatus(err), err.Error())
 			}
 
 		default:
@@ -162,6 +167,17 @@ func (h *Handler) ArchiveVideo(c echo.Context) error {
 	return SuccessResponse(c, archiveResponse, "archive started")
 }
 
+// archiveErrorStatus returns the status code of an archive error.
+func archiveErrorStatus(err error) int {
+	if errors.Is(err, diskguard.ErrDiskSpaceLow) {
+		return http.StatusInsufficientStorage
+	}
+	if errors.Is(err, trash.ErrVodInTrash) || errors.Is(err, trash.ErrChannelInTrash) {
+		return http.StatusConflict
+	}
+	return http.StatusInternalServerError
+}
+
 // debug route to test converting chat files
 func (h *Handler) ConvertTwitchChat(c echo.Context) error {
 	type Body struct {
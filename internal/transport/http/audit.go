@@ -0,0 +1,89 @@
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/zibbp/ganymede/internal/audit"
+	"github.com/zibbp/ganymede/internal/database"
+)
+
+type AuditService interface {
+	GetAuditLogs(ctx context.Context, filter audit.Filter) (audit.Pagination, error)
+}
+
+// GetAuditLogs godoc
+//
+//	@Summary		Get audit log
+//	@Description	Get audit log entries of administrative and destructive changes, newest first
+//	@Tags			admin
+//	@Produce		json
+//	@Param			limit			query		integer	false	"Limit, defaults to 50"
+//	@Param			offset			query		integer	false	"Offset"
+//	@Param			action			query		string	false	"Action, e.g. vod.delete"
+//	@Param			resource_type	query		string	false	"Resource type"
+//	@Param			resource_id		query		string	false	"Resource ID"
+//	@Param			actor_id		query		string	false	"User ID of the actor"
+//	@Param			from			query		string	false	"Entries at or after the time (RFC 3339)"
+//	@Param			to				query		string	false	"Entries at or before the time (RFC 3339)"
+//	@Success		200				{object}	audit.Pagination
+//	@Failure		400				{object}	utils.ErrorResponse
+//	@Failure		500				{object}	utils.ErrorResponse
+//	@Router			/admin/audit-log [get]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) GetAuditLogs(c echo.Context) error {
+	filter := audit.Filter{
+		Action:       c.QueryParam("action"),
+		ResourceType: c.QueryParam("resource_type"),
+		ResourceID:   c.QueryParam("resource_id"),
+		Limit:        50,
+	}
+
+	var err error
+	if limit := c.QueryParam("limit"); limit != "" {
+		filter.Limit, err = strconv.Atoi(limit)
+		if err != nil || filter.Limit < 1 || filter.Limit > 500 {
+			return ErrorResponse(c, http.StatusBadRequest, "limit must be between 1 and 500")
+		}
+	}
+	if offset := c.QueryParam("offset"); offset != "" {
+		filter.Offset, err = strconv.Atoi(offset)
+		if err != nil || filter.Offset < 0 {
+			return ErrorResponse(c, http.StatusBadRequest, "invalid offset")
+		}
+	}
+	if actorID := c.QueryParam("actor_id"); actorID != "" {
+		filter.ActorID, err = uuid.Parse(actorID)
+		if err != nil {
+			return ErrorResponse(c, http.StatusBadRequest, err.Error())
+		}
+	}
+	if from := c.QueryParam("from"); from != "" {
+		filter.From, err = time.Parse(time.RFC3339, from)
+		if err != nil {
+			return ErrorResponse(c, http.StatusBadRequest, fmt.Errorf("invalid from: %w", err).Error())
+		}
+	}
+	if to := c.QueryParam("to"); to != "" {
+		filter.To, err = time.Parse(time.RFC3339, to)
+		if err != nil {
+			return ErrorResponse(c, http.StatusBadRequest, fmt.Errorf("invalid to: %w", err).Error())
+		}
+	}
+
+	logs, err := h.Service.AuditService.GetAuditLogs(c.Request().Context(), filter)
+	if err != nil {
+		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+	}
+	return SuccessResponse(c, logs, "audit log")
+}
+
+// recordAudit writes the change to the audit log as made by the user of the request.
+func recordAudit(c echo.Context, entry audit.Entry) {
+	audit.Record(c.Request().Context(), database.DB(), entry)
+}
@@ -2,13 +2,19 @@ package http
 
 import (
 	"context"
+	"errors"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
 	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/internal/auth"
 	"github.com/zibbp/ganymede/internal/config"
 	"github.com/zibbp/ganymede/internal/user"
+	"github.com/zibbp/ganymede/internal/utils"
 )
 
 type AuthService interface {
@@ -17,6 +23,24 @@ type AuthService interface {
 	ChangePassword(ctx context.Context, userId uuid.UUID, oldPassword, newPassword string) error
 	OAuthRedirect(c echo.Context) error
 	OAuthCallback(c echo.Context) (*ent.User, error)
+	GetAPITokens(ctx context.Context, userID uuid.UUID) ([]*ent.ApiToken, error)
+	CreateAPIToken(ctx context.Context, u *ent.User, input auth.APIToken) (*ent.ApiToken, string, error)
+	DeleteAPIToken(ctx context.Context, userID uuid.UUID, tokenID uuid.UUID) error
+	DeleteUserAPITokens(ctx context.Context, userID uuid.UUID) (int, error)
+	EnrollTOTP(ctx context.Context, u *ent.User) (*auth.TOTPEnrollment, error)
+	EnableTOTP(ctx context.Context, u *ent.User, code string) ([]string, error)
+	DisableTOTP(ctx context.Context, u *ent.User, code string) error
+	RegenerateRecoveryCodes(ctx context.Context, u *ent.User, code string) ([]string, error)
+	VerifyTwoFactor(ctx context.Context, userID uuid.UUID, code string) (*ent.User, error)
+	ResetTOTP(ctx context.Context, userID uuid.UUID) error
+	StartLoginAttempt(ctx context.Context, ip string, username string) error
+	RecordLoginFailure(ctx context.Context, ip string, username string) error
+	ForgiveLoginAttempt(ctx context.Context, ip string) error
+	ResetLoginThrottle(ctx context.Context, username string) error
+	CheckRegistrationThrottle(ctx context.Context, ip string) error
+	RecordRegistration(ctx context.Context, ip string) error
+	GetLoginThrottles(ctx context.Context) ([]*ent.LoginThrottle, error)
+	DeleteLoginThrottle(ctx context.Context, id uuid.UUID) error
 }
 
 type RegisterRequest struct {
@@ -29,6 +53,17 @@ type LoginRequest struct {
 	Password string `json:"password" validate:"required"`
 }
 
+type CreateAPITokenRequest struct {
+	Name      string     `json:"name" validate:"required,max=100"`
+	Role      utils.Role `json:"role" validate:"omitempty,oneof=admin editor archiver user"`
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+type CreateAPITokenResponse struct {
+	*ent.ApiToken
+	Token string `json:"token"`
+}
+
 type ChangePasswordRequest struct {
 	OldPassword        string `json:"old_password" validate:"required"`
 	NewPassword        string `json:"new_password" validate:"required,min=8"`
@@ -46,6 +81,7 @@ type ChangePasswordRequest struct {
 //	@Success		200			{object}	ent.User
 //	@Failure		400			{object}	utils.ErrorResponse
 //	@Failure		403			{object}	utils.ErrorResponse
+//	@Failure		429			{object}	utils.ErrorResponse
 //	@Failure		500			{object}	utils.ErrorResponse
 //	@Router			/auth/register [post]
 func (h *Handler) Register(c echo.Context) error {
@@ -57,6 +93,16 @@ func (h *Handler) Register(c echo.Context) error {
 		return ErrorResponse(c, http.StatusBadRequest, err.Error())
 	}
 
+	// limit sign-ups per IP address to stop bot sign-up floods
+	if config.Get().RegistrationEnabled {
+		if err := h.Service.AuthService.CheckRegistrationThrottle(c.Request().Context(), c.RealIP()); err != nil {
+			return throttleErrorResponse(c, err)
+		}
+		if err := h.Service.AuthService.RecordRegistration(c.Request().Context(), c.RealIP()); err != nil {
+			log.Error().Err(err).Msg("error recording registration attempt")
+		}
+	}
+
 	userDto := user.User{
 		Username: rr.Username,
 		Password: rr.Password,
@@ -72,7 +118,7 @@ func (h *Handler) Register(c echo.Context) error {
 // Login godoc
 //
 //	@Summary		Login a user
-//	@Description	Login a user (sets access-token and refresh-token cookies). Access token lasts for 1 hour. Refresh token lasts for 1 month.
+//	@Description	Login a user (sets access-token and refresh-token cookies). Access token lasts for 1 hour. Refresh token lasts for 1 month. Users with two-factor authentication get two_factor_required and finish logging in with /auth/login/2fa.
 //	@Tags			auth
 //	@Accept			json
 //	@Produce		json
@@ -80,6 +126,7 @@ func (h *Handler) Register(c echo.Context) error {
 //	@Success		200		{object}	ent.User
 //	@Failure		400		{object}	utils.ErrorResponse
 //	@Failure		401		{object}	utils.ErrorResponse
+//	@Failure		429		{object}	utils.ErrorResponse
 //	@Failure		500		{object}	utils.ErrorResponse
 //	@Router			/auth/login [post]
 func (h *Handler) Login(c echo.Context) error {
@@ -91,6 +138,10 @@ func (h *Handler) Login(c echo.Context) error {
 		return ErrorResponse(c, http.StatusBadRequest, err.Error())
 	}
 
+	if err := h.Service.AuthService.StartLoginAttempt(c.Request().Context(), c.RealIP(), lr.Username); err != nil {
+		return throttleErrorResponse(c, err)
+	}
+
 	userDto := user.User{
 		Username: lr.Username,
 		Password: lr.Password,
@@ -98,14 +149,34 @@ func (h *Handler) Login(c echo.Context) error {
 
 	u, err := h.Service.AuthService.Login(c.Request().Context(), userDto)
 	if err != nil {
+		if err := h.Service.AuthService.RecordLoginFailure(c.Request().Context(), c.RealIP(), lr.Username); err != nil {
+			log.Error().Err(err).Msg("error recording failed login")
+		}
+		if errors.Is(err, auth.ErrNoMappedRole) {
+			return ErrorResponse(c, http.StatusForbidden, err.Error())
+		}
 		return ErrorResponse(c, http.StatusBadRequest, err.Error())
 	}
 
-	if err := h.SessionManager.RenewToken(c.Request().Context()); err != nil {
-		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+	if err := h.Service.AuthService.ForgiveLoginAttempt(c.Request().Context(), c.RealIP()); err != nil {
+		log.Error().Err(err).Msg("error updating login throttle")
 	}
 
-	h.SessionManager.Put(c.Request().Context(), "user_id", u.ID.String())
+	// the username stays throttled until the second factor is verified
+	if u.TotpEnabled {
+		if err := startTwoFactorLogin(c, u.ID, lr.Username); err != nil {
+			return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+		}
+		return SuccessResponse(c, TwoFactorLoginResponse{TwoFactorRequired: true}, "two-factor code required")
+	}
+
+	if err := h.Service.AuthService.ResetLoginThrottle(c.Request().Context(), lr.Username); err != nil {
+		log.Error().Err(err).Msg("error resetting login throttle")
+	}
+
+	if err := startSession(c, u.ID); err != nil {
+		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+	}
 
 	return SuccessResponse(c, u, "successfully logged in")
 }
@@ -165,7 +236,7 @@ func (h *Handler) Me(c echo.Context) error {
 // ChangePassword godoc
 //
 //	@Summary		Change password
-//	@Description	Change password
+//	@Description	Change password, other sessions and API tokens of the user are revoked
 //	@Tags			auth
 //	@Accept			json
 //	@Produce		json
@@ -192,6 +263,14 @@ func (h *Handler) ChangePassword(c echo.Context) error {
 		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
 	}
 
+	// log out other devices using the old password
+	if _, err := destroyOtherUserSessions(c.Request().Context(), user.ID); err != nil {
+		return ErrorResponse(c, http.StatusInternalServerError, "password changed but other sessions could not be revoked")
+	}
+	if _, err := h.Service.AuthService.DeleteUserAPITokens(c.Request().Context(), user.ID); err != nil {
+		return ErrorResponse(c, http.StatusInternalServerError, "password changed but api tokens could not be revoked")
+	}
+
 	return SuccessResponse(c, "", "password changed")
 }
 
@@ -205,16 +284,183 @@ func (h *Handler) ChangePassword(c echo.Context) error {
 //	@Success		200	{object}	string
 //	@Failure		400	{object}	utils.ErrorResponse
 //	@Failure		401	{object}	utils.ErrorResponse
+//	@Failure		403	{object}	utils.ErrorResponse
 //	@Failure		500	{object}	utils.ErrorResponse
 //	@Router			/auth/oauth/callback [get]
 func (h *Handler) OAuthCallback(c echo.Context) error {
 	user, err := h.Service.AuthService.OAuthCallback(c)
 	if err != nil {
+		if errors.Is(err, auth.ErrNoMappedRole) {
+			return ErrorResponse(c, http.StatusForbidden, err.Error())
+		}
 		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
 	}
 
-	h.SessionManager.Put(c.Request().Context(), "user_id", user.ID.String())
+	if err := startSession(c, user.ID); err != nil {
+		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+	}
 
 	// redirect to frontend /oauth page to set state in frontend
 	return c.Redirect(http.StatusFound, "/")
 }
+
+// GetAPITokens godoc
+//
+//	@Summary		Get API tokens
+//	@Description	Get the API tokens of the current user
+//	@Tags			auth
+//	@Produce		json
+//	@Success		200	{object}	[]ent.ApiToken
+//	@Failure		401	{object}	utils.ErrorResponse
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/auth/tokens [get]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) GetAPITokens(c echo.Context) error {
+	user := userFromContext(c)
+
+	tokens, err := h.Service.AuthService.GetAPITokens(c.Request().Context(), user.ID)
+	if err != nil {
+		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+	}
+
+	return SuccessResponse(c, tokens, "api tokens")
+}
+
+// CreateAPIToken godoc
+//
+//	@Summary		Create API token
+//	@Description	Create an API token for the current user. The token is only returned once and is sent as "Authorization: Bearer <token>".
+//	@Tags			auth
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body		CreateAPITokenRequest	true	"API token"
+//	@Success		200		{object}	CreateAPITokenResponse
+//	@Failure		400		{object}	utils.ErrorResponse
+//	@Failure		401		{object}	utils.ErrorResponse
+//	@Failure		403		{object}	utils.ErrorResponse
+//	@Failure		500		{object}	utils.ErrorResponse
+//	@Router			/auth/tokens [post]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) CreateAPIToken(c echo.Context) error {
+	user := userFromContext(c)
+
+	// a token must not be able to create tokens that outlive it
+	if c.Get("auth_method") == "token" {
+		return ErrorResponse(c, http.StatusForbidden, "api tokens cannot be managed with an api token")
+	}
+
+	body := new(CreateAPITokenRequest)
+	if err := c.Bind(body); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	if err := c.Validate(body); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	apiToken, token, err := h.Service.AuthService.CreateAPIToken(c.Request().Context(), user, auth.APIToken{
+		Name:      body.Name,
+		Role:      body.Role,
+		ExpiresAt: body.ExpiresAt,
+	})
+	if err != nil {
+		if errors.Is(err, auth.ErrAPITokenRole) {
+			return ErrorResponse(c, http.StatusForbidden, err.Error())
+		}
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	return SuccessResponse(c, CreateAPITokenResponse{ApiToken: apiToken, Token: token}, "api token created")
+}
+
+// DeleteAPIToken godoc
+//
+//	@Summary		Revoke API token
+//	@Description	Revoke an API token of the current user
+//	@Tags			auth
+//	@Produce		json
+//	@Param			id	path		string	true	"API token ID"
+//	@Success		200	{object}	string
+//	@Failure		400	{object}	utils.ErrorResponse
+//	@Failure		401	{object}	utils.ErrorResponse
+//	@Failure		404	{object}	utils.ErrorResponse
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/auth/tokens/{id} [delete]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) DeleteAPIToken(c echo.Context) error {
+	user := userFromContext(c)
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "invalid id")
+	}
+
+	if err := h.Service.AuthService.DeleteAPIToken(c.Request().Context(), user.ID, id); err != nil {
+		if errors.Is(err, auth.ErrAPITokenNotFound) {
+			return ErrorResponse(c, http.StatusNotFound, err.Error())
+		}
+		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+	}
+
+	return SuccessResponse(c, "", "api token revoked")
+}
+
+// GetLoginThrottles godoc
+//
+//	@Summary		Get login throttles
+//	@Description	Get IP addresses and usernames with recent failed logins or registrations and whether they are locked out
+//	@Tags			auth
+//	@Produce		json
+//	@Success		200	{object}	[]ent.LoginThrottle
+//	@Failure		401	{object}	utils.ErrorResponse
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/auth/lockouts [get]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) GetLoginThrottles(c echo.Context) error {
+	throttles, err := h.Service.AuthService.GetLoginThrottles(c.Request().Context())
+	if err != nil {
+		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+	}
+
+	return SuccessResponse(c, throttles, "login throttles")
+}
+
+// DeleteLoginThrottle godoc
+//
+//	@Summary		Unlock login
+//	@Description	Unlock an IP address or username and forget its failed logins
+//	@Tags			auth
+//	@Produce		json
+//	@Param			id	path		string	true	"Login throttle ID"
+//	@Success		200	{object}	string
+//	@Failure		400	{object}	utils.ErrorResponse
+//	@Failure		401	{object}	utils.ErrorResponse
+//	@Failure		404	{object}	utils.ErrorResponse
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/auth/lockouts/{id} [delete]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) DeleteLoginThrottle(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "invalid id")
+	}
+
+	if err := h.Service.AuthService.DeleteLoginThrottle(c.Request().Context(), id); err != nil {
+		if ent.IsNotFound(err) {
+			return ErrorResponse(c, http.StatusNotFound, "login throttle not found")
+		}
+		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+	}
+
+	return SuccessResponse(c, "", "unlocked")
+}
+
+// throttleErrorResponse responds with 429 and a Retry-After header to throttled logins and registrations.
+func throttleErrorResponse(c echo.Context, err error) error {
+	var throttleErr *auth.ThrottleError
+	if errors.As(err, &throttleErr) {
+		retryAfter := int(throttleErr.RetryAfter.Seconds()) + 1
+		c.Response().Header().Set("Retry-After", strconv.Itoa(retryAfter))
+		return ErrorResponse(c, http.StatusTooManyRequests, err.Error())
+	}
+	return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/labstack/echo/v4"
 	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/internal/audit"
 )
 
 type BlockedVideoService interface {
@@ -46,6 +47,7 @@ func (h *Handler) CreateBlockedVideo(c echo.Context) error {
 	if err != nil {
 		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
 	}
+	recordAudit(c, audit.Entry{Action: audit.ActionBlockedVideoCreate, ResourceType: "blocked_video", ResourceID: id})
 	return SuccessResponse(c, "", "blocked video")
 }
 
@@ -61,6 +63,7 @@ func (h *Handler) DeleteBlockedVideo(c echo.Context) error {
 	if err != nil {
 		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
 	}
+	recordAudit(c, audit.Entry{Action: audit.ActionBlockedVideoDelete, ResourceType: "blocked_video", ResourceID: id})
 	return SuccessResponse(c, "", "unblocked video")
 }
 
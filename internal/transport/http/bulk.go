@@ -0,0 +1,172 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/internal/audit"
+	"github.com/zibbp/ganymede/internal/bulk"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+type BulkService interface {
+	CreateBulkOperation(ctx context.Context, userID uuid.UUID, input bulk.BulkOperation) (*ent.BulkOperation, error)
+	GetBulkOperation(ctx context.Context, id uuid.UUID) (*ent.BulkOperation, error)
+	GetBulkOperations(ctx context.Context, limit int, offset int) ([]*ent.BulkOperation, error)
+	CancelBulkOperation(ctx context.Context, id uuid.UUID) error
+}
+
+type CreateBulkOperationRequest struct {
+	Action     utils.BulkAction    `json:"action" validate:"required,oneof=delete lock unlock add_to_playlist remove_from_playlist generate_static_thumbnail generate_sprite_thumbnails render_chat convert_to_hls refresh_metadata"`
+	VodIDs     []uuid.UUID         `json:"vod_ids" validate:"max=10000"`
+	Filter     utils.BulkVodFilter `json:"filter"` // used if vod_ids is empty
+	PlaylistID *uuid.UUID          `json:"playlist_id"`
+}
+
+// CreateBulkOperation godoc
+//
+//	@Summary		Start bulk operation
+//	@Description	Apply an action to the listed vods or the vods matching the filter as a background task. Deleting requires the admin role.
+//	@Tags			vods
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body		CreateBulkOperationRequest	true	"Bulk operation"
+//	@Success		200		{object}	ent.BulkOperation
+//	@Failure		400		{object}	utils.ErrorResponse
+//	@Failure		403		{object}	utils.ErrorResponse
+//	@Failure		404		{object}	utils.ErrorResponse
+//	@Failure		500		{object}	utils.ErrorResponse
+//	@Router			/vod/bulk [post]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) CreateBulkOperation(c echo.Context) error {
+	body := new(CreateBulkOperationRequest)
+	if err := c.Bind(body); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	if err := c.Validate(body); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	user := userFromContext(c)
+	// deleting a single vod requires the admin role too
+	if body.Action == utils.BulkActionDelete && !user.Role.HasRole(utils.AdminRole) {
+		return ErrorResponse(c, http.StatusForbidden, "deleting vods requires the admin role")
+	}
+
+	op, err := h.Service.BulkService.CreateBulkOperation(c.Request().Context(), user.ID, bulk.BulkOperation{
+		Action:     body.Action,
+		VodIDs:     body.VodIDs,
+		Filter:     body.Filter,
+		PlaylistID: body.PlaylistID,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, bulk.ErrPlaylistNotFound):
+			return ErrorResponse(c, http.StatusNotFound, err.Error())
+		case errors.Is(err, bulk.ErrNoVodsSelected), errors.Is(err, bulk.ErrPlaylistRequired):
+			return ErrorResponse(c, http.StatusBadRequest, err.Error())
+		}
+		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+	}
+	recordAudit(c, audit.Entry{Action: audit.ActionVodBulk, ResourceType: "bulk_operation", ResourceID: op.ID.String(), After: body})
+	return SuccessResponse(c, op, "bulk operation started")
+}
+
+// GetBulkOperations godoc
+//
+//	@Summary		Get bulk operations
+//	@Description	Get the most recent bulk operations
+//	@Tags			vods
+//	@Produce		json
+//	@Param			limit	query		integer	false	"Limit, defaults to 20"
+//	@Param			offset	query		integer	false	"Offset"
+//	@Success		200		{object}	[]ent.BulkOperation
+//	@Failure		400		{object}	utils.ErrorResponse
+//	@Failure		500		{object}	utils.ErrorResponse
+//	@Router			/vod/bulk [get]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) GetBulkOperations(c echo.Context) error {
+	limit, offset := 20, 0
+	var err error
+	if l := c.QueryParam("limit"); l != "" {
+		limit, err = strconv.Atoi(l)
+		if err != nil || limit < 1 || limit > 100 {
+			return ErrorResponse(c, http.StatusBadRequest, "limit must be between 1 and 100")
+		}
+	}
+	if o := c.QueryParam("offset"); o != "" {
+		offset, err = strconv.Atoi(o)
+		if err != nil || offset < 0 {
+			return ErrorResponse(c, http.StatusBadRequest, "invalid offset")
+		}
+	}
+
+	ops, err := h.Service.BulkService.GetBulkOperations(c.Request().Context(), limit, offset)
+	if err != nil {
+		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+	}
+	return SuccessResponse(c, ops, "bulk operations")
+}
+
+// GetBulkOperation godoc
+//
+//	@Summary		Get bulk operation
+//	@Description	Get a bulk operation with the result of each vod
+//	@Tags			vods
+//	@Produce		json
+//	@Param			id	path		string	true	"Bulk operation ID"
+//	@Success		200	{object}	ent.BulkOperation
+//	@Failure		400	{object}	utils.ErrorResponse
+//	@Failure		404	{object}	utils.ErrorResponse
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/vod/bulk/{id} [get]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) GetBulkOperation(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	op, err := h.Service.BulkService.GetBulkOperation(c.Request().Context(), id)
+	if err != nil {
+		if errors.Is(err, bulk.ErrBulkOperationNotFound) {
+			return ErrorResponse(c, http.StatusNotFound, err.Error())
+		}
+		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+	}
+	return SuccessResponse(c, op, "bulk operation")
+}
+
+// CancelBulkOperation godoc
+//
+//	@Summary		Cancel bulk operation
+//	@Description	Stop a pending or running bulk operation, vods already processed are not reverted
+//	@Tags			vods
+//	@Param			id	path	string	true	"Bulk operation ID"
+//	@Success		200
+//	@Failure		400	{object}	utils.ErrorResponse
+//	@Failure		404	{object}	utils.ErrorResponse
+//	@Failure		409	{object}	utils.ErrorResponse
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/vod/bulk/{id}/cancel [post]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) CancelBulkOperation(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	if err := h.Service.BulkService.CancelBulkOperation(c.Request().Context(), id); err != nil {
+		switch {
+		case errors.Is(err, bulk.ErrBulkOperationNotFound):
+			return ErrorResponse(c, http.StatusNotFound, err.Error())
+		case errors.Is(err, bulk.ErrBulkOperationFinished):
+			return ErrorResponse(c, http.StatusConflict, err.Error())
+		}
+		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+	}
+	return c.NoContent(http.StatusOK)
+}
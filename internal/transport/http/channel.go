@@ -9,17 +9,21 @@ import (
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/internal/acl"
+	"github.com/zibbp/ganymede/internal/audit"
 	"github.com/zibbp/ganymede/internal/channel"
 )
 
 type ChannelService interface {
 	CreateChannel(channelDto channel.Channel) (*ent.Channel, error)
-	GetChannels() ([]*ent.Channel, error)
+	GetChannels(ctx context.Context) ([]*ent.Channel, error)
 	GetChannel(channelID uuid.UUID) (*ent.Channel, error)
 	GetChannelByName(channelName string) (*ent.Channel, error)
 	DeleteChannel(channelID uuid.UUID) error
 	UpdateChannel(channelID uuid.UUID, channelDto channel.Channel) (*ent.Channel, error)
 	UpdateChannelImage(ctx context.Context, channelID uuid.UUID) error
+	GetChannelAccess(ctx context.Context, channelID uuid.UUID) (*ent.Channel, error)
+	SetChannelAccess(ctx context.Context, channelID uuid.UUID, access acl.Access) (*ent.Channel, error)
 }
 
 type CreateChannelRequest struct {
@@ -85,7 +89,7 @@ func (h *Handler) CreateChannel(c echo.Context) error {
 //	@Failure		500	{object}	utils.ErrorResponse
 //	@Router			/channel [get]
 func (h *Handler) GetChannels(c echo.Context) error {
-	channels, err := h.Service.ChannelService.GetChannels()
+	channels, err := h.Service.ChannelService.GetChannels(c.Request().Context())
 	if err != nil {
 		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
 	}
@@ -142,6 +146,13 @@ func (h *Handler) DeleteChannel(c echo.Context) error {
 	if err != nil {
 		return ErrorResponse(c, http.StatusBadRequest, err.Error())
 	}
+	cha, err := h.Service.ChannelService.GetChannel(cUUID)
+	if err != nil {
+		if err.Error() == "channel not found" {
+			return ErrorResponse(c, http.StatusNotFound, err.Error())
+		}
+		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+	}
 	err = h.Service.ChannelService.DeleteChannel(cUUID)
 	if err != nil {
 		if err.Error() == "channel not found" {
@@ -149,6 +160,7 @@ func (h *Handler) DeleteChannel(c echo.Context) error {
 		}
 		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
 	}
+	recordAudit(c, audit.Entry{Action: audit.ActionChannelDelete, ResourceType: "channel", ResourceID: cUUID.String(), Before: cha})
 	return c.NoContent(http.StatusOK)
 }
 
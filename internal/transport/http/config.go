@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	"github.com/labstack/echo/v4"
+	"github.com/zibbp/ganymede/internal/audit"
 	"github.com/zibbp/ganymede/internal/config"
 )
 
@@ -46,10 +47,12 @@ func (h *Handler) UpdateConfig(c echo.Context) error {
 		return ErrorResponse(c, http.StatusBadRequest, err.Error())
 	}
 
+	before := config.Get()
 	err := config.UpdateConfig(conf)
 	if err != nil {
 		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
 	}
+	recordAudit(c, audit.Entry{Action: audit.ActionConfigUpdate, ResourceType: "config", Before: before, After: conf})
 
 	return SuccessResponse(c, conf, "config updated")
 }
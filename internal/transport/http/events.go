@@ -0,0 +1,106 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/ent/channel"
+	"github.com/zibbp/ganymede/internal/acl"
+	"github.com/zibbp/ganymede/internal/database"
+	"github.com/zibbp/ganymede/internal/events"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+type EventsService interface {
+	Subscribe() (<-chan events.Event, func())
+}
+
+// StreamEvents godoc
+//
+//	@Summary		Stream events
+//	@Description	Stream queue, task, archive, live and retention events as server-sent events. The event name is the event type and the data is the event as JSON. Only events of channels the user can view are sent.
+//	@Tags			events
+//	@Produce		text/event-stream
+//	@Param			types	query		string	false	"Comma separated event types to receive, all types if empty"
+//	@Success		200		{object}	events.Event
+//	@Failure		400		{object}	utils.ErrorResponse
+//	@Router			/events [get]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) StreamEvents(c echo.Context) error {
+	types := map[events.EventType]bool{}
+	if typesParam := c.QueryParam("types"); typesParam != "" {
+		validTypes := events.EventType("").Values()
+		for _, t := range strings.Split(typesParam, ",") {
+			t = strings.TrimSpace(t)
+			if !utils.Contains(validTypes, t) {
+				return ErrorResponse(c, http.StatusBadRequest, fmt.Sprintf("invalid event type %s", t))
+			}
+			types[events.EventType(t)] = true
+		}
+	}
+
+	eventsCh, unsubscribe := h.Service.EventsService.Subscribe()
+	defer unsubscribe()
+
+	startServerSentEvents(c)
+	res := c.Response()
+
+	ctx := c.Request().Context()
+	keepAliveTicker := time.NewTicker(serverSentEventKeepAliveInterval)
+	defer keepAliveTicker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-keepAliveTicker.C:
+			if err := writeServerSentEventKeepAlive(res); err != nil {
+				return nil
+			}
+		case event, ok := <-eventsCh:
+			if !ok {
+				return nil
+			}
+			if len(types) > 0 && !types[event.Type] {
+				continue
+			}
+			if !eventVisible(ctx, userFromContext(c), event) {
+				continue
+			}
+			data, err := json.Marshal(event)
+			if err != nil {
+				return err
+			}
+			if err := writeServerSentEvent(res, string(event.Type), data); err != nil {
+				return nil
+			}
+		}
+	}
+}
+
+// eventVisible returns false for events of channels the user cannot view and queue events for users that cannot view the queue.
+func eventVisible(ctx context.Context, u *ent.User, event events.Event) bool {
+	if acl.CanViewAll(u) {
+		return true
+	}
+	switch event.Type {
+	case events.QueueItemCreated, events.TaskStatusChanged:
+		return u != nil && u.Role.HasRole(utils.ArchiverRole)
+	}
+
+	var data struct {
+		ChannelID uuid.UUID `json:"channel_id"`
+	}
+	if err := json.Unmarshal(event.Data, &data); err != nil || data.ChannelID == uuid.Nil {
+		return false
+	}
+	exists, err := database.DB().Client.Channel.Query().Where(channel.ID(data.ChannelID), acl.Channels(ctx)).Exist(ctx)
+	return err == nil && exists
+}
@@ -44,6 +44,12 @@ type Services struct {
 	CategoryService     CategoryService
 	BlockedVideoService BlockedVideoService
 	YoutubeService      YoutubeService
+	EventsService       EventsService
+	ShareService        ShareService
+	AuditService        AuditService
+	TrashService        TrashService
+	BulkService         BulkService
+	TagService          TagService
 	PlatformTwitch      platform.Platform
 }
 
@@ -56,7 +62,7 @@ type Handler struct {
 
 var sessionManager *scs.SessionManager
 
-func NewHandler(database *database.Database, authService AuthService, channelService ChannelService, vodService VodService, queueService QueueService, archiveService ArchiveService, adminService AdminService, userService UserService, liveService LiveService, playbackService PlaybackService, metricsService MetricsService, playlistService PlaylistService, taskService TaskService, chapterService ChapterService, categoryService CategoryService, blockedVideoService BlockedVideoService, youtubeService YoutubeService, platformTwitch platform.Platform, riverUIServer *riverui.Handler) *Handler {
+func NewHandler(database *database.Database, authService AuthService, channelService ChannelService, vodService VodService, queueService QueueService, archiveService ArchiveService, adminService AdminService, userService UserService, liveService LiveService, playbackService PlaybackService, metricsService MetricsService, playlistService PlaylistService, taskService TaskService, chapterService ChapterService, categoryService CategoryService, blockedVideoService BlockedVideoService, youtubeService YoutubeService, eventsService EventsService, shareService ShareService, auditService AuditService, trashService TrashService, bulkService BulkService, tagService TagService, platformTwitch platform.Platform, riverUIServer *riverui.Handler) *Handler {
 	log.Debug().Msg("creating route handler")
 	envConfig := config.GetEnvConfig()
 
@@ -86,6 +92,12 @@ func NewHandler(database *database.Database, authService AuthService, channelSer
 			CategoryService:     categoryService,
 			BlockedVideoService: blockedVideoService,
 			YoutubeService:      youtubeService,
+			EventsService:       eventsService,
+			ShareService:        shareService,
+			AuditService:        auditService,
+			TrashService:        trashService,
+			BulkService:         bulkService,
+			TagService:          tagService,
 			PlatformTwitch:      platformTwitch,
 		},
 		SessionManager: sessionManager,
@@ -95,7 +107,8 @@ func NewHandler(database *database.Database, authService AuthService, channelSer
 	// Enable gzip compression for API routes
 	h.Server.Use(middleware.GzipWithConfig(middleware.GzipConfig{
 		Skipper: func(c echo.Context) bool {
-			return !strings.Contains(c.Request().URL.Path, "/api")
+			// event streams are flushed per event and must not be buffered by the compressor
+			return !strings.Contains(c.Request().URL.Path, "/api") || strings.Contains(c.Request().Header.Get("Accept"), "text/event-stream")
 		},
 	}))
 
@@ -105,6 +118,12 @@ func NewHandler(database *database.Database, authService AuthService, channelSer
 	// Middleware
 	h.Server.Validator = &utils.CustomValidator{Validator: validator.New()}
 
+	ipExtractor, err := newIPExtractor(envConfig.TrustedProxies)
+	if err != nil {
+		log.Fatal().Err(err).Msg("invalid TRUSTED_PROXIES")
+	}
+	h.Server.IPExtractor = ipExtractor
+
 	h.Server.HideBanner = true
 
 	// If frontend is external then allow cors
@@ -160,7 +179,8 @@ func (h *Handler) mapRoutes() {
 
 	// Static files if not using nginx
 	envConfig := config.GetEnvConfig()
-	h.Server.Static(envConfig.VideosDir, envConfig.VideosDir)
+	mediaGroup := h.Server.Group(envConfig.VideosDir, AuthOptionalMiddleware, mediaAccessMiddleware)
+	mediaGroup.Static("/", envConfig.VideosDir)
 
 	// RiverUI
 	h.Server.Any("/riverui/", echo.WrapHandler(h.RiverUIServer), AuthGuardMiddleware, AuthGetUserMiddleware, AuthUserRoleMiddleware(utils.EditorRole))
@@ -184,45 +204,73 @@ func groupV1Routes(e *echo.Group, h *Handler) {
 	authGroup := e.Group("/auth")
 	authGroup.POST("/register", h.Register)
 	authGroup.POST("/login", h.Login)
-	authGroup.POST("/logout", h.Logout, AuthGuardMiddleware)
-	authGroup.GET("/me", h.Me, AuthGuardMiddleware, AuthGetUserMiddleware)
+	authGroup.POST("/login/2fa", h.LoginTwoFactor)
+	allowTwoFactorSetup(authGroup.POST("/logout", h.Logout, AuthGuardMiddleware))
+	allowTwoFactorSetup(authGroup.GET("/me", h.Me, AuthGuardMiddleware, AuthGetUserMiddleware))
 	authGroup.POST("/change-password", h.ChangePassword, AuthGuardMiddleware, AuthGetUserMiddleware)
 	authGroup.GET("/oauth/login", h.OAuthLogin)
 	authGroup.GET("/oauth/callback", h.OAuthCallback)
+	allowTwoFactorSetup(authGroup.POST("/2fa/enroll", h.EnrollTwoFactor, AuthGuardMiddleware, AuthGetUserMiddleware))
+	allowTwoFactorSetup(authGroup.POST("/2fa/enable", h.EnableTwoFactor, AuthGuardMiddleware, AuthGetUserMiddleware))
+	authGroup.POST("/2fa/disable", h.DisableTwoFactor, AuthGuardMiddleware, AuthGetUserMiddleware)
+	authGroup.POST("/2fa/recovery-codes", h.RegenerateRecoveryCodes, AuthGuardMiddleware, AuthGetUserMiddleware)
+	authGroup.GET("/sessions", h.GetSessions, AuthGuardMiddleware, AuthGetUserMiddleware)
+	authGroup.DELETE("/sessions", h.DeleteSessions, AuthGuardMiddleware, AuthGetUserMiddleware)
+	authGroup.DELETE("/sessions/:id", h.DeleteSession, AuthGuardMiddleware, AuthGetUserMiddleware)
+	authGroup.GET("/lockouts", h.GetLoginThrottles, AuthGuardMiddleware, AuthGetUserMiddleware, AuthUserRoleMiddleware(utils.AdminRole))
+	authGroup.DELETE("/lockouts/:id", h.DeleteLoginThrottle, AuthGuardMiddleware, AuthGetUserMiddleware, AuthUserRoleMiddleware(utils.AdminRole))
+	authGroup.GET("/tokens", h.GetAPITokens, AuthGuardMiddleware, AuthGetUserMiddleware)
+	authGroup.POST("/tokens", h.CreateAPIToken, AuthGuardMiddleware, AuthGetUserMiddleware)
+	authGroup.DELETE("/tokens/:id", h.DeleteAPIToken, AuthGuardMiddleware, AuthGetUserMiddleware)
 
 	// Channel
 	channelGroup := e.Group("/channel")
 	channelGroup.POST("", h.CreateChannel, AuthGuardMiddleware, AuthGetUserMiddleware, AuthUserRoleMiddleware(utils.EditorRole))
-	channelGroup.GET("", h.GetChannels)
-	channelGroup.GET("/:id", h.GetChannel)
-	channelGroup.GET("/name/:name", h.GetChannelByName)
+	channelGroup.GET("", h.GetChannels, AuthOptionalMiddleware)
+	channelGroup.GET("/:id", h.GetChannel, AuthOptionalMiddleware, ChannelAccessMiddleware)
+	channelGroup.GET("/name/:name", h.GetChannelByName, AuthOptionalMiddleware, ChannelAccessMiddleware)
 	channelGroup.PUT("/:id", h.UpdateChannel, AuthGuardMiddleware, AuthGetUserMiddleware, AuthUserRoleMiddleware(utils.EditorRole))
 	channelGroup.DELETE("/:id", h.DeleteChannel, AuthGuardMiddleware, AuthGetUserMiddleware, AuthUserRoleMiddleware(utils.AdminRole))
 	channelGroup.POST("/:id/update-image", h.UpdateChannelImage, AuthGuardMiddleware, AuthGetUserMiddleware, AuthUserRoleMiddleware(utils.EditorRole))
+	channelGroup.POST("/:id/media-token", h.CreateChannelMediaToken, AuthOptionalMiddleware, ChannelAccessMiddleware)
+	channelGroup.GET("/:id/access", h.GetChannelAccess, AuthGuardMiddleware, AuthGetUserMiddleware, AuthUserRoleMiddleware(utils.EditorRole))
+	channelGroup.PUT("/:id/access", h.SetChannelAccess, AuthGuardMiddleware, AuthGetUserMiddleware, AuthUserRoleMiddleware(utils.EditorRole))
 
 	// VOD
 	vodGroup := e.Group("/vod")
 	vodGroup.POST("", h.CreateVod, AuthGuardMiddleware, AuthGetUserMiddleware, AuthUserRoleMiddleware(utils.EditorRole))
-	vodGroup.GET("", h.GetVods)
-	vodGroup.GET("/:id", h.GetVod)
-	vodGroup.GET("/external_id/:external_id", h.GetVod)
-	vodGroup.GET("/search", h.SearchVods)
+	vodGroup.POST("/bulk", h.CreateBulkOperation, AuthGuardMiddleware, AuthGetUserMiddleware, AuthUserRoleMiddleware(utils.EditorRole))
+	vodGroup.GET("/bulk", h.GetBulkOperations, AuthGuardMiddleware, AuthGetUserMiddleware, AuthUserRoleMiddleware(utils.EditorRole))
+	vodGroup.GET("/bulk/:id", h.GetBulkOperation, AuthGuardMiddleware, AuthGetUserMiddleware, AuthUserRoleMiddleware(utils.EditorRole))
+	vodGroup.POST("/bulk/:id/cancel", h.CancelBulkOperation, AuthGuardMiddleware, AuthGetUserMiddleware, AuthUserRoleMiddleware(utils.EditorRole))
+	vodGroup.GET("", h.GetVods, AuthOptionalMiddleware)
+	vodGroup.GET("/:id", h.GetVod, VodShareMiddleware)
+	vodGroup.GET("/external_id/:external_id", h.GetVod, AuthOptionalMiddleware, VodAccessMiddleware)
+	vodGroup.GET("/search", h.SearchVods, AuthOptionalMiddleware)
 	vodGroup.PUT("/:id", h.UpdateVod, AuthGuardMiddleware, AuthGetUserMiddleware, AuthUserRoleMiddleware(utils.EditorRole))
 	vodGroup.DELETE("/:id", h.DeleteVod, AuthGuardMiddleware, AuthGetUserMiddleware, AuthUserRoleMiddleware(utils.AdminRole))
-	vodGroup.GET("/:id/playlist", h.GetVodPlaylists)
-	vodGroup.GET("/:id/clips", h.GetVodClips)
-	vodGroup.GET("/paginate", h.GetVodsPagination)
-	vodGroup.GET("/:id/chat", h.GetVodChatComments)
-	vodGroup.GET("/:id/chat/seek", h.GetNumberOfVodChatCommentsFromTime)
-	vodGroup.GET("/:id/chat/userid", h.GetUserIdFromChat)
-	vodGroup.GET("/:id/chat/emotes", h.GetChatEmotes)
-	vodGroup.GET("/:id/chat/badges", h.GetChatBadges)
-	vodGroup.GET("/:id/chat/histogram", h.GetVodChatHistogram)
+	vodGroup.GET("/:id/playlist", h.GetVodPlaylists, AuthOptionalMiddleware, VodAccessMiddleware)
+	vodGroup.GET("/:id/clips", h.GetVodClips, AuthOptionalMiddleware, VodAccessMiddleware)
+	vodGroup.GET("/paginate", h.GetVodsPagination, AuthOptionalMiddleware)
+	vodGroup.GET("/:id/chat", h.GetVodChatComments, VodShareMiddleware)
+	vodGroup.GET("/:id/chat/seek", h.GetNumberOfVodChatCommentsFromTime, VodShareMiddleware)
+	vodGroup.GET("/:id/chat/userid", h.GetUserIdFromChat, VodShareMiddleware)
+	vodGroup.GET("/:id/chat/emotes", h.GetChatEmotes, VodShareMiddleware)
+	vodGroup.GET("/:id/chat/badges", h.GetChatBadges, VodShareMiddleware)
+	vodGroup.GET("/:id/chat/histogram", h.GetVodChatHistogram, VodShareMiddleware)
 	vodGroup.POST("/:id/lock", h.LockVod, AuthGuardMiddleware, AuthGetUserMiddleware, AuthUserRoleMiddleware(utils.EditorRole))
 	vodGroup.POST("/:id/generate-static-thumbnail", h.GenerateStaticThumbnail, AuthGuardMiddleware, AuthGetUserMiddleware, AuthUserRoleMiddleware(utils.EditorRole))
 	vodGroup.POST("/:id/generate-sprite-thumbnails", h.GenerateSpriteThumbnails, AuthGuardMiddleware, AuthGetUserMiddleware, AuthUserRoleMiddleware(utils.EditorRole))
-	vodGroup.GET("/:id/thumbnails/vtt", h.GetVodSpriteThumbnails)
+	vodGroup.POST("/:id/media-token", h.CreateVodMediaToken, VodShareMiddleware)
+	vodGroup.GET("/:id/thumbnails/vtt", h.GetVodSpriteThumbnails, VodShareMiddleware)
+	vodGroup.GET("/:id/tags", h.GetVodTags, VodShareMiddleware)
+	vodGroup.PUT("/:id/tags", h.SetVodTags, AuthGuardMiddleware, AuthGetUserMiddleware, AuthUserRoleMiddleware(utils.EditorRole))
+	vodGroup.GET("/:id/share", h.GetVodShareLinks, AuthGuardMiddleware, AuthGetUserMiddleware, AuthUserRoleMiddleware(utils.EditorRole))
+	vodGroup.POST("/:id/share", h.CreateVodShareLink, AuthGuardMiddleware, AuthGetUserMiddleware, AuthUserRoleMiddleware(utils.EditorRole))
 	vodGroup.POST("/:id/ffprobe", h.GetFFprobe, AuthGuardMiddleware, AuthGetUserMiddleware, AuthUserRoleMiddleware(utils.ArchiverRole))
+	vodGroup.GET("/:id/live/playlist.m3u8", h.GetLiveDVRPlaylist, AuthGuardMiddleware, AuthGetUserMiddleware, VodAccessMiddleware)
+	vodGroup.GET("/:id/live/chat", h.GetLiveDVRChatComments, AuthGuardMiddleware, AuthGetUserMiddleware, VodAccessMiddleware)
+	vodGroup.GET("/:id/live/:segment", h.GetLiveDVRSegment, AuthGuardMiddleware, AuthGetUserMiddleware, VodAccessMiddleware)
 
 	// Queue
 	queueGroup := e.Group("/queue")
@@ -231,10 +279,17 @@ func groupV1Routes(e *echo.Group, h *Handler) {
 	queueGroup.GET("/:id", h.GetQueueItem, AuthGuardMiddleware, AuthGetUserMiddleware, AuthUserRoleMiddleware(utils.ArchiverRole))
 	queueGroup.PUT("/:id", h.UpdateQueueItem, AuthGuardMiddleware, AuthGetUserMiddleware, AuthUserRoleMiddleware(utils.EditorRole))
 	queueGroup.DELETE("/:id", h.DeleteQueueItem, AuthGuardMiddleware, AuthGetUserMiddleware, AuthUserRoleMiddleware(utils.AdminRole))
+	queueGroup.GET("/:id/progress/stream", h.StreamQueueItemProgress, AuthGuardMiddleware, AuthGetUserMiddleware, AuthUserRoleMiddleware(utils.ArchiverRole))
 	queueGroup.GET("/:id/tail", h.ReadQueueLogFile, AuthGuardMiddleware, AuthGetUserMiddleware, AuthUserRoleMiddleware(utils.ArchiverRole))
 	queueGroup.POST("/:id/stop", h.StopQueueItem, AuthGuardMiddleware, AuthGetUserMiddleware, AuthUserRoleMiddleware(utils.AdminRole))
 	queueGroup.POST("/task/start", h.StartQueueTask, AuthGuardMiddleware, AuthGetUserMiddleware, AuthUserRoleMiddleware(utils.ArchiverRole))
 
+	// Media
+	e.GET("/media/verify", h.VerifyMedia, AuthOptionalMiddleware)
+
+	// Events
+	e.GET("/events", h.StreamEvents, AuthGuardMiddleware, AuthGetUserMiddleware)
+
 	// Twitch
 	twitchGroup := e.Group("/twitch")
 	twitchGroup.GET("/channel", h.GetTwitchChannel)
@@ -254,13 +309,21 @@ func groupV1Routes(e *echo.Group, h *Handler) {
 	adminGroup.GET("/system-overview", h.GetSystemOverview, AuthGuardMiddleware, AuthGetUserMiddleware, AuthUserRoleMiddleware(utils.AdminRole))
 	adminGroup.GET("/storage-distribution", h.GetStorageDistribution, AuthGuardMiddleware, AuthGetUserMiddleware, AuthUserRoleMiddleware(utils.AdminRole))
 	adminGroup.GET("/info", h.GetInfo, AuthGuardMiddleware, AuthGetUserMiddleware, AuthUserRoleMiddleware(utils.AdminRole))
+	adminGroup.GET("/audit-log", h.GetAuditLogs, AuthGuardMiddleware, AuthGetUserMiddleware, AuthUserRoleMiddleware(utils.AdminRole))
 
 	// User
 	userGroup := e.Group("/user")
 	userGroup.GET("", h.GetUsers, AuthGuardMiddleware, AuthGetUserMiddleware, AuthUserRoleMiddleware(utils.AdminRole))
+	userGroup.GET("/me/subscriptions", h.GetSubscriptions, AuthGuardMiddleware, AuthGetUserMiddleware)
+	userGroup.POST("/me/subscriptions", h.CreateSubscription, AuthGuardMiddleware, AuthGetUserMiddleware)
+	userGroup.DELETE("/me/subscriptions/:id", h.DeleteSubscription, AuthGuardMiddleware, AuthGetUserMiddleware)
+	userGroup.PUT("/me/webhook", h.UpdateWebhook, AuthGuardMiddleware, AuthGetUserMiddleware)
 	userGroup.GET("/:id", h.GetUser, AuthGuardMiddleware, AuthGetUserMiddleware, AuthUserRoleMiddleware(utils.AdminRole))
 	userGroup.PUT("/:id", h.UpdateUser, AuthGuardMiddleware, AuthGetUserMiddleware, AuthUserRoleMiddleware(utils.AdminRole))
 	userGroup.DELETE("/:id", h.DeleteUser, AuthGuardMiddleware, AuthGetUserMiddleware, AuthUserRoleMiddleware(utils.AdminRole))
+	userGroup.GET("/:id/sessions", h.GetUserSessions, AuthGuardMiddleware, AuthGetUserMiddleware, AuthUserRoleMiddleware(utils.AdminRole))
+	userGroup.DELETE("/:id/2fa", h.ResetUserTwoFactor, AuthGuardMiddleware, AuthGetUserMiddleware, AuthUserRoleMiddleware(utils.AdminRole))
+	userGroup.POST("/:id/logout", h.LogoutUser, AuthGuardMiddleware, AuthGetUserMiddleware, AuthUserRoleMiddleware(utils.AdminRole))
 
 	// Config
 	configGroup := e.Group("/config")
@@ -280,26 +343,49 @@ func groupV1Routes(e *echo.Group, h *Handler) {
 	// Playback
 	playbackGroup := e.Group("/playback")
 	playbackGroup.GET("", h.GetAllProgress, AuthGuardMiddleware, AuthGetUserMiddleware)
-	playbackGroup.GET("/:id", h.GetProgress, AuthGuardMiddleware, AuthGetUserMiddleware)
+	playbackGroup.GET("/:id", h.GetProgress, AuthGuardMiddleware, AuthGetUserMiddleware, VodAccessMiddleware)
 	playbackGroup.POST("/progress", h.UpdateProgress, AuthGuardMiddleware, AuthGetUserMiddleware)
 	playbackGroup.POST("/status", h.UpdateStatus, AuthGuardMiddleware, AuthGetUserMiddleware)
-	playbackGroup.DELETE("/:id", h.DeleteProgress, AuthGuardMiddleware, AuthGetUserMiddleware)
+	playbackGroup.DELETE("/:id", h.DeleteProgress, AuthGuardMiddleware, AuthGetUserMiddleware, VodAccessMiddleware)
 	playbackGroup.GET("/last", h.GetLastPlaybacks, AuthGuardMiddleware, AuthGetUserMiddleware)
-	playbackGroup.POST("/start", h.StartPlayback)
+	playbackGroup.POST("/start", h.StartPlayback, AuthOptionalMiddleware)
 
 	// Playlist
 	playlistGroup := e.Group("/playlist")
-	playlistGroup.GET("/:id", h.GetPlaylist)
-	playlistGroup.GET("", h.GetPlaylists)
+	playlistGroup.GET("/:id", h.GetPlaylist, PlaylistShareMiddleware)
+	playlistGroup.GET("", h.GetPlaylists, AuthOptionalMiddleware)
 	playlistGroup.POST("", h.CreatePlaylist, AuthGuardMiddleware, AuthGetUserMiddleware, AuthUserRoleMiddleware(utils.EditorRole))
 	playlistGroup.POST("/:id", h.AddVodToPlaylist, AuthGuardMiddleware, AuthGetUserMiddleware, AuthUserRoleMiddleware(utils.EditorRole))
 	playlistGroup.DELETE("/:id/vod", h.DeleteVodFromPlaylist, AuthGuardMiddleware, AuthGetUserMiddleware, AuthUserRoleMiddleware(utils.EditorRole))
 	playlistGroup.DELETE("/:id", h.DeletePlaylist, AuthGuardMiddleware, AuthGetUserMiddleware, AuthUserRoleMiddleware(utils.EditorRole))
 	playlistGroup.PUT("/:id", h.UpdatePlaylist, AuthGuardMiddleware, AuthGetUserMiddleware, AuthUserRoleMiddleware(utils.EditorRole))
 	playlistGroup.PUT("/:id/multistream/delay", h.SetVodDelayOnPlaylistMultistream, AuthGuardMiddleware, AuthGetUserMiddleware, AuthUserRoleMiddleware(utils.EditorRole))
+	playlistGroup.PUT("/:id/order", h.SetPlaylistOrder, AuthGuardMiddleware, AuthGetUserMiddleware, AuthUserRoleMiddleware(utils.EditorRole))
+	playlistGroup.POST("/:id/play-next", h.PlayNext, AuthGuardMiddleware, AuthGetUserMiddleware, AuthUserRoleMiddleware(utils.EditorRole))
+	playlistGroup.GET("/:id/next", h.GetNextPlaylistVod, AuthGuardMiddleware, AuthGetUserMiddleware, PlaylistAccessMiddleware)
+	playlistGroup.POST("/:id/section", h.CreatePlaylistSection, AuthGuardMiddleware, AuthGetUserMiddleware, AuthUserRoleMiddleware(utils.EditorRole))
+	playlistGroup.PUT("/:id/section/:section_id", h.UpdatePlaylistSection, AuthGuardMiddleware, AuthGetUserMiddleware, AuthUserRoleMiddleware(utils.EditorRole))
+	playlistGroup.DELETE("/:id/section/:section_id", h.DeletePlaylistSection, AuthGuardMiddleware, AuthGetUserMiddleware, AuthUserRoleMiddleware(utils.EditorRole))
 	playlistGroup.PUT("/:id/rules", h.SetPlaylistRules, AuthGuardMiddleware, AuthGetUserMiddleware, AuthUserRoleMiddleware(utils.EditorRole))
 	playlistGroup.GET("/:id/rules", h.GetPlaylistRules, AuthGuardMiddleware, AuthGetUserMiddleware, AuthUserRoleMiddleware(utils.EditorRole))
 	playlistGroup.POST("/:id/rules/test", h.TestPlaylistRules, AuthGuardMiddleware, AuthGetUserMiddleware, AuthUserRoleMiddleware(utils.EditorRole))
+	playlistGroup.GET("/:id/access", h.GetPlaylistAccess, AuthGuardMiddleware, AuthGetUserMiddleware, AuthUserRoleMiddleware(utils.EditorRole))
+	playlistGroup.PUT("/:id/access", h.SetPlaylistAccess, AuthGuardMiddleware, AuthGetUserMiddleware, AuthUserRoleMiddleware(utils.EditorRole))
+	playlistGroup.GET("/:id/share", h.GetPlaylistShareLinks, AuthGuardMiddleware, AuthGetUserMiddleware, AuthUserRoleMiddleware(utils.EditorRole))
+	playlistGroup.POST("/:id/share", h.CreatePlaylistShareLink, AuthGuardMiddleware, AuthGetUserMiddleware, AuthUserRoleMiddleware(utils.EditorRole))
+
+	// Share
+	shareGroup := e.Group("/share")
+	shareGroup.GET("/:token", h.OpenShareLink)
+	shareGroup.DELETE("/:id", h.DeleteShareLink, AuthGuardMiddleware, AuthGetUserMiddleware, AuthUserRoleMiddleware(utils.EditorRole))
+
+	// Trash
+	trashGroup := e.Group("/trash")
+	trashGroup.GET("", h.GetTrash, AuthGuardMiddleware, AuthGetUserMiddleware, AuthUserRoleMiddleware(utils.EditorRole))
+	trashGroup.POST("/vod/:id/restore", h.RestoreVod, AuthGuardMiddleware, AuthGetUserMiddleware, AuthUserRoleMiddleware(utils.EditorRole))
+	trashGroup.DELETE("/vod/:id", h.PurgeVod, AuthGuardMiddleware, AuthGetUserMiddleware, AuthUserRoleMiddleware(utils.EditorRole))
+	trashGroup.POST("/channel/:id/restore", h.RestoreChannel, AuthGuardMiddleware, AuthGetUserMiddleware, AuthUserRoleMiddleware(utils.EditorRole))
+	trashGroup.DELETE("/channel/:id", h.PurgeChannel, AuthGuardMiddleware, AuthGetUserMiddleware, AuthUserRoleMiddleware(utils.EditorRole))
 
 	// Task
 	taskGroup := e.Group("/task")
@@ -308,16 +394,25 @@ func groupV1Routes(e *echo.Group, h *Handler) {
 	// Notification
 	notificationGroup := e.Group("/notification")
 	notificationGroup.POST("/test", h.TestNotification, AuthGuardMiddleware, AuthGetUserMiddleware, AuthUserRoleMiddleware(utils.AdminRole))
+	notificationGroup.GET("/failures", h.GetNotificationFailures, AuthGuardMiddleware, AuthGetUserMiddleware, AuthUserRoleMiddleware(utils.AdminRole))
+	notificationGroup.POST("/preview", h.PreviewNotification, AuthGuardMiddleware, AuthGetUserMiddleware, AuthUserRoleMiddleware(utils.AdminRole))
 
 	// Chapter
 	chapterGroup := e.Group("/chapter")
-	chapterGroup.GET("/video/:videoId", h.GetVideoChapters)
-	chapterGroup.GET("/video/:videoId/webvtt", h.GetWebVTTChapters)
+	chapterGroup.GET("/video/:videoId", h.GetVideoChapters, VodShareMiddleware)
+	chapterGroup.GET("/video/:videoId/webvtt", h.GetWebVTTChapters, VodShareMiddleware)
 
 	// Category
 	categoryGroup := e.Group("/category")
 	categoryGroup.GET("", h.GetCategories)
 
+	// Tag
+	tagGroup := e.Group("/tag")
+	tagGroup.GET("", h.GetTags, AuthOptionalMiddleware)
+	tagGroup.POST("", h.CreateTag, AuthGuardMiddleware, AuthGetUserMiddleware, AuthUserRoleMiddleware(utils.EditorRole))
+	tagGroup.PUT("/:id", h.UpdateTag, AuthGuardMiddleware, AuthGetUserMiddleware, AuthUserRoleMiddleware(utils.EditorRole))
+	tagGroup.DELETE("/:id", h.DeleteTag, AuthGuardMiddleware, AuthGetUserMiddleware, AuthUserRoleMiddleware(utils.EditorRole))
+
 	// Blocked
 	blockedGroup := e.Group("/blocked-video")
 	blockedGroup.GET("", h.GetBlockedVideos)
@@ -0,0 +1,49 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewIPExtractor(t *testing.T) {
+	tests := []struct {
+		name           string
+		trustedProxies []string
+		remoteAddr     string
+		forwardedFor   string
+		expected       string
+	}{
+		{name: "spoofed header without trusted proxies", remoteAddr: "203.0.113.5:1234", forwardedFor: "198.51.100.1", expected: "203.0.113.5"},
+		{name: "spoofed header from private network", remoteAddr: "10.0.0.5:1234", forwardedFor: "198.51.100.1", expected: "10.0.0.5"},
+		{name: "spoofed header from untrusted client", trustedProxies: []string{"10.0.0.2"}, remoteAddr: "203.0.113.5:1234", forwardedFor: "198.51.100.1", expected: "203.0.113.5"},
+		{name: "header from trusted proxy", trustedProxies: []string{"10.0.0.2"}, remoteAddr: "10.0.0.2:1234", forwardedFor: "198.51.100.1", expected: "198.51.100.1"},
+		{name: "header from trusted proxy range", trustedProxies: []string{"172.16.0.0/12"}, remoteAddr: "172.18.0.3:1234", forwardedFor: "198.51.100.1", expected: "198.51.100.1"},
+		{name: "spoofed entry before trusted proxy", trustedProxies: []string{"10.0.0.2"}, remoteAddr: "10.0.0.2:1234", forwardedFor: "192.0.2.9, 198.51.100.1", expected: "198.51.100.1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			extractor, err := newIPExtractor(tt.trustedProxies)
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/auth/login", nil)
+			req.RemoteAddr = tt.remoteAddr
+			req.Header.Set(echo.HeaderXForwardedFor, tt.forwardedFor)
+			req.Header.Set(echo.HeaderXRealIP, tt.forwardedFor)
+			assert.Equal(t, tt.expected, extractor(req))
+		})
+	}
+}
+
+func TestNewIPExtractorInvalid(t *testing.T) {
+	_, err := newIPExtractor([]string{"proxy"})
+	assert.Error(t, err)
+
+	_, err = newIPExtractor([]string{"10.0.0.0/33"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,191 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/ent/channel"
+	"github.com/zibbp/ganymede/internal/acl"
+	"github.com/zibbp/ganymede/internal/auth"
+	"github.com/zibbp/ganymede/internal/config"
+	"github.com/zibbp/ganymede/internal/database"
+)
+
+// mediaTokenCookie is the cookie signed media tokens are set in. A cookie is set per directory using the cookie path so tokens of several videos can be used at once.
+const mediaTokenCookie = "ganymede_media"
+
+// mediaTokenParam is the query parameter signed media URLs contain the token in.
+const mediaTokenParam = "token"
+
+type MediaTokenResponse struct {
+	Token     string    `json:"token"`
+	Path      string    `json:"path"`  // directory the token grants access to
+	Query     string    `json:"query"` // query string to append to media URLs if cookies cannot be used
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// CreateVodMediaToken godoc
+//
+//	@Summary		Create a media token for a video
+//	@Description	Returns a short-lived token granting access to the files of the video and sets it as a cookie scoped to the video directory. The token can also be appended to media URLs as the token query parameter. Tokens created with a share link expire with the link at the latest. Tokens grant access to the whole video, not only the shared part of it.
+//	@Tags			vods
+//	@Produce		json
+//	@Param			id	path		string	true	"Video ID"
+//	@Success		200	{object}	MediaTokenResponse
+//	@Failure		400	{object}	utils.ErrorResponse
+//	@Failure		404	{object}	utils.ErrorResponse
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/vod/{id}/media-token [post]
+func (h *Handler) CreateVodMediaToken(c echo.Context) error {
+	vID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	v, err := h.Service.VodService.GetVod(c.Request().Context(), vID, true, false, false, false)
+	if err != nil {
+		if err.Error() == "vod not found" {
+			return ErrorResponse(c, http.StatusNotFound, err.Error())
+		}
+		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+	}
+	if v.Edges.Channel == nil {
+		return ErrorResponse(c, http.StatusInternalServerError, "vod has no channel")
+	}
+
+	// older videos may not have a folder name and their files can be in the channel directory, a token for it would grant access to every video of the channel
+	if v.FolderName == "" {
+		return ErrorResponse(c, http.StatusBadRequest, "video has no folder of its own, media tokens are not available")
+	}
+	dir := path.Join(config.GetEnvConfig().VideosDir, v.Edges.Channel.Name, v.FolderName)
+
+	expiresAt := mediaTokenExpiry()
+	// the token must not outlive the share link it was created with
+	if link := shareLinkFromContext(c); link != nil && link.ExpiresAt != nil && link.ExpiresAt.Before(expiresAt) {
+		expiresAt = *link.ExpiresAt
+	}
+
+	return mediaTokenResponse(c, dir, expiresAt)
+}
+
+// CreateChannelMediaToken godoc
+//
+//	@Summary		Create a media token for a channel
+//	@Description	Returns a short-lived token granting access to the files of the channel and its videos and sets it as a cookie scoped to the channel directory.
+//	@Tags			channel
+//	@Produce		json
+//	@Param			id	path		string	true	"Channel ID"
+//	@Success		200	{object}	MediaTokenResponse
+//	@Failure		400	{object}	utils.ErrorResponse
+//	@Failure		404	{object}	utils.ErrorResponse
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/channel/{id}/media-token [post]
+func (h *Handler) CreateChannelMediaToken(c echo.Context) error {
+	cID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	cha, err := h.Service.ChannelService.GetChannel(cID)
+	if err != nil {
+		if err.Error() == "channel not found" {
+			return ErrorResponse(c, http.StatusNotFound, err.Error())
+		}
+		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+	}
+
+	return mediaTokenResponse(c, path.Join(config.GetEnvConfig().VideosDir, cha.Name), mediaTokenExpiry())
+}
+
+// VerifyMedia godoc
+//
+//	@Summary		Verify media access
+//	@Description	Verifies access to the media file of the X-Original-URI header for the nginx auth_request module. Responds with 204 if the request has a valid media token, or if tokens are not required and the session can view the channel of the file.
+//	@Tags			media
+//	@Param			X-Original-URI	header	string	true	"URI of the requested media file"
+//	@Success		204
+//	@Failure		403	{object}	utils.ErrorResponse
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/media/verify [get]
+func (h *Handler) VerifyMedia(c echo.Context) error {
+	originalURI, err := url.ParseRequestURI(c.Request().Header.Get("X-Original-URI"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusForbidden, "invalid original uri")
+	}
+
+	allowed, err := mediaAllowed(c, originalURI.Path, originalURI.Query())
+	if err != nil {
+		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+	}
+	if !allowed {
+		return ErrorResponse(c, http.StatusForbidden, "media access denied")
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// mediaTokenExpiry returns when a media token created now expires.
+func mediaTokenExpiry() time.Time {
+	return time.Now().Add(time.Duration(config.Get().Auth.MediaTokenMinutes) * time.Minute)
+}
+
+// mediaTokenResponse signs a token for the directory and sets it as a cookie.
+func mediaTokenResponse(c echo.Context, dir string, expiresAt time.Time) error {
+	token, mediaToken := auth.SignMediaToken(dir, expiresAt)
+
+	c.SetCookie(&http.Cookie{
+		Name:     mediaTokenCookie,
+		Value:    token,
+		Path:     (&url.URL{Path: mediaToken.Path}).EscapedPath(),
+		Expires:  mediaToken.ExpiresAt,
+		HttpOnly: true,
+		Secure:   c.Scheme() == "https",
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	return SuccessResponse(c, MediaTokenResponse{
+		Token:     token,
+		Path:      mediaToken.Path,
+		Query:     url.Values{mediaTokenParam: {token}}.Encode(),
+		ExpiresAt: mediaToken.ExpiresAt,
+	}, "media token")
+}
+
+// mediaAllowed returns true if a media token of the query or cookies grants access to the file. If tokens are not required, files of channels the viewer can view are allowed too. Files are stored in a folder named after the channel.
+func mediaAllowed(c echo.Context, file string, query url.Values) (bool, error) {
+	tokens := query[mediaTokenParam]
+	for _, cookie := range c.Cookies() {
+		if cookie.Name == mediaTokenCookie {
+			tokens = append(tokens, cookie.Value)
+		}
+	}
+	for _, token := range tokens {
+		if auth.VerifyMediaToken(token, file) == nil {
+			return true, nil
+		}
+	}
+
+	if config.Get().Auth.RequireMediaToken {
+		return false, nil
+	}
+	if u, ok := c.Get("user").(*ent.User); ok && acl.CanViewAll(u) {
+		return true, nil
+	}
+
+	relativePath, ok := strings.CutPrefix(path.Clean("/"+file), path.Clean(config.GetEnvConfig().VideosDir)+"/")
+	if !ok {
+		return false, nil
+	}
+	channelName, _, _ := strings.Cut(relativePath, "/")
+
+	ctx := c.Request().Context()
+	exists, err := database.DB().Client.Channel.Query().Where(channel.Name(channelName), acl.Channels(ctx)).Exist(ctx)
+	if err != nil {
+		return false, fmt.Errorf("error checking channel access: %v", err)
+	}
+	return exists, nil
+}
@@ -1,24 +1,58 @@
 package http
 
 import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	"github.com/rs/zerolog/log"
 	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/ent/channel"
+	"github.com/zibbp/ganymede/ent/playlist"
+	"github.com/zibbp/ganymede/ent/predicate"
 	"github.com/zibbp/ganymede/ent/user"
+	"github.com/zibbp/ganymede/ent/vod"
+	"github.com/zibbp/ganymede/internal/acl"
+	"github.com/zibbp/ganymede/internal/audit"
+	"github.com/zibbp/ganymede/internal/auth"
+	"github.com/zibbp/ganymede/internal/config"
 	"github.com/zibbp/ganymede/internal/database"
+	"github.com/zibbp/ganymede/internal/share"
 	"github.com/zibbp/ganymede/internal/utils"
 )
 
-// AuthGuardMiddleware is a middleware that enforces authentication. If the request does not contain a vaild session a 403 error is returned.
+// AuthGuardMiddleware is a middleware that enforces authentication. If the request does not contain a vaild session or API token a 403 error is returned.
 func AuthGuardMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
 	return func(c echo.Context) error {
 
-		userID, ok := sessionManager.Get(c.Request().Context(), "user_id").(string)
+		if token, ok := bearerToken(c); ok {
+			apiToken, err := auth.ValidateAPIToken(c.Request().Context(), database.DB(), token)
+			if err != nil {
+				if !errors.Is(err, auth.ErrAPITokenInvalid) {
+					log.Error().Err(err).Msg("error validating api token")
+				}
+				return ErrorInvalidAccessTokenResponse(c)
+			}
+
+			c.Set("auth_method", "token")
+			c.Set("user.id", apiToken.Edges.User.ID.String())
+			c.Set("api_token", apiToken)
+
+			return next(c)
+		}
+
+		userID, ok := sessionManager.Get(c.Request().Context(), sessionKeyUserID).(string)
 		if !ok {
 			return ErrorInvalidAccessTokenResponse(c)
 		}
 
+		touchSession(c)
+
 		c.Set("auth_method", "local")
 		c.Set("user.id", userID)
 
@@ -48,15 +82,237 @@ func AuthGetUserMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
 				return ErrorInvalidAccessTokenResponse(c)
 			}
 
+			if !user.TotpEnabled && auth.TwoFactorRequired(user) && !twoFactorSetupRoute(c) {
+				return ErrorResponse(c, http.StatusForbidden, "two-factor authentication must be enabled")
+			}
+
+			c.Set("user", user)
+			setViewer(c, user)
+			setActor(c, user)
+
+			return next(c)
+		}
+
+		if authMethod == "token" {
+			apiToken, ok := c.Get("api_token").(*ent.ApiToken)
+			if !ok || apiToken.Edges.User == nil {
+				log.Error().Msg("api token missing from context")
+				return ErrorInvalidAccessTokenResponse(c)
+			}
+
+			// tokens created before two-factor authentication was required are rejected like sessions
+			if !apiToken.Edges.User.TotpEnabled && auth.TwoFactorRequired(apiToken.Edges.User) && !twoFactorSetupRoute(c) {
+				return ErrorResponse(c, http.StatusForbidden, "two-factor authentication must be enabled")
+			}
+
+			user := auth.APITokenUser(apiToken)
+
 			c.Set("user", user)
+			setViewer(c, user)
+			setActor(c, user)
+
+			return next(c)
+		}
+
+		return next(c)
+	}
+}
+
+// AuthOptionalMiddleware authenticates requests that contain a session or API token like AuthGuardMiddleware and AuthGetUserMiddleware. Other requests continue as an anonymous viewer that can only view public channels and playlists.
+func AuthOptionalMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	authenticated := AuthGuardMiddleware(AuthGetUserMiddleware(next))
+	return func(c echo.Context) error {
+		if _, ok := bearerToken(c); ok {
+			return authenticated(c)
+		}
+		if sessionManager.Exists(c.Request().Context(), sessionKeyUserID) {
+			return authenticated(c)
+		}
+
+		setViewer(c, nil)
+
+		return next(c)
+	}
+}
+
+// ChannelAccessMiddleware responds with a 404 error if the viewer cannot view the channel of the id or name route parameter.
+func ChannelAccessMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		var p predicate.Channel
+		if name := c.Param("name"); name != "" {
+			p = channel.Name(name)
+		} else if id, err := uuid.Parse(c.Param("id")); err == nil {
+			p = channel.ID(id)
+		} else {
+			// the handler responds to invalid IDs
+			return next(c)
+		}
+
+		ctx := c.Request().Context()
+		exists, err := database.DB().Client.Channel.Query().Where(p, acl.Channels(ctx)).Exist(ctx)
+		if err != nil {
+			return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+		}
+		if !exists {
+			return ErrorResponse(c, http.StatusNotFound, "channel not found")
+		}
+
+		return next(c)
+	}
+}
 
+// VodAccessMiddleware responds with a 404 error if the viewer cannot view the vod of the id, videoId or external_id route parameter.
+func VodAccessMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		var p predicate.Vod
+		if extID := c.Param("external_id"); extID != "" {
+			p = vod.ExtID(extID)
+		} else if id, err := uuid.Parse(c.Param("id")); err == nil {
+			p = vod.ID(id)
+		} else if id, err := uuid.Parse(c.Param("videoId")); err == nil {
+			p = vod.ID(id)
+		} else {
+			// the handler responds to invalid IDs
 			return next(c)
 		}
 
+		ctx := c.Request().Context()
+		exists, err := database.DB().Client.Vod.Query().Where(p, acl.Vods(ctx)).Exist(ctx)
+		if err != nil {
+			return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+		}
+		if !exists {
+			return ErrorResponse(c, http.StatusNotFound, "vod not found")
+		}
+
 		return next(c)
 	}
 }
 
+// PlaylistAccessMiddleware responds with a 404 error if the viewer cannot view the playlist of the id route parameter.
+func PlaylistAccessMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		id, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			// the handler responds to invalid IDs
+			return next(c)
+		}
+
+		ctx := c.Request().Context()
+		exists, err := database.DB().Client.Playlist.Query().Where(playlist.ID(id), acl.Playlists(ctx)).Exist(ctx)
+		if err != nil {
+			return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+		}
+		if !exists {
+			return ErrorResponse(c, http.StatusNotFound, "playlist not found")
+		}
+
+		return next(c)
+	}
+}
+
+// shareTokenParam is the query parameter share link tokens can be sent in, they can also be sent in the X-Share-Token header.
+const shareTokenParam = "share"
+
+// VodShareMiddleware allows requests with a share link of the vod, or of a playlist containing it, without a session. Other requests are checked by AuthOptionalMiddleware and VodAccessMiddleware.
+func VodShareMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	fallback := AuthOptionalMiddleware(VodAccessMiddleware(next))
+	return func(c echo.Context) error {
+		id, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			id, err = uuid.Parse(c.Param("videoId"))
+		}
+		if err != nil {
+			return fallback(c)
+		}
+
+		return shareLinkMiddleware(c, next, fallback, func(ctx context.Context, link *ent.ShareLink) (bool, error) {
+			return share.CoversVod(ctx, database.DB(), link, id)
+		})
+	}
+}
+
+// PlaylistShareMiddleware allows requests with a share link of the playlist without a session. Other requests are checked by AuthOptionalMiddleware and PlaylistAccessMiddleware.
+func PlaylistShareMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	fallback := AuthOptionalMiddleware(PlaylistAccessMiddleware(next))
+	return func(c echo.Context) error {
+		id, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			return fallback(c)
+		}
+
+		return shareLinkMiddleware(c, next, fallback, func(ctx context.Context, link *ent.ShareLink) (bool, error) {
+			return link.Edges.Playlist != nil && link.Edges.Playlist.ID == id, nil
+		})
+	}
+}
+
+// shareLinkMiddleware calls next if the request has a valid share link that covers the resource and fallback otherwise. Shared requests have no viewer so the queries of the shared resource are not limited by its visibility.
+func shareLinkMiddleware(c echo.Context, next echo.HandlerFunc, fallback echo.HandlerFunc, covers func(context.Context, *ent.ShareLink) (bool, error)) error {
+	token := c.QueryParam(shareTokenParam)
+	if token == "" {
+		token = c.Request().Header.Get("X-Share-Token")
+	}
+	if token == "" {
+		return fallback(c)
+	}
+
+	ctx := c.Request().Context()
+	link, err := share.ValidateShareLink(ctx, database.DB(), token)
+	if err != nil {
+		if errors.Is(err, share.ErrShareLinkInvalid) {
+			return fallback(c)
+		}
+		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+	}
+	ok, err := covers(ctx, link)
+	if err != nil {
+		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+	}
+	if !ok {
+		return fallback(c)
+	}
+
+	c.Set("share_link", link)
+	return next(c)
+}
+
+// shareLinkFromContext returns the share link the request was allowed by, nil if it was not made with a share link.
+func shareLinkFromContext(c echo.Context) *ent.ShareLink {
+	link, _ := c.Get("share_link").(*ent.ShareLink)
+	return link
+}
+
+// mediaAccessMiddleware responds with a 404 error if the request cannot access a static file, see mediaAllowed.
+func mediaAccessMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		p, err := url.PathUnescape(c.Param("*"))
+		if err != nil {
+			return ErrorResponse(c, http.StatusBadRequest, err.Error())
+		}
+
+		allowed, err := mediaAllowed(c, path.Join(config.GetEnvConfig().VideosDir, p), c.QueryParams())
+		if err != nil {
+			return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+		}
+		if !allowed {
+			return echo.ErrNotFound
+		}
+
+		return next(c)
+	}
+}
+
+// setViewer limits the queries of the request to what the user can view, a nil user is an anonymous viewer.
+func setViewer(c echo.Context, u *ent.User) {
+	c.SetRequest(c.Request().WithContext(acl.WithViewer(c.Request().Context(), u)))
+}
+
+// setActor records the audit log entries of the request as made by the user.
+func setActor(c echo.Context, u *ent.User) {
+	c.SetRequest(c.Request().WithContext(audit.WithActor(c.Request().Context(), audit.Actor{UserID: u.ID, Username: u.Username, IP: c.RealIP()})))
+}
+
 func AuthUserRoleMiddleware(role utils.Role) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
@@ -90,6 +346,15 @@ func AuthUserRoleMiddleware(role utils.Role) echo.MiddlewareFunc {
 	}
 }
 
+// bearerToken returns the token of an "Authorization: Bearer" header.
+func bearerToken(c echo.Context) (string, bool) {
+	scheme, token, ok := strings.Cut(c.Request().Header.Get(echo.HeaderAuthorization), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") || token == "" {
+		return "", false
+	}
+	return strings.TrimSpace(token), true
+}
+
 // userFromContext parses the user from the request context as a *ent.User.
 func userFromContext(c echo.Context) *ent.User {
 	userStr := c.Get("user")
@@ -2,12 +2,14 @@ package http
 
 import (
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	"github.com/zibbp/ganymede/ent"
 	"github.com/zibbp/ganymede/internal/notification"
+	"github.com/zibbp/ganymede/internal/utils"
 )
 
 // TestNotification godoc
@@ -35,16 +37,17 @@ func (h *Handler) TestNotification(c echo.Context) error {
 		DisplayName: "Test Channel",
 	}
 	testVod := ent.Vod{
-		ID:         uuid.New(),
-		ExtID:      "987654321",
-		Platform:   "twitch",
-		Type:       "archive",
-		Title:      "Demo Notification Title",
-		Duration:   100,
-		Views:      4510,
-		Resolution: "best",
-		StreamedAt: time.Now(),
-		CreatedAt:  time.Now(),
+		ID:               uuid.New(),
+		ExtID:            "987654321",
+		Platform:         "twitch",
+		Type:             "archive",
+		Title:            "Demo Notification Title",
+		Duration:         100,
+		Views:            4510,
+		Resolution:       "best",
+		StorageSizeBytes: 2 << 30,
+		StreamedAt:       time.Now(),
+		CreatedAt:        time.Now(),
 	}
 	testQueue := ent.Queue{
 		ID:        uuid.New(),
@@ -61,9 +64,101 @@ func (h *Handler) TestNotification(c echo.Context) error {
 		notification.SendErrorNotification(&testChannel, &testVod, &testQueue, failedTask)
 	case "is_live":
 		notification.SendLiveNotification(&testChannel, &testVod, &testQueue, "Demo Game")
+	case "archive_queued":
+		notification.SendArchiveQueuedNotification(&testChannel, &testVod, &testQueue)
+	case "archive_started":
+		notification.SendArchiveStartedNotification(&testChannel, &testVod, &testQueue)
+	case "video_deleted":
+		notification.SendVideoDeletedNotification(&testChannel, &testVod, "retention")
+	case "disk_low":
+		notification.SendDiskLowNotification(notification.DiskUsage{Path: "/data/videos", FreeBytes: 5 << 30, TotalBytes: 500 << 30, ThresholdBytes: 20 << 30})
+	case "credential_invalid":
+		notification.SendCredentialInvalidNotification("Twitch token", "token is invalid or expired")
+	case "watchdog_failed":
+		notification.SendWatchdogFailedNotification(&testChannel, &testVod, &testQueue, failedTask)
+	case "login_lockout":
+		notification.SendLoginLockoutNotification(notification.LoginLockout{Kind: utils.LoginThrottleIP, Value: "203.0.113.7", Attempts: 20, LockedUntil: time.Now().Add(15 * time.Minute)})
 	default:
 		return ErrorResponse(c, http.StatusBadRequest, "type is invalid")
 	}
 
 	return SuccessResponse(c, "", "sent")
 }
+
+// GetNotificationFailures godoc
+//
+//	@Summary		Get notification failures
+//	@Description	Get the most recent notifications that could not be delivered after retrying
+//	@Tags			notification
+//	@Produce		json
+//	@Param			limit	query		integer	false	"Number of failures to return"	default(50)
+//	@Success		200		{object}	[]ent.NotificationFailure
+//	@Failure		400		{object}	utils.ErrorResponse
+//	@Failure		500		{object}	utils.ErrorResponse
+//	@Router			/notification/failures [get]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) GetNotificationFailures(c echo.Context) error {
+	limit := 50
+	if limitParam := c.QueryParam("limit"); limitParam != "" {
+		var err error
+		limit, err = strconv.Atoi(limitParam)
+		if err != nil || limit < 1 {
+			return ErrorResponse(c, http.StatusBadRequest, "limit must be a positive number")
+		}
+	}
+
+	failures, err := notification.GetDeliveryFailures(c.Request().Context(), limit)
+	if err != nil {
+		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+	}
+
+	return SuccessResponse(c, failures, "notification failures")
+}
+
+type PreviewNotificationRequest struct {
+	Template     string                  `json:"template" validate:"required"`
+	BodyTemplate bool                    `json:"body_template"` // render the template as a JSON request body
+	Event        utils.NotificationEvent `json:"event" validate:"required,oneof=video_success live_success error is_live archive_queued archive_started video_deleted disk_low credential_invalid watchdog_failed login_lockout"`
+	VideoID      uuid.UUID               `json:"video_id" validate:"required_without=QueueID"`
+	QueueID      uuid.UUID               `json:"queue_id" validate:"required_without=VideoID"`
+}
+
+// PreviewNotification godoc
+//
+//	@Summary		Preview notification
+//	@Description	Render a notification template against a video or the video of a queue item without sending it
+//	@Tags			notification
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body		PreviewNotificationRequest	true	"Template to preview"
+//	@Success		200		{object}	string
+//	@Failure		400		{object}	utils.ErrorResponse
+//	@Failure		404		{object}	utils.ErrorResponse
+//	@Router			/notification/preview [post]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) PreviewNotification(c echo.Context) error {
+	var body PreviewNotificationRequest
+	if err := c.Bind(&body); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	if err := c.Validate(body); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	rendered, err := notification.RenderPreview(c.Request().Context(), notification.PreviewInput{
+		Template:     body.Template,
+		BodyTemplate: body.BodyTemplate,
+		Event:        body.Event,
+		VideoID:      body.VideoID,
+		QueueID:      body.QueueID,
+	})
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ErrorResponse(c, http.StatusNotFound, err.Error())
+		}
+		// template errors are the most likely cause
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	return SuccessResponse(c, rendered, "notification preview")
+}
@@ -43,6 +43,7 @@ type UpdateStatusRequest struct {
 //	@Param			progress	body		UpdateProgressRequest	true	"progress"
 //	@Success		200			{object}	string
 //	@Failure		400			{object}	utils.ErrorResponse
+//	@Failure		404			{object}	utils.ErrorResponse
 //	@Failure		500			{object}	utils.ErrorResponse
 //	@Router			/playback/progress [post]
 //	@Security		ApiKeyCookieAuth
@@ -61,6 +62,9 @@ func (h *Handler) UpdateProgress(c echo.Context) error {
 	}
 	err = h.Service.PlaybackService.UpdateProgress(c.Request().Context(), user.ID, vID, upr.Time)
 	if err != nil {
+		if errors.Is(err, playback.ErrVodNotFound) {
+			return ErrorResponse(c, http.StatusNotFound, err.Error())
+		}
 		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
 	}
 	return SuccessResponse(c, "", "ok")
@@ -126,6 +130,7 @@ func (h *Handler) GetAllProgress(c echo.Context) error {
 //	@Param			status	body		UpdateStatusRequest	true	"status"
 //	@Success		200		{object}	string
 //	@Failure		400		{object}	utils.ErrorResponse
+//	@Failure		404		{object}	utils.ErrorResponse
 //	@Failure		500		{object}	utils.ErrorResponse
 //	@Router			/playback/status [post]
 //	@Security		ApiKeyCookieAuth
@@ -144,6 +149,9 @@ func (h *Handler) UpdateStatus(c echo.Context) error {
 	}
 	err = h.Service.PlaybackService.UpdateStatus(c.Request().Context(), user.ID, vID, usr.Status)
 	if err != nil {
+		if errors.Is(err, playback.ErrVodNotFound) {
+			return ErrorResponse(c, http.StatusNotFound, err.Error())
+		}
 		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
 	}
 
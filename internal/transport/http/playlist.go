@@ -7,6 +7,8 @@ import (
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/internal/acl"
+	"github.com/zibbp/ganymede/internal/audit"
 	"github.com/zibbp/ganymede/internal/playlist"
 	"github.com/zibbp/ganymede/internal/utils"
 )
@@ -23,6 +25,14 @@ type PlaylistService interface {
 	SetPlaylistRules(ctx context.Context, playlistID uuid.UUID, ruleGroups []playlist.RuleGroupInput) ([]*ent.PlaylistRuleGroup, error)
 	GetPlaylistRules(ctx context.Context, playlistID uuid.UUID) ([]*ent.PlaylistRuleGroup, error)
 	TestPlaylistRules(ctx context.Context, playlistID uuid.UUID, videoID uuid.UUID) (bool, error)
+	GetPlaylistAccess(ctx context.Context, playlistID uuid.UUID) (*ent.Playlist, error)
+	SetPlaylistAccess(ctx context.Context, playlistID uuid.UUID, access acl.Access) (*ent.Playlist, error)
+	SetPlaylistOrder(ctx context.Context, playlistID uuid.UUID, manualOrder bool, order []playlist.Entry) ([]*ent.PlaylistVod, error)
+	PlayNext(ctx context.Context, playlistID uuid.UUID, vodID uuid.UUID, afterVodID *uuid.UUID) ([]*ent.PlaylistVod, error)
+	GetNextVod(ctx context.Context, playlistID uuid.UUID, userID uuid.UUID, afterVodID *uuid.UUID) (*ent.Vod, error)
+	CreateSection(ctx context.Context, playlistID uuid.UUID, name string) (*ent.PlaylistSection, error)
+	UpdateSection(ctx context.Context, playlistID uuid.UUID, sectionID uuid.UUID, name string, position int) (*ent.PlaylistSection, error)
+	DeleteSection(ctx context.Context, playlistID uuid.UUID, sectionID uuid.UUID) error
 }
 
 type CreatePlaylistRequest struct {
@@ -42,18 +52,52 @@ type SetVodDelayPlaylistRequest struct {
 // SetPlaylistRulesRequest defines the structure for setting playlist rules.
 // Also update enums in utils/enums.go if necessary.
 type SetPlaylistRulesRequest struct {
-	RuleGroups []struct {
-		Operator string `json:"operator" validate:"required,oneof=AND OR"`
-		Position int    `json:"position"`
-		Rules    []struct {
-			Name     string                     `json:"name"`
-			Field    utils.PlaylistRuleField    `json:"field" validate:"required,oneof=title category type platform channel_name"`
-			Operator utils.PlaylistRuleOperator `json:"operator" validate:"required,oneof=equals contains regex"`
-			Value    string                     `json:"value" validate:"required"`
-			Position int                        `json:"position"`
-			Enabled  bool                       `json:"enabled"`
-		} `json:"rules" validate:"required,dive"`
-	} `json:"rule_groups" validate:"required,dive"`
+	RuleGroups []PlaylistRuleGroupRequest `json:"rule_groups" validate:"required,dive"`
+}
+
+type PlaylistRuleGroupRequest struct {
+	Operator string                     `json:"operator" validate:"required,oneof=AND OR"`
+	Position int                        `json:"position"`
+	Negate   bool                       `json:"negate"`
+	Rules    []PlaylistRuleRequest      `json:"rules" validate:"dive"`
+	Groups   []PlaylistRuleGroupRequest `json:"groups" validate:"dive"` // nested groups
+}
+
+type PlaylistRuleRequest struct {
+	Name     string                     `json:"name"`
+	Field    utils.PlaylistRuleField    `json:"field" validate:"required,oneof=title category type platform channel_name tag duration streamed_at views local_views storage_size_bytes age_days"`
+	Operator utils.PlaylistRuleOperator `json:"operator" validate:"required,oneof=equals contains regex greater_than greater_than_or_equal less_than less_than_or_equal"`
+	Value    string                     `json:"value" validate:"required"`
+	Position int                        `json:"position"`
+	Enabled  bool                       `json:"enabled"`
+	Negate   bool                       `json:"negate"`
+}
+
+// ruleGroupInputs converts the requested rule groups and their nested groups.
+func ruleGroupInputs(groups []PlaylistRuleGroupRequest) []playlist.RuleGroupInput {
+	ruleGroups := make([]playlist.RuleGroupInput, len(groups))
+	for i, g := range groups {
+		rules := make([]playlist.RuleInput, len(g.Rules))
+		for j, r := range g.Rules {
+			rules[j] = playlist.RuleInput{
+				Name:     r.Name,
+				Field:    r.Field,
+				Operator: r.Operator,
+				Value:    r.Value,
+				Position: r.Position,
+				Enabled:  r.Enabled,
+				Negate:   r.Negate,
+			}
+		}
+		ruleGroups[i] = playlist.RuleGroupInput{
+			Operator: g.Operator,
+			Position: g.Position,
+			Negate:   g.Negate,
+			Rules:    rules,
+			Groups:   ruleGroupInputs(g.Groups),
+		}
+	}
+	return ruleGroups
 }
 
 // CreatePlaylist godoc
@@ -331,30 +375,17 @@ func (h *Handler) SetPlaylistRules(c echo.Context) error {
 	if err := c.Validate(&req); err != nil {
 		return ErrorResponse(c, http.StatusBadRequest, err.Error())
 	}
-	ruleGroups := make([]playlist.RuleGroupInput, len(req.RuleGroups))
-	for i, g := range req.RuleGroups {
-		rules := make([]playlist.RuleInput, len(g.Rules))
-		for j, r := range g.Rules {
-			rules[j] = playlist.RuleInput{
-				Name:     r.Name,
-				Field:    r.Field,
-				Operator: r.Operator,
-				Value:    r.Value,
-				Position: r.Position,
-				Enabled:  r.Enabled,
-			}
-		}
-		ruleGroups[i] = playlist.RuleGroupInput{
-			Operator: g.Operator,
-			Position: g.Position,
-			Rules:    rules,
-		}
+	ruleGroups := ruleGroupInputs(req.RuleGroups)
+	before, err := h.Service.PlaylistService.GetPlaylistRules(c.Request().Context(), pID)
+	if err != nil {
+		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
 	}
 	createdGroups, err := h.Service.PlaylistService.SetPlaylistRules(c.Request().Context(),
 		pID, ruleGroups)
 	if err != nil {
 		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
 	}
+	recordAudit(c, audit.Entry{Action: audit.ActionPlaylistRulesUpdate, ResourceType: "playlist", ResourceID: pID.String(), Before: map[string]any{"rule_groups": before}, After: map[string]any{"rule_groups": createdGroups}})
 	return SuccessResponse(c, createdGroups, "playlist rules set")
 }
 
@@ -0,0 +1,254 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/zibbp/ganymede/internal/playlist"
+)
+
+type SetPlaylistOrderRequest struct {
+	ManualOrder bool                   `json:"manual_order"`
+	Entries     []PlaylistEntryRequest `json:"entries" validate:"dive"` // vods in the order they are played
+}
+
+type PlaylistEntryRequest struct {
+	VodID     uuid.UUID  `json:"vod_id" validate:"required"`
+	SectionID *uuid.UUID `json:"section_id"`
+}
+
+type PlayNextRequest struct {
+	VodID      uuid.UUID  `json:"vod_id" validate:"required"`
+	AfterVodID *uuid.UUID `json:"after_vod_id"` // vod currently playing, the vod is moved to the start if empty
+}
+
+type PlaylistSectionRequest struct {
+	Name     string `json:"name" validate:"required,max=100"`
+	Position int    `json:"position" validate:"min=0"`
+}
+
+// SetPlaylistOrder godoc
+//
+//	@Summary		Set playlist order
+//	@Description	Order the vods of a playlist and assign them to sections. Listed vods are played first in the given order, the others keep their order after them. Set manual_order to false to order the playlist by date again.
+//	@Tags			Playlist
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string					true	"playlist id"
+//	@Param			body	body		SetPlaylistOrderRequest	true	"order"
+//	@Success		200		{array}		ent.PlaylistVod
+//	@Failure		400		{object}	utils.ErrorResponse
+//	@Failure		404		{object}	utils.ErrorResponse
+//	@Failure		500		{object}	utils.ErrorResponse
+//	@Router			/playlist/{id}/order [put]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) SetPlaylistOrder(c echo.Context) error {
+	pID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "invalid playlist id")
+	}
+	body := new(SetPlaylistOrderRequest)
+	if err := c.Bind(body); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	if err := c.Validate(body); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	order := make([]playlist.Entry, len(body.Entries))
+	for i, e := range body.Entries {
+		order[i] = playlist.Entry{VodID: e.VodID, SectionID: e.SectionID}
+	}
+	entries, err := h.Service.PlaylistService.SetPlaylistOrder(c.Request().Context(), pID, body.ManualOrder, order)
+	if err != nil {
+		return playlistOrderErrorResponse(c, err)
+	}
+	return SuccessResponse(c, entries, "playlist order set")
+}
+
+// PlayNext godoc
+//
+//	@Summary		Play vod next
+//	@Description	Move a vod directly after the vod currently playing, adding it to the playlist if needed. The playlist is ordered manually afterwards.
+//	@Tags			Playlist
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string			true	"playlist id"
+//	@Param			body	body		PlayNextRequest	true	"vod"
+//	@Success		200		{array}		ent.PlaylistVod
+//	@Failure		400		{object}	utils.ErrorResponse
+//	@Failure		404		{object}	utils.ErrorResponse
+//	@Failure		500		{object}	utils.ErrorResponse
+//	@Router			/playlist/{id}/play-next [post]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) PlayNext(c echo.Context) error {
+	pID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "invalid playlist id")
+	}
+	body := new(PlayNextRequest)
+	if err := c.Bind(body); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	if err := c.Validate(body); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	entries, err := h.Service.PlaylistService.PlayNext(c.Request().Context(), pID, body.VodID, body.AfterVodID)
+	if err != nil {
+		return playlistOrderErrorResponse(c, err)
+	}
+	return SuccessResponse(c, entries, "playlist order set")
+}
+
+// GetNextPlaylistVod godoc
+//
+//	@Summary		Get next playlist vod
+//	@Description	Get the next vod of the playlist the user hasn't finished watching. Without after_vod_id this continues the playlist from the first unwatched vod.
+//	@Tags			Playlist
+//	@Produce		json
+//	@Param			id				path		string	true	"playlist id"
+//	@Param			after_vod_id	query		string	false	"vod currently playing"
+//	@Success		200				{object}	ent.Vod
+//	@Failure		400				{object}	utils.ErrorResponse
+//	@Failure		404				{object}	utils.ErrorResponse
+//	@Failure		500				{object}	utils.ErrorResponse
+//	@Router			/playlist/{id}/next [get]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) GetNextPlaylistVod(c echo.Context) error {
+	pID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "invalid playlist id")
+	}
+	var afterVodID *uuid.UUID
+	if after := c.QueryParam("after_vod_id"); after != "" {
+		id, err := uuid.Parse(after)
+		if err != nil {
+			return ErrorResponse(c, http.StatusBadRequest, "invalid vod id")
+		}
+		afterVodID = &id
+	}
+
+	user := userFromContext(c)
+	v, err := h.Service.PlaylistService.GetNextVod(c.Request().Context(), pID, user.ID, afterVodID)
+	if err != nil {
+		return playlistOrderErrorResponse(c, err)
+	}
+	return SuccessResponse(c, v, "next playlist vod")
+}
+
+// CreatePlaylistSection godoc
+//
+//	@Summary		Create playlist section
+//	@Description	Create a named section at the end of a playlist
+//	@Tags			Playlist
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string					true	"playlist id"
+//	@Param			body	body		PlaylistSectionRequest	true	"section"
+//	@Success		200		{object}	ent.PlaylistSection
+//	@Failure		400		{object}	utils.ErrorResponse
+//	@Failure		404		{object}	utils.ErrorResponse
+//	@Failure		500		{object}	utils.ErrorResponse
+//	@Router			/playlist/{id}/section [post]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) CreatePlaylistSection(c echo.Context) error {
+	pID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "invalid playlist id")
+	}
+	body := new(PlaylistSectionRequest)
+	if err := c.Bind(body); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	if err := c.Validate(body); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	section, err := h.Service.PlaylistService.CreateSection(c.Request().Context(), pID, body.Name)
+	if err != nil {
+		return playlistOrderErrorResponse(c, err)
+	}
+	return SuccessResponse(c, section, "playlist section created")
+}
+
+// UpdatePlaylistSection godoc
+//
+//	@Summary		Update playlist section
+//	@Description	Rename or move a section of a playlist
+//	@Tags			Playlist
+//	@Accept			json
+//	@Produce		json
+//	@Param			id			path		string					true	"playlist id"
+//	@Param			section_id	path		string					true	"section id"
+//	@Param			body		body		PlaylistSectionRequest	true	"section"
+//	@Success		200			{object}	ent.PlaylistSection
+//	@Failure		400			{object}	utils.ErrorResponse
+//	@Failure		404			{object}	utils.ErrorResponse
+//	@Failure		500			{object}	utils.ErrorResponse
+//	@Router			/playlist/{id}/section/{section_id} [put]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) UpdatePlaylistSection(c echo.Context) error {
+	pID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "invalid playlist id")
+	}
+	sID, err := uuid.Parse(c.Param("section_id"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "invalid section id")
+	}
+	body := new(PlaylistSectionRequest)
+	if err := c.Bind(body); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	if err := c.Validate(body); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	section, err := h.Service.PlaylistService.UpdateSection(c.Request().Context(), pID, sID, body.Name, body.Position)
+	if err != nil {
+		return playlistOrderErrorResponse(c, err)
+	}
+	return SuccessResponse(c, section, "playlist section updated")
+}
+
+// DeletePlaylistSection godoc
+//
+//	@Summary		Delete playlist section
+//	@Description	Delete a section of a playlist, its vods stay in the playlist
+//	@Tags			Playlist
+//	@Param			id			path	string	true	"playlist id"
+//	@Param			section_id	path	string	true	"section id"
+//	@Success		200
+//	@Failure		400	{object}	utils.ErrorResponse
+//	@Failure		404	{object}	utils.ErrorResponse
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/playlist/{id}/section/{section_id} [delete]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) DeletePlaylistSection(c echo.Context) error {
+	pID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "invalid playlist id")
+	}
+	sID, err := uuid.Parse(c.Param("section_id"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "invalid section id")
+	}
+
+	if err := h.Service.PlaylistService.DeleteSection(c.Request().Context(), pID, sID); err != nil {
+		return playlistOrderErrorResponse(c, err)
+	}
+	return c.NoContent(http.StatusOK)
+}
+
+func playlistOrderErrorResponse(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, playlist.ErrPlaylistNotFound), errors.Is(err, playlist.ErrSectionNotFound), errors.Is(err, playlist.ErrNoNextVod):
+		return ErrorResponse(c, http.StatusNotFound, err.Error())
+	case errors.Is(err, playlist.ErrVodNotInPlaylist):
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+}
@@ -1,14 +1,19 @@
 package http
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	"github.com/riverqueue/river/rivertype"
+	"github.com/rs/zerolog/log"
 	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/internal/audit"
 	"github.com/zibbp/ganymede/internal/queue"
 	"github.com/zibbp/ganymede/internal/utils"
 )
@@ -31,7 +36,7 @@ type CreateQueueRequest struct {
 
 type StartQueueTaskRequest struct {
 	QueueId  uuid.UUID `json:"queue_id" validate:"required,uuid4"`
-	TaskName string    `json:"task_name" validate:"required,oneof=task_vod_create_folder task_vod_download_thumbnail task_vod_save_info task_video_download task_video_convert task_video_move task_chat_download task_chat_convert task_chat_render task_chat_move task_live_chat_download task_live_video_download"`
+	TaskName string    `json:"task_name" validate:"required,oneof=task_vod_create_folder task_vod_download_thumbnail task_vod_save_info task_video_download task_video_convert task_video_validate task_video_move task_chat_download task_chat_convert task_chat_render task_chat_move task_live_chat_download task_live_video_download"`
 	Continue bool      `json:"continue"`
 }
 
@@ -47,6 +52,7 @@ type UpdateQueueRequest struct {
 	TaskVodSaveInfo          utils.TaskStatus `json:"task_vod_save_info" validate:"required,oneof=pending running success failed"`
 	TaskVideoDownload        utils.TaskStatus `json:"task_video_download" validate:"required,oneof=pending running success failed"`
 	TaskVideoConvert         utils.TaskStatus `json:"task_video_convert" validate:"required,oneof=pending running success failed"`
+	TaskVideoValidate        utils.TaskStatus `json:"task_video_validate" validate:"omitempty,oneof=pending running success failed"` // optional for clients that predate video validation
 	TaskVideoMove            utils.TaskStatus `json:"task_video_move" validate:"required,oneof=pending running success failed"`
 	TaskChatDownload         utils.TaskStatus `json:"task_chat_download" validate:"required,oneof=pending running success failed"`
 	TaskChatConvert          utils.TaskStatus `json:"task_chat_convert" validate:"required,oneof=pending running success failed"`
@@ -149,6 +155,80 @@ func (h *Handler) GetQueueItem(c echo.Context) error {
 	return SuccessResponse(c, q, "queue item")
 }
 
+// queueProgressPollInterval is how often the queue item is checked for changes while streaming progress.
+const queueProgressPollInterval = 2 * time.Second
+
+// StreamQueueItemProgress godoc
+//
+//	@Summary		Stream queue item progress
+//	@Description	Stream the queue item as server-sent events whenever its task statuses or progress change. A "progress" event is sent for each change and a "done" event once the queue item is no longer processing.
+//	@Tags			queue
+//	@Produce		text/event-stream
+//	@Param			id	path		string	true	"Queue item id"
+//	@Success		200	{object}	ent.Queue
+//	@Failure		400	{object}	utils.ErrorResponse
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/queue/{id}/progress/stream [get]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) StreamQueueItemProgress(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	q, err := h.Service.QueueService.GetQueueItem(id)
+	if err != nil {
+		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+	}
+
+	startServerSentEvents(c)
+	res := c.Response()
+
+	ctx := c.Request().Context()
+	pollTicker := time.NewTicker(queueProgressPollInterval)
+	defer pollTicker.Stop()
+	keepAliveTicker := time.NewTicker(serverSentEventKeepAliveInterval)
+	defer keepAliveTicker.Stop()
+
+	var last []byte
+	for {
+		data, err := json.Marshal(q)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(data, last) {
+			if err := writeServerSentEvent(res, "progress", data); err != nil {
+				return nil
+			}
+			last = data
+		}
+		if !q.Processing {
+			_ = writeServerSentEvent(res, "done", []byte("{}"))
+			return nil
+		}
+
+		// wait for the next poll, sending keep-alive comments in between
+	wait:
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-keepAliveTicker.C:
+				if err := writeServerSentEventKeepAlive(res); err != nil {
+					return nil
+				}
+			case <-pollTicker.C:
+				break wait
+			}
+		}
+
+		q, err = h.Service.QueueService.GetQueueItem(id)
+		if err != nil {
+			log.Debug().Err(err).Str("queue_id", id.String()).Msg("error getting queue item for progress stream")
+			return nil
+		}
+	}
+}
+
 // UpdateQueueItem godoc
 //
 //	@Summary		Update queue item
@@ -187,6 +267,7 @@ func (h *Handler) UpdateQueueItem(c echo.Context) error {
 		TaskVodSaveInfo:          uqr.TaskVodSaveInfo,
 		TaskVideoDownload:        uqr.TaskVideoDownload,
 		TaskVideoConvert:         uqr.TaskVideoConvert,
+		TaskVideoValidate:        uqr.TaskVideoValidate,
 		TaskVideoMove:            uqr.TaskVideoMove,
 		TaskChatDownload:         uqr.TaskChatDownload,
 		TaskChatConvert:          uqr.TaskChatConvert,
@@ -290,6 +371,7 @@ func (h *Handler) StopQueueItem(c echo.Context) error {
 	if err != nil {
 		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
 	}
+	recordAudit(c, audit.Entry{Action: audit.ActionQueueStop, ResourceType: "queue", ResourceID: uuid.String()})
 	return SuccessResponse(c, "", "queue item stopped")
 }
 
@@ -0,0 +1,278 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// Session keys stored alongside the user ID. Times are stored as unix seconds as the session codec cannot encode time.Time.
+const (
+	sessionKeyUserID    = "user_id"
+	sessionKeyID        = "session_id"
+	sessionKeyCreatedAt = "created_at"
+	sessionKeyLastSeen  = "last_seen"
+	sessionKeyIP        = "ip"
+	sessionKeyUserAgent = "user_agent"
+)
+
+// sessionLastSeenInterval limits how often the last seen time is written to the session store.
+const sessionLastSeenInterval = time.Minute
+
+type Session struct {
+	ID        string    `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	LastSeen  time.Time `json:"last_seen"`
+	IP        string    `json:"ip"`
+	UserAgent string    `json:"user_agent"`
+	Current   bool      `json:"current"`
+}
+
+// startSession logs the user in, renewing the session token to prevent session fixation.
+func startSession(c echo.Context, userID uuid.UUID) error {
+	ctx := c.Request().Context()
+	if err := sessionManager.RenewToken(ctx); err != nil {
+		return err
+	}
+
+	now := time.Now().Unix()
+	sessionManager.Put(ctx, sessionKeyUserID, userID.String())
+	sessionManager.Put(ctx, sessionKeyID, uuid.New().String())
+	sessionManager.Put(ctx, sessionKeyCreatedAt, now)
+	sessionManager.Put(ctx, sessionKeyLastSeen, now)
+	sessionManager.Put(ctx, sessionKeyIP, c.RealIP())
+	sessionManager.Put(ctx, sessionKeyUserAgent, c.Request().UserAgent())
+	return nil
+}
+
+// touchSession records when and from where the session was last used.
+func touchSession(c echo.Context) {
+	ctx := c.Request().Context()
+
+	// sessions created before session details were stored
+	if sessionManager.GetString(ctx, sessionKeyID) == "" {
+		sessionManager.Put(ctx, sessionKeyID, uuid.New().String())
+	}
+
+	lastSeen := sessionManager.GetInt64(ctx, sessionKeyLastSeen)
+	if time.Since(time.Unix(lastSeen, 0)) < sessionLastSeenInterval {
+		return
+	}
+	sessionManager.Put(ctx, sessionKeyLastSeen, time.Now().Unix())
+	sessionManager.Put(ctx, sessionKeyIP, c.RealIP())
+	sessionManager.Put(ctx, sessionKeyUserAgent, c.Request().UserAgent())
+}
+
+// getUserSessions returns the sessions of the user, most recently used first.
+func getUserSessions(ctx context.Context, userID uuid.UUID) ([]Session, error) {
+	currentID := sessionManager.GetString(ctx, sessionKeyID)
+	currentToken := sessionManager.Token(ctx)
+
+	sessions := []Session{}
+	err := sessionManager.Iterate(ctx, func(ctx context.Context) error {
+		if sessionManager.GetString(ctx, sessionKeyUserID) != userID.String() {
+			return nil
+		}
+		id := sessionManager.GetString(ctx, sessionKeyID)
+		if id == "" && sessionManager.Token(ctx) == currentToken {
+			// the ID of the request's session is saved when the request finishes
+			id = currentID
+		} else if id == "" {
+			// sessions created before session details were stored and not used since, assign an ID so they can be revoked
+			id = uuid.New().String()
+			sessionManager.Put(ctx, sessionKeyID, id)
+			if _, _, err := sessionManager.Commit(ctx); err != nil {
+				return err
+			}
+		}
+		sessions = append(sessions, Session{
+			ID:        id,
+			CreatedAt: time.Unix(sessionManager.GetInt64(ctx, sessionKeyCreatedAt), 0),
+			LastSeen:  time.Unix(sessionManager.GetInt64(ctx, sessionKeyLastSeen), 0),
+			IP:        sessionManager.GetString(ctx, sessionKeyIP),
+			UserAgent: sessionManager.GetString(ctx, sessionKeyUserAgent),
+			Current:   id != "" && id == currentID,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].LastSeen.After(sessions[j].LastSeen)
+	})
+	return sessions, nil
+}
+
+// destroyUserSessions destroys the sessions of the user matching the filter and returns how many were destroyed.
+func destroyUserSessions(ctx context.Context, userID uuid.UUID, filter func(sessionID string) bool) (int, error) {
+	currentID := sessionManager.GetString(ctx, sessionKeyID)
+	destroyed := 0
+	destroyedCurrent := false
+	err := sessionManager.Iterate(ctx, func(ctx context.Context) error {
+		if sessionManager.GetString(ctx, sessionKeyUserID) != userID.String() {
+			return nil
+		}
+		sessionID := sessionManager.GetString(ctx, sessionKeyID)
+		if filter != nil && !filter(sessionID) {
+			return nil
+		}
+		destroyed++
+		if currentID != "" && sessionID == currentID {
+			destroyedCurrent = true
+		}
+		return sessionManager.Destroy(ctx)
+	})
+	if err != nil {
+		return destroyed, err
+	}
+
+	// the session of the request would otherwise be saved again when the request finishes
+	if destroyedCurrent {
+		return destroyed, sessionManager.Destroy(ctx)
+	}
+	return destroyed, nil
+}
+
+// destroyOtherUserSessions destroys the sessions of the user except the session of the request.
+func destroyOtherUserSessions(ctx context.Context, userID uuid.UUID) (int, error) {
+	currentID := sessionManager.GetString(ctx, sessionKeyID)
+	return destroyUserSessions(ctx, userID, func(sessionID string) bool {
+		return currentID == "" || sessionID != currentID
+	})
+}
+
+// GetSessions godoc
+//
+//	@Summary		Get sessions
+//	@Description	Get the active sessions of the current user
+//	@Tags			auth
+//	@Produce		json
+//	@Success		200	{object}	[]Session
+//	@Failure		401	{object}	utils.ErrorResponse
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/auth/sessions [get]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) GetSessions(c echo.Context) error {
+	user := userFromContext(c)
+
+	sessions, err := getUserSessions(c.Request().Context(), user.ID)
+	if err != nil {
+		return ErrorResponse(c, http.StatusInternalServerError, "error getting sessions")
+	}
+
+	return SuccessResponse(c, sessions, "sessions")
+}
+
+// DeleteSession godoc
+//
+//	@Summary		Revoke session
+//	@Description	Revoke a session of the current user
+//	@Tags			auth
+//	@Produce		json
+//	@Param			id	path		string	true	"Session ID"
+//	@Success		200	{object}	string
+//	@Failure		401	{object}	utils.ErrorResponse
+//	@Failure		404	{object}	utils.ErrorResponse
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/auth/sessions/{id} [delete]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) DeleteSession(c echo.Context) error {
+	user := userFromContext(c)
+	id := c.Param("id")
+
+	destroyed, err := destroyUserSessions(c.Request().Context(), user.ID, func(sessionID string) bool {
+		return sessionID == id
+	})
+	if err != nil {
+		return ErrorResponse(c, http.StatusInternalServerError, "error revoking session")
+	}
+	if destroyed == 0 {
+		return ErrorResponse(c, http.StatusNotFound, "session not found")
+	}
+
+	return SuccessResponse(c, "", "session revoked")
+}
+
+// DeleteSessions godoc
+//
+//	@Summary		Revoke other sessions
+//	@Description	Revoke all sessions of the current user except the current one. Use /auth/logout to end the current session.
+//	@Tags			auth
+//	@Produce		json
+//	@Success		200	{object}	int
+//	@Failure		401	{object}	utils.ErrorResponse
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/auth/sessions [delete]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) DeleteSessions(c echo.Context) error {
+	user := userFromContext(c)
+
+	destroyed, err := destroyOtherUserSessions(c.Request().Context(), user.ID)
+	if err != nil {
+		return ErrorResponse(c, http.StatusInternalServerError, "error revoking sessions")
+	}
+
+	return SuccessResponse(c, destroyed, "sessions revoked")
+}
+
+// GetUserSessions godoc
+//
+//	@Summary		Get user sessions
+//	@Description	Get the active sessions of a user
+//	@Tags			users
+//	@Produce		json
+//	@Param			id	path		string	true	"User ID"
+//	@Success		200	{object}	[]Session
+//	@Failure		400	{object}	utils.ErrorResponse
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/user/{id}/sessions [get]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) GetUserSessions(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	sessions, err := getUserSessions(c.Request().Context(), id)
+	if err != nil {
+		return ErrorResponse(c, http.StatusInternalServerError, "error getting sessions")
+	}
+
+	return SuccessResponse(c, sessions, "sessions")
+}
+
+// LogoutUser godoc
+//
+//	@Summary		Force logout user
+//	@Description	Revoke all sessions and API tokens of a user
+//	@Tags			users
+//	@Produce		json
+//	@Param			id	path		string	true	"User ID"
+//	@Success		200	{object}	int
+//	@Failure		400	{object}	utils.ErrorResponse
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/user/{id}/logout [post]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) LogoutUser(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	destroyed, err := destroyUserSessions(c.Request().Context(), id, nil)
+	if err != nil {
+		return ErrorResponse(c, http.StatusInternalServerError, "error revoking sessions")
+	}
+	// API tokens would otherwise keep the user logged in
+	if _, err := h.Service.AuthService.DeleteUserAPITokens(c.Request().Context(), id); err != nil {
+		return ErrorResponse(c, http.StatusInternalServerError, "sessions revoked but api tokens could not be revoked")
+	}
+
+	return SuccessResponse(c, destroyed, "user logged out")
+}
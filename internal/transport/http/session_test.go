@@ -0,0 +1,114 @@
+package http_test
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/gavv/httpexpect/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zibbp/ganymede/ent"
+	internalHttp "github.com/zibbp/ganymede/internal/transport/http"
+	"github.com/zibbp/ganymede/tests"
+)
+
+// newDevice returns a client with its own cookie jar and user agent
+func newDevice(t *testing.T, userAgent string) *httpexpect.Expect {
+	return httpexpect.WithConfig(httpexpect.Config{
+		BaseURL:  fmt.Sprintf("http://localhost:%s/api/v1", os.Getenv("APP_PORT")),
+		Reporter: httpexpect.NewAssertReporter(t),
+		Client: &http.Client{
+			Jar: httpexpect.NewCookieJar(),
+		},
+	}).Builder(func(req *httpexpect.Request) {
+		req.WithHeader("User-Agent", userAgent)
+	})
+}
+
+func login(e *httpexpect.Expect, password string) *ent.User {
+	var user *ent.User
+	e.POST("/auth/login").WithJSON(internalHttp.LoginRequest{Username: "admin", Password: password}).Expect().Status(http.StatusOK).JSON().Object().Value("data").Decode(&user)
+	return user
+}
+
+func getSessions(e *httpexpect.Expect) []internalHttp.Session {
+	var sessions []internalHttp.Session
+	e.GET("/auth/sessions").Expect().Status(http.StatusOK).JSON().Object().Value("data").Decode(&sessions)
+	return sessions
+}
+
+// TestSessions tests listing and revoking sessions
+func TestSessions(t *testing.T) {
+	_, err := tests.SetupHTTP(t)
+	require.NoError(t, err)
+
+	first := newDevice(t, "first-device")
+	second := newDevice(t, "second-device")
+	admin := login(first, "ganymede")
+	login(second, "ganymede")
+
+	t.Run("session details are stored on login", func(t *testing.T) {
+		sessions := getSessions(first)
+		require.Len(t, sessions, 2)
+
+		userAgents := []string{}
+		for _, s := range sessions {
+			assert.NotEmpty(t, s.ID)
+			assert.NotEmpty(t, s.IP)
+			assert.False(t, s.CreatedAt.IsZero())
+			assert.False(t, s.LastSeen.Before(s.CreatedAt))
+			userAgents = append(userAgents, s.UserAgent)
+			if s.Current {
+				assert.Equal(t, "first-device", s.UserAgent)
+			}
+		}
+		assert.ElementsMatch(t, []string{"first-device", "second-device"}, userAgents)
+	})
+
+	t.Run("revoke a single session", func(t *testing.T) {
+		first.DELETE("/auth/sessions/unknown").Expect().Status(http.StatusNotFound)
+
+		third := newDevice(t, "third-device")
+		login(third, "ganymede")
+		for _, s := range getSessions(third) {
+			if s.Current {
+				first.DELETE("/auth/sessions/" + s.ID).Expect().Status(http.StatusOK)
+			}
+		}
+		third.GET("/auth/me").Expect().Status(http.StatusUnauthorized)
+		second.GET("/auth/me").Expect().Status(http.StatusOK)
+		assert.Len(t, getSessions(first), 2)
+	})
+
+	t.Run("revoke other sessions", func(t *testing.T) {
+		first.DELETE("/auth/sessions").Expect().Status(http.StatusOK).JSON().Object().Value("data").IsEqual(1)
+
+		second.GET("/auth/me").Expect().Status(http.StatusUnauthorized)
+		first.GET("/auth/me").Expect().Status(http.StatusOK)
+		login(second, "ganymede")
+	})
+
+	t.Run("change password revokes other sessions and api tokens", func(t *testing.T) {
+		token := first.POST("/auth/tokens").WithJSON(internalHttp.CreateAPITokenRequest{Name: "change-password"}).Expect().Status(http.StatusOK).JSON().Object().Value("data").Object().Value("token").String().Raw()
+
+		first.POST("/auth/change-password").WithJSON(internalHttp.ChangePasswordRequest{OldPassword: "ganymede", NewPassword: "ganymede1", ConfirmNewPassword: "ganymede1"}).Expect().Status(http.StatusOK)
+
+		first.GET("/auth/me").Expect().Status(http.StatusOK)
+		second.GET("/auth/me").Expect().Status(http.StatusUnauthorized)
+		newDevice(t, "api").GET("/auth/me").WithHeader("Authorization", "Bearer "+token).Expect().Status(http.StatusUnauthorized)
+		assert.Len(t, getSessions(first), 1)
+	})
+
+	t.Run("force logout revokes all sessions and api tokens", func(t *testing.T) {
+		login(second, "ganymede1")
+		token := first.POST("/auth/tokens").WithJSON(internalHttp.CreateAPITokenRequest{Name: "logout"}).Expect().Status(http.StatusOK).JSON().Object().Value("data").Object().Value("token").String().Raw()
+
+		first.POST("/user/" + admin.ID.String() + "/logout").Expect().Status(http.StatusOK).JSON().Object().Value("data").IsEqual(2)
+
+		first.GET("/auth/me").Expect().Status(http.StatusUnauthorized)
+		second.GET("/auth/me").Expect().Status(http.StatusUnauthorized)
+		newDevice(t, "api").GET("/auth/me").WithHeader("Authorization", "Bearer "+token).Expect().Status(http.StatusUnauthorized)
+	})
+}
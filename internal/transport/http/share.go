@@ -0,0 +1,213 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/internal/share"
+)
+
+type ShareService interface {
+	CreateShareLink(ctx context.Context, userID uuid.UUID, input share.ShareLink) (*ent.ShareLink, string, error)
+	GetVodShareLinks(ctx context.Context, vodID uuid.UUID) ([]*ent.ShareLink, error)
+	GetPlaylistShareLinks(ctx context.Context, playlistID uuid.UUID) ([]*ent.ShareLink, error)
+	DeleteShareLink(ctx context.Context, id uuid.UUID) error
+	OpenShareLink(ctx context.Context, token string) (*ent.ShareLink, error)
+}
+
+type CreateShareLinkRequest struct {
+	StartSeconds *int       `json:"start_seconds" validate:"omitempty,min=0"` // vods only
+	EndSeconds   *int       `json:"end_seconds" validate:"omitempty,min=1"`   // vods only
+	ExpiresAt    *time.Time `json:"expires_at"`
+}
+
+type CreateShareLinkResponse struct {
+	*ent.ShareLink
+	Token string `json:"token"`
+}
+
+// CreateVodShareLink godoc
+//
+//	@Summary		Create vod share link
+//	@Description	Create a link giving read-only access to the vod, its chat and chapters without an account. The link can be limited to a time range of the vod, the range is where the player starts and stops but the media files of the whole vod can be downloaded with the link. The token is only returned once and is sent as the share query parameter or the X-Share-Token header.
+//	@Tags			vods
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string					true	"Vod ID"
+//	@Param			body	body		CreateShareLinkRequest	true	"Share link"
+//	@Success		200		{object}	CreateShareLinkResponse
+//	@Failure		400		{object}	utils.ErrorResponse
+//	@Failure		404		{object}	utils.ErrorResponse
+//	@Failure		500		{object}	utils.ErrorResponse
+//	@Router			/vod/{id}/share [post]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) CreateVodShareLink(c echo.Context) error {
+	vID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	body := new(CreateShareLinkRequest)
+	if err := c.Bind(body); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	if err := c.Validate(body); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	return h.createShareLink(c, share.ShareLink{
+		VodID:        &vID,
+		StartSeconds: body.StartSeconds,
+		EndSeconds:   body.EndSeconds,
+		ExpiresAt:    body.ExpiresAt,
+	})
+}
+
+// CreatePlaylistShareLink godoc
+//
+//	@Summary		Create playlist share link
+//	@Description	Create a link giving read-only access to the playlist and its vods without an account. The token is only returned once and is sent as the share query parameter or the X-Share-Token header.
+//	@Tags			Playlist
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string					true	"Playlist ID"
+//	@Param			body	body		CreateShareLinkRequest	true	"Share link"
+//	@Success		200		{object}	CreateShareLinkResponse
+//	@Failure		400		{object}	utils.ErrorResponse
+//	@Failure		404		{object}	utils.ErrorResponse
+//	@Failure		500		{object}	utils.ErrorResponse
+//	@Router			/playlist/{id}/share [post]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) CreatePlaylistShareLink(c echo.Context) error {
+	pID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	body := new(CreateShareLinkRequest)
+	if err := c.Bind(body); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	if err := c.Validate(body); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	return h.createShareLink(c, share.ShareLink{
+		PlaylistID:   &pID,
+		StartSeconds: body.StartSeconds,
+		EndSeconds:   body.EndSeconds,
+		ExpiresAt:    body.ExpiresAt,
+	})
+}
+
+func (h *Handler) createShareLink(c echo.Context, input share.ShareLink) error {
+	user := userFromContext(c)
+	link, token, err := h.Service.ShareService.CreateShareLink(c.Request().Context(), user.ID, input)
+	if err != nil {
+		if errors.Is(err, share.ErrShareLinkNotFound) {
+			return ErrorResponse(c, http.StatusNotFound, "vod or playlist not found")
+		}
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	return SuccessResponse(c, CreateShareLinkResponse{ShareLink: link, Token: token}, "share link created")
+}
+
+// GetVodShareLinks godoc
+//
+//	@Summary		Get vod share links
+//	@Description	Get the share links of the vod with their view counts
+//	@Tags			vods
+//	@Produce		json
+//	@Param			id	path		string	true	"Vod ID"
+//	@Success		200	{object}	[]ent.ShareLink
+//	@Failure		400	{object}	utils.ErrorResponse
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/vod/{id}/share [get]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) GetVodShareLinks(c echo.Context) error {
+	vID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	links, err := h.Service.ShareService.GetVodShareLinks(c.Request().Context(), vID)
+	if err != nil {
+		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+	}
+	return SuccessResponse(c, links, "share links")
+}
+
+// GetPlaylistShareLinks godoc
+//
+//	@Summary		Get playlist share links
+//	@Description	Get the share links of the playlist with their view counts
+//	@Tags			Playlist
+//	@Produce		json
+//	@Param			id	path		string	true	"Playlist ID"
+//	@Success		200	{object}	[]ent.ShareLink
+//	@Failure		400	{object}	utils.ErrorResponse
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/playlist/{id}/share [get]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) GetPlaylistShareLinks(c echo.Context) error {
+	pID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	links, err := h.Service.ShareService.GetPlaylistShareLinks(c.Request().Context(), pID)
+	if err != nil {
+		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+	}
+	return SuccessResponse(c, links, "share links")
+}
+
+// DeleteShareLink godoc
+//
+//	@Summary		Revoke share link
+//	@Description	Revoke a share link, requests made with it are denied afterwards. Media tokens created with the link stay valid until they expire.
+//	@Tags			share
+//	@Produce		json
+//	@Param			id	path	string	true	"Share link ID"
+//	@Success		200
+//	@Failure		400	{object}	utils.ErrorResponse
+//	@Failure		404	{object}	utils.ErrorResponse
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/share/{id} [delete]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) DeleteShareLink(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	if err := h.Service.ShareService.DeleteShareLink(c.Request().Context(), id); err != nil {
+		if errors.Is(err, share.ErrShareLinkNotFound) {
+			return ErrorResponse(c, http.StatusNotFound, err.Error())
+		}
+		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+	}
+	return c.NoContent(http.StatusOK)
+}
+
+// OpenShareLink godoc
+//
+//	@Summary		Open share link
+//	@Description	Returns the share link of the token with its vod or playlist and counts the view. No session is required.
+//	@Tags			share
+//	@Produce		json
+//	@Param			token	path		string	true	"Share link token"
+//	@Success		200		{object}	ent.ShareLink
+//	@Failure		404		{object}	utils.ErrorResponse
+//	@Failure		500		{object}	utils.ErrorResponse
+//	@Router			/share/{token} [get]
+func (h *Handler) OpenShareLink(c echo.Context) error {
+	link, err := h.Service.ShareService.OpenShareLink(c.Request().Context(), c.Param("token"))
+	if err != nil {
+		if errors.Is(err, share.ErrShareLinkInvalid) {
+			return ErrorResponse(c, http.StatusNotFound, "share link not found")
+		}
+		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+	}
+	return SuccessResponse(c, link, "share link")
+}
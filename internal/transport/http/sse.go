@@ -0,0 +1,42 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// serverSentEventKeepAliveInterval is how often a comment is sent to keep idle event streams open through proxies.
+const serverSentEventKeepAliveInterval = 15 * time.Second
+
+// startServerSentEvents writes the headers of a server-sent events response.
+func startServerSentEvents(c echo.Context) {
+	res := c.Response()
+	res.Header().Set(echo.HeaderContentType, "text/event-stream")
+	res.Header().Set(echo.HeaderCacheControl, "no-cache")
+	res.Header().Set(echo.HeaderConnection, "keep-alive")
+	// disable response buffering in nginx
+	res.Header().Set("X-Accel-Buffering", "no")
+	res.WriteHeader(http.StatusOK)
+	res.Flush()
+}
+
+// writeServerSentEvent writes an event and flushes it to the client.
+func writeServerSentEvent(res *echo.Response, event string, data []byte) error {
+	if _, err := fmt.Fprintf(res, "event: %s\ndata: %s\n\n", event, data); err != nil {
+		return err
+	}
+	res.Flush()
+	return nil
+}
+
+// writeServerSentEventKeepAlive writes a comment that is ignored by clients.
+func writeServerSentEventKeepAlive(res *echo.Response) error {
+	if _, err := fmt.Fprint(res, ": keep-alive\n\n"); err != nil {
+		return err
+	}
+	res.Flush()
+	return nil
+}
@@ -0,0 +1,216 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/internal/audit"
+	"github.com/zibbp/ganymede/internal/tag"
+)
+
+type TagService interface {
+	GetTags(ctx context.Context) ([]*ent.Tag, error)
+	CreateTag(ctx context.Context, name string) (*ent.Tag, error)
+	UpdateTag(ctx context.Context, id uuid.UUID, name string) (*ent.Tag, error)
+	DeleteTag(ctx context.Context, id uuid.UUID) error
+	GetVodTags(ctx context.Context, vodID uuid.UUID) ([]*ent.Tag, error)
+	SetVodTags(ctx context.Context, vodID uuid.UUID, names []string) ([]*ent.Tag, error)
+}
+
+type TagRequest struct {
+	Name string `json:"name" validate:"required,max=50"`
+}
+
+type SetVodTagsRequest struct {
+	Tags []string `json:"tags" validate:"max=100,dive,required,max=50"`
+}
+
+// GetTags godoc
+//
+//	@Summary		Get tags
+//	@Description	Get all tags
+//	@Tags			tags
+//	@Produce		json
+//	@Success		200	{array}		ent.Tag
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/tag [get]
+func (h *Handler) GetTags(c echo.Context) error {
+	tags, err := h.Service.TagService.GetTags(c.Request().Context())
+	if err != nil {
+		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+	}
+	return SuccessResponse(c, tags, "tags")
+}
+
+// CreateTag godoc
+//
+//	@Summary		Create tag
+//	@Description	Create a tag
+//	@Tags			tags
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body		TagRequest	true	"Tag"
+//	@Success		200		{object}	ent.Tag
+//	@Failure		400		{object}	utils.ErrorResponse
+//	@Failure		409		{object}	utils.ErrorResponse
+//	@Failure		500		{object}	utils.ErrorResponse
+//	@Router			/tag [post]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) CreateTag(c echo.Context) error {
+	body := new(TagRequest)
+	if err := c.Bind(body); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	if err := c.Validate(body); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	t, err := h.Service.TagService.CreateTag(c.Request().Context(), body.Name)
+	if err != nil {
+		return tagErrorResponse(c, err)
+	}
+	return SuccessResponse(c, t, "tag created")
+}
+
+// UpdateTag godoc
+//
+//	@Summary		Update tag
+//	@Description	Rename a tag
+//	@Tags			tags
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string		true	"Tag ID"
+//	@Param			body	body		TagRequest	true	"Tag"
+//	@Success		200		{object}	ent.Tag
+//	@Failure		400		{object}	utils.ErrorResponse
+//	@Failure		404		{object}	utils.ErrorResponse
+//	@Failure		409		{object}	utils.ErrorResponse
+//	@Failure		500		{object}	utils.ErrorResponse
+//	@Router			/tag/{id} [put]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) UpdateTag(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	body := new(TagRequest)
+	if err := c.Bind(body); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	if err := c.Validate(body); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	t, err := h.Service.TagService.UpdateTag(c.Request().Context(), id, body.Name)
+	if err != nil {
+		return tagErrorResponse(c, err)
+	}
+	return SuccessResponse(c, t, "tag updated")
+}
+
+// DeleteTag godoc
+//
+//	@Summary		Delete tag
+//	@Description	Delete a tag and remove it from all vods
+//	@Tags			tags
+//	@Param			id	path	string	true	"Tag ID"
+//	@Success		200
+//	@Failure		400	{object}	utils.ErrorResponse
+//	@Failure		404	{object}	utils.ErrorResponse
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/tag/{id} [delete]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) DeleteTag(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	if err := h.Service.TagService.DeleteTag(c.Request().Context(), id); err != nil {
+		return tagErrorResponse(c, err)
+	}
+	recordAudit(c, audit.Entry{Action: audit.ActionTagDelete, ResourceType: "tag", ResourceID: id.String()})
+	return c.NoContent(http.StatusOK)
+}
+
+// GetVodTags godoc
+//
+//	@Summary		Get vod tags
+//	@Description	Get the tags of a vod
+//	@Tags			tags
+//	@Produce		json
+//	@Param			id	path		string	true	"Vod ID"
+//	@Success		200	{array}		ent.Tag
+//	@Failure		400	{object}	utils.ErrorResponse
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/vod/{id}/tags [get]
+func (h *Handler) GetVodTags(c echo.Context) error {
+	vID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	tags, err := h.Service.TagService.GetVodTags(c.Request().Context(), vID)
+	if err != nil {
+		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+	}
+	return SuccessResponse(c, tags, "vod tags")
+}
+
+// SetVodTags godoc
+//
+//	@Summary		Set vod tags
+//	@Description	Replace the tags of a vod, tags that don't exist yet are created
+//	@Tags			tags
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string				true	"Vod ID"
+//	@Param			body	body		SetVodTagsRequest	true	"Tags"
+//	@Success		200		{array}		ent.Tag
+//	@Failure		400		{object}	utils.ErrorResponse
+//	@Failure		404		{object}	utils.ErrorResponse
+//	@Failure		500		{object}	utils.ErrorResponse
+//	@Router			/vod/{id}/tags [put]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) SetVodTags(c echo.Context) error {
+	vID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	body := new(SetVodTagsRequest)
+	if err := c.Bind(body); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	if err := c.Validate(body); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	tags, err := h.Service.TagService.SetVodTags(c.Request().Context(), vID, body.Tags)
+	if err != nil {
+		return tagErrorResponse(c, err)
+	}
+	return SuccessResponse(c, tags, "vod tags updated")
+}
+
+func tagErrorResponse(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, tag.ErrTagNotFound), errors.Is(err, tag.ErrVodNotFound):
+		return ErrorResponse(c, http.StatusNotFound, err.Error())
+	case errors.Is(err, tag.ErrTagExists):
+		return ErrorResponse(c, http.StatusConflict, err.Error())
+	case errors.Is(err, tag.ErrTagInvalid):
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+}
+
+// splitTags parses a comma separated tags query param.
+func splitTags(raw string) []string {
+	var tags []string
+	for _, t := range strings.Split(raw, ",") {
+		if t = strings.TrimSpace(t); t != "" {
+			tags = append(tags, t)
+		}
+	}
+	return tags
+}
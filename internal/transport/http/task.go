@@ -5,6 +5,7 @@ import (
 	"net/http"
 
 	"github.com/labstack/echo/v4"
+	"github.com/zibbp/ganymede/internal/audit"
 )
 
 type TaskService interface {
@@ -38,5 +39,6 @@ func (h *Handler) StartTask(c echo.Context) error {
 	if err := h.Service.TaskService.StartTask(c.Request().Context(), str.Task); err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
+	recordAudit(c, audit.Entry{Action: audit.ActionTaskStart, ResourceType: "task", ResourceID: str.Task})
 	return c.NoContent(http.StatusOK)
 }
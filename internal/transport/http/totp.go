@@ -0,0 +1,307 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+	"github.com/zibbp/ganymede/internal/auth"
+)
+
+// Session keys of a login waiting for its second step.
+const (
+	sessionKeyTwoFactorUserID   = "two_factor_user_id"
+	sessionKeyTwoFactorUsername = "two_factor_username"
+	sessionKeyTwoFactorExpires  = "two_factor_expires"
+	sessionKeyTwoFactorAttempts = "two_factor_attempts"
+)
+
+const (
+	twoFactorLoginTimeout     = 5 * time.Minute
+	twoFactorLoginMaxAttempts = 5
+)
+
+type TwoFactorCodeRequest struct {
+	Code string `json:"code" validate:"required"`
+}
+
+type TwoFactorLoginResponse struct {
+	TwoFactorRequired bool `json:"two_factor_required"`
+}
+
+type RecoveryCodesResponse struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+// startTwoFactorLogin stores the user waiting for the second login step. The session does not get the user ID until the code is verified.
+// The username the user logged in with is kept to throttle codes together with the password.
+func startTwoFactorLogin(c echo.Context, userID uuid.UUID, username string) error {
+	ctx := c.Request().Context()
+	if err := sessionManager.RenewToken(ctx); err != nil {
+		return err
+	}
+	sessionManager.Remove(ctx, sessionKeyUserID)
+	sessionManager.Put(ctx, sessionKeyTwoFactorUserID, userID.String())
+	sessionManager.Put(ctx, sessionKeyTwoFactorUsername, username)
+	sessionManager.Put(ctx, sessionKeyTwoFactorExpires, time.Now().Add(twoFactorLoginTimeout).Unix())
+	sessionManager.Put(ctx, sessionKeyTwoFactorAttempts, 0)
+	return nil
+}
+
+func clearTwoFactorLogin(c echo.Context) {
+	ctx := c.Request().Context()
+	sessionManager.Remove(ctx, sessionKeyTwoFactorUserID)
+	sessionManager.Remove(ctx, sessionKeyTwoFactorUsername)
+	sessionManager.Remove(ctx, sessionKeyTwoFactorExpires)
+	sessionManager.Remove(ctx, sessionKeyTwoFactorAttempts)
+}
+
+// twoFactorSetupRoutes are the routes reachable by users that must enable two-factor authentication first, keyed by method and path.
+var twoFactorSetupRoutes = map[string]bool{}
+
+// allowTwoFactorSetup makes the route reachable by users that must enable two-factor authentication first.
+func allowTwoFactorSetup(r *echo.Route) {
+	twoFactorSetupRoutes[r.Method+" "+r.Path] = true
+}
+
+// twoFactorSetupRoute returns whether the route is reachable by users that must enable two-factor authentication first.
+func twoFactorSetupRoute(c echo.Context) bool {
+	return twoFactorSetupRoutes[c.Request().Method+" "+c.Path()]
+}
+
+// LoginTwoFactor godoc
+//
+//	@Summary		Verify two-factor login
+//	@Description	Second login step for users with two-factor authentication. Accepts a TOTP code or a recovery code.
+//	@Tags			auth
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body		TwoFactorCodeRequest	true	"Code"
+//	@Success		200		{object}	ent.User
+//	@Failure		400		{object}	utils.ErrorResponse
+//	@Failure		401		{object}	utils.ErrorResponse
+//	@Failure		429		{object}	utils.ErrorResponse
+//	@Failure		500		{object}	utils.ErrorResponse
+//	@Router			/auth/login/2fa [post]
+func (h *Handler) LoginTwoFactor(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	body := new(TwoFactorCodeRequest)
+	if err := c.Bind(body); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	if err := c.Validate(body); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	userID, err := uuid.Parse(sessionManager.GetString(ctx, sessionKeyTwoFactorUserID))
+	if err != nil || time.Now().Unix() > sessionManager.GetInt64(ctx, sessionKeyTwoFactorExpires) {
+		clearTwoFactorLogin(c)
+		return ErrorResponse(c, http.StatusUnauthorized, "no pending login, log in again")
+	}
+
+	attempts := sessionManager.GetInt(ctx, sessionKeyTwoFactorAttempts) + 1
+	if attempts > twoFactorLoginMaxAttempts {
+		clearTwoFactorLogin(c)
+		return ErrorResponse(c, http.StatusUnauthorized, "too many attempts, log in again")
+	}
+	sessionManager.Put(ctx, sessionKeyTwoFactorAttempts, attempts)
+
+	// codes are throttled with the password so logging in again does not allow more guesses
+	username := sessionManager.GetString(ctx, sessionKeyTwoFactorUsername)
+	if err := h.Service.AuthService.StartLoginAttempt(ctx, c.RealIP(), username); err != nil {
+		return throttleErrorResponse(c, err)
+	}
+
+	u, err := h.Service.AuthService.VerifyTwoFactor(ctx, userID, body.Code)
+	if err != nil {
+		if errors.Is(err, auth.ErrTwoFactorInvalidCode) {
+			if err := h.Service.AuthService.RecordLoginFailure(ctx, c.RealIP(), username); err != nil {
+				log.Error().Err(err).Msg("error recording failed login")
+			}
+			return ErrorResponse(c, http.StatusUnauthorized, err.Error())
+		}
+		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+	}
+
+	if err := h.Service.AuthService.ForgiveLoginAttempt(ctx, c.RealIP()); err != nil {
+		log.Error().Err(err).Msg("error updating login throttle")
+	}
+	if err := h.Service.AuthService.ResetLoginThrottle(ctx, username); err != nil {
+		log.Error().Err(err).Msg("error resetting login throttle")
+	}
+
+	clearTwoFactorLogin(c)
+	if err := startSession(c, u.ID); err != nil {
+		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+	}
+
+	return SuccessResponse(c, u, "successfully logged in")
+}
+
+// EnrollTwoFactor godoc
+//
+//	@Summary		Enroll two-factor authentication
+//	@Description	Generate a TOTP secret for the current user. Two-factor authentication is enabled after a code is verified.
+//	@Tags			auth
+//	@Produce		json
+//	@Success		200	{object}	auth.TOTPEnrollment
+//	@Failure		400	{object}	utils.ErrorResponse
+//	@Failure		401	{object}	utils.ErrorResponse
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/auth/2fa/enroll [post]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) EnrollTwoFactor(c echo.Context) error {
+	user := userFromContext(c)
+
+	enrollment, err := h.Service.AuthService.EnrollTOTP(c.Request().Context(), user)
+	if err != nil {
+		return twoFactorErrorResponse(c, err)
+	}
+
+	return SuccessResponse(c, enrollment, "scan the uri with an authenticator app and verify a code to enable two-factor authentication")
+}
+
+// EnableTwoFactor godoc
+//
+//	@Summary		Enable two-factor authentication
+//	@Description	Verify a code of the enrolled secret and enable two-factor authentication. The recovery codes are only returned once.
+//	@Tags			auth
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body		TwoFactorCodeRequest	true	"Code"
+//	@Success		200		{object}	RecoveryCodesResponse
+//	@Failure		400		{object}	utils.ErrorResponse
+//	@Failure		401		{object}	utils.ErrorResponse
+//	@Failure		500		{object}	utils.ErrorResponse
+//	@Router			/auth/2fa/enable [post]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) EnableTwoFactor(c echo.Context) error {
+	user := userFromContext(c)
+
+	body := new(TwoFactorCodeRequest)
+	if err := c.Bind(body); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	if err := c.Validate(body); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	codes, err := h.Service.AuthService.EnableTOTP(c.Request().Context(), user, body.Code)
+	if err != nil {
+		return twoFactorErrorResponse(c, err)
+	}
+
+	return SuccessResponse(c, RecoveryCodesResponse{RecoveryCodes: codes}, "two-factor authentication enabled")
+}
+
+// DisableTwoFactor godoc
+//
+//	@Summary		Disable two-factor authentication
+//	@Description	Disable two-factor authentication of the current user with a TOTP or recovery code
+//	@Tags			auth
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body		TwoFactorCodeRequest	true	"Code"
+//	@Success		200		{object}	string
+//	@Failure		400		{object}	utils.ErrorResponse
+//	@Failure		401		{object}	utils.ErrorResponse
+//	@Failure		403		{object}	utils.ErrorResponse
+//	@Failure		500		{object}	utils.ErrorResponse
+//	@Router			/auth/2fa/disable [post]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) DisableTwoFactor(c echo.Context) error {
+	user := userFromContext(c)
+
+	body := new(TwoFactorCodeRequest)
+	if err := c.Bind(body); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	if err := c.Validate(body); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	if err := h.Service.AuthService.DisableTOTP(c.Request().Context(), user, body.Code); err != nil {
+		return twoFactorErrorResponse(c, err)
+	}
+
+	return SuccessResponse(c, "", "two-factor authentication disabled")
+}
+
+// RegenerateRecoveryCodes godoc
+//
+//	@Summary		Regenerate recovery codes
+//	@Description	Replace the recovery codes of the current user after verifying a TOTP code
+//	@Tags			auth
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body		TwoFactorCodeRequest	true	"Code"
+//	@Success		200		{object}	RecoveryCodesResponse
+//	@Failure		400		{object}	utils.ErrorResponse
+//	@Failure		401		{object}	utils.ErrorResponse
+//	@Failure		500		{object}	utils.ErrorResponse
+//	@Router			/auth/2fa/recovery-codes [post]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) RegenerateRecoveryCodes(c echo.Context) error {
+	user := userFromContext(c)
+
+	body := new(TwoFactorCodeRequest)
+	if err := c.Bind(body); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	if err := c.Validate(body); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	codes, err := h.Service.AuthService.RegenerateRecoveryCodes(c.Request().Context(), user, body.Code)
+	if err != nil {
+		return twoFactorErrorResponse(c, err)
+	}
+
+	return SuccessResponse(c, RecoveryCodesResponse{RecoveryCodes: codes}, "recovery codes regenerated")
+}
+
+// ResetUserTwoFactor godoc
+//
+//	@Summary		Reset two-factor authentication
+//	@Description	Disable two-factor authentication of a user, for users that lost their authenticator and recovery codes
+//	@Tags			users
+//	@Produce		json
+//	@Param			id	path		string	true	"User ID"
+//	@Success		200	{object}	string
+//	@Failure		400	{object}	utils.ErrorResponse
+//	@Failure		404	{object}	utils.ErrorResponse
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/user/{id}/2fa [delete]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) ResetUserTwoFactor(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	if err := h.Service.AuthService.ResetTOTP(c.Request().Context(), id); err != nil {
+		if errors.Is(err, auth.ErrTwoFactorUserNotFound) {
+			return ErrorResponse(c, http.StatusNotFound, err.Error())
+		}
+		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+	}
+
+	return SuccessResponse(c, "", "two-factor authentication reset")
+}
+
+func twoFactorErrorResponse(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, auth.ErrTwoFactorInvalidCode):
+		return ErrorResponse(c, http.StatusUnauthorized, err.Error())
+	case errors.Is(err, auth.ErrTwoFactorRequired):
+		return ErrorResponse(c, http.StatusForbidden, err.Error())
+	case errors.Is(err, auth.ErrTwoFactorNotEnrolled), errors.Is(err, auth.ErrTwoFactorEnabled), errors.Is(err, auth.ErrTwoFactorOAuth):
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	default:
+		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+	}
+}
@@ -0,0 +1,150 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/internal/audit"
+	"github.com/zibbp/ganymede/internal/trash"
+)
+
+type TrashService interface {
+	GetTrash(ctx context.Context) (trash.Trash, error)
+	RestoreVod(ctx context.Context, vodID uuid.UUID) (*ent.Vod, error)
+	RestoreChannel(ctx context.Context, channelID uuid.UUID) (*ent.Channel, error)
+	PurgeVod(ctx context.Context, vodID uuid.UUID) error
+	PurgeChannel(ctx context.Context, channelID uuid.UUID) error
+}
+
+// GetTrash godoc
+//
+//	@Summary		Get trash
+//	@Description	Get the deleted vods and channels that can be restored until they are purged
+//	@Tags			trash
+//	@Produce		json
+//	@Success		200	{object}	trash.Trash
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/trash [get]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) GetTrash(c echo.Context) error {
+	t, err := h.Service.TrashService.GetTrash(c.Request().Context())
+	if err != nil {
+		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+	}
+	return SuccessResponse(c, t, "trash")
+}
+
+// RestoreVod godoc
+//
+//	@Summary		Restore vod
+//	@Description	Restore a deleted vod and move its files back
+//	@Tags			trash
+//	@Produce		json
+//	@Param			id	path		string	true	"Vod ID"
+//	@Success		200	{object}	ent.Vod
+//	@Failure		400	{object}	utils.ErrorResponse
+//	@Failure		404	{object}	utils.ErrorResponse
+//	@Failure		409	{object}	utils.ErrorResponse
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/trash/vod/{id}/restore [post]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) RestoreVod(c echo.Context) error {
+	vID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	v, err := h.Service.TrashService.RestoreVod(c.Request().Context(), vID)
+	if err != nil {
+		return trashErrorResponse(c, err)
+	}
+	recordAudit(c, audit.Entry{Action: audit.ActionVodRestore, ResourceType: "vod", ResourceID: vID.String()})
+	return SuccessResponse(c, v, "vod restored")
+}
+
+// PurgeVod godoc
+//
+//	@Summary		Purge vod
+//	@Description	Permanently delete a vod in the trash and its files
+//	@Tags			trash
+//	@Param			id	path	string	true	"Vod ID"
+//	@Success		200
+//	@Failure		400	{object}	utils.ErrorResponse
+//	@Failure		404	{object}	utils.ErrorResponse
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/trash/vod/{id} [delete]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) PurgeVod(c echo.Context) error {
+	vID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	if err := h.Service.TrashService.PurgeVod(c.Request().Context(), vID); err != nil {
+		return trashErrorResponse(c, err)
+	}
+	recordAudit(c, audit.Entry{Action: audit.ActionVodPurge, ResourceType: "vod", ResourceID: vID.String()})
+	return c.NoContent(http.StatusOK)
+}
+
+// RestoreChannel godoc
+//
+//	@Summary		Restore channel
+//	@Description	Restore a deleted channel and the vods deleted with it
+//	@Tags			trash
+//	@Produce		json
+//	@Param			id	path		string	true	"Channel ID"
+//	@Success		200	{object}	ent.Channel
+//	@Failure		400	{object}	utils.ErrorResponse
+//	@Failure		404	{object}	utils.ErrorResponse
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/trash/channel/{id}/restore [post]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) RestoreChannel(c echo.Context) error {
+	cID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	cha, err := h.Service.TrashService.RestoreChannel(c.Request().Context(), cID)
+	if err != nil {
+		return trashErrorResponse(c, err)
+	}
+	recordAudit(c, audit.Entry{Action: audit.ActionChannelRestore, ResourceType: "channel", ResourceID: cID.String()})
+	return SuccessResponse(c, cha, "channel restored")
+}
+
+// PurgeChannel godoc
+//
+//	@Summary		Purge channel
+//	@Description	Permanently delete a channel in the trash and its vods
+//	@Tags			trash
+//	@Param			id	path	string	true	"Channel ID"
+//	@Success		200
+//	@Failure		400	{object}	utils.ErrorResponse
+//	@Failure		404	{object}	utils.ErrorResponse
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/trash/channel/{id} [delete]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) PurgeChannel(c echo.Context) error {
+	cID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	if err := h.Service.TrashService.PurgeChannel(c.Request().Context(), cID); err != nil {
+		return trashErrorResponse(c, err)
+	}
+	recordAudit(c, audit.Entry{Action: audit.ActionChannelPurge, ResourceType: "channel", ResourceID: cID.String()})
+	return c.NoContent(http.StatusOK)
+}
+
+func trashErrorResponse(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, trash.ErrVodNotFound), errors.Is(err, trash.ErrChannelNotFound):
+		return ErrorResponse(c, http.StatusNotFound, err.Error())
+	case errors.Is(err, trash.ErrChannelDeleted), errors.Is(err, trash.ErrRestoreExists):
+		return ErrorResponse(c, http.StatusConflict, err.Error())
+	}
+	return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+}
@@ -2,11 +2,13 @@ package http
 
 import (
 	"context"
+	"errors"
 	"net/http"
 
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/internal/audit"
 	"github.com/zibbp/ganymede/internal/user"
 	"github.com/zibbp/ganymede/internal/utils"
 )
@@ -16,11 +18,16 @@ type UserService interface {
 	AdminGetUser(c echo.Context, id uuid.UUID) (*ent.User, error)
 	AdminUpdateUser(c echo.Context, uDto user.User) (*ent.User, error)
 	AdminDeleteUser(c echo.Context, id uuid.UUID) error
+	GetSubscriptions(ctx context.Context, userID uuid.UUID) ([]*ent.NotificationSubscription, error)
+	CreateSubscription(ctx context.Context, userID uuid.UUID, input user.Subscription) (*ent.NotificationSubscription, error)
+	DeleteSubscription(ctx context.Context, userID uuid.UUID, subscriptionID uuid.UUID) error
+	UpdateWebhook(ctx context.Context, userID uuid.UUID, webhook string) (*ent.User, error)
 }
 
 type UpdateChannelRequest struct {
-	Username string `json:"username" validate:"required,min=2,max=50"`
-	Role     string `json:"role" validate:"required,oneof=admin editor archiver user"`
+	Username string   `json:"username" validate:"required,min=2,max=50"`
+	Role     string   `json:"role" validate:"required,oneof=admin editor archiver user"`
+	Groups   []string `json:"groups" validate:"omitempty,dive,required"` // groups used by channel and playlist access, unchanged if omitted
 }
 
 // GetUsers godoc
@@ -98,11 +105,17 @@ func (h *Handler) UpdateUser(c echo.Context) error {
 		ID:       uID,
 		Username: usr.Username,
 		Role:     utils.Role(usr.Role),
+		Groups:   usr.Groups,
+	}
+	before, err := h.Service.UserService.AdminGetUser(c, uID)
+	if err != nil {
+		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
 	}
 	u, err := h.Service.UserService.AdminUpdateUser(c, uDto)
 	if err != nil {
 		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
 	}
+	recordAudit(c, audit.Entry{Action: audit.ActionUserUpdate, ResourceType: "user", ResourceID: uID.String(), Before: before, After: u})
 	return SuccessResponse(c, u, "user updated")
 }
 
@@ -124,24 +137,156 @@ func (h *Handler) DeleteUser(c echo.Context) error {
 	if err != nil {
 		return ErrorResponse(c, http.StatusBadRequest, err.Error())
 	}
+	before, err := h.Service.UserService.AdminGetUser(c, id)
+	if err != nil {
+		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+	}
 	err = h.Service.UserService.AdminDeleteUser(c, id)
 	if err != nil {
 		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
 	}
+	recordAudit(c, audit.Entry{Action: audit.ActionUserDelete, ResourceType: "user", ResourceID: id.String(), Before: before})
 
 	// destroy sessions
-	err = h.SessionManager.Iterate(c.Request().Context(), func(ctx context.Context) error {
-		userID := sessionManager.GetString(ctx, "user_id")
+	if _, err := destroyUserSessions(c.Request().Context(), id, nil); err != nil {
+		return ErrorResponse(c, 500, "error deleting user sessions")
+	}
 
-		if userID == id.String() {
-			return sessionManager.Destroy(ctx)
-		}
+	return c.NoContent(http.StatusOK)
+}
+
+type CreateSubscriptionRequest struct {
+	Event        utils.SubscriptionEvent        `json:"event" validate:"required,oneof=channel_live archive_completed playlist_video_added"`
+	ProviderType utils.NotificationProviderType `json:"provider_type" validate:"omitempty,oneof=webhook discord slack ntfy gotify apprise"`
+	URL          string                         `json:"url" validate:"omitempty,url"` // the user's webhook is used if empty
+	Token        string                         `json:"token"`
+	ChannelID    uuid.UUID                      `json:"channel_id" validate:"required_unless=Event playlist_video_added"`
+	PlaylistID   uuid.UUID                      `json:"playlist_id" validate:"required_if=Event playlist_video_added"`
+}
+
+type UpdateWebhookRequest struct {
+	Webhook string `json:"webhook" validate:"omitempty,url"`
+}
+
+// GetSubscriptions godoc
+//
+//	@Summary		Get subscriptions
+//	@Description	Get the notification subscriptions of the current user
+//	@Tags			user
+//	@Produce		json
+//	@Success		200	{object}	[]ent.NotificationSubscription
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/user/me/subscriptions [get]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) GetSubscriptions(c echo.Context) error {
+	u := userFromContext(c)
+	subscriptions, err := h.Service.UserService.GetSubscriptions(c.Request().Context(), u.ID)
+	if err != nil {
+		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+	}
+	return SuccessResponse(c, subscriptions, "subscriptions")
+}
 
-		return nil
+// CreateSubscription godoc
+//
+//	@Summary		Create subscription
+//	@Description	Subscribe the current user to an event of a channel or playlist. Notifications are sent to the subscription's URL or the user's webhook.
+//	@Tags			user
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body		CreateSubscriptionRequest	true	"Subscription"
+//	@Success		200		{object}	ent.NotificationSubscription
+//	@Failure		400		{object}	utils.ErrorResponse
+//	@Failure		500		{object}	utils.ErrorResponse
+//	@Router			/user/me/subscriptions [post]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) CreateSubscription(c echo.Context) error {
+	var body CreateSubscriptionRequest
+	if err := c.Bind(&body); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	if err := c.Validate(body); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	u := userFromContext(c)
+	if body.URL == "" && u.Webhook == "" {
+		return ErrorResponse(c, http.StatusBadRequest, "url is required when no webhook is set")
+	}
+	if body.ProviderType == "" {
+		body.ProviderType = utils.NotificationProviderWebhook
+	}
+
+	subscription, err := h.Service.UserService.CreateSubscription(c.Request().Context(), u.ID, user.Subscription{
+		Event:        body.Event,
+		ProviderType: body.ProviderType,
+		URL:          body.URL,
+		Token:        body.Token,
+		ChannelID:    body.ChannelID,
+		PlaylistID:   body.PlaylistID,
 	})
 	if err != nil {
-		return ErrorResponse(c, 500, "error deleting user sessions")
+		if ent.IsConstraintError(err) || errors.Is(err, user.ErrSubscriptionTargetNotFound) {
+			return ErrorResponse(c, http.StatusBadRequest, "channel or playlist not found")
+		}
+		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
 	}
+	return SuccessResponse(c, subscription, "subscription created")
+}
 
+// DeleteSubscription godoc
+//
+//	@Summary		Delete subscription
+//	@Description	Delete a notification subscription of the current user
+//	@Tags			user
+//	@Param			id	path	string	true	"Subscription ID"
+//	@Success		200
+//	@Failure		400	{object}	utils.ErrorResponse
+//	@Failure		404	{object}	utils.ErrorResponse
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/user/me/subscriptions/{id} [delete]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) DeleteSubscription(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	u := userFromContext(c)
+	err = h.Service.UserService.DeleteSubscription(c.Request().Context(), u.ID, id)
+	if err != nil {
+		if errors.Is(err, user.ErrSubscriptionNotFound) {
+			return ErrorResponse(c, http.StatusNotFound, err.Error())
+		}
+		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+	}
 	return c.NoContent(http.StatusOK)
 }
+
+// UpdateWebhook godoc
+//
+//	@Summary		Update webhook
+//	@Description	Set the webhook of the current user that subscriptions without their own URL are sent to
+//	@Tags			user
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body		UpdateWebhookRequest	true	"Webhook"
+//	@Success		200		{object}	ent.User
+//	@Failure		400		{object}	utils.ErrorResponse
+//	@Failure		500		{object}	utils.ErrorResponse
+//	@Router			/user/me/webhook [put]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) UpdateWebhook(c echo.Context) error {
+	var body UpdateWebhookRequest
+	if err := c.Bind(&body); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	if err := c.Validate(body); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	u := userFromContext(c)
+	updated, err := h.Service.UserService.UpdateWebhook(c.Request().Context(), u.ID, body.Webhook)
+	if err != nil {
+		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+	}
+	return SuccessResponse(c, updated, "webhook updated")
+}
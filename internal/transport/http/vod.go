@@ -2,7 +2,9 @@ package http
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math"
 	"net/http"
 	"strconv"
 	"strings"
@@ -17,10 +19,12 @@ import (
 	entChapter "github.com/zibbp/ganymede/ent/chapter"
 	"github.com/zibbp/ganymede/ent/predicate"
 	entVod "github.com/zibbp/ganymede/ent/vod"
+	"github.com/zibbp/ganymede/internal/audit"
 	"github.com/zibbp/ganymede/internal/chat"
 	"github.com/zibbp/ganymede/internal/config"
 	"github.com/zibbp/ganymede/internal/exec"
 	"github.com/zibbp/ganymede/internal/platform"
+	"github.com/zibbp/ganymede/internal/share"
 	"github.com/zibbp/ganymede/internal/utils"
 	"github.com/zibbp/ganymede/internal/vod"
 )
@@ -33,9 +37,9 @@ type VodService interface {
 	GetVodByExternalId(ctx context.Context, externalId string) (*ent.Vod, error)
 	DeleteVod(ctx context.Context, vID uuid.UUID, deleteFiles bool) error
 	UpdateVod(c echo.Context, vID uuid.UUID, vod vod.Vod, cID uuid.UUID) (*ent.Vod, error)
-	SearchVods(ctx context.Context, limit int, offset int, types []utils.VodType, predicates []predicate.Vod, sortBy utils.VideoSort, order utils.SortOrder) (vod.Pagination, error)
+	SearchVods(ctx context.Context, limit int, offset int, types []utils.VodType, tags []string, predicates []predicate.Vod, sortBy utils.VideoSort, order utils.SortOrder) (vod.Pagination, error)
 	GetVodPlaylists(c echo.Context, vID uuid.UUID) ([]*ent.Playlist, error)
-	GetVodsPagination(c echo.Context, limit int, offset int, channelId uuid.UUID, types []utils.VodType, playlistId uuid.UUID, processing bool, sortBy utils.VideoSort, sortOrder utils.SortOrder) (vod.Pagination, error)
+	GetVodsPagination(c echo.Context, limit int, offset int, channelId uuid.UUID, types []utils.VodType, tags []string, playlistId uuid.UUID, processing bool, sortBy utils.VideoSort, sortOrder utils.SortOrder) (vod.Pagination, error)
 	GetVodChatComments(c echo.Context, vodID uuid.UUID, start float64, end float64) (*[]chat.Comment, error)
 	GetUserIdFromChat(c echo.Context, vodID uuid.UUID) (*int64, error)
 	GetChatEmotes(ctx context.Context, vodID uuid.UUID) (*platform.Emotes, error)
@@ -46,6 +50,9 @@ type VodService interface {
 	GenerateSpriteThumbnails(ctx context.Context, videoID uuid.UUID) (*rivertype.JobInsertResult, error)
 	GetVodClips(ctx context.Context, id uuid.UUID) ([]*ent.Vod, error)
 	GetVodChatHistogram(ctx context.Context, vodID uuid.UUID, resolutionSeconds float64) (map[int]int, error)
+	GetLiveDVRPlaylist(ctx context.Context, videoID uuid.UUID) ([]byte, error)
+	GetLiveDVRSegmentPath(ctx context.Context, videoID uuid.UUID, segment string) (string, error)
+	GetLiveDVRChatComments(ctx context.Context, videoID uuid.UUID, start float64, end float64) ([]chat.Comment, error)
 }
 
 type CreateVodRequest struct {
@@ -286,6 +293,13 @@ func (h *Handler) DeleteVod(c echo.Context) error {
 	if dF == "true" {
 		deleteFiles = true
 	}
+	v, err := h.Service.VodService.GetVod(c.Request().Context(), vID, false, false, false, false)
+	if err != nil {
+		if err.Error() == "vod not found" {
+			return ErrorResponse(c, http.StatusNotFound, err.Error())
+		}
+		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+	}
 	err = h.Service.VodService.DeleteVod(c.Request().Context(), vID, deleteFiles)
 	if err != nil {
 		if err.Error() == "vod not found" {
@@ -293,6 +307,7 @@ func (h *Handler) DeleteVod(c echo.Context) error {
 		}
 		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
 	}
+	recordAudit(c, audit.Entry{Action: audit.ActionVodDelete, ResourceType: "vod", ResourceID: vID.String(), Before: v, After: map[string]any{"delete_files": deleteFiles}})
 	return c.NoContent(http.StatusOK)
 }
 
@@ -372,6 +387,7 @@ func (h *Handler) UpdateVod(c echo.Context) error {
 //	@Param			q		query		string	true	"Search query"
 //	@Param			limit	query		integer	false	"Limit"		default(10)
 //	@Param			offset	query		integer	false	"Offset"	default(0)
+//	@Param			tags	query		string	false	"Comma separated tags, vods must have all of them"
 //	@Success		200		{array}		ent.Vod
 //	@Failure		400		{object}	utils.ErrorResponse
 //	@Failure		500		{object}	utils.ErrorResponse
@@ -400,6 +416,8 @@ func (h *Handler) SearchVods(c echo.Context) error {
 		}
 	}
 
+	tags := splitTags(c.QueryParam("tags"))
+
 	fieldsRaw := c.QueryParam("fields")
 	var fields []string
 	if fieldsRaw != "" {
@@ -461,7 +479,7 @@ func (h *Handler) SearchVods(c echo.Context) error {
 		predicates = append(predicates, entVod.TitleContainsFold(qp.Q))
 	}
 
-	v, err := h.Service.VodService.SearchVods(c.Request().Context(), limit, offset, types, predicates, qp.SortBy, qp.Order)
+	v, err := h.Service.VodService.SearchVods(c.Request().Context(), limit, offset, types, tags, predicates, qp.SortBy, qp.Order)
 	if err != nil {
 		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
 	}
@@ -504,7 +522,9 @@ func (h *Handler) GetVodPlaylists(c echo.Context) error {
 //		@Param			offset		query		integer	false	"Offset"	default(0)
 //		@Param			channel_id	query		string	false	"Channel ID"
 //	 @Param			types		query		string	false	"Types"
+//		@Param			tags		query		string	false	"Comma separated tags, vods must have all of them"
 //		@Param			playlist_id	query		string	false	"Playlist ID"
+//		@Param			sort_by		query		string	false	"Sort by date, views, local_views, created or position. Position requires a playlist ID."
 //		@Param			processing	query		string	false	"Processing. Set to false to exclude videos that are still processing."
 //		@Success		200			{object}	vod.Pagination
 //		@Failure		400			{object}	utils.ErrorResponse
@@ -546,11 +566,13 @@ func (h *Handler) GetVodsPagination(c echo.Context) error {
 		}
 	}
 
+	tags := splitTags(c.QueryParam("tags"))
+
 	vSortBy := c.QueryParam("sort_by")
 	var sortBy utils.VideoSort
 	if vSortBy != "" {
 		sortBy = utils.VideoSort(vSortBy)
-		if sortBy != utils.SortDate && sortBy != utils.SortViews && sortBy != utils.SortLocalViews && sortBy != utils.SortCreated {
+		if sortBy != utils.SortDate && sortBy != utils.SortViews && sortBy != utils.SortLocalViews && sortBy != utils.SortCreated && sortBy != utils.SortPosition {
 			return ErrorResponse(c, http.StatusBadRequest, "invalid sort_by option, must be one of: "+strings.Join(utils.VideoSort("").Values(), ", "))
 		}
 	} else {
@@ -564,6 +586,8 @@ func (h *Handler) GetVodsPagination(c echo.Context) error {
 		if sortOrder != utils.SortOrderAsc && sortOrder != utils.SortOrderDesc {
 			return ErrorResponse(c, http.StatusBadRequest, "invalid order option, must be one of: "+strings.Join(utils.SortOrder("").Values(), ", "))
 		}
+	} else if sortBy == utils.SortPosition {
+		sortOrder = utils.SortOrderAsc
 	} else {
 		sortOrder = utils.SortOrderDesc
 	}
@@ -571,8 +595,11 @@ func (h *Handler) GetVodsPagination(c echo.Context) error {
 	// Default to true to include all videos. Only exclude processing videos is requested.
 	isProcessing := c.QueryParam("processing") != "false"
 
-	v, err := h.Service.VodService.GetVodsPagination(c, limit, offset, cUUID, types, playlistUUID, isProcessing, sortBy, sortOrder)
+	v, err := h.Service.VodService.GetVodsPagination(c, limit, offset, cUUID, types, tags, playlistUUID, isProcessing, sortBy, sortOrder)
 	if err != nil {
+		if errors.Is(err, vod.ErrPositionSortWithoutPlaylist) {
+			return ErrorResponse(c, http.StatusBadRequest, err.Error())
+		}
 		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
 	}
 	return SuccessResponse(c, v, "paginated videos")
@@ -635,6 +662,9 @@ func (h *Handler) GetVodChatComments(c echo.Context) error {
 		return ErrorResponse(c, http.StatusBadRequest, fmt.Errorf("invalid end: %w", err).Error())
 	}
 
+	// share links limited to a time range only return the chat of the range
+	startFloat, endFloat = share.ClampRange(shareLinkFromContext(c), startFloat, endFloat)
+
 	v, err := h.Service.VodService.GetVodChatComments(c, vID, startFloat, endFloat)
 	if err != nil {
 		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
@@ -731,10 +761,23 @@ func (h *Handler) GetNumberOfVodChatCommentsFromTime(c echo.Context) error {
 		return ErrorResponse(c, http.StatusBadRequest, "count must be greater than 0")
 	}
 
+	link := shareLinkFromContext(c)
+	startFloat, _ = share.ClampRange(link, startFloat, startFloat)
+
 	v, err := h.Service.VodService.GetNumberOfVodChatCommentsFromTime(c, vID, startFloat, int64(countInt))
 	if err != nil {
 		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
 	}
+	if link != nil && link.StartSeconds != nil {
+		// comments before the start are not part of the shared range
+		comments := make([]chat.Comment, 0, len(*v))
+		for _, comment := range *v {
+			if comment.ContentOffsetSeconds >= float64(*link.StartSeconds) {
+				comments = append(comments, comment)
+			}
+		}
+		v = &comments
+	}
 	return SuccessResponse(c, v, fmt.Sprintf("comments for %s from %f", vID, startFloat))
 }
 
@@ -936,3 +979,111 @@ func (h *Handler) GetVodChatHistogram(c echo.Context) error {
 
 	return SuccessResponse(c, histogram, "chat histogram")
 }
+
+// GetLiveDVRPlaylist godoc
+//
+//	@Summary		Get live dvr playlist
+//	@Description	Get the growing HLS playlist of a live archive that is still being recorded
+//	@Tags			vods
+//	@Produce		application/vnd.apple.mpegurl
+//	@Param			id	path		string	true	"Vod ID"
+//	@Success		200	{string}	string
+//	@Failure		400	{object}	utils.ErrorResponse
+//	@Failure		404	{object}	utils.ErrorResponse
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/vod/{id}/live/playlist.m3u8 [get]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) GetLiveDVRPlaylist(c echo.Context) error {
+	vID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	playlist, err := h.Service.VodService.GetLiveDVRPlaylist(c.Request().Context(), vID)
+	if err != nil {
+		return liveDVRErrorResponse(c, err)
+	}
+
+	c.Response().Header().Set(echo.HeaderCacheControl, "no-cache")
+	return c.Blob(http.StatusOK, "application/vnd.apple.mpegurl", playlist)
+}
+
+// GetLiveDVRSegment godoc
+//
+//	@Summary		Get live dvr segment
+//	@Description	Get a segment of a live archive that is still being recorded
+//	@Tags			vods
+//	@Produce		video/mp2t
+//	@Param			id		path		string	true	"Vod ID"
+//	@Param			segment	path		string	true	"Segment file name"
+//	@Success		200		{file}		file
+//	@Failure		400		{object}	utils.ErrorResponse
+//	@Failure		404		{object}	utils.ErrorResponse
+//	@Failure		500		{object}	utils.ErrorResponse
+//	@Router			/vod/{id}/live/{segment} [get]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) GetLiveDVRSegment(c echo.Context) error {
+	vID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	path, err := h.Service.VodService.GetLiveDVRSegmentPath(c.Request().Context(), vID, c.Param("segment"))
+	if err != nil {
+		return liveDVRErrorResponse(c, err)
+	}
+
+	c.Response().Header().Set(echo.HeaderContentType, "video/mp2t")
+	return c.File(path)
+}
+
+// GetLiveDVRChatComments godoc
+//
+//	@Summary		Get live dvr chat comments
+//	@Description	Get the live chat comments collected so far for a live archive that is still being recorded
+//	@Tags			vods
+//	@Produce		json
+//	@Param			id		path		string	true	"Vod ID"
+//	@Param			start	query		string	false	"Start time"
+//	@Param			end		query		string	false	"End time"
+//	@Success		200		{array}		[]chat.Comment
+//	@Failure		400		{object}	utils.ErrorResponse
+//	@Failure		404		{object}	utils.ErrorResponse
+//	@Failure		500		{object}	utils.ErrorResponse
+//	@Router			/vod/{id}/live/chat [get]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) GetLiveDVRChatComments(c echo.Context) error {
+	vID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	startFloat := 0.0
+	endFloat := math.MaxFloat64
+	if start := c.QueryParam("start"); start != "" {
+		startFloat, err = strconv.ParseFloat(start, 64)
+		if err != nil {
+			return ErrorResponse(c, http.StatusBadRequest, fmt.Errorf("invalid start: %w", err).Error())
+		}
+	}
+	if end := c.QueryParam("end"); end != "" {
+		endFloat, err = strconv.ParseFloat(end, 64)
+		if err != nil {
+			return ErrorResponse(c, http.StatusBadRequest, fmt.Errorf("invalid end: %w", err).Error())
+		}
+	}
+
+	comments, err := h.Service.VodService.GetLiveDVRChatComments(c.Request().Context(), vID, startFloat, endFloat)
+	if err != nil {
+		return liveDVRErrorResponse(c, err)
+	}
+
+	return SuccessResponse(c, comments, "live chat comments")
+}
+
+func liveDVRErrorResponse(c echo.Context, err error) error {
+	if errors.Is(err, vod.ErrLiveDVRUnavailable) || errors.Is(err, vod.ErrLiveDVRSegmentNotFound) || errors.Is(err, vod.ErrVodNotFound) {
+		return ErrorResponse(c, http.StatusNotFound, err.Error())
+	}
+	return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+}
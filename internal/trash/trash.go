@@ -0,0 +1,323 @@
+// Package trash moves deleted vods and channels to a trash where they can be restored until they are purged.
+package trash
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"github.com/zibbp/ganymede/ent"
+	entChannel "github.com/zibbp/ganymede/ent/channel"
+	entVod "github.com/zibbp/ganymede/ent/vod"
+	"github.com/zibbp/ganymede/internal/config"
+	"github.com/zibbp/ganymede/internal/database"
+	"github.com/zibbp/ganymede/internal/utils"
+	vods_utility "github.com/zibbp/ganymede/internal/vod/utility"
+)
+
+var (
+	ErrVodNotFound     = errors.New("vod not found")
+	ErrChannelNotFound = errors.New("channel not found")
+	ErrChannelDeleted  = errors.New("channel of the vod is deleted, restore the channel first")
+	ErrRestoreExists   = errors.New("restore directory already exists")
+	ErrVodInTrash      = errors.New("vod is in the trash, restore or purge it")
+	ErrChannelInTrash  = errors.New("channel is in the trash, restore or purge it")
+)
+
+type Service struct {
+	Store *database.Database
+}
+
+func NewService(store *database.Database) *Service {
+	return &Service{Store: store}
+}
+
+type Trash struct {
+	Vods     []*ent.Vod     `json:"vods"`
+	Channels []*ent.Channel `json:"channels"`
+}
+
+// Dir returns the directory files of deleted vods are moved to.
+func Dir() string {
+	env := config.GetEnvConfig()
+	if env.TrashDir != "" {
+		return env.TrashDir
+	}
+	return filepath.Join(env.VideosDir, ".trash")
+}
+
+// GetTrash returns the deleted vods and channels, most recently deleted first. Vods deleted with their channel are only returned with the channel.
+func (s *Service) GetTrash(ctx context.Context) (Trash, error) {
+	channels, err := s.Store.Client.Channel.Query().
+		Where(entChannel.DeletedAtNotNil()).
+		Order(ent.Desc(entChannel.FieldDeletedAt)).
+		All(ctx)
+	if err != nil {
+		return Trash{}, fmt.Errorf("error getting deleted channels: %v", err)
+	}
+	vods, err := s.Store.Client.Vod.Query().
+		Where(entVod.DeletedAtNotNil(), entVod.HasChannelWith(entChannel.DeletedAtIsNil())).
+		WithChannel().
+		Order(ent.Desc(entVod.FieldDeletedAt)).
+		All(ctx)
+	if err != nil {
+		return Trash{}, fmt.Errorf("error getting deleted vods: %v", err)
+	}
+	return Trash{Vods: vods, Channels: channels}, nil
+}
+
+// TrashVod moves the vod to the trash. If deleteFiles is true its directory is moved to the trash directory and deleted when purged, otherwise the files are kept in place. The vod is deleted immediately if the trash is disabled.
+func TrashVod(ctx context.Context, store *database.Database, vodID uuid.UUID, deleteFiles bool) error {
+	if config.Get().Trash.RetentionDays == 0 {
+		return vods_utility.DeleteVod(ctx, store, vodID, deleteFiles)
+	}
+
+	v, err := store.Client.Vod.Query().Where(entVod.ID(vodID), entVod.DeletedAtIsNil()).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ErrVodNotFound
+		}
+		return fmt.Errorf("error getting vod: %v", err)
+	}
+
+	update := store.Client.Vod.UpdateOne(v).SetDeletedAt(deletedAt())
+	if deleteFiles {
+		trashPath, err := moveVodToTrash(ctx, v)
+		if err != nil {
+			return err
+		}
+		update.SetTrashPath(trashPath)
+	}
+
+	if err := update.Exec(ctx); err != nil {
+		return fmt.Errorf("error deleting vod: %v", err)
+	}
+	return nil
+}
+
+// TrashChannel moves the channel and its vods to the trash. Files are kept in place like they were before channels could be restored. The channel is deleted immediately if the trash is disabled.
+func TrashChannel(ctx context.Context, store *database.Database, channelID uuid.UUID) error {
+	if config.Get().Trash.RetentionDays == 0 {
+		return purgeChannel(ctx, store, channelID)
+	}
+
+	now := deletedAt()
+	tx, err := store.Client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %v", err)
+	}
+	updated, err := tx.Channel.Update().
+		Where(entChannel.ID(channelID), entChannel.DeletedAtIsNil()).
+		SetDeletedAt(now).
+		Save(ctx)
+	if err != nil {
+		return database.Rollback(tx, fmt.Errorf("error deleting channel: %v", err))
+	}
+	if updated == 0 {
+		return database.Rollback(tx, ErrChannelNotFound)
+	}
+	// vods get the channel's deleted time so restoring the channel only restores the vods deleted with it
+	if err := tx.Vod.Update().
+		Where(entVod.HasChannelWith(entChannel.ID(channelID)), entVod.DeletedAtIsNil()).
+		SetDeletedAt(now).
+		Exec(ctx); err != nil {
+		return database.Rollback(tx, fmt.Errorf("error deleting channel vods: %v", err))
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("error committing transaction: %v", err)
+	}
+	return nil
+}
+
+// RestoreVod moves the vod's files back and shows the vod again.
+func (s *Service) RestoreVod(ctx context.Context, vodID uuid.UUID) (*ent.Vod, error) {
+	v, err := s.Store.Client.Vod.Query().Where(entVod.ID(vodID), entVod.DeletedAtNotNil()).WithChannel().Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrVodNotFound
+		}
+		return nil, fmt.Errorf("error getting vod: %v", err)
+	}
+	if v.Edges.Channel != nil && v.Edges.Channel.DeletedAt != nil {
+		return nil, ErrChannelDeleted
+	}
+
+	if v.TrashPath != "" {
+		path, err := vods_utility.VodDirectory(v)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := os.Stat(path); err == nil {
+			return nil, fmt.Errorf("%w: %s", ErrRestoreExists, path)
+		}
+		if err := moveDirectory(ctx, v.TrashPath, path); err != nil {
+			return nil, fmt.Errorf("error restoring vod files: %v", err)
+		}
+	}
+
+	v, err = s.Store.Client.Vod.UpdateOne(v).ClearDeletedAt().ClearTrashPath().Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error restoring vod: %v", err)
+	}
+	return v, nil
+}
+
+// RestoreChannel shows the channel and the vods deleted with it again.
+func (s *Service) RestoreChannel(ctx context.Context, channelID uuid.UUID) (*ent.Channel, error) {
+	cha, err := s.Store.Client.Channel.Query().Where(entChannel.ID(channelID), entChannel.DeletedAtNotNil()).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrChannelNotFound
+		}
+		return nil, fmt.Errorf("error getting channel: %v", err)
+	}
+
+	tx, err := s.Store.Client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error starting transaction: %v", err)
+	}
+	if err := tx.Vod.Update().
+		Where(entVod.HasChannelWith(entChannel.ID(channelID)), entVod.DeletedAt(*cha.DeletedAt)).
+		ClearDeletedAt().
+		Exec(ctx); err != nil {
+		return nil, database.Rollback(tx, fmt.Errorf("error restoring channel vods: %v", err))
+	}
+	cha, err = tx.Channel.UpdateOne(cha).ClearDeletedAt().Save(ctx)
+	if err != nil {
+		return nil, database.Rollback(tx, fmt.Errorf("error restoring channel: %v", err))
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("error committing transaction: %v", err)
+	}
+	return cha, nil
+}
+
+// PurgeVod permanently deletes a vod in the trash and its trashed files.
+func (s *Service) PurgeVod(ctx context.Context, vodID uuid.UUID) error {
+	v, err := s.Store.Client.Vod.Query().Where(entVod.ID(vodID), entVod.DeletedAtNotNil()).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ErrVodNotFound
+		}
+		return fmt.Errorf("error getting vod: %v", err)
+	}
+	return purgeVod(ctx, s.Store, v)
+}
+
+// PurgeChannel permanently deletes a channel in the trash and its vods.
+func (s *Service) PurgeChannel(ctx context.Context, channelID uuid.UUID) error {
+	exists, err := s.Store.Client.Channel.Query().Where(entChannel.ID(channelID), entChannel.DeletedAtNotNil()).Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("error getting channel: %v", err)
+	}
+	if !exists {
+		return ErrChannelNotFound
+	}
+	return purgeChannel(ctx, s.Store, channelID)
+}
+
+// PurgeExpired permanently deletes vods and channels that have been in the trash longer than the retention.
+func PurgeExpired(ctx context.Context, store *database.Database, retentionDays int) (int, error) {
+	before := time.Now().AddDate(0, 0, -retentionDays)
+	purged := 0
+
+	channels, err := store.Client.Channel.Query().Where(entChannel.DeletedAtLT(before)).IDs(ctx)
+	if err != nil {
+		return purged, fmt.Errorf("error getting expired channels: %v", err)
+	}
+	for _, id := range channels {
+		if err := purgeChannel(ctx, store, id); err != nil {
+			log.Error().Err(err).Str("channel_id", id.String()).Msg("error purging channel")
+			continue
+		}
+		purged++
+	}
+
+	vods, err := store.Client.Vod.Query().Where(entVod.DeletedAtLT(before)).All(ctx)
+	if err != nil {
+		return purged, fmt.Errorf("error getting expired vods: %v", err)
+	}
+	for _, v := range vods {
+		if err := purgeVod(ctx, store, v); err != nil {
+			log.Error().Err(err).Str("vod_id", v.ID.String()).Msg("error purging vod")
+			continue
+		}
+		purged++
+	}
+	return purged, nil
+}
+
+func purgeVod(ctx context.Context, store *database.Database, v *ent.Vod) error {
+	if v.TrashPath != "" {
+		if err := os.RemoveAll(v.TrashPath); err != nil {
+			return fmt.Errorf("error deleting trashed files: %v", err)
+		}
+	}
+	return vods_utility.DeleteVod(ctx, store, v.ID, false)
+}
+
+func purgeChannel(ctx context.Context, store *database.Database, channelID uuid.UUID) error {
+	vods, err := store.Client.Vod.Query().Where(entVod.HasChannelWith(entChannel.ID(channelID))).All(ctx)
+	if err != nil {
+		return fmt.Errorf("error getting channel vods: %v", err)
+	}
+	for _, v := range vods {
+		if err := purgeVod(ctx, store, v); err != nil {
+			return err
+		}
+	}
+
+	if err := store.Client.Channel.DeleteOneID(channelID).Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return ErrChannelNotFound
+		}
+		return fmt.Errorf("error deleting channel: %v", err)
+	}
+	return nil
+}
+
+// moveVodToTrash moves the vod's directory to a directory named after its ID in the trash directory.
+func moveVodToTrash(ctx context.Context, v *ent.Vod) (string, error) {
+	path, err := vods_utility.VodDirectory(v)
+	if err != nil {
+		return "", err
+	}
+	if err := vods_utility.DeleteVodTempFiles(v); err != nil {
+		return "", err
+	}
+
+	trashPath := filepath.Join(Dir(), v.ID.String())
+	if _, err := os.Stat(path); errors.Is(err, os.ErrNotExist) {
+		log.Warn().Str("vod_id", v.ID.String()).Str("path", path).Msg("vod directory does not exist, nothing to move to the trash")
+		return "", nil
+	}
+	if err := moveDirectory(ctx, path, trashPath); err != nil {
+		return "", fmt.Errorf("error moving vod to trash: %v", err)
+	}
+	log.Info().Str("vod_id", v.ID.String()).Str("path", path).Str("trash_path", trashPath).Msg("moved vod to trash")
+	return trashPath, nil
+}
+
+// moveDirectory renames the directory, or copies it if the destination is on another filesystem.
+func moveDirectory(ctx context.Context, source, dest string) error {
+	if err := os.MkdirAll(filepath.Dir(dest), os.ModePerm); err != nil {
+		return err
+	}
+	if err := os.Rename(source, dest); err == nil {
+		return nil
+	}
+	if err := utils.MoveDirectory(ctx, source, dest); err != nil {
+		return err
+	}
+	return os.RemoveAll(source)
+}
+
+// deletedAt returns the current time at the precision stored by Postgres so it can be compared after a round trip.
+func deletedAt() time.Time {
+	return time.Now().Truncate(time.Microsecond)
+}
@@ -0,0 +1,28 @@
+package trash
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestMoveDirectory(t *testing.T) {
+	source := filepath.Join(t.TempDir(), "vod")
+	dest := filepath.Join(t.TempDir(), ".trash", "vod")
+	if err := os.MkdirAll(source, os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(source, "video.mp4"), []byte("video"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := moveDirectory(t.Context(), source, dest); err != nil {
+		t.Fatalf("moveDirectory() error = %v", err)
+	}
+	if _, err := os.Stat(source); !os.IsNotExist(err) {
+		t.Errorf("expected %s to be removed", source)
+	}
+	if _, err := os.Stat(filepath.Join(dest, "video.mp4")); err != nil {
+		t.Errorf("expected video to be moved: %v", err)
+	}
+}
@@ -0,0 +1,104 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent"
+	entChannel "github.com/zibbp/ganymede/ent/channel"
+	entNotificationSubscription "github.com/zibbp/ganymede/ent/notificationsubscription"
+	entPlaylist "github.com/zibbp/ganymede/ent/playlist"
+	entUser "github.com/zibbp/ganymede/ent/user"
+	"github.com/zibbp/ganymede/internal/acl"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+var (
+	ErrSubscriptionNotFound       = errors.New("subscription not found")
+	ErrSubscriptionTargetNotFound = errors.New("channel or playlist not found")
+)
+
+type Subscription struct {
+	Event        utils.SubscriptionEvent
+	ProviderType utils.NotificationProviderType
+	URL          string // the user's webhook is used if empty
+	Token        string
+	ChannelID    uuid.UUID // channel of channel_live and archive_completed subscriptions
+	PlaylistID   uuid.UUID // playlist of playlist_video_added subscriptions
+}
+
+// GetSubscriptions returns the notification subscriptions of the user.
+func (s *Service) GetSubscriptions(ctx context.Context, userID uuid.UUID) ([]*ent.NotificationSubscription, error) {
+	subscriptions, err := s.Store.Client.NotificationSubscription.Query().
+		Where(entNotificationSubscription.HasUserWith(entUser.ID(userID))).
+		WithChannel().
+		WithPlaylist().
+		Order(ent.Asc(entNotificationSubscription.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting subscriptions: %v", err)
+	}
+	return subscriptions, nil
+}
+
+// CreateSubscription subscribes the user to the event of a channel or playlist.
+func (s *Service) CreateSubscription(ctx context.Context, userID uuid.UUID, input Subscription) (*ent.NotificationSubscription, error) {
+	// users can only subscribe to channels and playlists they can view
+	var visible bool
+	var err error
+	if input.Event == utils.SubscriptionEventPlaylistVideoAdded {
+		visible, err = s.Store.Client.Playlist.Query().Where(entPlaylist.ID(input.PlaylistID), acl.Playlists(ctx)).Exist(ctx)
+	} else {
+		visible, err = s.Store.Client.Channel.Query().Where(entChannel.ID(input.ChannelID), acl.Channels(ctx)).Exist(ctx)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error checking subscription target: %v", err)
+	}
+	if !visible {
+		return nil, ErrSubscriptionTargetNotFound
+	}
+
+	create := s.Store.Client.NotificationSubscription.Create().
+		SetUserID(userID).
+		SetEvent(input.Event).
+		SetProviderType(input.ProviderType).
+		SetURL(input.URL).
+		SetToken(input.Token)
+
+	if input.Event == utils.SubscriptionEventPlaylistVideoAdded {
+		create.SetPlaylistID(input.PlaylistID)
+	} else {
+		create.SetChannelID(input.ChannelID)
+	}
+
+	subscription, err := create.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error creating subscription: %w", err)
+	}
+	return subscription, nil
+}
+
+// DeleteSubscription deletes a subscription of the user.
+func (s *Service) DeleteSubscription(ctx context.Context, userID uuid.UUID, subscriptionID uuid.UUID) error {
+	deleted, err := s.Store.Client.NotificationSubscription.Delete().
+		Where(entNotificationSubscription.ID(subscriptionID), entNotificationSubscription.HasUserWith(entUser.ID(userID))).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("error deleting subscription: %v", err)
+	}
+	if deleted == 0 {
+		return ErrSubscriptionNotFound
+	}
+	return nil
+}
+
+// UpdateWebhook sets the webhook subscriptions without their own URL are sent to.
+func (s *Service) UpdateWebhook(ctx context.Context, userID uuid.UUID, webhook string) (*ent.User, error) {
+	u, err := s.Store.Client.User.UpdateOneID(userID).SetWebhook(webhook).Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error updating webhook: %v", err)
+	}
+	return u, nil
+}
@@ -24,6 +24,7 @@ type User struct {
 	Password  string     `json:"password"`
 	Role      utils.Role `json:"role"`
 	Webhook   string     `json:"webhook"`
+	Groups    []string   `json:"groups"`
 	UpdatedAt string     `json:"updated_at"`
 	CreatedAt string     `json:"created_at"`
 }
@@ -45,7 +46,12 @@ func (s *Service) AdminGetUser(c echo.Context, uID uuid.UUID) (*ent.User, error)
 }
 
 func (s *Service) AdminUpdateUser(c echo.Context, uDto User) (*ent.User, error) {
-	u, err := s.Store.Client.User.UpdateOneID(uDto.ID).SetUsername(uDto.Username).SetRole(uDto.Role).Save(c.Request().Context())
+	update := s.Store.Client.User.UpdateOneID(uDto.ID).SetUsername(uDto.Username).SetRole(uDto.Role)
+	// groups are only changed if set so older clients do not clear them
+	if uDto.Groups != nil {
+		update.SetGroups(uDto.Groups)
+	}
+	u, err := update.Save(c.Request().Context())
 	if err != nil {
 		return nil, fmt.Errorf("error updating user: %v", err)
 	}
@@ -0,0 +1,31 @@
+package utils
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BulkVodFilter selects the vods of a bulk operation. Empty fields are not filtered on.
+type BulkVodFilter struct {
+	ChannelID  *uuid.UUID `json:"channel_id,omitempty"`
+	PlaylistID *uuid.UUID `json:"playlist_id,omitempty"`
+	Types      []VodType  `json:"types,omitempty"`
+	From       *time.Time `json:"from,omitempty"` // streamed at or after
+	To         *time.Time `json:"to,omitempty"`   // streamed at or before
+	Query      string     `json:"query,omitempty"`
+}
+
+// IsEmpty returns true if the filter matches every vod.
+func (f BulkVodFilter) IsEmpty() bool {
+	return f.ChannelID == nil && f.PlaylistID == nil && len(f.Types) == 0 && f.From == nil && f.To == nil && f.Query == ""
+}
+
+// BulkItemResult is the result of a bulk operation for a single vod.
+type BulkItemResult struct {
+	VodID  uuid.UUID      `json:"vod_id"`
+	Title  string         `json:"title"`
+	Status BulkItemStatus `json:"status"`
+	Error  string         `json:"error,omitempty"`
+	JobID  int64          `json:"job_id,omitempty"` // task queued for the vod
+}
@@ -29,6 +29,28 @@ func IsValidRole(role string) bool {
 	return exists
 }
 
+// roleLevels orders roles from least to most privileged.
+var roleLevels = map[Role]int{
+	UserRole:     1,
+	ArchiverRole: 2,
+	EditorRole:   3,
+	AdminRole:    4,
+}
+
+// HasRole checks if the role has at least the privileges of the required role.
+func (r Role) HasRole(required Role) bool {
+	level, ok := roleLevels[r]
+	return ok && level >= roleLevels[required]
+}
+
+// LowestRole returns the least privileged of the two roles.
+func LowestRole(a, b Role) Role {
+	if roleLevels[a] <= roleLevels[b] {
+		return a
+	}
+	return b
+}
+
 type VideoPlatform string
 
 const (
@@ -67,10 +89,11 @@ const (
 	SortViews      VideoSort = "views"       // views from platform
 	SortLocalViews VideoSort = "local_views" // views from Ganymede
 	SortCreated    VideoSort = "created"     // when the vod was created in Ganymede
+	SortPosition   VideoSort = "position"    // position in the playlist, only when listing a playlist
 )
 
 func (VideoSort) Values() (kinds []string) {
-	for _, s := range []VideoSort{SortDate, SortViews, SortLocalViews, SortCreated} {
+	for _, s := range []VideoSort{SortDate, SortViews, SortLocalViews, SortCreated, SortPosition} {
 		kinds = append(kinds, string(s))
 	}
 	return
@@ -154,6 +177,7 @@ const (
 	TaskDownloadVideo            TaskName = "task_video_download"
 	TaskDownloadLiveVideo        TaskName = "task_live_video_download" // not used queue
 	TaskPostProcessVideo         TaskName = "task_video_convert"
+	TaskValidateVideo            TaskName = "task_video_validate"
 	TaskMoveVideo                TaskName = "task_video_move"
 	TaskDownloadChat             TaskName = "task_chat_download"
 	TaskDownloadLiveChat         TaskName = "task_live_chat_download" // not used queue
@@ -164,7 +188,7 @@ const (
 )
 
 func (TaskName) Values() (kinds []string) {
-	for _, s := range []TaskName{TaskCreateFolder, TaskDownloadThumbnail, TaskSaveInfo, TaskDownloadVideo, TaskPostProcessVideo, TaskMoveVideo, TaskDownloadChat, TaskConvertChat, TaskRenderChat, TaskMoveChat, TaskUpdateLiveStreamMetadata} {
+	for _, s := range []TaskName{TaskCreateFolder, TaskDownloadThumbnail, TaskSaveInfo, TaskDownloadVideo, TaskPostProcessVideo, TaskValidateVideo, TaskMoveVideo, TaskDownloadChat, TaskConvertChat, TaskRenderChat, TaskMoveChat, TaskUpdateLiveStreamMetadata} {
 		kinds = append(kinds, string(s))
 	}
 	return
@@ -182,6 +206,8 @@ func GetTaskName(s string) TaskName {
 		return TaskDownloadVideo
 	case string(TaskPostProcessVideo):
 		return TaskPostProcessVideo
+	case string(TaskValidateVideo):
+		return TaskValidateVideo
 	case string(TaskMoveVideo):
 		return TaskMoveVideo
 	case string(TaskDownloadChat):
@@ -199,6 +225,20 @@ func GetTaskName(s string) TaskName {
 	}
 }
 
+type VideoValidationVerdict string
+
+const (
+	VideoValidationPassed VideoValidationVerdict = "passed"
+	VideoValidationFailed VideoValidationVerdict = "failed"
+)
+
+func (VideoValidationVerdict) Values() (kinds []string) {
+	for _, s := range []VideoValidationVerdict{VideoValidationPassed, VideoValidationFailed} {
+		kinds = append(kinds, string(s))
+	}
+	return
+}
+
 type ProxyType string
 
 const (
@@ -213,6 +253,96 @@ func (ProxyType) Values() (kinds []string) {
 	return
 }
 
+type NotificationProviderType string
+
+const (
+	NotificationProviderWebhook NotificationProviderType = "webhook"
+	NotificationProviderDiscord NotificationProviderType = "discord"
+	NotificationProviderSlack   NotificationProviderType = "slack"
+	NotificationProviderNtfy    NotificationProviderType = "ntfy"
+	NotificationProviderGotify  NotificationProviderType = "gotify"
+	NotificationProviderApprise NotificationProviderType = "apprise"
+	NotificationProviderSMTP    NotificationProviderType = "smtp"
+)
+
+func (NotificationProviderType) Values() (kinds []string) {
+	for _, s := range []NotificationProviderType{NotificationProviderWebhook, NotificationProviderDiscord, NotificationProviderSlack, NotificationProviderNtfy, NotificationProviderGotify, NotificationProviderApprise, NotificationProviderSMTP} {
+		kinds = append(kinds, string(s))
+	}
+	return
+}
+
+type NotificationEvent string
+
+const (
+	NotificationEventVideoSuccess      NotificationEvent = "video_success"
+	NotificationEventLiveSuccess       NotificationEvent = "live_success"
+	NotificationEventError             NotificationEvent = "error"
+	NotificationEventIsLive            NotificationEvent = "is_live"
+	NotificationEventArchiveQueued     NotificationEvent = "archive_queued"     // a video or live stream was queued for archiving
+	NotificationEventArchiveStarted    NotificationEvent = "archive_started"    // the first task of a queued archive started
+	NotificationEventVideoDeleted      NotificationEvent = "video_deleted"      // a video was deleted by retention
+	NotificationEventDiskLow           NotificationEvent = "disk_low"           // free space of the videos or temp directory is below the threshold
+	NotificationEventCredentialInvalid NotificationEvent = "credential_invalid" // the Twitch token or YouTube credential is no longer valid
+	NotificationEventWatchdogFailed    NotificationEvent = "watchdog_failed"    // the watchdog failed a job that stopped responding
+	NotificationEventLoginLockout      NotificationEvent = "login_lockout"      // an IP address or username was locked out after repeated failed logins
+)
+
+func (NotificationEvent) Values() (kinds []string) {
+	for _, s := range []NotificationEvent{NotificationEventVideoSuccess, NotificationEventLiveSuccess, NotificationEventError, NotificationEventIsLive, NotificationEventArchiveQueued, NotificationEventArchiveStarted, NotificationEventVideoDeleted, NotificationEventDiskLow, NotificationEventCredentialInvalid, NotificationEventWatchdogFailed, NotificationEventLoginLockout} {
+		kinds = append(kinds, string(s))
+	}
+	return
+}
+
+// LoginThrottleKind is what failed login attempts are counted by.
+type LoginThrottleKind string
+
+const (
+	LoginThrottleIP           LoginThrottleKind = "ip"           // failed logins from an IP address
+	LoginThrottleUsername     LoginThrottleKind = "username"     // failed logins of a username
+	LoginThrottleRegistration LoginThrottleKind = "registration" // registrations from an IP address
+)
+
+func (LoginThrottleKind) Values() (kinds []string) {
+	for _, s := range []LoginThrottleKind{LoginThrottleIP, LoginThrottleUsername, LoginThrottleRegistration} {
+		kinds = append(kinds, string(s))
+	}
+	return
+}
+
+// SubscriptionEvent is an event users can subscribe to.
+type SubscriptionEvent string
+
+const (
+	SubscriptionEventChannelLive        SubscriptionEvent = "channel_live"         // a subscribed channel went live
+	SubscriptionEventArchiveCompleted   SubscriptionEvent = "archive_completed"    // an archive of a subscribed channel finished
+	SubscriptionEventPlaylistVideoAdded SubscriptionEvent = "playlist_video_added" // a video was added to a subscribed playlist
+)
+
+func (SubscriptionEvent) Values() (kinds []string) {
+	for _, s := range []SubscriptionEvent{SubscriptionEventChannelLive, SubscriptionEventArchiveCompleted, SubscriptionEventPlaylistVideoAdded} {
+		kinds = append(kinds, string(s))
+	}
+	return
+}
+
+// Visibility is who can view a channel or playlist.
+type Visibility string
+
+const (
+	VisibilityPublic        Visibility = "public"        // anyone, including anonymous requests
+	VisibilityAuthenticated Visibility = "authenticated" // any signed in user
+	VisibilityRestricted    Visibility = "restricted"    // only the allowed users and groups
+)
+
+func (Visibility) Values() (kinds []string) {
+	for _, s := range []Visibility{VisibilityPublic, VisibilityAuthenticated, VisibilityRestricted} {
+		kinds = append(kinds, string(s))
+	}
+	return
+}
+
 // PlaylistRuleOperator represents the operator used in playlist rules.
 // also update http structs when changing this
 type PlaylistRuleOperator string
@@ -221,10 +351,15 @@ const (
 	OperatorEquals   PlaylistRuleOperator = "equals"
 	OperatorContains PlaylistRuleOperator = "contains"
 	OperatorRegex    PlaylistRuleOperator = "regex"
+	// comparison operators for number and date fields
+	OperatorGreaterThan        PlaylistRuleOperator = "greater_than"
+	OperatorGreaterThanOrEqual PlaylistRuleOperator = "greater_than_or_equal"
+	OperatorLessThan           PlaylistRuleOperator = "less_than"
+	OperatorLessThanOrEqual    PlaylistRuleOperator = "less_than_or_equal"
 )
 
 func (PlaylistRuleOperator) Values() (kinds []string) {
-	for _, s := range []PlaylistRuleOperator{OperatorEquals, OperatorContains, OperatorRegex} {
+	for _, s := range []PlaylistRuleOperator{OperatorEquals, OperatorContains, OperatorRegex, OperatorGreaterThan, OperatorGreaterThanOrEqual, OperatorLessThan, OperatorLessThanOrEqual} {
 		kinds = append(kinds, string(s))
 	}
 	return
@@ -241,10 +376,18 @@ const (
 	FieldType        PlaylistRuleField = "type"
 	FieldPlatform    PlaylistRuleField = "platform"
 	FieldChannelName PlaylistRuleField = "channel_name"
+	FieldTag         PlaylistRuleField = "tag"
+	// number and date fields
+	FieldDuration         PlaylistRuleField = "duration" // seconds
+	FieldStreamedAt       PlaylistRuleField = "streamed_at"
+	FieldViews            PlaylistRuleField = "views"
+	FieldLocalViews       PlaylistRuleField = "local_views"
+	FieldStorageSizeBytes PlaylistRuleField = "storage_size_bytes"
+	FieldAgeDays          PlaylistRuleField = "age_days" // full days since the video was streamed
 )
 
 func (PlaylistRuleField) Values() (kinds []string) {
-	for _, s := range []PlaylistRuleField{FieldTitle, FieldCategory, FieldType, FieldPlatform, FieldChannelName} {
+	for _, s := range []PlaylistRuleField{FieldTitle, FieldCategory, FieldType, FieldPlatform, FieldChannelName, FieldTag, FieldDuration, FieldStreamedAt, FieldViews, FieldLocalViews, FieldStorageSizeBytes, FieldAgeDays} {
 		kinds = append(kinds, string(s))
 	}
 	return
@@ -264,3 +407,36 @@ func (ChapterType) Values() (kinds []string) {
 	}
 	return
 }
+
+// BulkAction is the action a bulk operation applies to each vod.
+type BulkAction string
+
+const (
+	BulkActionDelete                   BulkAction = "delete"
+	BulkActionLock                     BulkAction = "lock"
+	BulkActionUnlock                   BulkAction = "unlock"
+	BulkActionAddToPlaylist            BulkAction = "add_to_playlist"
+	BulkActionRemoveFromPlaylist       BulkAction = "remove_from_playlist"
+	BulkActionGenerateStaticThumbnail  BulkAction = "generate_static_thumbnail"
+	BulkActionGenerateSpriteThumbnails BulkAction = "generate_sprite_thumbnails"
+	BulkActionRenderChat               BulkAction = "render_chat"
+	BulkActionConvertToHls             BulkAction = "convert_to_hls"
+	BulkActionRefreshMetadata          BulkAction = "refresh_metadata"
+)
+
+func (BulkAction) Values() (kinds []string) {
+	for _, s := range []BulkAction{BulkActionDelete, BulkActionLock, BulkActionUnlock, BulkActionAddToPlaylist, BulkActionRemoveFromPlaylist, BulkActionGenerateStaticThumbnail, BulkActionGenerateSpriteThumbnails, BulkActionRenderChat, BulkActionConvertToHls, BulkActionRefreshMetadata} {
+		kinds = append(kinds, string(s))
+	}
+	return
+}
+
+// BulkItemStatus is the result of a bulk operation for a single vod.
+type BulkItemStatus string
+
+const (
+	BulkItemSuccess BulkItemStatus = "success"
+	BulkItemQueued  BulkItemStatus = "queued"  // a task doing the work was queued
+	BulkItemSkipped BulkItemStatus = "skipped" // nothing to do for the vod
+	BulkItemFailed  BulkItemStatus = "failed"
+)
@@ -0,0 +1,38 @@
+package utils
+
+import "testing"
+
+func TestRoleHasRole(t *testing.T) {
+	tests := []struct {
+		role     Role
+		required Role
+		expected bool
+	}{
+		{role: AdminRole, required: UserRole, expected: true},
+		{role: EditorRole, required: EditorRole, expected: true},
+		{role: ArchiverRole, required: EditorRole, expected: false},
+		{role: UserRole, required: AdminRole, expected: false},
+		{role: Role("unknown"), required: UserRole, expected: false},
+	}
+	for _, tt := range tests {
+		if got := tt.role.HasRole(tt.required); got != tt.expected {
+			t.Errorf("%s.HasRole(%s) = %v, want %v", tt.role, tt.required, got, tt.expected)
+		}
+	}
+}
+
+func TestLowestRole(t *testing.T) {
+	tests := []struct {
+		a, b     Role
+		expected Role
+	}{
+		{a: AdminRole, b: ArchiverRole, expected: ArchiverRole},
+		{a: UserRole, b: EditorRole, expected: UserRole},
+		{a: EditorRole, b: EditorRole, expected: EditorRole},
+	}
+	for _, tt := range tests {
+		if got := LowestRole(tt.a, tt.b); got != tt.expected {
+			t.Errorf("LowestRole(%s, %s) = %s, want %s", tt.a, tt.b, got, tt.expected)
+		}
+	}
+}
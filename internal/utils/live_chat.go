@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -78,3 +79,42 @@ func OpenLiveChatFile(path string) ([]LiveComment, error) {
 	}
 	return liveComments, nil
 }
+
+// ReadPartialLiveChatFile reads a live chat file that is still being written by chat_downloader.
+//
+// The JSON array is not closed until the download finishes so comments are decoded one at a time and a trailing, partially written comment is ignored.
+func ReadPartialLiveChatFile(path string) ([]LiveComment, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read chat file: %v", err)
+	}
+	return ParsePartialLiveChat(data)
+}
+
+// ParsePartialLiveChat decodes as many complete comments as possible from a possibly unterminated live chat JSON array.
+func ParsePartialLiveChat(data []byte) ([]LiveComment, error) {
+	liveComments := []LiveComment{}
+	if len(bytes.TrimSpace(data)) == 0 {
+		return liveComments, nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read chat file: %v", err)
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != '[' {
+		return nil, fmt.Errorf("failed to read chat file: expected json array")
+	}
+
+	for decoder.More() {
+		var comment LiveComment
+		if err := decoder.Decode(&comment); err != nil {
+			// the last comment is still being written
+			break
+		}
+		liveComments = append(liveComments, comment)
+	}
+
+	return liveComments, nil
+}
@@ -0,0 +1,57 @@
+package utils
+
+import "testing"
+
+// TestParsePartialLiveChat tests the ParsePartialLiveChat function
+func TestParsePartialLiveChat(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected int
+		wantErr  bool
+	}{
+		{
+			name:     "empty file",
+			input:    "",
+			expected: 0,
+		},
+		{
+			name:     "complete array",
+			input:    `[{"message":"a","timestamp":1},{"message":"b","timestamp":2}]`,
+			expected: 2,
+		},
+		{
+			name:     "unterminated array",
+			input:    "[\n{\"message\":\"a\",\"timestamp\":1},\n{\"message\":\"b\",\"timestamp\":2}",
+			expected: 2,
+		},
+		{
+			name:     "partially written comment",
+			input:    "[\n{\"message\":\"a\",\"timestamp\":1},\n{\"message\":\"b\",\"times",
+			expected: 1,
+		},
+		{
+			name:    "not an array",
+			input:   `{"message":"a"}`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			comments, err := ParsePartialLiveChat([]byte(tt.input))
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(comments) != tt.expected {
+				t.Errorf("expected %d comments, got %d", tt.expected, len(comments))
+			}
+		})
+	}
+}
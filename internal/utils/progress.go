@@ -0,0 +1,17 @@
+package utils
+
+import "time"
+
+// TaskProgress is the progress of a running task parsed from the output of the tool running it.
+//
+// Fields are zero when the tool does not report them, e.g. a live stream has no total size or ETA.
+type TaskProgress struct {
+	Percent         float64   `json:"percent"`          // 0-100
+	DownloadedBytes int64     `json:"downloaded_bytes"` // bytes downloaded or written so far
+	TotalBytes      int64     `json:"total_bytes"`      // total bytes, may be an estimate
+	Speed           float64   `json:"speed"`            // bytes per second
+	SpeedFactor     float64   `json:"speed_factor"`     // ffmpeg processing speed relative to realtime
+	ETASeconds      int64     `json:"eta_seconds"`
+	DurationSeconds float64   `json:"duration_seconds"` // media duration processed or recorded so far
+	UpdatedAt       time.Time `json:"updated_at"`
+}
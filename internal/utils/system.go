@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"runtime"
 
+	"github.com/shirou/gopsutil/v4/disk"
 	"github.com/shirou/gopsutil/v4/mem"
 )
 
@@ -21,3 +22,12 @@ func GetMemoryTotal() (int64, error) {
 	}
 	return int64(v.Total), nil
 }
+
+// GetDiskUsage returns the free and total bytes of the filesystem the path is on.
+func GetDiskUsage(path string) (free int64, total int64, err error) {
+	usage, err := disk.Usage(path)
+	if err != nil {
+		return 0, 0, fmt.Errorf("error getting disk usage of %s: %w", path, err)
+	}
+	return int64(usage.Free), int64(usage.Total), nil
+}
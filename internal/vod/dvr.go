@@ -0,0 +1,178 @@
+package vod
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/internal/chat"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+var (
+	// ErrLiveDVRUnavailable is returned when a video is not a live archive that is currently being recorded as HLS
+	ErrLiveDVRUnavailable = errors.New("live dvr is not available for this video")
+	// ErrLiveDVRSegmentNotFound is returned when a requested segment does not exist in the temporary HLS directory
+	ErrLiveDVRSegmentNotFound = errors.New("segment not found")
+)
+
+const liveDVRPlaylistType = "#EXT-X-PLAYLIST-TYPE:EVENT"
+
+// getLiveDVRVideo returns the video if it is a live archive that is being recorded as HLS.
+func (s *Service) getLiveDVRVideo(ctx context.Context, videoID uuid.UUID) (*ent.Vod, error) {
+	video, err := s.GetVod(ctx, videoID, false, false, false, true)
+	if err != nil {
+		return nil, err
+	}
+	if !video.Processing || video.Type != utils.Live || video.TmpVideoHlsPath == "" {
+		return nil, ErrLiveDVRUnavailable
+	}
+	if video.Edges.Queue == nil || !video.Edges.Queue.LiveArchive {
+		return nil, ErrLiveDVRUnavailable
+	}
+	return video, nil
+}
+
+// GetLiveDVRPlaylist returns the HLS playlist of a live archive that is still being recorded.
+//
+// The playlist written by ffmpeg grows as segments are added, it is returned as an EVENT playlist so players allow seeking back to the start of the stream.
+func (s *Service) GetLiveDVRPlaylist(ctx context.Context, videoID uuid.UUID) ([]byte, error) {
+	video, err := s.getLiveDVRVideo(ctx, videoID)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(video.TmpVideoDownloadPath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			// ffmpeg has not written the first segment yet
+			return nil, ErrLiveDVRUnavailable
+		}
+		return nil, fmt.Errorf("error reading playlist: %v", err)
+	}
+
+	return rewriteLiveDVRPlaylist(data), nil
+}
+
+// GetLiveDVRSegmentPath returns the path of a segment of a live archive that is still being recorded.
+func (s *Service) GetLiveDVRSegmentPath(ctx context.Context, videoID uuid.UUID, segment string) (string, error) {
+	video, err := s.getLiveDVRVideo(ctx, videoID)
+	if err != nil {
+		return "", err
+	}
+
+	// only allow plain segment file names to prevent path traversal
+	if segment != filepath.Base(segment) || filepath.Ext(segment) != ".ts" {
+		return "", ErrLiveDVRSegmentNotFound
+	}
+
+	path := filepath.Join(video.TmpVideoHlsPath, segment)
+	if !utils.FileExists(path) {
+		return "", ErrLiveDVRSegmentNotFound
+	}
+
+	return path, nil
+}
+
+// GetLiveDVRChatComments returns the live chat comments collected so far for a live archive that is still being recorded.
+//
+// Comments are offset from the queue's chat start time, matching the offsets used when the live chat is converted after the stream ends.
+func (s *Service) GetLiveDVRChatComments(ctx context.Context, videoID uuid.UUID, start float64, end float64) ([]chat.Comment, error) {
+	video, err := s.getLiveDVRVideo(ctx, videoID)
+	if err != nil {
+		return nil, err
+	}
+
+	comments := []chat.Comment{}
+	if video.TmpLiveChatDownloadPath == "" || !utils.FileExists(video.TmpLiveChatDownloadPath) {
+		return comments, nil
+	}
+
+	liveComments, err := utils.ReadPartialLiveChatFile(video.TmpLiveChatDownloadPath)
+	if err != nil {
+		return nil, err
+	}
+
+	chatStart := video.Edges.Queue.ChatStart
+	if chatStart.IsZero() {
+		chatStart = video.StreamedAt
+	}
+
+	for _, liveComment := range liveComments {
+		if liveComment.Message == "" {
+			continue
+		}
+		comment := convertLiveComment(liveComment, chatStart)
+		if comment.ContentOffsetSeconds < start || comment.ContentOffsetSeconds > end {
+			continue
+		}
+		comments = append(comments, comment)
+	}
+
+	return comments, nil
+}
+
+// convertLiveComment converts a chat_downloader comment to a chat comment offset from chatStart.
+func convertLiveComment(liveComment utils.LiveComment, chatStart time.Time) chat.Comment {
+	// chat_downloader timestamps are in microseconds
+	offset := time.UnixMicro(liveComment.Timestamp).Sub(chatStart)
+
+	comment := chat.Comment{
+		ID:                   liveComment.MessageID,
+		ChannelID:            liveComment.ChannelID,
+		ContentOffsetSeconds: offset.Seconds(),
+		Source:               "chat",
+		Commenter: chat.Commenter{
+			ID:          liveComment.Author.ID,
+			DisplayName: liveComment.Author.DisplayName,
+			Name:        liveComment.Author.Name,
+		},
+		Message: chat.Message{
+			Body:       liveComment.Message,
+			Fragments:  []chat.Fragment{{Text: liveComment.Message}},
+			UserBadges: []chat.UserBadge{},
+		},
+	}
+	if liveComment.Colour != "" {
+		colour := liveComment.Colour
+		comment.Message.UserColor = &colour
+	}
+
+	return comment
+}
+
+// rewriteLiveDVRPlaylist marks the playlist as an EVENT playlist and strips any directories from segment URIs
+// so they resolve relative to the playlist endpoint.
+func rewriteLiveDVRPlaylist(data []byte) []byte {
+	var out bytes.Buffer
+	hasPlaylistType := bytes.Contains(data, []byte("#EXT-X-PLAYLIST-TYPE"))
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "":
+			continue
+		case strings.HasPrefix(line, "#"):
+			out.WriteString(line)
+			out.WriteString("\n")
+			if line == "#EXTM3U" && !hasPlaylistType {
+				out.WriteString(liveDVRPlaylistType)
+				out.WriteString("\n")
+			}
+		default:
+			out.WriteString(filepath.Base(line))
+			out.WriteString("\n")
+		}
+	}
+
+	return out.Bytes()
+}
@@ -0,0 +1,46 @@
+package vod
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+func TestRewriteLiveDVRPlaylist(t *testing.T) {
+	input := "#EXTM3U\n#EXT-X-VERSION:3\n#EXT-X-TARGETDURATION:10\n#EXT-X-MEDIA-SEQUENCE:0\n#EXTINF:10.000000,\n/data/temp/123_segment0.ts\n#EXTINF:10.000000,\n123_segment1.ts\n"
+	expected := "#EXTM3U\n#EXT-X-PLAYLIST-TYPE:EVENT\n#EXT-X-VERSION:3\n#EXT-X-TARGETDURATION:10\n#EXT-X-MEDIA-SEQUENCE:0\n#EXTINF:10.000000,\n123_segment0.ts\n#EXTINF:10.000000,\n123_segment1.ts\n"
+
+	got := string(rewriteLiveDVRPlaylist([]byte(input)))
+	if got != expected {
+		t.Errorf("unexpected playlist:\n%s\nexpected:\n%s", got, expected)
+	}
+
+	// an existing playlist type should not be duplicated
+	got = string(rewriteLiveDVRPlaylist([]byte("#EXTM3U\n#EXT-X-PLAYLIST-TYPE:EVENT\n")))
+	if strings.Count(got, "#EXT-X-PLAYLIST-TYPE") != 1 {
+		t.Errorf("expected a single playlist type tag, got:\n%s", got)
+	}
+}
+
+func TestConvertLiveComment(t *testing.T) {
+	chatStart := time.Unix(1700000000, 0)
+	liveComment := utils.LiveComment{
+		Message:   "hello",
+		MessageID: "abc",
+		Colour:    "#ffffff",
+		Timestamp: chatStart.Add(90 * time.Second).UnixMicro(),
+	}
+
+	comment := convertLiveComment(liveComment, chatStart)
+	if comment.ContentOffsetSeconds != 90 {
+		t.Errorf("expected offset 90, got %f", comment.ContentOffsetSeconds)
+	}
+	if comment.Message.Body != "hello" || comment.ID != "abc" {
+		t.Errorf("unexpected comment: %+v", comment)
+	}
+	if comment.Message.UserColor == nil || *comment.Message.UserColor != "#ffffff" {
+		t.Errorf("expected user color to be set")
+	}
+}
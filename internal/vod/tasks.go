@@ -10,16 +10,20 @@ import (
 	entChannel "github.com/zibbp/ganymede/ent/channel"
 	entVod "github.com/zibbp/ganymede/ent/vod"
 	"github.com/zibbp/ganymede/internal/database"
+	"github.com/zibbp/ganymede/internal/events"
+	"github.com/zibbp/ganymede/internal/notification"
+	vods_utility "github.com/zibbp/ganymede/internal/vod/utility"
 )
 
+// PruneVideos deletes the videos of channels with retention that are older than the retention. Videos are deleted
+// with their files instead of moved to the trash so pruning frees disk space.
 func PruneVideos(ctx context.Context, store *database.Database) error {
-	vodService := &Service{Store: database.DB()}
 	req := &http.Request{}
 	echoCtx := echo.New().NewContext(req, nil)
 	echoCtx.SetRequest(req.WithContext(ctx))
 
 	// fetch all channels that have retention enable
-	channels, err := store.Client.Channel.Query().Where(entChannel.Retention(true)).All(context.Background())
+	channels, err := store.Client.Channel.Query().Where(entChannel.Retention(true), entChannel.DeletedAtIsNil()).All(context.Background())
 	if err != nil {
 		log.Error().Err(err).Msg("error fetching channels")
 		return err
@@ -30,7 +34,7 @@ func PruneVideos(ctx context.Context, store *database.Database) error {
 	for _, channel := range channels {
 		log.Debug().Msgf("Processing channel %s", channel.ID)
 		// fetch all videos for channel
-		videos, err := store.Client.Vod.Query().Where(entVod.HasChannelWith(entChannel.ID(channel.ID))).All(context.Background())
+		videos, err := store.Client.Vod.Query().Where(entVod.HasChannelWith(entChannel.ID(channel.ID)), entVod.DeletedAtIsNil()).All(context.Background())
 		if err != nil {
 			log.Error().Err(err).Msgf("Error fetching videos for channel %s", channel.ID)
 			continue
@@ -47,11 +51,18 @@ func PruneVideos(ctx context.Context, store *database.Database) error {
 			if video.CreatedAt.Add(time.Duration(channel.RetentionDays) * 24 * time.Hour).Before(time.Now()) {
 				// delete video
 				log.Info().Str("video_id", video.ID.String()).Msg("deleting video as it is older than retention")
-				err := vodService.DeleteVod(ctx, video.ID, true)
+				err := vods_utility.DeleteVod(ctx, store, video.ID, true)
 				if err != nil {
 					log.Error().Err(err).Msgf("Error deleting video %s", video.ID)
 					continue
 				}
+				events.Publish(ctx, events.VideoDeleted, events.VideoDeletedData{
+					VideoID:   video.ID,
+					ChannelID: channel.ID,
+					Reason:    "retention",
+				})
+				// send in the background so a slow provider does not hold up retention
+				go notification.SendVideoDeletedNotification(channel, video, "retention")
 			}
 		}
 
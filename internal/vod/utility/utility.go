@@ -15,9 +15,28 @@ import (
 	entMutedSegment "github.com/zibbp/ganymede/ent/mutedsegment"
 	"github.com/zibbp/ganymede/ent/vod"
 	"github.com/zibbp/ganymede/internal/database"
+	"github.com/zibbp/ganymede/internal/exec"
+	"github.com/zibbp/ganymede/internal/exec/ytdlp"
 	"github.com/zibbp/ganymede/internal/utils"
 )
 
+// VodDirectory returns the directory of the VOD's files. An error is returned if the directory does not contain the VOD's folder name to prevent deleting or moving unrelated directories.
+func VodDirectory(v *ent.Vod) (string, error) {
+	// Use the videopath for standard videos
+	// If HLS video use the path of the HLS directory
+	videoPath := v.VideoPath
+	if v.VideoHlsPath != "" {
+		videoPath = v.VideoHlsPath
+	}
+
+	path := filepath.Dir(filepath.Clean(videoPath))
+
+	if v.FolderName != "" && !strings.Contains(path, v.FolderName) {
+		return "", fmt.Errorf("video folder_name not found in path, cowardly refusing to delete: %s", path)
+	}
+	return path, nil
+}
+
 // DeleteVod deletes a VOD and its associated files from the database and filesystem.
 // This is in a separate package to avoid circular dependencies with the vod service.
 func DeleteVod(ctx context.Context, store *database.Database, vodID uuid.UUID, deleteFiles bool) error {
@@ -54,22 +73,11 @@ func DeleteVod(ctx context.Context, store *database.Database, vodID uuid.UUID, d
 	if deleteFiles {
 		log.Info().Msgf("deleting files for vod %s", v.ID)
 
-		// Use the videopath for standard videos
-		// If HLS video use the path of the HLS directory
-		videoPath := v.VideoPath
-		if v.VideoHlsPath != "" {
-			videoPath = v.VideoHlsPath
-		}
-
-		path := filepath.Dir(filepath.Clean(videoPath))
-
-		// Make sure FolderName is present in the path before deleting
-		// This is to prevent accidental deletion of unrelated directories
-		if v.FolderName != "" {
-			if !strings.Contains(path, v.FolderName) {
-				log.Warn().Msgf("video folder_name not found in path, cowardly refusing to delete: %s. Delete video without deleting files then manually delete directory", path)
-				return fmt.Errorf("video folder_name not found in path, cowardly refusing to delete: %s. Delete video without deleting files then manually delete directory", path)
-			}
+		path, err := VodDirectory(v)
+		if err != nil {
+			msg := fmt.Sprintf("%v. Delete video without deleting files then manually delete directory", err)
+			log.Warn().Msg(msg)
+			return errors.New(msg)
 		}
 
 		log.Info().Msgf("deleting directory %s", path)
@@ -79,44 +87,57 @@ func DeleteVod(ctx context.Context, store *database.Database, vodID uuid.UUID, d
 			return fmt.Errorf("error deleting directory: %v", err)
 		}
 
-		// attempt to delete temp files
-		tempFiles := []string{
-			v.TmpVideoDownloadPath,
-			v.TmpVideoConvertPath,
-			v.TmpChatDownloadPath,
-			v.TmpChatRenderPath,
-			v.TmpLiveChatConvertPath,
-			v.TmpLiveChatDownloadPath,
+		if err := DeleteVodTempFiles(v); err != nil {
+			return err
 		}
-		for _, path := range tempFiles {
-			if path != "" {
-				err := utils.DeleteFile(path)
-				if err != nil {
-					if errors.Is(err, os.ErrNotExist) {
-						log.Debug().Msgf("temp file %s does not exist", path)
-					} else {
-						return err
-					}
-				}
-			}
-		}
-		if v.TmpVideoHlsPath != "" {
-			err := utils.DeleteDirectory(v.TmpVideoHlsPath)
+
+	}
+
+	err = store.Client.Vod.DeleteOneID(vodID).Exec(ctx)
+	if err != nil {
+		log.Debug().Err(err).Msg("error deleting vod")
+		return fmt.Errorf("error deleting vod: %v", err)
+	}
+	return nil
+}
+
+// DeleteVodTempFiles deletes the temporary files of an unfinished archive of the VOD.
+func DeleteVodTempFiles(v *ent.Vod) error {
+	tempFiles := []string{
+		v.TmpVideoDownloadPath,
+		v.TmpVideoConvertPath,
+		v.TmpChatDownloadPath,
+		v.TmpChatRenderPath,
+		v.TmpLiveChatConvertPath,
+		v.TmpLiveChatDownloadPath,
+	}
+	for _, path := range tempFiles {
+		if path != "" {
+			err := utils.DeleteFile(path)
 			if err != nil {
 				if errors.Is(err, os.ErrNotExist) {
-					log.Debug().Msgf("temp directory %s does not exist", v.TmpVideoHlsPath)
+					log.Debug().Msgf("temp file %s does not exist", path)
 				} else {
 					return err
 				}
 			}
 		}
-
 	}
-
-	err = store.Client.Vod.DeleteOneID(vodID).Exec(ctx)
-	if err != nil {
-		log.Debug().Err(err).Msg("error deleting vod")
-		return fmt.Errorf("error deleting vod: %v", err)
+	// remove partial files of an unfinished video download
+	if v.TmpVideoDownloadPath != "" {
+		if err := ytdlp.RemovePartialFiles(exec.VideoDownloadPathNoExt(*v)); err != nil {
+			log.Debug().Err(err).Msg("error removing partial video download files")
+		}
+	}
+	if v.TmpVideoHlsPath != "" {
+		err := utils.DeleteDirectory(v.TmpVideoHlsPath)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				log.Debug().Msgf("temp directory %s does not exist", v.TmpVideoHlsPath)
+			} else {
+				return err
+			}
+		}
 	}
 	return nil
 }
@@ -3,6 +3,7 @@ package vod
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math"
 	"runtime"
@@ -10,6 +11,7 @@ import (
 	"strconv"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	"github.com/riverqueue/river/rivertype"
@@ -17,18 +19,27 @@ import (
 	"github.com/zibbp/ganymede/ent"
 	"github.com/zibbp/ganymede/ent/channel"
 	"github.com/zibbp/ganymede/ent/playlist"
+	"github.com/zibbp/ganymede/ent/playlistvod"
 	"github.com/zibbp/ganymede/ent/predicate"
 	"github.com/zibbp/ganymede/ent/vod"
+	"github.com/zibbp/ganymede/internal/acl"
 	"github.com/zibbp/ganymede/internal/cache"
 	"github.com/zibbp/ganymede/internal/chat"
 	"github.com/zibbp/ganymede/internal/database"
 	"github.com/zibbp/ganymede/internal/platform"
+	"github.com/zibbp/ganymede/internal/tag"
 	"github.com/zibbp/ganymede/internal/tasks"
 	tasks_client "github.com/zibbp/ganymede/internal/tasks/client"
+	"github.com/zibbp/ganymede/internal/trash"
 	"github.com/zibbp/ganymede/internal/utils"
-	vods_utility "github.com/zibbp/ganymede/internal/vod/utility"
 )
 
+// ErrVodNotFound is returned when the requested vod does not exist
+var ErrVodNotFound = errors.New("vod not found")
+
+// ErrPositionSortWithoutPlaylist is returned when sorting by position without a playlist
+var ErrPositionSortWithoutPlaylist = errors.New("sort_by position requires a playlist_id")
+
 type Service struct {
 	Store       *database.Database
 	RiverClient *tasks_client.RiverClient
@@ -105,7 +116,7 @@ func (s *Service) CreateVod(vodDto Vod, cUUID uuid.UUID) (*ent.Vod, error) {
 }
 
 func (s *Service) GetVods(c echo.Context) ([]*ent.Vod, error) {
-	v, err := s.Store.Client.Vod.Query().WithChannel().Order(ent.Desc(vod.FieldStreamedAt)).All(c.Request().Context())
+	v, err := s.Store.Client.Vod.Query().Where(acl.Vods(c.Request().Context())).WithChannel().Order(ent.Desc(vod.FieldStreamedAt)).All(c.Request().Context())
 	if err != nil {
 		log.Debug().Err(err).Msg("error getting vods")
 		return nil, fmt.Errorf("error getting vods: %v", err)
@@ -115,7 +126,7 @@ func (s *Service) GetVods(c echo.Context) ([]*ent.Vod, error) {
 }
 
 func (s *Service) GetVodsByChannel(c echo.Context, cUUID uuid.UUID) ([]*ent.Vod, error) {
-	v, err := s.Store.Client.Vod.Query().Where(vod.HasChannelWith(channel.ID(cUUID))).Order(ent.Desc(vod.FieldStreamedAt)).All(c.Request().Context())
+	v, err := s.Store.Client.Vod.Query().Where(vod.HasChannelWith(channel.ID(cUUID)), acl.Vods(c.Request().Context())).Order(ent.Desc(vod.FieldStreamedAt)).All(c.Request().Context())
 	if err != nil {
 		log.Debug().Err(err).Msg("error getting vods by channel")
 		return nil, fmt.Errorf("error getting vods by channel: %v", err)
@@ -161,7 +172,7 @@ func (s *Service) GetVod(ctx context.Context, vodID uuid.UUID, withChannel bool,
 		log.Debug().Err(err).Msg("error getting vod")
 		// if vod not found
 		if _, ok := err.(*ent.NotFoundError); ok {
-			return nil, fmt.Errorf("vod not found")
+			return nil, ErrVodNotFound
 		}
 		return nil, fmt.Errorf("error getting vod: %v", err)
 	}
@@ -169,8 +180,9 @@ func (s *Service) GetVod(ctx context.Context, vodID uuid.UUID, withChannel bool,
 	return v, nil
 }
 
+// DeleteVod moves the vod to the trash, it is permanently deleted when the trash is purged.
 func (s *Service) DeleteVod(ctx context.Context, vodID uuid.UUID, deleteFiles bool) error {
-	return vods_utility.DeleteVod(ctx, s.Store, vodID, deleteFiles)
+	return trash.TrashVod(ctx, s.Store, vodID, deleteFiles)
 }
 
 func (s *Service) UpdateVod(c echo.Context, vodID uuid.UUID, vodDto Vod, cUUID uuid.UUID) (*ent.Vod, error) {
@@ -188,8 +200,9 @@ func (s *Service) UpdateVod(c echo.Context, vodID uuid.UUID, vodDto Vod, cUUID u
 	return v, nil
 }
 
+// CheckVodExists returns whether a vod with the external ID exists. It returns trash.ErrVodInTrash if the vod is in the trash.
 func (s *Service) CheckVodExists(extID string) (bool, error) {
-	_, err := s.Store.Client.Vod.Query().Where(vod.ExtID(extID)).Only(context.Background())
+	v, err := s.Store.Client.Vod.Query().Where(vod.ExtID(extID)).Only(context.Background())
 	if err != nil {
 		log.Debug().Err(err).Msg("error checking vod exists")
 
@@ -199,17 +212,22 @@ func (s *Service) CheckVodExists(extID string) (bool, error) {
 		}
 		return false, fmt.Errorf("error checking vod exists: %v", err)
 	}
+	if v.DeletedAt != nil {
+		return true, trash.ErrVodInTrash
+	}
 
 	return true, nil
 }
 
-func (s *Service) SearchVods(ctx context.Context, limit int, offset int, types []utils.VodType, predicates []predicate.Vod, sortBy utils.VideoSort, sortOrder utils.SortOrder) (Pagination, error) {
+func (s *Service) SearchVods(ctx context.Context, limit int, offset int, types []utils.VodType, tags []string, predicates []predicate.Vod, sortBy utils.VideoSort, sortOrder utils.SortOrder) (Pagination, error) {
 
 	var pagination Pagination
 
 	queryBuilder := s.Store.Client.Vod.Query().
-		Where(vod.Or(predicates...)).
+		Where(vod.Or(predicates...), acl.Vods(ctx)).
+		Where(tag.Predicates(tags)...).
 		WithChannel().
+		WithTags().
 		Limit(limit).
 		Offset(offset)
 
@@ -230,7 +248,7 @@ func (s *Service) SearchVods(ctx context.Context, limit int, offset int, types [
 		return pagination, fmt.Errorf("error searching vods: %v", err)
 	}
 
-	countQuery := s.Store.Client.Vod.Query().Where(vod.Or(predicates...))
+	countQuery := s.Store.Client.Vod.Query().Where(vod.Or(predicates...), acl.Vods(ctx)).Where(tag.Predicates(tags)...)
 	if len(types) > 0 {
 		countQuery = countQuery.Where(vod.TypeIn(types...))
 	}
@@ -251,7 +269,9 @@ func (s *Service) SearchVods(ctx context.Context, limit int, offset int, types [
 }
 
 func (s *Service) GetVodPlaylists(c echo.Context, vodID uuid.UUID) ([]*ent.Playlist, error) {
-	v, err := s.Store.Client.Vod.Query().Where(vod.ID(vodID)).WithPlaylists().Only(c.Request().Context())
+	v, err := s.Store.Client.Vod.Query().Where(vod.ID(vodID)).WithPlaylists(func(q *ent.PlaylistQuery) {
+		q.Where(acl.Playlists(c.Request().Context()))
+	}).Only(c.Request().Context())
 	if err != nil {
 		log.Debug().Err(err).Msg("error getting vod playlists")
 		return nil, fmt.Errorf("error getting vod playlists: %v", err)
@@ -260,7 +280,7 @@ func (s *Service) GetVodPlaylists(c echo.Context, vodID uuid.UUID) ([]*ent.Playl
 	return v.Edges.Playlists, nil
 }
 
-func (s *Service) GetVodsPagination(c echo.Context, limit int, offset int, channelId uuid.UUID, types []utils.VodType, playlistId uuid.UUID, isProcessing bool, sortBy utils.VideoSort, sortOrder utils.SortOrder) (Pagination, error) {
+func (s *Service) GetVodsPagination(c echo.Context, limit int, offset int, channelId uuid.UUID, types []utils.VodType, tags []string, playlistId uuid.UUID, isProcessing bool, sortBy utils.VideoSort, sortOrder utils.SortOrder) (Pagination, error) {
 	var pagination Pagination
 
 	if channelId != uuid.Nil && playlistId != uuid.Nil {
@@ -268,7 +288,7 @@ func (s *Service) GetVodsPagination(c echo.Context, limit int, offset int, chann
 	}
 
 	// Query builder
-	vodQuery := s.Store.Client.Vod.Query()
+	vodQuery := s.Store.Client.Vod.Query().Where(acl.Vods(c.Request().Context()))
 
 	// If channel id is not nil
 	if channelId != uuid.Nil {
@@ -277,7 +297,7 @@ func (s *Service) GetVodsPagination(c echo.Context, limit int, offset int, chann
 
 	// If playlist id is not nil
 	if playlistId != uuid.Nil {
-		vodQuery = vodQuery.Where(vod.HasPlaylistsWith(playlist.ID(playlistId)))
+		vodQuery = vodQuery.Where(vod.HasPlaylistsWith(playlist.ID(playlistId), acl.Playlists(c.Request().Context())))
 	}
 
 	// If types is not nil
@@ -285,19 +305,32 @@ func (s *Service) GetVodsPagination(c echo.Context, limit int, offset int, chann
 		vodQuery = vodQuery.Where(vod.TypeIn(types...))
 	}
 
+	// Vods must have all tags
+	if len(tags) > 0 {
+		vodQuery = vodQuery.Where(tag.Predicates(tags)...)
+	}
+
 	// If processing is true
 	if !isProcessing {
 		vodQuery = vodQuery.Where(vod.Processing(isProcessing))
 	}
 
 	// Apply sorting
-	vodQuery, err := applyVodSorting(vodQuery, sortBy, sortOrder)
+	var err error
+	if sortBy == utils.SortPosition {
+		if playlistId == uuid.Nil {
+			return pagination, ErrPositionSortWithoutPlaylist
+		}
+		vodQuery = applyPlaylistPositionSorting(vodQuery, playlistId, sortOrder)
+	} else {
+		vodQuery, err = applyVodSorting(vodQuery, sortBy, sortOrder)
+	}
 	if err != nil {
 		log.Debug().Err(err).Msg("error applying vod sorting")
 		return pagination, fmt.Errorf("error applying vod sorting: %v", err)
 	}
 
-	v, err := vodQuery.Limit(limit).Offset(offset).WithChannel().All(c.Request().Context())
+	v, err := vodQuery.Limit(limit).Offset(offset).WithChannel().WithTags().All(c.Request().Context())
 	if err != nil {
 		log.Debug().Err(err).Msg("error getting vods")
 		return pagination, fmt.Errorf("error getting vods: %v", err)
@@ -305,7 +338,7 @@ func (s *Service) GetVodsPagination(c echo.Context, limit int, offset int, chann
 
 	// Get total count
 	// Amount will differ depending on types supplied
-	totalCountQuery := s.Store.Client.Vod.Query()
+	totalCountQuery := s.Store.Client.Vod.Query().Where(acl.Vods(c.Request().Context()))
 
 	// If channel id is not nil
 	if channelId != uuid.Nil {
@@ -314,7 +347,7 @@ func (s *Service) GetVodsPagination(c echo.Context, limit int, offset int, chann
 
 	// If playlist id is not nil
 	if playlistId != uuid.Nil {
-		totalCountQuery = totalCountQuery.Where(vod.HasPlaylistsWith(playlist.ID(playlistId)))
+		totalCountQuery = totalCountQuery.Where(vod.HasPlaylistsWith(playlist.ID(playlistId), acl.Playlists(c.Request().Context())))
 	}
 
 	// If types is not nil
@@ -322,6 +355,11 @@ func (s *Service) GetVodsPagination(c echo.Context, limit int, offset int, chann
 		totalCountQuery = totalCountQuery.Where(vod.TypeIn(types...))
 	}
 
+	// Vods must have all tags
+	if len(tags) > 0 {
+		totalCountQuery = totalCountQuery.Where(tag.Predicates(tags)...)
+	}
+
 	totalCount, err := totalCountQuery.Count(c.Request().Context())
 	if err != nil {
 		log.Debug().Err(err).Msg("error getting total vod count")
@@ -355,7 +393,7 @@ func (s *Service) GetVodClips(ctx context.Context, id uuid.UUID) ([]*ent.Vod, er
 		return nil, err
 	}
 
-	clips, err := s.Store.Client.Vod.Query().Where(vod.ClipExtVodID(video.ExtID)).All(ctx)
+	clips, err := s.Store.Client.Vod.Query().Where(vod.ClipExtVodID(video.ExtID), acl.Vods(ctx)).All(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -936,6 +974,23 @@ func (s *Service) GetVodChatHistogram(ctx context.Context, videoId uuid.UUID, re
 	return sortedHistogram, nil
 }
 
+// applyPlaylistPositionSorting sorts a VodQuery by the position of the vods in the playlist, vods at the same position are sorted by date streamed.
+func applyPlaylistPositionSorting(query *ent.VodQuery, playlistID uuid.UUID, sortOrder utils.SortOrder) *ent.VodQuery {
+	return query.Order(func(s *sql.Selector) {
+		t := sql.Table(playlistvod.Table)
+		s.Join(t).OnP(sql.And(
+			sql.ColumnsEQ(s.C(vod.FieldID), t.C(playlistvod.FieldVodID)),
+			sql.EQ(t.C(playlistvod.FieldPlaylistID), playlistID),
+		))
+		if sortOrder == utils.SortOrderDesc {
+			s.OrderBy(sql.Desc(t.C(playlistvod.FieldPosition)))
+		} else {
+			s.OrderBy(t.C(playlistvod.FieldPosition))
+		}
+		s.OrderBy(sql.Desc(s.C(vod.FieldStreamedAt)))
+	})
+}
+
 // applyVodSorting applies sorting to a VodQuery based on sortBy and sortOrder
 func applyVodSorting(query *ent.VodQuery, sortBy utils.VideoSort, sortOrder utils.SortOrder) (*ent.VodQuery, error) {
 	if sortOrder == "" {
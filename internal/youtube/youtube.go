@@ -2,8 +2,10 @@ package youtube
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
+	"net/http"
 	"os"
 	"path/filepath"
 	"strings"
@@ -14,10 +16,16 @@ import (
 	"github.com/zibbp/ganymede/ent"
 	entVod "github.com/zibbp/ganymede/ent/vod"
 	"golang.org/x/oauth2"
+	"google.golang.org/api/googleapi"
 	"google.golang.org/api/option"
 	"google.golang.org/api/youtube/v3"
 )
 
+var (
+	ErrNoCredentials      = errors.New("no YouTube credentials found")
+	ErrCredentialsInvalid = errors.New("YouTube credentials are invalid")
+)
+
 // getYouTubeClient creates an authenticated YouTube API client
 func (s *Service) getYouTubeClient(ctx context.Context) (*youtube.Service, error) {
 	// Get credentials from database
@@ -555,3 +563,37 @@ func (pr *progressReader) Read(p []byte) (n int, err error) {
 	}
 	return
 }
+
+// ValidateCredentials checks the stored YouTube credentials can still be used. ErrNoCredentials is returned if no credentials are stored and ErrCredentialsInvalid if they were revoked or can no longer be refreshed.
+func (s *Service) ValidateCredentials(ctx context.Context) error {
+	exists, err := s.Store.Client.YoutubeCredential.Query().Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("error checking YouTube credentials: %w", err)
+	}
+	if !exists {
+		return ErrNoCredentials
+	}
+
+	service, err := s.getYouTubeClient(ctx)
+	if err != nil {
+		var retrieveErr *oauth2.RetrieveError
+		if errors.As(err, &retrieveErr) {
+			return fmt.Errorf("%w: %v", ErrCredentialsInvalid, retrieveErr)
+		}
+		return err
+	}
+
+	_, err = service.Channels.List([]string{"id"}).Mine(true).Context(ctx).Do()
+	if err != nil {
+		var apiErr *googleapi.Error
+		if errors.As(err, &apiErr) && apiErr.Code == http.StatusUnauthorized {
+			return fmt.Errorf("%w: %v", ErrCredentialsInvalid, apiErr)
+		}
+		var retrieveErr *oauth2.RetrieveError
+		if errors.As(err, &retrieveErr) {
+			return fmt.Errorf("%w: %v", ErrCredentialsInvalid, retrieveErr)
+		}
+		return fmt.Errorf("error validating YouTube credentials: %w", err)
+	}
+	return nil
+}